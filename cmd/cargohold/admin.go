@@ -0,0 +1,138 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/na2na-p/cargohold/internal/config"
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/na2na-p/cargohold/internal/infrastructure"
+	"github.com/na2na-p/cargohold/internal/infrastructure/postgres"
+	"github.com/na2na-p/cargohold/internal/infrastructure/redis"
+	"github.com/na2na-p/cargohold/internal/usecase"
+)
+
+// runAdmin は管理用のサブコマンドを実行する
+func runAdmin(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: cargohold admin allowlist <add|remove|list>")
+	}
+
+	switch args[0] {
+	case "allowlist":
+		return runAllowlist(args[1:])
+	default:
+		return fmt.Errorf("unknown admin command: %s", args[0])
+	}
+}
+
+// allowlistEntryJSON は許可リポジトリのJSON出力形式
+type allowlistEntryJSON struct {
+	Repository string `json:"repository"`
+	Owner      string `json:"owner"`
+	Repo       string `json:"repo"`
+}
+
+// runAllowlist は許可リポジトリの追加・削除・一覧表示を行う
+// 認証時に参照されるキャッシュ(lfs:oidc:github:repo:*)も合わせて更新する
+func runAllowlist(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: cargohold admin allowlist <add|remove|list> [-json] [owner/repo]")
+	}
+	command := args[0]
+
+	fs := flag.NewFlagSet("allowlist "+command, flag.ContinueOnError)
+	asJSON := fs.Bool("json", false, "結果をJSONで出力する")
+	if err := fs.Parse(args[1:]); err != nil {
+		return err
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	pool, err := openPostgres(cfg.Database, nil)
+	if err != nil {
+		return err
+	}
+	defer pool.Close()
+
+	redisConn, err := openRedis(cfg.Redis)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = redisConn.Close() }()
+
+	allowlistUC := usecase.NewRepositoryAllowlistUseCase(
+		infrastructure.NewCachingRepositoryAllowlist(
+			postgres.NewRepositoryAllowlistRepository(pool),
+			redis.NewRedisClient(redisConn),
+			nil,
+		),
+	)
+
+	switch command {
+	case "add", "remove":
+		if fs.NArg() != 1 {
+			return fmt.Errorf("usage: cargohold admin allowlist %s [-json] <owner/repo>", command)
+		}
+		var repository *domain.AllowedRepository
+		if command == "add" {
+			repository, err = allowlistUC.Add(ctx, fs.Arg(0))
+		} else {
+			repository, err = allowlistUC.Remove(ctx, fs.Arg(0))
+		}
+		if err != nil {
+			return err
+		}
+		if *asJSON {
+			return writeAllowlistJSON(os.Stdout, toAllowlistEntryJSON(repository))
+		}
+		_, err = fmt.Fprintf(os.Stdout, "%s: %s\n", command, repository.String())
+		return err
+	case "list":
+		repositories, err := allowlistUC.List(ctx)
+		if err != nil {
+			return err
+		}
+		if *asJSON {
+			entries := make([]allowlistEntryJSON, len(repositories))
+			for i, repository := range repositories {
+				entries[i] = toAllowlistEntryJSON(repository)
+			}
+			return writeAllowlistJSON(os.Stdout, entries)
+		}
+		for _, repository := range repositories {
+			if _, err := fmt.Fprintln(os.Stdout, repository.String()); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown allowlist command: %s", command)
+	}
+}
+
+func toAllowlistEntryJSON(repository *domain.AllowedRepository) allowlistEntryJSON {
+	return allowlistEntryJSON{
+		Repository: repository.String(),
+		Owner:      repository.Owner(),
+		Repo:       repository.Repo(),
+	}
+}
+
+func writeAllowlistJSON(w io.Writer, v any) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/na2na-p/cargohold/internal/config"
+	"github.com/na2na-p/cargohold/internal/infrastructure/postgres"
+	"github.com/na2na-p/cargohold/internal/usecase"
+)
+
+// runAudit は監査ログ用のサブコマンドを実行する
+func runAudit(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: cargohold audit prune [-older-than duration]")
+	}
+
+	switch args[0] {
+	case "prune":
+		return runAuditPrune(args[1:])
+	default:
+		return fmt.Errorf("unknown audit command: %s", args[0])
+	}
+}
+
+// runAuditPrune は保持期間を過ぎた監査イベントを削除する
+func runAuditPrune(args []string) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	fs := flag.NewFlagSet("audit prune", flag.ContinueOnError)
+	retention := fs.Duration("older-than", cfg.Audit.Retention, "この時間より前に発生した監査イベントを削除する")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *retention <= 0 {
+		return fmt.Errorf("-older-than には正の値を指定してください")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	pool, err := openPostgres(cfg.Database, nil)
+	if err != nil {
+		return err
+	}
+	defer pool.Close()
+
+	auditRepo := postgres.NewAuditEventRepository(pool)
+	auditUC := usecase.NewAuditUseCase(auditRepo, auditRepo)
+
+	deleted, err := auditUC.Prune(ctx, *retention)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(os.Stdout, "%d 件の監査イベントを削除しました\n", deleted)
+	return err
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"log/slog"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	goredis "github.com/redis/go-redis/v9"
+
+	"github.com/na2na-p/cargohold/internal/config"
+	"github.com/na2na-p/cargohold/internal/infrastructure/postgres"
+	"github.com/na2na-p/cargohold/internal/infrastructure/redis"
+	"github.com/na2na-p/cargohold/internal/infrastructure/s3"
+)
+
+// openPostgres はPostgreSQLに接続する。metricsがnilの場合はクエリのメトリクスを記録しない
+func openPostgres(cfg config.DatabaseConfig, metrics postgres.QueryMetricsRecorder) (*pgxpool.Pool, error) {
+	pool, err := postgres.NewPostgresConnection(postgres.PostgresConfig{
+		Host:     cfg.Host,
+		Port:     cfg.Port,
+		User:     cfg.User,
+		Password: cfg.Password,
+		Database: cfg.DBName,
+		SSLMode:  cfg.SSLMode,
+		Metrics:  metrics,
+	})
+	if err != nil {
+		return nil, err
+	}
+	slog.Info("PostgreSQL connection established")
+	return pool, nil
+}
+
+func openRedis(cfg config.RedisConfig) (*goredis.Client, error) {
+	conn, err := redis.NewRedisConnection(redis.RedisConfig{
+		Host:     cfg.Host,
+		Port:     cfg.Port,
+		Password: cfg.Password,
+		DB:       cfg.DB,
+	})
+	if err != nil {
+		return nil, err
+	}
+	slog.Info("Redis connection established")
+	return conn, nil
+}
+
+// openS3 はS3クライアントを生成する。metricsがnilの場合はストレージ操作のメトリクスを記録しない
+func openS3(cfg config.S3Config, metrics s3.StorageMetricsRecorder) (*s3.S3Client, error) {
+	conn, err := s3.NewS3Connection(s3.S3Config{
+		Endpoint:        cfg.Endpoint,
+		AccessKeyID:     cfg.AccessKeyID,
+		SecretAccessKey: cfg.SecretAccessKey,
+		Region:          cfg.Region,
+	})
+	if err != nil {
+		return nil, err
+	}
+	slog.Info("S3 connection established")
+	return s3.NewS3Client(conn, cfg.BucketName, metrics), nil
+}
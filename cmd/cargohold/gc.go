@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"github.com/na2na-p/cargohold/internal/config"
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/na2na-p/cargohold/internal/infrastructure"
+	"github.com/na2na-p/cargohold/internal/infrastructure/postgres"
+	"github.com/na2na-p/cargohold/internal/infrastructure/redis"
+	"github.com/na2na-p/cargohold/internal/usecase"
+)
+
+// runGC はアップロードされないまま放置されたオブジェクトと、アクセスポリシーが残っていないオブジェクト、
+// 検証前のまま残された一時オブジェクトを削除する
+func runGC(args []string) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	fs := flag.NewFlagSet("gc", flag.ContinueOnError)
+	staleAfter := fs.Duration("older-than", cfg.GC.StaleAfter, "この時間より前に作成されたオブジェクトのみを対象とする")
+	limit := fs.Int("limit", cfg.GC.Limit, "種類ごとに1回の実行で対象とする最大件数")
+	dryRun := fs.Bool("dry-run", false, "削除せずに対象のオブジェクトを表示する")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *staleAfter <= 0 || *limit <= 0 {
+		return fmt.Errorf("-older-than と -limit には正の値を指定してください")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	pool, err := openPostgres(cfg.Database, nil)
+	if err != nil {
+		return err
+	}
+	defer pool.Close()
+
+	redisConn, err := openRedis(cfg.Redis)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = redisConn.Close() }()
+
+	s3Client, err := openS3(cfg.S3, nil)
+	if err != nil {
+		return err
+	}
+
+	cachingRepo := infrastructure.NewCachingLFSObjectRepository(
+		postgres.NewLFSObjectRepository(pool),
+		redis.NewRedisClient(redisConn),
+		redis.NewCacheKeyGenerator(),
+		redis.NewCacheConfig(),
+		nil,
+	)
+	gcUC := usecase.NewGCUseCase(cachingRepo, s3Client, s3Client)
+
+	result, err := gcUC.Run(ctx, usecase.GCQuery{
+		StaleAfter: *staleAfter,
+		Limit:      *limit,
+		DryRun:     *dryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := printGCResult(os.Stdout, result, *dryRun); err != nil {
+		return err
+	}
+	if result.Failed > 0 {
+		return fmt.Errorf("%d 件のオブジェクトの処理に失敗しました", result.Failed)
+	}
+	return nil
+}
+
+func printGCResult(w io.Writer, result *usecase.GCResult, dryRun bool) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "REASON\tOID\tSIZE\tUPLOADED\tCREATED_AT")
+	writeRows := func(reason string, objects []*domain.LFSObject) {
+		for _, obj := range objects {
+			_, _ = fmt.Fprintf(tw, "%s\t%s\t%d\t%t\t%s\n",
+				reason, obj.OID().String(), obj.Size().Int64(), obj.IsUploaded(), obj.CreatedAt().Format(time.RFC3339))
+		}
+	}
+	writeRows("stale", result.Stale)
+	writeRows("unreferenced", result.Unreferenced)
+	writeRows("recovered", result.Recovered)
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	if len(result.Staging) > 0 {
+		_, _ = fmt.Fprintln(tw, "STAGING_KEY\tSIZE\tLAST_MODIFIED")
+		for _, staged := range result.Staging {
+			_, _ = fmt.Fprintf(tw, "%s\t%d\t%s\n", staged.Key, staged.Size, staged.LastModified.Format(time.RFC3339))
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+
+	total := len(result.Stale) + len(result.Unreferenced) + len(result.Staging)
+	if dryRun {
+		_, err := fmt.Fprintf(w, "dry-run: %d 件のオブジェクトが削除対象です (stale: %d, unreferenced: %d, staging: %d)、%d 件をアップロード済みとして記録します\n",
+			total, len(result.Stale), len(result.Unreferenced), len(result.Staging), len(result.Recovered))
+		return err
+	}
+	_, err := fmt.Fprintf(w, "%d 件中 %d 件のオブジェクトを削除し、%d 件をアップロード済みとして記録しました (スキップ: %d, 失敗: %d)\n",
+		total, result.Deleted, result.Completed, result.Skipped, result.Failed)
+	return err
+}
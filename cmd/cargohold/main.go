@@ -9,6 +9,8 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"slices"
+	"strings"
 	"syscall"
 	"time"
 
@@ -22,10 +24,12 @@ import (
 	authMiddleware "github.com/na2na-p/cargohold/internal/handler/middleware"
 	"github.com/na2na-p/cargohold/internal/infrastructure"
 	"github.com/na2na-p/cargohold/internal/infrastructure/logging"
+	"github.com/na2na-p/cargohold/internal/infrastructure/metrics"
 	"github.com/na2na-p/cargohold/internal/infrastructure/oidc"
 	"github.com/na2na-p/cargohold/internal/infrastructure/postgres"
 	"github.com/na2na-p/cargohold/internal/infrastructure/redis"
 	"github.com/na2na-p/cargohold/internal/infrastructure/s3"
+	"github.com/na2na-p/cargohold/internal/infrastructure/tracing"
 	infraurl "github.com/na2na-p/cargohold/internal/infrastructure/url"
 	"github.com/na2na-p/cargohold/internal/usecase"
 )
@@ -39,66 +43,102 @@ const (
 )
 
 func main() {
-	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+	args := os.Args[1:]
+
+	// サブコマンドは結果を標準出力に書き出すため、ログは標準エラー出力に分離する
+	logOutput := os.Stdout
+	if len(args) > 0 && args[0] != "serve" {
+		logOutput = os.Stderr
+	}
+	logger := slog.New(slog.NewJSONHandler(logOutput, &slog.HandlerOptions{
 		Level:       slog.LevelInfo,
 		ReplaceAttr: logging.MaskSensitiveAttrs,
 	}))
 	slog.SetDefault(logger)
 
-	if err := run(); err != nil {
+	if err := dispatch(args); err != nil {
 		slog.Error("application failed", "error", err)
 		os.Exit(1)
 	}
 }
 
+// dispatch はサブコマンドに応じた処理を実行する。サブコマンドが指定されない場合はサーバーを起動する。
+func dispatch(args []string) error {
+	if len(args) == 0 {
+		return run()
+	}
+
+	switch args[0] {
+	case "serve":
+		return run()
+	case "gc":
+		return runGC(args[1:])
+	case "reconcile":
+		return runReconcile(args[1:])
+	case "admin":
+		return runAdmin(args[1:])
+	case "audit":
+		return runAudit(args[1:])
+	default:
+		return fmt.Errorf("unknown command: %s", args[0])
+	}
+}
+
 func run() error {
 	cfg, err := config.Load()
 	if err != nil {
 		return err
 	}
 
-	pool, err := postgres.NewPostgresConnection(postgres.PostgresConfig{
-		Host:     cfg.Database.Host,
-		Port:     cfg.Database.Port,
-		User:     cfg.Database.User,
-		Password: cfg.Database.Password,
-		Database: cfg.Database.DBName,
-		SSLMode:  cfg.Database.SSLMode,
-	})
+	// トレーシングが無効な場合もtraceparentヘッダーを下流へ引き継げるよう、プロパゲーターは常に設定する
+	tracing.SetupPropagator()
+	if cfg.Tracing.Enabled {
+		shutdownTracing, err := tracing.Setup(context.Background(), tracing.Config{
+			Endpoint:    cfg.Tracing.OTLPEndpoint,
+			Insecure:    cfg.Tracing.OTLPInsecure,
+			ServiceName: cfg.Tracing.ServiceName,
+			SampleRatio: cfg.Tracing.SampleRatio,
+		})
+		if err != nil {
+			return err
+		}
+		defer func() {
+			ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+			defer cancel()
+			if err := shutdownTracing(ctx); err != nil {
+				slog.Error("failed to shutdown tracer provider", "error", err)
+			}
+		}()
+		slog.Info("tracing enabled", "service_name", cfg.Tracing.ServiceName, "sample_ratio", cfg.Tracing.SampleRatio)
+	}
+
+	// メトリクスは常に収集し、METRICS_ENABLEDが有効な場合のみ公開する
+	appMetrics := metrics.NewMetrics()
+
+	pool, err := openPostgres(cfg.Database, appMetrics)
 	if err != nil {
 		return err
 	}
 	defer pool.Close()
-	slog.Info("PostgreSQL connection established")
-
-	redisConn, err := redis.NewRedisConnection(redis.RedisConfig{
-		Host:     cfg.Redis.Host,
-		Port:     cfg.Redis.Port,
-		Password: cfg.Redis.Password,
-		DB:       cfg.Redis.DB,
-	})
+
+	redisConn, err := openRedis(cfg.Redis)
 	if err != nil {
 		return err
 	}
 	defer func() { _ = redisConn.Close() }()
 	redisClient := redis.NewRedisClient(redisConn)
-	slog.Info("Redis connection established")
-
-	s3Conn, err := s3.NewS3Connection(s3.S3Config{
-		Endpoint:        cfg.S3.Endpoint,
-		AccessKeyID:     cfg.S3.AccessKeyID,
-		SecretAccessKey: cfg.S3.SecretAccessKey,
-		Region:          cfg.S3.Region,
-	})
+
+	s3Client, err := openS3(cfg.S3, appMetrics)
 	if err != nil {
 		return err
 	}
-	s3Client := s3.NewS3Client(s3Conn, cfg.S3.BucketName)
-	slog.Info("S3 connection established")
 
 	lfsRepo := postgres.NewLFSObjectRepository(pool)
 	policyRepo := postgres.NewAccessPolicyRepository(pool)
 	repoAllowlistRepo := postgres.NewRepositoryAllowlistRepository(pool)
+	claimRuleRepo := postgres.NewGitHubClaimRuleRepository(pool)
+	lockRepo := postgres.NewLockRepository(pool)
+	accessTokenRepo := postgres.NewAccessTokenRepository(pool)
 
 	var githubProvider *oidc.GitHubOIDCProvider
 	if cfg.OIDC.GitHub.Enabled {
@@ -114,11 +154,11 @@ func run() error {
 	}
 
 	var githubOAuthUC *usecase.GitHubOAuthUseCase
+	sessionAuthUC := usecase.NewSessionAuthUseCase(redisClient, nil, nil, 0)
 	if cfg.OAuth.GitHub.Enabled {
 		githubOAuthProvider, err := oidc.NewGitHubOAuthProvider(
 			cfg.OAuth.GitHub.ClientID,
 			cfg.OAuth.GitHub.ClientSecret,
-			"",
 		)
 		if err != nil {
 			return err
@@ -126,7 +166,28 @@ func run() error {
 
 		oauthProviderAdapter := oidc.NewGitHubOAuthProviderAdapter(githubOAuthProvider)
 		oauthStateStore := redis.NewOAuthStateStore(redisClient)
+		deviceAuthorizationStore := redis.NewDeviceAuthorizationStore(redisClient)
 		sessionStoreAdapter := redis.NewSessionStoreAdapterWithDefaults(redisClient)
+		if cfg.OAuth.GitHub.SessionRecheckInterval > 0 && cfg.OAuth.GitHub.TokenEncryptionKey == "" {
+			// 再検証を指定したまま黙って無効にならないよう、鍵が未設定の場合は起動しない
+			return fmt.Errorf("OAUTH_GITHUB_TOKEN_ENCRYPTION_KEY is required when OAUTH_GITHUB_SESSION_RECHECK_INTERVAL is set (%s)", cfg.OAuth.GitHub.SessionRecheckInterval)
+		}
+		// 権限の再検証と複数のリポジトリを対象とするセッションは、保存したOAuthトークンで権限を取得する
+		repositoryScopeEnabled := cfg.OAuth.GitHub.TokenEncryptionKey != ""
+		if repositoryScopeEnabled {
+			tokenCipher, err := infrastructure.NewTokenCipher(cfg.OAuth.GitHub.TokenEncryptionKey)
+			if err != nil {
+				return fmt.Errorf("failed to create token cipher: %w", err)
+			}
+			sessionStoreAdapter = redis.NewSessionStoreAdapter(redisClient, &redis.DefaultUUIDGenerator{}, tokenCipher)
+			sessionAuthUC = usecase.NewSessionAuthUseCase(
+				redisClient,
+				sessionStoreAdapter,
+				oauthProviderAdapter,
+				cfg.OAuth.GitHub.SessionRecheckInterval,
+			)
+			slog.Info("OAuth token storage enabled", "recheck_interval", cfg.OAuth.GitHub.SessionRecheckInterval)
+		}
 
 		allowedRedirectURIs, err := domain.NewAllowedRedirectURIs(cfg.OAuth.GitHub.AllowedRedirectURIs)
 		if err != nil {
@@ -137,7 +198,9 @@ func run() error {
 			oauthProviderAdapter,
 			sessionStoreAdapter,
 			oauthStateStore,
+			deviceAuthorizationStore,
 			allowedRedirectURIs,
+			repositoryScopeEnabled,
 		)
 		if err != nil {
 			return err
@@ -150,22 +213,39 @@ func run() error {
 	storageKeyGenerator := s3.NewStorageKeyGenerator()
 	proxyActionURLGenerator := infraurl.NewProxyActionURLGenerator()
 
+	transferMode, err := domain.ParseTransferMode(cfg.Transfer.Mode)
+	if err != nil {
+		return err
+	}
+	transferPolicy := usecase.NewTransferPolicy(transferMode, cfg.Transfer.PresignThreshold, cfg.Transfer.PresignTTL)
+	slog.Info("transfer mode configured", "mode", transferMode.String(), "presign_threshold", cfg.Transfer.PresignThreshold)
+
 	cachingRepo := infrastructure.NewCachingLFSObjectRepository(
 		lfsRepo,
 		redisClient,
 		cacheKeyGenerator,
 		cacheConfig,
+		appMetrics,
 	)
 
-	cachingRepoAllowlist := infrastructure.NewCachingRepositoryAllowlist(repoAllowlistRepo, redisClient)
-	authUC := usecase.NewAuthUseCase(githubProvider, cachingRepoAllowlist, redisClient)
+	cachingRepoAllowlist := infrastructure.NewCachingRepositoryAllowlist(repoAllowlistRepo, redisClient, appMetrics)
+	cachingClaimRuleRepo := infrastructure.NewCachingGitHubClaimRuleRepository(claimRuleRepo, redisClient, appMetrics)
+	oidcAuthenticators, err := buildOIDCAuthenticators(cfg.OIDC, githubProvider, cachingRepoAllowlist, cachingClaimRuleRepo, redisClient)
+	if err != nil {
+		return err
+	}
+	accessTokenUC := usecase.NewAccessTokenUseCase(accessTokenRepo, infrastructure.NewAccessTokenSecretGenerator(), sessionAuthUC)
+	authUC := usecase.NewAuthUseCase(oidcAuthenticators, sessionAuthUC, accessTokenUC)
+	sessionUC := usecase.NewSessionUseCase(redis.NewSessionStoreAdapterWithDefaults(redisClient), accessTokenRepo)
 	accessAuthService := domain.NewAccessAuthorizationService(policyRepo)
-	batchUC := usecase.NewBatchUseCase(cachingRepo, proxyActionURLGenerator, policyRepo, storageKeyGenerator, accessAuthService)
-	verifyUC := usecase.NewVerifyUseCase(cachingRepo, cachingRepo)
-	proxyUploadUC := usecase.NewProxyUploadUseCase(cachingRepo, s3Client, accessAuthService)
+	batchUC := usecase.NewBatchUseCase(cachingRepo, proxyActionURLGenerator, policyRepo, storageKeyGenerator, accessAuthService, s3Client, s3Client, transferPolicy)
+	verifyUC := usecase.NewVerifyUseCase(cachingRepo, cachingRepo, s3Client, accessAuthService)
+	proxyUploadUC := usecase.NewProxyUploadUseCase(cachingRepo, s3Client, accessAuthService, policyRepo)
 	proxyDownloadUC := usecase.NewProxyDownloadUseCase(cachingRepo, s3Client, accessAuthService)
 	storageErrorChecker := s3.NewStorageErrorChecker()
 	proxyHandler := handler.NewProxyHandler(proxyUploadUC, proxyDownloadUC, storageErrorChecker, cfg.Server.ProxyTimeout)
+	lockUC := usecase.NewLockUseCase(lockRepo)
+	lockHandler := handler.NewLockHandler(lockUC)
 
 	postgresHealthChecker := postgres.NewPostgresHealthChecker(pool)
 	redisHealthChecker := redis.NewRedisHealthChecker(redisClient)
@@ -190,6 +270,8 @@ func run() error {
 
 	e.Use(middleware.Recover())
 	e.Use(middleware.RequestID())
+	e.Use(authMiddleware.Tracing())
+	e.Use(authMiddleware.Metrics(appMetrics))
 	e.Use(middleware.RequestLoggerWithConfig(middleware.RequestLoggerConfig{
 		LogStatus:   true,
 		LogURI:      true,
@@ -216,32 +298,114 @@ func run() error {
 
 	e.GET("/healthz", handler.HealthHandler)
 
+	var metricsServer *http.Server
+	if cfg.Metrics.Enabled {
+		if cfg.Metrics.Port == "" {
+			e.GET("/metrics", echo.WrapHandler(appMetrics.Handler()))
+			slog.Info("metrics endpoint registered", "path", "/metrics")
+		} else {
+			mux := http.NewServeMux()
+			mux.Handle("/metrics", appMetrics.Handler())
+			metricsServer = &http.Server{
+				Addr:         ":" + cfg.Metrics.Port,
+				Handler:      mux,
+				ReadTimeout:  readTimeout,
+				WriteTimeout: writeTimeout,
+				IdleTimeout:  idleTimeout,
+			}
+		}
+	}
+
 	readyzHandler := handler.NewReadyzHandler(readinessUC)
 	e.GET("/readyz", readyzHandler.Handle)
 
 	batchHandler := handler.NewBatchHandler(batchUC)
 	authDispatcher := authMiddleware.AuthDispatcher(authUC)
 
-	lfsGroup := e.Group("/:owner/:repo/info/lfs")
+	// 監査ログは認証より前に適用し、認証に失敗したリクエストも記録する
+	var auditMiddlewares []echo.MiddlewareFunc
+	if cfg.Audit.Enabled {
+		auditRepo := postgres.NewAuditEventRepository(pool)
+		auditUC := usecase.NewAuditUseCase(auditRepo, auditRepo)
+		auditMiddlewares = append(auditMiddlewares, authMiddleware.Audit(auditUC))
+		slog.Info("audit logging enabled", "retention", cfg.Audit.Retention)
+	}
+
+	lfsGroup := e.Group("/:owner/:repo/info/lfs", auditMiddlewares...)
 	lfsGroup.Use(authDispatcher)
-	lfsGroup.POST("/objects/batch", batchHandler.Handle)
+	batchMiddlewares, proxyMiddlewares, err := buildRateLimitMiddlewares(cfg.RateLimit, redisClient)
+	if err != nil {
+		return err
+	}
+	lfsGroup.POST("/objects/batch", batchHandler.Handle, batchMiddlewares...)
 	lfsGroup.POST("/objects/verify", handler.VerifyHandler(verifyUC))
-	lfsGroup.PUT("/objects/:oid", proxyHandler.HandleUpload)
-	lfsGroup.GET("/objects/:oid", proxyHandler.HandleDownload)
+	lfsGroup.PUT("/objects/:oid", proxyHandler.HandleUpload, proxyMiddlewares...)
+	lfsGroup.GET("/objects/:oid", proxyHandler.HandleDownload, proxyMiddlewares...)
+	lfsGroup.POST("/locks", lockHandler.Create)
+	lfsGroup.GET("/locks", lockHandler.List)
+	lfsGroup.POST("/locks/verify", lockHandler.Verify)
+	lfsGroup.POST("/locks/:id/unlock", lockHandler.Unlock)
 
 	if githubOAuthUC != nil {
 		loginHandlerConfig := auth.GitHubLoginHandlerConfig{
 			TrustProxy:   cfg.Server.TrustProxy,
 			AllowedHosts: cfg.OAuth.GitHub.AllowedHosts,
 		}
-		authGroup := e.Group("/auth/github")
+		authGroup := e.Group("/auth/github", auditMiddlewares...)
 		authGroup.GET("/login", auth.GitHubLoginHandler(githubOAuthUC, loginHandlerConfig))
 		authGroup.GET("/callback", auth.GitHubCallbackHandler(githubOAuthUC))
+		authGroup.POST("/device", auth.GitHubDeviceHandler(githubOAuthUC))
+		authGroup.POST("/device/token", auth.GitHubDeviceTokenHandler(githubOAuthUC))
 		slog.Info("GitHub OAuth routes registered")
 	}
 
 	e.GET("/auth/session", auth.SessionDisplayHandler())
 
+	// アクセストークンはセッションで認証したユーザーのみが発行・管理できる
+	accessTokenHandler := handler.NewAccessTokenHandler(accessTokenUC)
+	sessionAuthMiddlewares := append(slices.Clone(auditMiddlewares), authMiddleware.SessionAuth(authUC))
+	tokenGroup := e.Group("/auth/tokens", sessionAuthMiddlewares...)
+	tokenGroup.GET("", accessTokenHandler.List)
+	tokenGroup.POST("", accessTokenHandler.Issue)
+	tokenGroup.DELETE("/:id", accessTokenHandler.Revoke)
+
+	sessionHandler := handler.NewSessionHandler(sessionUC)
+	e.POST("/auth/logout", sessionHandler.Logout, sessionAuthMiddlewares...)
+	e.GET("/auth/sessions", sessionHandler.List, sessionAuthMiddlewares...)
+
+	if cfg.Admin.Enabled {
+		if cfg.Admin.Token == "" && len(cfg.Admin.OIDCSubjects) == 0 {
+			return errors.New("admin API is enabled but neither ADMIN_API_TOKEN nor ADMIN_API_OIDC_SUBJECTS is configured")
+		}
+		// githubProviderは型付きnilをインターフェースに渡さないよう、有効な場合のみ指定する
+		var adminOIDCProvider usecase.GitHubOIDCProvider
+		if githubProvider != nil {
+			adminOIDCProvider = githubProvider
+		}
+		adminAuthUC := usecase.NewAdminAuthUseCase(cfg.Admin.Token, adminOIDCProvider, cfg.Admin.OIDCSubjects)
+		allowlistUC := usecase.NewRepositoryAllowlistUseCase(cachingRepoAllowlist)
+		adminObjectUC := usecase.NewAdminObjectUseCase(lfsRepo, policyRepo, s3Client, redisClient, cacheKeyGenerator)
+		claimRuleUC := usecase.NewGitHubClaimRuleUseCase(cachingClaimRuleRepo)
+		adminHandler := handler.NewAdminHandler(allowlistUC, adminObjectUC, claimRuleUC, accessTokenUC, sessionUC)
+
+		adminGroup := e.Group("/admin/api/v1", auditMiddlewares...)
+		adminGroup.Use(authMiddleware.AdminAuth(adminAuthUC))
+		adminGroup.GET("/allowlist", adminHandler.ListAllowlist)
+		adminGroup.POST("/allowlist", adminHandler.AddAllowlist)
+		adminGroup.DELETE("/allowlist/:owner/:repo", adminHandler.RemoveAllowlist)
+		adminGroup.GET("/repositories/:owner/:repo/claim-rules", adminHandler.ListClaimRules)
+		adminGroup.POST("/repositories/:owner/:repo/claim-rules", adminHandler.AddClaimRule)
+		adminGroup.DELETE("/repositories/:owner/:repo/claim-rules/:id", adminHandler.RemoveClaimRule)
+		adminGroup.GET("/tokens", adminHandler.ListAccessTokens)
+		adminGroup.POST("/tokens", adminHandler.IssueAccessToken)
+		adminGroup.DELETE("/tokens/:id", adminHandler.RevokeAccessToken)
+		adminGroup.DELETE("/users/:id/sessions", adminHandler.RevokeUserSessions)
+		adminGroup.GET("/objects/:oid", adminHandler.GetObject)
+		adminGroup.DELETE("/objects/:oid", adminHandler.DeleteObject)
+		adminGroup.DELETE("/objects/:oid/cache", adminHandler.FlushObjectCache)
+		slog.Info("admin API routes registered", "config", cfg.Admin.String())
+	}
+
 	port := os.Getenv("PORT")
 	if port == "" {
 		port = defaultPort
@@ -263,6 +427,19 @@ func run() error {
 		close(errChan)
 	}()
 
+	// metricsServerが無効な場合、nilチャネルは受信されないためselectに影響しない
+	var metricsErrChan chan error
+	if metricsServer != nil {
+		metricsErrChan = make(chan error, 1)
+		go func() {
+			slog.Info("starting metrics server", "port", cfg.Metrics.Port)
+			if err := metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				metricsErrChan <- err
+			}
+			close(metricsErrChan)
+		}()
+	}
+
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 
@@ -273,6 +450,10 @@ func run() error {
 		if err != nil {
 			return err
 		}
+	case err := <-metricsErrChan:
+		if err != nil {
+			return err
+		}
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
@@ -282,11 +463,99 @@ func run() error {
 	if err := e.Shutdown(ctx); err != nil {
 		return err
 	}
+	if metricsServer != nil {
+		if err := metricsServer.Shutdown(ctx); err != nil {
+			return err
+		}
+	}
 
 	slog.Info("server stopped gracefully")
 	return nil
 }
 
+// buildRateLimitMiddlewares は設定に基づいてバッチAPIとプロキシ転送に適用するレート制限のミドルウェアを構築する。
+// 同時プロキシ転送数の上限はアップロードとダウンロードで共有する。
+func buildRateLimitMiddlewares(cfg config.RateLimitConfig, redisClient *redis.RedisClient) (batch, proxy []echo.MiddlewareFunc, err error) {
+	if cfg.BatchRequestsPerMinute > 0 && cfg.BatchBurst < 1 {
+		return nil, nil, fmt.Errorf("RATE_LIMIT_BATCH_BURST must be at least 1: %d", cfg.BatchBurst)
+	}
+	if cfg.ProxyBytesPerSecond > 0 && cfg.ProxyBurstBytes < 1 {
+		return nil, nil, fmt.Errorf("RATE_LIMIT_PROXY_BURST_BYTES must be at least 1: %d", cfg.ProxyBurstBytes)
+	}
+
+	rateLimitUC := usecase.NewRateLimitUseCase(
+		redis.NewRateLimitStore(redisClient),
+		usecase.RateLimitPolicy{Rate: float64(cfg.BatchRequestsPerMinute) / 60, Burst: float64(cfg.BatchBurst)},
+		usecase.RateLimitPolicy{Rate: float64(cfg.ProxyBytesPerSecond), Burst: float64(cfg.ProxyBurstBytes)},
+	)
+	if cfg.BatchRequestsPerMinute > 0 {
+		batch = append(batch, authMiddleware.BatchRateLimit(rateLimitUC))
+		slog.Info("batch rate limit enabled", "requests_per_minute", cfg.BatchRequestsPerMinute, "burst", cfg.BatchBurst)
+	}
+	if cfg.ProxyBytesPerSecond > 0 {
+		proxy = append(proxy, authMiddleware.ProxyRateLimit(rateLimitUC))
+		slog.Info("proxy transfer rate limit enabled", "bytes_per_second", cfg.ProxyBytesPerSecond, "burst_bytes", cfg.ProxyBurstBytes)
+	}
+	if cfg.MaxConcurrentProxyStreams > 0 {
+		proxy = append(proxy, authMiddleware.ProxyConcurrencyLimit(cfg.MaxConcurrentProxyStreams))
+		slog.Info("proxy concurrency limit enabled", "max_streams", cfg.MaxConcurrentProxyStreams)
+	}
+	return batch, proxy, nil
+}
+
+// buildOIDCAuthenticators はBearerトークンのissuerごとの認証処理を構築する。
+// GitHub Actionsに加えて、OIDC_ISSUERSで指定したissuerのトークンを受け付ける。
+// GitHub Actionsのトークンの権限はリポジトリごとのクレームルールで決める。
+func buildOIDCAuthenticators(
+	cfg config.OIDCConfig,
+	githubProvider *oidc.GitHubOIDCProvider,
+	repoAllowlistRepo domain.RepositoryAllowlistRepository,
+	claimRuleRepo domain.GitHubClaimRuleRepository,
+	redisClient *redis.RedisClient,
+) (map[string]usecase.OIDCAuthenticator, error) {
+	authenticators := make(map[string]usecase.OIDCAuthenticator)
+	if githubProvider != nil {
+		authenticators[oidc.GitHubIssuer] = usecase.NewGitHubOIDCUseCase(githubProvider, repoAllowlistRepo, claimRuleRepo)
+	}
+
+	for _, issuerCfg := range cfg.Issuers {
+		providerType, err := domain.NewProviderType(issuerCfg.Type)
+		if err != nil {
+			return nil, fmt.Errorf("invalid type for OIDC issuer %q: %w", issuerCfg.Name, err)
+		}
+		if providerType == domain.ProviderTypeGitHub {
+			return nil, fmt.Errorf("OIDC issuer %q: GitHub Actions is configured with OIDC_GITHUB_* variables", issuerCfg.Name)
+		}
+		provider, err := oidc.NewGenericOIDCProvider(oidc.GenericOIDCProviderConfig{
+			Name:         issuerCfg.Name,
+			ProviderType: providerType,
+			Issuer:       issuerCfg.Issuer,
+			JWKSURL:      issuerCfg.JWKSURL,
+			Audience:     issuerCfg.Audience,
+			Claims: oidc.ClaimMapping{
+				Subject:    issuerCfg.Claims.Subject,
+				Repository: issuerCfg.Claims.Repository,
+				Ref:        issuerCfg.Claims.Ref,
+				Actor:      issuerCfg.Claims.Actor,
+			},
+		}, redisClient)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create OIDC provider %q: %w", issuerCfg.Name, err)
+		}
+		if _, ok := authenticators[provider.Issuer()]; ok {
+			return nil, fmt.Errorf("OIDC issuer %q is configured more than once", provider.Issuer())
+		}
+		repositories, err := domain.ParseRepositoryScope(strings.Join(issuerCfg.Repositories, ","))
+		if err != nil {
+			return nil, fmt.Errorf("invalid repositories for OIDC issuer %q: %w", issuerCfg.Name, err)
+		}
+		authenticators[provider.Issuer()] = usecase.NewGenericOIDCUseCase(provider, repoAllowlistRepo, repositories, issuerCfg.AllowWrite)
+		slog.Info("OIDC issuer registered", "name", issuerCfg.Name, "type", providerType.String(), "issuer", provider.Issuer(),
+			"repositories", repositories.String(), "allow_write", issuerCfg.AllowWrite)
+	}
+	return authenticators, nil
+}
+
 // buildIPExtractor は設定に基づいてIPエクストラクタを構築する。
 // 信頼するプロキシのCIDRが指定されている場合、そのCIDRからのX-Forwarded-Forヘッダーのみを信頼する。
 // 指定されていない場合、IPスプーフィング防止のため接続元IPを直接使用する。
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"text/tabwriter"
+
+	"github.com/na2na-p/cargohold/internal/config"
+	"github.com/na2na-p/cargohold/internal/infrastructure"
+	"github.com/na2na-p/cargohold/internal/infrastructure/postgres"
+	"github.com/na2na-p/cargohold/internal/infrastructure/redis"
+	"github.com/na2na-p/cargohold/internal/usecase"
+)
+
+// runReconcile はバケット上の実体とメタデータを突き合わせ、不整合を報告する
+func runReconcile(args []string) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	fs := flag.NewFlagSet("reconcile", flag.ContinueOnError)
+	hashAlgo := fs.String("hash-algo", "sha256", "突き合わせの対象とするハッシュアルゴリズム")
+	fix := fs.Bool("fix", false, "欠損・サイズ不一致のメタデータを未アップロードに戻し、サイズ不一致の実体とメタデータのない実体を削除する")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	pool, err := openPostgres(cfg.Database, nil)
+	if err != nil {
+		return err
+	}
+	defer pool.Close()
+
+	redisConn, err := openRedis(cfg.Redis)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = redisConn.Close() }()
+
+	s3Client, err := openS3(cfg.S3, nil)
+	if err != nil {
+		return err
+	}
+
+	cachingRepo := infrastructure.NewCachingLFSObjectRepository(
+		postgres.NewLFSObjectRepository(pool),
+		redis.NewRedisClient(redisConn),
+		redis.NewCacheKeyGenerator(),
+		redis.NewCacheConfig(),
+		nil,
+	)
+	reconcileUC := usecase.NewReconcileUseCase(cachingRepo, s3Client, s3Client)
+
+	result, err := reconcileUC.Run(ctx, usecase.ReconcileQuery{
+		HashAlgo: *hashAlgo,
+		Fix:      *fix,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := printReconcileResult(os.Stdout, result, *fix); err != nil {
+		return err
+	}
+	if result.Failed > 0 {
+		return fmt.Errorf("%d 件の不整合の修復に失敗しました", result.Failed)
+	}
+	return nil
+}
+
+func printReconcileResult(w io.Writer, result *usecase.ReconcileResult, fix bool) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "PROBLEM\tKEY\tDB_SIZE\tSTORED_SIZE")
+	for _, obj := range result.MissingBlobs {
+		_, _ = fmt.Fprintf(tw, "missing_blob\t%s\t%d\t-\n", obj.GetStorageKey(), obj.Size().Int64())
+	}
+	for _, mismatch := range result.SizeMismatches {
+		_, _ = fmt.Fprintf(tw, "size_mismatch\t%s\t%d\t%d\n",
+			mismatch.Object.GetStorageKey(), mismatch.Object.Size().Int64(), mismatch.StoredSize)
+	}
+	for _, blob := range result.OrphanedBlobs {
+		_, _ = fmt.Fprintf(tw, "orphaned_blob\t%s\t-\t%d\n", blob.Key, blob.Size)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	total := len(result.MissingBlobs) + len(result.SizeMismatches) + len(result.OrphanedBlobs)
+	_, err := fmt.Fprintf(w, "%d 件の不整合が見つかりました (missing_blob: %d, size_mismatch: %d, orphaned_blob: %d)\n",
+		total, len(result.MissingBlobs), len(result.SizeMismatches), len(result.OrphanedBlobs))
+	if err != nil || !fix {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%d 件を修復しました (失敗: %d)\n", result.Fixed, result.Failed)
+	return err
+}
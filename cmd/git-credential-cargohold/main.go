@@ -0,0 +1,44 @@
+// Package main はcargoholdのセッションを取得するgitのcredential helperです
+//
+// git config --global credential.https://lfs.example.com.helper cargohold
+// git config --global credential.https://lfs.example.com.useHttpPath true
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/na2na-p/cargohold/internal/credentialhelper"
+)
+
+func main() {
+	if len(os.Args) != 2 {
+		fmt.Fprintln(os.Stderr, "usage: git-credential-cargohold <get|store|erase>")
+		os.Exit(2)
+	}
+
+	if err := run(os.Args[1]); err != nil {
+		fmt.Fprintf(os.Stderr, "git-credential-cargohold: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(action string) error {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	path := os.Getenv("CARGOHOLD_CREDENTIAL_CACHE")
+	if path == "" {
+		var err error
+		path, err = credentialhelper.DefaultCachePath()
+		if err != nil {
+			return err
+		}
+	}
+
+	helper := credentialhelper.NewHelper(credentialhelper.NewFileCache(path), os.Stderr)
+	return helper.Run(ctx, action, os.Stdin, os.Stdout)
+}
@@ -35,6 +35,7 @@ type BatchObject struct {
 type Actions struct {
 	Upload   *Action `json:"upload,omitempty"`
 	Download *Action `json:"download,omitempty"`
+	Verify   *Action `json:"verify,omitempty"`
 }
 
 type Action struct {
@@ -218,6 +219,12 @@ func TestBatchAPI_ResponseFieldValidation(t *testing.T) {
 				if tt.want.hasExpiresIn && obj.Actions.Upload.ExpiresIn <= 0 {
 					t.Errorf("expires_inフィールドが正の値ではありません: got=%d", obj.Actions.Upload.ExpiresIn)
 				}
+				if obj.Actions.Verify == nil {
+					t.Fatal("verifyアクションが含まれていません")
+				}
+				if !strings.HasSuffix(obj.Actions.Verify.Href, "/info/lfs/objects/verify") {
+					t.Errorf("verifyアクションのhrefが不正です: got=%s", obj.Actions.Verify.Href)
+				}
 			}
 		})
 	}
@@ -516,7 +523,8 @@ func TestBatchAPI_DownloadUnauthorizedObjects(t *testing.T) {
 		objects []objectSpec
 	}
 	type want struct {
-		statusCode int
+		statusCode      int
+		objectErrorCode int
 	}
 	tests := []struct {
 		name string
@@ -524,7 +532,7 @@ func TestBatchAPI_DownloadUnauthorizedObjects(t *testing.T) {
 		want want
 	}{
 		{
-			name: "異常系: アクセスポリシーが存在しないオブジェクトのダウンロードは403を返す",
+			name: "異常系: アクセスポリシーが存在しないオブジェクトのダウンロードは200とオブジェクト単位の404エラーを返す",
 			args: args{
 				objects: []objectSpec{
 					{
@@ -538,7 +546,8 @@ func TestBatchAPI_DownloadUnauthorizedObjects(t *testing.T) {
 				},
 			},
 			want: want{
-				statusCode: http.StatusForbidden,
+				statusCode:      http.StatusOK,
+				objectErrorCode: http.StatusNotFound,
 			},
 		},
 	}
@@ -601,6 +610,27 @@ func TestBatchAPI_DownloadUnauthorizedObjects(t *testing.T) {
 				body, _ := io.ReadAll(resp.Body)
 				t.Fatalf("ステータスコードが期待値と異なります (-want +got):\n%s\nbody: %s", diff, string(body))
 			}
+
+			var batchResp BatchResponse
+			if err := json.NewDecoder(resp.Body).Decode(&batchResp); err != nil {
+				t.Fatalf("レスポンスのデコードに失敗: %v", err)
+			}
+
+			if diff := cmp.Diff(len(tt.args.objects), len(batchResp.Objects)); diff != "" {
+				t.Fatalf("オブジェクト数が期待値と異なります (-want +got):\n%s", diff)
+			}
+			for i, obj := range batchResp.Objects {
+				if diff := cmp.Diff(tt.args.objects[i].oid, obj.OID); diff != "" {
+					t.Errorf("オブジェクト[%d]のOIDが期待値と異なります (-want +got):\n%s", i, diff)
+				}
+				if obj.Error == nil {
+					t.Errorf("オブジェクト[%d]にerrorが含まれていません", i)
+					continue
+				}
+				if diff := cmp.Diff(tt.want.objectErrorCode, obj.Error.Code); diff != "" {
+					t.Errorf("オブジェクト[%d]のエラーコードが期待値と異なります (-want +got):\n%s", i, diff)
+				}
+			}
 		})
 	}
 }
@@ -424,8 +424,8 @@ func TestUploadProxy_NotFound(t *testing.T) {
 	}
 }
 
-// TestUploadProxy_CrossRepositoryAccessDenied は別のリポジトリで作成されたOIDへのアップロードが拒否されることを検証します
-func TestUploadProxy_CrossRepositoryAccessDenied(t *testing.T) {
+// TestUploadProxy_CrossRepositoryProofOfPossession は別のリポジトリで作成されたOIDへのアップロードが、内容の所持を証明できた場合にのみ受け付けられることを検証します
+func TestUploadProxy_CrossRepositoryProofOfPossession(t *testing.T) {
 	if err := SetupE2EEnvironment(); err != nil {
 		t.Fatalf("E2E環境のセットアップに失敗: %v", err)
 	}
@@ -436,6 +436,7 @@ func TestUploadProxy_CrossRepositoryAccessDenied(t *testing.T) {
 		registerRef        string
 		accessRepository   string
 		accessRef          string
+		tamper             bool
 	}
 	tests := []struct {
 		name       string
@@ -443,7 +444,7 @@ func TestUploadProxy_CrossRepositoryAccessDenied(t *testing.T) {
 		wantStatus int
 	}{
 		{
-			name: "異常系: 別リポジトリのトークンでアップロードした場合、403エラーが返る",
+			name: "正常系: 別リポジトリのトークンで同じ内容をアップロードした場合、200が返る",
 			args: args{
 				fileSize:           1024,
 				registerRepository: "na2na-p/test-repo",
@@ -451,7 +452,19 @@ func TestUploadProxy_CrossRepositoryAccessDenied(t *testing.T) {
 				accessRepository:   "na2na-p/na2na-platform",
 				accessRef:          "refs/heads/main",
 			},
-			wantStatus: http.StatusForbidden,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "異常系: 別リポジトリのトークンで異なる内容をアップロードした場合、422エラーが返る",
+			args: args{
+				fileSize:           1024,
+				registerRepository: "na2na-p/test-repo",
+				registerRef:        "refs/heads/main",
+				accessRepository:   "na2na-p/na2na-platform",
+				accessRef:          "refs/heads/main",
+				tamper:             true,
+			},
+			wantStatus: http.StatusUnprocessableEntity,
 		},
 	}
 
@@ -506,13 +519,15 @@ func TestUploadProxy_CrossRepositoryAccessDenied(t *testing.T) {
 			baseURL := GetBaseEndpoint()
 			proxyURL := fmt.Sprintf("%s/%s/info/lfs/objects/%s", baseURL, tt.args.accessRepository, oid)
 
-			file, err := os.Open(testFile)
+			content, err := os.ReadFile(testFile)
 			if err != nil {
-				t.Fatalf("os.Open() error = %v", err)
+				t.Fatalf("os.ReadFile() error = %v", err)
+			}
+			if tt.args.tamper {
+				content[0] ^= 0xff
 			}
-			defer func() { _ = file.Close() }()
 
-			req, err := http.NewRequest(http.MethodPut, proxyURL, file)
+			req, err := http.NewRequest(http.MethodPut, proxyURL, bytes.NewReader(content))
 			if err != nil {
 				t.Fatalf("http.NewRequest() error = %v", err)
 			}
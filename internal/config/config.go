@@ -1,6 +1,7 @@
 package config
 
 import (
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -13,13 +14,74 @@ type ServerConfig struct {
 	ProxyTimeout      time.Duration `envconfig:"SERVER_PROXY_TIMEOUT" default:"10m"`
 }
 
+type TransferConfig struct {
+	Mode             string        `envconfig:"TRANSFER_MODE" default:"proxy"`
+	PresignThreshold int64         `envconfig:"TRANSFER_PRESIGN_THRESHOLD" default:"104857600"`
+	PresignTTL       time.Duration `envconfig:"TRANSFER_PRESIGN_TTL" default:"15m"`
+}
+
+type GCConfig struct {
+	StaleAfter time.Duration `envconfig:"GC_STALE_AFTER" default:"24h"`
+	Limit      int           `envconfig:"GC_LIMIT" default:"1000"`
+}
+
+// AdminConfig は管理APIの設定
+// Tokenによる静的なBearer認証と、GitHub OIDCトークンのsubjectによる認証のいずれかで管理者を識別する
+type AdminConfig struct {
+	Enabled      bool     `envconfig:"ADMIN_API_ENABLED" default:"false"`
+	Token        string   `envconfig:"ADMIN_API_TOKEN"`
+	OIDCSubjects []string `envconfig:"ADMIN_API_OIDC_SUBJECTS"`
+}
+
+// AuditConfig は監査ログの設定
+// Retentionより古い監査イベントは audit prune コマンドで削除される
+type AuditConfig struct {
+	Enabled   bool          `envconfig:"AUDIT_ENABLED" default:"true"`
+	Retention time.Duration `envconfig:"AUDIT_RETENTION" default:"2160h"`
+}
+
+// MetricsConfig はPrometheusメトリクスの設定
+// Portが空の場合はAPIサーバーと同じポートの /metrics で公開する
+type MetricsConfig struct {
+	Enabled bool   `envconfig:"METRICS_ENABLED" default:"false"`
+	Port    string `envconfig:"METRICS_PORT"`
+}
+
+// TracingConfig はOpenTelemetryトレーシングの設定
+// OTLPEndpointが空の場合はOTEL_EXPORTER_OTLP_ENDPOINTなどの標準の環境変数に従う
+type TracingConfig struct {
+	Enabled      bool    `envconfig:"TRACING_ENABLED" default:"false"`
+	OTLPEndpoint string  `envconfig:"TRACING_OTLP_ENDPOINT"`
+	OTLPInsecure bool    `envconfig:"TRACING_OTLP_INSECURE" default:"false"`
+	ServiceName  string  `envconfig:"TRACING_SERVICE_NAME" default:"cargohold"`
+	SampleRatio  float64 `envconfig:"TRACING_SAMPLE_RATIO" default:"1.0"`
+}
+
+// RateLimitConfig はレート制限の設定
+// 各制限は0の場合に無効となる。バッチ回数と転送量は認証済みの識別子・リポジトリ・クライアントIPごとにRedisで共有し、
+// 同時プロキシ転送数はPodごとに制限する
+type RateLimitConfig struct {
+	BatchRequestsPerMinute    int   `envconfig:"RATE_LIMIT_BATCH_REQUESTS_PER_MINUTE" default:"0"`
+	BatchBurst                int   `envconfig:"RATE_LIMIT_BATCH_BURST" default:"10"`
+	ProxyBytesPerSecond       int64 `envconfig:"RATE_LIMIT_PROXY_BYTES_PER_SECOND" default:"0"`
+	ProxyBurstBytes           int64 `envconfig:"RATE_LIMIT_PROXY_BURST_BYTES" default:"104857600"`
+	MaxConcurrentProxyStreams int   `envconfig:"RATE_LIMIT_MAX_CONCURRENT_PROXY_STREAMS" default:"0"`
+}
+
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	Redis    RedisConfig
-	S3       S3Config
-	OIDC     OIDCConfig
-	OAuth    OAuthConfig
+	Server    ServerConfig
+	Transfer  TransferConfig
+	GC        GCConfig
+	Admin     AdminConfig
+	Audit     AuditConfig
+	Metrics   MetricsConfig
+	Tracing   TracingConfig
+	RateLimit RateLimitConfig
+	Database  DatabaseConfig
+	Redis     RedisConfig
+	S3        S3Config
+	OIDC      OIDCConfig
+	OAuth     OAuthConfig
 }
 
 type DatabaseConfig struct {
@@ -47,7 +109,60 @@ type S3Config struct {
 }
 
 type OIDCConfig struct {
-	GitHub GitHubOIDCConfig
+	GitHub  GitHubOIDCConfig
+	Issuers OIDCIssuerConfigs `envconfig:"OIDC_ISSUERS"`
+}
+
+// OIDCIssuerConfigs はGitHub Actions以外に受け付けるOIDCトークンのissuerの一覧
+// OIDC_ISSUERSにJSON配列で指定する
+type OIDCIssuerConfigs []OIDCIssuerConfig
+
+// OIDCIssuerConfig は1つのissuerの設定
+// Typeがgitlab・buildkite・kubernetesの場合、省略した項目には種別ごとの既定値が使われる
+type OIDCIssuerConfig struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Issuer   string `json:"issuer"`
+	JWKSURL  string `json:"jwks_url"`
+	Audience string `json:"audience"`
+	// Repositories はこのissuerのトークンに操作を許可するリポジトリ（"owner/repo" または "owner/*"）
+	// クレームから求めたリポジトリ名はGitHubのリポジトリと同じ名前空間に対応付けられるため、明示的な指定を必須とする
+	Repositories []string `json:"repositories"`
+	// AllowWrite がtrueの場合、このissuerのトークンにアップロードも許可する。既定ではダウンロードのみを許可する
+	AllowWrite bool                   `json:"allow_write"`
+	Claims     OIDCClaimMappingConfig `json:"claims"`
+}
+
+// OIDCClaimMappingConfig はクレームからリポジトリ・ref・実行者を組み立てるテンプレート
+// {claim} の形式でクレームの値を埋め込み、ネストしたクレームは "/" で区切って指定する
+type OIDCClaimMappingConfig struct {
+	Subject    string `json:"subject"`
+	Repository string `json:"repository"`
+	Ref        string `json:"ref"`
+	Actor      string `json:"actor"`
+}
+
+// Decode はenvconfig.DecoderとしてOIDC_ISSUERSのJSON配列を読み込む
+func (c *OIDCIssuerConfigs) Decode(value string) error {
+	var issuers []OIDCIssuerConfig
+	if err := json.Unmarshal([]byte(value), &issuers); err != nil {
+		return fmt.Errorf("invalid OIDC issuers: %w", err)
+	}
+	names := make(map[string]struct{}, len(issuers))
+	for i, issuer := range issuers {
+		if issuer.Name == "" {
+			return fmt.Errorf("invalid OIDC issuers: name is required at index %d", i)
+		}
+		if _, ok := names[issuer.Name]; ok {
+			return fmt.Errorf("invalid OIDC issuers: duplicate name %q", issuer.Name)
+		}
+		names[issuer.Name] = struct{}{}
+		if len(issuer.Repositories) == 0 {
+			return fmt.Errorf("invalid OIDC issuers: repositories is required for %q", issuer.Name)
+		}
+	}
+	*c = issuers
+	return nil
 }
 
 type GitHubOIDCConfig struct {
@@ -66,6 +181,11 @@ type GitHubOAuthConfig struct {
 	ClientSecret        string   `envconfig:"GITHUB_OAUTH_CLIENT_SECRET"`
 	AllowedHosts        []string `envconfig:"OAUTH_GITHUB_ALLOWED_HOSTS"`
 	AllowedRedirectURIs []string `envconfig:"OAUTH_GITHUB_ALLOWED_REDIRECT_URIS"`
+	// SessionRecheckInterval はセッションの権限をGitHubに再度問い合わせるまでの間隔
+	// 0の場合は再検証しない。0より大きい場合はTokenEncryptionKeyが必須
+	SessionRecheckInterval time.Duration `envconfig:"OAUTH_GITHUB_SESSION_RECHECK_INTERVAL" default:"0"`
+	// TokenEncryptionKey はRedisに保存するOAuthトークンを暗号化するAES-256の鍵（base64エンコード）
+	TokenEncryptionKey string `envconfig:"OAUTH_GITHUB_TOKEN_ENCRYPTION_KEY"`
 }
 
 func Load() (*Config, error) {
@@ -92,6 +212,11 @@ func (c S3Config) String() string {
 }
 
 func (c GitHubOAuthConfig) String() string {
-	return fmt.Sprintf("GitHubOAuthConfig{Enabled: %t, ClientID: %s, ClientSecret: ***, AllowedHosts: %v, AllowedRedirectURIs: %v}",
-		c.Enabled, c.ClientID, c.AllowedHosts, c.AllowedRedirectURIs)
+	return fmt.Sprintf("GitHubOAuthConfig{Enabled: %t, ClientID: %s, ClientSecret: ***, AllowedHosts: %v, AllowedRedirectURIs: %v, SessionRecheckInterval: %s, TokenEncryptionKey: ***}",
+		c.Enabled, c.ClientID, c.AllowedHosts, c.AllowedRedirectURIs, c.SessionRecheckInterval)
+}
+
+func (c AdminConfig) String() string {
+	return fmt.Sprintf("AdminConfig{Enabled: %t, Token: ***, OIDCSubjects: %v}",
+		c.Enabled, c.OIDCSubjects)
 }
@@ -144,6 +144,97 @@ func TestLoad_Defaults(t *testing.T) {
 				}
 			},
 		},
+		{
+			name:    "正常系: OAUTH_GITHUB_SESSION_RECHECK_INTERVALのデフォルト値は0（再検証しない）",
+			envVars: map[string]string{},
+			validate: func(t *testing.T, cfg *config.Config) {
+				if cfg.OAuth.GitHub.SessionRecheckInterval != 0 {
+					t.Errorf("OAuth.GitHub.SessionRecheckInterval = %v, want %v", cfg.OAuth.GitHub.SessionRecheckInterval, time.Duration(0))
+				}
+			},
+		},
+		{
+			name:    "正常系: TRANSFER_MODEのデフォルト値はproxy",
+			envVars: map[string]string{},
+			validate: func(t *testing.T, cfg *config.Config) {
+				if cfg.Transfer.Mode != "proxy" {
+					t.Errorf("Transfer.Mode = %q, want %q", cfg.Transfer.Mode, "proxy")
+				}
+				if cfg.Transfer.PresignTTL != 15*time.Minute {
+					t.Errorf("Transfer.PresignTTL = %v, want %v", cfg.Transfer.PresignTTL, 15*time.Minute)
+				}
+			},
+		},
+		{
+			name:    "正常系: GC_STALE_AFTERのデフォルト値は24時間、GC_LIMITのデフォルト値は1000",
+			envVars: map[string]string{},
+			validate: func(t *testing.T, cfg *config.Config) {
+				want := config.GCConfig{
+					StaleAfter: 24 * time.Hour,
+					Limit:      1000,
+				}
+				if diff := cmp.Diff(want, cfg.GC); diff != "" {
+					t.Errorf("GC mismatch (-want +got):\n%s", diff)
+				}
+			},
+		},
+		{
+			name:    "正常系: ADMIN_API_ENABLEDのデフォルト値はfalse",
+			envVars: map[string]string{},
+			validate: func(t *testing.T, cfg *config.Config) {
+				if diff := cmp.Diff(config.AdminConfig{}, cfg.Admin); diff != "" {
+					t.Errorf("Admin mismatch (-want +got):\n%s", diff)
+				}
+			},
+		},
+		{
+			name:    "正常系: AUDIT_ENABLEDのデフォルト値はtrue、AUDIT_RETENTIONのデフォルト値は90日",
+			envVars: map[string]string{},
+			validate: func(t *testing.T, cfg *config.Config) {
+				want := config.AuditConfig{
+					Enabled:   true,
+					Retention: 90 * 24 * time.Hour,
+				}
+				if diff := cmp.Diff(want, cfg.Audit); diff != "" {
+					t.Errorf("Audit mismatch (-want +got):\n%s", diff)
+				}
+			},
+		},
+		{
+			name:    "正常系: METRICS_ENABLEDのデフォルト値はfalse",
+			envVars: map[string]string{},
+			validate: func(t *testing.T, cfg *config.Config) {
+				if diff := cmp.Diff(config.MetricsConfig{}, cfg.Metrics); diff != "" {
+					t.Errorf("Metrics mismatch (-want +got):\n%s", diff)
+				}
+			},
+		},
+		{
+			name:    "正常系: TRACING_ENABLEDのデフォルト値はfalse、TRACING_SAMPLE_RATIOのデフォルト値は1.0",
+			envVars: map[string]string{},
+			validate: func(t *testing.T, cfg *config.Config) {
+				want := config.TracingConfig{
+					ServiceName: "cargohold",
+					SampleRatio: 1.0,
+				}
+				if diff := cmp.Diff(want, cfg.Tracing); diff != "" {
+					t.Errorf("Tracing mismatch (-want +got):\n%s", diff)
+				}
+			},
+		},
+		{
+			name:    "正常系: レート制限はデフォルトで無効",
+			envVars: map[string]string{},
+			validate: func(t *testing.T, cfg *config.Config) {
+				want := config.RateLimitConfig{
+					BatchBurst:      10,
+					ProxyBurstBytes: 100 * 1024 * 1024,
+				}
+				if diff := cmp.Diff(want, cfg.RateLimit); diff != "" {
+					t.Errorf("RateLimit mismatch (-want +got):\n%s", diff)
+				}
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -214,6 +305,33 @@ func TestLoad_CustomValues(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "正常系: OIDC_ISSUERSをJSON配列で設定",
+			envVars: map[string]string{
+				"OIDC_ISSUERS": `[{"name":"gitlab","type":"gitlab","audience":"cargohold","repositories":["group/project"]},` +
+					`{"name":"k8s","type":"kubernetes","issuer":"https://kubernetes.default.svc","jwks_url":"https://kubernetes.default.svc/openid/v1/jwks","audience":"cargohold",` +
+					`"repositories":["team/*"],"allow_write":true,` +
+					`"claims":{"repository":"{kubernetes.io/namespace}/assets"}}]`,
+			},
+			validate: func(t *testing.T, cfg *config.Config) {
+				want := config.OIDCIssuerConfigs{
+					{Name: "gitlab", Type: "gitlab", Audience: "cargohold", Repositories: []string{"group/project"}},
+					{
+						Name:         "k8s",
+						Type:         "kubernetes",
+						Issuer:       "https://kubernetes.default.svc",
+						JWKSURL:      "https://kubernetes.default.svc/openid/v1/jwks",
+						Audience:     "cargohold",
+						Repositories: []string{"team/*"},
+						AllowWrite:   true,
+						Claims:       config.OIDCClaimMappingConfig{Repository: "{kubernetes.io/namespace}/assets"},
+					},
+				}
+				if diff := cmp.Diff(want, cfg.OIDC.Issuers); diff != "" {
+					t.Errorf("OIDC.Issuers mismatch (-want +got):\n%s", diff)
+				}
+			},
+		},
 		{
 			name: "正常系: OAUTH_GITHUB_ALLOWED_HOSTSをカンマ区切りで設定",
 			envVars: map[string]string{
@@ -227,6 +345,100 @@ func TestLoad_CustomValues(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "正常系: TRANSFER_MODEをthresholdに設定",
+			envVars: map[string]string{
+				"TRANSFER_MODE":              "threshold",
+				"TRANSFER_PRESIGN_THRESHOLD": "1048576",
+				"TRANSFER_PRESIGN_TTL":       "5m",
+			},
+			validate: func(t *testing.T, cfg *config.Config) {
+				want := config.TransferConfig{
+					Mode:             "threshold",
+					PresignThreshold: 1048576,
+					PresignTTL:       5 * time.Minute,
+				}
+				if diff := cmp.Diff(want, cfg.Transfer); diff != "" {
+					t.Errorf("Transfer mismatch (-want +got):\n%s", diff)
+				}
+			},
+		},
+		{
+			name: "正常系: AUDIT_ENABLEDとAUDIT_RETENTIONを設定",
+			envVars: map[string]string{
+				"AUDIT_ENABLED":   "false",
+				"AUDIT_RETENTION": "720h",
+			},
+			validate: func(t *testing.T, cfg *config.Config) {
+				want := config.AuditConfig{
+					Enabled:   false,
+					Retention: 30 * 24 * time.Hour,
+				}
+				if diff := cmp.Diff(want, cfg.Audit); diff != "" {
+					t.Errorf("Audit mismatch (-want +got):\n%s", diff)
+				}
+			},
+		},
+		{
+			name: "正常系: METRICS_ENABLEDとMETRICS_PORTを設定",
+			envVars: map[string]string{
+				"METRICS_ENABLED": "true",
+				"METRICS_PORT":    "9090",
+			},
+			validate: func(t *testing.T, cfg *config.Config) {
+				want := config.MetricsConfig{
+					Enabled: true,
+					Port:    "9090",
+				}
+				if diff := cmp.Diff(want, cfg.Metrics); diff != "" {
+					t.Errorf("Metrics mismatch (-want +got):\n%s", diff)
+				}
+			},
+		},
+		{
+			name: "正常系: TRACING_*を設定",
+			envVars: map[string]string{
+				"TRACING_ENABLED":       "true",
+				"TRACING_OTLP_ENDPOINT": "otel-collector:4318",
+				"TRACING_OTLP_INSECURE": "true",
+				"TRACING_SERVICE_NAME":  "cargohold-staging",
+				"TRACING_SAMPLE_RATIO":  "0.25",
+			},
+			validate: func(t *testing.T, cfg *config.Config) {
+				want := config.TracingConfig{
+					Enabled:      true,
+					OTLPEndpoint: "otel-collector:4318",
+					OTLPInsecure: true,
+					ServiceName:  "cargohold-staging",
+					SampleRatio:  0.25,
+				}
+				if diff := cmp.Diff(want, cfg.Tracing); diff != "" {
+					t.Errorf("Tracing mismatch (-want +got):\n%s", diff)
+				}
+			},
+		},
+		{
+			name: "正常系: RATE_LIMIT_*を設定",
+			envVars: map[string]string{
+				"RATE_LIMIT_BATCH_REQUESTS_PER_MINUTE":    "120",
+				"RATE_LIMIT_BATCH_BURST":                  "20",
+				"RATE_LIMIT_PROXY_BYTES_PER_SECOND":       "10485760",
+				"RATE_LIMIT_PROXY_BURST_BYTES":            "1073741824",
+				"RATE_LIMIT_MAX_CONCURRENT_PROXY_STREAMS": "32",
+			},
+			validate: func(t *testing.T, cfg *config.Config) {
+				want := config.RateLimitConfig{
+					BatchRequestsPerMinute:    120,
+					BatchBurst:                20,
+					ProxyBytesPerSecond:       10 * 1024 * 1024,
+					ProxyBurstBytes:           1024 * 1024 * 1024,
+					MaxConcurrentProxyStreams: 32,
+				}
+				if diff := cmp.Diff(want, cfg.RateLimit); diff != "" {
+					t.Errorf("RateLimit mismatch (-want +got):\n%s", diff)
+				}
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -246,6 +458,53 @@ func TestLoad_CustomValues(t *testing.T) {
 	}
 }
 
+func TestOIDCIssuerConfigs_Decode(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		wantErr bool
+	}{
+		{
+			name:  "正常系: 空の配列の場合、エラーにならない",
+			value: `[]`,
+		},
+		{
+			name:    "異常系: JSONとして不正な場合、エラーが返る",
+			value:   `[{"name":`,
+			wantErr: true,
+		},
+		{
+			name:    "異常系: nameが空の場合、エラーが返る",
+			value:   `[{"type":"gitlab","audience":"cargohold"}]`,
+			wantErr: true,
+		},
+		{
+			name:    "異常系: nameが重複している場合、エラーが返る",
+			value:   `[{"name":"ci","type":"gitlab","repositories":["a/b"]},{"name":"ci","type":"buildkite","repositories":["a/b"]}]`,
+			wantErr: true,
+		},
+		{
+			name:    "異常系: repositoriesが指定されていない場合、エラーが返る",
+			value:   `[{"name":"gitlab","type":"gitlab","audience":"cargohold"}]`,
+			wantErr: true,
+		},
+		{
+			name:  "正常系: repositoriesを指定した場合、エラーにならない",
+			value: `[{"name":"gitlab","type":"gitlab","audience":"cargohold","repositories":["group/*"]}]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got config.OIDCIssuerConfigs
+			err := got.Decode(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Decode() error = %v, wantErr %t", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestDatabaseConfig_String(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -373,15 +632,44 @@ func TestGitHubOAuthConfig_String(t *testing.T) {
 		want   string
 	}{
 		{
-			name: "正常系: ClientSecretがマスクされる",
+			name: "正常系: ClientSecretとTokenEncryptionKeyがマスクされる",
 			config: config.GitHubOAuthConfig{
-				Enabled:             true,
-				ClientID:            "client-id-123",
-				ClientSecret:        "super-secret",
-				AllowedHosts:        []string{"example.com"},
-				AllowedRedirectURIs: []string{"https://example.com/callback"},
+				Enabled:                true,
+				ClientID:               "client-id-123",
+				ClientSecret:           "super-secret",
+				AllowedHosts:           []string{"example.com"},
+				AllowedRedirectURIs:    []string{"https://example.com/callback"},
+				SessionRecheckInterval: 15 * time.Minute,
+				TokenEncryptionKey:     "encryption-key",
+			},
+			want: "GitHubOAuthConfig{Enabled: true, ClientID: client-id-123, ClientSecret: ***, AllowedHosts: [example.com], AllowedRedirectURIs: [https://example.com/callback], SessionRecheckInterval: 15m0s, TokenEncryptionKey: ***}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.config.String()
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("String() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAdminConfig_String(t *testing.T) {
+	tests := []struct {
+		name   string
+		config config.AdminConfig
+		want   string
+	}{
+		{
+			name: "正常系: Tokenがマスクされる",
+			config: config.AdminConfig{
+				Enabled:      true,
+				Token:        "super-secret",
+				OIDCSubjects: []string{"repo:owner/infra:ref:refs/heads/main"},
 			},
-			want: "GitHubOAuthConfig{Enabled: true, ClientID: client-id-123, ClientSecret: ***, AllowedHosts: [example.com], AllowedRedirectURIs: [https://example.com/callback]}",
+			want: "AdminConfig{Enabled: true, Token: ***, OIDCSubjects: [repo:owner/infra:ref:refs/heads/main]}",
 		},
 	}
 
@@ -0,0 +1,135 @@
+package credentialhelper
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// expiryMargin は期限切れ直前のセッションを使わないための余裕
+const expiryMargin = time.Minute
+
+type cachedCredential struct {
+	SessionID string    `json:"session_id"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// FileCache はセッションを "host/owner/repo" ごとにJSONファイルへ保存する
+// セッションIDは認証情報のため、ファイルは所有者のみ読み書きできる権限で作成する
+type FileCache struct {
+	path string
+}
+
+func NewFileCache(path string) *FileCache {
+	return &FileCache{
+		path: path,
+	}
+}
+
+// DefaultCachePath はユーザーのキャッシュディレクトリ配下のパスを返す
+func DefaultCachePath() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("キャッシュディレクトリの取得に失敗しました: %w", err)
+	}
+	return filepath.Join(dir, "cargohold", "credentials.json"), nil
+}
+
+// Get は有効期限内のセッションを返す。存在しないか期限切れの場合はnilを返す
+func (c *FileCache) Get(key string, now time.Time) (*Credential, error) {
+	entries, err := c.load()
+	if err != nil {
+		return nil, err
+	}
+	entry, ok := entries[key]
+	if !ok || !now.Add(expiryMargin).Before(entry.ExpiresAt) {
+		return nil, nil
+	}
+	return &Credential{
+		Username:  SessionUsername,
+		Password:  entry.SessionID,
+		ExpiresAt: entry.ExpiresAt,
+	}, nil
+}
+
+// Put はセッションを保存する。あわせて期限切れのセッションを取り除く
+func (c *FileCache) Put(key string, cred *Credential, now time.Time) error {
+	entries, err := c.load()
+	if err != nil {
+		return err
+	}
+	for k, entry := range entries {
+		if !now.Before(entry.ExpiresAt) {
+			delete(entries, k)
+		}
+	}
+	entries[key] = cachedCredential{
+		SessionID: cred.Password,
+		ExpiresAt: cred.ExpiresAt,
+	}
+	return c.save(entries)
+}
+
+// Delete はセッションを削除する
+// passwordを指定した場合は、保存されているセッションと一致する場合のみ削除する
+func (c *FileCache) Delete(key, password string) error {
+	entries, err := c.load()
+	if err != nil {
+		return err
+	}
+	entry, ok := entries[key]
+	if !ok || (password != "" && entry.SessionID != password) {
+		return nil
+	}
+	delete(entries, key)
+	return c.save(entries)
+}
+
+func (c *FileCache) load() (map[string]cachedCredential, error) {
+	data, err := os.ReadFile(c.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return map[string]cachedCredential{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("キャッシュの読み込みに失敗しました: %w", err)
+	}
+
+	entries := map[string]cachedCredential{}
+	if err := json.Unmarshal(data, &entries); err != nil {
+		// 壊れたキャッシュは再ログインで上書きできるよう、空として扱う
+		return map[string]cachedCredential{}, nil
+	}
+	return entries, nil
+}
+
+func (c *FileCache) save(entries map[string]cachedCredential) error {
+	data, err := json.Marshal(entries)
+	if err != nil {
+		return fmt.Errorf("キャッシュのシリアライズに失敗しました: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o700); err != nil {
+		return fmt.Errorf("キャッシュディレクトリの作成に失敗しました: %w", err)
+	}
+
+	// 書き込み途中のファイルを読まないよう、一時ファイルに書いてから置き換える
+	tmp, err := os.CreateTemp(filepath.Dir(c.path), ".credentials-*.json")
+	if err != nil {
+		return fmt.Errorf("キャッシュの書き込みに失敗しました: %w", err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("キャッシュの書き込みに失敗しました: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("キャッシュの書き込みに失敗しました: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), c.path); err != nil {
+		return fmt.Errorf("キャッシュの書き込みに失敗しました: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package credentialhelper_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/na2na-p/cargohold/internal/credentialhelper"
+)
+
+func TestFileCache(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	cred := &credentialhelper.Credential{
+		Username:  credentialhelper.SessionUsername,
+		Password:  "session-id",
+		ExpiresAt: now.Add(24 * time.Hour),
+	}
+
+	tests := []struct {
+		name string
+		run  func(t *testing.T, cache *credentialhelper.FileCache) (*credentialhelper.Credential, error)
+		want *credentialhelper.Credential
+	}{
+		{
+			name: "正常系: 保存したセッションを取得できる",
+			run: func(t *testing.T, cache *credentialhelper.FileCache) (*credentialhelper.Credential, error) {
+				if err := cache.Put("lfs.example.com/owner/repo", cred, now); err != nil {
+					t.Fatalf("Put() unexpected error: %v", err)
+				}
+				return cache.Get("lfs.example.com/owner/repo", now)
+			},
+			want: cred,
+		},
+		{
+			name: "正常系: 期限切れ直前のセッションは返さない",
+			run: func(t *testing.T, cache *credentialhelper.FileCache) (*credentialhelper.Credential, error) {
+				if err := cache.Put("lfs.example.com/owner/repo", cred, now); err != nil {
+					t.Fatalf("Put() unexpected error: %v", err)
+				}
+				return cache.Get("lfs.example.com/owner/repo", now.Add(24*time.Hour-30*time.Second))
+			},
+			want: nil,
+		},
+		{
+			name: "正常系: キャッシュファイルがない場合はnilを返す",
+			run: func(t *testing.T, cache *credentialhelper.FileCache) (*credentialhelper.Credential, error) {
+				return cache.Get("lfs.example.com/owner/repo", now)
+			},
+			want: nil,
+		},
+		{
+			name: "正常系: 一致するパスワードを指定するとセッションが削除される",
+			run: func(t *testing.T, cache *credentialhelper.FileCache) (*credentialhelper.Credential, error) {
+				if err := cache.Put("lfs.example.com/owner/repo", cred, now); err != nil {
+					t.Fatalf("Put() unexpected error: %v", err)
+				}
+				if err := cache.Delete("lfs.example.com/owner/repo", "session-id"); err != nil {
+					t.Fatalf("Delete() unexpected error: %v", err)
+				}
+				return cache.Get("lfs.example.com/owner/repo", now)
+			},
+			want: nil,
+		},
+		{
+			name: "正常系: パスワードが異なる場合はセッションを削除しない",
+			run: func(t *testing.T, cache *credentialhelper.FileCache) (*credentialhelper.Credential, error) {
+				if err := cache.Put("lfs.example.com/owner/repo", cred, now); err != nil {
+					t.Fatalf("Put() unexpected error: %v", err)
+				}
+				if err := cache.Delete("lfs.example.com/owner/repo", "old-session-id"); err != nil {
+					t.Fatalf("Delete() unexpected error: %v", err)
+				}
+				return cache.Get("lfs.example.com/owner/repo", now)
+			},
+			want: cred,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache := credentialhelper.NewFileCache(filepath.Join(t.TempDir(), "cargohold", "credentials.json"))
+
+			got, err := tt.run(t, cache)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFileCache_Permission(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cargohold", "credentials.json")
+	cache := credentialhelper.NewFileCache(path)
+	now := time.Now()
+
+	if err := cache.Put("lfs.example.com/owner/repo", &credentialhelper.Credential{Password: "session-id", ExpiresAt: now.Add(time.Hour)}, now); err != nil {
+		t.Fatalf("Put() unexpected error: %v", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("Stat() unexpected error: %v", err)
+	}
+	if perm := info.Mode().Perm(); perm != 0o600 {
+		t.Errorf("permission = %o, want 600", perm)
+	}
+}
@@ -0,0 +1,193 @@
+package credentialhelper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/na2na-p/cargohold/internal/domain"
+)
+
+const (
+	maxResponseSize = 1 << 20
+
+	// slowDownIncrement は slow_down を受け取った際にポーリング間隔へ加える時間（RFC 8628）
+	slowDownIncrement = 5 * time.Second
+	// defaultPollInterval はサーバーが間隔を返さなかった場合のポーリング間隔
+	defaultPollInterval = 5 * time.Second
+)
+
+type deviceAuthorizationResponse struct {
+	DeviceCode      string `json:"device_code"`
+	UserCode        string `json:"user_code"`
+	VerificationURI string `json:"verification_uri"`
+	ExpiresIn       int    `json:"expires_in"`
+	Interval        int    `json:"interval"`
+}
+
+type deviceTokenResponse struct {
+	SessionID        string `json:"session_id"`
+	ExpiresIn        int    `json:"expires_in"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// Client はcargoholdサーバーのデバイスフローでログインする
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	now        func() time.Time
+	sleep      func(ctx context.Context, d time.Duration) error
+}
+
+func NewClient(baseURL string) *Client {
+	return &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		now:        time.Now,
+		sleep:      sleepContext,
+	}
+}
+
+// Login はデバイスフローを開始し、promptに表示したコードをユーザーが承認するまでポーリングする
+func (c *Client) Login(ctx context.Context, repository *domain.RepositoryIdentifier, prompt io.Writer) (*Credential, error) {
+	authorization, err := c.startDeviceAuthorization(ctx, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	_, _ = fmt.Fprintf(prompt, "cargohold: %s にログインします\n", repository.FullName())
+	_, _ = fmt.Fprintf(prompt, "ブラウザで %s を開き、コード %s を入力してください\n", authorization.VerificationURI, authorization.UserCode)
+
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(authorization.ExpiresIn)*time.Second)
+	defer cancel()
+
+	interval := time.Duration(authorization.Interval) * time.Second
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+	for {
+		if err := c.sleep(ctx, interval); err != nil {
+			return nil, fmt.Errorf("ログインがタイムアウトしました: %w", err)
+		}
+
+		resp, err := c.pollDeviceToken(ctx, authorization.DeviceCode)
+		if err != nil {
+			return nil, err
+		}
+		switch resp.Error {
+		case "":
+			return &Credential{
+				Username:  SessionUsername,
+				Password:  resp.SessionID,
+				ExpiresAt: c.now().Add(time.Duration(resp.ExpiresIn) * time.Second),
+			}, nil
+		case "authorization_pending":
+		case "slow_down":
+			interval += slowDownIncrement
+		default:
+			return nil, fmt.Errorf("ログインに失敗しました: %s (%s)", resp.Error, resp.ErrorDescription)
+		}
+	}
+}
+
+func (c *Client) startDeviceAuthorization(ctx context.Context, repository *domain.RepositoryIdentifier) (*deviceAuthorizationResponse, error) {
+	form := url.Values{}
+	form.Set("repository", repository.FullName())
+
+	status, body, err := c.postForm(ctx, "/auth/github/device", form)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("デバイスフローの開始に失敗しました: status=%d %s", status, errorMessage(body))
+	}
+
+	var resp deviceAuthorizationResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("レスポンスのパースに失敗しました: %w", err)
+	}
+	if resp.DeviceCode == "" || resp.UserCode == "" {
+		return nil, errors.New("デバイスコードがレスポンスに含まれていません")
+	}
+	return &resp, nil
+}
+
+// pollDeviceToken は承認前のエラーコードも含めてレスポンスを返す
+func (c *Client) pollDeviceToken(ctx context.Context, deviceCode string) (*deviceTokenResponse, error) {
+	form := url.Values{}
+	form.Set("device_code", deviceCode)
+
+	status, body, err := c.postForm(ctx, "/auth/github/device/token", form)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp deviceTokenResponse
+	switch status {
+	case http.StatusOK, http.StatusBadRequest:
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return nil, fmt.Errorf("レスポンスのパースに失敗しました: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("ログインに失敗しました: status=%d %s", status, errorMessage(body))
+	}
+
+	if status == http.StatusOK && resp.SessionID == "" {
+		return nil, errors.New("セッションIDがレスポンスに含まれていません")
+	}
+	if status == http.StatusBadRequest && resp.ErrorDescription == "" {
+		// device_code の指定漏れなど、RFC 8628 のエラーコード以外の400
+		return nil, fmt.Errorf("ログインに失敗しました: status=%d %s", status, resp.Error)
+	}
+	return &resp, nil
+}
+
+func (c *Client) postForm(ctx context.Context, path string, form url.Values) (int, []byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+path, strings.NewReader(form.Encode()))
+	if err != nil {
+		return 0, nil, fmt.Errorf("リクエストの作成に失敗しました: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, nil, fmt.Errorf("リクエストに失敗しました: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return 0, nil, fmt.Errorf("レスポンスの読み取りに失敗しました: %w", err)
+	}
+	return resp.StatusCode, body, nil
+}
+
+// errorMessage はサーバーが返したエラーメッセージを取り出す
+func errorMessage(body []byte) string {
+	var resp struct {
+		Error string `json:"error"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return ""
+	}
+	return resp.Error
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,93 @@
+package credentialhelper
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Helper はgitから呼び出される get / store / erase を処理する
+type Helper struct {
+	cache     *FileCache
+	newClient func(baseURL string) *Client
+	prompt    io.Writer
+	now       func() time.Time
+}
+
+// NewHelper はログイン時の案内をpromptに出力するHelperを生成する
+// gitはcredential helperの標準出力を読み取るため、promptには標準エラー出力を指定する
+func NewHelper(cache *FileCache, prompt io.Writer) *Helper {
+	return &Helper{
+		cache:     cache,
+		newClient: NewClient,
+		prompt:    prompt,
+		now:       time.Now,
+	}
+}
+
+func (h *Helper) Run(ctx context.Context, action string, in io.Reader, out io.Writer) error {
+	req, err := ReadRequest(in)
+	if err != nil {
+		return err
+	}
+
+	switch action {
+	case "get":
+		return h.get(ctx, req, out)
+	case "erase":
+		return h.erase(req)
+	case "store":
+		// ログイン時にキャッシュ済みのため、gitからの保存要求は無視する
+		return nil
+	default:
+		return fmt.Errorf("不明な操作です: %s", action)
+	}
+}
+
+// get はキャッシュ済みのセッションを返し、なければログインしてキャッシュする
+func (h *Helper) get(ctx context.Context, req *Request, out io.Writer) error {
+	// 他の認証方式のユーザー名が指定されている場合は、他のcredential helperに任せる
+	if req.Username != "" && req.Username != SessionUsername {
+		return nil
+	}
+
+	repository, err := req.Repository()
+	if err != nil {
+		return err
+	}
+	key := cacheKey(req.Host, repository.FullName())
+
+	cred, err := h.cache.Get(key, h.now())
+	if err != nil {
+		return err
+	}
+	if cred == nil {
+		cred, err = h.newClient(req.BaseURL()).Login(ctx, repository, h.prompt)
+		if err != nil {
+			return err
+		}
+		if err := h.cache.Put(key, cred, h.now()); err != nil {
+			return err
+		}
+	}
+
+	return WriteCredential(out, cred)
+}
+
+// erase はサーバーが401を返した際にgitから呼び出され、キャッシュしたセッションを破棄する
+func (h *Helper) erase(req *Request) error {
+	if req.Username != "" && req.Username != SessionUsername {
+		return nil
+	}
+
+	repository, err := req.Repository()
+	if err != nil {
+		return err
+	}
+	return h.cache.Delete(cacheKey(req.Host, repository.FullName()), req.Password)
+}
+
+func cacheKey(host, repository string) string {
+	return host + "/" + repository
+}
@@ -0,0 +1,186 @@
+package credentialhelper
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// newDeviceFlowServer はpendingCount回 authorization_pending を返した後にセッションを返すサーバーを起動する
+func newDeviceFlowServer(t *testing.T, pendingCount int, finalError string) *httptest.Server {
+	t.Helper()
+	polls := 0
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("フォームのパースに失敗: %v", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/auth/github/device":
+			if r.PostForm.Get("repository") != "owner/repo" {
+				t.Errorf("repository = %q, want owner/repo", r.PostForm.Get("repository"))
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"device_code":      "device-id",
+				"user_code":        "ABCD-1234",
+				"verification_uri": "https://github.com/login/device",
+				"expires_in":       900,
+				"interval":         5,
+			})
+		case "/auth/github/device/token":
+			if r.PostForm.Get("device_code") != "device-id" {
+				t.Errorf("device_code = %q, want device-id", r.PostForm.Get("device_code"))
+			}
+			polls++
+			if polls <= pendingCount {
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(map[string]string{"error": "authorization_pending", "error_description": "pending"})
+				return
+			}
+			if finalError != "" {
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(map[string]string{"error": finalError, "error_description": "denied"})
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"session_id": "new-session-id", "expires_in": 86400})
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestHelper_Run(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		action       string
+		cached       *Credential
+		pendingCount int
+		finalError   string
+		input        func(host string) string
+		wantOutput   string
+		wantCached   *Credential
+		wantSleeps   int
+		wantErr      bool
+	}{
+		{
+			name:   "正常系: キャッシュ済みのセッションを返す",
+			action: "get",
+			cached: &Credential{Username: SessionUsername, Password: "cached-session-id", ExpiresAt: now.Add(time.Hour)},
+			input: func(host string) string {
+				return "protocol=http\nhost=" + host + "\npath=owner/repo.git/info/lfs\n\n"
+			},
+			wantOutput: "username=x-session\npassword=cached-session-id\npassword_expiry_utc=1767229200\n",
+			wantCached: &Credential{Username: SessionUsername, Password: "cached-session-id", ExpiresAt: now.Add(time.Hour)},
+		},
+		{
+			name:         "正常系: キャッシュがない場合、承認されるまでポーリングしてキャッシュする",
+			action:       "get",
+			pendingCount: 2,
+			input: func(host string) string {
+				return "protocol=http\nhost=" + host + "\npath=owner/repo/info/lfs/objects/batch\n\n"
+			},
+			wantOutput: "username=x-session\npassword=new-session-id\npassword_expiry_utc=1767312000\n",
+			wantCached: &Credential{Username: SessionUsername, Password: "new-session-id", ExpiresAt: now.Add(24 * time.Hour)},
+			wantSleeps: 3,
+		},
+		{
+			name:       "異常系: ユーザーが拒否した場合はエラーを返しキャッシュしない",
+			action:     "get",
+			finalError: "access_denied",
+			input: func(host string) string {
+				return "protocol=http\nhost=" + host + "\npath=owner/repo/info/lfs\n\n"
+			},
+			wantSleeps: 1,
+			wantErr:    true,
+		},
+		{
+			name:   "正常系: 他のユーザー名が指定されている場合は何も返さない",
+			action: "get",
+			input: func(host string) string {
+				return "protocol=http\nhost=" + host + "\npath=owner/repo/info/lfs\nusername=x-token\n\n"
+			},
+		},
+		{
+			name:   "正常系: eraseでキャッシュしたセッションを破棄する",
+			action: "erase",
+			cached: &Credential{Username: SessionUsername, Password: "cached-session-id", ExpiresAt: now.Add(time.Hour)},
+			input: func(host string) string {
+				return "protocol=http\nhost=" + host + "\npath=owner/repo/info/lfs\nusername=x-session\npassword=cached-session-id\n\n"
+			},
+		},
+		{
+			name:   "異常系: パスが含まれない場合はエラー",
+			action: "get",
+			input: func(host string) string {
+				return "protocol=http\nhost=" + host + "\n\n"
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newDeviceFlowServer(t, tt.pendingCount, tt.finalError)
+			defer server.Close()
+			serverURL, _ := url.Parse(server.URL)
+			key := cacheKey(serverURL.Host, "owner/repo")
+
+			cache := NewFileCache(filepath.Join(t.TempDir(), "credentials.json"))
+			if tt.cached != nil {
+				if err := cache.Put(key, tt.cached, now); err != nil {
+					t.Fatalf("Put() unexpected error: %v", err)
+				}
+			}
+
+			sleeps := 0
+			var prompt bytes.Buffer
+			helper := NewHelper(cache, &prompt)
+			helper.now = func() time.Time { return now }
+			helper.newClient = func(baseURL string) *Client {
+				client := NewClient(baseURL)
+				client.now = helper.now
+				client.sleep = func(ctx context.Context, d time.Duration) error {
+					sleeps++
+					return nil
+				}
+				return client
+			}
+
+			var out bytes.Buffer
+			err := helper.Run(context.Background(), tt.action, strings.NewReader(tt.input(serverURL.Host)), &out)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Run() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.wantOutput, out.String()); diff != "" {
+				t.Errorf("Run() output mismatch (-want +got):\n%s", diff)
+			}
+			if sleeps != tt.wantSleeps {
+				t.Errorf("Run() polled %d times, want %d", sleeps, tt.wantSleeps)
+			}
+			if tt.wantSleeps > 0 && !strings.Contains(prompt.String(), "ABCD-1234") {
+				t.Errorf("Run() prompt does not contain user code: %q", prompt.String())
+			}
+
+			got, err := cache.Get(key, now)
+			if err != nil {
+				t.Fatalf("Get() unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantCached, got); diff != "" {
+				t.Errorf("cached credential mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+// Package credentialhelper はgitのcredential helperとしてcargoholdのセッションを取得・キャッシュする
+package credentialhelper
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/na2na-p/cargohold/internal/domain"
+)
+
+// SessionUsername はセッションIDをパスワードとして送る際のユーザー名
+const SessionUsername = "x-session"
+
+// ErrPathRequired はgitがリクエストにパスを含めていない場合のエラー
+var ErrPathRequired = errors.New("credential request has no path; set credential.useHttpPath to true")
+
+// Request はgitからcredential helperに渡される入力
+type Request struct {
+	Protocol string
+	Host     string
+	Path     string
+	Username string
+	Password string
+}
+
+// Credential はgitに返す認証情報
+type Credential struct {
+	Username  string
+	Password  string
+	ExpiresAt time.Time
+}
+
+// ReadRequest はgitのcredential helperプロトコルの "key=value" 形式の入力を読み取る
+// 空行または入力の終端までを1つのリクエストとして扱い、未知のキーは無視する
+func ReadRequest(r io.Reader) (*Request, error) {
+	req := &Request{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			break
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("不正な入力行です: %q", line)
+		}
+		switch key {
+		case "protocol":
+			req.Protocol = value
+		case "host":
+			req.Host = value
+		case "path":
+			req.Path = value
+		case "username":
+			req.Username = value
+		case "password":
+			req.Password = value
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("入力の読み取りに失敗しました: %w", err)
+	}
+	return req, nil
+}
+
+// Repository はリクエストのパスからリポジトリを求める
+// LFSのエンドポイントは "owner/repo[.git]/info/lfs/..." の形式のため、先頭の2要素を使う
+func (r *Request) Repository() (*domain.RepositoryIdentifier, error) {
+	if r.Path == "" {
+		return nil, ErrPathRequired
+	}
+	parts := strings.Split(strings.Trim(r.Path, "/"), "/")
+	if len(parts) < 2 {
+		return nil, fmt.Errorf("パスからリポジトリを特定できません: %q", r.Path)
+	}
+	return domain.NewRepositoryIdentifier(parts[0] + "/" + strings.TrimSuffix(parts[1], ".git"))
+}
+
+// BaseURL はcargoholdサーバーのURLを返す
+func (r *Request) BaseURL() string {
+	return r.Protocol + "://" + r.Host
+}
+
+// WriteCredential は認証情報をgitに返す
+// password_expiry_utc はgit 2.41以降で解釈され、期限切れの認証情報は使われなくなる
+func WriteCredential(w io.Writer, cred *Credential) error {
+	var b strings.Builder
+	b.WriteString("username=" + cred.Username + "\n")
+	b.WriteString("password=" + cred.Password + "\n")
+	if !cred.ExpiresAt.IsZero() {
+		b.WriteString("password_expiry_utc=" + strconv.FormatInt(cred.ExpiresAt.Unix(), 10) + "\n")
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
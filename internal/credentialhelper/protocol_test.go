@@ -0,0 +1,119 @@
+package credentialhelper_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/na2na-p/cargohold/internal/credentialhelper"
+)
+
+func TestReadRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    *credentialhelper.Request
+		wantErr bool
+	}{
+		{
+			name:  "正常系: gitからの入力を読み取り、未知のキーは無視する",
+			input: "protocol=https\nhost=lfs.example.com\npath=owner/repo.git/info/lfs\nusername=x-session\nwwwauth[]=Basic realm=\"cargohold\"\n\n",
+			want: &credentialhelper.Request{
+				Protocol: "https",
+				Host:     "lfs.example.com",
+				Path:     "owner/repo.git/info/lfs",
+				Username: "x-session",
+			},
+		},
+		{
+			name:  "正常系: 終端の空行がなくても読み取れる",
+			input: "protocol=https\nhost=lfs.example.com\npassword=a=b",
+			want: &credentialhelper.Request{
+				Protocol: "https",
+				Host:     "lfs.example.com",
+				Password: "a=b",
+			},
+		},
+		{
+			name:    "異常系: key=value形式でない行はエラー",
+			input:   "protocol\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := credentialhelper.ReadRequest(strings.NewReader(tt.input))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ReadRequest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ReadRequest() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRequest_Repository(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		want    string
+		wantErr error
+	}{
+		{
+			name: "正常系: LFSのエンドポイントのパスからリポジトリを求める",
+			path: "owner/repo/info/lfs/objects/batch",
+			want: "owner/repo",
+		},
+		{
+			name: "正常系: .git サフィックスを取り除く",
+			path: "/owner/repo.git/info/lfs",
+			want: "owner/repo",
+		},
+		{
+			name:    "異常系: パスがない場合、ErrPathRequiredを返す",
+			path:    "",
+			wantErr: credentialhelper.ErrPathRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &credentialhelper.Request{Path: tt.path}
+			got, err := req.Repository()
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Repository() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Repository() unexpected error: %v", err)
+			}
+			if got.FullName() != tt.want {
+				t.Errorf("Repository() = %q, want %q", got.FullName(), tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteCredential(t *testing.T) {
+	var buf bytes.Buffer
+	err := credentialhelper.WriteCredential(&buf, &credentialhelper.Credential{
+		Username:  "x-session",
+		Password:  "session-id",
+		ExpiresAt: time.Unix(1767225600, 0),
+	})
+	if err != nil {
+		t.Fatalf("WriteCredential() unexpected error: %v", err)
+	}
+
+	want := "username=x-session\npassword=session-id\npassword_expiry_utc=1767225600\n"
+	if diff := cmp.Diff(want, buf.String()); diff != "" {
+		t.Errorf("WriteCredential() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -20,4 +20,5 @@ type AuthorizationResult struct {
 type AccessAuthorizationService interface {
 	CanAccess(ctx context.Context, userRepo *RepositoryIdentifier, oid OID) (bool, error)
 	Authorize(ctx context.Context, operation Operation, userRepo *RepositoryIdentifier, oid OID) (AuthorizationResult, error)
+	AuthorizeAll(ctx context.Context, operation Operation, userRepo *RepositoryIdentifier, oids []OID) (map[OID]AuthorizationResult, error)
 }
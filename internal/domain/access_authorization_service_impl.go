@@ -20,16 +20,12 @@ func (s *accessAuthorizationServiceImpl) CanAccess(ctx context.Context, userRepo
 		return false, ErrInvalidRepositoryIdentifier
 	}
 
-	policy, err := s.policyRepo.FindByOID(ctx, oid)
+	policy, err := s.findGrant(ctx, userRepo, oid)
 	if err != nil {
 		return false, err
 	}
 
-	if policy == nil {
-		return false, ErrAccessPolicyNotFound
-	}
-
-	return userRepo.Equals(policy.Repository()), nil
+	return policy != nil, nil
 }
 
 func (s *accessAuthorizationServiceImpl) Authorize(ctx context.Context, operation Operation, userRepo *RepositoryIdentifier, oid OID) (AuthorizationResult, error) {
@@ -37,21 +33,56 @@ func (s *accessAuthorizationServiceImpl) Authorize(ctx context.Context, operatio
 		return AuthorizationResult{Allowed: false, IsNewObject: false}, ErrInvalidRepositoryIdentifier
 	}
 
-	policy, err := s.policyRepo.FindByOID(ctx, oid)
-	if err != nil && !errors.Is(err, ErrAccessPolicyNotFound) {
+	policy, err := s.findGrant(ctx, userRepo, oid)
+	if err != nil {
 		return AuthorizationResult{Allowed: false, IsNewObject: false}, err
 	}
 
-	if policy == nil || errors.Is(err, ErrAccessPolicyNotFound) {
-		if operation == OperationUpload {
-			return AuthorizationResult{Allowed: true, IsNewObject: true}, nil
+	result := decideAuthorization(operation, policy)
+	if !result.Allowed {
+		return result, ErrAuthorizationDenied
+	}
+	return result, nil
+}
+
+func (s *accessAuthorizationServiceImpl) AuthorizeAll(ctx context.Context, operation Operation, userRepo *RepositoryIdentifier, oids []OID) (map[OID]AuthorizationResult, error) {
+	if userRepo == nil {
+		return nil, ErrInvalidRepositoryIdentifier
+	}
+
+	policies, err := s.policyRepo.FindByOIDsAndRepository(ctx, oids, userRepo)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make(map[OID]AuthorizationResult, len(oids))
+	for _, oid := range oids {
+		results[oid] = decideAuthorization(operation, policies[oid])
+	}
+	return results, nil
+}
+
+func (s *accessAuthorizationServiceImpl) findGrant(ctx context.Context, userRepo *RepositoryIdentifier, oid OID) (*AccessPolicy, error) {
+	policy, err := s.policyRepo.FindByOIDAndRepository(ctx, oid, userRepo)
+	if err != nil {
+		if errors.Is(err, ErrAccessPolicyNotFound) {
+			return nil, nil
 		}
-		return AuthorizationResult{Allowed: false, IsNewObject: false}, ErrAuthorizationDenied
+		return nil, err
+	}
+	if policy == nil || !userRepo.Equals(policy.Repository()) {
+		return nil, nil
 	}
+	return policy, nil
+}
 
-	if !userRepo.Equals(policy.Repository()) {
-		return AuthorizationResult{Allowed: false, IsNewObject: false}, ErrAuthorizationDenied
+func decideAuthorization(operation Operation, policy *AccessPolicy) AuthorizationResult {
+	if policy != nil {
+		return AuthorizationResult{Allowed: true, IsNewObject: false}
 	}
 
-	return AuthorizationResult{Allowed: true, IsNewObject: false}, nil
+	if operation == OperationUpload {
+		return AuthorizationResult{Allowed: true, IsNewObject: true}
+	}
+	return AuthorizationResult{Allowed: false, IsNewObject: false}
 }
@@ -42,7 +42,6 @@ func TestNewAccessAuthorizationService(t *testing.T) {
 func TestAccessAuthorizationService_CanAccess(t *testing.T) {
 	validOID, _ := domain.NewOID(strings.Repeat("a", 64))
 	userRepo, _ := domain.NewRepositoryIdentifier("owner/repo")
-	differentRepo, _ := domain.NewRepositoryIdentifier("other/repo")
 	fixedTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
 	policyID, _ := domain.NewAccessPolicyID(1)
 
@@ -69,7 +68,7 @@ func TestAccessAuthorizationService_CanAccess(t *testing.T) {
 				policyRepo: func(ctrl *gomock.Controller) domain.AccessPolicyRepository {
 					mock := mock_domain.NewMockAccessPolicyRepository(ctrl)
 					policy := domain.NewAccessPolicy(policyID, validOID, userRepo, fixedTime)
-					mock.EXPECT().FindByOID(gomock.Any(), validOID).Return(policy, nil)
+					mock.EXPECT().FindByOIDAndRepository(gomock.Any(), validOID, userRepo).Return(policy, nil)
 					return mock
 				},
 			},
@@ -82,12 +81,11 @@ func TestAccessAuthorizationService_CanAccess(t *testing.T) {
 			wantErr: nil,
 		},
 		{
-			name: "正常系: ポリシーが存在し、リポジトリが一致しない場合、falseが返る",
+			name: "正常系: 他リポジトリにのみ紐付いている場合、falseが返る",
 			fields: fields{
 				policyRepo: func(ctrl *gomock.Controller) domain.AccessPolicyRepository {
 					mock := mock_domain.NewMockAccessPolicyRepository(ctrl)
-					policy := domain.NewAccessPolicy(policyID, validOID, differentRepo, fixedTime)
-					mock.EXPECT().FindByOID(gomock.Any(), validOID).Return(policy, nil)
+					mock.EXPECT().FindByOIDAndRepository(gomock.Any(), validOID, userRepo).Return(nil, domain.ErrAccessPolicyNotFound)
 					return mock
 				},
 			},
@@ -100,11 +98,11 @@ func TestAccessAuthorizationService_CanAccess(t *testing.T) {
 			wantErr: nil,
 		},
 		{
-			name: "異常系: ポリシーが存在しない場合、エラーが返る",
+			name: "異常系: ポリシーの取得に失敗した場合、エラーが返る",
 			fields: fields{
 				policyRepo: func(ctrl *gomock.Controller) domain.AccessPolicyRepository {
 					mock := mock_domain.NewMockAccessPolicyRepository(ctrl)
-					mock.EXPECT().FindByOID(gomock.Any(), validOID).Return(nil, errPolicyNotFound)
+					mock.EXPECT().FindByOIDAndRepository(gomock.Any(), validOID, userRepo).Return(nil, errPolicyNotFound)
 					return mock
 				},
 			},
@@ -117,11 +115,11 @@ func TestAccessAuthorizationService_CanAccess(t *testing.T) {
 			wantErr: errPolicyNotFound,
 		},
 		{
-			name: "異常系: ポリシーがnilで返された場合、ErrAccessPolicyNotFoundが返る",
+			name: "正常系: ポリシーがnilで返された場合、falseが返る",
 			fields: fields{
 				policyRepo: func(ctrl *gomock.Controller) domain.AccessPolicyRepository {
 					mock := mock_domain.NewMockAccessPolicyRepository(ctrl)
-					mock.EXPECT().FindByOID(gomock.Any(), validOID).Return(nil, nil)
+					mock.EXPECT().FindByOIDAndRepository(gomock.Any(), validOID, userRepo).Return(nil, nil)
 					return mock
 				},
 			},
@@ -131,7 +129,7 @@ func TestAccessAuthorizationService_CanAccess(t *testing.T) {
 				oid:      validOID,
 			},
 			want:    false,
-			wantErr: domain.ErrAccessPolicyNotFound,
+			wantErr: nil,
 		},
 		{
 			name: "異常系: userRepoがnilの場合、ErrInvalidRepositoryIdentifierが返る",
@@ -181,7 +179,6 @@ func TestAccessAuthorizationService_CanAccess(t *testing.T) {
 func TestAccessAuthorizationService_Authorize(t *testing.T) {
 	validOID, _ := domain.NewOID(strings.Repeat("a", 64))
 	userRepo, _ := domain.NewRepositoryIdentifier("owner/repo")
-	differentRepo, _ := domain.NewRepositoryIdentifier("other/repo")
 	fixedTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
 	policyID, _ := domain.NewAccessPolicyID(1)
 
@@ -207,7 +204,7 @@ func TestAccessAuthorizationService_Authorize(t *testing.T) {
 				policyRepo: func(ctrl *gomock.Controller) domain.AccessPolicyRepository {
 					mock := mock_domain.NewMockAccessPolicyRepository(ctrl)
 					policy := domain.NewAccessPolicy(policyID, validOID, userRepo, fixedTime)
-					mock.EXPECT().FindByOID(gomock.Any(), validOID).Return(policy, nil)
+					mock.EXPECT().FindByOIDAndRepository(gomock.Any(), validOID, userRepo).Return(policy, nil)
 					return mock
 				},
 			},
@@ -225,7 +222,7 @@ func TestAccessAuthorizationService_Authorize(t *testing.T) {
 			fields: fields{
 				policyRepo: func(ctrl *gomock.Controller) domain.AccessPolicyRepository {
 					mock := mock_domain.NewMockAccessPolicyRepository(ctrl)
-					mock.EXPECT().FindByOID(gomock.Any(), validOID).Return(nil, nil)
+					mock.EXPECT().FindByOIDAndRepository(gomock.Any(), validOID, userRepo).Return(nil, nil)
 					return mock
 				},
 			},
@@ -239,12 +236,11 @@ func TestAccessAuthorizationService_Authorize(t *testing.T) {
 			wantErr: domain.ErrAuthorizationDenied,
 		},
 		{
-			name: "異常系: Download操作でリポジトリが一致しない場合、ErrAuthorizationDeniedが返る",
+			name: "異常系: Download操作で他リポジトリにのみ紐付いている場合、ErrAuthorizationDeniedが返る",
 			fields: fields{
 				policyRepo: func(ctrl *gomock.Controller) domain.AccessPolicyRepository {
 					mock := mock_domain.NewMockAccessPolicyRepository(ctrl)
-					policy := domain.NewAccessPolicy(policyID, validOID, differentRepo, fixedTime)
-					mock.EXPECT().FindByOID(gomock.Any(), validOID).Return(policy, nil)
+					mock.EXPECT().FindByOIDAndRepository(gomock.Any(), validOID, userRepo).Return(nil, domain.ErrAccessPolicyNotFound)
 					return mock
 				},
 			},
@@ -263,7 +259,7 @@ func TestAccessAuthorizationService_Authorize(t *testing.T) {
 				policyRepo: func(ctrl *gomock.Controller) domain.AccessPolicyRepository {
 					mock := mock_domain.NewMockAccessPolicyRepository(ctrl)
 					policy := domain.NewAccessPolicy(policyID, validOID, userRepo, fixedTime)
-					mock.EXPECT().FindByOID(gomock.Any(), validOID).Return(policy, nil)
+					mock.EXPECT().FindByOIDAndRepository(gomock.Any(), validOID, userRepo).Return(policy, nil)
 					return mock
 				},
 			},
@@ -281,7 +277,7 @@ func TestAccessAuthorizationService_Authorize(t *testing.T) {
 			fields: fields{
 				policyRepo: func(ctrl *gomock.Controller) domain.AccessPolicyRepository {
 					mock := mock_domain.NewMockAccessPolicyRepository(ctrl)
-					mock.EXPECT().FindByOID(gomock.Any(), validOID).Return(nil, nil)
+					mock.EXPECT().FindByOIDAndRepository(gomock.Any(), validOID, userRepo).Return(nil, nil)
 					return mock
 				},
 			},
@@ -295,12 +291,11 @@ func TestAccessAuthorizationService_Authorize(t *testing.T) {
 			wantErr: nil,
 		},
 		{
-			name: "異常系: Upload操作でリポジトリが一致しない場合、ErrAuthorizationDeniedが返る",
+			name: "正常系: Upload操作で他リポジトリにのみ紐付いている場合、このリポジトリへの新規紐付けとして許可される",
 			fields: fields{
 				policyRepo: func(ctrl *gomock.Controller) domain.AccessPolicyRepository {
 					mock := mock_domain.NewMockAccessPolicyRepository(ctrl)
-					policy := domain.NewAccessPolicy(policyID, validOID, differentRepo, fixedTime)
-					mock.EXPECT().FindByOID(gomock.Any(), validOID).Return(policy, nil)
+					mock.EXPECT().FindByOIDAndRepository(gomock.Any(), validOID, userRepo).Return(nil, domain.ErrAccessPolicyNotFound)
 					return mock
 				},
 			},
@@ -310,8 +305,8 @@ func TestAccessAuthorizationService_Authorize(t *testing.T) {
 				userRepo:  userRepo,
 				oid:       validOID,
 			},
-			want:    domain.AuthorizationResult{Allowed: false, IsNewObject: false},
-			wantErr: domain.ErrAuthorizationDenied,
+			want:    domain.AuthorizationResult{Allowed: true, IsNewObject: true},
+			wantErr: nil,
 		},
 		{
 			name: "異常系: userRepoがnilの場合、ErrInvalidRepositoryIdentifierが返る",
@@ -335,7 +330,7 @@ func TestAccessAuthorizationService_Authorize(t *testing.T) {
 			fields: fields{
 				policyRepo: func(ctrl *gomock.Controller) domain.AccessPolicyRepository {
 					mock := mock_domain.NewMockAccessPolicyRepository(ctrl)
-					mock.EXPECT().FindByOID(gomock.Any(), validOID).Return(nil, errors.New("database error"))
+					mock.EXPECT().FindByOIDAndRepository(gomock.Any(), validOID, userRepo).Return(nil, errors.New("database error"))
 					return mock
 				},
 			},
@@ -376,3 +371,138 @@ func TestAccessAuthorizationService_Authorize(t *testing.T) {
 		})
 	}
 }
+
+func TestAccessAuthorizationService_AuthorizeAll(t *testing.T) {
+	ownOID, _ := domain.NewOID(strings.Repeat("a", 64))
+	foreignOID, _ := domain.NewOID(strings.Repeat("b", 64))
+	newOID, _ := domain.NewOID(strings.Repeat("c", 64))
+	userRepo, _ := domain.NewRepositoryIdentifier("owner/repo")
+	fixedTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	policyID, _ := domain.NewAccessPolicyID(1)
+
+	type fields struct {
+		policyRepo func(ctrl *gomock.Controller) domain.AccessPolicyRepository
+	}
+	type args struct {
+		ctx       context.Context
+		operation domain.Operation
+		userRepo  *domain.RepositoryIdentifier
+		oids      []domain.OID
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    map[domain.OID]domain.AuthorizationResult
+		wantErr error
+	}{
+		{
+			name: "正常系: Download操作でこのリポジトリに紐付いたOIDのみ許可され、拒否されたOIDはエラーにならない",
+			fields: fields{
+				policyRepo: func(ctrl *gomock.Controller) domain.AccessPolicyRepository {
+					mock := mock_domain.NewMockAccessPolicyRepository(ctrl)
+					mock.EXPECT().FindByOIDsAndRepository(gomock.Any(), []domain.OID{ownOID, foreignOID, newOID}, userRepo).Return(map[domain.OID]*domain.AccessPolicy{
+						ownOID: domain.NewAccessPolicy(policyID, ownOID, userRepo, fixedTime),
+					}, nil)
+					return mock
+				},
+			},
+			args: args{
+				ctx:       context.Background(),
+				operation: domain.OperationDownload,
+				userRepo:  userRepo,
+				oids:      []domain.OID{ownOID, foreignOID, newOID},
+			},
+			want: map[domain.OID]domain.AuthorizationResult{
+				ownOID:     {Allowed: true, IsNewObject: false},
+				foreignOID: {Allowed: false, IsNewObject: false},
+				newOID:     {Allowed: false, IsNewObject: false},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "正常系: Upload操作でこのリポジトリに紐付いていないOIDは新規紐付けとして許可される",
+			fields: fields{
+				policyRepo: func(ctrl *gomock.Controller) domain.AccessPolicyRepository {
+					mock := mock_domain.NewMockAccessPolicyRepository(ctrl)
+					mock.EXPECT().FindByOIDsAndRepository(gomock.Any(), []domain.OID{ownOID, foreignOID, newOID}, userRepo).Return(map[domain.OID]*domain.AccessPolicy{
+						ownOID: domain.NewAccessPolicy(policyID, ownOID, userRepo, fixedTime),
+					}, nil)
+					return mock
+				},
+			},
+			args: args{
+				ctx:       context.Background(),
+				operation: domain.OperationUpload,
+				userRepo:  userRepo,
+				oids:      []domain.OID{ownOID, foreignOID, newOID},
+			},
+			want: map[domain.OID]domain.AuthorizationResult{
+				ownOID:     {Allowed: true, IsNewObject: false},
+				foreignOID: {Allowed: true, IsNewObject: true},
+				newOID:     {Allowed: true, IsNewObject: true},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "異常系: userRepoがnilの場合、ErrInvalidRepositoryIdentifierが返る",
+			fields: fields{
+				policyRepo: func(ctrl *gomock.Controller) domain.AccessPolicyRepository {
+					return mock_domain.NewMockAccessPolicyRepository(ctrl)
+				},
+			},
+			args: args{
+				ctx:       context.Background(),
+				operation: domain.OperationDownload,
+				userRepo:  nil,
+				oids:      []domain.OID{ownOID},
+			},
+			want:    nil,
+			wantErr: domain.ErrInvalidRepositoryIdentifier,
+		},
+		{
+			name: "異常系: ポリシーの一括取得に失敗した場合、エラーが返る",
+			fields: fields{
+				policyRepo: func(ctrl *gomock.Controller) domain.AccessPolicyRepository {
+					mock := mock_domain.NewMockAccessPolicyRepository(ctrl)
+					mock.EXPECT().FindByOIDsAndRepository(gomock.Any(), []domain.OID{ownOID}, userRepo).Return(nil, errors.New("database error"))
+					return mock
+				},
+			},
+			args: args{
+				ctx:       context.Background(),
+				operation: domain.OperationDownload,
+				userRepo:  userRepo,
+				oids:      []domain.OID{ownOID},
+			},
+			want:    nil,
+			wantErr: errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			service := domain.NewAccessAuthorizationService(tt.fields.policyRepo(ctrl))
+
+			got, err := service.AuthorizeAll(tt.args.ctx, tt.args.operation, tt.args.userRepo, tt.args.oids)
+
+			if tt.wantErr != nil {
+				if err == nil {
+					t.Fatalf("want error %v, but got nil", tt.wantErr)
+				}
+				if !errors.Is(err, tt.wantErr) && err.Error() != tt.wantErr.Error() {
+					t.Errorf("AuthorizeAll() error = %v, wantErr %v", err, tt.wantErr)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("want no error, but got %v", err)
+				}
+			}
+
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(domain.OID{})); diff != "" {
+				t.Errorf("AuthorizeAll() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
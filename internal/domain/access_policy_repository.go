@@ -4,7 +4,9 @@ package domain
 import "context"
 
 type AccessPolicyRepository interface {
-	FindByOID(ctx context.Context, oid OID) (*AccessPolicy, error)
+	FindByOID(ctx context.Context, oid OID) ([]*AccessPolicy, error)
+	FindByOIDAndRepository(ctx context.Context, oid OID, repository *RepositoryIdentifier) (*AccessPolicy, error)
+	FindByOIDsAndRepository(ctx context.Context, oids []OID, repository *RepositoryIdentifier) (map[OID]*AccessPolicy, error)
 	Save(ctx context.Context, policy *AccessPolicy) error
 	Delete(ctx context.Context, oid OID) error
 }
@@ -0,0 +1,209 @@
+package domain
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/newmo-oss/ctxtime"
+)
+
+// AccessTokenPrefix はcargoholdが発行するアクセストークンの接頭辞
+// BearerトークンがOIDCトークンかアクセストークンかを判別するために使用する
+const AccessTokenPrefix = "cht_"
+
+// MaxAccessTokenLifetime はアクセストークンに設定できる有効期間の上限
+const MaxAccessTokenLifetime = 365 * 24 * time.Hour
+
+// MaxUserAccessTokenLifetime はユーザーがセッションから発行するアクセストークンの有効期間の上限
+// トークンは発行後に発行者の権限が変わっても失効しないため、管理者が発行するトークンより短く制限する
+const MaxUserAccessTokenLifetime = 30 * 24 * time.Hour
+
+// AccessTokenSubjectPrefix はアクセストークンで認証したユーザーのsubjectの接頭辞
+const AccessTokenSubjectPrefix = "token:"
+
+var (
+	ErrEmptyAccessTokenName         = errors.New("access token name cannot be empty")
+	ErrEmptyAccessTokenRepositories = errors.New("access token must be scoped to at least one repository")
+	ErrEmptyAccessTokenOperations   = errors.New("access token must allow at least one operation")
+	ErrInvalidAccessTokenLifetime   = errors.New("access token lifetime must be positive and at most 365 days")
+	ErrInvalidAccessTokenHash       = errors.New("access token hash cannot be empty")
+)
+
+// IsAccessTokenSecret はトークンがcargoholdの発行したアクセストークンの形式かを判定する
+func IsAccessTokenSecret(secret string) bool {
+	return strings.HasPrefix(secret, AccessTokenPrefix) && len(secret) > len(AccessTokenPrefix)
+}
+
+// HashAccessTokenSecret はアクセストークンを保存・照合するためのSHA-256ハッシュを返す
+// トークンは十分な長さの乱数のため、ソルトを付与せずに照合用のキーとして使用する
+func HashAccessTokenSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// AccessToken はブラウザでのOAuth認証を使えない環境向けに発行する長期間有効なトークン
+// トークン自体は保持せず、ハッシュのみを保持する
+type AccessToken struct {
+	id           AccessTokenID
+	name         string
+	createdBy    string
+	repositories []*RepositoryIdentifier
+	operations   []Operation
+	tokenHash    string
+	expiresAt    time.Time
+	lastUsedAt   *time.Time
+	createdAt    time.Time
+}
+
+// NewAccessToken は発行者とスコープを指定してアクセストークンを生成する
+// 有効期限は現在時刻にlifetimeを加えた時刻とする
+func NewAccessToken(
+	ctx context.Context,
+	name, createdBy string,
+	repositories []*RepositoryIdentifier,
+	operations []Operation,
+	tokenHash string,
+	lifetime time.Duration,
+) (*AccessToken, error) {
+	if name == "" {
+		return nil, ErrEmptyAccessTokenName
+	}
+	if createdBy == "" {
+		return nil, ErrEmptySub
+	}
+	if len(repositories) == 0 {
+		return nil, ErrEmptyAccessTokenRepositories
+	}
+	for _, repository := range repositories {
+		if repository == nil {
+			return nil, ErrInvalidRepositoryIdentifier
+		}
+	}
+	if len(operations) == 0 {
+		return nil, ErrEmptyAccessTokenOperations
+	}
+	for _, operation := range operations {
+		if operation.IsZero() {
+			return nil, ErrInvalidOperation
+		}
+	}
+	if tokenHash == "" {
+		return nil, ErrInvalidAccessTokenHash
+	}
+	if lifetime <= 0 || lifetime > MaxAccessTokenLifetime {
+		return nil, ErrInvalidAccessTokenLifetime
+	}
+
+	now := ctxtime.Now(ctx)
+	return &AccessToken{
+		name:         name,
+		createdBy:    createdBy,
+		repositories: repositories,
+		operations:   uniqueOperations(operations),
+		tokenHash:    tokenHash,
+		expiresAt:    now.Add(lifetime),
+		createdAt:    now,
+	}, nil
+}
+
+func ReconstructAccessToken(
+	id AccessTokenID,
+	name, createdBy string,
+	repositories []*RepositoryIdentifier,
+	operations []Operation,
+	tokenHash string,
+	expiresAt time.Time,
+	lastUsedAt *time.Time,
+	createdAt time.Time,
+) *AccessToken {
+	return &AccessToken{
+		id:           id,
+		name:         name,
+		createdBy:    createdBy,
+		repositories: repositories,
+		operations:   operations,
+		tokenHash:    tokenHash,
+		expiresAt:    expiresAt,
+		lastUsedAt:   lastUsedAt,
+		createdAt:    createdAt,
+	}
+}
+
+func (t *AccessToken) ID() AccessTokenID {
+	return t.id
+}
+
+func (t *AccessToken) Name() string {
+	return t.name
+}
+
+// CreatedBy はトークンを発行したユーザーまたは管理者のsubject
+func (t *AccessToken) CreatedBy() string {
+	return t.createdBy
+}
+
+// Subject はトークンで認証したユーザーを識別するsubject
+// 同じ発行者のトークンでもロックの所有者やレート制限を共有しないよう、トークンごとに異なる値とする
+func (t *AccessToken) Subject() string {
+	return AccessTokenSubjectPrefix + t.id.String()
+}
+
+func (t *AccessToken) Repositories() []*RepositoryIdentifier {
+	return t.repositories
+}
+
+func (t *AccessToken) Operations() []Operation {
+	return t.operations
+}
+
+func (t *AccessToken) TokenHash() string {
+	return t.tokenHash
+}
+
+func (t *AccessToken) ExpiresAt() time.Time {
+	return t.expiresAt
+}
+
+// LastUsedAt は最後に認証に使用された時刻。一度も使用されていない場合はnilを返す
+func (t *AccessToken) LastUsedAt() *time.Time {
+	return t.lastUsedAt
+}
+
+func (t *AccessToken) CreatedAt() time.Time {
+	return t.createdAt
+}
+
+func (t *AccessToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.expiresAt)
+}
+
+// CanAccess はリポジトリがトークンのスコープに含まれるかを判定する
+func (t *AccessToken) CanAccess(repository *RepositoryIdentifier) bool {
+	if repository == nil {
+		return false
+	}
+	return slices.ContainsFunc(t.repositories, repository.EqualsFold)
+}
+
+// Permissions は許可されたオペレーションに対応する権限を返す
+// uploadを許可したトークンは、Git LFSがアップロード前に行うダウンロードも実行できる
+func (t *AccessToken) Permissions() RepositoryPermissions {
+	push := slices.Contains(t.operations, OperationUpload)
+	pull := push || slices.Contains(t.operations, OperationDownload)
+	return NewRepositoryPermissions(false, push, pull, false, false)
+}
+
+func uniqueOperations(operations []Operation) []Operation {
+	result := make([]Operation, 0, len(operations))
+	for _, operation := range operations {
+		if !slices.Contains(result, operation) {
+			result = append(result, operation)
+		}
+	}
+	return result
+}
@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"errors"
+	"strconv"
+)
+
+var ErrInvalidAccessTokenID = errors.New("AccessTokenID must be a positive integer")
+
+type AccessTokenID struct {
+	value int64
+}
+
+func NewAccessTokenID(value int64) (AccessTokenID, error) {
+	if value <= 0 {
+		return AccessTokenID{}, ErrInvalidAccessTokenID
+	}
+	return AccessTokenID{value: value}, nil
+}
+
+func ParseAccessTokenID(s string) (AccessTokenID, error) {
+	value, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return AccessTokenID{}, ErrInvalidAccessTokenID
+	}
+	return NewAccessTokenID(value)
+}
+
+func (id AccessTokenID) Int64() int64 {
+	return id.value
+}
+
+func (id AccessTokenID) String() string {
+	return strconv.FormatInt(id.value, 10)
+}
+
+func (id AccessTokenID) IsZero() bool {
+	return id.value == 0
+}
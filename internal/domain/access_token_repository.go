@@ -0,0 +1,25 @@
+//go:generate mockgen -source=$GOFILE -destination=../../tests/domain/mock_access_token_repository.go -package=domain
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type AccessTokenRepository interface {
+	// FindByHash はトークンのハッシュに一致するトークンを返す。存在しない場合はErrNotFoundを返す
+	FindByHash(ctx context.Context, tokenHash string) (*AccessToken, error)
+	// FindByID はIDに一致するトークンを返す。存在しない場合はErrNotFoundを返す
+	FindByID(ctx context.Context, id AccessTokenID) (*AccessToken, error)
+	// List はすべてのトークンを発行日時の新しい順に返す
+	List(ctx context.Context) ([]*AccessToken, error)
+	// ListByCreator は発行者が発行したトークンを発行日時の新しい順に返す
+	ListByCreator(ctx context.Context, createdBy string) ([]*AccessToken, error)
+	Create(ctx context.Context, token *AccessToken) (*AccessToken, error)
+	// UpdateLastUsedAt はトークンの最終使用日時を更新する。存在しない場合はErrNotFoundを返す
+	UpdateLastUsedAt(ctx context.Context, id AccessTokenID, usedAt time.Time) error
+	// Delete はトークンを削除する。存在しない場合はErrNotFoundを返す
+	Delete(ctx context.Context, id AccessTokenID) error
+	// DeleteByCreator は発行者が発行したトークンをすべて削除し、削除した件数を返す
+	DeleteByCreator(ctx context.Context, createdBy string) (int, error)
+}
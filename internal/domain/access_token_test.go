@@ -0,0 +1,261 @@
+package domain_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/newmo-oss/ctxtime/ctxtimetest"
+	"github.com/newmo-oss/testid"
+)
+
+func mustNewRepositoryIdentifiers(t *testing.T, fullNames ...string) []*domain.RepositoryIdentifier {
+	t.Helper()
+
+	repositories := make([]*domain.RepositoryIdentifier, len(fullNames))
+	for i, fullName := range fullNames {
+		repo, err := domain.NewRepositoryIdentifier(fullName)
+		if err != nil {
+			t.Fatalf("NewRepositoryIdentifier() failed: %v", err)
+		}
+		repositories[i] = repo
+	}
+	return repositories
+}
+
+func TestNewAccessToken(t *testing.T) {
+	fixedNow := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	repositories := mustNewRepositoryIdentifiers(t, "owner/repo")
+
+	tests := []struct {
+		name           string
+		tokenName      string
+		createdBy      string
+		repositories   []*domain.RepositoryIdentifier
+		operations     []domain.Operation
+		lifetime       time.Duration
+		wantOperations []string
+		wantExpiresAt  time.Time
+		wantErr        error
+	}{
+		{
+			name:           "正常系: 現在時刻に有効期間を加えた有効期限で作成できる",
+			tokenName:      "build-farm",
+			createdBy:      "12345",
+			repositories:   repositories,
+			operations:     []domain.Operation{domain.OperationDownload, domain.OperationDownload},
+			lifetime:       30 * 24 * time.Hour,
+			wantOperations: []string{"download"},
+			wantExpiresAt:  fixedNow.Add(30 * 24 * time.Hour),
+		},
+		{
+			name:         "異常系: 名前が空の場合はエラー",
+			createdBy:    "12345",
+			repositories: repositories,
+			operations:   []domain.Operation{domain.OperationDownload},
+			lifetime:     time.Hour,
+			wantErr:      domain.ErrEmptyAccessTokenName,
+		},
+		{
+			name:         "異常系: 発行者が空の場合はエラー",
+			tokenName:    "build-farm",
+			repositories: repositories,
+			operations:   []domain.Operation{domain.OperationDownload},
+			lifetime:     time.Hour,
+			wantErr:      domain.ErrEmptySub,
+		},
+		{
+			name:       "異常系: リポジトリが空の場合はエラー",
+			tokenName:  "build-farm",
+			createdBy:  "12345",
+			operations: []domain.Operation{domain.OperationDownload},
+			lifetime:   time.Hour,
+			wantErr:    domain.ErrEmptyAccessTokenRepositories,
+		},
+		{
+			name:         "異常系: オペレーションが空の場合はエラー",
+			tokenName:    "build-farm",
+			createdBy:    "12345",
+			repositories: repositories,
+			lifetime:     time.Hour,
+			wantErr:      domain.ErrEmptyAccessTokenOperations,
+		},
+		{
+			name:         "異常系: 有効期間が上限を超える場合はエラー",
+			tokenName:    "build-farm",
+			createdBy:    "12345",
+			repositories: repositories,
+			operations:   []domain.Operation{domain.OperationDownload},
+			lifetime:     domain.MaxAccessTokenLifetime + time.Second,
+			wantErr:      domain.ErrInvalidAccessTokenLifetime,
+		},
+		{
+			name:         "異常系: 有効期間が0の場合はエラー",
+			tokenName:    "build-farm",
+			createdBy:    "12345",
+			repositories: repositories,
+			operations:   []domain.Operation{domain.OperationDownload},
+			wantErr:      domain.ErrInvalidAccessTokenLifetime,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := testid.WithValue(context.Background(), t.Name())
+			ctxtimetest.SetFixedNow(t, ctx, fixedNow)
+
+			got, err := domain.NewAccessToken(ctx, tt.tokenName, tt.createdBy, tt.repositories, tt.operations, "hash", tt.lifetime)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NewAccessToken() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			gotOperations := make([]string, len(got.Operations()))
+			for i, op := range got.Operations() {
+				gotOperations[i] = op.String()
+			}
+			if diff := cmp.Diff(tt.wantOperations, gotOperations); diff != "" {
+				t.Errorf("Operations() mismatch (-want +got):\n%s", diff)
+			}
+			if !got.ExpiresAt().Equal(tt.wantExpiresAt) {
+				t.Errorf("ExpiresAt() = %v, want %v", got.ExpiresAt(), tt.wantExpiresAt)
+			}
+			if !got.CreatedAt().Equal(fixedNow) {
+				t.Errorf("CreatedAt() = %v, want %v", got.CreatedAt(), fixedNow)
+			}
+			if got.LastUsedAt() != nil {
+				t.Errorf("LastUsedAt() = %v, want nil", got.LastUsedAt())
+			}
+		})
+	}
+}
+
+func TestAccessToken_CanAccess(t *testing.T) {
+	token := domain.ReconstructAccessToken(domain.AccessTokenID{}, "build-farm", "12345",
+		mustNewRepositoryIdentifiers(t, "owner/repo", "owner/other"),
+		[]domain.Operation{domain.OperationDownload}, "hash", time.Time{}, nil, time.Time{})
+
+	tests := []struct {
+		name       string
+		repository *domain.RepositoryIdentifier
+		want       bool
+	}{
+		{
+			name:       "正常系: スコープに含まれるリポジトリの場合はtrue",
+			repository: mustNewRepositoryIdentifiers(t, "owner/other")[0],
+			want:       true,
+		},
+		{
+			name:       "正常系: 大文字小文字が異なるリポジトリの場合はtrue",
+			repository: mustNewRepositoryIdentifiers(t, "Owner/Repo")[0],
+			want:       true,
+		},
+		{
+			name:       "正常系: スコープに含まれないリポジトリの場合はfalse",
+			repository: mustNewRepositoryIdentifiers(t, "owner/secret")[0],
+			want:       false,
+		},
+		{
+			name: "正常系: リポジトリがnilの場合はfalse",
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := token.CanAccess(tt.repository); got != tt.want {
+				t.Errorf("CanAccess() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAccessToken_Permissions(t *testing.T) {
+	tests := []struct {
+		name         string
+		operations   []domain.Operation
+		wantUpload   bool
+		wantDownload bool
+	}{
+		{
+			name:         "正常系: downloadのみの場合はダウンロードのみ許可される",
+			operations:   []domain.Operation{domain.OperationDownload},
+			wantDownload: true,
+		},
+		{
+			name:         "正常系: uploadを含む場合はアップロードとダウンロードが許可される",
+			operations:   []domain.Operation{domain.OperationUpload},
+			wantUpload:   true,
+			wantDownload: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token := domain.ReconstructAccessToken(domain.AccessTokenID{}, "build-farm", "12345",
+				mustNewRepositoryIdentifiers(t, "owner/repo"), tt.operations, "hash", time.Time{}, nil, time.Time{})
+
+			got := token.Permissions()
+			if got.CanUpload() != tt.wantUpload {
+				t.Errorf("CanUpload() = %v, want %v", got.CanUpload(), tt.wantUpload)
+			}
+			if got.CanDownload() != tt.wantDownload {
+				t.Errorf("CanDownload() = %v, want %v", got.CanDownload(), tt.wantDownload)
+			}
+			if got.CanForceUnlock() {
+				t.Error("CanForceUnlock() = true, want false")
+			}
+		})
+	}
+}
+
+func TestAccessToken_IsExpired(t *testing.T) {
+	expiresAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	token := domain.ReconstructAccessToken(domain.AccessTokenID{}, "build-farm", "12345",
+		mustNewRepositoryIdentifiers(t, "owner/repo"), []domain.Operation{domain.OperationDownload}, "hash", expiresAt, nil, time.Time{})
+
+	if token.IsExpired(expiresAt.Add(-time.Second)) {
+		t.Error("IsExpired() before expiry = true, want false")
+	}
+	if !token.IsExpired(expiresAt) {
+		t.Error("IsExpired() at expiry = false, want true")
+	}
+}
+
+func TestIsAccessTokenSecret(t *testing.T) {
+	tests := []struct {
+		name   string
+		secret string
+		want   bool
+	}{
+		{name: "正常系: 接頭辞を持つ場合はtrue", secret: "cht_abc", want: true},
+		{name: "正常系: 接頭辞のみの場合はfalse", secret: "cht_", want: false},
+		{name: "正常系: JWTの場合はfalse", secret: "eyJhbGciOiJSUzI1NiJ9.e30.sig", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := domain.IsAccessTokenSecret(tt.secret); got != tt.want {
+				t.Errorf("IsAccessTokenSecret() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHashAccessTokenSecret(t *testing.T) {
+	got := domain.HashAccessTokenSecret("cht_secret")
+	if len(got) != 64 {
+		t.Errorf("HashAccessTokenSecret() length = %d, want 64", len(got))
+	}
+	if got != domain.HashAccessTokenSecret("cht_secret") {
+		t.Error("HashAccessTokenSecret() is not deterministic")
+	}
+	if got == domain.HashAccessTokenSecret("cht_other") {
+		t.Error("HashAccessTokenSecret() returned the same hash for different secrets")
+	}
+}
@@ -0,0 +1,194 @@
+package domain
+
+import (
+	"net/http"
+	"time"
+)
+
+// AuditAction は監査ログに記録する操作の種類
+type AuditAction struct {
+	value string
+}
+
+var (
+	AuditActionBatch         = AuditAction{value: "batch"}
+	AuditActionBatchUpload   = AuditAction{value: "batch_upload"}
+	AuditActionBatchDownload = AuditAction{value: "batch_download"}
+	AuditActionUpload        = AuditAction{value: "upload"}
+	AuditActionDownload      = AuditAction{value: "download"}
+	AuditActionVerify        = AuditAction{value: "verify"}
+	AuditActionAuthenticate  = AuditAction{value: "authenticate"}
+
+	AuditActionLockCreate      = AuditAction{value: "lock_create"}
+	AuditActionLockUnlock      = AuditAction{value: "lock_unlock"}
+	AuditActionLockForceUnlock = AuditAction{value: "lock_force_unlock"}
+
+	AuditActionTokenIssue  = AuditAction{value: "token_issue"}
+	AuditActionTokenRevoke = AuditAction{value: "token_revoke"}
+
+	AuditActionAdminAllowlistAdd    = AuditAction{value: "admin_allowlist_add"}
+	AuditActionAdminAllowlistRemove = AuditAction{value: "admin_allowlist_remove"}
+	AuditActionAdminClaimRuleAdd    = AuditAction{value: "admin_claim_rule_add"}
+	AuditActionAdminClaimRuleRemove = AuditAction{value: "admin_claim_rule_remove"}
+	AuditActionAdminTokenIssue      = AuditAction{value: "admin_token_issue"}
+	AuditActionAdminTokenRevoke     = AuditAction{value: "admin_token_revoke"}
+	AuditActionAdminSessionRevoke   = AuditAction{value: "admin_session_revoke"}
+	AuditActionAdminObjectDelete    = AuditAction{value: "admin_object_delete"}
+	AuditActionAdminCacheFlush      = AuditAction{value: "admin_cache_flush"}
+)
+
+func (a AuditAction) String() string {
+	return a.value
+}
+
+func (a AuditAction) IsZero() bool {
+	return a.value == ""
+}
+
+// AuditOutcome は監査対象の操作の結果
+type AuditOutcome struct {
+	value string
+}
+
+var (
+	AuditOutcomeSuccess = AuditOutcome{value: "success"}
+	AuditOutcomeDenied  = AuditOutcome{value: "denied"}
+	AuditOutcomeFailure = AuditOutcome{value: "failure"}
+)
+
+// NewAuditOutcomeFromStatus はHTTPステータスコードから操作の結果を判定する
+// 401と403は認証・認可による拒否、それ以外の4xx・5xxは失敗として扱う
+func NewAuditOutcomeFromStatus(statusCode int) AuditOutcome {
+	switch {
+	case statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden:
+		return AuditOutcomeDenied
+	case statusCode >= http.StatusBadRequest:
+		return AuditOutcomeFailure
+	default:
+		return AuditOutcomeSuccess
+	}
+}
+
+func (o AuditOutcome) String() string {
+	return o.value
+}
+
+// AuditEventParams は監査イベントの生成に用いる値
+// UserInfoは認証に失敗した場合nilとなる
+// Targetにはロックのパスやアクセストークンのidなど、オブジェクト以外の操作対象を指定する
+type AuditEventParams struct {
+	OccurredAt time.Time
+	Action     AuditAction
+	StatusCode int
+	UserInfo   *UserInfo
+	Repository string
+	OID        string
+	Size       int64
+	Target     string
+	ClientIP   string
+	RequestID  string
+}
+
+// AuditEvent はオブジェクトへのアクセスや認証失敗を記録する監査イベント
+type AuditEvent struct {
+	occurredAt time.Time
+	action     AuditAction
+	outcome    AuditOutcome
+	statusCode int
+	subject    string
+	actorName  string
+	createdBy  string
+	provider   string
+	repository string
+	ref        string
+	oid        string
+	size       int64
+	target     string
+	clientIP   string
+	requestID  string
+}
+
+func NewAuditEvent(params AuditEventParams) *AuditEvent {
+	event := &AuditEvent{
+		occurredAt: params.OccurredAt,
+		action:     params.Action,
+		outcome:    NewAuditOutcomeFromStatus(params.StatusCode),
+		statusCode: params.StatusCode,
+		repository: params.Repository,
+		oid:        params.OID,
+		size:       params.Size,
+		target:     params.Target,
+		clientIP:   params.ClientIP,
+		requestID:  params.RequestID,
+	}
+	if params.UserInfo != nil {
+		event.subject = params.UserInfo.Sub()
+		event.actorName = params.UserInfo.Name()
+		event.createdBy = params.UserInfo.CreatedBy()
+		event.provider = params.UserInfo.Provider().String()
+		event.ref = params.UserInfo.Ref()
+	}
+	return event
+}
+
+func (e *AuditEvent) OccurredAt() time.Time {
+	return e.occurredAt
+}
+
+func (e *AuditEvent) Action() AuditAction {
+	return e.action
+}
+
+func (e *AuditEvent) Outcome() AuditOutcome {
+	return e.outcome
+}
+
+func (e *AuditEvent) StatusCode() int {
+	return e.statusCode
+}
+
+func (e *AuditEvent) Subject() string {
+	return e.subject
+}
+
+func (e *AuditEvent) ActorName() string {
+	return e.actorName
+}
+
+// CreatedBy はアクセストークンで認証した場合のトークンの発行者
+func (e *AuditEvent) CreatedBy() string {
+	return e.createdBy
+}
+
+func (e *AuditEvent) Provider() string {
+	return e.provider
+}
+
+func (e *AuditEvent) Repository() string {
+	return e.repository
+}
+
+func (e *AuditEvent) Ref() string {
+	return e.ref
+}
+
+func (e *AuditEvent) OID() string {
+	return e.oid
+}
+
+func (e *AuditEvent) Size() int64 {
+	return e.size
+}
+
+// Target はロックのパスやアクセストークンのIDなど、オブジェクト以外の操作対象
+func (e *AuditEvent) Target() string {
+	return e.target
+}
+
+func (e *AuditEvent) ClientIP() string {
+	return e.clientIP
+}
+
+func (e *AuditEvent) RequestID() string {
+	return e.requestID
+}
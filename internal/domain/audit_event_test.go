@@ -0,0 +1,189 @@
+package domain_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/na2na-p/cargohold/internal/domain"
+)
+
+func TestNewAuditOutcomeFromStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		want       domain.AuditOutcome
+	}{
+		{name: "正常系: 2xxの場合はsuccess", statusCode: http.StatusOK, want: domain.AuditOutcomeSuccess},
+		{name: "正常系: 401の場合はdenied", statusCode: http.StatusUnauthorized, want: domain.AuditOutcomeDenied},
+		{name: "正常系: 403の場合はdenied", statusCode: http.StatusForbidden, want: domain.AuditOutcomeDenied},
+		{name: "正常系: 404の場合はfailure", statusCode: http.StatusNotFound, want: domain.AuditOutcomeFailure},
+		{name: "正常系: 5xxの場合はfailure", statusCode: http.StatusInternalServerError, want: domain.AuditOutcomeFailure},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := domain.NewAuditOutcomeFromStatus(tt.statusCode)
+			if diff := cmp.Diff(tt.want.String(), got.String()); diff != "" {
+				t.Errorf("NewAuditOutcomeFromStatus() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewAuditEvent(t *testing.T) {
+	occurredAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	repo, _ := domain.NewRepositoryIdentifier("owner/repo")
+	userInfo, err := domain.NewUserInfo("repo:owner/repo:ref:refs/heads/main", "", "octocat", domain.ProviderTypeGitHub, repo, "refs/heads/main")
+	if err != nil {
+		t.Fatalf("NewUserInfo() failed: %v", err)
+	}
+	tokenUserInfo, err := domain.NewUserInfo("token:42", "", "build-farm", domain.ProviderTypeAccessToken, repo, "")
+	if err != nil {
+		t.Fatalf("NewUserInfo() failed: %v", err)
+	}
+	tokenUserInfo.SetCreatedBy("12345")
+
+	type eventFields struct {
+		Action     string
+		Outcome    string
+		StatusCode int
+		Subject    string
+		ActorName  string
+		CreatedBy  string
+		Provider   string
+		Repository string
+		Ref        string
+		OID        string
+		Size       int64
+		Target     string
+		ClientIP   string
+	}
+	tests := []struct {
+		name   string
+		params domain.AuditEventParams
+		want   eventFields
+	}{
+		{
+			name: "正常系: 認証済みユーザーの情報が記録される",
+			params: domain.AuditEventParams{
+				OccurredAt: occurredAt,
+				Action:     domain.AuditActionDownload,
+				StatusCode: http.StatusOK,
+				UserInfo:   userInfo,
+				Repository: "owner/repo",
+				OID:        "abc",
+				Size:       1024,
+				ClientIP:   "192.0.2.1",
+			},
+			want: eventFields{
+				Action:     "download",
+				Outcome:    "success",
+				StatusCode: http.StatusOK,
+				Subject:    "repo:owner/repo:ref:refs/heads/main",
+				ActorName:  "octocat",
+				Provider:   "github",
+				Repository: "owner/repo",
+				Ref:        "refs/heads/main",
+				OID:        "abc",
+				Size:       1024,
+				ClientIP:   "192.0.2.1",
+			},
+		},
+		{
+			name: "正常系: アクセストークンで認証した場合はトークンのsubjectと発行者が記録される",
+			params: domain.AuditEventParams{
+				OccurredAt: occurredAt,
+				Action:     domain.AuditActionUpload,
+				StatusCode: http.StatusOK,
+				UserInfo:   tokenUserInfo,
+				Repository: "owner/repo",
+				OID:        "abc",
+				Size:       1024,
+				ClientIP:   "192.0.2.1",
+			},
+			want: eventFields{
+				Action:     "upload",
+				Outcome:    "success",
+				StatusCode: http.StatusOK,
+				Subject:    "token:42",
+				ActorName:  "build-farm",
+				CreatedBy:  "12345",
+				Provider:   "access_token",
+				Repository: "owner/repo",
+				OID:        "abc",
+				Size:       1024,
+				ClientIP:   "192.0.2.1",
+			},
+		},
+		{
+			name: "正常系: ロックの操作はロックのパスが操作対象として記録される",
+			params: domain.AuditEventParams{
+				OccurredAt: occurredAt,
+				Action:     domain.AuditActionLockCreate,
+				StatusCode: http.StatusCreated,
+				UserInfo:   userInfo,
+				Repository: "owner/repo",
+				Target:     "assets/model.bin",
+				ClientIP:   "192.0.2.1",
+			},
+			want: eventFields{
+				Action:     "lock_create",
+				Outcome:    "success",
+				StatusCode: http.StatusCreated,
+				Subject:    "repo:owner/repo:ref:refs/heads/main",
+				ActorName:  "octocat",
+				Provider:   "github",
+				Repository: "owner/repo",
+				Ref:        "refs/heads/main",
+				Target:     "assets/model.bin",
+				ClientIP:   "192.0.2.1",
+			},
+		},
+		{
+			name: "正常系: 認証に失敗した場合はユーザー情報を空で記録する",
+			params: domain.AuditEventParams{
+				OccurredAt: occurredAt,
+				Action:     domain.AuditActionAuthenticate,
+				StatusCode: http.StatusUnauthorized,
+				Repository: "owner/repo",
+				ClientIP:   "192.0.2.1",
+			},
+			want: eventFields{
+				Action:     "authenticate",
+				Outcome:    "denied",
+				StatusCode: http.StatusUnauthorized,
+				Repository: "owner/repo",
+				ClientIP:   "192.0.2.1",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := domain.NewAuditEvent(tt.params)
+			got := eventFields{
+				Action:     event.Action().String(),
+				Outcome:    event.Outcome().String(),
+				StatusCode: event.StatusCode(),
+				Subject:    event.Subject(),
+				ActorName:  event.ActorName(),
+				CreatedBy:  event.CreatedBy(),
+				Provider:   event.Provider(),
+				Repository: event.Repository(),
+				Ref:        event.Ref(),
+				OID:        event.OID(),
+				Size:       event.Size(),
+				Target:     event.Target(),
+				ClientIP:   event.ClientIP(),
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("NewAuditEvent() mismatch (-want +got):\n%s", diff)
+			}
+			if !event.OccurredAt().Equal(occurredAt) {
+				t.Errorf("OccurredAt() = %v, want %v", event.OccurredAt(), occurredAt)
+			}
+		})
+	}
+}
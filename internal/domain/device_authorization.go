@@ -0,0 +1,24 @@
+package domain
+
+// DeviceAuthorization はGitHubのデバイスフローでユーザーの承認を待っているリクエストを表す
+type DeviceAuthorization struct {
+	deviceCode string
+	repository string
+}
+
+// NewDeviceAuthorization はGitHubが発行したデバイスコードと、ログイン対象のリポジトリから生成する
+func NewDeviceAuthorization(deviceCode, repository string) *DeviceAuthorization {
+	return &DeviceAuthorization{
+		deviceCode: deviceCode,
+		repository: repository,
+	}
+}
+
+// DeviceCode はGitHubが発行したデバイスコードを返す。クライアントには公開しない
+func (d *DeviceAuthorization) DeviceCode() string {
+	return d.deviceCode
+}
+
+func (d *DeviceAuthorization) Repository() string {
+	return d.repository
+}
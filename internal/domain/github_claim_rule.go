@@ -0,0 +1,206 @@
+package domain
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/newmo-oss/ctxtime"
+)
+
+var ErrInvalidClaimPattern = errors.New("claim pattern must not be empty")
+
+// claimPattern はクレームの値と照合するパターン
+// "*" は "/" を含む任意の文字列に一致する
+type claimPattern struct {
+	pattern string
+	re      *regexp.Regexp
+}
+
+func newClaimPattern(pattern string) (claimPattern, error) {
+	if pattern == "" {
+		return claimPattern{}, ErrInvalidClaimPattern
+	}
+	parts := strings.Split(pattern, "*")
+	for i, part := range parts {
+		parts[i] = regexp.QuoteMeta(part)
+	}
+	return claimPattern{
+		pattern: pattern,
+		re:      regexp.MustCompile("^" + strings.Join(parts, ".*") + "$"),
+	}, nil
+}
+
+func newClaimPatterns(patterns []string) ([]claimPattern, error) {
+	result := make([]claimPattern, 0, len(patterns))
+	for _, pattern := range patterns {
+		p, err := newClaimPattern(pattern)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, p)
+	}
+	return result, nil
+}
+
+// matchAny はパターンが空の場合、またはいずれかのパターンに値が一致する場合にtrueを返す
+func matchAny(patterns []claimPattern, value string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, p := range patterns {
+		if p.re.MatchString(value) {
+			return true
+		}
+	}
+	return false
+}
+
+func claimPatternStrings(patterns []claimPattern) []string {
+	result := make([]string, len(patterns))
+	for i, p := range patterns {
+		result[i] = p.pattern
+	}
+	return result
+}
+
+// GitHubClaimCondition はGitHub ActionsのOIDCトークンのクレームに対する条件
+// 項目ごとにいずれかのパターンに一致し、かつすべての項目を満たす場合に一致とする。空の項目は任意の値に一致する
+type GitHubClaimCondition struct {
+	refs            []claimPattern
+	eventNames      []claimPattern
+	environments    []claimPattern
+	jobWorkflowRefs []claimPattern
+}
+
+func NewGitHubClaimCondition(refs, eventNames, environments, jobWorkflowRefs []string) (GitHubClaimCondition, error) {
+	var (
+		c   GitHubClaimCondition
+		err error
+	)
+	if c.refs, err = newClaimPatterns(refs); err != nil {
+		return GitHubClaimCondition{}, err
+	}
+	if c.eventNames, err = newClaimPatterns(eventNames); err != nil {
+		return GitHubClaimCondition{}, err
+	}
+	if c.environments, err = newClaimPatterns(environments); err != nil {
+		return GitHubClaimCondition{}, err
+	}
+	if c.jobWorkflowRefs, err = newClaimPatterns(jobWorkflowRefs); err != nil {
+		return GitHubClaimCondition{}, err
+	}
+	return c, nil
+}
+
+func (c GitHubClaimCondition) Refs() []string {
+	return claimPatternStrings(c.refs)
+}
+
+func (c GitHubClaimCondition) EventNames() []string {
+	return claimPatternStrings(c.eventNames)
+}
+
+func (c GitHubClaimCondition) Environments() []string {
+	return claimPatternStrings(c.environments)
+}
+
+func (c GitHubClaimCondition) JobWorkflowRefs() []string {
+	return claimPatternStrings(c.jobWorkflowRefs)
+}
+
+// Matches はトークンのクレームが条件を満たすかを判定する
+func (c GitHubClaimCondition) Matches(info *GitHubUserInfo) bool {
+	return matchAny(c.refs, info.Ref()) &&
+		matchAny(c.eventNames, info.EventName()) &&
+		matchAny(c.environments, info.Environment()) &&
+		matchAny(c.jobWorkflowRefs, info.JobWorkflowRef())
+}
+
+// GitHubClaimRule はリポジトリごとに、条件に一致したGitHub Actionsのトークンへ与える権限を定めるルール
+type GitHubClaimRule struct {
+	id         GitHubClaimRuleID
+	repository *RepositoryIdentifier
+	priority   int
+	condition  GitHubClaimCondition
+	access     RepositoryAccessLevel
+	createdAt  time.Time
+}
+
+func NewGitHubClaimRule(ctx context.Context, repository *RepositoryIdentifier, priority int, condition GitHubClaimCondition, access RepositoryAccessLevel) (*GitHubClaimRule, error) {
+	if repository == nil {
+		return nil, ErrInvalidRepositoryIdentifier
+	}
+	if _, err := NewRepositoryAccessLevel(access.String()); err != nil {
+		return nil, err
+	}
+	return &GitHubClaimRule{
+		repository: repository,
+		priority:   priority,
+		condition:  condition,
+		access:     access,
+		createdAt:  ctxtime.Now(ctx),
+	}, nil
+}
+
+func ReconstructGitHubClaimRule(id GitHubClaimRuleID, repository *RepositoryIdentifier, priority int, condition GitHubClaimCondition, access RepositoryAccessLevel, createdAt time.Time) *GitHubClaimRule {
+	return &GitHubClaimRule{
+		id:         id,
+		repository: repository,
+		priority:   priority,
+		condition:  condition,
+		access:     access,
+		createdAt:  createdAt,
+	}
+}
+
+func (r *GitHubClaimRule) ID() GitHubClaimRuleID {
+	return r.id
+}
+
+func (r *GitHubClaimRule) Repository() *RepositoryIdentifier {
+	return r.repository
+}
+
+// Priority はルールを評価する順序。値の小さいルールから評価する
+func (r *GitHubClaimRule) Priority() int {
+	return r.priority
+}
+
+func (r *GitHubClaimRule) Condition() GitHubClaimCondition {
+	return r.condition
+}
+
+func (r *GitHubClaimRule) Access() RepositoryAccessLevel {
+	return r.access
+}
+
+func (r *GitHubClaimRule) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+// EvaluateGitHubClaimRules はpriorityの小さい順にルールを評価し、最初に一致したルールの権限を返す
+// ルールが1件もないリポジトリはすべての操作を許可し、いずれのルールにも一致しない場合は権限を与えない
+func EvaluateGitHubClaimRules(rules []*GitHubClaimRule, info *GitHubUserInfo) RepositoryPermissions {
+	if len(rules) == 0 {
+		return RepositoryAccessLevelAdmin.Permissions()
+	}
+
+	sorted := slices.Clone(rules)
+	slices.SortStableFunc(sorted, func(a, b *GitHubClaimRule) int {
+		if c := cmp.Compare(a.priority, b.priority); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.id.value, b.id.value)
+	})
+	for _, rule := range sorted {
+		if rule.condition.Matches(info) {
+			return rule.access.Permissions()
+		}
+	}
+	return RepositoryAccessLevelNone.Permissions()
+}
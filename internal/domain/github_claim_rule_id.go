@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"errors"
+	"strconv"
+)
+
+var ErrInvalidGitHubClaimRuleID = errors.New("GitHubClaimRuleID must be a positive integer")
+
+type GitHubClaimRuleID struct {
+	value int64
+}
+
+func NewGitHubClaimRuleID(value int64) (GitHubClaimRuleID, error) {
+	if value <= 0 {
+		return GitHubClaimRuleID{}, ErrInvalidGitHubClaimRuleID
+	}
+	return GitHubClaimRuleID{value: value}, nil
+}
+
+func ParseGitHubClaimRuleID(s string) (GitHubClaimRuleID, error) {
+	value, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return GitHubClaimRuleID{}, ErrInvalidGitHubClaimRuleID
+	}
+	return NewGitHubClaimRuleID(value)
+}
+
+func (id GitHubClaimRuleID) Int64() int64 {
+	return id.value
+}
+
+func (id GitHubClaimRuleID) String() string {
+	return strconv.FormatInt(id.value, 10)
+}
+
+func (id GitHubClaimRuleID) IsZero() bool {
+	return id.value == 0
+}
@@ -0,0 +1,45 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/na2na-p/cargohold/internal/domain"
+)
+
+func TestParseGitHubClaimRuleID(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    int64
+		wantErr error
+	}{
+		{
+			name:  "正常系: 数値文字列をパースできる",
+			value: "42",
+			want:  42,
+		},
+		{
+			name:    "異常系: 0の場合はエラー",
+			value:   "0",
+			wantErr: domain.ErrInvalidGitHubClaimRuleID,
+		},
+		{
+			name:    "異常系: 数値以外の文字列はエラー",
+			value:   "abc",
+			wantErr: domain.ErrInvalidGitHubClaimRuleID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := domain.ParseGitHubClaimRuleID(tt.value)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseGitHubClaimRuleID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got.Int64() != tt.want {
+				t.Errorf("ParseGitHubClaimRuleID() = %v, want %v", got.Int64(), tt.want)
+			}
+		})
+	}
+}
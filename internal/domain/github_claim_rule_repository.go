@@ -0,0 +1,12 @@
+//go:generate mockgen -source=$GOFILE -destination=../../tests/domain/mock_github_claim_rule_repository.go -package=domain
+package domain
+
+import "context"
+
+type GitHubClaimRuleRepository interface {
+	// FindByRepository はリポジトリに設定されたルールをpriorityの小さい順に返す
+	FindByRepository(ctx context.Context, repository *RepositoryIdentifier) ([]*GitHubClaimRule, error)
+	Create(ctx context.Context, rule *GitHubClaimRule) (*GitHubClaimRule, error)
+	// Delete はルールを削除する。リポジトリにルールが存在しない場合はErrNotFoundを返す
+	Delete(ctx context.Context, repository *RepositoryIdentifier, id GitHubClaimRuleID) error
+}
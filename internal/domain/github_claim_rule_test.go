@@ -0,0 +1,251 @@
+package domain_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/newmo-oss/ctxtime/ctxtimetest"
+	"github.com/newmo-oss/testid"
+)
+
+func mustNewGitHubClaimRule(t *testing.T, id int64, priority int, condition domain.GitHubClaimCondition, access domain.RepositoryAccessLevel) *domain.GitHubClaimRule {
+	t.Helper()
+
+	ruleID, err := domain.NewGitHubClaimRuleID(id)
+	if err != nil {
+		t.Fatalf("NewGitHubClaimRuleID() failed: %v", err)
+	}
+	repo, err := domain.NewRepositoryIdentifier("owner/repo")
+	if err != nil {
+		t.Fatalf("NewRepositoryIdentifier() failed: %v", err)
+	}
+	return domain.ReconstructGitHubClaimRule(ruleID, repo, priority, condition, access, time.Time{})
+}
+
+func mustNewGitHubClaimCondition(t *testing.T, refs, eventNames, environments, jobWorkflowRefs []string) domain.GitHubClaimCondition {
+	t.Helper()
+
+	condition, err := domain.NewGitHubClaimCondition(refs, eventNames, environments, jobWorkflowRefs)
+	if err != nil {
+		t.Fatalf("NewGitHubClaimCondition() failed: %v", err)
+	}
+	return condition
+}
+
+func TestNewGitHubClaimCondition(t *testing.T) {
+	tests := []struct {
+		name    string
+		refs    []string
+		wantErr error
+	}{
+		{
+			name: "正常系: パターンを指定して作成できる",
+			refs: []string{"refs/heads/main", "refs/tags/*"},
+		},
+		{
+			name: "正常系: パターンを指定せずに作成できる",
+		},
+		{
+			name:    "異常系: 空のパターンはエラー",
+			refs:    []string{""},
+			wantErr: domain.ErrInvalidClaimPattern,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := domain.NewGitHubClaimCondition(tt.refs, nil, nil, nil)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NewGitHubClaimCondition() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if len(got.Refs()) != len(tt.refs) {
+				t.Errorf("Refs() = %v, want %v", got.Refs(), tt.refs)
+			}
+		})
+	}
+}
+
+func TestGitHubClaimCondition_Matches(t *testing.T) {
+	const reusable = "org/workflows/.github/workflows/release.yml@refs/heads/main"
+
+	tests := []struct {
+		name      string
+		condition domain.GitHubClaimCondition
+		info      *domain.GitHubUserInfo
+		want      bool
+	}{
+		{
+			name:      "正常系: 条件がない場合はすべてのトークンに一致する",
+			condition: mustNewGitHubClaimCondition(t, nil, nil, nil, nil),
+			info:      domain.NewGitHubUserInfoWithWorkflow("sub", "owner/repo", "refs/pull/1/merge", "actor", "pull_request", "", ""),
+			want:      true,
+		},
+		{
+			name:      "正常系: ワイルドカードは/を含む文字列に一致する",
+			condition: mustNewGitHubClaimCondition(t, []string{"refs/heads/main", "refs/tags/*"}, nil, nil, nil),
+			info:      domain.NewGitHubUserInfoWithWorkflow("sub", "owner/repo", "refs/tags/release/v1.0.0", "actor", "push", "", ""),
+			want:      true,
+		},
+		{
+			name:      "正常系: refがいずれのパターンにも一致しない場合は一致しない",
+			condition: mustNewGitHubClaimCondition(t, []string{"refs/heads/main", "refs/tags/*"}, nil, nil, nil),
+			info:      domain.NewGitHubUserInfoWithWorkflow("sub", "owner/repo", "refs/heads/feature", "actor", "push", "", ""),
+			want:      false,
+		},
+		{
+			name:      "正常系: パターン中の正規表現の記号は文字として扱われる",
+			condition: mustNewGitHubClaimCondition(t, nil, nil, nil, []string{"org/workflows/.github/workflows/release.yml@*"}),
+			info:      domain.NewGitHubUserInfoWithWorkflow("sub", "owner/repo", "refs/heads/main", "actor", "push", "", "org/workflows/.github/workflows/releaseXyml@refs/heads/main"),
+			want:      false,
+		},
+		{
+			name:      "正常系: すべての項目を満たす場合に一致する",
+			condition: mustNewGitHubClaimCondition(t, []string{"refs/heads/main"}, []string{"push"}, []string{"production"}, []string{reusable}),
+			info:      domain.NewGitHubUserInfoWithWorkflow("sub", "owner/repo", "refs/heads/main", "actor", "push", "production", reusable),
+			want:      true,
+		},
+		{
+			name:      "正常系: いずれかの項目を満たさない場合は一致しない",
+			condition: mustNewGitHubClaimCondition(t, []string{"refs/heads/main"}, []string{"push"}, []string{"production"}, nil),
+			info:      domain.NewGitHubUserInfoWithWorkflow("sub", "owner/repo", "refs/heads/main", "actor", "push", "", ""),
+			want:      false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.condition.Matches(tt.info); got != tt.want {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewGitHubClaimRule(t *testing.T) {
+	fixedNow := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	repo, err := domain.NewRepositoryIdentifier("owner/repo")
+	if err != nil {
+		t.Fatalf("NewRepositoryIdentifier() failed: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		repository *domain.RepositoryIdentifier
+		access     domain.RepositoryAccessLevel
+		wantErr    error
+	}{
+		{
+			name:       "正常系: 作成日時が現在時刻のルールが作成される",
+			repository: repo,
+			access:     domain.RepositoryAccessLevelRead,
+		},
+		{
+			name:    "異常系: リポジトリがnilの場合はエラー",
+			access:  domain.RepositoryAccessLevelRead,
+			wantErr: domain.ErrInvalidRepositoryIdentifier,
+		},
+		{
+			name:       "異常系: アクセスレベルが未設定の場合はエラー",
+			repository: repo,
+			wantErr:    domain.ErrInvalidRepositoryAccessLevel,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := testid.WithValue(context.Background(), t.Name())
+			ctxtimetest.SetFixedNow(t, ctx, fixedNow)
+
+			got, err := domain.NewGitHubClaimRule(ctx, tt.repository, 10, mustNewGitHubClaimCondition(t, nil, nil, nil, nil), tt.access)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NewGitHubClaimRule() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if !got.ID().IsZero() {
+				t.Errorf("ID() = %v, want zero", got.ID())
+			}
+			if got.Priority() != 10 {
+				t.Errorf("Priority() = %v, want 10", got.Priority())
+			}
+			if !got.CreatedAt().Equal(fixedNow) {
+				t.Errorf("CreatedAt() = %v, want %v", got.CreatedAt(), fixedNow)
+			}
+		})
+	}
+}
+
+func TestEvaluateGitHubClaimRules(t *testing.T) {
+	mainOrTags := mustNewGitHubClaimCondition(t, []string{"refs/heads/main", "refs/tags/*"}, nil, nil, nil)
+	pullRequest := mustNewGitHubClaimCondition(t, nil, []string{"pull_request", "pull_request_target"}, nil, nil)
+	anyClaims := mustNewGitHubClaimCondition(t, nil, nil, nil, nil)
+
+	tests := []struct {
+		name         string
+		rules        []*domain.GitHubClaimRule
+		info         *domain.GitHubUserInfo
+		wantUpload   bool
+		wantDownload bool
+	}{
+		{
+			name:         "正常系: ルールがない場合はすべての操作を許可する",
+			info:         domain.NewGitHubUserInfoWithWorkflow("sub", "owner/repo", "refs/heads/feature", "actor", "push", "", ""),
+			wantUpload:   true,
+			wantDownload: true,
+		},
+		{
+			name: "正常系: priorityの小さいルールが優先される",
+			rules: []*domain.GitHubClaimRule{
+				mustNewGitHubClaimRule(t, 1, 20, mainOrTags, domain.RepositoryAccessLevelWrite),
+				mustNewGitHubClaimRule(t, 2, 10, pullRequest, domain.RepositoryAccessLevelRead),
+			},
+			info:         domain.NewGitHubUserInfoWithWorkflow("sub", "owner/repo", "refs/heads/main", "actor", "pull_request", "", ""),
+			wantDownload: true,
+		},
+		{
+			name: "正常系: 先に評価したルールに一致しない場合は次のルールを評価する",
+			rules: []*domain.GitHubClaimRule{
+				mustNewGitHubClaimRule(t, 1, 10, pullRequest, domain.RepositoryAccessLevelRead),
+				mustNewGitHubClaimRule(t, 2, 20, mainOrTags, domain.RepositoryAccessLevelWrite),
+			},
+			info:         domain.NewGitHubUserInfoWithWorkflow("sub", "owner/repo", "refs/tags/v1.0.0", "actor", "push", "", ""),
+			wantUpload:   true,
+			wantDownload: true,
+		},
+		{
+			name: "正常系: priorityが同じ場合はIDの小さいルールが優先される",
+			rules: []*domain.GitHubClaimRule{
+				mustNewGitHubClaimRule(t, 2, 10, anyClaims, domain.RepositoryAccessLevelWrite),
+				mustNewGitHubClaimRule(t, 1, 10, anyClaims, domain.RepositoryAccessLevelRead),
+			},
+			info:         domain.NewGitHubUserInfoWithWorkflow("sub", "owner/repo", "refs/heads/main", "actor", "push", "", ""),
+			wantDownload: true,
+		},
+		{
+			name: "正常系: いずれのルールにも一致しない場合は権限を与えない",
+			rules: []*domain.GitHubClaimRule{
+				mustNewGitHubClaimRule(t, 1, 10, mainOrTags, domain.RepositoryAccessLevelWrite),
+			},
+			info: domain.NewGitHubUserInfoWithWorkflow("sub", "owner/repo", "refs/heads/feature", "actor", "push", "", ""),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := domain.EvaluateGitHubClaimRules(tt.rules, tt.info)
+			if got.CanUpload() != tt.wantUpload {
+				t.Errorf("CanUpload() = %v, want %v", got.CanUpload(), tt.wantUpload)
+			}
+			if got.CanDownload() != tt.wantDownload {
+				t.Errorf("CanDownload() = %v, want %v", got.CanDownload(), tt.wantDownload)
+			}
+		})
+	}
+}
@@ -1,18 +1,30 @@
 package domain
 
 type GitHubUserInfo struct {
-	sub        string
-	repository string
-	ref        string
-	actor      string
+	sub            string
+	repository     string
+	ref            string
+	actor          string
+	eventName      string
+	environment    string
+	jobWorkflowRef string
 }
 
 func NewGitHubUserInfo(sub, repository, ref, actor string) *GitHubUserInfo {
+	return NewGitHubUserInfoWithWorkflow(sub, repository, ref, actor, "", "", "")
+}
+
+// NewGitHubUserInfoWithWorkflow はワークフローの実行に関するクレームを含めてGitHubUserInfoを生成する
+// environmentはジョブがEnvironmentを参照しない場合、jobWorkflowRefはトークンに含まれない場合に空文字とする
+func NewGitHubUserInfoWithWorkflow(sub, repository, ref, actor, eventName, environment, jobWorkflowRef string) *GitHubUserInfo {
 	return &GitHubUserInfo{
-		sub:        sub,
-		repository: repository,
-		ref:        ref,
-		actor:      actor,
+		sub:            sub,
+		repository:     repository,
+		ref:            ref,
+		actor:          actor,
+		eventName:      eventName,
+		environment:    environment,
+		jobWorkflowRef: jobWorkflowRef,
 	}
 }
 
@@ -32,6 +44,21 @@ func (g *GitHubUserInfo) Actor() string {
 	return g.actor
 }
 
+// EventName はワークフローを起動したイベント名（push, pull_requestなど）
+func (g *GitHubUserInfo) EventName() string {
+	return g.eventName
+}
+
+// Environment はジョブが参照するEnvironment名
+func (g *GitHubUserInfo) Environment() string {
+	return g.environment
+}
+
+// JobWorkflowRef はジョブを定義したワークフローの参照（owner/repo/.github/workflows/x.yml@ref）
+func (g *GitHubUserInfo) JobWorkflowRef() string {
+	return g.jobWorkflowRef
+}
+
 func (g *GitHubUserInfo) ToUserInfo() (*UserInfo, error) {
 	repo, err := NewRepositoryIdentifier(g.repository)
 	if err != nil {
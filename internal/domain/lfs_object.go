@@ -61,6 +61,11 @@ func (o *LFSObject) MarkAsUploaded(ctx context.Context) {
 	o.updatedAt = ctxtime.Now(ctx)
 }
 
+func (o *LFSObject) MarkAsNotUploaded(ctx context.Context) {
+	o.uploaded = false
+	o.updatedAt = ctxtime.Now(ctx)
+}
+
 func (o *LFSObject) IsUploaded() bool {
 	return o.uploaded
 }
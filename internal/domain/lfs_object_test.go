@@ -189,6 +189,39 @@ func TestLFSObject_MarkAsUploaded(t *testing.T) {
 	}
 }
 
+func TestLFSObject_MarkAsNotUploaded(t *testing.T) {
+	oid, err := domain.NewOID("a1b2c3d4e5f6a1b2c3d4e5f6a1b2c3d4e5f6a1b2c3d4e5f6a1b2c3d4e5f6a1b2")
+	if err != nil {
+		t.Fatalf("NewOID() failed: %v", err)
+	}
+	size, err := domain.NewSize(1024)
+	if err != nil {
+		t.Fatalf("NewSize() failed: %v", err)
+	}
+
+	createdAt := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	obj, err := domain.ReconstructLFSObject(oid, size, "sha256", "test/key", true, createdAt, createdAt)
+	if err != nil {
+		t.Fatalf("ReconstructLFSObject() failed: %v", err)
+	}
+
+	updatedTime := time.Date(2024, 1, 15, 11, 0, 0, 0, time.UTC)
+	ctx := testid.WithValue(context.Background(), uuid.NewString())
+	ctxtimetest.SetFixedNow(t, ctx, updatedTime)
+
+	obj.MarkAsNotUploaded(ctx)
+
+	if diff := cmp.Diff(false, obj.IsUploaded()); diff != "" {
+		t.Errorf("MarkAsNotUploaded()後にIsUploaded() mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(updatedTime, obj.UpdatedAt()); diff != "" {
+		t.Errorf("UpdatedAt() mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(createdAt, obj.CreatedAt()); diff != "" {
+		t.Errorf("CreatedAt() mismatch (-want +got):\n%s", diff)
+	}
+}
+
 func TestLFSObject_Getters(t *testing.T) {
 	type args struct {
 		oidValue      string
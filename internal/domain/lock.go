@@ -0,0 +1,95 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/newmo-oss/ctxtime"
+)
+
+type LockOwner struct {
+	sub  string
+	name string
+}
+
+func NewLockOwner(sub, name string) (LockOwner, error) {
+	if sub == "" {
+		return LockOwner{}, ErrEmptySub
+	}
+	if name == "" {
+		name = sub
+	}
+	return LockOwner{sub: sub, name: name}, nil
+}
+
+func (o LockOwner) Sub() string {
+	return o.sub
+}
+
+func (o LockOwner) Name() string {
+	return o.name
+}
+
+func (o LockOwner) IsOwnedBy(userInfo *UserInfo) bool {
+	if userInfo == nil {
+		return false
+	}
+	return o.sub == userInfo.Sub()
+}
+
+type Lock struct {
+	id         LockID
+	repository *RepositoryIdentifier
+	path       LockPath
+	refName    string
+	owner      LockOwner
+	lockedAt   time.Time
+}
+
+func NewLock(ctx context.Context, repository *RepositoryIdentifier, path LockPath, refName string, owner LockOwner) (*Lock, error) {
+	if repository == nil {
+		return nil, ErrInvalidRepositoryIdentifier
+	}
+	return &Lock{
+		repository: repository,
+		path:       path,
+		refName:    refName,
+		owner:      owner,
+		lockedAt:   ctxtime.Now(ctx),
+	}, nil
+}
+
+func ReconstructLock(id LockID, repository *RepositoryIdentifier, path LockPath, refName string, owner LockOwner, lockedAt time.Time) *Lock {
+	return &Lock{
+		id:         id,
+		repository: repository,
+		path:       path,
+		refName:    refName,
+		owner:      owner,
+		lockedAt:   lockedAt,
+	}
+}
+
+func (l *Lock) ID() LockID {
+	return l.id
+}
+
+func (l *Lock) Repository() *RepositoryIdentifier {
+	return l.repository
+}
+
+func (l *Lock) Path() LockPath {
+	return l.path
+}
+
+func (l *Lock) RefName() string {
+	return l.refName
+}
+
+func (l *Lock) Owner() LockOwner {
+	return l.owner
+}
+
+func (l *Lock) LockedAt() time.Time {
+	return l.lockedAt
+}
@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"errors"
+	"strconv"
+)
+
+var ErrInvalidLockID = errors.New("LockID must be a positive integer")
+
+type LockID struct {
+	value int64
+}
+
+func NewLockID(value int64) (LockID, error) {
+	if value <= 0 {
+		return LockID{}, ErrInvalidLockID
+	}
+	return LockID{value: value}, nil
+}
+
+func ParseLockID(s string) (LockID, error) {
+	value, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return LockID{}, ErrInvalidLockID
+	}
+	return NewLockID(value)
+}
+
+func (id LockID) Int64() int64 {
+	return id.value
+}
+
+func (id LockID) String() string {
+	return strconv.FormatInt(id.value, 10)
+}
+
+func (id LockID) IsZero() bool {
+	return id.value == 0
+}
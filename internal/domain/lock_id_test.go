@@ -0,0 +1,93 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/na2na-p/cargohold/internal/domain"
+)
+
+func TestNewLockID(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   int64
+		want    int64
+		wantErr error
+	}{
+		{
+			name:  "正常系: 正の値で作成できる",
+			value: 1,
+			want:  1,
+		},
+		{
+			name:    "異常系: 0の場合はエラー",
+			value:   0,
+			wantErr: domain.ErrInvalidLockID,
+		},
+		{
+			name:    "異常系: 負の値の場合はエラー",
+			value:   -1,
+			wantErr: domain.ErrInvalidLockID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := domain.NewLockID(tt.value)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NewLockID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got.Int64() != tt.want {
+				t.Errorf("NewLockID() = %v, want %v", got.Int64(), tt.want)
+			}
+		})
+	}
+}
+
+func TestParseLockID(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    string
+		wantErr error
+	}{
+		{
+			name:  "正常系: 数値文字列をパースできる",
+			value: "42",
+			want:  "42",
+		},
+		{
+			name:    "異常系: 数値以外の文字列はエラー",
+			value:   "abc",
+			wantErr: domain.ErrInvalidLockID,
+		},
+		{
+			name:    "異常系: 空文字はエラー",
+			value:   "",
+			wantErr: domain.ErrInvalidLockID,
+		},
+		{
+			name:    "異常系: 0はエラー",
+			value:   "0",
+			wantErr: domain.ErrInvalidLockID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := domain.ParseLockID(tt.value)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseLockID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if !got.IsZero() {
+					t.Errorf("ParseLockID() = %v, want zero value", got)
+				}
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("ParseLockID() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+)
+
+var ErrInvalidLockPath = errors.New("lock path must be a non-empty relative path")
+
+const maxLockPathLength = 4096
+
+type LockPath struct {
+	value string
+}
+
+func NewLockPath(value string) (LockPath, error) {
+	if value == "" || len(value) > maxLockPathLength {
+		return LockPath{}, ErrInvalidLockPath
+	}
+	if strings.HasPrefix(value, "/") || strings.ContainsRune(value, 0) {
+		return LockPath{}, ErrInvalidLockPath
+	}
+	return LockPath{value: value}, nil
+}
+
+func (p LockPath) String() string {
+	return p.value
+}
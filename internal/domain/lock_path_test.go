@@ -0,0 +1,54 @@
+package domain_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/na2na-p/cargohold/internal/domain"
+)
+
+func TestNewLockPath(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		wantErr error
+	}{
+		{
+			name:  "正常系: 相対パスで作成できる",
+			value: "assets/images/logo.png",
+		},
+		{
+			name:    "異常系: 空文字の場合はエラー",
+			value:   "",
+			wantErr: domain.ErrInvalidLockPath,
+		},
+		{
+			name:    "異常系: 絶対パスの場合はエラー",
+			value:   "/etc/passwd",
+			wantErr: domain.ErrInvalidLockPath,
+		},
+		{
+			name:    "異常系: NUL文字を含む場合はエラー",
+			value:   "foo\x00bar",
+			wantErr: domain.ErrInvalidLockPath,
+		},
+		{
+			name:    "異常系: 長すぎる場合はエラー",
+			value:   strings.Repeat("a", 4097),
+			wantErr: domain.ErrInvalidLockPath,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := domain.NewLockPath(tt.value)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NewLockPath() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && got.String() != tt.value {
+				t.Errorf("NewLockPath() = %v, want %v", got.String(), tt.value)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+//go:generate mockgen -source=$GOFILE -destination=../../tests/domain/mock_lock_repository.go -package=domain
+package domain
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	ErrLockNotFound      = errors.New("lock not found")
+	ErrLockAlreadyExists = errors.New("lock already exists")
+)
+
+type LockListFilter struct {
+	Path   string
+	ID     LockID
+	Cursor LockID
+	Limit  int
+}
+
+type LockRepository interface {
+	Create(ctx context.Context, lock *Lock) (*Lock, error)
+	FindByID(ctx context.Context, repository *RepositoryIdentifier, id LockID) (*Lock, error)
+	FindByPath(ctx context.Context, repository *RepositoryIdentifier, path LockPath) (*Lock, error)
+	List(ctx context.Context, repository *RepositoryIdentifier, filter LockListFilter) (locks []*Lock, nextCursor LockID, err error)
+	Delete(ctx context.Context, repository *RepositoryIdentifier, id LockID) error
+}
@@ -0,0 +1,170 @@
+package domain_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/newmo-oss/ctxtime/ctxtimetest"
+	"github.com/newmo-oss/testid"
+)
+
+func TestNewLockOwner(t *testing.T) {
+	tests := []struct {
+		name      string
+		sub       string
+		ownerName string
+		wantName  string
+		wantErr   error
+	}{
+		{
+			name:      "正常系: subとnameで作成できる",
+			sub:       "user-1",
+			ownerName: "octocat",
+			wantName:  "octocat",
+		},
+		{
+			name:      "正常系: nameが空の場合はsubが使用される",
+			sub:       "user-1",
+			ownerName: "",
+			wantName:  "user-1",
+		},
+		{
+			name:      "異常系: subが空の場合はエラー",
+			sub:       "",
+			ownerName: "octocat",
+			wantErr:   domain.ErrEmptySub,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := domain.NewLockOwner(tt.sub, tt.ownerName)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NewLockOwner() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if got.Sub() != tt.sub {
+				t.Errorf("Sub() = %v, want %v", got.Sub(), tt.sub)
+			}
+			if got.Name() != tt.wantName {
+				t.Errorf("Name() = %v, want %v", got.Name(), tt.wantName)
+			}
+		})
+	}
+}
+
+func TestLockOwner_IsOwnedBy(t *testing.T) {
+	repo, err := domain.NewRepositoryIdentifier("owner/repo")
+	if err != nil {
+		t.Fatalf("NewRepositoryIdentifier() failed: %v", err)
+	}
+	owner, err := domain.NewLockOwner("user-1", "octocat")
+	if err != nil {
+		t.Fatalf("NewLockOwner() failed: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		userInfo func() *domain.UserInfo
+		want     bool
+	}{
+		{
+			name: "正常系: subが一致する場合はtrue",
+			userInfo: func() *domain.UserInfo {
+				u, _ := domain.NewUserInfo("user-1", "", "octocat", domain.ProviderTypeGitHub, repo, "")
+				return u
+			},
+			want: true,
+		},
+		{
+			name: "正常系: subが一致しない場合はfalse",
+			userInfo: func() *domain.UserInfo {
+				u, _ := domain.NewUserInfo("user-2", "", "octocat", domain.ProviderTypeGitHub, repo, "")
+				return u
+			},
+			want: false,
+		},
+		{
+			name: "正常系: nilの場合はfalse",
+			userInfo: func() *domain.UserInfo {
+				return nil
+			},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := owner.IsOwnedBy(tt.userInfo()); got != tt.want {
+				t.Errorf("IsOwnedBy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewLock(t *testing.T) {
+	fixedTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	ctx := testid.WithValue(context.Background(), t.Name())
+	ctxtimetest.SetFixedNow(t, ctx, fixedTime)
+
+	repo, err := domain.NewRepositoryIdentifier("owner/repo")
+	if err != nil {
+		t.Fatalf("NewRepositoryIdentifier() failed: %v", err)
+	}
+	path, err := domain.NewLockPath("foo/bar.bin")
+	if err != nil {
+		t.Fatalf("NewLockPath() failed: %v", err)
+	}
+	owner, err := domain.NewLockOwner("user-1", "octocat")
+	if err != nil {
+		t.Fatalf("NewLockOwner() failed: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		repository *domain.RepositoryIdentifier
+		wantErr    error
+	}{
+		{
+			name:       "正常系: ロックを作成できる",
+			repository: repo,
+		},
+		{
+			name:       "異常系: リポジトリがnilの場合はエラー",
+			repository: nil,
+			wantErr:    domain.ErrInvalidRepositoryIdentifier,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := domain.NewLock(ctx, tt.repository, path, "refs/heads/main", owner)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NewLock() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if !got.ID().IsZero() {
+				t.Errorf("ID() = %v, want zero", got.ID())
+			}
+			if got.Path().String() != "foo/bar.bin" {
+				t.Errorf("Path() = %v, want %v", got.Path().String(), "foo/bar.bin")
+			}
+			if got.RefName() != "refs/heads/main" {
+				t.Errorf("RefName() = %v, want %v", got.RefName(), "refs/heads/main")
+			}
+			if !got.LockedAt().Equal(fixedTime) {
+				t.Errorf("LockedAt() = %v, want %v", got.LockedAt(), fixedTime)
+			}
+			if got.Repository().FullName() != "owner/repo" {
+				t.Errorf("Repository() = %v, want %v", got.Repository().FullName(), "owner/repo")
+			}
+		})
+	}
+}
@@ -3,14 +3,17 @@ package domain
 type OAuthState struct {
 	repository  string
 	redirectURI string
-	shell       ShellType
+	// codeVerifier はPKCEのcode_verifier。トークンとの交換時にのみ使用し、ブラウザには渡さない
+	codeVerifier string
+	shell        ShellType
 }
 
-func NewOAuthState(repository, redirectURI string, shell ShellType) *OAuthState {
+func NewOAuthState(repository, redirectURI, codeVerifier string, shell ShellType) *OAuthState {
 	return &OAuthState{
-		repository:  repository,
-		redirectURI: redirectURI,
-		shell:       shell,
+		repository:   repository,
+		redirectURI:  redirectURI,
+		codeVerifier: codeVerifier,
+		shell:        shell,
 	}
 }
 
@@ -22,6 +25,10 @@ func (o *OAuthState) RedirectURI() string {
 	return o.redirectURI
 }
 
+func (o *OAuthState) CodeVerifier() string {
+	return o.codeVerifier
+}
+
 func (o *OAuthState) Shell() ShellType {
 	return o.shell
 }
@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"regexp"
 )
@@ -25,3 +27,11 @@ func NewOID(value string) (OID, error) {
 func (o OID) String() string {
 	return o.value
 }
+
+// Base64 はOIDが表すSHA-256ハッシュをbase64で符号化して返す
+// S3のx-amz-checksum-sha256ヘッダーと同じ形式で、アップロードされた内容の検証に使う
+func (o OID) Base64() string {
+	// NewOIDで16進数であることを検証済みのため、デコードは失敗しない
+	b, _ := hex.DecodeString(o.value)
+	return base64.StdEncoding.EncodeToString(b)
+}
@@ -203,3 +203,34 @@ func BenchmarkNewOID(b *testing.B) {
 		_, _ = domain.NewOID(validOID)
 	}
 }
+
+func TestOID_Base64(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{
+			name:  "正常系: 空データのSHA-256ハッシュをbase64で返す",
+			value: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+			want:  "47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU=",
+		},
+		{
+			name:  "正常系: 大文字の16進数でも同じ値を返す",
+			value: "E3B0C44298FC1C149AFBF4C8996FB92427AE41E4649B934CA495991B7852B855",
+			want:  "47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU=",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oid, err := domain.NewOID(tt.value)
+			if err != nil {
+				t.Fatalf("NewOID() failed: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, oid.Base64()); diff != "" {
+				t.Errorf("Base64() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
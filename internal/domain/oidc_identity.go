@@ -0,0 +1,56 @@
+package domain
+
+// OIDCIdentity はGitHub Actions以外のOIDCプロバイダーが発行したトークンのクレームを、
+// cargoholdのリポジトリ・ref・実行者に対応付けたもの
+type OIDCIdentity struct {
+	provider   ProviderType
+	sub        string
+	repository string
+	ref        string
+	actor      string
+}
+
+func NewOIDCIdentity(provider ProviderType, sub, repository, ref, actor string) *OIDCIdentity {
+	return &OIDCIdentity{
+		provider:   provider,
+		sub:        sub,
+		repository: repository,
+		ref:        ref,
+		actor:      actor,
+	}
+}
+
+func (i *OIDCIdentity) Provider() ProviderType {
+	return i.provider
+}
+
+func (i *OIDCIdentity) Sub() string {
+	return i.sub
+}
+
+func (i *OIDCIdentity) Repository() string {
+	return i.repository
+}
+
+func (i *OIDCIdentity) Ref() string {
+	return i.ref
+}
+
+func (i *OIDCIdentity) Actor() string {
+	return i.actor
+}
+
+func (i *OIDCIdentity) ToUserInfo() (*UserInfo, error) {
+	repo, err := NewRepositoryIdentifier(i.repository)
+	if err != nil {
+		return nil, err
+	}
+	return NewUserInfo(
+		i.sub,
+		"",
+		i.actor,
+		i.provider,
+		repo,
+		i.ref,
+	)
+}
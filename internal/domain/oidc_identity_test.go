@@ -0,0 +1,63 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/na2na-p/cargohold/internal/domain"
+)
+
+func TestOIDCIdentity_ToUserInfo(t *testing.T) {
+	tests := []struct {
+		name           string
+		identity       *domain.OIDCIdentity
+		wantRepository string
+		wantErr        bool
+	}{
+		{
+			name: "正常系: リポジトリ・ref・実行者がUserInfoに引き継がれる",
+			identity: domain.NewOIDCIdentity(
+				domain.ProviderTypeGitLab,
+				"project_path:group/project:ref_type:branch:ref:main",
+				"group/project",
+				"refs/heads/main",
+				"gitlab-user",
+			),
+			wantRepository: "group/project",
+		},
+		{
+			name:     "異常系: リポジトリがowner/repo形式でない場合、エラーが返る",
+			identity: domain.NewOIDCIdentity(domain.ProviderTypeGitLab, "sub", "group/subgroup/project", "", ""),
+			wantErr:  true,
+		},
+		{
+			name:     "異常系: subが空の場合、エラーが返る",
+			identity: domain.NewOIDCIdentity(domain.ProviderTypeKubernetes, "", "namespace/serviceaccount", "", ""),
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.identity.ToUserInfo()
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ToUserInfo() error = nil, wantErr")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ToUserInfo() unexpected error: %v", err)
+			}
+			if got.Repository().FullName() != tt.wantRepository {
+				t.Errorf("Repository() = %q, want %q", got.Repository().FullName(), tt.wantRepository)
+			}
+			if got.Provider() != tt.identity.Provider() {
+				t.Errorf("Provider() = %v, want %v", got.Provider(), tt.identity.Provider())
+			}
+			if got.Ref() != tt.identity.Ref() || got.Name() != tt.identity.Actor() || got.Sub() != tt.identity.Sub() {
+				t.Errorf("ToUserInfo() = {sub: %q, ref: %q, name: %q}, want {sub: %q, ref: %q, name: %q}",
+					got.Sub(), got.Ref(), got.Name(), tt.identity.Sub(), tt.identity.Ref(), tt.identity.Actor())
+			}
+		})
+	}
+}
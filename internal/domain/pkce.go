@@ -0,0 +1,12 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+)
+
+// PKCECodeChallenge はPKCEのcode_verifierからS256方式のcode_challengeを求める
+func PKCECodeChallenge(codeVerifier string) string {
+	sum := sha256.Sum256([]byte(codeVerifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
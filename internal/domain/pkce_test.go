@@ -0,0 +1,30 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/na2na-p/cargohold/internal/domain"
+)
+
+func TestPKCECodeChallenge(t *testing.T) {
+	tests := []struct {
+		name         string
+		codeVerifier string
+		want         string
+	}{
+		{
+			// RFC 7636 Appendix B の例
+			name:         "正常系: RFC 7636の例と同じcode_challengeを返す",
+			codeVerifier: "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk",
+			want:         "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := domain.PKCECodeChallenge(tt.codeVerifier); got != tt.want {
+				t.Errorf("PKCECodeChallenge() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
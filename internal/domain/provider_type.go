@@ -9,11 +9,28 @@ type ProviderType struct {
 }
 
 var (
-	ProviderTypeGitHub = ProviderType{value: "github"}
+	ProviderTypeGitHub     = ProviderType{value: "github"}
+	ProviderTypeGitLab     = ProviderType{value: "gitlab"}
+	ProviderTypeBuildkite  = ProviderType{value: "buildkite"}
+	ProviderTypeKubernetes = ProviderType{value: "kubernetes"}
+	// ProviderTypeOIDC は種別を特定しない汎用のOIDCプロバイダー
+	ProviderTypeOIDC = ProviderType{value: "oidc"}
+	// ProviderTypeAccessToken はcargoholdが発行したアクセストークンによる認証
+	// 設定で指定するプロバイダーではないため、validProviderTypesには含めない
+	ProviderTypeAccessToken = ProviderType{value: "access_token"}
+	// ProviderTypeAdmin は管理APIのトークンによる認証。監査ログの記録にのみ用いる
+	ProviderTypeAdmin = ProviderType{value: "admin"}
 )
 
+// GitHubActionsIssuer はGitHub Actionsが発行するOIDCトークンのissuer
+const GitHubActionsIssuer = "https://token.actions.githubusercontent.com"
+
 var validProviderTypes = map[string]ProviderType{
-	"github": ProviderTypeGitHub,
+	"github":     ProviderTypeGitHub,
+	"gitlab":     ProviderTypeGitLab,
+	"buildkite":  ProviderTypeBuildkite,
+	"kubernetes": ProviderTypeKubernetes,
+	"oidc":       ProviderTypeOIDC,
 }
 
 func NewProviderType(s string) (ProviderType, error) {
@@ -21,6 +21,30 @@ func TestNewProviderType(t *testing.T) {
 			want:    domain.ProviderTypeGitHub,
 			wantErr: nil,
 		},
+		{
+			name:    "正常系: gitlabを指定した場合、ProviderTypeが返却される",
+			input:   "gitlab",
+			want:    domain.ProviderTypeGitLab,
+			wantErr: nil,
+		},
+		{
+			name:    "正常系: buildkiteを指定した場合、ProviderTypeが返却される",
+			input:   "buildkite",
+			want:    domain.ProviderTypeBuildkite,
+			wantErr: nil,
+		},
+		{
+			name:    "正常系: kubernetesを指定した場合、ProviderTypeが返却される",
+			input:   "kubernetes",
+			want:    domain.ProviderTypeKubernetes,
+			wantErr: nil,
+		},
+		{
+			name:    "正常系: oidcを指定した場合、ProviderTypeが返却される",
+			input:   "oidc",
+			want:    domain.ProviderTypeOIDC,
+			wantErr: nil,
+		},
 		{
 			name:    "異常系: 空文字を指定した場合、ErrInvalidProviderTypeが返却される",
 			input:   "",
@@ -0,0 +1,26 @@
+package domain
+
+import "time"
+
+// RateLimitDimension はレート制限を適用する単位
+type RateLimitDimension string
+
+const (
+	RateLimitDimensionIdentity   RateLimitDimension = "identity"
+	RateLimitDimensionRepository RateLimitDimension = "repository"
+	RateLimitDimensionClientIP   RateLimitDimension = "ip"
+)
+
+// RateLimitSubject はレート制限の対象となる識別子
+// 1つのリクエストは認証済みの識別子・リポジトリ・クライアントIPのそれぞれについて制限される
+type RateLimitSubject struct {
+	Dimension RateLimitDimension
+	Value     string
+}
+
+// RateLimitDecision はレート制限の判定結果
+// Allowedがfalseの場合、RetryAfterは再試行できるようになるまでの待ち時間を表す
+type RateLimitDecision struct {
+	Allowed    bool
+	RetryAfter time.Duration
+}
@@ -1,11 +1,21 @@
 //go:generate mockgen -source=$GOFILE -destination=../../tests/domain/mock_repository.go -package=domain
 package domain
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type LFSObjectRepository interface {
 	FindByOID(ctx context.Context, oid OID) (*LFSObject, error)
+	FindByOIDs(ctx context.Context, oids []OID) (map[OID]*LFSObject, error)
 	Save(ctx context.Context, obj *LFSObject) error
 	Update(ctx context.Context, obj *LFSObject) error
 	ExistsByOID(ctx context.Context, oid OID) (bool, error)
+	FindStaleNotUploaded(ctx context.Context, olderThan time.Time, limit int) ([]*LFSObject, error)
+	FindUnreferenced(ctx context.Context, olderThan time.Time, limit int) ([]*LFSObject, error)
+	ListAfter(ctx context.Context, after OID, limit int) ([]*LFSObject, error)
+	Delete(ctx context.Context, oid OID) error
+	// DeleteStaleNotUploaded は未アップロードのままolderThan以前から更新されていない場合のみメタデータを削除し、削除したかを返す
+	DeleteStaleNotUploaded(ctx context.Context, oid OID, olderThan time.Time) (bool, error)
 }
@@ -0,0 +1,54 @@
+package domain
+
+import "errors"
+
+var ErrInvalidRepositoryAccessLevel = errors.New("access level must be one of 'admin', 'write', 'read' or 'none'")
+
+// RepositoryAccessLevel はクレームルールに一致したトークンに許可する操作の範囲
+type RepositoryAccessLevel struct {
+	value string
+}
+
+var (
+	// RepositoryAccessLevelAdmin はロックの強制解除を含むすべての操作を許可する
+	RepositoryAccessLevelAdmin = RepositoryAccessLevel{value: "admin"}
+	// RepositoryAccessLevelWrite はアップロードとダウンロードを許可する
+	RepositoryAccessLevelWrite = RepositoryAccessLevel{value: "write"}
+	// RepositoryAccessLevelRead はダウンロードのみを許可する
+	RepositoryAccessLevelRead = RepositoryAccessLevel{value: "read"}
+	// RepositoryAccessLevelNone はいずれの操作も許可しない
+	RepositoryAccessLevelNone = RepositoryAccessLevel{value: "none"}
+)
+
+var validRepositoryAccessLevels = map[string]RepositoryAccessLevel{
+	"admin": RepositoryAccessLevelAdmin,
+	"write": RepositoryAccessLevelWrite,
+	"read":  RepositoryAccessLevelRead,
+	"none":  RepositoryAccessLevelNone,
+}
+
+func NewRepositoryAccessLevel(value string) (RepositoryAccessLevel, error) {
+	level, ok := validRepositoryAccessLevels[value]
+	if !ok {
+		return RepositoryAccessLevel{}, ErrInvalidRepositoryAccessLevel
+	}
+	return level, nil
+}
+
+func (l RepositoryAccessLevel) String() string {
+	return l.value
+}
+
+// Permissions はアクセスレベルに対応するリポジトリ権限を返す
+func (l RepositoryAccessLevel) Permissions() RepositoryPermissions {
+	switch l {
+	case RepositoryAccessLevelAdmin:
+		return NewRepositoryPermissions(true, true, true, true, true)
+	case RepositoryAccessLevelWrite:
+		return NewRepositoryPermissions(false, true, true, false, false)
+	case RepositoryAccessLevelRead:
+		return NewRepositoryPermissions(false, false, true, false, false)
+	default:
+		return NewRepositoryPermissions(false, false, false, false, false)
+	}
+}
@@ -0,0 +1,72 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/na2na-p/cargohold/internal/domain"
+)
+
+func TestNewRepositoryAccessLevel(t *testing.T) {
+	tests := []struct {
+		name            string
+		value           string
+		wantUpload      bool
+		wantDownload    bool
+		wantForceUnlock bool
+		wantErr         error
+	}{
+		{
+			name:            "正常系: adminはすべての操作を許可する",
+			value:           "admin",
+			wantUpload:      true,
+			wantDownload:    true,
+			wantForceUnlock: true,
+		},
+		{
+			name:         "正常系: writeはアップロードとダウンロードを許可する",
+			value:        "write",
+			wantUpload:   true,
+			wantDownload: true,
+		},
+		{
+			name:         "正常系: readはダウンロードのみを許可する",
+			value:        "read",
+			wantDownload: true,
+		},
+		{
+			name:  "正常系: noneはいずれの操作も許可しない",
+			value: "none",
+		},
+		{
+			name:    "異常系: 未知の値はエラー",
+			value:   "owner",
+			wantErr: domain.ErrInvalidRepositoryAccessLevel,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := domain.NewRepositoryAccessLevel(tt.value)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NewRepositoryAccessLevel() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if got.String() != tt.value {
+				t.Errorf("String() = %v, want %v", got.String(), tt.value)
+			}
+			perms := got.Permissions()
+			if perms.CanUpload() != tt.wantUpload {
+				t.Errorf("CanUpload() = %v, want %v", perms.CanUpload(), tt.wantUpload)
+			}
+			if perms.CanDownload() != tt.wantDownload {
+				t.Errorf("CanDownload() = %v, want %v", perms.CanDownload(), tt.wantDownload)
+			}
+			if perms.CanForceUnlock() != tt.wantForceUnlock {
+				t.Errorf("CanForceUnlock() = %v, want %v", perms.CanForceUnlock(), tt.wantForceUnlock)
+			}
+		})
+	}
+}
@@ -26,6 +26,10 @@ func (p RepositoryPermissions) CanDownload() bool {
 	return p.pull || p.push || p.admin || p.maintain || p.triage
 }
 
+func (p RepositoryPermissions) CanForceUnlock() bool {
+	return p.admin || p.maintain
+}
+
 func (p RepositoryPermissions) Admin() bool {
 	return p.admin
 }
@@ -45,3 +49,14 @@ func (p RepositoryPermissions) Maintain() bool {
 func (p RepositoryPermissions) Triage() bool {
 	return p.triage
 }
+
+// Intersect は両方の権限で許可されている権限のみを返す
+func (p RepositoryPermissions) Intersect(other RepositoryPermissions) RepositoryPermissions {
+	return RepositoryPermissions{
+		admin:    p.admin && other.admin,
+		push:     p.push && other.push,
+		pull:     p.pull && other.pull,
+		maintain: p.maintain && other.maintain,
+		triage:   p.triage && other.triage,
+	}
+}
@@ -197,3 +197,76 @@ func TestRepositoryPermissions_CanDownload(t *testing.T) {
 		})
 	}
 }
+
+func TestRepositoryPermissions_CanForceUnlock(t *testing.T) {
+	tests := []struct {
+		name     string
+		admin    bool
+		push     bool
+		pull     bool
+		maintain bool
+		triage   bool
+		want     bool
+	}{
+		{
+			name:  "正常系: admin権限で強制解除可能",
+			admin: true,
+			want:  true,
+		},
+		{
+			name:     "正常系: maintain権限で強制解除可能",
+			maintain: true,
+			want:     true,
+		},
+		{
+			name: "正常系: push権限のみでは強制解除不可",
+			push: true,
+			pull: true,
+			want: false,
+		},
+		{
+			name:   "正常系: triage権限のみでは強制解除不可",
+			triage: true,
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := domain.NewRepositoryPermissions(tt.admin, tt.push, tt.pull, tt.maintain, tt.triage)
+			if got := p.CanForceUnlock(); got != tt.want {
+				t.Errorf("CanForceUnlock() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRepositoryPermissions_Intersect(t *testing.T) {
+	tests := []struct {
+		name  string
+		p     domain.RepositoryPermissions
+		other domain.RepositoryPermissions
+		want  domain.RepositoryPermissions
+	}{
+		{
+			name:  "正常系: 両方で許可されている権限のみが残る",
+			p:     domain.NewRepositoryPermissions(true, true, true, false, false),
+			other: domain.NewRepositoryPermissions(false, true, true, true, false),
+			want:  domain.NewRepositoryPermissions(false, true, true, false, false),
+		},
+		{
+			name:  "正常系: 一方に権限がない場合、すべての権限がなくなる",
+			p:     domain.NewRepositoryPermissions(true, true, true, true, true),
+			other: domain.NewRepositoryPermissions(false, false, false, false, false),
+			want:  domain.NewRepositoryPermissions(false, false, false, false, false),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.p.Intersect(tt.other); got != tt.want {
+				t.Errorf("Intersect() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
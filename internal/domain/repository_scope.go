@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// RepositoryScopeWildcard はオーナー配下のすべてのリポジトリを表すリポジトリ名
+const RepositoryScopeWildcard = "*"
+
+// MaxRepositoryScopePatterns はセッションのスコープに指定できるパターン数の上限
+const MaxRepositoryScopePatterns = 50
+
+var ErrInvalidRepositoryScope = errors.New("repository scope must be a comma-separated list of 'owner/repo' or 'owner/*'")
+
+// RepositoryScope はセッションが対象とするリポジトリの集合
+// "owner/repo" でリポジトリを、"owner/*" でオーナー配下のすべてのリポジトリを表す
+type RepositoryScope struct {
+	patterns []repositoryPattern
+}
+
+type repositoryPattern struct {
+	owner string
+	// name がRepositoryScopeWildcardの場合はオーナー配下のすべてのリポジトリに一致する
+	name string
+}
+
+// ParseRepositoryScope はカンマ区切りのパターンからスコープを生成する
+// 大文字小文字のみが異なるパターンは1つにまとめる
+func ParseRepositoryScope(value string) (*RepositoryScope, error) {
+	var patterns []repositoryPattern
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		owner, name, ok := strings.Cut(part, "/")
+		if !ok || owner == "" || name == "" || owner == RepositoryScopeWildcard || strings.Contains(name, "/") {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidRepositoryScope, part)
+		}
+		if name != RepositoryScopeWildcard && strings.Contains(name, RepositoryScopeWildcard) {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidRepositoryScope, part)
+		}
+
+		pattern := repositoryPattern{owner: owner, name: name}
+		if !containsPattern(patterns, pattern) {
+			patterns = append(patterns, pattern)
+		}
+	}
+	if len(patterns) > MaxRepositoryScopePatterns {
+		return nil, fmt.Errorf("%w: at most %d patterns are allowed", ErrInvalidRepositoryScope, MaxRepositoryScopePatterns)
+	}
+	return &RepositoryScope{patterns: patterns}, nil
+}
+
+// Contains はリポジトリがスコープに含まれるかを判定する
+func (s *RepositoryScope) Contains(repository *RepositoryIdentifier) bool {
+	if s == nil || repository == nil {
+		return false
+	}
+	for _, pattern := range s.patterns {
+		if !strings.EqualFold(pattern.owner, repository.Owner()) {
+			continue
+		}
+		if pattern.name == RepositoryScopeWildcard || strings.EqualFold(pattern.name, repository.Name()) {
+			return true
+		}
+	}
+	return false
+}
+
+// SingleRepository はスコープが1つのリポジトリのみを対象とする場合にそのリポジトリを返す
+// 複数のリポジトリやワイルドカードを含む場合はnilを返す
+func (s *RepositoryScope) SingleRepository() *RepositoryIdentifier {
+	if s == nil || len(s.patterns) != 1 || s.patterns[0].name == RepositoryScopeWildcard {
+		return nil
+	}
+	return &RepositoryIdentifier{owner: s.patterns[0].owner, name: s.patterns[0].name}
+}
+
+// Patterns は "owner/repo" または "owner/*" 形式のパターンを指定された順に返す
+func (s *RepositoryScope) Patterns() []string {
+	patterns := make([]string, len(s.patterns))
+	for i, pattern := range s.patterns {
+		patterns[i] = pattern.owner + "/" + pattern.name
+	}
+	return patterns
+}
+
+// String はParseRepositoryScopeで復元できるカンマ区切りの形式を返す
+func (s *RepositoryScope) String() string {
+	return strings.Join(s.Patterns(), ",")
+}
+
+func containsPattern(patterns []repositoryPattern, target repositoryPattern) bool {
+	for _, pattern := range patterns {
+		if strings.EqualFold(pattern.owner, target.owner) && strings.EqualFold(pattern.name, target.name) {
+			return true
+		}
+	}
+	return false
+}
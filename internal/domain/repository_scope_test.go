@@ -0,0 +1,192 @@
+package domain_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/na2na-p/cargohold/internal/domain"
+)
+
+func TestParseRepositoryScope(t *testing.T) {
+	tooMany := make([]string, domain.MaxRepositoryScopePatterns+1)
+	for i := range tooMany {
+		tooMany[i] = "owner/repo" + strings.Repeat("x", i)
+	}
+
+	tests := []struct {
+		name         string
+		value        string
+		wantPatterns []string
+		wantErr      error
+	}{
+		{
+			name:         "正常系: 単一のリポジトリ",
+			value:        "owner/repo",
+			wantPatterns: []string{"owner/repo"},
+		},
+		{
+			name:         "正常系: カンマ区切りの複数のリポジトリとワイルドカード",
+			value:        "owner/repo1, owner/repo2,other/*",
+			wantPatterns: []string{"owner/repo1", "owner/repo2", "other/*"},
+		},
+		{
+			name:         "正常系: 大文字小文字のみが異なるパターンは1つにまとめられる",
+			value:        "Owner/Repo,owner/repo",
+			wantPatterns: []string{"Owner/Repo"},
+		},
+		{
+			name:    "異常系: 空文字列",
+			value:   "",
+			wantErr: domain.ErrInvalidRepositoryScope,
+		},
+		{
+			name:    "異常系: 空の要素を含む",
+			value:   "owner/repo,",
+			wantErr: domain.ErrInvalidRepositoryScope,
+		},
+		{
+			name:    "異常系: オーナーのワイルドカード",
+			value:   "*/repo",
+			wantErr: domain.ErrInvalidRepositoryScope,
+		},
+		{
+			name:    "異常系: リポジトリ名の一部のワイルドカード",
+			value:   "owner/repo-*",
+			wantErr: domain.ErrInvalidRepositoryScope,
+		},
+		{
+			name:    "異常系: スラッシュが多い",
+			value:   "owner/repo/extra",
+			wantErr: domain.ErrInvalidRepositoryScope,
+		},
+		{
+			name:    "異常系: パターン数が上限を超える",
+			value:   strings.Join(tooMany, ","),
+			wantErr: domain.ErrInvalidRepositoryScope,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := domain.ParseRepositoryScope(tt.value)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("ParseRepositoryScope() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseRepositoryScope() unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantPatterns, got.Patterns()); diff != "" {
+				t.Errorf("Patterns() mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(strings.Join(tt.wantPatterns, ","), got.String()); diff != "" {
+				t.Errorf("String() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRepositoryScope_Contains(t *testing.T) {
+	scope, err := domain.ParseRepositoryScope("owner/repo,org/*")
+	if err != nil {
+		t.Fatalf("ParseRepositoryScope() failed: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		repository string
+		want       bool
+	}{
+		{
+			name:       "正常系: 指定したリポジトリを含む",
+			repository: "owner/repo",
+			want:       true,
+		},
+		{
+			name:       "正常系: 大文字小文字を区別しない",
+			repository: "OWNER/Repo",
+			want:       true,
+		},
+		{
+			name:       "正常系: ワイルドカードのオーナー配下のリポジトリを含む",
+			repository: "org/any-repo",
+			want:       true,
+		},
+		{
+			name:       "正常系: 同じオーナーの指定していないリポジトリは含まない",
+			repository: "owner/other",
+			want:       false,
+		},
+		{
+			name:       "正常系: 他のオーナーのリポジトリは含まない",
+			repository: "other/repo",
+			want:       false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repository, err := domain.NewRepositoryIdentifier(tt.repository)
+			if err != nil {
+				t.Fatalf("NewRepositoryIdentifier() failed: %v", err)
+			}
+			if got := scope.Contains(repository); got != tt.want {
+				t.Errorf("Contains() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("異常系: nilのリポジトリは含まない", func(t *testing.T) {
+		if scope.Contains(nil) {
+			t.Error("Contains(nil) = true, want false")
+		}
+	})
+}
+
+func TestRepositoryScope_SingleRepository(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{
+			name:  "正常系: 単一のリポジトリの場合、そのリポジトリを返す",
+			value: "owner/repo",
+			want:  "owner/repo",
+		},
+		{
+			name:  "正常系: 複数のリポジトリの場合、nilを返す",
+			value: "owner/repo1,owner/repo2",
+		},
+		{
+			name:  "正常系: ワイルドカードの場合、nilを返す",
+			value: "owner/*",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scope, err := domain.ParseRepositoryScope(tt.value)
+			if err != nil {
+				t.Fatalf("ParseRepositoryScope() failed: %v", err)
+			}
+
+			got := scope.SingleRepository()
+
+			if tt.want == "" {
+				if got != nil {
+					t.Errorf("SingleRepository() = %v, want nil", got.FullName())
+				}
+				return
+			}
+			if got == nil || got.FullName() != tt.want {
+				t.Errorf("SingleRepository() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
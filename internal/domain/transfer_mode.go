@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrInvalidTransferMode = errors.New("invalid transfer mode")
+
+type TransferMode struct {
+	value string
+}
+
+var (
+	TransferModeProxy     = TransferMode{value: "proxy"}
+	TransferModePresigned = TransferMode{value: "presigned"}
+	TransferModeThreshold = TransferMode{value: "threshold"}
+)
+
+func ParseTransferMode(s string) (TransferMode, error) {
+	switch s {
+	case "", TransferModeProxy.value:
+		return TransferModeProxy, nil
+	case TransferModePresigned.value:
+		return TransferModePresigned, nil
+	case TransferModeThreshold.value:
+		return TransferModeThreshold, nil
+	default:
+		return TransferMode{}, fmt.Errorf("%w: %q", ErrInvalidTransferMode, s)
+	}
+}
+
+func (m TransferMode) String() string {
+	return m.value
+}
+
+func (m TransferMode) IsZero() bool {
+	return m.value == ""
+}
@@ -0,0 +1,66 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/na2na-p/cargohold/internal/domain"
+)
+
+func TestParseTransferMode(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    domain.TransferMode
+		wantErr error
+	}{
+		{
+			name:  "正常系: proxyが指定された場合はTransferModeProxyを返す",
+			input: "proxy",
+			want:  domain.TransferModeProxy,
+		},
+		{
+			name:  "正常系: 空文字の場合はTransferModeProxyをデフォルトとして返す",
+			input: "",
+			want:  domain.TransferModeProxy,
+		},
+		{
+			name:  "正常系: presignedが指定された場合はTransferModePresignedを返す",
+			input: "presigned",
+			want:  domain.TransferModePresigned,
+		},
+		{
+			name:  "正常系: thresholdが指定された場合はTransferModeThresholdを返す",
+			input: "threshold",
+			want:  domain.TransferModeThreshold,
+		},
+		{
+			name:    "異常系: 不正なモード名の場合はエラーを返す",
+			input:   "direct",
+			wantErr: domain.ErrInvalidTransferMode,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := domain.ParseTransferMode(tt.input)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("ParseTransferMode(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+				}
+				if !got.IsZero() {
+					t.Errorf("ParseTransferMode(%q) = %v, want zero value", tt.input, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseTransferMode(%q) unexpected error: %v", tt.input, err)
+			}
+			if diff := cmp.Diff(tt.want.String(), got.String()); diff != "" {
+				t.Errorf("ParseTransferMode(%q) mismatch (-want +got):\n%s", tt.input, diff)
+			}
+		})
+	}
+}
@@ -1,5 +1,7 @@
 package domain
 
+import "strings"
+
 type UserInfo struct {
 	sub         string
 	email       string
@@ -8,6 +10,12 @@ type UserInfo struct {
 	repository  *RepositoryIdentifier
 	ref         string
 	permissions *RepositoryPermissions
+	// repositoryScope は複数のリポジトリを対象とするセッションのスコープ。nilの場合はrepositoryのみを対象とする
+	repositoryScope *RepositoryScope
+	// cachedPermissions はrepositoryScopeのリポジトリごとに取得した権限。キーは小文字の "owner/repo"
+	cachedPermissions map[string]RepositoryPermissions
+	// createdBy はアクセストークンで認証した場合のトークンの発行者のsubject
+	createdBy string
 }
 
 func NewUserInfo(sub, email, name string, provider ProviderType, repository *RepositoryIdentifier, ref string) (*UserInfo, error) {
@@ -48,6 +56,15 @@ func (u *UserInfo) Ref() string {
 	return u.ref
 }
 
+// CreatedBy はアクセストークンで認証した場合にトークンを発行したユーザーまたは管理者のsubjectを返す
+func (u *UserInfo) CreatedBy() string {
+	return u.createdBy
+}
+
+func (u *UserInfo) SetCreatedBy(createdBy string) {
+	u.createdBy = createdBy
+}
+
 func (u *UserInfo) Permissions() *RepositoryPermissions {
 	return u.permissions
 }
@@ -55,3 +72,48 @@ func (u *UserInfo) Permissions() *RepositoryPermissions {
 func (u *UserInfo) SetPermissions(permissions *RepositoryPermissions) {
 	u.permissions = permissions
 }
+
+func (u *UserInfo) RepositoryScope() *RepositoryScope {
+	return u.repositoryScope
+}
+
+func (u *UserInfo) SetRepositoryScope(scope *RepositoryScope) {
+	u.repositoryScope = scope
+}
+
+// CachedPermissions はスコープのリポジトリについて取得済みの権限を返す
+func (u *UserInfo) CachedPermissions(repository *RepositoryIdentifier) (RepositoryPermissions, bool) {
+	permissions, ok := u.cachedPermissions[permissionsCacheKey(repository)]
+	return permissions, ok
+}
+
+// CachePermissions はスコープのリポジトリについて取得した権限を保持する
+// アクセスできないリポジトリも、ゼロ値の権限を保持して再度問い合わせないようにする
+func (u *UserInfo) CachePermissions(repository *RepositoryIdentifier, permissions RepositoryPermissions) {
+	if u.cachedPermissions == nil {
+		u.cachedPermissions = make(map[string]RepositoryPermissions)
+	}
+	u.cachedPermissions[permissionsCacheKey(repository)] = permissions
+}
+
+// ClearCachedPermissions は取得済みの権限をすべて破棄する
+func (u *UserInfo) ClearCachedPermissions() {
+	u.cachedPermissions = nil
+}
+
+// AllCachedPermissions は取得済みの権限を "owner/repo" ごとに返す
+func (u *UserInfo) AllCachedPermissions() map[string]RepositoryPermissions {
+	return u.cachedPermissions
+}
+
+// ForRepository はスコープのリポジトリを操作するユーザー情報を返す。元のユーザー情報は変更しない
+func (u *UserInfo) ForRepository(repository *RepositoryIdentifier, permissions RepositoryPermissions) *UserInfo {
+	scoped := *u
+	scoped.repository = repository
+	scoped.permissions = &permissions
+	return &scoped
+}
+
+func permissionsCacheKey(repository *RepositoryIdentifier) string {
+	return strings.ToLower(repository.FullName())
+}
@@ -283,3 +283,64 @@ func TestUserInfo_Repository(t *testing.T) {
 		})
 	}
 }
+
+func TestUserInfo_CachedPermissions(t *testing.T) {
+	repo, _ := domain.NewRepositoryIdentifier("Owner/Repo")
+	sameRepo, _ := domain.NewRepositoryIdentifier("owner/repo")
+	otherRepo, _ := domain.NewRepositoryIdentifier("owner/other")
+	permissions := domain.NewRepositoryPermissions(false, true, true, false, false)
+
+	userInfo, err := domain.NewUserInfo("12345", "", "user", domain.ProviderTypeGitHub, nil, "")
+	if err != nil {
+		t.Fatalf("NewUserInfo failed: %v", err)
+	}
+
+	if _, ok := userInfo.CachedPermissions(repo); ok {
+		t.Fatal("CachedPermissions() ok = true before caching, want false")
+	}
+
+	userInfo.CachePermissions(repo, permissions)
+
+	got, ok := userInfo.CachedPermissions(sameRepo)
+	if !ok {
+		t.Fatal("CachedPermissions() ok = false, want true")
+	}
+	if diff := cmp.Diff(permissions, got, cmp.AllowUnexported(domain.RepositoryPermissions{})); diff != "" {
+		t.Errorf("CachedPermissions() mismatch (-want +got):\n%s", diff)
+	}
+	if _, ok := userInfo.CachedPermissions(otherRepo); ok {
+		t.Error("CachedPermissions() for other repository ok = true, want false")
+	}
+
+	userInfo.ClearCachedPermissions()
+	if _, ok := userInfo.CachedPermissions(repo); ok {
+		t.Error("CachedPermissions() ok = true after clearing, want false")
+	}
+}
+
+func TestUserInfo_ForRepository(t *testing.T) {
+	repo, _ := domain.NewRepositoryIdentifier("owner/repo")
+	scope, _ := domain.ParseRepositoryScope("owner/*")
+	permissions := domain.NewRepositoryPermissions(false, false, true, false, false)
+
+	userInfo, err := domain.NewUserInfo("12345", "", "user", domain.ProviderTypeGitHub, nil, "")
+	if err != nil {
+		t.Fatalf("NewUserInfo failed: %v", err)
+	}
+	userInfo.SetRepositoryScope(scope)
+
+	got := userInfo.ForRepository(repo, permissions)
+
+	if !got.Repository().Equals(repo) {
+		t.Errorf("Repository() = %v, want %v", got.Repository(), repo)
+	}
+	if got.Permissions() == nil || !got.Permissions().CanDownload() || got.Permissions().CanUpload() {
+		t.Errorf("Permissions() = %+v, want pull only", got.Permissions())
+	}
+	if got.Sub() != "12345" || got.RepositoryScope() != scope {
+		t.Errorf("ForRepository() did not keep sub and scope: %+v", got)
+	}
+	if userInfo.Repository() != nil || userInfo.Permissions() != nil {
+		t.Error("ForRepository() modified the original UserInfo")
+	}
+}
@@ -0,0 +1,105 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/na2na-p/cargohold/internal/handler/dto"
+	"github.com/na2na-p/cargohold/internal/handler/middleware"
+	"github.com/na2na-p/cargohold/internal/usecase"
+)
+
+// AccessTokenHandler はセッションで認証したユーザーが自身のアクセストークンを管理するハンドラー
+type AccessTokenHandler struct {
+	accessTokenUseCase usecase.AccessTokenUseCase
+}
+
+func NewAccessTokenHandler(accessTokenUseCase usecase.AccessTokenUseCase) *AccessTokenHandler {
+	return &AccessTokenHandler{
+		accessTokenUseCase: accessTokenUseCase,
+	}
+}
+
+func (h *AccessTokenHandler) List(c echo.Context) error {
+	userInfo, err := sessionUserInfo(c)
+	if err != nil {
+		return err
+	}
+
+	tokens, err := h.accessTokenUseCase.ListForUser(c.Request().Context(), userInfo)
+	if err != nil {
+		return handleAccessTokenUseCaseError(err)
+	}
+	return c.JSON(http.StatusOK, dto.NewAccessTokensResponseDTO(tokens))
+}
+
+// Issue はセッションのリポジトリに対して、ユーザーの権限の範囲内でアクセストークンを発行する
+func (h *AccessTokenHandler) Issue(c echo.Context) error {
+	middleware.SetAuditAction(c, domain.AuditActionTokenIssue)
+
+	userInfo, err := sessionUserInfo(c)
+	if err != nil {
+		return err
+	}
+	sessionID, _ := c.Get(middleware.SessionIDContextKey).(string)
+
+	var req dto.IssueAccessTokenRequestDTO
+	if err := json.NewDecoder(io.LimitReader(c.Request().Body, maxBodySize)).Decode(&req); err != nil {
+		return middleware.NewAppError(http.StatusBadRequest, "リクエストボディのパースに失敗しました", err)
+	}
+
+	issued, err := h.accessTokenUseCase.IssueForUser(c.Request().Context(), sessionID, userInfo, req.ToInput())
+	if err != nil {
+		return handleAccessTokenUseCaseError(err)
+	}
+	middleware.SetAuditObjects(c, middleware.AuditObject{Target: issued.Token.ID().String()})
+	return c.JSON(http.StatusCreated, dto.NewIssuedAccessTokenDTO(issued))
+}
+
+func (h *AccessTokenHandler) Revoke(c echo.Context) error {
+	middleware.SetAuditAction(c, domain.AuditActionTokenRevoke)
+	middleware.SetAuditObjects(c, middleware.AuditObject{Target: c.Param("id")})
+
+	userInfo, err := sessionUserInfo(c)
+	if err != nil {
+		return err
+	}
+
+	if err := h.accessTokenUseCase.RevokeForUser(c.Request().Context(), userInfo, c.Param("id")); err != nil {
+		return handleAccessTokenUseCaseError(err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func sessionUserInfo(c echo.Context) (*domain.UserInfo, error) {
+	userInfo, ok := c.Get(middleware.UserInfoContextKey).(*domain.UserInfo)
+	if !ok || userInfo == nil {
+		return nil, middleware.NewAppError(http.StatusUnauthorized, "認証情報が見つかりません", nil)
+	}
+	return userInfo, nil
+}
+
+func handleAccessTokenUseCaseError(err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrInvalidRepository):
+		return middleware.NewAppError(http.StatusBadRequest, "リポジトリ識別子の形式が不正です", err)
+	case errors.Is(err, usecase.ErrInvalidAccessTokenRequest):
+		return middleware.NewAppError(http.StatusBadRequest, "アクセストークンの発行内容が不正です", err)
+	case errors.Is(err, usecase.ErrInvalidAccessTokenID):
+		return middleware.NewAppError(http.StatusBadRequest, "アクセストークンのIDが不正です", err)
+	case errors.Is(err, usecase.ErrSessionNotFound):
+		return middleware.NewAppError(http.StatusUnauthorized, "Unauthorized", err)
+	case errors.Is(err, usecase.ErrRepositoryAccessDenied):
+		return middleware.NewAppError(http.StatusForbidden, "セッションでアクセスできないリポジトリにはアクセストークンを発行できません", err)
+	case errors.Is(err, usecase.ErrPermissionDenied):
+		return middleware.NewAppError(http.StatusForbidden, "このオペレーションを実行する権限がありません", err)
+	case errors.Is(err, usecase.ErrAccessTokenNotFound):
+		return middleware.NewAppError(http.StatusNotFound, "アクセストークンが見つかりません", err)
+	default:
+		return middleware.NewAppError(http.StatusInternalServerError, "サーバー内部エラーが発生しました", err)
+	}
+}
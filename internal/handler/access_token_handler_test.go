@@ -0,0 +1,301 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/labstack/echo/v4"
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/na2na-p/cargohold/internal/handler"
+	"github.com/na2na-p/cargohold/internal/handler/middleware"
+	"github.com/na2na-p/cargohold/internal/usecase"
+	mock_usecase "github.com/na2na-p/cargohold/tests/usecase"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAccessTokenHandler(t *testing.T) {
+	repo, err := domain.NewRepositoryIdentifier("owner/repo")
+	if err != nil {
+		t.Fatalf("NewRepositoryIdentifier() failed: %v", err)
+	}
+	userInfo, err := domain.NewUserInfo("12345", "", "user", domain.ProviderTypeGitHub, repo, "")
+	if err != nil {
+		t.Fatalf("NewUserInfo() failed: %v", err)
+	}
+	tokenID, _ := domain.NewAccessTokenID(1)
+	createdAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	lastUsedAt := createdAt.Add(time.Hour)
+	token := domain.ReconstructAccessToken(tokenID, "build-farm", "12345", []*domain.RepositoryIdentifier{repo},
+		[]domain.Operation{domain.OperationUpload}, "hash", createdAt.Add(7*24*time.Hour), &lastUsedAt, createdAt)
+	tokenJSON := map[string]any{
+		"id":           float64(1),
+		"name":         "build-farm",
+		"created_by":   "12345",
+		"repositories": []any{"owner/repo"},
+		"operations":   []any{"upload"},
+		"expires_at":   "2026-01-08T00:00:00Z",
+		"last_used_at": "2026-01-01T01:00:00Z",
+		"created_at":   "2026-01-01T00:00:00Z",
+	}
+
+	tests := []struct {
+		name               string
+		accessTokenUseCase func(ctrl *gomock.Controller) usecase.AccessTokenUseCase
+		userInfo           *domain.UserInfo
+		method             string
+		target             string
+		body               string
+		wantStatusCode     int
+		wantBodyJSON       map[string]any
+	}{
+		{
+			name: "正常系: ユーザーが発行したアクセストークン一覧が返る",
+			accessTokenUseCase: func(ctrl *gomock.Controller) usecase.AccessTokenUseCase {
+				m := mock_usecase.NewMockAccessTokenUseCase(ctrl)
+				m.EXPECT().ListForUser(gomock.Any(), userInfo).Return([]*domain.AccessToken{token}, nil)
+				return m
+			},
+			userInfo:       userInfo,
+			method:         http.MethodGet,
+			target:         "/auth/tokens",
+			wantStatusCode: http.StatusOK,
+			wantBodyJSON:   map[string]any{"tokens": []any{tokenJSON}},
+		},
+		{
+			name: "正常系: アクセストークンが発行され201が返る",
+			accessTokenUseCase: func(ctrl *gomock.Controller) usecase.AccessTokenUseCase {
+				m := mock_usecase.NewMockAccessTokenUseCase(ctrl)
+				m.EXPECT().IssueForUser(gomock.Any(), "session-id", userInfo, usecase.AccessTokenInput{
+					Name:       "build-farm",
+					Operations: []string{"upload"},
+					ExpiresIn:  7 * 24 * time.Hour,
+				}).Return(&usecase.IssuedAccessToken{Token: token, Secret: "cht_secret"}, nil)
+				return m
+			},
+			userInfo:       userInfo,
+			method:         http.MethodPost,
+			target:         "/auth/tokens",
+			body:           `{"name":"build-farm","operations":["upload"],"expires_in_days":7}`,
+			wantStatusCode: http.StatusCreated,
+		},
+		{
+			name: "異常系: 権限を超えるオペレーションを要求した場合、403が返る",
+			accessTokenUseCase: func(ctrl *gomock.Controller) usecase.AccessTokenUseCase {
+				m := mock_usecase.NewMockAccessTokenUseCase(ctrl)
+				m.EXPECT().IssueForUser(gomock.Any(), "session-id", userInfo, gomock.Any()).Return(nil, usecase.ErrPermissionDenied)
+				return m
+			},
+			userInfo:       userInfo,
+			method:         http.MethodPost,
+			target:         "/auth/tokens",
+			body:           `{"name":"build-farm","operations":["upload"],"expires_in_days":7}`,
+			wantStatusCode: http.StatusForbidden,
+			wantBodyJSON:   map[string]any{"error": "このオペレーションを実行する権限がありません"},
+		},
+		{
+			name: "異常系: セッションでアクセスできないリポジトリを指定した場合、403が返る",
+			accessTokenUseCase: func(ctrl *gomock.Controller) usecase.AccessTokenUseCase {
+				m := mock_usecase.NewMockAccessTokenUseCase(ctrl)
+				m.EXPECT().IssueForUser(gomock.Any(), "session-id", userInfo, gomock.Any()).Return(nil, usecase.ErrRepositoryAccessDenied)
+				return m
+			},
+			userInfo:       userInfo,
+			method:         http.MethodPost,
+			target:         "/auth/tokens",
+			body:           `{"name":"build-farm","repositories":["owner/other"],"operations":["download"],"expires_in_days":7}`,
+			wantStatusCode: http.StatusForbidden,
+			wantBodyJSON:   map[string]any{"error": "セッションでアクセスできないリポジトリにはアクセストークンを発行できません"},
+		},
+		{
+			name: "異常系: 発行中にセッションが失効した場合、401が返る",
+			accessTokenUseCase: func(ctrl *gomock.Controller) usecase.AccessTokenUseCase {
+				m := mock_usecase.NewMockAccessTokenUseCase(ctrl)
+				m.EXPECT().IssueForUser(gomock.Any(), "session-id", userInfo, gomock.Any()).Return(nil, usecase.ErrSessionNotFound)
+				return m
+			},
+			userInfo:       userInfo,
+			method:         http.MethodPost,
+			target:         "/auth/tokens",
+			body:           `{"name":"build-farm","repositories":["owner/repo"],"operations":["download"],"expires_in_days":7}`,
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name: "異常系: リクエストボディが不正な場合、400が返る",
+			accessTokenUseCase: func(ctrl *gomock.Controller) usecase.AccessTokenUseCase {
+				return mock_usecase.NewMockAccessTokenUseCase(ctrl)
+			},
+			userInfo:       userInfo,
+			method:         http.MethodPost,
+			target:         "/auth/tokens",
+			body:           `{`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "正常系: アクセストークンが失効され204が返る",
+			accessTokenUseCase: func(ctrl *gomock.Controller) usecase.AccessTokenUseCase {
+				m := mock_usecase.NewMockAccessTokenUseCase(ctrl)
+				m.EXPECT().RevokeForUser(gomock.Any(), userInfo, "1").Return(nil)
+				return m
+			},
+			userInfo:       userInfo,
+			method:         http.MethodDelete,
+			target:         "/auth/tokens/1",
+			wantStatusCode: http.StatusNoContent,
+		},
+		{
+			name: "異常系: 他のユーザーのアクセストークンを失効する場合、404が返る",
+			accessTokenUseCase: func(ctrl *gomock.Controller) usecase.AccessTokenUseCase {
+				m := mock_usecase.NewMockAccessTokenUseCase(ctrl)
+				m.EXPECT().RevokeForUser(gomock.Any(), userInfo, "2").Return(usecase.ErrAccessTokenNotFound)
+				return m
+			},
+			userInfo:       userInfo,
+			method:         http.MethodDelete,
+			target:         "/auth/tokens/2",
+			wantStatusCode: http.StatusNotFound,
+			wantBodyJSON:   map[string]any{"error": "アクセストークンが見つかりません"},
+		},
+		{
+			name: "異常系: 認証情報がない場合、401が返る",
+			accessTokenUseCase: func(ctrl *gomock.Controller) usecase.AccessTokenUseCase {
+				return mock_usecase.NewMockAccessTokenUseCase(ctrl)
+			},
+			method:         http.MethodGet,
+			target:         "/auth/tokens",
+			wantStatusCode: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			h := handler.NewAccessTokenHandler(tt.accessTokenUseCase(ctrl))
+
+			e := echo.New()
+			e.HTTPErrorHandler = middleware.CustomHTTPErrorHandler
+			group := e.Group("/auth/tokens")
+			group.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+				return func(c echo.Context) error {
+					if tt.userInfo != nil {
+						c.Set(middleware.UserInfoContextKey, tt.userInfo)
+						c.Set(middleware.SessionIDContextKey, "session-id")
+					}
+					return next(c)
+				}
+			})
+			group.GET("", h.List)
+			group.POST("", h.Issue)
+			group.DELETE("/:id", h.Revoke)
+
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assertAdminResponse(t, rec, tt.wantStatusCode, tt.wantBodyJSON)
+		})
+	}
+}
+
+func TestAccessTokenHandler_Audit(t *testing.T) {
+	repo, err := domain.NewRepositoryIdentifier("owner/repo")
+	if err != nil {
+		t.Fatalf("NewRepositoryIdentifier() failed: %v", err)
+	}
+	userInfo, err := domain.NewUserInfo("12345", "", "user", domain.ProviderTypeGitHub, repo, "")
+	if err != nil {
+		t.Fatalf("NewUserInfo() failed: %v", err)
+	}
+	tokenID, _ := domain.NewAccessTokenID(1)
+	createdAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	token := domain.ReconstructAccessToken(tokenID, "build-farm", "12345", []*domain.RepositoryIdentifier{repo},
+		[]domain.Operation{domain.OperationUpload}, "hash", createdAt.Add(7*24*time.Hour), nil, createdAt)
+
+	tests := []struct {
+		name               string
+		accessTokenUseCase func(ctrl *gomock.Controller) usecase.AccessTokenUseCase
+		userInfo           *domain.UserInfo
+		method             string
+		target             string
+		body               string
+		wantEvents         []handlerAuditEvent
+	}{
+		{
+			name: "正常系: アクセストークンの発行が発行したトークンのIDとともに記録される",
+			accessTokenUseCase: func(ctrl *gomock.Controller) usecase.AccessTokenUseCase {
+				m := mock_usecase.NewMockAccessTokenUseCase(ctrl)
+				m.EXPECT().IssueForUser(gomock.Any(), "session-id", userInfo, gomock.Any()).
+					Return(&usecase.IssuedAccessToken{Token: token, Secret: "cht_secret"}, nil)
+				return m
+			},
+			userInfo: userInfo,
+			method:   http.MethodPost,
+			target:   "/auth/tokens",
+			body:     `{"name":"build-farm","operations":["upload"],"expires_in_days":7}`,
+			wantEvents: []handlerAuditEvent{
+				{Action: "token_issue", Outcome: "success", Subject: "12345", Provider: "github", Target: "1"},
+			},
+		},
+		{
+			name: "正常系: 存在しないアクセストークンの失効が失敗として記録される",
+			accessTokenUseCase: func(ctrl *gomock.Controller) usecase.AccessTokenUseCase {
+				m := mock_usecase.NewMockAccessTokenUseCase(ctrl)
+				m.EXPECT().RevokeForUser(gomock.Any(), userInfo, "2").Return(usecase.ErrAccessTokenNotFound)
+				return m
+			},
+			userInfo: userInfo,
+			method:   http.MethodDelete,
+			target:   "/auth/tokens/2",
+			wantEvents: []handlerAuditEvent{
+				{Action: "token_revoke", Outcome: "failure", Subject: "12345", Provider: "github", Target: "2"},
+			},
+		},
+		{
+			name: "正常系: 認証情報がないリクエストが認証失敗として記録される",
+			accessTokenUseCase: func(ctrl *gomock.Controller) usecase.AccessTokenUseCase {
+				return mock_usecase.NewMockAccessTokenUseCase(ctrl)
+			},
+			method: http.MethodGet,
+			target: "/auth/tokens",
+			wantEvents: []handlerAuditEvent{
+				{Action: "authenticate", Outcome: "denied"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			h := handler.NewAccessTokenHandler(tt.accessTokenUseCase(ctrl))
+
+			var gotEvents []handlerAuditEvent
+			e := echo.New()
+			e.HTTPErrorHandler = middleware.CustomHTTPErrorHandler
+			group := e.Group("/auth/tokens", middleware.Audit(newHandlerAuditRecorder(ctrl, &gotEvents)))
+			group.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+				return func(c echo.Context) error {
+					if tt.userInfo != nil {
+						c.Set(middleware.UserInfoContextKey, tt.userInfo)
+						c.Set(middleware.SessionIDContextKey, "session-id")
+					}
+					return next(c)
+				}
+			})
+			group.GET("", h.List)
+			group.POST("", h.Issue)
+			group.DELETE("/:id", h.Revoke)
+
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			e.ServeHTTP(httptest.NewRecorder(), req)
+
+			if diff := cmp.Diff(tt.wantEvents, gotEvents); diff != "" {
+				t.Errorf("audit events mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,214 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/na2na-p/cargohold/internal/handler/dto"
+	"github.com/na2na-p/cargohold/internal/handler/middleware"
+	"github.com/na2na-p/cargohold/internal/usecase"
+)
+
+type AdminHandler struct {
+	allowlistUseCase   usecase.RepositoryAllowlistUseCase
+	objectUseCase      usecase.AdminObjectUseCase
+	claimRuleUseCase   usecase.GitHubClaimRuleUseCase
+	accessTokenUseCase usecase.AccessTokenUseCase
+	sessionUseCase     usecase.SessionUseCase
+}
+
+func NewAdminHandler(
+	allowlistUseCase usecase.RepositoryAllowlistUseCase,
+	objectUseCase usecase.AdminObjectUseCase,
+	claimRuleUseCase usecase.GitHubClaimRuleUseCase,
+	accessTokenUseCase usecase.AccessTokenUseCase,
+	sessionUseCase usecase.SessionUseCase,
+) *AdminHandler {
+	return &AdminHandler{
+		allowlistUseCase:   allowlistUseCase,
+		objectUseCase:      objectUseCase,
+		claimRuleUseCase:   claimRuleUseCase,
+		accessTokenUseCase: accessTokenUseCase,
+		sessionUseCase:     sessionUseCase,
+	}
+}
+
+func (h *AdminHandler) ListAllowlist(c echo.Context) error {
+	repositories, err := h.allowlistUseCase.List(c.Request().Context())
+	if err != nil {
+		return handleAdminUseCaseError(err)
+	}
+	return c.JSON(http.StatusOK, dto.NewAllowlistResponseDTO(repositories))
+}
+
+func (h *AdminHandler) AddAllowlist(c echo.Context) error {
+	middleware.SetAuditAction(c, domain.AuditActionAdminAllowlistAdd)
+
+	var req dto.AddAllowlistRequestDTO
+	if err := json.NewDecoder(io.LimitReader(c.Request().Body, maxBodySize)).Decode(&req); err != nil {
+		return middleware.NewAppError(http.StatusBadRequest, "リクエストボディのパースに失敗しました", err)
+	}
+	middleware.SetAuditObjects(c, middleware.AuditObject{Target: req.Repository})
+
+	repository, err := h.allowlistUseCase.Add(c.Request().Context(), req.Repository)
+	if err != nil {
+		return handleAdminUseCaseError(err)
+	}
+	return c.JSON(http.StatusCreated, dto.NewAllowlistEntryDTO(repository))
+}
+
+func (h *AdminHandler) RemoveAllowlist(c echo.Context) error {
+	middleware.SetAuditAction(c, domain.AuditActionAdminAllowlistRemove)
+
+	fullName := c.Param("owner") + "/" + c.Param("repo")
+	if _, err := h.allowlistUseCase.Remove(c.Request().Context(), fullName); err != nil {
+		return handleAdminUseCaseError(err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *AdminHandler) ListClaimRules(c echo.Context) error {
+	rules, err := h.claimRuleUseCase.List(c.Request().Context(), c.Param("owner")+"/"+c.Param("repo"))
+	if err != nil {
+		return handleAdminUseCaseError(err)
+	}
+	return c.JSON(http.StatusOK, dto.NewClaimRulesResponseDTO(rules))
+}
+
+func (h *AdminHandler) AddClaimRule(c echo.Context) error {
+	middleware.SetAuditAction(c, domain.AuditActionAdminClaimRuleAdd)
+
+	var req dto.AddClaimRuleRequestDTO
+	if err := json.NewDecoder(io.LimitReader(c.Request().Body, maxBodySize)).Decode(&req); err != nil {
+		return middleware.NewAppError(http.StatusBadRequest, "リクエストボディのパースに失敗しました", err)
+	}
+
+	rule, err := h.claimRuleUseCase.Add(c.Request().Context(), c.Param("owner")+"/"+c.Param("repo"), req.ToInput())
+	if err != nil {
+		return handleAdminUseCaseError(err)
+	}
+	middleware.SetAuditObjects(c, middleware.AuditObject{Target: rule.ID().String()})
+	return c.JSON(http.StatusCreated, dto.NewClaimRuleDTO(rule))
+}
+
+func (h *AdminHandler) RemoveClaimRule(c echo.Context) error {
+	middleware.SetAuditAction(c, domain.AuditActionAdminClaimRuleRemove)
+	middleware.SetAuditObjects(c, middleware.AuditObject{Target: c.Param("id")})
+
+	if err := h.claimRuleUseCase.Remove(c.Request().Context(), c.Param("owner")+"/"+c.Param("repo"), c.Param("id")); err != nil {
+		return handleAdminUseCaseError(err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *AdminHandler) ListAccessTokens(c echo.Context) error {
+	tokens, err := h.accessTokenUseCase.List(c.Request().Context())
+	if err != nil {
+		return handleAdminUseCaseError(err)
+	}
+	return c.JSON(http.StatusOK, dto.NewAccessTokensResponseDTO(tokens))
+}
+
+// IssueAccessToken は任意のリポジトリに対するアクセストークンを発行する。発行者には認証された管理者のsubjectを記録する
+func (h *AdminHandler) IssueAccessToken(c echo.Context) error {
+	middleware.SetAuditAction(c, domain.AuditActionAdminTokenIssue)
+
+	var req dto.IssueAccessTokenRequestDTO
+	if err := json.NewDecoder(io.LimitReader(c.Request().Body, maxBodySize)).Decode(&req); err != nil {
+		return middleware.NewAppError(http.StatusBadRequest, "リクエストボディのパースに失敗しました", err)
+	}
+
+	subject, _ := c.Get(middleware.AdminSubjectContextKey).(string)
+	issued, err := h.accessTokenUseCase.Issue(c.Request().Context(), subject, req.ToInput())
+	if err != nil {
+		return handleAdminUseCaseError(err)
+	}
+	middleware.SetAuditObjects(c, middleware.AuditObject{Target: issued.Token.ID().String()})
+	return c.JSON(http.StatusCreated, dto.NewIssuedAccessTokenDTO(issued))
+}
+
+func (h *AdminHandler) RevokeAccessToken(c echo.Context) error {
+	middleware.SetAuditAction(c, domain.AuditActionAdminTokenRevoke)
+	middleware.SetAuditObjects(c, middleware.AuditObject{Target: c.Param("id")})
+
+	if err := h.accessTokenUseCase.Revoke(c.Request().Context(), c.Param("id")); err != nil {
+		return handleAdminUseCaseError(err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// RevokeUserSessions はGitHubユーザーIDに紐づくセッションと、そのユーザーが発行したアクセストークンをすべて失効させる
+func (h *AdminHandler) RevokeUserSessions(c echo.Context) error {
+	middleware.SetAuditAction(c, domain.AuditActionAdminSessionRevoke)
+	middleware.SetAuditObjects(c, middleware.AuditObject{Target: c.Param("id")})
+
+	revoked, err := h.sessionUseCase.RevokeAllForGitHubUser(c.Request().Context(), c.Param("id"))
+	if err != nil {
+		return handleAdminUseCaseError(err)
+	}
+	return c.JSON(http.StatusOK, &dto.RevokedSessionsResponseDTO{
+		Revoked:             revoked.Sessions,
+		RevokedAccessTokens: revoked.AccessTokens,
+	})
+}
+
+func (h *AdminHandler) GetObject(c echo.Context) error {
+	detail, err := h.objectUseCase.GetObject(c.Request().Context(), c.Param("oid"))
+	if err != nil {
+		return handleAdminUseCaseError(err)
+	}
+	return c.JSON(http.StatusOK, dto.NewAdminObjectDTO(detail))
+}
+
+func (h *AdminHandler) DeleteObject(c echo.Context) error {
+	middleware.SetAuditAction(c, domain.AuditActionAdminObjectDelete)
+	middleware.SetAuditObjects(c, middleware.AuditObject{OID: c.Param("oid")})
+
+	if err := h.objectUseCase.DeleteObject(c.Request().Context(), c.Param("oid")); err != nil {
+		return handleAdminUseCaseError(err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *AdminHandler) FlushObjectCache(c echo.Context) error {
+	middleware.SetAuditAction(c, domain.AuditActionAdminCacheFlush)
+	middleware.SetAuditObjects(c, middleware.AuditObject{OID: c.Param("oid")})
+
+	if err := h.objectUseCase.FlushObjectCache(c.Request().Context(), c.Param("oid")); err != nil {
+		return handleAdminUseCaseError(err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func handleAdminUseCaseError(err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrInvalidRepository):
+		return middleware.NewAppError(http.StatusBadRequest, "リポジトリ識別子の形式が不正です", err)
+	case errors.Is(err, usecase.ErrInvalidOID):
+		return middleware.NewAppError(http.StatusBadRequest, "OIDの形式が不正です", err)
+	case errors.Is(err, usecase.ErrInvalidClaimRule):
+		return middleware.NewAppError(http.StatusBadRequest, "クレームルールの内容が不正です", err)
+	case errors.Is(err, usecase.ErrInvalidClaimRuleID):
+		return middleware.NewAppError(http.StatusBadRequest, "クレームルールのIDが不正です", err)
+	case errors.Is(err, usecase.ErrInvalidAccessTokenRequest):
+		return middleware.NewAppError(http.StatusBadRequest, "アクセストークンの発行内容が不正です", err)
+	case errors.Is(err, usecase.ErrInvalidAccessTokenID):
+		return middleware.NewAppError(http.StatusBadRequest, "アクセストークンのIDが不正です", err)
+	case errors.Is(err, usecase.ErrInvalidGitHubUserID):
+		return middleware.NewAppError(http.StatusBadRequest, "GitHubユーザーIDの形式が不正です", err)
+	case errors.Is(err, usecase.ErrAllowedRepositoryNotFound):
+		return middleware.NewAppError(http.StatusNotFound, "許可リストにリポジトリが登録されていません", err)
+	case errors.Is(err, usecase.ErrClaimRuleNotFound):
+		return middleware.NewAppError(http.StatusNotFound, "クレームルールが見つかりません", err)
+	case errors.Is(err, usecase.ErrAccessTokenNotFound):
+		return middleware.NewAppError(http.StatusNotFound, "アクセストークンが見つかりません", err)
+	case errors.Is(err, usecase.ErrObjectNotFound):
+		return middleware.NewAppError(http.StatusNotFound, "オブジェクトが見つかりません", err)
+	default:
+		return middleware.NewAppError(http.StatusInternalServerError, "サーバー内部エラーが発生しました", err)
+	}
+}
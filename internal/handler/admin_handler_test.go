@@ -0,0 +1,853 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/labstack/echo/v4"
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/na2na-p/cargohold/internal/handler"
+	"github.com/na2na-p/cargohold/internal/handler/middleware"
+	"github.com/na2na-p/cargohold/internal/usecase"
+	mock_middleware "github.com/na2na-p/cargohold/tests/handler/middleware"
+	mock_usecase "github.com/na2na-p/cargohold/tests/usecase"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	adminHandlerTestOID     = "abcd567890123456789012345678901234567890123456789012345678901234"
+	adminHandlerTestSubject = "static-token"
+)
+
+type adminHandlerFields struct {
+	allowlistUseCase   func(ctrl *gomock.Controller) usecase.RepositoryAllowlistUseCase
+	objectUseCase      func(ctrl *gomock.Controller) usecase.AdminObjectUseCase
+	claimRuleUseCase   func(ctrl *gomock.Controller) usecase.GitHubClaimRuleUseCase
+	accessTokenUseCase func(ctrl *gomock.Controller) usecase.AccessTokenUseCase
+	sessionUseCase     func(ctrl *gomock.Controller) usecase.SessionUseCase
+}
+
+func (f adminHandlerFields) newHandler(ctrl *gomock.Controller) *handler.AdminHandler {
+	var allowlistUseCase usecase.RepositoryAllowlistUseCase = mock_usecase.NewMockRepositoryAllowlistUseCase(ctrl)
+	if f.allowlistUseCase != nil {
+		allowlistUseCase = f.allowlistUseCase(ctrl)
+	}
+	var objectUseCase usecase.AdminObjectUseCase = mock_usecase.NewMockAdminObjectUseCase(ctrl)
+	if f.objectUseCase != nil {
+		objectUseCase = f.objectUseCase(ctrl)
+	}
+	var claimRuleUseCase usecase.GitHubClaimRuleUseCase = mock_usecase.NewMockGitHubClaimRuleUseCase(ctrl)
+	if f.claimRuleUseCase != nil {
+		claimRuleUseCase = f.claimRuleUseCase(ctrl)
+	}
+	var accessTokenUseCase usecase.AccessTokenUseCase = mock_usecase.NewMockAccessTokenUseCase(ctrl)
+	if f.accessTokenUseCase != nil {
+		accessTokenUseCase = f.accessTokenUseCase(ctrl)
+	}
+	var sessionUseCase usecase.SessionUseCase = mock_usecase.NewMockSessionUseCase(ctrl)
+	if f.sessionUseCase != nil {
+		sessionUseCase = f.sessionUseCase(ctrl)
+	}
+	return handler.NewAdminHandler(allowlistUseCase, objectUseCase, claimRuleUseCase, accessTokenUseCase, sessionUseCase)
+}
+
+func serveAdminRequest(t *testing.T, h *handler.AdminHandler, method, target, body string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	e := echo.New()
+	e.HTTPErrorHandler = middleware.CustomHTTPErrorHandler
+	group := e.Group("/admin/api/v1")
+	group.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			c.Set(middleware.AdminSubjectContextKey, adminHandlerTestSubject)
+			return next(c)
+		}
+	})
+	group.GET("/allowlist", h.ListAllowlist)
+	group.POST("/allowlist", h.AddAllowlist)
+	group.DELETE("/allowlist/:owner/:repo", h.RemoveAllowlist)
+	group.GET("/repositories/:owner/:repo/claim-rules", h.ListClaimRules)
+	group.POST("/repositories/:owner/:repo/claim-rules", h.AddClaimRule)
+	group.DELETE("/repositories/:owner/:repo/claim-rules/:id", h.RemoveClaimRule)
+	group.GET("/tokens", h.ListAccessTokens)
+	group.POST("/tokens", h.IssueAccessToken)
+	group.DELETE("/tokens/:id", h.RevokeAccessToken)
+	group.DELETE("/users/:id/sessions", h.RevokeUserSessions)
+	group.GET("/objects/:oid", h.GetObject)
+	group.DELETE("/objects/:oid", h.DeleteObject)
+	group.DELETE("/objects/:oid/cache", h.FlushObjectCache)
+
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+// handlerAuditEvent はハンドラーのテストで検証する監査イベントの項目
+type handlerAuditEvent struct {
+	Action     string
+	Outcome    string
+	Subject    string
+	Provider   string
+	Repository string
+	OID        string
+	Target     string
+}
+
+// newHandlerAuditRecorder は記録された監査イベントをgotに追加するモックを作成する
+func newHandlerAuditRecorder(ctrl *gomock.Controller, got *[]handlerAuditEvent) middleware.AuditRecorderInterface {
+	m := mock_middleware.NewMockAuditRecorderInterface(ctrl)
+	m.EXPECT().Record(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, events []*domain.AuditEvent) {
+		for _, e := range events {
+			*got = append(*got, handlerAuditEvent{
+				Action:     e.Action().String(),
+				Outcome:    e.Outcome().String(),
+				Subject:    e.Subject(),
+				Provider:   e.Provider(),
+				Repository: e.Repository(),
+				OID:        e.OID(),
+				Target:     e.Target(),
+			})
+		}
+	}).AnyTimes()
+	return m
+}
+
+func newAdminHandlerTestRepository(t *testing.T, fullName string) *domain.AllowedRepository {
+	t.Helper()
+	repository, err := domain.NewAllowedRepositoryFromString(fullName)
+	if err != nil {
+		t.Fatalf("NewAllowedRepositoryFromString() failed: %v", err)
+	}
+	return repository
+}
+
+func TestAdminHandler_Allowlist(t *testing.T) {
+	tests := []struct {
+		name           string
+		fields         adminHandlerFields
+		method         string
+		target         string
+		body           string
+		wantStatusCode int
+		wantBodyJSON   map[string]any
+	}{
+		{
+			name: "正常系: 許可リポジトリの一覧が返る",
+			fields: adminHandlerFields{
+				allowlistUseCase: func(ctrl *gomock.Controller) usecase.RepositoryAllowlistUseCase {
+					m := mock_usecase.NewMockRepositoryAllowlistUseCase(ctrl)
+					m.EXPECT().List(gomock.Any()).Return([]*domain.AllowedRepository{newAdminHandlerTestRepository(t, "owner/repo")}, nil)
+					return m
+				},
+			},
+			method:         http.MethodGet,
+			target:         "/admin/api/v1/allowlist",
+			wantStatusCode: http.StatusOK,
+			wantBodyJSON: map[string]any{
+				"repositories": []any{
+					map[string]any{"repository": "owner/repo", "owner": "owner", "repo": "repo"},
+				},
+			},
+		},
+		{
+			name: "正常系: リポジトリが追加され201が返る",
+			fields: adminHandlerFields{
+				allowlistUseCase: func(ctrl *gomock.Controller) usecase.RepositoryAllowlistUseCase {
+					m := mock_usecase.NewMockRepositoryAllowlistUseCase(ctrl)
+					m.EXPECT().Add(gomock.Any(), "owner/repo").Return(newAdminHandlerTestRepository(t, "owner/repo"), nil)
+					return m
+				},
+			},
+			method:         http.MethodPost,
+			target:         "/admin/api/v1/allowlist",
+			body:           `{"repository":"owner/repo"}`,
+			wantStatusCode: http.StatusCreated,
+			wantBodyJSON:   map[string]any{"repository": "owner/repo", "owner": "owner", "repo": "repo"},
+		},
+		{
+			name: "異常系: リポジトリ形式が不正な場合、400が返る",
+			fields: adminHandlerFields{
+				allowlistUseCase: func(ctrl *gomock.Controller) usecase.RepositoryAllowlistUseCase {
+					m := mock_usecase.NewMockRepositoryAllowlistUseCase(ctrl)
+					m.EXPECT().Add(gomock.Any(), "invalid").Return(nil, usecase.ErrInvalidRepository)
+					return m
+				},
+			},
+			method:         http.MethodPost,
+			target:         "/admin/api/v1/allowlist",
+			body:           `{"repository":"invalid"}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantBodyJSON:   map[string]any{"error": "リポジトリ識別子の形式が不正です"},
+		},
+		{
+			name:           "異常系: リクエストボディが不正な場合、400が返る",
+			method:         http.MethodPost,
+			target:         "/admin/api/v1/allowlist",
+			body:           `{`,
+			wantStatusCode: http.StatusBadRequest,
+			wantBodyJSON:   map[string]any{"error": "リクエストボディのパースに失敗しました"},
+		},
+		{
+			name: "正常系: リポジトリが削除され204が返る",
+			fields: adminHandlerFields{
+				allowlistUseCase: func(ctrl *gomock.Controller) usecase.RepositoryAllowlistUseCase {
+					m := mock_usecase.NewMockRepositoryAllowlistUseCase(ctrl)
+					m.EXPECT().Remove(gomock.Any(), "owner/repo").Return(newAdminHandlerTestRepository(t, "owner/repo"), nil)
+					return m
+				},
+			},
+			method:         http.MethodDelete,
+			target:         "/admin/api/v1/allowlist/owner/repo",
+			wantStatusCode: http.StatusNoContent,
+		},
+		{
+			name: "異常系: 登録されていないリポジトリを削除する場合、404が返る",
+			fields: adminHandlerFields{
+				allowlistUseCase: func(ctrl *gomock.Controller) usecase.RepositoryAllowlistUseCase {
+					m := mock_usecase.NewMockRepositoryAllowlistUseCase(ctrl)
+					m.EXPECT().Remove(gomock.Any(), "owner/repo").Return(nil, usecase.ErrAllowedRepositoryNotFound)
+					return m
+				},
+			},
+			method:         http.MethodDelete,
+			target:         "/admin/api/v1/allowlist/owner/repo",
+			wantStatusCode: http.StatusNotFound,
+			wantBodyJSON:   map[string]any{"error": "許可リストにリポジトリが登録されていません"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			rec := serveAdminRequest(t, tt.fields.newHandler(ctrl), tt.method, tt.target, tt.body)
+
+			assertAdminResponse(t, rec, tt.wantStatusCode, tt.wantBodyJSON)
+		})
+	}
+}
+
+func TestAdminHandler_ClaimRules(t *testing.T) {
+	repo, err := domain.NewRepositoryIdentifier("owner/repo")
+	if err != nil {
+		t.Fatalf("NewRepositoryIdentifier() failed: %v", err)
+	}
+	condition, err := domain.NewGitHubClaimCondition([]string{"refs/heads/main", "refs/tags/*"}, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("NewGitHubClaimCondition() failed: %v", err)
+	}
+	ruleID, _ := domain.NewGitHubClaimRuleID(1)
+	rule := domain.ReconstructGitHubClaimRule(ruleID, repo, 10, condition, domain.RepositoryAccessLevelWrite, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	ruleJSON := map[string]any{
+		"id":                float64(1),
+		"repository":        "owner/repo",
+		"priority":          float64(10),
+		"refs":              []any{"refs/heads/main", "refs/tags/*"},
+		"event_names":       []any{},
+		"environments":      []any{},
+		"job_workflow_refs": []any{},
+		"access":            "write",
+		"created_at":        "2026-01-01T00:00:00Z",
+	}
+
+	tests := []struct {
+		name           string
+		fields         adminHandlerFields
+		method         string
+		target         string
+		body           string
+		wantStatusCode int
+		wantBodyJSON   map[string]any
+	}{
+		{
+			name: "正常系: リポジトリのクレームルール一覧が返る",
+			fields: adminHandlerFields{
+				claimRuleUseCase: func(ctrl *gomock.Controller) usecase.GitHubClaimRuleUseCase {
+					m := mock_usecase.NewMockGitHubClaimRuleUseCase(ctrl)
+					m.EXPECT().List(gomock.Any(), "owner/repo").Return([]*domain.GitHubClaimRule{rule}, nil)
+					return m
+				},
+			},
+			method:         http.MethodGet,
+			target:         "/admin/api/v1/repositories/owner/repo/claim-rules",
+			wantStatusCode: http.StatusOK,
+			wantBodyJSON:   map[string]any{"rules": []any{ruleJSON}},
+		},
+		{
+			name: "正常系: クレームルールが追加され201が返る",
+			fields: adminHandlerFields{
+				claimRuleUseCase: func(ctrl *gomock.Controller) usecase.GitHubClaimRuleUseCase {
+					m := mock_usecase.NewMockGitHubClaimRuleUseCase(ctrl)
+					m.EXPECT().Add(gomock.Any(), "owner/repo", usecase.GitHubClaimRuleInput{
+						Priority: 10,
+						Refs:     []string{"refs/heads/main", "refs/tags/*"},
+						Access:   "write",
+					}).Return(rule, nil)
+					return m
+				},
+			},
+			method:         http.MethodPost,
+			target:         "/admin/api/v1/repositories/owner/repo/claim-rules",
+			body:           `{"priority":10,"refs":["refs/heads/main","refs/tags/*"],"access":"write"}`,
+			wantStatusCode: http.StatusCreated,
+			wantBodyJSON:   ruleJSON,
+		},
+		{
+			name: "異常系: ルールの内容が不正な場合、400が返る",
+			fields: adminHandlerFields{
+				claimRuleUseCase: func(ctrl *gomock.Controller) usecase.GitHubClaimRuleUseCase {
+					m := mock_usecase.NewMockGitHubClaimRuleUseCase(ctrl)
+					m.EXPECT().Add(gomock.Any(), "owner/repo", gomock.Any()).Return(nil, usecase.ErrInvalidClaimRule)
+					return m
+				},
+			},
+			method:         http.MethodPost,
+			target:         "/admin/api/v1/repositories/owner/repo/claim-rules",
+			body:           `{"access":"owner"}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantBodyJSON:   map[string]any{"error": "クレームルールの内容が不正です"},
+		},
+		{
+			name: "正常系: クレームルールが削除され204が返る",
+			fields: adminHandlerFields{
+				claimRuleUseCase: func(ctrl *gomock.Controller) usecase.GitHubClaimRuleUseCase {
+					m := mock_usecase.NewMockGitHubClaimRuleUseCase(ctrl)
+					m.EXPECT().Remove(gomock.Any(), "owner/repo", "1").Return(nil)
+					return m
+				},
+			},
+			method:         http.MethodDelete,
+			target:         "/admin/api/v1/repositories/owner/repo/claim-rules/1",
+			wantStatusCode: http.StatusNoContent,
+		},
+		{
+			name: "異常系: 存在しないクレームルールを削除する場合、404が返る",
+			fields: adminHandlerFields{
+				claimRuleUseCase: func(ctrl *gomock.Controller) usecase.GitHubClaimRuleUseCase {
+					m := mock_usecase.NewMockGitHubClaimRuleUseCase(ctrl)
+					m.EXPECT().Remove(gomock.Any(), "owner/repo", "9").Return(usecase.ErrClaimRuleNotFound)
+					return m
+				},
+			},
+			method:         http.MethodDelete,
+			target:         "/admin/api/v1/repositories/owner/repo/claim-rules/9",
+			wantStatusCode: http.StatusNotFound,
+			wantBodyJSON:   map[string]any{"error": "クレームルールが見つかりません"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			rec := serveAdminRequest(t, tt.fields.newHandler(ctrl), tt.method, tt.target, tt.body)
+
+			assertAdminResponse(t, rec, tt.wantStatusCode, tt.wantBodyJSON)
+		})
+	}
+}
+
+func TestAdminHandler_AccessTokens(t *testing.T) {
+	repo, err := domain.NewRepositoryIdentifier("owner/repo")
+	if err != nil {
+		t.Fatalf("NewRepositoryIdentifier() failed: %v", err)
+	}
+	tokenID, _ := domain.NewAccessTokenID(1)
+	createdAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	token := domain.ReconstructAccessToken(tokenID, "build-farm", adminHandlerTestSubject, []*domain.RepositoryIdentifier{repo},
+		[]domain.Operation{domain.OperationDownload}, "hash", createdAt.Add(30*24*time.Hour), nil, createdAt)
+	tokenJSON := map[string]any{
+		"id":           float64(1),
+		"name":         "build-farm",
+		"created_by":   adminHandlerTestSubject,
+		"repositories": []any{"owner/repo"},
+		"operations":   []any{"download"},
+		"expires_at":   "2026-01-31T00:00:00Z",
+		"last_used_at": nil,
+		"created_at":   "2026-01-01T00:00:00Z",
+	}
+	issuedJSON := map[string]any{"token": "cht_secret"}
+	for k, v := range tokenJSON {
+		issuedJSON[k] = v
+	}
+
+	tests := []struct {
+		name           string
+		fields         adminHandlerFields
+		method         string
+		target         string
+		body           string
+		wantStatusCode int
+		wantBodyJSON   map[string]any
+	}{
+		{
+			name: "正常系: アクセストークン一覧が返る",
+			fields: adminHandlerFields{
+				accessTokenUseCase: func(ctrl *gomock.Controller) usecase.AccessTokenUseCase {
+					m := mock_usecase.NewMockAccessTokenUseCase(ctrl)
+					m.EXPECT().List(gomock.Any()).Return([]*domain.AccessToken{token}, nil)
+					return m
+				},
+			},
+			method:         http.MethodGet,
+			target:         "/admin/api/v1/tokens",
+			wantStatusCode: http.StatusOK,
+			wantBodyJSON:   map[string]any{"tokens": []any{tokenJSON}},
+		},
+		{
+			name: "正常系: 管理者のsubjectを発行者としてアクセストークンが発行され、トークン文字列が返る",
+			fields: adminHandlerFields{
+				accessTokenUseCase: func(ctrl *gomock.Controller) usecase.AccessTokenUseCase {
+					m := mock_usecase.NewMockAccessTokenUseCase(ctrl)
+					m.EXPECT().Issue(gomock.Any(), adminHandlerTestSubject, usecase.AccessTokenInput{
+						Name:         "build-farm",
+						Repositories: []string{"owner/repo"},
+						Operations:   []string{"download"},
+						ExpiresIn:    30 * 24 * time.Hour,
+					}).Return(&usecase.IssuedAccessToken{Token: token, Secret: "cht_secret"}, nil)
+					return m
+				},
+			},
+			method:         http.MethodPost,
+			target:         "/admin/api/v1/tokens",
+			body:           `{"name":"build-farm","repositories":["owner/repo"],"operations":["download"],"expires_in_days":30}`,
+			wantStatusCode: http.StatusCreated,
+			wantBodyJSON:   issuedJSON,
+		},
+		{
+			name: "異常系: 発行内容が不正な場合、400が返る",
+			fields: adminHandlerFields{
+				accessTokenUseCase: func(ctrl *gomock.Controller) usecase.AccessTokenUseCase {
+					m := mock_usecase.NewMockAccessTokenUseCase(ctrl)
+					m.EXPECT().Issue(gomock.Any(), adminHandlerTestSubject, gomock.Any()).Return(nil, usecase.ErrInvalidAccessTokenRequest)
+					return m
+				},
+			},
+			method:         http.MethodPost,
+			target:         "/admin/api/v1/tokens",
+			body:           `{"name":"build-farm","repositories":["owner/repo"],"operations":["download"],"expires_in_days":0}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantBodyJSON:   map[string]any{"error": "アクセストークンの発行内容が不正です"},
+		},
+		{
+			name: "正常系: アクセストークンが失効され204が返る",
+			fields: adminHandlerFields{
+				accessTokenUseCase: func(ctrl *gomock.Controller) usecase.AccessTokenUseCase {
+					m := mock_usecase.NewMockAccessTokenUseCase(ctrl)
+					m.EXPECT().Revoke(gomock.Any(), "1").Return(nil)
+					return m
+				},
+			},
+			method:         http.MethodDelete,
+			target:         "/admin/api/v1/tokens/1",
+			wantStatusCode: http.StatusNoContent,
+		},
+		{
+			name: "異常系: 存在しないアクセストークンを失効する場合、404が返る",
+			fields: adminHandlerFields{
+				accessTokenUseCase: func(ctrl *gomock.Controller) usecase.AccessTokenUseCase {
+					m := mock_usecase.NewMockAccessTokenUseCase(ctrl)
+					m.EXPECT().Revoke(gomock.Any(), "9").Return(usecase.ErrAccessTokenNotFound)
+					return m
+				},
+			},
+			method:         http.MethodDelete,
+			target:         "/admin/api/v1/tokens/9",
+			wantStatusCode: http.StatusNotFound,
+			wantBodyJSON:   map[string]any{"error": "アクセストークンが見つかりません"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			rec := serveAdminRequest(t, tt.fields.newHandler(ctrl), tt.method, tt.target, tt.body)
+
+			assertAdminResponse(t, rec, tt.wantStatusCode, tt.wantBodyJSON)
+		})
+	}
+}
+
+func TestAdminHandler_RevokeUserSessions(t *testing.T) {
+	tests := []struct {
+		name           string
+		fields         adminHandlerFields
+		target         string
+		wantStatusCode int
+		wantBodyJSON   map[string]any
+	}{
+		{
+			name: "正常系: ユーザーのセッションとアクセストークンがすべて失効され、失効数が返る",
+			fields: adminHandlerFields{
+				sessionUseCase: func(ctrl *gomock.Controller) usecase.SessionUseCase {
+					m := mock_usecase.NewMockSessionUseCase(ctrl)
+					m.EXPECT().RevokeAllForGitHubUser(gomock.Any(), "12345").Return(&usecase.RevokedUserCredentials{Sessions: 2, AccessTokens: 1}, nil)
+					return m
+				},
+			},
+			target:         "/admin/api/v1/users/12345/sessions",
+			wantStatusCode: http.StatusOK,
+			wantBodyJSON:   map[string]any{"revoked": float64(2), "revoked_access_tokens": float64(1)},
+		},
+		{
+			name: "異常系: GitHubユーザーIDが不正な場合、400が返る",
+			fields: adminHandlerFields{
+				sessionUseCase: func(ctrl *gomock.Controller) usecase.SessionUseCase {
+					m := mock_usecase.NewMockSessionUseCase(ctrl)
+					m.EXPECT().RevokeAllForGitHubUser(gomock.Any(), "octocat").Return(nil, usecase.ErrInvalidGitHubUserID)
+					return m
+				},
+			},
+			target:         "/admin/api/v1/users/octocat/sessions",
+			wantStatusCode: http.StatusBadRequest,
+			wantBodyJSON:   map[string]any{"error": "GitHubユーザーIDの形式が不正です"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			rec := serveAdminRequest(t, tt.fields.newHandler(ctrl), http.MethodDelete, tt.target, "")
+
+			assertAdminResponse(t, rec, tt.wantStatusCode, tt.wantBodyJSON)
+		})
+	}
+}
+
+func TestAdminHandler_Objects(t *testing.T) {
+	createdAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	oid, _ := domain.NewOID(adminHandlerTestOID)
+	size, _ := domain.NewSize(1024)
+	obj, _ := domain.ReconstructLFSObject(oid, size, "sha256", "objects/sha256/"+adminHandlerTestOID, true, createdAt, createdAt)
+	repository, _ := domain.NewRepositoryIdentifier("owner/repo")
+	policyID, _ := domain.NewAccessPolicyID(1)
+	policy := domain.NewAccessPolicy(policyID, oid, repository, createdAt)
+
+	tests := []struct {
+		name           string
+		fields         adminHandlerFields
+		method         string
+		target         string
+		wantStatusCode int
+		wantBodyJSON   map[string]any
+	}{
+		{
+			name: "正常系: オブジェクトのメタデータとアクセスポリシーが返る",
+			fields: adminHandlerFields{
+				objectUseCase: func(ctrl *gomock.Controller) usecase.AdminObjectUseCase {
+					m := mock_usecase.NewMockAdminObjectUseCase(ctrl)
+					m.EXPECT().GetObject(gomock.Any(), adminHandlerTestOID).Return(&usecase.AdminObjectDetail{
+						Object:   obj,
+						Policies: []*domain.AccessPolicy{policy},
+					}, nil)
+					return m
+				},
+			},
+			method:         http.MethodGet,
+			target:         "/admin/api/v1/objects/" + adminHandlerTestOID,
+			wantStatusCode: http.StatusOK,
+			wantBodyJSON: map[string]any{
+				"oid":         adminHandlerTestOID,
+				"size":        float64(1024),
+				"hash_algo":   "sha256",
+				"storage_key": "objects/sha256/" + adminHandlerTestOID,
+				"uploaded":    true,
+				"created_at":  "2024-01-01T12:00:00Z",
+				"updated_at":  "2024-01-01T12:00:00Z",
+				"access_policies": []any{
+					map[string]any{"id": float64(1), "repository": "owner/repo", "created_at": "2024-01-01T12:00:00Z"},
+				},
+			},
+		},
+		{
+			name: "異常系: オブジェクトが存在しない場合、404が返る",
+			fields: adminHandlerFields{
+				objectUseCase: func(ctrl *gomock.Controller) usecase.AdminObjectUseCase {
+					m := mock_usecase.NewMockAdminObjectUseCase(ctrl)
+					m.EXPECT().GetObject(gomock.Any(), adminHandlerTestOID).Return(nil, usecase.ErrObjectNotFound)
+					return m
+				},
+			},
+			method:         http.MethodGet,
+			target:         "/admin/api/v1/objects/" + adminHandlerTestOID,
+			wantStatusCode: http.StatusNotFound,
+			wantBodyJSON:   map[string]any{"error": "オブジェクトが見つかりません"},
+		},
+		{
+			name: "正常系: オブジェクトが削除され204が返る",
+			fields: adminHandlerFields{
+				objectUseCase: func(ctrl *gomock.Controller) usecase.AdminObjectUseCase {
+					m := mock_usecase.NewMockAdminObjectUseCase(ctrl)
+					m.EXPECT().DeleteObject(gomock.Any(), adminHandlerTestOID).Return(nil)
+					return m
+				},
+			},
+			method:         http.MethodDelete,
+			target:         "/admin/api/v1/objects/" + adminHandlerTestOID,
+			wantStatusCode: http.StatusNoContent,
+		},
+		{
+			name: "異常系: 削除に失敗した場合、500が返る",
+			fields: adminHandlerFields{
+				objectUseCase: func(ctrl *gomock.Controller) usecase.AdminObjectUseCase {
+					m := mock_usecase.NewMockAdminObjectUseCase(ctrl)
+					m.EXPECT().DeleteObject(gomock.Any(), adminHandlerTestOID).Return(errors.New("s3 error"))
+					return m
+				},
+			},
+			method:         http.MethodDelete,
+			target:         "/admin/api/v1/objects/" + adminHandlerTestOID,
+			wantStatusCode: http.StatusInternalServerError,
+			wantBodyJSON:   map[string]any{"error": "サーバー内部エラーが発生しました"},
+		},
+		{
+			name: "正常系: キャッシュが削除され204が返る",
+			fields: adminHandlerFields{
+				objectUseCase: func(ctrl *gomock.Controller) usecase.AdminObjectUseCase {
+					m := mock_usecase.NewMockAdminObjectUseCase(ctrl)
+					m.EXPECT().FlushObjectCache(gomock.Any(), adminHandlerTestOID).Return(nil)
+					return m
+				},
+			},
+			method:         http.MethodDelete,
+			target:         "/admin/api/v1/objects/" + adminHandlerTestOID + "/cache",
+			wantStatusCode: http.StatusNoContent,
+		},
+		{
+			name: "異常系: OIDの形式が不正な場合、400が返る",
+			fields: adminHandlerFields{
+				objectUseCase: func(ctrl *gomock.Controller) usecase.AdminObjectUseCase {
+					m := mock_usecase.NewMockAdminObjectUseCase(ctrl)
+					m.EXPECT().FlushObjectCache(gomock.Any(), "invalid").Return(usecase.ErrInvalidOID)
+					return m
+				},
+			},
+			method:         http.MethodDelete,
+			target:         "/admin/api/v1/objects/invalid/cache",
+			wantStatusCode: http.StatusBadRequest,
+			wantBodyJSON:   map[string]any{"error": "OIDの形式が不正です"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			rec := serveAdminRequest(t, tt.fields.newHandler(ctrl), tt.method, tt.target, "")
+
+			assertAdminResponse(t, rec, tt.wantStatusCode, tt.wantBodyJSON)
+		})
+	}
+}
+
+func TestAdminHandler_Audit(t *testing.T) {
+	repo, err := domain.NewRepositoryIdentifier("owner/repo")
+	if err != nil {
+		t.Fatalf("NewRepositoryIdentifier() failed: %v", err)
+	}
+	condition, err := domain.NewGitHubClaimCondition([]string{"refs/heads/main"}, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("NewGitHubClaimCondition() failed: %v", err)
+	}
+	ruleID, _ := domain.NewGitHubClaimRuleID(5)
+	rule := domain.ReconstructGitHubClaimRule(ruleID, repo, 10, condition, domain.RepositoryAccessLevelWrite, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	tokenID, _ := domain.NewAccessTokenID(3)
+	createdAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	token := domain.ReconstructAccessToken(tokenID, "build-farm", adminHandlerTestSubject, []*domain.RepositoryIdentifier{repo},
+		[]domain.Operation{domain.OperationDownload}, "hash", createdAt.Add(24*time.Hour), nil, createdAt)
+
+	tests := []struct {
+		name          string
+		fields        adminHandlerFields
+		authorization string
+		method        string
+		target        string
+		body          string
+		wantEvents    []handlerAuditEvent
+	}{
+		{
+			name: "正常系: クレームルールの追加が作成したルールのIDとともに記録される",
+			fields: adminHandlerFields{
+				claimRuleUseCase: func(ctrl *gomock.Controller) usecase.GitHubClaimRuleUseCase {
+					m := mock_usecase.NewMockGitHubClaimRuleUseCase(ctrl)
+					m.EXPECT().Add(gomock.Any(), "owner/repo", gomock.Any()).Return(rule, nil)
+					return m
+				},
+			},
+			authorization: "Bearer " + adminHandlerTestSubject,
+			method:        http.MethodPost,
+			target:        "/admin/api/v1/repositories/owner/repo/claim-rules",
+			body:          `{"priority":10,"refs":["refs/heads/main"],"access":"write"}`,
+			wantEvents: []handlerAuditEvent{
+				{Action: "admin_claim_rule_add", Outcome: "success", Subject: adminHandlerTestSubject, Provider: "admin", Repository: "owner/repo", Target: "5"},
+			},
+		},
+		{
+			name: "正常系: クレームルールの削除がルールのIDとともに記録される",
+			fields: adminHandlerFields{
+				claimRuleUseCase: func(ctrl *gomock.Controller) usecase.GitHubClaimRuleUseCase {
+					m := mock_usecase.NewMockGitHubClaimRuleUseCase(ctrl)
+					m.EXPECT().Remove(gomock.Any(), "owner/repo", "5").Return(nil)
+					return m
+				},
+			},
+			authorization: "Bearer " + adminHandlerTestSubject,
+			method:        http.MethodDelete,
+			target:        "/admin/api/v1/repositories/owner/repo/claim-rules/5",
+			wantEvents: []handlerAuditEvent{
+				{Action: "admin_claim_rule_remove", Outcome: "success", Subject: adminHandlerTestSubject, Provider: "admin", Repository: "owner/repo", Target: "5"},
+			},
+		},
+		{
+			name: "正常系: アクセストークンの発行が発行したトークンのIDとともに記録される",
+			fields: adminHandlerFields{
+				accessTokenUseCase: func(ctrl *gomock.Controller) usecase.AccessTokenUseCase {
+					m := mock_usecase.NewMockAccessTokenUseCase(ctrl)
+					m.EXPECT().Issue(gomock.Any(), adminHandlerTestSubject, gomock.Any()).Return(&usecase.IssuedAccessToken{Token: token, Secret: "cht_secret"}, nil)
+					return m
+				},
+			},
+			authorization: "Bearer " + adminHandlerTestSubject,
+			method:        http.MethodPost,
+			target:        "/admin/api/v1/tokens",
+			body:          `{"name":"build-farm","repositories":["owner/repo"],"operations":["download"],"expires_in_days":1}`,
+			wantEvents: []handlerAuditEvent{
+				{Action: "admin_token_issue", Outcome: "success", Subject: adminHandlerTestSubject, Provider: "admin", Target: "3"},
+			},
+		},
+		{
+			name: "正常系: 存在しないアクセストークンの失効が失敗として記録される",
+			fields: adminHandlerFields{
+				accessTokenUseCase: func(ctrl *gomock.Controller) usecase.AccessTokenUseCase {
+					m := mock_usecase.NewMockAccessTokenUseCase(ctrl)
+					m.EXPECT().Revoke(gomock.Any(), "9").Return(usecase.ErrAccessTokenNotFound)
+					return m
+				},
+			},
+			authorization: "Bearer " + adminHandlerTestSubject,
+			method:        http.MethodDelete,
+			target:        "/admin/api/v1/tokens/9",
+			wantEvents: []handlerAuditEvent{
+				{Action: "admin_token_revoke", Outcome: "failure", Subject: adminHandlerTestSubject, Provider: "admin", Target: "9"},
+			},
+		},
+		{
+			name: "正常系: セッションの失効がGitHubユーザーIDとともに記録される",
+			fields: adminHandlerFields{
+				sessionUseCase: func(ctrl *gomock.Controller) usecase.SessionUseCase {
+					m := mock_usecase.NewMockSessionUseCase(ctrl)
+					m.EXPECT().RevokeAllForGitHubUser(gomock.Any(), "12345").Return(&usecase.RevokedUserCredentials{Sessions: 1}, nil)
+					return m
+				},
+			},
+			authorization: "Bearer " + adminHandlerTestSubject,
+			method:        http.MethodDelete,
+			target:        "/admin/api/v1/users/12345/sessions",
+			wantEvents: []handlerAuditEvent{
+				{Action: "admin_session_revoke", Outcome: "success", Subject: adminHandlerTestSubject, Provider: "admin", Target: "12345"},
+			},
+		},
+		{
+			name: "正常系: オブジェクトの削除がOIDとともに記録される",
+			fields: adminHandlerFields{
+				objectUseCase: func(ctrl *gomock.Controller) usecase.AdminObjectUseCase {
+					m := mock_usecase.NewMockAdminObjectUseCase(ctrl)
+					m.EXPECT().DeleteObject(gomock.Any(), adminHandlerTestOID).Return(nil)
+					return m
+				},
+			},
+			authorization: "Bearer " + adminHandlerTestSubject,
+			method:        http.MethodDelete,
+			target:        "/admin/api/v1/objects/" + adminHandlerTestOID,
+			wantEvents: []handlerAuditEvent{
+				{Action: "admin_object_delete", Outcome: "success", Subject: adminHandlerTestSubject, Provider: "admin", OID: adminHandlerTestOID},
+			},
+		},
+		{
+			name:   "正常系: 管理者の認証に失敗したリクエストが認証失敗として記録される",
+			fields: adminHandlerFields{},
+			method: http.MethodDelete,
+			target: "/admin/api/v1/tokens/3",
+			wantEvents: []handlerAuditEvent{
+				{Action: "authenticate", Outcome: "denied"},
+			},
+		},
+		{
+			name: "正常系: 参照系のリクエストは記録されない",
+			fields: adminHandlerFields{
+				allowlistUseCase: func(ctrl *gomock.Controller) usecase.RepositoryAllowlistUseCase {
+					m := mock_usecase.NewMockRepositoryAllowlistUseCase(ctrl)
+					m.EXPECT().List(gomock.Any()).Return(nil, nil)
+					return m
+				},
+			},
+			authorization: "Bearer " + adminHandlerTestSubject,
+			method:        http.MethodGet,
+			target:        "/admin/api/v1/allowlist",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			h := tt.fields.newHandler(ctrl)
+
+			var gotEvents []handlerAuditEvent
+			adminAuthUC := mock_middleware.NewMockAdminAuthUseCaseInterface(ctrl)
+			adminAuthUC.EXPECT().Authenticate(gomock.Any(), adminHandlerTestSubject).Return(adminHandlerTestSubject, nil).AnyTimes()
+
+			e := echo.New()
+			e.HTTPErrorHandler = middleware.CustomHTTPErrorHandler
+			group := e.Group("/admin/api/v1", middleware.Audit(newHandlerAuditRecorder(ctrl, &gotEvents)))
+			group.Use(middleware.AdminAuth(adminAuthUC))
+			group.GET("/allowlist", h.ListAllowlist)
+			group.POST("/repositories/:owner/:repo/claim-rules", h.AddClaimRule)
+			group.DELETE("/repositories/:owner/:repo/claim-rules/:id", h.RemoveClaimRule)
+			group.POST("/tokens", h.IssueAccessToken)
+			group.DELETE("/tokens/:id", h.RevokeAccessToken)
+			group.DELETE("/users/:id/sessions", h.RevokeUserSessions)
+			group.DELETE("/objects/:oid", h.DeleteObject)
+
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			if tt.authorization != "" {
+				req.Header.Set(echo.HeaderAuthorization, tt.authorization)
+			}
+			e.ServeHTTP(httptest.NewRecorder(), req)
+
+			if diff := cmp.Diff(tt.wantEvents, gotEvents); diff != "" {
+				t.Errorf("audit events mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func assertAdminResponse(t *testing.T, rec *httptest.ResponseRecorder, wantStatusCode int, wantBodyJSON map[string]any) {
+	t.Helper()
+
+	if rec.Code != wantStatusCode {
+		t.Fatalf("status code = %d, want %d, body = %s", rec.Code, wantStatusCode, rec.Body.String())
+	}
+	if wantBodyJSON == nil {
+		return
+	}
+
+	var got map[string]any
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatalf("failed to unmarshal response body: %v", err)
+	}
+	if diff := cmp.Diff(wantBodyJSON, got); diff != "" {
+		t.Errorf("response body mismatch (-want +got):\n%s", diff)
+	}
+}
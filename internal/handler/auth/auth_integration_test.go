@@ -92,10 +92,12 @@ func TestAuthUseCase_AuthenticateSession(t *testing.T) {
 			mockGitHub := &mockGitHubOIDCProvider{}
 			mockRepoAllowlist := &mockRepositoryAllowlistRepository{}
 
-			authUC := usecase.NewAuthUseCase(mockGitHub, mockRepoAllowlist, mockSession)
+			authUC := usecase.NewAuthUseCase(map[string]usecase.OIDCAuthenticator{
+				domain.GitHubActionsIssuer: usecase.NewGitHubOIDCUseCase(mockGitHub, mockRepoAllowlist, nil),
+			}, usecase.NewSessionAuthUseCase(mockSession, nil, nil, 0), nil)
 
 			ctx := context.Background()
-			userInfo, err := authUC.AuthenticateSession(ctx, tt.sessionID)
+			userInfo, err := authUC.AuthenticateSession(ctx, tt.sessionID, nil)
 
 			if tt.expectError && err == nil {
 				t.Error("expected error but got nil")
@@ -115,7 +117,7 @@ func TestAuthUseCase_AuthenticateSession(t *testing.T) {
 	}
 }
 
-func TestAuthUseCase_AuthenticateGitHubOIDC(t *testing.T) {
+func TestAuthUseCase_AuthenticateOIDC(t *testing.T) {
 	tests := []struct {
 		name        string
 		token       string
@@ -136,10 +138,12 @@ func TestAuthUseCase_AuthenticateGitHubOIDC(t *testing.T) {
 			mockSession := newMockSessionClient()
 			mockRepoAllowlist := &mockRepositoryAllowlistRepository{}
 
-			authUC := usecase.NewAuthUseCase(mockGitHub, mockRepoAllowlist, mockSession)
+			authUC := usecase.NewAuthUseCase(map[string]usecase.OIDCAuthenticator{
+				domain.GitHubActionsIssuer: usecase.NewGitHubOIDCUseCase(mockGitHub, mockRepoAllowlist, nil),
+			}, usecase.NewSessionAuthUseCase(mockSession, nil, nil, 0), nil)
 
 			ctx := context.Background()
-			userInfo, err := authUC.AuthenticateGitHubOIDC(ctx, tt.token)
+			userInfo, err := authUC.AuthenticateOIDC(ctx, domain.GitHubActionsIssuer, tt.token)
 
 			if tt.expectError && err == nil {
 				t.Error("expected error but got nil")
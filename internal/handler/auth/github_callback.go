@@ -33,7 +33,22 @@ func GitHubCallbackHandler(githubOAuthUC GitHubOAuthUseCaseInterface) echo.Handl
 			)
 		}
 
-		sessionID, shellType, err := githubOAuthUC.HandleCallback(ctx, code, state)
+		// stateは一度しか使えないため、結果に関わらずCookieを削除する
+		var browserState string
+		if cookie, err := c.Cookie(common.OAuthStateCookieName); err == nil {
+			browserState = cookie.Value
+		}
+		c.SetCookie(&http.Cookie{
+			Name:     common.OAuthStateCookieName,
+			Value:    "",
+			Path:     common.OAuthStateCookiePath,
+			HttpOnly: true,
+			Secure:   true,
+			SameSite: http.SameSiteLaxMode,
+			MaxAge:   -1,
+		})
+
+		sessionID, shellType, err := githubOAuthUC.HandleCallback(ctx, code, state, browserState)
 		if err != nil {
 			return handleCallbackError(err)
 		}
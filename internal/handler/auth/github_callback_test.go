@@ -20,8 +20,9 @@ import (
 
 func TestGitHubCallbackHandler(t *testing.T) {
 	type args struct {
-		code  string
-		state string
+		code        string
+		state       string
+		stateCookie string
 	}
 	tests := []struct {
 		name                     string
@@ -37,14 +38,15 @@ func TestGitHubCallbackHandler(t *testing.T) {
 		{
 			name: "正常系: コールバック処理が成功しセッションCookieが設定される",
 			args: args{
-				code:  "valid-auth-code",
-				state: "valid-state",
+				code:        "valid-auth-code",
+				state:       "valid-state",
+				stateCookie: "valid-state",
 			},
 			host: "example.com",
 			setupMock: func(ctrl *gomock.Controller) *mockauth.MockGitHubOAuthUseCaseInterface {
 				m := mockauth.NewMockGitHubOAuthUseCaseInterface(ctrl)
 				m.EXPECT().
-					HandleCallback(gomock.Any(), "valid-auth-code", "valid-state").
+					HandleCallback(gomock.Any(), "valid-auth-code", "valid-state", "valid-state").
 					Return("session-id-12345", domain.ShellTypeBash, nil)
 				return m
 			},
@@ -57,8 +59,9 @@ func TestGitHubCallbackHandler(t *testing.T) {
 		{
 			name: "異常系: codeパラメータが空の場合はBadRequestを返す",
 			args: args{
-				code:  "",
-				state: "valid-state",
+				code:        "",
+				state:       "valid-state",
+				stateCookie: "valid-state",
 			},
 			setupMock: func(ctrl *gomock.Controller) *mockauth.MockGitHubOAuthUseCaseInterface {
 				return mockauth.NewMockGitHubOAuthUseCaseInterface(ctrl)
@@ -96,13 +99,14 @@ func TestGitHubCallbackHandler(t *testing.T) {
 		{
 			name: "異常系: state検証が失敗した場合はUnauthorizedを返す",
 			args: args{
-				code:  "valid-auth-code",
-				state: "invalid-state",
+				code:        "valid-auth-code",
+				state:       "invalid-state",
+				stateCookie: "invalid-state",
 			},
 			setupMock: func(ctrl *gomock.Controller) *mockauth.MockGitHubOAuthUseCaseInterface {
 				m := mockauth.NewMockGitHubOAuthUseCaseInterface(ctrl)
 				m.EXPECT().
-					HandleCallback(gomock.Any(), "valid-auth-code", "invalid-state").
+					HandleCallback(gomock.Any(), "valid-auth-code", "invalid-state", "invalid-state").
 					Return("", domain.ShellType{}, fmt.Errorf("%w: state not found", usecase.ErrInvalidState))
 				return m
 			},
@@ -113,13 +117,14 @@ func TestGitHubCallbackHandler(t *testing.T) {
 		{
 			name: "異常系: リポジトリアクセス権がない場合はForbiddenを返す",
 			args: args{
-				code:  "valid-auth-code",
-				state: "valid-state",
+				code:        "valid-auth-code",
+				state:       "valid-state",
+				stateCookie: "valid-state",
 			},
 			setupMock: func(ctrl *gomock.Controller) *mockauth.MockGitHubOAuthUseCaseInterface {
 				m := mockauth.NewMockGitHubOAuthUseCaseInterface(ctrl)
 				m.EXPECT().
-					HandleCallback(gomock.Any(), "valid-auth-code", "valid-state").
+					HandleCallback(gomock.Any(), "valid-auth-code", "valid-state", "valid-state").
 					Return("", domain.ShellType{}, fmt.Errorf("%w: access denied", usecase.ErrRepositoryAccessDenied))
 				return m
 			},
@@ -130,13 +135,14 @@ func TestGitHubCallbackHandler(t *testing.T) {
 		{
 			name: "異常系: コード交換が失敗した場合はUnauthorizedを返す",
 			args: args{
-				code:  "invalid-code",
-				state: "valid-state",
+				code:        "invalid-code",
+				state:       "valid-state",
+				stateCookie: "valid-state",
 			},
 			setupMock: func(ctrl *gomock.Controller) *mockauth.MockGitHubOAuthUseCaseInterface {
 				m := mockauth.NewMockGitHubOAuthUseCaseInterface(ctrl)
 				m.EXPECT().
-					HandleCallback(gomock.Any(), "invalid-code", "valid-state").
+					HandleCallback(gomock.Any(), "invalid-code", "valid-state", "valid-state").
 					Return("", domain.ShellType{}, fmt.Errorf("%w: exchange failed", usecase.ErrCodeExchangeFailed))
 				return m
 			},
@@ -147,14 +153,15 @@ func TestGitHubCallbackHandler(t *testing.T) {
 		{
 			name: "正常系: shellがゼロ値の場合はリダイレクトURLにshellパラメータが付与されない",
 			args: args{
-				code:  "valid-auth-code",
-				state: "valid-state",
+				code:        "valid-auth-code",
+				state:       "valid-state",
+				stateCookie: "valid-state",
 			},
 			host: "example.com",
 			setupMock: func(ctrl *gomock.Controller) *mockauth.MockGitHubOAuthUseCaseInterface {
 				m := mockauth.NewMockGitHubOAuthUseCaseInterface(ctrl)
 				m.EXPECT().
-					HandleCallback(gomock.Any(), "valid-auth-code", "valid-state").
+					HandleCallback(gomock.Any(), "valid-auth-code", "valid-state", "valid-state").
 					Return("session-id-12345", domain.ShellType{}, nil)
 				return m
 			},
@@ -167,13 +174,14 @@ func TestGitHubCallbackHandler(t *testing.T) {
 		{
 			name: "異常系: その他のエラーの場合はInternalServerErrorを返す",
 			args: args{
-				code:  "valid-auth-code",
-				state: "valid-state",
+				code:        "valid-auth-code",
+				state:       "valid-state",
+				stateCookie: "valid-state",
 			},
 			setupMock: func(ctrl *gomock.Controller) *mockauth.MockGitHubOAuthUseCaseInterface {
 				m := mockauth.NewMockGitHubOAuthUseCaseInterface(ctrl)
 				m.EXPECT().
-					HandleCallback(gomock.Any(), "valid-auth-code", "valid-state").
+					HandleCallback(gomock.Any(), "valid-auth-code", "valid-state", "valid-state").
 					Return("", domain.ShellType{}, errors.New("unexpected error"))
 				return m
 			},
@@ -181,6 +189,23 @@ func TestGitHubCallbackHandler(t *testing.T) {
 			expectCookie:   false,
 			wantAppError:   true,
 		},
+		{
+			name: "異常系: ブラウザにstateのCookieがない場合はUnauthorizedを返す",
+			args: args{
+				code:  "valid-auth-code",
+				state: "valid-state",
+			},
+			setupMock: func(ctrl *gomock.Controller) *mockauth.MockGitHubOAuthUseCaseInterface {
+				m := mockauth.NewMockGitHubOAuthUseCaseInterface(ctrl)
+				m.EXPECT().
+					HandleCallback(gomock.Any(), "valid-auth-code", "valid-state", "").
+					Return("", domain.ShellType{}, usecase.ErrInvalidState)
+				return m
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectCookie:   false,
+			wantAppError:   true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -208,6 +233,9 @@ func TestGitHubCallbackHandler(t *testing.T) {
 			if tt.host != "" {
 				req.Host = tt.host
 			}
+			if tt.args.stateCookie != "" {
+				req.AddCookie(&http.Cookie{Name: "lfs_oauth_state", Value: tt.args.stateCookie})
+			}
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
 
@@ -266,6 +294,17 @@ func TestGitHubCallbackHandler(t *testing.T) {
 				}
 			}
 
+			stateCookieCleared := false
+			for _, cookie := range rec.Result().Cookies() {
+				if cookie.Name == "lfs_oauth_state" && cookie.MaxAge < 0 && cookie.Path == "/auth/github/callback" {
+					stateCookieCleared = true
+				}
+			}
+			// パラメータの検証を通過した場合は、stateを使い終えたCookieを削除する
+			if tt.args.code != "" && tt.args.state != "" && !stateCookieCleared {
+				t.Error("state cookie 'lfs_oauth_state' should be cleared")
+			}
+
 			if tt.expectCookie {
 				cookies := rec.Result().Cookies()
 				found := false
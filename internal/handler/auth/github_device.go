@@ -0,0 +1,97 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/na2na-p/cargohold/internal/handler/dto"
+	"github.com/na2na-p/cargohold/internal/handler/middleware"
+	"github.com/na2na-p/cargohold/internal/usecase"
+)
+
+// GitHubDeviceHandler はブラウザを開けない環境からのログイン向けに、GitHubのデバイスフローを開始する
+func GitHubDeviceHandler(githubOAuthUC GitHubOAuthUseCaseInterface) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		repositoryParam := c.FormValue("repository")
+		if repositoryParam == "" {
+			return middleware.NewAppError(
+				http.StatusBadRequest,
+				"repositoryパラメータが指定されていません",
+				errors.New("repository parameter is empty"),
+			)
+		}
+
+		scope, err := domain.ParseRepositoryScope(repositoryParam)
+		if err != nil {
+			return middleware.NewAppError(
+				http.StatusBadRequest,
+				"repositoryパラメータの形式が不正です",
+				fmt.Errorf("invalid repository %q: %w", repositoryParam, err),
+			)
+		}
+
+		result, err := githubOAuthUC.StartDeviceAuthorization(c.Request().Context(), scope)
+		if err != nil {
+			if errors.Is(err, usecase.ErrRepositoryScopeNotEnabled) {
+				return newRepositoryScopeNotEnabledError(err)
+			}
+			return middleware.NewAppError(
+				http.StatusInternalServerError,
+				"デバイスフローの開始に失敗しました",
+				err,
+			)
+		}
+
+		return c.JSON(http.StatusOK, dto.NewDeviceAuthorizationResponseDTO(result))
+	}
+}
+
+// GitHubDeviceTokenHandler はデバイスフローのポーリングを受け付け、承認されていればセッションを返す
+// 承認前の状態は RFC 8628 と同じく400とエラーコードで返す
+func GitHubDeviceTokenHandler(githubOAuthUC GitHubOAuthUseCaseInterface) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		deviceCode := c.FormValue("device_code")
+		if deviceCode == "" {
+			return middleware.NewAppError(
+				http.StatusBadRequest,
+				"device_codeパラメータが指定されていません",
+				errors.New("device_code parameter is empty"),
+			)
+		}
+
+		sessionID, err := githubOAuthUC.PollDeviceAuthorization(c.Request().Context(), deviceCode)
+		if err != nil {
+			return handleDeviceTokenError(c, err)
+		}
+
+		return c.JSON(http.StatusOK, &dto.DeviceTokenResponseDTO{
+			SessionID: sessionID,
+			ExpiresIn: int(usecase.SessionTTL / time.Second),
+		})
+	}
+}
+
+func handleDeviceTokenError(c echo.Context, err error) error {
+	var code, description string
+	switch {
+	case errors.Is(err, usecase.ErrAuthorizationPending):
+		code, description = "authorization_pending", "ユーザーの承認を待っています"
+	case errors.Is(err, usecase.ErrSlowDown):
+		code, description = "slow_down", "ポーリングの間隔を空けてください"
+	case errors.Is(err, usecase.ErrInvalidDeviceCode):
+		code, description = "expired_token", "デバイスコードが無効または期限切れです"
+	case errors.Is(err, usecase.ErrDeviceAuthorizationDenied):
+		code, description = "access_denied", "ユーザーが認可を拒否しました"
+	default:
+		return handleCallbackError(err)
+	}
+
+	return c.JSON(http.StatusBadRequest, &dto.DeviceTokenErrorResponseDTO{
+		Error:            code,
+		ErrorDescription: description,
+	})
+}
@@ -0,0 +1,246 @@
+package auth_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/labstack/echo/v4"
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/na2na-p/cargohold/internal/handler/auth"
+	"github.com/na2na-p/cargohold/internal/handler/middleware"
+	"github.com/na2na-p/cargohold/internal/usecase"
+	mockauth "github.com/na2na-p/cargohold/tests/handler/auth"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGitHubDeviceHandler(t *testing.T) {
+	tests := []struct {
+		name           string
+		form           url.Values
+		setupMock      func(ctrl *gomock.Controller) *mockauth.MockGitHubOAuthUseCaseInterface
+		expectedStatus int
+		expectedBody   map[string]any
+		wantAppError   bool
+	}{
+		{
+			name: "正常系: デバイスコードとユーザーコードが返る",
+			form: url.Values{"repository": {"owner/repo"}},
+			setupMock: func(ctrl *gomock.Controller) *mockauth.MockGitHubOAuthUseCaseInterface {
+				scope, _ := domain.ParseRepositoryScope("owner/repo")
+				m := mockauth.NewMockGitHubOAuthUseCaseInterface(ctrl)
+				m.EXPECT().StartDeviceAuthorization(gomock.Any(), scope).Return(&usecase.DeviceCodeResult{
+					DeviceCode:      "device-id",
+					UserCode:        "ABCD-1234",
+					VerificationURI: "https://github.com/login/device",
+					ExpiresIn:       15 * time.Minute,
+					Interval:        5 * time.Second,
+				}, nil)
+				return m
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]any{
+				"device_code":      "device-id",
+				"user_code":        "ABCD-1234",
+				"verification_uri": "https://github.com/login/device",
+				"expires_in":       float64(900),
+				"interval":         float64(5),
+			},
+		},
+		{
+			name: "異常系: repositoryが指定されていない場合はBadRequestを返す",
+			form: url.Values{},
+			setupMock: func(ctrl *gomock.Controller) *mockauth.MockGitHubOAuthUseCaseInterface {
+				return mockauth.NewMockGitHubOAuthUseCaseInterface(ctrl)
+			},
+			expectedStatus: http.StatusBadRequest,
+			wantAppError:   true,
+		},
+		{
+			name: "異常系: repositoryの形式が不正な場合はBadRequestを返す",
+			form: url.Values{"repository": {"invalid"}},
+			setupMock: func(ctrl *gomock.Controller) *mockauth.MockGitHubOAuthUseCaseInterface {
+				return mockauth.NewMockGitHubOAuthUseCaseInterface(ctrl)
+			},
+			expectedStatus: http.StatusBadRequest,
+			wantAppError:   true,
+		},
+		{
+			name: "異常系: 複数のリポジトリを対象とするログインが有効でない場合はBadRequestを返す",
+			form: url.Values{"repository": {"owner/*"}},
+			setupMock: func(ctrl *gomock.Controller) *mockauth.MockGitHubOAuthUseCaseInterface {
+				m := mockauth.NewMockGitHubOAuthUseCaseInterface(ctrl)
+				m.EXPECT().StartDeviceAuthorization(gomock.Any(), gomock.Any()).Return(nil, usecase.ErrRepositoryScopeNotEnabled)
+				return m
+			},
+			expectedStatus: http.StatusBadRequest,
+			wantAppError:   true,
+		},
+		{
+			name: "異常系: デバイスコードの要求に失敗した場合はInternalServerErrorを返す",
+			form: url.Values{"repository": {"owner/repo"}},
+			setupMock: func(ctrl *gomock.Controller) *mockauth.MockGitHubOAuthUseCaseInterface {
+				m := mockauth.NewMockGitHubOAuthUseCaseInterface(ctrl)
+				m.EXPECT().StartDeviceAuthorization(gomock.Any(), gomock.Any()).Return(nil, usecase.ErrDeviceCodeRequestFailed)
+				return m
+			},
+			expectedStatus: http.StatusInternalServerError,
+			wantAppError:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/auth/github/device", strings.NewReader(tt.form.Encode()))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationForm)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			err := auth.GitHubDeviceHandler(tt.setupMock(ctrl))(c)
+
+			assertDeviceResponse(t, err, rec, tt.wantAppError, tt.expectedStatus, tt.expectedBody)
+		})
+	}
+}
+
+func TestGitHubDeviceTokenHandler(t *testing.T) {
+	tests := []struct {
+		name           string
+		form           url.Values
+		setupMock      func(ctrl *gomock.Controller) *mockauth.MockGitHubOAuthUseCaseInterface
+		expectedStatus int
+		expectedBody   map[string]any
+		wantAppError   bool
+	}{
+		{
+			name: "正常系: 承認済みの場合はセッションIDが返る",
+			form: url.Values{"device_code": {"device-id"}},
+			setupMock: func(ctrl *gomock.Controller) *mockauth.MockGitHubOAuthUseCaseInterface {
+				m := mockauth.NewMockGitHubOAuthUseCaseInterface(ctrl)
+				m.EXPECT().PollDeviceAuthorization(gomock.Any(), "device-id").Return("session-id-123", nil)
+				return m
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]any{
+				"session_id": "session-id-123",
+				"expires_in": float64(86400),
+			},
+		},
+		{
+			name: "異常系: 承認待ちの場合はauthorization_pendingを返す",
+			form: url.Values{"device_code": {"device-id"}},
+			setupMock: func(ctrl *gomock.Controller) *mockauth.MockGitHubOAuthUseCaseInterface {
+				m := mockauth.NewMockGitHubOAuthUseCaseInterface(ctrl)
+				m.EXPECT().PollDeviceAuthorization(gomock.Any(), "device-id").Return("", usecase.ErrAuthorizationPending)
+				return m
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]any{
+				"error":             "authorization_pending",
+				"error_description": "ユーザーの承認を待っています",
+			},
+		},
+		{
+			name: "異常系: ポーリング間隔が短すぎる場合はslow_downを返す",
+			form: url.Values{"device_code": {"device-id"}},
+			setupMock: func(ctrl *gomock.Controller) *mockauth.MockGitHubOAuthUseCaseInterface {
+				m := mockauth.NewMockGitHubOAuthUseCaseInterface(ctrl)
+				m.EXPECT().PollDeviceAuthorization(gomock.Any(), "device-id").Return("", usecase.ErrSlowDown)
+				return m
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]any{
+				"error":             "slow_down",
+				"error_description": "ポーリングの間隔を空けてください",
+			},
+		},
+		{
+			name: "異常系: デバイスコードが期限切れの場合はexpired_tokenを返す",
+			form: url.Values{"device_code": {"device-id"}},
+			setupMock: func(ctrl *gomock.Controller) *mockauth.MockGitHubOAuthUseCaseInterface {
+				m := mockauth.NewMockGitHubOAuthUseCaseInterface(ctrl)
+				m.EXPECT().PollDeviceAuthorization(gomock.Any(), "device-id").Return("", usecase.ErrInvalidDeviceCode)
+				return m
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]any{
+				"error":             "expired_token",
+				"error_description": "デバイスコードが無効または期限切れです",
+			},
+		},
+		{
+			name: "異常系: リポジトリへのアクセス権がない場合はForbiddenを返す",
+			form: url.Values{"device_code": {"device-id"}},
+			setupMock: func(ctrl *gomock.Controller) *mockauth.MockGitHubOAuthUseCaseInterface {
+				m := mockauth.NewMockGitHubOAuthUseCaseInterface(ctrl)
+				m.EXPECT().PollDeviceAuthorization(gomock.Any(), "device-id").Return("", usecase.ErrRepositoryAccessDenied)
+				return m
+			},
+			expectedStatus: http.StatusForbidden,
+			wantAppError:   true,
+		},
+		{
+			name: "異常系: device_codeが指定されていない場合はBadRequestを返す",
+			form: url.Values{},
+			setupMock: func(ctrl *gomock.Controller) *mockauth.MockGitHubOAuthUseCaseInterface {
+				return mockauth.NewMockGitHubOAuthUseCaseInterface(ctrl)
+			},
+			expectedStatus: http.StatusBadRequest,
+			wantAppError:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/auth/github/device/token", strings.NewReader(tt.form.Encode()))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationForm)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			err := auth.GitHubDeviceTokenHandler(tt.setupMock(ctrl))(c)
+
+			assertDeviceResponse(t, err, rec, tt.wantAppError, tt.expectedStatus, tt.expectedBody)
+		})
+	}
+}
+
+func assertDeviceResponse(t *testing.T, err error, rec *httptest.ResponseRecorder, wantAppError bool, expectedStatus int, expectedBody map[string]any) {
+	t.Helper()
+
+	if wantAppError {
+		var appErr *middleware.AppError
+		if !errors.As(err, &appErr) {
+			t.Fatalf("expected *middleware.AppError, got %T", err)
+		}
+		if appErr.StatusCode != expectedStatus {
+			t.Errorf("expected status %d, got %d", expectedStatus, appErr.StatusCode)
+		}
+		return
+	}
+
+	if err != nil {
+		t.Fatalf("handler returned error: %v", err)
+	}
+	if rec.Code != expectedStatus {
+		t.Errorf("expected status %d, got %d", expectedStatus, rec.Code)
+	}
+	var got map[string]any
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if diff := cmp.Diff(expectedBody, got); diff != "" {
+		t.Errorf("response body mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -10,21 +10,29 @@ import (
 
 	"github.com/labstack/echo/v4"
 	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/na2na-p/cargohold/internal/handler/common"
 	"github.com/na2na-p/cargohold/internal/handler/middleware"
+	"github.com/na2na-p/cargohold/internal/usecase"
 )
 
 type GitHubOAuthUseCaseInterface interface {
 	StartAuthentication(
 		ctx context.Context,
-		repository *domain.RepositoryIdentifier,
+		scope *domain.RepositoryScope,
 		redirectURI string,
 		shell domain.ShellType,
-	) (string, error)
+	) (*usecase.AuthorizationRequest, error)
 	HandleCallback(
 		ctx context.Context,
 		code string,
 		state string,
+		browserState string,
 	) (string, domain.ShellType, error)
+	StartDeviceAuthorization(
+		ctx context.Context,
+		scope *domain.RepositoryScope,
+	) (*usecase.DeviceCodeResult, error)
+	PollDeviceAuthorization(ctx context.Context, deviceCode string) (string, error)
 }
 
 type GitHubLoginHandlerConfig struct {
@@ -52,7 +60,7 @@ func GitHubLoginHandler(githubOAuthUC GitHubOAuthUseCaseInterface, cfg GitHubLog
 			)
 		}
 
-		repository, err := domain.NewRepositoryIdentifier(repositoryParam)
+		scope, err := domain.ParseRepositoryScope(repositoryParam)
 		if err != nil {
 			return middleware.NewAppError(
 				http.StatusBadRequest,
@@ -74,8 +82,11 @@ func GitHubLoginHandler(githubOAuthUC GitHubOAuthUseCaseInterface, cfg GitHubLog
 		scheme := ResolveScheme(c, cfg.TrustProxy)
 		redirectURI := scheme + "://" + host + "/auth/github/callback"
 
-		authURL, err := githubOAuthUC.StartAuthentication(c.Request().Context(), repository, redirectURI, shellType)
+		authRequest, err := githubOAuthUC.StartAuthentication(c.Request().Context(), scope, redirectURI, shellType)
 		if err != nil {
+			if errors.Is(err, usecase.ErrRepositoryScopeNotEnabled) {
+				return newRepositoryScopeNotEnabledError(err)
+			}
 			return middleware.NewAppError(
 				http.StatusInternalServerError,
 				"認証URLの生成に失敗しました",
@@ -83,10 +94,30 @@ func GitHubLoginHandler(githubOAuthUC GitHubOAuthUseCaseInterface, cfg GitHubLog
 			)
 		}
 
-		return c.Redirect(http.StatusFound, authURL)
+		// GitHubからのリダイレクトはクロスサイトのトップレベルナビゲーションのため、SameSite=Laxで送信させる
+		c.SetCookie(&http.Cookie{
+			Name:     common.OAuthStateCookieName,
+			Value:    authRequest.State,
+			Path:     common.OAuthStateCookiePath,
+			HttpOnly: true,
+			Secure:   true,
+			SameSite: http.SameSiteLaxMode,
+			MaxAge:   int(usecase.OIDCStateTTL.Seconds()),
+		})
+
+		return c.Redirect(http.StatusFound, authRequest.URL)
 	}
 }
 
+// newRepositoryScopeNotEnabledError は複数のリポジトリを対象とするログインを拒否するエラーを返す
+func newRepositoryScopeNotEnabledError(err error) error {
+	return middleware.NewAppError(
+		http.StatusBadRequest,
+		"複数のリポジトリを対象とするログインは有効になっていません",
+		err,
+	)
+}
+
 func IsHostAllowed(host string, allowedHosts []string) bool {
 	if len(allowedHosts) == 0 {
 		return true
@@ -11,6 +11,7 @@ import (
 	"github.com/na2na-p/cargohold/internal/domain"
 	"github.com/na2na-p/cargohold/internal/handler/auth"
 	"github.com/na2na-p/cargohold/internal/handler/middleware"
+	"github.com/na2na-p/cargohold/internal/usecase"
 	mockauth "github.com/na2na-p/cargohold/tests/handler/auth"
 	"go.uber.org/mock/gomock"
 )
@@ -41,7 +42,7 @@ func TestGitHubLoginHandler(t *testing.T) {
 				m := mockauth.NewMockGitHubOAuthUseCaseInterface(ctrl)
 				m.EXPECT().
 					StartAuthentication(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-					Return("https://github.com/login/oauth/authorize?client_id=test&state=abc123", nil)
+					Return(&usecase.AuthorizationRequest{URL: "https://github.com/login/oauth/authorize?client_id=test&state=abc123", State: "abc123"}, nil)
 				return m
 			},
 			expectedStatus: http.StatusFound,
@@ -62,7 +63,26 @@ func TestGitHubLoginHandler(t *testing.T) {
 				m := mockauth.NewMockGitHubOAuthUseCaseInterface(ctrl)
 				m.EXPECT().
 					StartAuthentication(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-					Return("https://github.com/login/oauth/authorize?client_id=test&state=abc123", nil)
+					Return(&usecase.AuthorizationRequest{URL: "https://github.com/login/oauth/authorize?client_id=test&state=abc123", State: "abc123"}, nil)
+				return m
+			},
+			expectedStatus: http.StatusFound,
+			expectedURL:    "https://github.com/login/oauth/authorize?client_id=test&state=abc123",
+			wantAppError:   false,
+		},
+		{
+			name: "正常系: 複数のリポジトリとワイルドカードを指定した場合はスコープとして渡される",
+			args: args{
+				repository: "owner/repo1,owner/repo2,org/*",
+				host:       "example.com",
+			},
+			cfg: auth.GitHubLoginHandlerConfig{TrustProxy: false},
+			setupMock: func(ctrl *gomock.Controller) *mockauth.MockGitHubOAuthUseCaseInterface {
+				scope, _ := domain.ParseRepositoryScope("owner/repo1,owner/repo2,org/*")
+				m := mockauth.NewMockGitHubOAuthUseCaseInterface(ctrl)
+				m.EXPECT().
+					StartAuthentication(gomock.Any(), scope, gomock.Any(), gomock.Any()).
+					Return(&usecase.AuthorizationRequest{URL: "https://github.com/login/oauth/authorize?client_id=test&state=abc123", State: "abc123"}, nil)
 				return m
 			},
 			expectedStatus: http.StatusFound,
@@ -157,6 +177,24 @@ func TestGitHubLoginHandler(t *testing.T) {
 			expectedURL:    "",
 			wantAppError:   true,
 		},
+		{
+			name: "異常系: 複数のリポジトリを対象とするログインが有効でない場合はBadRequestを返す",
+			args: args{
+				repository: "owner/*",
+				host:       "example.com",
+			},
+			cfg: auth.GitHubLoginHandlerConfig{TrustProxy: false},
+			setupMock: func(ctrl *gomock.Controller) *mockauth.MockGitHubOAuthUseCaseInterface {
+				m := mockauth.NewMockGitHubOAuthUseCaseInterface(ctrl)
+				m.EXPECT().
+					StartAuthentication(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, usecase.ErrRepositoryScopeNotEnabled)
+				return m
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedURL:    "",
+			wantAppError:   true,
+		},
 		{
 			name: "異常系: UseCaseでエラーが発生した場合はInternalServerErrorを返す",
 			args: args{
@@ -168,7 +206,7 @@ func TestGitHubLoginHandler(t *testing.T) {
 				m := mockauth.NewMockGitHubOAuthUseCaseInterface(ctrl)
 				m.EXPECT().
 					StartAuthentication(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-					Return("", errors.New("usecase error"))
+					Return(nil, errors.New("usecase error"))
 				return m
 			},
 			expectedStatus: http.StatusInternalServerError,
@@ -234,6 +272,34 @@ func TestGitHubLoginHandler(t *testing.T) {
 					t.Errorf("expected Location %s, got %s", tt.expectedURL, location)
 				}
 			}
+
+			var stateCookie *http.Cookie
+			for _, cookie := range rec.Result().Cookies() {
+				if cookie.Name == "lfs_oauth_state" {
+					stateCookie = cookie
+				}
+			}
+			if tt.wantAppError {
+				if stateCookie != nil {
+					t.Error("state cookie should not be set on error")
+				}
+				return
+			}
+			if stateCookie == nil {
+				t.Fatal("state cookie 'lfs_oauth_state' not found")
+			}
+			if stateCookie.Value != "abc123" {
+				t.Errorf("expected state cookie value 'abc123', got '%s'", stateCookie.Value)
+			}
+			if !stateCookie.HttpOnly || !stateCookie.Secure || stateCookie.SameSite != http.SameSiteLaxMode {
+				t.Error("state cookie should be HttpOnly, Secure and SameSite=Lax")
+			}
+			if stateCookie.Path != "/auth/github/callback" {
+				t.Errorf("expected state cookie Path '/auth/github/callback', got '%s'", stateCookie.Path)
+			}
+			if stateCookie.MaxAge <= 0 {
+				t.Errorf("expected positive state cookie MaxAge, got %d", stateCookie.MaxAge)
+			}
 		})
 	}
 }
@@ -427,9 +493,9 @@ func TestGitHubLoginHandler_XForwardedProto(t *testing.T) {
 			mockUC := mockauth.NewMockGitHubOAuthUseCaseInterface(ctrl)
 			mockUC.EXPECT().
 				StartAuthentication(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-				DoAndReturn(func(_ interface{}, _ interface{}, redirectURI string, _ domain.ShellType) (string, error) {
+				DoAndReturn(func(_ interface{}, _ interface{}, redirectURI string, _ domain.ShellType) (*usecase.AuthorizationRequest, error) {
 					capturedRedirect = redirectURI
-					return "https://github.com/login/oauth/authorize", nil
+					return &usecase.AuthorizationRequest{URL: "https://github.com/login/oauth/authorize", State: "abc123"}, nil
 				})
 
 			e := echo.New()
@@ -27,6 +27,7 @@ func NewBatchHandler(batchUseCase usecase.BatchUseCaseInterface) *BatchHandler {
 
 func (h *BatchHandler) Handle(c echo.Context) error {
 	ctx := c.Request().Context()
+	middleware.SetAuditAction(c, domain.AuditActionBatch)
 
 	if err := ValidateLFSHeaders(c); err != nil {
 		return SendLFSError(c, http.StatusBadRequest, err.Error())
@@ -48,13 +49,15 @@ func (h *BatchHandler) Handle(c echo.Context) error {
 	if err := json.Unmarshal(bodyBytes, &reqDTO); err != nil {
 		return SendLFSError(c, http.StatusUnprocessableEntity, "リクエストボディのパースに失敗しました")
 	}
+	setBatchAuditRequest(c, &reqDTO)
 
 	req, err := reqDTO.ToBatchRequest(repoID)
 	if err != nil {
 		return SendLFSError(c, http.StatusUnprocessableEntity, "リクエストボディのパースに失敗しました")
 	}
+	middleware.RecordBatchSize(c, req.Operation().String(), len(req.Objects()))
 
-	if err := h.checkPermissions(c, reqDTO.Operation); err != nil {
+	if err := checkPermissions(c, reqDTO.Operation); err != nil {
 		return err
 	}
 
@@ -67,12 +70,43 @@ func (h *BatchHandler) Handle(c echo.Context) error {
 	if err != nil {
 		return h.handleUseCaseError(c, err)
 	}
+	setBatchAuditResponse(c, resp)
 
 	c.Response().Header().Set(echo.HeaderContentType, GitLFSContentType)
 	return c.JSON(http.StatusOK, resp)
 }
 
-func (h *BatchHandler) checkPermissions(c echo.Context, operation string) error {
+// setBatchAuditRequest はリクエストされたオペレーションとオブジェクトを監査ログの記録対象とする
+func setBatchAuditRequest(c echo.Context, reqDTO *dto.BatchRequestDTO) {
+	switch reqDTO.Operation {
+	case "upload":
+		middleware.SetAuditAction(c, domain.AuditActionBatchUpload)
+	case "download":
+		middleware.SetAuditAction(c, domain.AuditActionBatchDownload)
+	}
+
+	objects := make([]middleware.AuditObject, len(reqDTO.Objects))
+	for i, obj := range reqDTO.Objects {
+		objects[i] = middleware.AuditObject{OID: obj.OID, Size: obj.Size}
+	}
+	middleware.SetAuditObjects(c, objects...)
+}
+
+// setBatchAuditResponse はオブジェクトごとのエラーを監査ログの結果に反映する
+func setBatchAuditResponse(c echo.Context, resp usecase.BatchResponse) {
+	respObjects := resp.Objects()
+	objects := make([]middleware.AuditObject, len(respObjects))
+	for i, obj := range respObjects {
+		objects[i] = middleware.AuditObject{OID: obj.OID(), Size: obj.Size()}
+		if objErr := obj.Error(); objErr != nil {
+			objects[i].StatusCode = objErr.Code()
+		}
+	}
+	middleware.SetAuditObjects(c, objects...)
+}
+
+// checkPermissions は認証済みユーザーがリポジトリに対してオペレーションを実行する権限を持つかを確認する
+func checkPermissions(c echo.Context, operation string) error {
 	userInfoRaw := c.Get(middleware.UserInfoContextKey)
 	if userInfoRaw == nil {
 		return middleware.NewAppError(http.StatusForbidden, "認証情報が見つかりません", nil)
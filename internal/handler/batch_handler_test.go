@@ -48,7 +48,7 @@ func TestBatchHandler_Handle(t *testing.T) {
 					m.EXPECT().HandleBatchRequest(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
 						func(_ interface{}, _, _, _ string, _ usecase.BatchRequest, _ string) (usecase.BatchResponse, error) {
 							uploadAction := usecase.NewAction("https://s3.example.com/upload", nil, 900)
-							actions := usecase.NewActions(&uploadAction, nil)
+							actions := usecase.NewActions(&uploadAction, nil, nil)
 							return usecase.NewBatchResponse(
 								"basic",
 								[]usecase.ResponseObject{
@@ -115,7 +115,7 @@ func TestBatchHandler_Handle(t *testing.T) {
 					m.EXPECT().HandleBatchRequest(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
 						func(_ interface{}, _, _, _ string, _ usecase.BatchRequest, _ string) (usecase.BatchResponse, error) {
 							downloadAction := usecase.NewAction("https://s3.example.com/download", nil, 900)
-							actions := usecase.NewActions(nil, &downloadAction)
+							actions := usecase.NewActions(nil, &downloadAction, nil)
 							return usecase.NewBatchResponse(
 								"basic",
 								[]usecase.ResponseObject{
@@ -655,7 +655,7 @@ func TestBatchHandler_Handle(t *testing.T) {
 					m.EXPECT().HandleBatchRequest(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
 						func(_ interface{}, _, _, _ string, _ usecase.BatchRequest, _ string) (usecase.BatchResponse, error) {
 							uploadAction := usecase.NewAction("https://s3.example.com/upload", nil, 900)
-							actions := usecase.NewActions(&uploadAction, nil)
+							actions := usecase.NewActions(&uploadAction, nil, nil)
 							return usecase.NewBatchResponse(
 								"basic",
 								[]usecase.ResponseObject{
@@ -722,7 +722,7 @@ func TestBatchHandler_Handle(t *testing.T) {
 					m.EXPECT().HandleBatchRequest(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
 						func(_ interface{}, _, _, _ string, _ usecase.BatchRequest, _ string) (usecase.BatchResponse, error) {
 							downloadAction := usecase.NewAction("https://s3.example.com/download", nil, 900)
-							actions := usecase.NewActions(nil, &downloadAction)
+							actions := usecase.NewActions(nil, &downloadAction, nil)
 							return usecase.NewBatchResponse(
 								"basic",
 								[]usecase.ResponseObject{
@@ -888,7 +888,7 @@ func TestBatchHandler_Integration(t *testing.T) {
 							t.Errorf("Expected 1 object, got %d", len(req.Objects()))
 						}
 						uploadAction := usecase.NewAction("https://s3.example.com/upload", nil, 900)
-						actions := usecase.NewActions(&uploadAction, nil)
+						actions := usecase.NewActions(&uploadAction, nil, nil)
 						return usecase.NewBatchResponse(
 							"basic",
 							[]usecase.ResponseObject{
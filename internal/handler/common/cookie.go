@@ -3,4 +3,8 @@ package common
 const (
 	LFSSessionCookieName = "lfs_session"
 	LFSSessionMaxAge     = 86400
+
+	// OAuthStateCookieName はログインを開始したブラウザとコールバックを結び付けるため、stateを保存するCookie
+	OAuthStateCookieName = "lfs_oauth_state"
+	OAuthStateCookiePath = "/auth/github/callback"
 )
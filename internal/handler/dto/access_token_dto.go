@@ -0,0 +1,93 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/na2na-p/cargohold/internal/usecase"
+)
+
+// IssueAccessTokenRequestDTO はアクセストークンの発行リクエスト
+type IssueAccessTokenRequestDTO struct {
+	Name         string   `json:"name"`
+	Repositories []string `json:"repositories,omitempty"`
+	Operations   []string `json:"operations"`
+	// ExpiresInDays は発行日からの有効日数
+	ExpiresInDays int `json:"expires_in_days"`
+}
+
+// AccessTokenDTO は発行済みのアクセストークン。トークン文字列は含まない
+type AccessTokenDTO struct {
+	ID           int64    `json:"id"`
+	Name         string   `json:"name"`
+	CreatedBy    string   `json:"created_by"`
+	Repositories []string `json:"repositories"`
+	Operations   []string `json:"operations"`
+	ExpiresAt    string   `json:"expires_at"`
+	LastUsedAt   *string  `json:"last_used_at"`
+	CreatedAt    string   `json:"created_at"`
+}
+
+// IssuedAccessTokenDTO は発行したアクセストークン。トークン文字列は発行時のみ返す
+type IssuedAccessTokenDTO struct {
+	*AccessTokenDTO
+	Token string `json:"token"`
+}
+
+type AccessTokensResponseDTO struct {
+	Tokens []*AccessTokenDTO `json:"tokens"`
+}
+
+func (r IssueAccessTokenRequestDTO) ToInput() usecase.AccessTokenInput {
+	return usecase.AccessTokenInput{
+		Name:         r.Name,
+		Repositories: r.Repositories,
+		Operations:   r.Operations,
+		ExpiresIn:    time.Duration(r.ExpiresInDays) * 24 * time.Hour,
+	}
+}
+
+func NewAccessTokenDTO(token *domain.AccessToken) *AccessTokenDTO {
+	repositories := make([]string, len(token.Repositories()))
+	for i, repository := range token.Repositories() {
+		repositories[i] = repository.FullName()
+	}
+	operations := make([]string, len(token.Operations()))
+	for i, operation := range token.Operations() {
+		operations[i] = operation.String()
+	}
+
+	var lastUsedAt *string
+	if token.LastUsedAt() != nil {
+		formatted := token.LastUsedAt().UTC().Format(time.RFC3339)
+		lastUsedAt = &formatted
+	}
+
+	return &AccessTokenDTO{
+		ID:           token.ID().Int64(),
+		Name:         token.Name(),
+		CreatedBy:    token.CreatedBy(),
+		Repositories: repositories,
+		Operations:   operations,
+		ExpiresAt:    token.ExpiresAt().UTC().Format(time.RFC3339),
+		LastUsedAt:   lastUsedAt,
+		CreatedAt:    token.CreatedAt().UTC().Format(time.RFC3339),
+	}
+}
+
+func NewIssuedAccessTokenDTO(issued *usecase.IssuedAccessToken) *IssuedAccessTokenDTO {
+	return &IssuedAccessTokenDTO{
+		AccessTokenDTO: NewAccessTokenDTO(issued.Token),
+		Token:          issued.Secret,
+	}
+}
+
+func NewAccessTokensResponseDTO(tokens []*domain.AccessToken) *AccessTokensResponseDTO {
+	entries := make([]*AccessTokenDTO, len(tokens))
+	for i, token := range tokens {
+		entries[i] = NewAccessTokenDTO(token)
+	}
+	return &AccessTokensResponseDTO{
+		Tokens: entries,
+	}
+}
@@ -0,0 +1,142 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/na2na-p/cargohold/internal/usecase"
+)
+
+type AddAllowlistRequestDTO struct {
+	Repository string `json:"repository"`
+}
+
+type AllowlistEntryDTO struct {
+	Repository string `json:"repository"`
+	Owner      string `json:"owner"`
+	Repo       string `json:"repo"`
+}
+
+type AllowlistResponseDTO struct {
+	Repositories []*AllowlistEntryDTO `json:"repositories"`
+}
+
+// AddClaimRuleRequestDTO はクレームルールの追加リクエスト
+// 省略した条件は任意の値に一致する
+type AddClaimRuleRequestDTO struct {
+	Priority        int      `json:"priority"`
+	Refs            []string `json:"refs"`
+	EventNames      []string `json:"event_names"`
+	Environments    []string `json:"environments"`
+	JobWorkflowRefs []string `json:"job_workflow_refs"`
+	Access          string   `json:"access"`
+}
+
+type ClaimRuleDTO struct {
+	ID              int64    `json:"id"`
+	Repository      string   `json:"repository"`
+	Priority        int      `json:"priority"`
+	Refs            []string `json:"refs"`
+	EventNames      []string `json:"event_names"`
+	Environments    []string `json:"environments"`
+	JobWorkflowRefs []string `json:"job_workflow_refs"`
+	Access          string   `json:"access"`
+	CreatedAt       string   `json:"created_at"`
+}
+
+type ClaimRulesResponseDTO struct {
+	Rules []*ClaimRuleDTO `json:"rules"`
+}
+
+type AdminAccessPolicyDTO struct {
+	ID         int64  `json:"id"`
+	Repository string `json:"repository"`
+	CreatedAt  string `json:"created_at"`
+}
+
+type AdminObjectDTO struct {
+	OID        string                  `json:"oid"`
+	Size       int64                   `json:"size"`
+	HashAlgo   string                  `json:"hash_algo"`
+	StorageKey string                  `json:"storage_key"`
+	Uploaded   bool                    `json:"uploaded"`
+	CreatedAt  string                  `json:"created_at"`
+	UpdatedAt  string                  `json:"updated_at"`
+	Policies   []*AdminAccessPolicyDTO `json:"access_policies"`
+}
+
+func NewAllowlistEntryDTO(repository *domain.AllowedRepository) *AllowlistEntryDTO {
+	return &AllowlistEntryDTO{
+		Repository: repository.String(),
+		Owner:      repository.Owner(),
+		Repo:       repository.Repo(),
+	}
+}
+
+func NewAllowlistResponseDTO(repositories []*domain.AllowedRepository) *AllowlistResponseDTO {
+	entries := make([]*AllowlistEntryDTO, len(repositories))
+	for i, repository := range repositories {
+		entries[i] = NewAllowlistEntryDTO(repository)
+	}
+	return &AllowlistResponseDTO{
+		Repositories: entries,
+	}
+}
+
+func (r AddClaimRuleRequestDTO) ToInput() usecase.GitHubClaimRuleInput {
+	return usecase.GitHubClaimRuleInput{
+		Priority:        r.Priority,
+		Refs:            r.Refs,
+		EventNames:      r.EventNames,
+		Environments:    r.Environments,
+		JobWorkflowRefs: r.JobWorkflowRefs,
+		Access:          r.Access,
+	}
+}
+
+func NewClaimRuleDTO(rule *domain.GitHubClaimRule) *ClaimRuleDTO {
+	condition := rule.Condition()
+	return &ClaimRuleDTO{
+		ID:              rule.ID().Int64(),
+		Repository:      rule.Repository().FullName(),
+		Priority:        rule.Priority(),
+		Refs:            condition.Refs(),
+		EventNames:      condition.EventNames(),
+		Environments:    condition.Environments(),
+		JobWorkflowRefs: condition.JobWorkflowRefs(),
+		Access:          rule.Access().String(),
+		CreatedAt:       rule.CreatedAt().UTC().Format(time.RFC3339),
+	}
+}
+
+func NewClaimRulesResponseDTO(rules []*domain.GitHubClaimRule) *ClaimRulesResponseDTO {
+	entries := make([]*ClaimRuleDTO, len(rules))
+	for i, rule := range rules {
+		entries[i] = NewClaimRuleDTO(rule)
+	}
+	return &ClaimRulesResponseDTO{
+		Rules: entries,
+	}
+}
+
+func NewAdminObjectDTO(detail *usecase.AdminObjectDetail) *AdminObjectDTO {
+	obj := detail.Object
+	policies := make([]*AdminAccessPolicyDTO, len(detail.Policies))
+	for i, policy := range detail.Policies {
+		policies[i] = &AdminAccessPolicyDTO{
+			ID:         policy.ID().Int64(),
+			Repository: policy.Repository().FullName(),
+			CreatedAt:  policy.CreatedAt().UTC().Format(time.RFC3339),
+		}
+	}
+	return &AdminObjectDTO{
+		OID:        obj.OID().String(),
+		Size:       obj.Size().Int64(),
+		HashAlgo:   obj.HashAlgo(),
+		StorageKey: obj.GetStorageKey(),
+		Uploaded:   obj.IsUploaded(),
+		CreatedAt:  obj.CreatedAt().UTC().Format(time.RFC3339),
+		UpdatedAt:  obj.UpdatedAt().UTC().Format(time.RFC3339),
+		Policies:   policies,
+	}
+}
@@ -0,0 +1,39 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/na2na-p/cargohold/internal/usecase"
+)
+
+// DeviceAuthorizationResponseDTO はデバイスフローの開始レスポンス
+// ユーザーは verification_uri で user_code を入力し、クライアントは device_code でポーリングする
+type DeviceAuthorizationResponseDTO struct {
+	DeviceCode      string `json:"device_code"`
+	UserCode        string `json:"user_code"`
+	VerificationURI string `json:"verification_uri"`
+	ExpiresIn       int    `json:"expires_in"`
+	Interval        int    `json:"interval"`
+}
+
+// DeviceTokenResponseDTO はデバイスフローが承認された際に返すセッション
+type DeviceTokenResponseDTO struct {
+	SessionID string `json:"session_id"`
+	ExpiresIn int    `json:"expires_in"`
+}
+
+// DeviceTokenErrorResponseDTO はRFC 8628 のエラーコードでポーリング結果を返す
+type DeviceTokenErrorResponseDTO struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+func NewDeviceAuthorizationResponseDTO(result *usecase.DeviceCodeResult) *DeviceAuthorizationResponseDTO {
+	return &DeviceAuthorizationResponseDTO{
+		DeviceCode:      result.DeviceCode,
+		UserCode:        result.UserCode,
+		VerificationURI: result.VerificationURI,
+		ExpiresIn:       int(result.ExpiresIn / time.Second),
+		Interval:        int(result.Interval / time.Second),
+	}
+}
@@ -0,0 +1,76 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/na2na-p/cargohold/internal/domain"
+)
+
+type CreateLockRequestDTO struct {
+	Path string      `json:"path"`
+	Ref  *RefInfoDTO `json:"ref,omitempty"`
+}
+
+type VerifyLocksRequestDTO struct {
+	Cursor string      `json:"cursor,omitempty"`
+	Limit  int         `json:"limit,omitempty"`
+	Ref    *RefInfoDTO `json:"ref,omitempty"`
+}
+
+type UnlockRequestDTO struct {
+	Force bool        `json:"force,omitempty"`
+	Ref   *RefInfoDTO `json:"ref,omitempty"`
+}
+
+type LockDTO struct {
+	ID       string        `json:"id"`
+	Path     string        `json:"path"`
+	LockedAt string        `json:"locked_at"`
+	Owner    *LockOwnerDTO `json:"owner,omitempty"`
+}
+
+type LockOwnerDTO struct {
+	Name string `json:"name"`
+}
+
+type LockResponseDTO struct {
+	Lock    *LockDTO `json:"lock"`
+	Message string   `json:"message,omitempty"`
+}
+
+type LockListResponseDTO struct {
+	Locks      []*LockDTO `json:"locks"`
+	NextCursor string     `json:"next_cursor,omitempty"`
+}
+
+type VerifyLocksResponseDTO struct {
+	Ours       []*LockDTO `json:"ours"`
+	Theirs     []*LockDTO `json:"theirs"`
+	NextCursor string     `json:"next_cursor,omitempty"`
+}
+
+func RefName(ref *RefInfoDTO) string {
+	if ref == nil {
+		return ""
+	}
+	return ref.Name
+}
+
+func NewLockDTO(lock *domain.Lock) *LockDTO {
+	return &LockDTO{
+		ID:       lock.ID().String(),
+		Path:     lock.Path().String(),
+		LockedAt: lock.LockedAt().UTC().Format(time.RFC3339),
+		Owner: &LockOwnerDTO{
+			Name: lock.Owner().Name(),
+		},
+	}
+}
+
+func NewLockDTOs(locks []*domain.Lock) []*LockDTO {
+	dtos := make([]*LockDTO, len(locks))
+	for i, lock := range locks {
+		dtos[i] = NewLockDTO(lock)
+	}
+	return dtos
+}
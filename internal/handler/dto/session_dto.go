@@ -0,0 +1,48 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/na2na-p/cargohold/internal/usecase"
+)
+
+// SessionDTO はユーザーのセッション。セッションIDは認証情報のため返さない
+type SessionDTO struct {
+	Repository string `json:"repository,omitempty"`
+	// Repositories は複数のリポジトリを対象とするセッションのスコープ
+	Repositories []string `json:"repositories,omitempty"`
+	ExpiresAt    string   `json:"expires_at"`
+	// Current はリクエストの認証に使用したセッションかどうか
+	Current bool `json:"current"`
+}
+
+type SessionsResponseDTO struct {
+	Sessions []*SessionDTO `json:"sessions"`
+}
+
+// RevokedSessionsResponseDTO はセッションの一括失効の結果
+type RevokedSessionsResponseDTO struct {
+	Revoked int `json:"revoked"`
+	// RevokedAccessTokens は合わせて失効したアクセストークンの数
+	RevokedAccessTokens int `json:"revoked_access_tokens"`
+}
+
+func NewSessionsResponseDTO(sessions []*usecase.UserSession, currentSessionID string) *SessionsResponseDTO {
+	entries := make([]*SessionDTO, len(sessions))
+	for i, session := range sessions {
+		entry := &SessionDTO{
+			ExpiresAt: session.ExpiresAt.UTC().Format(time.RFC3339),
+			Current:   session.ID == currentSessionID,
+		}
+		if session.UserInfo != nil && session.UserInfo.Repository() != nil {
+			entry.Repository = session.UserInfo.Repository().FullName()
+		}
+		if session.UserInfo != nil && session.UserInfo.RepositoryScope() != nil {
+			entry.Repositories = session.UserInfo.RepositoryScope().Patterns()
+		}
+		entries[i] = entry
+	}
+	return &SessionsResponseDTO{
+		Sessions: entries,
+	}
+}
@@ -0,0 +1,208 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/na2na-p/cargohold/internal/handler/dto"
+	"github.com/na2na-p/cargohold/internal/handler/middleware"
+	"github.com/na2na-p/cargohold/internal/usecase"
+)
+
+type LockHandler struct {
+	lockUseCase usecase.LockUseCase
+}
+
+func NewLockHandler(lockUseCase usecase.LockUseCase) *LockHandler {
+	return &LockHandler{
+		lockUseCase: lockUseCase,
+	}
+}
+
+func (h *LockHandler) Create(c echo.Context) error {
+	ctx := c.Request().Context()
+	middleware.SetAuditAction(c, domain.AuditActionLockCreate)
+
+	if err := ValidateLFSHeaders(c); err != nil {
+		return SendLFSError(c, http.StatusBadRequest, err.Error())
+	}
+
+	repoID, userInfo, err := h.extractRequestContext(c)
+	if err != nil {
+		return err
+	}
+
+	var req dto.CreateLockRequestDTO
+	if err := decodeLockRequest(c, &req); err != nil {
+		return SendLFSError(c, http.StatusUnprocessableEntity, "リクエストボディのパースに失敗しました")
+	}
+
+	middleware.SetAuditObjects(c, middleware.AuditObject{Target: req.Path})
+
+	lock, err := h.lockUseCase.CreateLock(ctx, userInfo, repoID, req.Path, dto.RefName(req.Ref))
+	if err != nil {
+		if errors.Is(err, usecase.ErrLockConflict) && lock != nil {
+			c.Response().Header().Set(echo.HeaderContentType, GitLFSContentType)
+			return c.JSON(http.StatusConflict, dto.LockResponseDTO{
+				Lock:    dto.NewLockDTO(lock),
+				Message: "既にロックされています",
+			})
+		}
+		return handleLockUseCaseError(c, err)
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, GitLFSContentType)
+	return c.JSON(http.StatusCreated, dto.LockResponseDTO{
+		Lock: dto.NewLockDTO(lock),
+	})
+}
+
+func (h *LockHandler) List(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	repoID, userInfo, err := h.extractRequestContext(c)
+	if err != nil {
+		return err
+	}
+
+	query := usecase.LockListQuery{
+		Path:   c.QueryParam("path"),
+		ID:     c.QueryParam("id"),
+		Cursor: c.QueryParam("cursor"),
+	}
+	if limit := c.QueryParam("limit"); limit != "" {
+		query.Limit, err = strconv.Atoi(limit)
+		if err != nil {
+			return SendLFSError(c, http.StatusBadRequest, "limitパラメータが不正です")
+		}
+	}
+
+	result, err := h.lockUseCase.ListLocks(ctx, userInfo, repoID, query)
+	if err != nil {
+		return handleLockUseCaseError(c, err)
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, GitLFSContentType)
+	return c.JSON(http.StatusOK, dto.LockListResponseDTO{
+		Locks:      dto.NewLockDTOs(result.Locks),
+		NextCursor: result.NextCursor,
+	})
+}
+
+func (h *LockHandler) Verify(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	if err := ValidateLFSHeaders(c); err != nil {
+		return SendLFSError(c, http.StatusBadRequest, err.Error())
+	}
+
+	repoID, userInfo, err := h.extractRequestContext(c)
+	if err != nil {
+		return err
+	}
+
+	var req dto.VerifyLocksRequestDTO
+	if err := decodeLockRequest(c, &req); err != nil {
+		return SendLFSError(c, http.StatusUnprocessableEntity, "リクエストボディのパースに失敗しました")
+	}
+
+	result, err := h.lockUseCase.VerifyLocks(ctx, userInfo, repoID, usecase.LockVerifyQuery{
+		Cursor: req.Cursor,
+		Limit:  req.Limit,
+	})
+	if err != nil {
+		return handleLockUseCaseError(c, err)
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, GitLFSContentType)
+	return c.JSON(http.StatusOK, dto.VerifyLocksResponseDTO{
+		Ours:       dto.NewLockDTOs(result.Ours),
+		Theirs:     dto.NewLockDTOs(result.Theirs),
+		NextCursor: result.NextCursor,
+	})
+}
+
+func (h *LockHandler) Unlock(c echo.Context) error {
+	ctx := c.Request().Context()
+	middleware.SetAuditAction(c, domain.AuditActionLockUnlock)
+	middleware.SetAuditObjects(c, middleware.AuditObject{Target: c.Param("id")})
+
+	if err := ValidateLFSHeaders(c); err != nil {
+		return SendLFSError(c, http.StatusBadRequest, err.Error())
+	}
+
+	repoID, userInfo, err := h.extractRequestContext(c)
+	if err != nil {
+		return err
+	}
+
+	var req dto.UnlockRequestDTO
+	if err := decodeLockRequest(c, &req); err != nil {
+		return SendLFSError(c, http.StatusUnprocessableEntity, "リクエストボディのパースに失敗しました")
+	}
+	if req.Force {
+		middleware.SetAuditAction(c, domain.AuditActionLockForceUnlock)
+	}
+
+	lock, err := h.lockUseCase.Unlock(ctx, userInfo, repoID, c.Param("id"), req.Force)
+	if err != nil {
+		return handleLockUseCaseError(c, err)
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, GitLFSContentType)
+	return c.JSON(http.StatusOK, dto.LockResponseDTO{
+		Lock: dto.NewLockDTO(lock),
+	})
+}
+
+func (h *LockHandler) extractRequestContext(c echo.Context) (*domain.RepositoryIdentifier, *domain.UserInfo, error) {
+	repoID, err := ExtractRepositoryIdentifier(c)
+	if err != nil {
+		return nil, nil, middleware.NewAppError(http.StatusBadRequest, "リポジトリ識別子の形式が不正です", err)
+	}
+
+	userInfo, ok := c.Get(middleware.UserInfoContextKey).(*domain.UserInfo)
+	if !ok || userInfo == nil {
+		return nil, nil, middleware.NewAppError(http.StatusForbidden, "認証情報が見つかりません", nil)
+	}
+
+	return repoID, userInfo, nil
+}
+
+func decodeLockRequest(c echo.Context, v any) error {
+	bodyBytes, err := io.ReadAll(io.LimitReader(c.Request().Body, maxBodySize+1))
+	if err != nil {
+		return err
+	}
+	if len(bodyBytes) > maxBodySize {
+		return errors.New("request body too large")
+	}
+	if len(bodyBytes) == 0 {
+		return nil
+	}
+	return json.Unmarshal(bodyBytes, v)
+}
+
+func handleLockUseCaseError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrPermissionDenied):
+		return SendLFSError(c, http.StatusForbidden, "このオペレーションを実行する権限がありません")
+	case errors.Is(err, usecase.ErrLockOwnerMismatch):
+		return SendLFSError(c, http.StatusForbidden, "他のユーザーが所有するロックは強制解除が必要です")
+	case errors.Is(err, usecase.ErrLockNotFound):
+		return SendLFSError(c, http.StatusNotFound, "ロックが見つかりません")
+	case errors.Is(err, usecase.ErrInvalidLockPath):
+		return SendLFSError(c, http.StatusUnprocessableEntity, "不正なパスです")
+	case errors.Is(err, usecase.ErrInvalidLockID):
+		return SendLFSError(c, http.StatusBadRequest, "不正なロックIDです")
+	case errors.Is(err, usecase.ErrInvalidRepository):
+		return SendLFSError(c, http.StatusBadRequest, "リポジトリ識別子の形式が不正です")
+	default:
+		return middleware.NewAppError(http.StatusInternalServerError, "サーバー内部エラーが発生しました", err)
+	}
+}
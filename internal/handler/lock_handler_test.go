@@ -0,0 +1,500 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/labstack/echo/v4"
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/na2na-p/cargohold/internal/handler"
+	"github.com/na2na-p/cargohold/internal/handler/middleware"
+	"github.com/na2na-p/cargohold/internal/usecase"
+	mock_usecase "github.com/na2na-p/cargohold/tests/usecase"
+	"go.uber.org/mock/gomock"
+)
+
+func newLockHandlerTestUserInfo(t *testing.T) *domain.UserInfo {
+	t.Helper()
+
+	repo, _ := domain.NewRepositoryIdentifier("testowner/testrepo")
+	userInfo, err := domain.NewUserInfo("user-1", "", "octocat", domain.ProviderTypeGitHub, repo, "")
+	if err != nil {
+		t.Fatalf("NewUserInfo() failed: %v", err)
+	}
+	permissions := domain.NewRepositoryPermissions(false, true, true, false, false)
+	userInfo.SetPermissions(&permissions)
+	return userInfo
+}
+
+func newLockHandlerTestLock(t *testing.T, id int64, path string) *domain.Lock {
+	t.Helper()
+
+	repo, _ := domain.NewRepositoryIdentifier("testowner/testrepo")
+	lockID, _ := domain.NewLockID(id)
+	lockPath, _ := domain.NewLockPath(path)
+	owner, _ := domain.NewLockOwner("user-1", "octocat")
+	return domain.ReconstructLock(lockID, repo, lockPath, "", owner, time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+}
+
+func newLockHandlerTestContext(t *testing.T, method, target string, body any, userInfo *domain.UserInfo) (echo.Context, *httptest.ResponseRecorder) {
+	t.Helper()
+
+	e := echo.New()
+	e.HTTPErrorHandler = middleware.CustomHTTPErrorHandler
+
+	var reqBody *bytes.Buffer
+	if str, ok := body.(string); ok {
+		reqBody = bytes.NewBufferString(str)
+	} else if body != nil {
+		bodyBytes, _ := json.Marshal(body)
+		reqBody = bytes.NewBuffer(bodyBytes)
+	} else {
+		reqBody = &bytes.Buffer{}
+	}
+
+	req := httptest.NewRequest(method, target, reqBody)
+	req.Header.Set("Content-Type", "application/vnd.git-lfs+json")
+	req.Header.Set("Accept", "application/vnd.git-lfs+json")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("owner", "repo", "id")
+	c.SetParamValues("testowner", "testrepo", "1")
+	if userInfo != nil {
+		c.Set(middleware.UserInfoContextKey, userInfo)
+	}
+	return c, rec
+}
+
+func runLockHandler(c echo.Context, h echo.HandlerFunc) {
+	if err := h(c); err != nil {
+		middleware.CustomHTTPErrorHandler(err, c)
+	}
+}
+
+func TestLockHandler_Create(t *testing.T) {
+	tests := []struct {
+		name           string
+		usecase        func(t *testing.T, ctrl *gomock.Controller) usecase.LockUseCase
+		body           any
+		withUser       bool
+		wantStatusCode int
+		wantBodyJSON   map[string]any
+	}{
+		{
+			name: "正常系: ロックが作成され201が返る",
+			usecase: func(t *testing.T, ctrl *gomock.Controller) usecase.LockUseCase {
+				m := mock_usecase.NewMockLockUseCase(ctrl)
+				m.EXPECT().CreateLock(gomock.Any(), gomock.Any(), gomock.Any(), "foo.bin", "refs/heads/main").
+					Return(newLockHandlerTestLock(t, 1, "foo.bin"), nil)
+				return m
+			},
+			body:           map[string]any{"path": "foo.bin", "ref": map[string]any{"name": "refs/heads/main"}},
+			withUser:       true,
+			wantStatusCode: http.StatusCreated,
+			wantBodyJSON: map[string]any{
+				"lock": map[string]any{
+					"id":        "1",
+					"path":      "foo.bin",
+					"locked_at": "2024-01-01T12:00:00Z",
+					"owner":     map[string]any{"name": "octocat"},
+				},
+			},
+		},
+		{
+			name: "異常系: 既にロックされている場合は409と既存ロックが返る",
+			usecase: func(t *testing.T, ctrl *gomock.Controller) usecase.LockUseCase {
+				m := mock_usecase.NewMockLockUseCase(ctrl)
+				m.EXPECT().CreateLock(gomock.Any(), gomock.Any(), gomock.Any(), "foo.bin", "").
+					Return(newLockHandlerTestLock(t, 2, "foo.bin"), usecase.ErrLockConflict)
+				return m
+			},
+			body:           map[string]any{"path": "foo.bin"},
+			withUser:       true,
+			wantStatusCode: http.StatusConflict,
+			wantBodyJSON: map[string]any{
+				"lock": map[string]any{
+					"id":        "2",
+					"path":      "foo.bin",
+					"locked_at": "2024-01-01T12:00:00Z",
+					"owner":     map[string]any{"name": "octocat"},
+				},
+				"message": "既にロックされています",
+			},
+		},
+		{
+			name: "異常系: 権限がない場合は403が返る",
+			usecase: func(t *testing.T, ctrl *gomock.Controller) usecase.LockUseCase {
+				m := mock_usecase.NewMockLockUseCase(ctrl)
+				m.EXPECT().CreateLock(gomock.Any(), gomock.Any(), gomock.Any(), "foo.bin", "").
+					Return(nil, usecase.ErrPermissionDenied)
+				return m
+			},
+			body:           map[string]any{"path": "foo.bin"},
+			withUser:       true,
+			wantStatusCode: http.StatusForbidden,
+			wantBodyJSON: map[string]any{
+				"message": "このオペレーションを実行する権限がありません",
+			},
+		},
+		{
+			name: "異常系: 認証情報がない場合は403が返る",
+			usecase: func(t *testing.T, ctrl *gomock.Controller) usecase.LockUseCase {
+				return mock_usecase.NewMockLockUseCase(ctrl)
+			},
+			body:           map[string]any{"path": "foo.bin"},
+			withUser:       false,
+			wantStatusCode: http.StatusForbidden,
+			wantBodyJSON: map[string]any{
+				"message": "認証情報が見つかりません",
+			},
+		},
+		{
+			name: "異常系: 不正なJSONの場合は422が返る",
+			usecase: func(t *testing.T, ctrl *gomock.Controller) usecase.LockUseCase {
+				return mock_usecase.NewMockLockUseCase(ctrl)
+			},
+			body:           "{invalid json}",
+			withUser:       true,
+			wantStatusCode: http.StatusUnprocessableEntity,
+			wantBodyJSON: map[string]any{
+				"message": "リクエストボディのパースに失敗しました",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			var userInfo *domain.UserInfo
+			if tt.withUser {
+				userInfo = newLockHandlerTestUserInfo(t)
+			}
+			c, rec := newLockHandlerTestContext(t, http.MethodPost, "/testowner/testrepo/info/lfs/locks", tt.body, userInfo)
+
+			h := handler.NewLockHandler(tt.usecase(t, ctrl))
+			runLockHandler(c, h.Create)
+
+			if diff := cmp.Diff(tt.wantStatusCode, rec.Code); diff != "" {
+				t.Errorf("ステータスコードが一致しません (-want +got):\n%s", diff)
+			}
+
+			var gotBodyJSON map[string]any
+			if err := json.Unmarshal(rec.Body.Bytes(), &gotBodyJSON); err != nil {
+				t.Fatalf("レスポンスボディのパースに失敗しました: %v, body: %s", err, rec.Body.String())
+			}
+			if diff := cmp.Diff(tt.wantBodyJSON, gotBodyJSON); diff != "" {
+				t.Errorf("レスポンスボディが一致しません (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLockHandler_List(t *testing.T) {
+	tests := []struct {
+		name           string
+		usecase        func(t *testing.T, ctrl *gomock.Controller) usecase.LockUseCase
+		target         string
+		wantStatusCode int
+		wantBodyJSON   map[string]any
+	}{
+		{
+			name: "正常系: クエリパラメータが渡されロック一覧が返る",
+			usecase: func(t *testing.T, ctrl *gomock.Controller) usecase.LockUseCase {
+				m := mock_usecase.NewMockLockUseCase(ctrl)
+				m.EXPECT().ListLocks(gomock.Any(), gomock.Any(), gomock.Any(), usecase.LockListQuery{Path: "foo.bin", Cursor: "3", Limit: 10}).
+					Return(&usecase.LockListResult{
+						Locks:      []*domain.Lock{newLockHandlerTestLock(t, 3, "foo.bin")},
+						NextCursor: "4",
+					}, nil)
+				return m
+			},
+			target:         "/testowner/testrepo/info/lfs/locks?path=foo.bin&cursor=3&limit=10",
+			wantStatusCode: http.StatusOK,
+			wantBodyJSON: map[string]any{
+				"locks": []any{
+					map[string]any{
+						"id":        "3",
+						"path":      "foo.bin",
+						"locked_at": "2024-01-01T12:00:00Z",
+						"owner":     map[string]any{"name": "octocat"},
+					},
+				},
+				"next_cursor": "4",
+			},
+		},
+		{
+			name: "異常系: limitが数値でない場合は400が返る",
+			usecase: func(t *testing.T, ctrl *gomock.Controller) usecase.LockUseCase {
+				return mock_usecase.NewMockLockUseCase(ctrl)
+			},
+			target:         "/testowner/testrepo/info/lfs/locks?limit=abc",
+			wantStatusCode: http.StatusBadRequest,
+			wantBodyJSON: map[string]any{
+				"message": "limitパラメータが不正です",
+			},
+		},
+		{
+			name: "異常系: 不正なカーソルの場合は400が返る",
+			usecase: func(t *testing.T, ctrl *gomock.Controller) usecase.LockUseCase {
+				m := mock_usecase.NewMockLockUseCase(ctrl)
+				m.EXPECT().ListLocks(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, usecase.ErrInvalidLockID)
+				return m
+			},
+			target:         "/testowner/testrepo/info/lfs/locks?cursor=abc",
+			wantStatusCode: http.StatusBadRequest,
+			wantBodyJSON: map[string]any{
+				"message": "不正なロックIDです",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			c, rec := newLockHandlerTestContext(t, http.MethodGet, tt.target, nil, newLockHandlerTestUserInfo(t))
+
+			h := handler.NewLockHandler(tt.usecase(t, ctrl))
+			runLockHandler(c, h.List)
+
+			if diff := cmp.Diff(tt.wantStatusCode, rec.Code); diff != "" {
+				t.Errorf("ステータスコードが一致しません (-want +got):\n%s", diff)
+			}
+
+			var gotBodyJSON map[string]any
+			if err := json.Unmarshal(rec.Body.Bytes(), &gotBodyJSON); err != nil {
+				t.Fatalf("レスポンスボディのパースに失敗しました: %v, body: %s", err, rec.Body.String())
+			}
+			if diff := cmp.Diff(tt.wantBodyJSON, gotBodyJSON); diff != "" {
+				t.Errorf("レスポンスボディが一致しません (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLockHandler_Verify(t *testing.T) {
+	tests := []struct {
+		name           string
+		usecase        func(t *testing.T, ctrl *gomock.Controller) usecase.LockUseCase
+		wantStatusCode int
+		wantBodyJSON   map[string]any
+	}{
+		{
+			name: "正常系: ours/theirsに振り分けられたロックが返る",
+			usecase: func(t *testing.T, ctrl *gomock.Controller) usecase.LockUseCase {
+				m := mock_usecase.NewMockLockUseCase(ctrl)
+				m.EXPECT().VerifyLocks(gomock.Any(), gomock.Any(), gomock.Any(), usecase.LockVerifyQuery{Cursor: "1", Limit: 50}).
+					Return(&usecase.LockVerifyResult{
+						Ours:   []*domain.Lock{newLockHandlerTestLock(t, 1, "a.bin")},
+						Theirs: []*domain.Lock{},
+					}, nil)
+				return m
+			},
+			wantStatusCode: http.StatusOK,
+			wantBodyJSON: map[string]any{
+				"ours": []any{
+					map[string]any{
+						"id":        "1",
+						"path":      "a.bin",
+						"locked_at": "2024-01-01T12:00:00Z",
+						"owner":     map[string]any{"name": "octocat"},
+					},
+				},
+				"theirs": []any{},
+			},
+		},
+		{
+			name: "異常系: 内部エラーの場合は500が返る",
+			usecase: func(t *testing.T, ctrl *gomock.Controller) usecase.LockUseCase {
+				m := mock_usecase.NewMockLockUseCase(ctrl)
+				m.EXPECT().VerifyLocks(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+				return m
+			},
+			wantStatusCode: http.StatusInternalServerError,
+			wantBodyJSON: map[string]any{
+				"message": "サーバー内部エラーが発生しました",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			body := map[string]any{"cursor": "1", "limit": 50}
+			c, rec := newLockHandlerTestContext(t, http.MethodPost, "/testowner/testrepo/info/lfs/locks/verify", body, newLockHandlerTestUserInfo(t))
+
+			h := handler.NewLockHandler(tt.usecase(t, ctrl))
+			runLockHandler(c, h.Verify)
+
+			if diff := cmp.Diff(tt.wantStatusCode, rec.Code); diff != "" {
+				t.Errorf("ステータスコードが一致しません (-want +got):\n%s", diff)
+			}
+
+			var gotBodyJSON map[string]any
+			if err := json.Unmarshal(rec.Body.Bytes(), &gotBodyJSON); err != nil {
+				t.Fatalf("レスポンスボディのパースに失敗しました: %v, body: %s", err, rec.Body.String())
+			}
+			if diff := cmp.Diff(tt.wantBodyJSON, gotBodyJSON); diff != "" {
+				t.Errorf("レスポンスボディが一致しません (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLockHandler_Unlock(t *testing.T) {
+	tests := []struct {
+		name           string
+		usecase        func(t *testing.T, ctrl *gomock.Controller) usecase.LockUseCase
+		body           any
+		wantStatusCode int
+		wantBodyJSON   map[string]any
+	}{
+		{
+			name: "正常系: ロックが解除され解除したロックが返る",
+			usecase: func(t *testing.T, ctrl *gomock.Controller) usecase.LockUseCase {
+				m := mock_usecase.NewMockLockUseCase(ctrl)
+				m.EXPECT().Unlock(gomock.Any(), gomock.Any(), gomock.Any(), "1", true).
+					Return(newLockHandlerTestLock(t, 1, "a.bin"), nil)
+				return m
+			},
+			body:           map[string]any{"force": true},
+			wantStatusCode: http.StatusOK,
+			wantBodyJSON: map[string]any{
+				"lock": map[string]any{
+					"id":        "1",
+					"path":      "a.bin",
+					"locked_at": "2024-01-01T12:00:00Z",
+					"owner":     map[string]any{"name": "octocat"},
+				},
+			},
+		},
+		{
+			name: "異常系: 他ユーザーのロックの場合は403が返る",
+			usecase: func(t *testing.T, ctrl *gomock.Controller) usecase.LockUseCase {
+				m := mock_usecase.NewMockLockUseCase(ctrl)
+				m.EXPECT().Unlock(gomock.Any(), gomock.Any(), gomock.Any(), "1", false).Return(nil, usecase.ErrLockOwnerMismatch)
+				return m
+			},
+			body:           map[string]any{},
+			wantStatusCode: http.StatusForbidden,
+			wantBodyJSON: map[string]any{
+				"message": "他のユーザーが所有するロックは強制解除が必要です",
+			},
+		},
+		{
+			name: "異常系: ロックが存在しない場合は404が返る",
+			usecase: func(t *testing.T, ctrl *gomock.Controller) usecase.LockUseCase {
+				m := mock_usecase.NewMockLockUseCase(ctrl)
+				m.EXPECT().Unlock(gomock.Any(), gomock.Any(), gomock.Any(), "1", false).Return(nil, usecase.ErrLockNotFound)
+				return m
+			},
+			body:           nil,
+			wantStatusCode: http.StatusNotFound,
+			wantBodyJSON: map[string]any{
+				"message": "ロックが見つかりません",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			c, rec := newLockHandlerTestContext(t, http.MethodPost, "/testowner/testrepo/info/lfs/locks/1/unlock", tt.body, newLockHandlerTestUserInfo(t))
+
+			h := handler.NewLockHandler(tt.usecase(t, ctrl))
+			runLockHandler(c, h.Unlock)
+
+			if diff := cmp.Diff(tt.wantStatusCode, rec.Code); diff != "" {
+				t.Errorf("ステータスコードが一致しません (-want +got):\n%s", diff)
+			}
+
+			var gotBodyJSON map[string]any
+			if err := json.Unmarshal(rec.Body.Bytes(), &gotBodyJSON); err != nil {
+				t.Fatalf("レスポンスボディのパースに失敗しました: %v, body: %s", err, rec.Body.String())
+			}
+			if diff := cmp.Diff(tt.wantBodyJSON, gotBodyJSON); diff != "" {
+				t.Errorf("レスポンスボディが一致しません (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLockHandler_Audit(t *testing.T) {
+	tests := []struct {
+		name       string
+		usecase    func(t *testing.T, ctrl *gomock.Controller) usecase.LockUseCase
+		handler    func(h *handler.LockHandler) echo.HandlerFunc
+		target     string
+		body       any
+		wantEvents []handlerAuditEvent
+	}{
+		{
+			name: "正常系: ロックの作成がパスとともに記録される",
+			usecase: func(t *testing.T, ctrl *gomock.Controller) usecase.LockUseCase {
+				m := mock_usecase.NewMockLockUseCase(ctrl)
+				m.EXPECT().CreateLock(gomock.Any(), gomock.Any(), gomock.Any(), "a.bin", gomock.Any()).
+					Return(newLockHandlerTestLock(t, 1, "a.bin"), nil)
+				return m
+			},
+			handler: func(h *handler.LockHandler) echo.HandlerFunc { return h.Create },
+			target:  "/testowner/testrepo/info/lfs/locks",
+			body:    map[string]any{"path": "a.bin"},
+			wantEvents: []handlerAuditEvent{
+				{Action: "lock_create", Outcome: "success", Subject: "user-1", Provider: "github", Repository: "testowner/testrepo", Target: "a.bin"},
+			},
+		},
+		{
+			name: "正常系: ロックの解除がロックIDとともに記録される",
+			usecase: func(t *testing.T, ctrl *gomock.Controller) usecase.LockUseCase {
+				m := mock_usecase.NewMockLockUseCase(ctrl)
+				m.EXPECT().Unlock(gomock.Any(), gomock.Any(), gomock.Any(), "1", false).
+					Return(newLockHandlerTestLock(t, 1, "a.bin"), nil)
+				return m
+			},
+			handler: func(h *handler.LockHandler) echo.HandlerFunc { return h.Unlock },
+			target:  "/testowner/testrepo/info/lfs/locks/1/unlock",
+			body:    map[string]any{},
+			wantEvents: []handlerAuditEvent{
+				{Action: "lock_unlock", Outcome: "success", Subject: "user-1", Provider: "github", Repository: "testowner/testrepo", Target: "1"},
+			},
+		},
+		{
+			name: "正常系: 拒否されたロックの強制解除が記録される",
+			usecase: func(t *testing.T, ctrl *gomock.Controller) usecase.LockUseCase {
+				m := mock_usecase.NewMockLockUseCase(ctrl)
+				m.EXPECT().Unlock(gomock.Any(), gomock.Any(), gomock.Any(), "1", true).Return(nil, usecase.ErrPermissionDenied)
+				return m
+			},
+			handler: func(h *handler.LockHandler) echo.HandlerFunc { return h.Unlock },
+			target:  "/testowner/testrepo/info/lfs/locks/1/unlock",
+			body:    map[string]any{"force": true},
+			wantEvents: []handlerAuditEvent{
+				{Action: "lock_force_unlock", Outcome: "denied", Subject: "user-1", Provider: "github", Repository: "testowner/testrepo", Target: "1"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			h := handler.NewLockHandler(tt.usecase(t, ctrl))
+
+			var gotEvents []handlerAuditEvent
+			c, _ := newLockHandlerTestContext(t, http.MethodPost, tt.target, tt.body, newLockHandlerTestUserInfo(t))
+			runLockHandler(c, middleware.Audit(newHandlerAuditRecorder(ctrl, &gotEvents))(tt.handler(h)))
+
+			if diff := cmp.Diff(tt.wantEvents, gotEvents); diff != "" {
+				t.Errorf("監査イベントが一致しません (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
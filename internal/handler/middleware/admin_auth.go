@@ -0,0 +1,40 @@
+//go:generate mockgen -source=$GOFILE -destination=../../../tests/handler/middleware/mock_admin_auth.go -package=middleware
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	AdminSubjectContextKey = "admin_subject"
+)
+
+type AdminAuthUseCaseInterface interface {
+	Authenticate(ctx context.Context, token string) (string, error)
+}
+
+// AdminAuth は管理APIへのリクエストをBearerトークンで認証する
+// 認証された管理者のsubjectはAdminSubjectContextKeyに格納される
+func AdminAuth(adminAuthUC AdminAuthUseCaseInterface) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			authHeader := c.Request().Header.Get("Authorization")
+			token, ok := strings.CutPrefix(authHeader, "Bearer ")
+			if !ok || token == "" {
+				return NewAppError(http.StatusUnauthorized, "Unauthorized", nil)
+			}
+
+			subject, err := adminAuthUC.Authenticate(c.Request().Context(), token)
+			if err != nil {
+				return NewAppError(http.StatusUnauthorized, "Unauthorized", err)
+			}
+
+			c.Set(AdminSubjectContextKey, subject)
+			return next(c)
+		}
+	}
+}
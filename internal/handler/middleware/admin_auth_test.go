@@ -0,0 +1,93 @@
+package middleware_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/labstack/echo/v4"
+	"github.com/na2na-p/cargohold/internal/handler/middleware"
+	mock_middleware "github.com/na2na-p/cargohold/tests/handler/middleware"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAdminAuth(t *testing.T) {
+	tests := []struct {
+		name           string
+		setupMock      func(ctrl *gomock.Controller) *mock_middleware.MockAdminAuthUseCaseInterface
+		authHeader     string
+		wantStatusCode int
+		wantSubject    string
+	}{
+		{
+			name: "正常系: 認証に成功した場合、subjectが格納されnextが呼ばれる",
+			setupMock: func(ctrl *gomock.Controller) *mock_middleware.MockAdminAuthUseCaseInterface {
+				mock := mock_middleware.NewMockAdminAuthUseCaseInterface(ctrl)
+				mock.EXPECT().Authenticate(gomock.Any(), "admin-token").Return("static-token", nil)
+				return mock
+			},
+			authHeader:     "Bearer admin-token",
+			wantStatusCode: http.StatusOK,
+			wantSubject:    "static-token",
+		},
+		{
+			name: "異常系: Authorizationヘッダーがない場合、401が返る",
+			setupMock: func(ctrl *gomock.Controller) *mock_middleware.MockAdminAuthUseCaseInterface {
+				return mock_middleware.NewMockAdminAuthUseCaseInterface(ctrl)
+			},
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name: "異常系: Bearer以外の認証方式の場合、401が返る",
+			setupMock: func(ctrl *gomock.Controller) *mock_middleware.MockAdminAuthUseCaseInterface {
+				return mock_middleware.NewMockAdminAuthUseCaseInterface(ctrl)
+			},
+			authHeader:     "Basic dXNlcjpwYXNz",
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name: "異常系: 認証に失敗した場合、401が返る",
+			setupMock: func(ctrl *gomock.Controller) *mock_middleware.MockAdminAuthUseCaseInterface {
+				mock := mock_middleware.NewMockAdminAuthUseCaseInterface(ctrl)
+				mock.EXPECT().Authenticate(gomock.Any(), "invalid-token").Return("", errors.New("admin authentication failed"))
+				return mock
+			},
+			authHeader:     "Bearer invalid-token",
+			wantStatusCode: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			e := echo.New()
+			e.HTTPErrorHandler = middleware.CustomHTTPErrorHandler
+			req := httptest.NewRequest(http.MethodGet, "/admin/api/v1/allowlist", nil)
+			if tt.authHeader != "" {
+				req.Header.Set("Authorization", tt.authHeader)
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			var gotSubject string
+			next := func(c echo.Context) error {
+				gotSubject, _ = c.Get(middleware.AdminSubjectContextKey).(string)
+				return c.NoContent(http.StatusOK)
+			}
+
+			if err := middleware.AdminAuth(tt.setupMock(ctrl))(next)(c); err != nil {
+				middleware.CustomHTTPErrorHandler(err, c)
+			}
+
+			if rec.Code != tt.wantStatusCode {
+				t.Errorf("status code = %d, want %d", rec.Code, tt.wantStatusCode)
+			}
+			if diff := cmp.Diff(tt.wantSubject, gotSubject); diff != "" {
+				t.Errorf("subject mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
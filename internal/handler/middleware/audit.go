@@ -0,0 +1,110 @@
+//go:generate mockgen -source=$GOFILE -destination=../../../tests/handler/middleware/mock_audit.go -package=middleware
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/newmo-oss/ctxtime"
+)
+
+const (
+	auditActionContextKey  = "audit_action"
+	auditObjectsContextKey = "audit_objects"
+)
+
+type AuditRecorderInterface interface {
+	Record(ctx context.Context, events []*domain.AuditEvent)
+}
+
+// AuditObject はハンドラーが監査ログに記録するオブジェクト
+// StatusCodeが0の場合はレスポンスのステータスコードを結果として記録する
+// Targetにはロックのパスやアクセストークンのidなど、オブジェクト以外の操作対象を指定する
+type AuditObject struct {
+	OID        string
+	Size       int64
+	Target     string
+	StatusCode int
+}
+
+// SetAuditAction は監査ログに記録する操作の種類を設定する
+func SetAuditAction(c echo.Context, action domain.AuditAction) {
+	c.Set(auditActionContextKey, action)
+}
+
+// SetAuditObjects は監査ログに記録するオブジェクトを設定する。既に設定されているオブジェクトは置き換えられる
+func SetAuditObjects(c echo.Context, objects ...AuditObject) {
+	c.Set(auditObjectsContextKey, objects)
+}
+
+// Audit はハンドラーが設定した操作とオブジェクトを、認証情報・クライアントIP・結果とともに監査ログに記録する
+// 操作が設定されないまま認証に失敗したリクエストや、認証情報が他のリポジトリ向けなどの理由で
+// ユーザー情報が設定される前に拒否されたリクエストは認証失敗として記録する
+// 管理APIのリクエストはAdminAuthが設定した管理者のsubjectを操作者として記録する
+func Audit(recorder AuditRecorderInterface) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			err := next(c)
+
+			statusCode := responseStatusCode(c, err)
+			userInfo := auditUserInfo(c)
+			action, _ := c.Get(auditActionContextKey).(domain.AuditAction)
+			if action.IsZero() {
+				if userInfo != nil || (statusCode != http.StatusUnauthorized && statusCode != http.StatusForbidden) {
+					return err
+				}
+				action = domain.AuditActionAuthenticate
+			}
+
+			objects, _ := c.Get(auditObjectsContextKey).([]AuditObject)
+			if len(objects) == 0 {
+				objects = []AuditObject{{}}
+			}
+
+			ctx := context.WithoutCancel(c.Request().Context())
+			occurredAt := ctxtime.Now(ctx)
+			var repository string
+			if owner, repo := c.Param("owner"), c.Param("repo"); owner != "" && repo != "" {
+				repository = owner + "/" + repo
+			}
+
+			events := make([]*domain.AuditEvent, len(objects))
+			for i, obj := range objects {
+				objectStatusCode := statusCode
+				if obj.StatusCode != 0 {
+					objectStatusCode = obj.StatusCode
+				}
+				events[i] = domain.NewAuditEvent(domain.AuditEventParams{
+					OccurredAt: occurredAt,
+					Action:     action,
+					StatusCode: objectStatusCode,
+					UserInfo:   userInfo,
+					Repository: repository,
+					OID:        obj.OID,
+					Size:       obj.Size,
+					Target:     obj.Target,
+					ClientIP:   c.RealIP(),
+					RequestID:  c.Response().Header().Get(echo.HeaderXRequestID),
+				})
+			}
+			recorder.Record(ctx, events)
+
+			return err
+		}
+	}
+}
+
+// auditUserInfo は監査ログに記録する操作者を返す。認証されていない場合はnilを返す
+func auditUserInfo(c echo.Context) *domain.UserInfo {
+	if userInfo, ok := c.Get(UserInfoContextKey).(*domain.UserInfo); ok && userInfo != nil {
+		return userInfo
+	}
+	subject, _ := c.Get(AdminSubjectContextKey).(string)
+	userInfo, err := domain.NewUserInfo(subject, "", "", domain.ProviderTypeAdmin, nil, "")
+	if err != nil {
+		return nil
+	}
+	return userInfo
+}
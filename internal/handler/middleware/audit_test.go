@@ -0,0 +1,271 @@
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/labstack/echo/v4"
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/na2na-p/cargohold/internal/handler/middleware"
+	mock_middleware "github.com/na2na-p/cargohold/tests/handler/middleware"
+	"github.com/newmo-oss/ctxtime/ctxtimetest"
+	"github.com/newmo-oss/testid"
+	"go.uber.org/mock/gomock"
+)
+
+type auditEventSummary struct {
+	OccurredAt time.Time
+	Action     string
+	Outcome    string
+	StatusCode int
+	Subject    string
+	Provider   string
+	Repository string
+	Ref        string
+	OID        string
+	Size       int64
+	Target     string
+	ClientIP   string
+	RequestID  string
+}
+
+func summarizeAuditEvents(events []*domain.AuditEvent) []auditEventSummary {
+	summaries := make([]auditEventSummary, len(events))
+	for i, e := range events {
+		summaries[i] = auditEventSummary{
+			OccurredAt: e.OccurredAt(),
+			Action:     e.Action().String(),
+			Outcome:    e.Outcome().String(),
+			StatusCode: e.StatusCode(),
+			Subject:    e.Subject(),
+			Provider:   e.Provider(),
+			Repository: e.Repository(),
+			Ref:        e.Ref(),
+			OID:        e.OID(),
+			Size:       e.Size(),
+			Target:     e.Target(),
+			ClientIP:   e.ClientIP(),
+			RequestID:  e.RequestID(),
+		}
+	}
+	return summaries
+}
+
+func TestAudit(t *testing.T) {
+	fixedNow := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	const oid = "abc123def456789012345678901234567890123456789012345678901234abcd"
+
+	repoID, err := domain.NewRepositoryIdentifier("owner/repo")
+	if err != nil {
+		t.Fatalf("failed to create repository identifier: %v", err)
+	}
+	userInfo, err := domain.NewUserInfo("repo:owner/repo:ref:refs/heads/main", "", "actor", domain.ProviderTypeGitHub, repoID, "refs/heads/main")
+	if err != nil {
+		t.Fatalf("failed to create user info: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		handler    echo.HandlerFunc
+		wantEvents []auditEventSummary
+	}{
+		{
+			name: "正常系: 設定されたオブジェクトごとにイベントが記録される",
+			handler: func(c echo.Context) error {
+				c.Set(middleware.UserInfoContextKey, userInfo)
+				middleware.SetAuditAction(c, domain.AuditActionBatchDownload)
+				middleware.SetAuditObjects(c,
+					middleware.AuditObject{OID: oid, Size: 100},
+					middleware.AuditObject{OID: oid, Size: 200, StatusCode: http.StatusNotFound},
+				)
+				return c.NoContent(http.StatusOK)
+			},
+			wantEvents: []auditEventSummary{
+				{
+					OccurredAt: fixedNow,
+					Action:     "batch_download",
+					Outcome:    "success",
+					StatusCode: http.StatusOK,
+					Subject:    "repo:owner/repo:ref:refs/heads/main",
+					Provider:   "github",
+					Repository: "owner/repo",
+					Ref:        "refs/heads/main",
+					OID:        oid,
+					Size:       100,
+					ClientIP:   "192.0.2.1",
+					RequestID:  "req-1",
+				},
+				{
+					OccurredAt: fixedNow,
+					Action:     "batch_download",
+					Outcome:    "failure",
+					StatusCode: http.StatusNotFound,
+					Subject:    "repo:owner/repo:ref:refs/heads/main",
+					Provider:   "github",
+					Repository: "owner/repo",
+					Ref:        "refs/heads/main",
+					OID:        oid,
+					Size:       200,
+					ClientIP:   "192.0.2.1",
+					RequestID:  "req-1",
+				},
+			},
+		},
+		{
+			name: "正常系: オブジェクトが設定されていない場合、1件のイベントが記録される",
+			handler: func(c echo.Context) error {
+				middleware.SetAuditAction(c, domain.AuditActionBatch)
+				return middleware.NewAppError(http.StatusForbidden, "アクセスが拒否されました", nil)
+			},
+			wantEvents: []auditEventSummary{
+				{
+					OccurredAt: fixedNow,
+					Action:     "batch",
+					Outcome:    "denied",
+					StatusCode: http.StatusForbidden,
+					Repository: "owner/repo",
+					ClientIP:   "192.0.2.1",
+					RequestID:  "req-1",
+				},
+			},
+		},
+		{
+			name: "正常系: 操作が設定されないまま認証に失敗した場合、認証失敗が記録される",
+			handler: func(c echo.Context) error {
+				return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+			},
+			wantEvents: []auditEventSummary{
+				{
+					OccurredAt: fixedNow,
+					Action:     "authenticate",
+					Outcome:    "denied",
+					StatusCode: http.StatusUnauthorized,
+					Repository: "owner/repo",
+					ClientIP:   "192.0.2.1",
+					RequestID:  "req-1",
+				},
+			},
+		},
+		{
+			name: "正常系: 操作が設定されないまま他のリポジトリ向けの認証情報で拒否された場合、認証失敗が記録される",
+			handler: func(c echo.Context) error {
+				return middleware.NewAppError(http.StatusForbidden, "このリポジトリへのアクセスは許可されていません", nil)
+			},
+			wantEvents: []auditEventSummary{
+				{
+					OccurredAt: fixedNow,
+					Action:     "authenticate",
+					Outcome:    "denied",
+					StatusCode: http.StatusForbidden,
+					Repository: "owner/repo",
+					ClientIP:   "192.0.2.1",
+					RequestID:  "req-1",
+				},
+			},
+		},
+		{
+			name: "正常系: 操作が設定されていない認証済みの拒否されたリクエストは記録されない",
+			handler: func(c echo.Context) error {
+				c.Set(middleware.UserInfoContextKey, userInfo)
+				return middleware.NewAppError(http.StatusForbidden, "アクセスが拒否されました", nil)
+			},
+		},
+		{
+			name: "正常系: 管理APIの操作は管理者のsubjectと操作対象とともに記録される",
+			handler: func(c echo.Context) error {
+				c.Set(middleware.AdminSubjectContextKey, "admin-token")
+				middleware.SetAuditAction(c, domain.AuditActionAdminClaimRuleRemove)
+				middleware.SetAuditObjects(c, middleware.AuditObject{Target: "42"})
+				return c.NoContent(http.StatusNoContent)
+			},
+			wantEvents: []auditEventSummary{
+				{
+					OccurredAt: fixedNow,
+					Action:     "admin_claim_rule_remove",
+					Outcome:    "success",
+					StatusCode: http.StatusNoContent,
+					Subject:    "admin-token",
+					Provider:   "admin",
+					Repository: "owner/repo",
+					Target:     "42",
+					ClientIP:   "192.0.2.1",
+					RequestID:  "req-1",
+				},
+			},
+		},
+		{
+			name: "正常系: ロックの強制解除はロックIDとともに記録される",
+			handler: func(c echo.Context) error {
+				c.Set(middleware.UserInfoContextKey, userInfo)
+				middleware.SetAuditAction(c, domain.AuditActionLockForceUnlock)
+				middleware.SetAuditObjects(c, middleware.AuditObject{Target: "7"})
+				return c.NoContent(http.StatusOK)
+			},
+			wantEvents: []auditEventSummary{
+				{
+					OccurredAt: fixedNow,
+					Action:     "lock_force_unlock",
+					Outcome:    "success",
+					StatusCode: http.StatusOK,
+					Subject:    "repo:owner/repo:ref:refs/heads/main",
+					Provider:   "github",
+					Repository: "owner/repo",
+					Ref:        "refs/heads/main",
+					Target:     "7",
+					ClientIP:   "192.0.2.1",
+					RequestID:  "req-1",
+				},
+			},
+		},
+		{
+			name: "正常系: 操作が設定されていない管理者のリクエストは記録されない",
+			handler: func(c echo.Context) error {
+				c.Set(middleware.AdminSubjectContextKey, "admin-token")
+				return c.NoContent(http.StatusOK)
+			},
+		},
+		{
+			name: "正常系: 操作が設定されていない認証済みリクエストは記録されない",
+			handler: func(c echo.Context) error {
+				c.Set(middleware.UserInfoContextKey, userInfo)
+				return c.NoContent(http.StatusOK)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ctx := testid.WithValue(context.Background(), t.Name())
+			ctxtimetest.SetFixedNow(t, ctx, fixedNow)
+
+			var gotEvents []*domain.AuditEvent
+			recorder := mock_middleware.NewMockAuditRecorderInterface(ctrl)
+			if tt.wantEvents != nil {
+				recorder.EXPECT().Record(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, events []*domain.AuditEvent) {
+					gotEvents = events
+				})
+			}
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/owner/repo/info/lfs/objects/batch", nil).WithContext(ctx)
+			req.RemoteAddr = "192.0.2.1:12345"
+			rec := httptest.NewRecorder()
+			rec.Header().Set(echo.HeaderXRequestID, "req-1")
+			c := e.NewContext(req, rec)
+			c.SetParamNames("owner", "repo")
+			c.SetParamValues("owner", "repo")
+
+			_ = middleware.Audit(recorder)(tt.handler)(c)
+
+			if diff := cmp.Diff(tt.wantEvents, summarizeAuditEvents(gotEvents), cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("events mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
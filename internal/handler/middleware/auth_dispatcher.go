@@ -4,6 +4,7 @@ package middleware
 import (
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"net/http"
 	"strings"
@@ -17,11 +18,18 @@ import (
 var (
 	ErrRepositoryMismatch    = errors.New("repository mismatch")
 	ErrInvalidRepositoryPath = errors.New("invalid repository path")
+	ErrMalformedToken        = errors.New("malformed token")
 )
 
 type AuthUseCaseInterface interface {
-	AuthenticateSession(ctx context.Context, sessionID string) (*domain.UserInfo, error)
-	AuthenticateGitHubOIDC(ctx context.Context, token string) (*domain.UserInfo, error)
+	// AuthenticateSession はセッションを検証する
+	// 複数のリポジトリを対象とするセッションでは、repositoryに対する権限を持つユーザー情報を返す
+	AuthenticateSession(ctx context.Context, sessionID string, repository *domain.RepositoryIdentifier) (*domain.UserInfo, error)
+	// AuthenticateOIDC はissuerに対応する認証処理でOIDCトークンを検証する
+	AuthenticateOIDC(ctx context.Context, issuer, token string) (*domain.UserInfo, error)
+	// AuthenticateAccessToken はcargoholdが発行したアクセストークンを検証する
+	// repositoryがトークンのスコープに含まれない場合、リポジトリを持たないユーザー情報を返す
+	AuthenticateAccessToken(ctx context.Context, secret string, repository *domain.RepositoryIdentifier) (*domain.UserInfo, error)
 }
 
 func AuthDispatcher(authUC AuthUseCaseInterface) echo.MiddlewareFunc {
@@ -29,56 +37,134 @@ func AuthDispatcher(authUC AuthUseCaseInterface) echo.MiddlewareFunc {
 		return func(c echo.Context) error {
 			ctx := c.Request().Context()
 			authHeader := c.Request().Header.Get("Authorization")
+			// 認証情報を1つも検証できなかったリクエストは認証方式なしの失敗として記録する
+			attempted := false
 
 			if strings.HasPrefix(authHeader, "Bearer ") {
 				token := strings.TrimPrefix(authHeader, "Bearer ")
-				userInfo, err := authUC.AuthenticateGitHubOIDC(ctx, token)
+				if domain.IsAccessTokenSecret(token) {
+					return authenticateAccessToken(c, authUC, token, next)
+				}
+				issuer, err := unverifiedIssuer(token)
+				if err != nil {
+					recordAuthAttempt(c, AuthMethodOIDC, AuthOutcomeFailure)
+					return response.SendLFSError(c, http.StatusUnauthorized, "Unauthorized")
+				}
+				userInfo, err := authUC.AuthenticateOIDC(ctx, issuer, token)
 				if err != nil {
+					recordAuthAttempt(c, AuthMethodOIDC, AuthOutcomeFailure)
 					return response.SendLFSError(c, http.StatusUnauthorized, "Unauthorized")
 				}
 				if err := validateRepository(c, userInfo); err != nil {
+					recordAuthAttempt(c, AuthMethodOIDC, AuthOutcomeDenied)
 					return err
 				}
+				recordAuthAttempt(c, AuthMethodOIDC, AuthOutcomeSuccess)
 				c.Set(UserInfoContextKey, userInfo)
 				return next(c)
 			}
 
-			if strings.HasPrefix(authHeader, "Basic ") {
-				credentials := strings.TrimPrefix(authHeader, "Basic ")
-				decoded, err := base64.StdEncoding.DecodeString(credentials)
-				if err == nil {
-					parts := strings.SplitN(string(decoded), ":", 2)
-					if len(parts) == 2 && parts[0] == "x-session" {
-						sessionID := parts[1]
-						userInfo, err := authUC.AuthenticateSession(ctx, sessionID)
-						if err == nil {
-							if err := validateRepository(c, userInfo); err != nil {
-								return err
-							}
-							c.Set(UserInfoContextKey, userInfo)
-							return next(c)
+			if username, password, ok := parseBasicCredentials(authHeader); ok {
+				switch username {
+				case basicUsernameAccessToken:
+					return authenticateAccessToken(c, authUC, password, next)
+				case basicUsernameSession:
+					userInfo, err := authUC.AuthenticateSession(ctx, password, urlRepository(c))
+					if err == nil {
+						if err := validateRepository(c, userInfo); err != nil {
+							recordAuthAttempt(c, AuthMethodSessionBasic, AuthOutcomeDenied)
+							return err
 						}
+						recordAuthAttempt(c, AuthMethodSessionBasic, AuthOutcomeSuccess)
+						c.Set(UserInfoContextKey, userInfo)
+						return next(c)
 					}
+					recordAuthAttempt(c, AuthMethodSessionBasic, AuthOutcomeFailure)
+					attempted = true
 				}
 			}
 
 			cookie, err := c.Cookie(common.LFSSessionCookieName)
 			if err == nil && cookie.Value != "" {
-				userInfo, err := authUC.AuthenticateSession(ctx, cookie.Value)
+				userInfo, err := authUC.AuthenticateSession(ctx, cookie.Value, urlRepository(c))
 				if err == nil {
 					if err := validateRepository(c, userInfo); err != nil {
+						recordAuthAttempt(c, AuthMethodSessionCookie, AuthOutcomeDenied)
 						return err
 					}
+					recordAuthAttempt(c, AuthMethodSessionCookie, AuthOutcomeSuccess)
 					c.Set(UserInfoContextKey, userInfo)
 					return next(c)
 				}
+				recordAuthAttempt(c, AuthMethodSessionCookie, AuthOutcomeFailure)
+				attempted = true
 			}
 
+			if !attempted {
+				recordAuthAttempt(c, AuthMethodNone, AuthOutcomeFailure)
+			}
 			return response.SendLFSError(c, http.StatusUnauthorized, "Unauthorized")
 		}
 	}
 }
 
+// authenticateAccessToken はcargoholdが発行したアクセストークンでリクエストを認証する
+// トークンのスコープはリポジトリごとに決まるため、URLのリポジトリを渡して検証する
+func authenticateAccessToken(c echo.Context, authUC AuthUseCaseInterface, secret string, next echo.HandlerFunc) error {
+	userInfo, err := authUC.AuthenticateAccessToken(c.Request().Context(), secret, urlRepository(c))
+	if err != nil {
+		recordAuthAttempt(c, AuthMethodAccessToken, AuthOutcomeFailure)
+		return response.SendLFSError(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	if err := validateRepository(c, userInfo); err != nil {
+		recordAuthAttempt(c, AuthMethodAccessToken, AuthOutcomeDenied)
+		return err
+	}
+	recordAuthAttempt(c, AuthMethodAccessToken, AuthOutcomeSuccess)
+	c.Set(UserInfoContextKey, userInfo)
+	return next(c)
+}
+
+// urlRepository はURLのリポジトリを返す
+// URLのリポジトリが不正な場合はnilを返し、validateRepositoryで拒否させる
+func urlRepository(c echo.Context) *domain.RepositoryIdentifier {
+	repository, _ := common.ExtractRepositoryIdentifier(c)
+	return repository
+}
+
+// parseBasicCredentials はBasic認証のヘッダーからユーザー名とパスワードを取り出す
+func parseBasicCredentials(authHeader string) (username, password string, ok bool) {
+	credentials, ok := strings.CutPrefix(authHeader, "Basic ")
+	if !ok {
+		return "", "", false
+	}
+	decoded, err := base64.StdEncoding.DecodeString(credentials)
+	if err != nil {
+		return "", "", false
+	}
+	return strings.Cut(string(decoded), ":")
+}
+
+// unverifiedIssuer は署名を検証せずにJWTのペイロードからissクレームを取り出す
+// 取り出した値は認証処理の振り分けにのみ使用し、署名とissuerの検証は振り分け先で行う
+func unverifiedIssuer(token string) (string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return "", ErrMalformedToken
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", ErrMalformedToken
+	}
+	var claims struct {
+		Issuer string `json:"iss"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Issuer == "" {
+		return "", ErrMalformedToken
+	}
+	return claims.Issuer, nil
+}
+
 func validateRepository(c echo.Context, userInfo *domain.UserInfo) error {
 	urlRepoIdentifier, err := common.ExtractRepositoryIdentifier(c)
 	if err != nil {
@@ -111,4 +197,12 @@ func validateRepository(c echo.Context, userInfo *domain.UserInfo) error {
 
 const (
 	UserInfoContextKey = "user_info"
+	// SessionIDContextKey はSessionAuthで認証したセッションのID
+	SessionIDContextKey = "session_id"
+)
+
+// Basic認証のユーザー名で認証情報の種類を区別する
+const (
+	basicUsernameSession     = "x-session"
+	basicUsernameAccessToken = "x-token"
 )
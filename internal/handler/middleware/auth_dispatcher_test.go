@@ -2,6 +2,7 @@ package middleware_test
 
 import (
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
@@ -32,6 +33,19 @@ func mustNewUserInfo(t *testing.T, sub, email, name string, provider domain.Prov
 	return userInfo
 }
 
+// newTestJWT は指定したissuerを含む、署名のないJWT形式のトークンを生成する
+func newTestJWT(issuer, subject string) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"RS256","typ":"JWT"}`))
+	payload, _ := json.Marshal(map[string]string{"iss": issuer, "sub": subject})
+	return header + "." + base64.RawURLEncoding.EncodeToString(payload) + ".signature"
+}
+
+var (
+	validToken   = newTestJWT(domain.GitHubActionsIssuer, "valid")
+	invalidToken = newTestJWT(domain.GitHubActionsIssuer, "invalid")
+	gitlabToken  = newTestJWT("https://gitlab.com", "gitlab")
+)
+
 func TestAuthDispatcher(t *testing.T) {
 	type fields struct {
 		setupMock func(t *testing.T, ctrl *gomock.Controller) *mock_middleware.MockAuthUseCaseInterface
@@ -57,7 +71,7 @@ func TestAuthDispatcher(t *testing.T) {
 				setupMock: func(t *testing.T, ctrl *gomock.Controller) *mock_middleware.MockAuthUseCaseInterface {
 					mock := mock_middleware.NewMockAuthUseCaseInterface(ctrl)
 					mock.EXPECT().
-						AuthenticateGitHubOIDC(gomock.Any(), "valid-token").
+						AuthenticateOIDC(gomock.Any(), domain.GitHubActionsIssuer, validToken).
 						Return(mustNewUserInfo(t,
 							"repo:testowner/testrepo:ref:refs/heads/main",
 							"",
@@ -75,7 +89,7 @@ func TestAuthDispatcher(t *testing.T) {
 				owner:  "testowner",
 				repo:   "testrepo",
 				headers: map[string]string{
-					"Authorization": "Bearer valid-token",
+					"Authorization": "Bearer " + validToken,
 				},
 			},
 			wantStatusCode: http.StatusOK,
@@ -87,7 +101,7 @@ func TestAuthDispatcher(t *testing.T) {
 				setupMock: func(t *testing.T, ctrl *gomock.Controller) *mock_middleware.MockAuthUseCaseInterface {
 					mock := mock_middleware.NewMockAuthUseCaseInterface(ctrl)
 					mock.EXPECT().
-						AuthenticateGitHubOIDC(gomock.Any(), "valid-token").
+						AuthenticateOIDC(gomock.Any(), domain.GitHubActionsIssuer, validToken).
 						Return(mustNewUserInfo(t,
 							"repo:otherowner/otherrepo:ref:refs/heads/main",
 							"",
@@ -105,7 +119,7 @@ func TestAuthDispatcher(t *testing.T) {
 				owner:  "testowner",
 				repo:   "testrepo",
 				headers: map[string]string{
-					"Authorization": "Bearer valid-token",
+					"Authorization": "Bearer " + validToken,
 				},
 			},
 			wantStatusCode: http.StatusForbidden,
@@ -117,7 +131,7 @@ func TestAuthDispatcher(t *testing.T) {
 				setupMock: func(t *testing.T, ctrl *gomock.Controller) *mock_middleware.MockAuthUseCaseInterface {
 					mock := mock_middleware.NewMockAuthUseCaseInterface(ctrl)
 					mock.EXPECT().
-						AuthenticateGitHubOIDC(gomock.Any(), "invalid-token").
+						AuthenticateOIDC(gomock.Any(), domain.GitHubActionsIssuer, invalidToken).
 						Return(nil, errors.New("invalid token"))
 					return mock
 				},
@@ -128,7 +142,75 @@ func TestAuthDispatcher(t *testing.T) {
 				owner:  "testowner",
 				repo:   "testrepo",
 				headers: map[string]string{
-					"Authorization": "Bearer invalid-token",
+					"Authorization": "Bearer " + invalidToken,
+				},
+			},
+			wantStatusCode: http.StatusUnauthorized,
+			wantNextCalled: false,
+		},
+		{
+			name: "正常系: GitLab CIのトークンの場合、issuerがGitLabとして認証される",
+			fields: fields{
+				setupMock: func(t *testing.T, ctrl *gomock.Controller) *mock_middleware.MockAuthUseCaseInterface {
+					mock := mock_middleware.NewMockAuthUseCaseInterface(ctrl)
+					mock.EXPECT().
+						AuthenticateOIDC(gomock.Any(), "https://gitlab.com", gitlabToken).
+						Return(mustNewUserInfo(t,
+							"project_path:testowner/testrepo:ref_type:branch:ref:main",
+							"",
+							"gitlab-user",
+							domain.ProviderTypeGitLab,
+							mustParseRepo(t, "testowner/testrepo"),
+							"refs/heads/main",
+						), nil)
+					return mock
+				},
+			},
+			args: args{
+				method: http.MethodPost,
+				path:   "/testowner/testrepo/info/lfs/objects/batch",
+				owner:  "testowner",
+				repo:   "testrepo",
+				headers: map[string]string{
+					"Authorization": "Bearer " + gitlabToken,
+				},
+			},
+			wantStatusCode: http.StatusOK,
+			wantNextCalled: true,
+		},
+		{
+			name: "異常系: BearerトークンがJWT形式でない場合、認証処理を呼ばずに401が返る",
+			fields: fields{
+				setupMock: func(t *testing.T, ctrl *gomock.Controller) *mock_middleware.MockAuthUseCaseInterface {
+					return mock_middleware.NewMockAuthUseCaseInterface(ctrl)
+				},
+			},
+			args: args{
+				method: http.MethodPost,
+				path:   "/testowner/testrepo/info/lfs/objects/batch",
+				owner:  "testowner",
+				repo:   "testrepo",
+				headers: map[string]string{
+					"Authorization": "Bearer not-a-jwt",
+				},
+			},
+			wantStatusCode: http.StatusUnauthorized,
+			wantNextCalled: false,
+		},
+		{
+			name: "異常系: Bearerトークンにissクレームがない場合、認証処理を呼ばずに401が返る",
+			fields: fields{
+				setupMock: func(t *testing.T, ctrl *gomock.Controller) *mock_middleware.MockAuthUseCaseInterface {
+					return mock_middleware.NewMockAuthUseCaseInterface(ctrl)
+				},
+			},
+			args: args{
+				method: http.MethodPost,
+				path:   "/testowner/testrepo/info/lfs/objects/batch",
+				owner:  "testowner",
+				repo:   "testrepo",
+				headers: map[string]string{
+					"Authorization": "Bearer " + newTestJWT("", "no-issuer"),
 				},
 			},
 			wantStatusCode: http.StatusUnauthorized,
@@ -140,7 +222,7 @@ func TestAuthDispatcher(t *testing.T) {
 				setupMock: func(t *testing.T, ctrl *gomock.Controller) *mock_middleware.MockAuthUseCaseInterface {
 					mock := mock_middleware.NewMockAuthUseCaseInterface(ctrl)
 					mock.EXPECT().
-						AuthenticateSession(gomock.Any(), "valid-session-id").
+						AuthenticateSession(gomock.Any(), "valid-session-id", mustParseRepo(t, "testowner/testrepo")).
 						Return(mustNewUserInfo(t,
 							"user123",
 							"",
@@ -170,7 +252,7 @@ func TestAuthDispatcher(t *testing.T) {
 				setupMock: func(t *testing.T, ctrl *gomock.Controller) *mock_middleware.MockAuthUseCaseInterface {
 					mock := mock_middleware.NewMockAuthUseCaseInterface(ctrl)
 					mock.EXPECT().
-						AuthenticateSession(gomock.Any(), "valid-session-id").
+						AuthenticateSession(gomock.Any(), "valid-session-id", gomock.Any()).
 						Return(mustNewUserInfo(t,
 							"user123",
 							"",
@@ -194,13 +276,49 @@ func TestAuthDispatcher(t *testing.T) {
 			wantStatusCode: http.StatusForbidden,
 			wantNextCalled: false,
 		},
+		{
+			name: "異常系: 複数のリポジトリを対象とするセッションでリポジトリの権限が得られない場合、403が返る",
+			fields: fields{
+				setupMock: func(t *testing.T, ctrl *gomock.Controller) *mock_middleware.MockAuthUseCaseInterface {
+					mock := mock_middleware.NewMockAuthUseCaseInterface(ctrl)
+					userInfo := mustNewUserInfo(t,
+						"user123",
+						"",
+						"testuser",
+						domain.ProviderTypeGitHub,
+						nil,
+						"",
+					)
+					scope, err := domain.ParseRepositoryScope("otherowner/*")
+					if err != nil {
+						t.Fatalf("ParseRepositoryScope() failed: %v", err)
+					}
+					userInfo.SetRepositoryScope(scope)
+					mock.EXPECT().
+						AuthenticateSession(gomock.Any(), "valid-session-id", mustParseRepo(t, "testowner/testrepo")).
+						Return(userInfo, nil)
+					return mock
+				},
+			},
+			args: args{
+				method: http.MethodPost,
+				path:   "/testowner/testrepo/info/lfs/objects/batch",
+				owner:  "testowner",
+				repo:   "testrepo",
+				cookies: []*http.Cookie{
+					{Name: "lfs_session", Value: "valid-session-id"},
+				},
+			},
+			wantStatusCode: http.StatusForbidden,
+			wantNextCalled: false,
+		},
 		{
 			name: "異常系: セッション認証でセッションが無効の場合、401が返る",
 			fields: fields{
 				setupMock: func(t *testing.T, ctrl *gomock.Controller) *mock_middleware.MockAuthUseCaseInterface {
 					mock := mock_middleware.NewMockAuthUseCaseInterface(ctrl)
 					mock.EXPECT().
-						AuthenticateSession(gomock.Any(), "invalid-session-id").
+						AuthenticateSession(gomock.Any(), "invalid-session-id", gomock.Any()).
 						Return(nil, errors.New("session not found"))
 					return mock
 				},
@@ -240,7 +358,7 @@ func TestAuthDispatcher(t *testing.T) {
 				setupMock: func(t *testing.T, ctrl *gomock.Controller) *mock_middleware.MockAuthUseCaseInterface {
 					mock := mock_middleware.NewMockAuthUseCaseInterface(ctrl)
 					mock.EXPECT().
-						AuthenticateGitHubOIDC(gomock.Any(), "valid-token").
+						AuthenticateOIDC(gomock.Any(), domain.GitHubActionsIssuer, validToken).
 						Return(mustNewUserInfo(t,
 							"repo:TestOwner/TestRepo:ref:refs/heads/main",
 							"",
@@ -258,7 +376,7 @@ func TestAuthDispatcher(t *testing.T) {
 				owner:  "testowner",
 				repo:   "testrepo",
 				headers: map[string]string{
-					"Authorization": "Bearer valid-token",
+					"Authorization": "Bearer " + validToken,
 				},
 			},
 			wantStatusCode: http.StatusOK,
@@ -270,7 +388,7 @@ func TestAuthDispatcher(t *testing.T) {
 				setupMock: func(t *testing.T, ctrl *gomock.Controller) *mock_middleware.MockAuthUseCaseInterface {
 					mock := mock_middleware.NewMockAuthUseCaseInterface(ctrl)
 					mock.EXPECT().
-						AuthenticateGitHubOIDC(gomock.Any(), "valid-token").
+						AuthenticateOIDC(gomock.Any(), domain.GitHubActionsIssuer, validToken).
 						Return(mustNewUserInfo(t,
 							"repo:testowner/testrepo:ref:refs/heads/main",
 							"",
@@ -288,7 +406,7 @@ func TestAuthDispatcher(t *testing.T) {
 				owner:  "",
 				repo:   "",
 				headers: map[string]string{
-					"Authorization": "Bearer valid-token",
+					"Authorization": "Bearer " + validToken,
 				},
 			},
 			wantStatusCode: http.StatusBadRequest,
@@ -300,7 +418,7 @@ func TestAuthDispatcher(t *testing.T) {
 				setupMock: func(t *testing.T, ctrl *gomock.Controller) *mock_middleware.MockAuthUseCaseInterface {
 					mock := mock_middleware.NewMockAuthUseCaseInterface(ctrl)
 					mock.EXPECT().
-						AuthenticateSession(gomock.Any(), "valid-session-id").
+						AuthenticateSession(gomock.Any(), "valid-session-id", gomock.Any()).
 						Return(mustNewUserInfo(t,
 							"user123",
 							"",
@@ -350,7 +468,7 @@ func TestAuthDispatcher(t *testing.T) {
 				setupMock: func(t *testing.T, ctrl *gomock.Controller) *mock_middleware.MockAuthUseCaseInterface {
 					mock := mock_middleware.NewMockAuthUseCaseInterface(ctrl)
 					mock.EXPECT().
-						AuthenticateSession(gomock.Any(), "invalid-session-id").
+						AuthenticateSession(gomock.Any(), "invalid-session-id", gomock.Any()).
 						Return(nil, errors.New("session not found"))
 					return mock
 				},
@@ -373,7 +491,7 @@ func TestAuthDispatcher(t *testing.T) {
 				setupMock: func(t *testing.T, ctrl *gomock.Controller) *mock_middleware.MockAuthUseCaseInterface {
 					mock := mock_middleware.NewMockAuthUseCaseInterface(ctrl)
 					mock.EXPECT().
-						AuthenticateSession(gomock.Any(), "valid-session-id").
+						AuthenticateSession(gomock.Any(), "valid-session-id", gomock.Any()).
 						Return(mustNewUserInfo(t,
 							"user123",
 							"",
@@ -397,6 +515,99 @@ func TestAuthDispatcher(t *testing.T) {
 			wantStatusCode: http.StatusForbidden,
 			wantNextCalled: false,
 		},
+		{
+			name: "正常系: Bearer認証でアクセストークンの場合、URLのリポジトリを渡して検証しnextが呼ばれる",
+			fields: fields{
+				setupMock: func(t *testing.T, ctrl *gomock.Controller) *mock_middleware.MockAuthUseCaseInterface {
+					mock := mock_middleware.NewMockAuthUseCaseInterface(ctrl)
+					mock.EXPECT().
+						AuthenticateAccessToken(gomock.Any(), "cht_secret", mustParseRepo(t, "testowner/testrepo")).
+						Return(mustNewUserInfo(t, "12345", "", "build-farm", domain.ProviderTypeAccessToken, mustParseRepo(t, "testowner/testrepo"), ""), nil)
+					return mock
+				},
+			},
+			args: args{
+				method: http.MethodPost,
+				path:   "/testowner/testrepo/info/lfs/objects/batch",
+				owner:  "testowner",
+				repo:   "testrepo",
+				headers: map[string]string{
+					"Authorization": "Bearer cht_secret",
+				},
+			},
+			wantStatusCode: http.StatusOK,
+			wantNextCalled: true,
+		},
+		{
+			name: "正常系: Basic認証でusernameがx-tokenの場合、アクセストークンとして検証しnextが呼ばれる",
+			fields: fields{
+				setupMock: func(t *testing.T, ctrl *gomock.Controller) *mock_middleware.MockAuthUseCaseInterface {
+					mock := mock_middleware.NewMockAuthUseCaseInterface(ctrl)
+					mock.EXPECT().
+						AuthenticateAccessToken(gomock.Any(), "cht_secret", mustParseRepo(t, "testowner/testrepo")).
+						Return(mustNewUserInfo(t, "12345", "", "build-farm", domain.ProviderTypeAccessToken, mustParseRepo(t, "testowner/testrepo"), ""), nil)
+					return mock
+				},
+			},
+			args: args{
+				method: http.MethodPost,
+				path:   "/testowner/testrepo/info/lfs/objects/batch",
+				owner:  "testowner",
+				repo:   "testrepo",
+				headers: map[string]string{
+					"Authorization": "Basic " + base64.StdEncoding.EncodeToString([]byte("x-token:cht_secret")),
+				},
+			},
+			wantStatusCode: http.StatusOK,
+			wantNextCalled: true,
+		},
+		{
+			name: "異常系: アクセストークンのスコープにリポジトリが含まれない場合、403が返る",
+			fields: fields{
+				setupMock: func(t *testing.T, ctrl *gomock.Controller) *mock_middleware.MockAuthUseCaseInterface {
+					mock := mock_middleware.NewMockAuthUseCaseInterface(ctrl)
+					mock.EXPECT().
+						AuthenticateAccessToken(gomock.Any(), "cht_secret", mustParseRepo(t, "testowner/testrepo")).
+						Return(mustNewUserInfo(t, "12345", "", "build-farm", domain.ProviderTypeAccessToken, nil, ""), nil)
+					return mock
+				},
+			},
+			args: args{
+				method: http.MethodPost,
+				path:   "/testowner/testrepo/info/lfs/objects/batch",
+				owner:  "testowner",
+				repo:   "testrepo",
+				headers: map[string]string{
+					"Authorization": "Bearer cht_secret",
+				},
+			},
+			wantStatusCode: http.StatusForbidden,
+			wantNextCalled: false,
+		},
+		{
+			name: "異常系: アクセストークンが無効な場合、Cookieがあっても401が返る",
+			fields: fields{
+				setupMock: func(t *testing.T, ctrl *gomock.Controller) *mock_middleware.MockAuthUseCaseInterface {
+					mock := mock_middleware.NewMockAuthUseCaseInterface(ctrl)
+					mock.EXPECT().
+						AuthenticateAccessToken(gomock.Any(), "cht_revoked", gomock.Any()).
+						Return(nil, errors.New("invalid access token"))
+					return mock
+				},
+			},
+			args: args{
+				method: http.MethodPost,
+				path:   "/testowner/testrepo/info/lfs/objects/batch",
+				owner:  "testowner",
+				repo:   "testrepo",
+				headers: map[string]string{
+					"Authorization": "Basic " + base64.StdEncoding.EncodeToString([]byte("x-token:cht_revoked")),
+				},
+				cookies: []*http.Cookie{{Name: "lfs_session", Value: "valid-session-id"}},
+			},
+			wantStatusCode: http.StatusUnauthorized,
+			wantNextCalled: false,
+		},
 	}
 
 	for _, tt := range tests {
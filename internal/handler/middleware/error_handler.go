@@ -77,7 +77,7 @@ func CustomHTTPErrorHandler(err error, c echo.Context) {
 		slog.Warn("クライアントエラー", logAttrs...)
 	}
 
-	if strings.HasPrefix(c.Request().URL.Path, "/auth/") {
+	if path := c.Request().URL.Path; strings.HasPrefix(path, "/auth/") || strings.HasPrefix(path, "/admin/") {
 		if jsonErr := c.JSON(statusCode, map[string]string{"error": message}); jsonErr != nil {
 			slog.Error("レスポンスの送信に失敗しました",
 				"request_id", requestID,
@@ -107,3 +107,21 @@ func CustomHTTPErrorHandler(err error, c echo.Context) {
 		)
 	}
 }
+
+// responseStatusCode はレスポンスのステータスコードを返す
+// レスポンスが書き込まれないままハンドラーがエラーを返した場合は、エラーハンドラーと同様にエラーから判定する
+func responseStatusCode(c echo.Context, err error) int {
+	if err == nil || c.Response().Committed {
+		return c.Response().Status
+	}
+
+	var appErr *AppError
+	if errors.As(err, &appErr) {
+		return appErr.StatusCode
+	}
+	var httpErr *echo.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.Code
+	}
+	return http.StatusInternalServerError
+}
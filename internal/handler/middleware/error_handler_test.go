@@ -334,6 +334,23 @@ func TestCustomHTTPErrorHandler(t *testing.T) {
 				logCalled:   true,
 			},
 		},
+		{
+			name: "正常系: /admin/パスでAppErrorの場合、JSON形式でエラーが返される",
+			args: args{
+				err:       middleware.NewAppError(http.StatusNotFound, "オブジェクトが見つかりません", nil),
+				committed: false,
+				requestID: "req-admin-json",
+				method:    http.MethodGet,
+				path:      "/admin/api/v1/objects/abc",
+			},
+			want: want{
+				statusCode:  http.StatusNotFound,
+				bodyContain: `"error":"オブジェクトが見つかりません"`,
+				contentType: "application/json",
+				logLevel:    slog.LevelWarn,
+				logCalled:   true,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,87 @@
+//go:generate mockgen -source=$GOFILE -destination=../../../tests/handler/middleware/mock_metrics.go -package=middleware
+package middleware
+
+import (
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/newmo-oss/ctxtime"
+)
+
+const metricsRecorderContextKey = "metrics_recorder"
+
+const (
+	ProxyDirectionUpload   = "upload"
+	ProxyDirectionDownload = "download"
+)
+
+const (
+	AuthMethodOIDC          = "oidc"
+	AuthMethodSessionBasic  = "session_basic"
+	AuthMethodSessionCookie = "session_cookie"
+	AuthMethodAccessToken   = "access_token"
+	AuthMethodNone          = "none"
+)
+
+const (
+	AuthOutcomeSuccess = "success"
+	AuthOutcomeDenied  = "denied"
+	AuthOutcomeFailure = "failure"
+)
+
+// unmatchedRoute はルートに一致しなかったリクエストに付与するルート名
+const unmatchedRoute = "unmatched"
+
+type MetricsRecorderInterface interface {
+	ObserveHTTPRequest(route, method string, statusCode int, elapsed time.Duration)
+	AddProxiedBytes(direction string, bytes int64)
+	ObserveBatchSize(operation string, objects int)
+	RecordAuthAttempt(method, outcome string)
+}
+
+// Metrics はルートごとのリクエスト数と処理時間を記録する
+// 後続のハンドラーやミドルウェアがRecord系の関数でメトリクスを記録できるよう、レコーダーをコンテキストに格納する
+func Metrics(recorder MetricsRecorderInterface) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			c.Set(metricsRecorderContextKey, recorder)
+			ctx := c.Request().Context()
+			start := ctxtime.Now(ctx)
+
+			err := next(c)
+
+			route := c.Path()
+			if route == "" {
+				route = unmatchedRoute
+			}
+			recorder.ObserveHTTPRequest(route, c.Request().Method, responseStatusCode(c, err), ctxtime.Now(ctx).Sub(start))
+			return err
+		}
+	}
+}
+
+// RecordProxiedBytes はプロキシ経由で転送したバイト数を記録する
+func RecordProxiedBytes(c echo.Context, direction string, bytes int64) {
+	if recorder := metricsRecorder(c); recorder != nil {
+		recorder.AddProxiedBytes(direction, bytes)
+	}
+}
+
+// RecordBatchSize はバッチリクエストに含まれるオブジェクト数を記録する
+func RecordBatchSize(c echo.Context, operation string, objects int) {
+	if recorder := metricsRecorder(c); recorder != nil {
+		recorder.ObserveBatchSize(operation, objects)
+	}
+}
+
+func recordAuthAttempt(c echo.Context, method, outcome string) {
+	if recorder := metricsRecorder(c); recorder != nil {
+		recorder.RecordAuthAttempt(method, outcome)
+	}
+}
+
+// metricsRecorder はMetricsミドルウェアが格納したレコーダーを返す。メトリクスが無効な場合はnilを返す
+func metricsRecorder(c echo.Context) MetricsRecorderInterface {
+	recorder, _ := c.Get(metricsRecorderContextKey).(MetricsRecorderInterface)
+	return recorder
+}
@@ -0,0 +1,200 @@
+package middleware_test
+
+import (
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/na2na-p/cargohold/internal/handler/middleware"
+	mock_middleware "github.com/na2na-p/cargohold/tests/handler/middleware"
+	"go.uber.org/mock/gomock"
+)
+
+func TestMetrics(t *testing.T) {
+	tests := []struct {
+		name           string
+		route          string
+		handler        echo.HandlerFunc
+		wantRoute      string
+		wantStatusCode int
+	}{
+		{
+			name:  "正常系: ルートとレスポンスのステータスコードが記録される",
+			route: "/:owner/:repo/info/lfs/objects/batch",
+			handler: func(c echo.Context) error {
+				return c.NoContent(http.StatusOK)
+			},
+			wantRoute:      "/:owner/:repo/info/lfs/objects/batch",
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:  "正常系: レスポンスを書き込まずにエラーを返した場合、エラーのステータスコードが記録される",
+			route: "/:owner/:repo/info/lfs/objects/:oid",
+			handler: func(c echo.Context) error {
+				return middleware.NewAppError(http.StatusNotFound, "オブジェクトが見つかりません", nil)
+			},
+			wantRoute:      "/:owner/:repo/info/lfs/objects/:oid",
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "正常系: ルートに一致しない場合、unmatchedとして記録される",
+			handler: func(c echo.Context) error {
+				return echo.ErrNotFound
+			},
+			wantRoute:      "unmatched",
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			recorder := mock_middleware.NewMockMetricsRecorderInterface(ctrl)
+			recorder.EXPECT().ObserveHTTPRequest(tt.wantRoute, http.MethodPost, tt.wantStatusCode, gomock.Any())
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/owner/repo/info/lfs/objects/batch", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath(tt.route)
+
+			_ = middleware.Metrics(recorder)(tt.handler)(c)
+		})
+	}
+}
+
+func TestRecordProxiedBytes(t *testing.T) {
+	t.Run("正常系: Metricsミドルウェアを経由した場合、転送バイト数が記録される", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		recorder := mock_middleware.NewMockMetricsRecorderInterface(ctrl)
+		recorder.EXPECT().AddProxiedBytes(middleware.ProxyDirectionDownload, int64(1024))
+		recorder.EXPECT().ObserveHTTPRequest(gomock.Any(), gomock.Any(), http.StatusOK, gomock.Any())
+
+		e := echo.New()
+		c := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), httptest.NewRecorder())
+
+		_ = middleware.Metrics(recorder)(func(c echo.Context) error {
+			middleware.RecordProxiedBytes(c, middleware.ProxyDirectionDownload, 1024)
+			return c.NoContent(http.StatusOK)
+		})(c)
+	})
+
+	t.Run("正常系: Metricsミドルウェアを経由しない場合、何も記録されない", func(t *testing.T) {
+		e := echo.New()
+		c := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), httptest.NewRecorder())
+
+		middleware.RecordProxiedBytes(c, middleware.ProxyDirectionUpload, 1024)
+		middleware.RecordBatchSize(c, "upload", 3)
+	})
+}
+
+func TestAuthDispatcher_Metrics(t *testing.T) {
+	repo := mustParseRepo(t, "owner/repo")
+	otherRepo := mustParseRepo(t, "owner/other")
+	userInfo := mustNewUserInfo(t, "user-1", "", "user", domain.ProviderTypeGitHub, repo, "")
+	otherUserInfo := mustNewUserInfo(t, "user-2", "", "user", domain.ProviderTypeGitHub, otherRepo, "")
+	basicSession := "Basic " + base64.StdEncoding.EncodeToString([]byte("x-session:session-id"))
+
+	tests := []struct {
+		name        string
+		headers     map[string]string
+		cookie      *http.Cookie
+		setupMock   func(ctrl *gomock.Controller) *mock_middleware.MockAuthUseCaseInterface
+		wantMethod  string
+		wantOutcome string
+	}{
+		{
+			name:    "正常系: OIDC認証に成功した場合、oidcの成功が記録される",
+			headers: map[string]string{"Authorization": "Bearer " + validToken},
+			setupMock: func(ctrl *gomock.Controller) *mock_middleware.MockAuthUseCaseInterface {
+				mock := mock_middleware.NewMockAuthUseCaseInterface(ctrl)
+				mock.EXPECT().AuthenticateOIDC(gomock.Any(), domain.GitHubActionsIssuer, validToken).Return(userInfo, nil)
+				return mock
+			},
+			wantMethod:  middleware.AuthMethodOIDC,
+			wantOutcome: middleware.AuthOutcomeSuccess,
+		},
+		{
+			name:    "異常系: OIDC認証に失敗した場合、oidcの失敗が記録される",
+			headers: map[string]string{"Authorization": "Bearer " + invalidToken},
+			setupMock: func(ctrl *gomock.Controller) *mock_middleware.MockAuthUseCaseInterface {
+				mock := mock_middleware.NewMockAuthUseCaseInterface(ctrl)
+				mock.EXPECT().AuthenticateOIDC(gomock.Any(), domain.GitHubActionsIssuer, invalidToken).Return(nil, errors.New("invalid token"))
+				return mock
+			},
+			wantMethod:  middleware.AuthMethodOIDC,
+			wantOutcome: middleware.AuthOutcomeFailure,
+		},
+		{
+			name:    "異常系: セッションのリポジトリが一致しない場合、session_basicの拒否が記録される",
+			headers: map[string]string{"Authorization": basicSession},
+			setupMock: func(ctrl *gomock.Controller) *mock_middleware.MockAuthUseCaseInterface {
+				mock := mock_middleware.NewMockAuthUseCaseInterface(ctrl)
+				mock.EXPECT().AuthenticateSession(gomock.Any(), "session-id", gomock.Any()).Return(otherUserInfo, nil)
+				return mock
+			},
+			wantMethod:  middleware.AuthMethodSessionBasic,
+			wantOutcome: middleware.AuthOutcomeDenied,
+		},
+		{
+			name:   "異常系: Cookieのセッションが無効な場合、session_cookieの失敗が記録される",
+			cookie: &http.Cookie{Name: "lfs_session", Value: "expired"},
+			setupMock: func(ctrl *gomock.Controller) *mock_middleware.MockAuthUseCaseInterface {
+				mock := mock_middleware.NewMockAuthUseCaseInterface(ctrl)
+				mock.EXPECT().AuthenticateSession(gomock.Any(), "expired", gomock.Any()).Return(nil, errors.New("session not found"))
+				return mock
+			},
+			wantMethod:  middleware.AuthMethodSessionCookie,
+			wantOutcome: middleware.AuthOutcomeFailure,
+		},
+		{
+			name:    "正常系: アクセストークンの認証に成功した場合、access_tokenの成功が記録される",
+			headers: map[string]string{"Authorization": "Bearer cht_secret"},
+			setupMock: func(ctrl *gomock.Controller) *mock_middleware.MockAuthUseCaseInterface {
+				mock := mock_middleware.NewMockAuthUseCaseInterface(ctrl)
+				mock.EXPECT().AuthenticateAccessToken(gomock.Any(), "cht_secret", repo).Return(userInfo, nil)
+				return mock
+			},
+			wantMethod:  middleware.AuthMethodAccessToken,
+			wantOutcome: middleware.AuthOutcomeSuccess,
+		},
+		{
+			name: "異常系: 認証情報がない場合、認証方式なしの失敗が記録される",
+			setupMock: func(ctrl *gomock.Controller) *mock_middleware.MockAuthUseCaseInterface {
+				return mock_middleware.NewMockAuthUseCaseInterface(ctrl)
+			},
+			wantMethod:  middleware.AuthMethodNone,
+			wantOutcome: middleware.AuthOutcomeFailure,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			recorder := mock_middleware.NewMockMetricsRecorderInterface(ctrl)
+			recorder.EXPECT().RecordAuthAttempt(tt.wantMethod, tt.wantOutcome)
+			recorder.EXPECT().ObserveHTTPRequest(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any())
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/owner/repo/info/lfs/objects/batch", nil)
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+			if tt.cookie != nil {
+				req.AddCookie(tt.cookie)
+			}
+			c := e.NewContext(req, httptest.NewRecorder())
+			c.SetParamNames("owner", "repo")
+			c.SetParamValues("owner", "repo")
+
+			next := func(c echo.Context) error {
+				return c.NoContent(http.StatusOK)
+			}
+			_ = middleware.Metrics(recorder)(middleware.AuthDispatcher(tt.setupMock(ctrl))(next))(c)
+		})
+	}
+}
@@ -0,0 +1,177 @@
+//go:generate mockgen -source=$GOFILE -destination=../../../tests/handler/middleware/mock_rate_limit.go -package=middleware
+package middleware
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/na2na-p/cargohold/internal/handler/common"
+	"github.com/na2na-p/cargohold/internal/handler/response"
+)
+
+const (
+	tooManyRequestsMessage             = "Too Many Requests"
+	proxyTransferReservationContextKey = "proxy_transfer_reservation"
+)
+
+type RateLimiterInterface interface {
+	AllowBatch(ctx context.Context, subjects []domain.RateLimitSubject) (domain.RateLimitDecision, error)
+	AllowProxyTransfer(ctx context.Context, subjects []domain.RateLimitSubject, expectedBytes int64) (domain.RateLimitDecision, error)
+	RecordProxyTransfer(ctx context.Context, subjects []domain.RateLimitSubject, expectedBytes, transferredBytes int64) error
+}
+
+// BatchRateLimit は認証済みの識別子・リポジトリ・クライアントIPごとにバッチリクエストの回数を制限する
+// AuthDispatcherの後に適用する必要がある
+// レート制限のストアに障害が発生した場合は、LFSの利用を止めないようリクエストを許可する
+func BatchRateLimit(limiter RateLimiterInterface) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			decision, err := limiter.AllowBatch(c.Request().Context(), rateLimitSubjects(c))
+			if err != nil {
+				slog.Warn("レート制限の判定に失敗したため、リクエストを許可します", "error", err)
+				return next(c)
+			}
+			if !decision.Allowed {
+				return sendTooManyRequests(c, decision.RetryAfter)
+			}
+			return next(c)
+		}
+	}
+}
+
+// ProxyRateLimit は認証済みの識別子・リポジトリ・クライアントIPごとにプロキシ経由の転送バイト数を制限する
+// 同時に開始した転送が上限を超えないよう、転送予定のバイト数を開始前に消費し、転送を終えた後に実際のバイト数との差を精算する
+// アップロードはContent-Lengthを転送予定とし、ダウンロードはハンドラーがReserveProxyTransferでオブジェクトのサイズを予約する
+// AuthDispatcherの後に適用する必要がある
+func ProxyRateLimit(limiter RateLimiterInterface) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			reservation := &proxyTransferReservation{
+				limiter:  limiter,
+				subjects: rateLimitSubjects(c),
+			}
+			if allowed, err := reservation.reserve(c, max(c.Request().ContentLength, 0)); !allowed {
+				return err
+			}
+			c.Set(proxyTransferReservationContextKey, reservation)
+
+			var body *rateLimitCountingReader
+			if c.Request().Body != nil {
+				body = &rateLimitCountingReader{ReadCloser: c.Request().Body}
+				c.Request().Body = body
+			}
+
+			handlerErr := next(c)
+
+			// クライアントの切断でリクエストのコンテキストがキャンセルされても転送量は消費する
+			transferred := c.Response().Size
+			if body != nil {
+				transferred += body.count
+			}
+			ctx := context.WithoutCancel(c.Request().Context())
+			if err := limiter.RecordProxyTransfer(ctx, reservation.subjects, reservation.expectedBytes, transferred); err != nil {
+				slog.Warn("転送量のレート制限への反映に失敗しました", "bytes", transferred, "error", err)
+			}
+			return handlerErr
+		}
+	}
+}
+
+// ReserveProxyTransfer はハンドラーが転送の開始前に判明した転送予定のバイト数を予約する
+// 上限を超える場合はRetry-After付きの429を返すため、ハンドラーは転送せずにそのまま返す
+// ProxyRateLimitが適用されていない場合は何もしない
+func ReserveProxyTransfer(c echo.Context, expectedBytes int64) (bool, error) {
+	reservation, ok := c.Get(proxyTransferReservationContextKey).(*proxyTransferReservation)
+	if !ok {
+		return true, nil
+	}
+	return reservation.reserve(c, expectedBytes)
+}
+
+// proxyTransferReservation は1回のプロキシ転送について前払いしたバイト数を保持する
+type proxyTransferReservation struct {
+	limiter       RateLimiterInterface
+	subjects      []domain.RateLimitSubject
+	expectedBytes int64
+}
+
+// reserve は前払い済みの分を超える転送予定のバイト数を前払いし、拒否された場合は429を返す
+// 前払いするバイト数が0の場合も、過去の転送で超過していれば拒否する
+// レート制限のストアに障害が発生した場合は、LFSの利用を止めないよう前払いせずに許可する
+func (r *proxyTransferReservation) reserve(c echo.Context, expectedBytes int64) (bool, error) {
+	if r.expectedBytes > 0 && expectedBytes <= r.expectedBytes {
+		return true, nil
+	}
+	decision, err := r.limiter.AllowProxyTransfer(c.Request().Context(), r.subjects, expectedBytes-r.expectedBytes)
+	if err != nil {
+		slog.Warn("レート制限の判定に失敗したため、リクエストを許可します", "error", err)
+		return true, nil
+	}
+	if !decision.Allowed {
+		return false, sendTooManyRequests(c, decision.RetryAfter)
+	}
+	r.expectedBytes = expectedBytes
+	return true, nil
+}
+
+// ProxyConcurrencyLimit はPodあたりの同時プロキシ転送数をmaxStreamsに制限する
+// 上限に達している場合は待たずに429を返し、クライアントの再試行に任せる
+func ProxyConcurrencyLimit(maxStreams int) echo.MiddlewareFunc {
+	slots := make(chan struct{}, maxStreams)
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			select {
+			case slots <- struct{}{}:
+				defer func() { <-slots }()
+				return next(c)
+			default:
+				return sendTooManyRequests(c, time.Second)
+			}
+		}
+	}
+}
+
+// rateLimitSubjects はリクエストの認証済み識別子・リポジトリ・クライアントIPを制限の対象として返す
+// 取得できない対象は含めない
+func rateLimitSubjects(c echo.Context) []domain.RateLimitSubject {
+	subjects := make([]domain.RateLimitSubject, 0, 3)
+	if userInfo, ok := c.Get(UserInfoContextKey).(*domain.UserInfo); ok && userInfo.Sub() != "" {
+		subjects = append(subjects, domain.RateLimitSubject{Dimension: domain.RateLimitDimensionIdentity, Value: userInfo.Sub()})
+	}
+	if repo, err := common.ExtractRepositoryIdentifier(c); err == nil {
+		// リポジトリ名は大文字小文字を区別せずに認可しているため、制限も同じ単位で共有する
+		subjects = append(subjects, domain.RateLimitSubject{Dimension: domain.RateLimitDimensionRepository, Value: strings.ToLower(repo.FullName())})
+	}
+	if ip := c.RealIP(); ip != "" {
+		subjects = append(subjects, domain.RateLimitSubject{Dimension: domain.RateLimitDimensionClientIP, Value: ip})
+	}
+	return subjects
+}
+
+// sendTooManyRequests はRetry-Afterヘッダーを付けてLFS形式の429レスポンスを返す
+// Retry-Afterは秒単位のため切り上げ、最低でも1秒とする
+func sendTooManyRequests(c echo.Context, retryAfter time.Duration) error {
+	seconds := max(int64(math.Ceil(retryAfter.Seconds())), 1)
+	c.Response().Header().Set("Retry-After", strconv.FormatInt(seconds, 10))
+	return response.SendLFSError(c, http.StatusTooManyRequests, tooManyRequestsMessage)
+}
+
+// rateLimitCountingReader はプロキシアップロードで読み込んだバイト数を数える
+type rateLimitCountingReader struct {
+	io.ReadCloser
+	count int64
+}
+
+func (r *rateLimitCountingReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	r.count += int64(n)
+	return n, err
+}
@@ -0,0 +1,275 @@
+package middleware_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/labstack/echo/v4"
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/na2na-p/cargohold/internal/handler/middleware"
+	"github.com/na2na-p/cargohold/internal/handler/response"
+	mock_middleware "github.com/na2na-p/cargohold/tests/handler/middleware"
+	"go.uber.org/mock/gomock"
+)
+
+func newRateLimitContext(t *testing.T, method string, body io.Reader) (echo.Context, *httptest.ResponseRecorder) {
+	t.Helper()
+	e := echo.New()
+	req := httptest.NewRequest(method, "/Owner/Repo/info/lfs/objects/batch", body)
+	req.RemoteAddr = "192.0.2.1:12345"
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("owner", "repo")
+	c.SetParamValues("Owner", "Repo")
+	c.Set(middleware.UserInfoContextKey, mustNewUserInfo(t, "user-1", "", "user", domain.ProviderTypeGitHub, mustParseRepo(t, "Owner/Repo"), ""))
+	return c, rec
+}
+
+func TestBatchRateLimit(t *testing.T) {
+	wantSubjects := []domain.RateLimitSubject{
+		{Dimension: domain.RateLimitDimensionIdentity, Value: "user-1"},
+		{Dimension: domain.RateLimitDimensionRepository, Value: "owner/repo"},
+		{Dimension: domain.RateLimitDimensionClientIP, Value: "192.0.2.1"},
+	}
+
+	tests := []struct {
+		name           string
+		setupMock      func(mock *mock_middleware.MockRateLimiterInterface)
+		wantStatusCode int
+		wantRetryAfter string
+		wantNextCalled bool
+	}{
+		{
+			name: "正常系: 許可された場合、次のハンドラーが呼ばれる",
+			setupMock: func(mock *mock_middleware.MockRateLimiterInterface) {
+				mock.EXPECT().AllowBatch(gomock.Any(), wantSubjects).Return(domain.RateLimitDecision{Allowed: true}, nil)
+			},
+			wantStatusCode: http.StatusOK,
+			wantNextCalled: true,
+		},
+		{
+			name: "異常系: 拒否された場合、Retry-After付きの429が返る",
+			setupMock: func(mock *mock_middleware.MockRateLimiterInterface) {
+				mock.EXPECT().AllowBatch(gomock.Any(), wantSubjects).Return(domain.RateLimitDecision{Allowed: false, RetryAfter: 1500 * time.Millisecond}, nil)
+			},
+			wantStatusCode: http.StatusTooManyRequests,
+			wantRetryAfter: "2",
+		},
+		{
+			name: "正常系: 判定に失敗した場合、リクエストは許可される",
+			setupMock: func(mock *mock_middleware.MockRateLimiterInterface) {
+				mock.EXPECT().AllowBatch(gomock.Any(), gomock.Any()).Return(domain.RateLimitDecision{}, errors.New("redis error"))
+			},
+			wantStatusCode: http.StatusOK,
+			wantNextCalled: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			limiter := mock_middleware.NewMockRateLimiterInterface(ctrl)
+			tt.setupMock(limiter)
+
+			c, rec := newRateLimitContext(t, http.MethodPost, nil)
+			nextCalled := false
+			err := middleware.BatchRateLimit(limiter)(func(c echo.Context) error {
+				nextCalled = true
+				return c.NoContent(http.StatusOK)
+			})(c)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if rec.Code != tt.wantStatusCode {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatusCode)
+			}
+			if got := rec.Header().Get("Retry-After"); got != tt.wantRetryAfter {
+				t.Errorf("Retry-After = %q, want %q", got, tt.wantRetryAfter)
+			}
+			if nextCalled != tt.wantNextCalled {
+				t.Errorf("next called = %t, want %t", nextCalled, tt.wantNextCalled)
+			}
+			if tt.wantStatusCode == http.StatusTooManyRequests {
+				if got := rec.Header().Get(echo.HeaderContentType); got != response.GitLFSContentType {
+					t.Errorf("Content-Type = %q, want %q", got, response.GitLFSContentType)
+				}
+			}
+		})
+	}
+}
+
+func TestProxyRateLimit(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         string
+		body           string
+		handler        echo.HandlerFunc
+		setupMock      func(mock *mock_middleware.MockRateLimiterInterface)
+		wantStatusCode int
+		wantRetryAfter string
+	}{
+		{
+			name:   "正常系: アップロードで読み込んだバイト数が消費される",
+			method: http.MethodPut,
+			body:   "0123456789",
+			handler: func(c echo.Context) error {
+				if _, err := io.Copy(io.Discard, c.Request().Body); err != nil {
+					return err
+				}
+				return c.NoContent(http.StatusOK)
+			},
+			setupMock: func(mock *mock_middleware.MockRateLimiterInterface) {
+				mock.EXPECT().AllowProxyTransfer(gomock.Any(), gomock.Any(), int64(10)).Return(domain.RateLimitDecision{Allowed: true}, nil)
+				mock.EXPECT().RecordProxyTransfer(gomock.Any(), gomock.Any(), int64(10), int64(10)).Return(nil)
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:   "正常系: アップロードのContent-Lengthが上限を超える場合、読み込まずに429が返る",
+			method: http.MethodPut,
+			body:   "0123456789",
+			handler: func(c echo.Context) error {
+				t.Error("next handler must not be called")
+				return nil
+			},
+			setupMock: func(mock *mock_middleware.MockRateLimiterInterface) {
+				mock.EXPECT().AllowProxyTransfer(gomock.Any(), gomock.Any(), int64(10)).Return(domain.RateLimitDecision{Allowed: false, RetryAfter: 5 * time.Second}, nil)
+			},
+			wantStatusCode: http.StatusTooManyRequests,
+			wantRetryAfter: "5",
+		},
+		{
+			name:   "正常系: ダウンロードでハンドラーが予約したサイズが前払いされ、転送後に精算される",
+			method: http.MethodGet,
+			handler: func(c echo.Context) error {
+				if allowed, err := middleware.ReserveProxyTransfer(c, int64(len("download-body"))); !allowed {
+					return err
+				}
+				return c.Blob(http.StatusOK, "application/octet-stream", []byte("download-body"))
+			},
+			setupMock: func(mock *mock_middleware.MockRateLimiterInterface) {
+				gomock.InOrder(
+					mock.EXPECT().AllowProxyTransfer(gomock.Any(), gomock.Any(), int64(0)).Return(domain.RateLimitDecision{Allowed: true}, nil),
+					mock.EXPECT().AllowProxyTransfer(gomock.Any(), gomock.Any(), int64(len("download-body"))).Return(domain.RateLimitDecision{Allowed: true}, nil),
+					mock.EXPECT().RecordProxyTransfer(gomock.Any(), gomock.Any(), int64(len("download-body")), int64(len("download-body"))).Return(nil),
+				)
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:   "異常系: ダウンロードでハンドラーの予約が拒否された場合、Retry-After付きの429が返る",
+			method: http.MethodGet,
+			handler: func(c echo.Context) error {
+				if allowed, err := middleware.ReserveProxyTransfer(c, int64(len("download-body"))); !allowed {
+					return err
+				}
+				t.Error("download must not be streamed")
+				return nil
+			},
+			setupMock: func(mock *mock_middleware.MockRateLimiterInterface) {
+				mock.EXPECT().AllowProxyTransfer(gomock.Any(), gomock.Any(), int64(0)).Return(domain.RateLimitDecision{Allowed: true}, nil)
+				mock.EXPECT().AllowProxyTransfer(gomock.Any(), gomock.Any(), int64(len("download-body"))).Return(domain.RateLimitDecision{Allowed: false, RetryAfter: 2 * time.Second}, nil)
+				mock.EXPECT().RecordProxyTransfer(gomock.Any(), gomock.Any(), int64(0), gomock.Any()).Return(nil)
+			},
+			wantStatusCode: http.StatusTooManyRequests,
+			wantRetryAfter: "2",
+		},
+		{
+			name:   "正常系: ダウンロードで書き込んだバイト数が消費される",
+			method: http.MethodGet,
+			handler: func(c echo.Context) error {
+				return c.Blob(http.StatusOK, "application/octet-stream", []byte("download-body"))
+			},
+			setupMock: func(mock *mock_middleware.MockRateLimiterInterface) {
+				mock.EXPECT().AllowProxyTransfer(gomock.Any(), gomock.Any(), int64(0)).Return(domain.RateLimitDecision{Allowed: true}, nil)
+				mock.EXPECT().RecordProxyTransfer(gomock.Any(), gomock.Any(), int64(0), int64(len("download-body"))).Return(nil)
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:   "異常系: 拒否された場合、転送せずにRetry-After付きの429が返る",
+			method: http.MethodGet,
+			handler: func(c echo.Context) error {
+				t.Error("next handler must not be called")
+				return nil
+			},
+			setupMock: func(mock *mock_middleware.MockRateLimiterInterface) {
+				mock.EXPECT().AllowProxyTransfer(gomock.Any(), gomock.Any(), int64(0)).Return(domain.RateLimitDecision{Allowed: false, RetryAfter: 30 * time.Second}, nil)
+			},
+			wantStatusCode: http.StatusTooManyRequests,
+			wantRetryAfter: "30",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			limiter := mock_middleware.NewMockRateLimiterInterface(ctrl)
+			tt.setupMock(limiter)
+
+			c, rec := newRateLimitContext(t, tt.method, strings.NewReader(tt.body))
+			if err := middleware.ProxyRateLimit(limiter)(tt.handler)(c); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if rec.Code != tt.wantStatusCode {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatusCode)
+			}
+			if got := rec.Header().Get("Retry-After"); got != tt.wantRetryAfter {
+				t.Errorf("Retry-After = %q, want %q", got, tt.wantRetryAfter)
+			}
+		})
+	}
+}
+
+func TestProxyConcurrencyLimit(t *testing.T) {
+	t.Run("正常系: 上限を超える同時転送は429で拒否され、転送が終わると再び許可される", func(t *testing.T) {
+		limit := middleware.ProxyConcurrencyLimit(1)
+		started := make(chan struct{})
+		release := make(chan struct{})
+		blocking := limit(func(c echo.Context) error {
+			close(started)
+			<-release
+			return c.NoContent(http.StatusOK)
+		})
+		immediate := limit(func(c echo.Context) error {
+			return c.NoContent(http.StatusOK)
+		})
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c, _ := newRateLimitContext(t, http.MethodGet, nil)
+			_ = blocking(c)
+		}()
+		<-started
+
+		c, rec := newRateLimitContext(t, http.MethodGet, nil)
+		if err := immediate(c); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if diff := cmp.Diff([]any{http.StatusTooManyRequests, "1"}, []any{rec.Code, rec.Header().Get("Retry-After")}); diff != "" {
+			t.Errorf("rejected response mismatch (-want +got):\n%s", diff)
+		}
+
+		close(release)
+		wg.Wait()
+
+		c, rec = newRateLimitContext(t, http.MethodGet, nil)
+		if err := immediate(c); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if rec.Code != http.StatusOK {
+			t.Errorf("status = %d, want %d", rec.Code, http.StatusOK)
+		}
+	})
+}
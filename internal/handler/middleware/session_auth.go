@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/na2na-p/cargohold/internal/handler/common"
+)
+
+// SessionAuth はGitHub OAuthで作成したセッションでリクエストを認証する
+// リポジトリのパスを含まないエンドポイント向けのため、URLとセッションのリポジトリは照合しない
+// 複数のリポジトリを対象とするセッションのユーザー情報は、リポジトリを持たない
+// セッションは "Basic x-session:<id>" またはセッションCookieで受け付ける
+func SessionAuth(authUC AuthUseCaseInterface) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			ctx := c.Request().Context()
+
+			method := AuthMethodSessionBasic
+			sessionID := ""
+			if username, password, ok := parseBasicCredentials(c.Request().Header.Get("Authorization")); ok && username == basicUsernameSession {
+				sessionID = password
+			} else if cookie, err := c.Cookie(common.LFSSessionCookieName); err == nil {
+				method = AuthMethodSessionCookie
+				sessionID = cookie.Value
+			}
+
+			if sessionID == "" {
+				recordAuthAttempt(c, AuthMethodNone, AuthOutcomeFailure)
+				return NewAppError(http.StatusUnauthorized, "Unauthorized", nil)
+			}
+
+			userInfo, err := authUC.AuthenticateSession(ctx, sessionID, nil)
+			if err != nil {
+				recordAuthAttempt(c, method, AuthOutcomeFailure)
+				return NewAppError(http.StatusUnauthorized, "Unauthorized", err)
+			}
+
+			recordAuthAttempt(c, method, AuthOutcomeSuccess)
+			c.Set(UserInfoContextKey, userInfo)
+			c.Set(SessionIDContextKey, sessionID)
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package middleware_test
+
+import (
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/na2na-p/cargohold/internal/handler/middleware"
+	mock_middleware "github.com/na2na-p/cargohold/tests/handler/middleware"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSessionAuth(t *testing.T) {
+	tests := []struct {
+		name           string
+		headers        map[string]string
+		cookie         *http.Cookie
+		setupMock      func(t *testing.T, ctrl *gomock.Controller) *mock_middleware.MockAuthUseCaseInterface
+		wantStatusCode int
+		wantNextCalled bool
+		wantSessionID  string
+	}{
+		{
+			name:    "正常系: Basic認証のセッションが有効な場合、nextが呼ばれる",
+			headers: map[string]string{"Authorization": "Basic " + base64.StdEncoding.EncodeToString([]byte("x-session:session-id"))},
+			setupMock: func(t *testing.T, ctrl *gomock.Controller) *mock_middleware.MockAuthUseCaseInterface {
+				mock := mock_middleware.NewMockAuthUseCaseInterface(ctrl)
+				mock.EXPECT().AuthenticateSession(gomock.Any(), "session-id", nil).
+					Return(mustNewUserInfo(t, "12345", "", "user", domain.ProviderTypeGitHub, mustParseRepo(t, "owner/repo"), ""), nil)
+				return mock
+			},
+			wantStatusCode: http.StatusOK,
+			wantNextCalled: true,
+			wantSessionID:  "session-id",
+		},
+		{
+			name:   "正常系: Cookieのセッションが有効な場合、nextが呼ばれる",
+			cookie: &http.Cookie{Name: "lfs_session", Value: "session-id"},
+			setupMock: func(t *testing.T, ctrl *gomock.Controller) *mock_middleware.MockAuthUseCaseInterface {
+				mock := mock_middleware.NewMockAuthUseCaseInterface(ctrl)
+				mock.EXPECT().AuthenticateSession(gomock.Any(), "session-id", nil).
+					Return(mustNewUserInfo(t, "12345", "", "user", domain.ProviderTypeGitHub, mustParseRepo(t, "owner/repo"), ""), nil)
+				return mock
+			},
+			wantStatusCode: http.StatusOK,
+			wantNextCalled: true,
+			wantSessionID:  "session-id",
+		},
+		{
+			name:   "異常系: セッションが無効な場合、401が返る",
+			cookie: &http.Cookie{Name: "lfs_session", Value: "expired"},
+			setupMock: func(t *testing.T, ctrl *gomock.Controller) *mock_middleware.MockAuthUseCaseInterface {
+				mock := mock_middleware.NewMockAuthUseCaseInterface(ctrl)
+				mock.EXPECT().AuthenticateSession(gomock.Any(), "expired", nil).Return(nil, errors.New("session not found"))
+				return mock
+			},
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:    "異常系: アクセストークンの場合、検証せずに401が返る",
+			headers: map[string]string{"Authorization": "Bearer cht_secret"},
+			setupMock: func(t *testing.T, ctrl *gomock.Controller) *mock_middleware.MockAuthUseCaseInterface {
+				return mock_middleware.NewMockAuthUseCaseInterface(ctrl)
+			},
+			wantStatusCode: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			e := echo.New()
+			e.HTTPErrorHandler = middleware.CustomHTTPErrorHandler
+			req := httptest.NewRequest(http.MethodGet, "/auth/tokens", nil)
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+			if tt.cookie != nil {
+				req.AddCookie(tt.cookie)
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			nextCalled := false
+			gotSessionID := ""
+			next := func(c echo.Context) error {
+				nextCalled = true
+				gotSessionID, _ = c.Get(middleware.SessionIDContextKey).(string)
+				return c.NoContent(http.StatusOK)
+			}
+
+			if err := middleware.SessionAuth(tt.setupMock(t, ctrl))(next)(c); err != nil {
+				e.HTTPErrorHandler(err, c)
+			}
+
+			if rec.Code != tt.wantStatusCode {
+				t.Errorf("SessionAuth() status code = %v, want %v", rec.Code, tt.wantStatusCode)
+			}
+			if nextCalled != tt.wantNextCalled {
+				t.Errorf("SessionAuth() nextCalled = %v, want %v", nextCalled, tt.wantNextCalled)
+			}
+			if gotSessionID != tt.wantSessionID {
+				t.Errorf("SessionAuth() session id = %v, want %v", gotSessionID, tt.wantSessionID)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/na2na-p/cargohold/internal/handler/middleware"
+	"github.com/na2na-p/cargohold/internal/infrastructure/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracing(t *testing.T) {
+	const traceparent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+	tests := []struct {
+		name           string
+		route          string
+		traceparent    string
+		handler        echo.HandlerFunc
+		wantName       string
+		wantStatusCode int64
+		wantStatus     codes.Code
+		wantTraceID    string
+	}{
+		{
+			name:        "正常系: traceparentヘッダーの親スパンを引き継いでサーバースパンが記録される",
+			route:       "/:owner/:repo/info/lfs/objects/batch",
+			traceparent: traceparent,
+			handler: func(c echo.Context) error {
+				return c.NoContent(http.StatusOK)
+			},
+			wantName:       "POST /:owner/:repo/info/lfs/objects/batch",
+			wantStatusCode: http.StatusOK,
+			wantStatus:     codes.Unset,
+			wantTraceID:    "4bf92f3577b34da6a3ce929d0e0e4736",
+		},
+		{
+			name:  "正常系: 4xxのエラーはスパンのエラーとして扱われない",
+			route: "/:owner/:repo/info/lfs/objects/:oid",
+			handler: func(c echo.Context) error {
+				return middleware.NewAppError(http.StatusNotFound, "オブジェクトが見つかりません", nil)
+			},
+			wantName:       "POST /:owner/:repo/info/lfs/objects/:oid",
+			wantStatusCode: http.StatusNotFound,
+			wantStatus:     codes.Unset,
+		},
+		{
+			name: "異常系: 5xxのエラーはスパンのエラーとして記録される",
+			handler: func(c echo.Context) error {
+				return middleware.NewAppError(http.StatusInternalServerError, "内部エラー", nil)
+			},
+			wantName:       "POST unmatched",
+			wantStatusCode: http.StatusInternalServerError,
+			wantStatus:     codes.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exporter := tracetest.NewInMemoryExporter()
+			tp := tracing.NewTracerProvider(sdktrace.NewSimpleSpanProcessor(exporter), "cargohold", 1.0)
+			prevTP, prevPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+			otel.SetTracerProvider(tp)
+			tracing.SetupPropagator()
+			t.Cleanup(func() {
+				otel.SetTracerProvider(prevTP)
+				otel.SetTextMapPropagator(prevPropagator)
+			})
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/owner/repo/info/lfs/objects/batch", nil)
+			if tt.traceparent != "" {
+				req.Header.Set("traceparent", tt.traceparent)
+			}
+			c := e.NewContext(req, httptest.NewRecorder())
+			c.SetPath(tt.route)
+
+			var handlerSpanContext trace.SpanContext
+			_ = middleware.Tracing()(func(c echo.Context) error {
+				handlerSpanContext = trace.SpanContextFromContext(c.Request().Context())
+				return tt.handler(c)
+			})(c)
+
+			spans := exporter.GetSpans()
+			if len(spans) != 1 {
+				t.Fatalf("expected 1 span, got %d", len(spans))
+			}
+			span := spans[0]
+			if span.Name != tt.wantName {
+				t.Errorf("span name = %q, want %q", span.Name, tt.wantName)
+			}
+			if span.SpanKind != trace.SpanKindServer {
+				t.Errorf("span kind = %v, want %v", span.SpanKind, trace.SpanKindServer)
+			}
+			if span.Status.Code != tt.wantStatus {
+				t.Errorf("span status = %v, want %v", span.Status.Code, tt.wantStatus)
+			}
+			if handlerSpanContext.SpanID() != span.SpanContext.SpanID() {
+				t.Errorf("handler span id = %s, want %s", handlerSpanContext.SpanID(), span.SpanContext.SpanID())
+			}
+			if tt.wantTraceID != "" && span.SpanContext.TraceID().String() != tt.wantTraceID {
+				t.Errorf("trace id = %s, want %s", span.SpanContext.TraceID(), tt.wantTraceID)
+			}
+
+			var gotStatusCode int64
+			for _, attr := range span.Attributes {
+				if attr.Key == "http.response.status_code" {
+					gotStatusCode = attr.Value.AsInt64()
+				}
+			}
+			if gotStatusCode != tt.wantStatusCode {
+				t.Errorf("http.response.status_code = %d, want %d", gotStatusCode, tt.wantStatusCode)
+			}
+		})
+	}
+}
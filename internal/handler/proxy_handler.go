@@ -3,12 +3,14 @@ package handler
 import (
 	"context"
 	"errors"
+	"io"
 	"net/http"
 	"strconv"
 	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/na2na-p/cargohold/internal/handler/middleware"
 	"github.com/na2na-p/cargohold/internal/usecase"
 )
 
@@ -32,6 +34,11 @@ func (h *ProxyHandler) HandleUpload(c echo.Context) error {
 	owner := c.Param("owner")
 	repo := c.Param("repo")
 	oidStr := c.Param("oid")
+	middleware.SetAuditAction(c, domain.AuditActionUpload)
+	middleware.SetAuditObjects(c, middleware.AuditObject{OID: oidStr, Size: max(c.Request().ContentLength, 0)})
+	if err := checkPermissions(c, "upload"); err != nil {
+		return err
+	}
 	oid, err := domain.NewOID(oidStr)
 	if err != nil {
 		return SendLFSError(c, http.StatusUnprocessableEntity, "不正なOIDです")
@@ -40,7 +47,10 @@ func (h *ProxyHandler) HandleUpload(c echo.Context) error {
 	ctx, cancel := context.WithTimeout(c.Request().Context(), h.proxyTimeout)
 	defer cancel()
 
-	if err := h.proxyUploadUseCase.Execute(ctx, owner, repo, oid, c.Request().Body); err != nil {
+	body := &countingReader{reader: c.Request().Body}
+	err = h.proxyUploadUseCase.Execute(ctx, owner, repo, oid, body)
+	middleware.RecordProxiedBytes(c, middleware.ProxyDirectionUpload, body.count)
+	if err != nil {
 		return h.handleProxyError(c, err)
 	}
 
@@ -51,6 +61,11 @@ func (h *ProxyHandler) HandleDownload(c echo.Context) error {
 	owner := c.Param("owner")
 	repo := c.Param("repo")
 	oidStr := c.Param("oid")
+	middleware.SetAuditAction(c, domain.AuditActionDownload)
+	middleware.SetAuditObjects(c, middleware.AuditObject{OID: oidStr})
+	if err := checkPermissions(c, "download"); err != nil {
+		return err
+	}
 	oid, err := domain.NewOID(oidStr)
 	if err != nil {
 		return SendLFSError(c, http.StatusUnprocessableEntity, "不正なOIDです")
@@ -64,11 +79,17 @@ func (h *ProxyHandler) HandleDownload(c echo.Context) error {
 		return h.handleProxyError(c, err)
 	}
 	defer func() { _ = stream.Close() }()
+	middleware.SetAuditObjects(c, middleware.AuditObject{OID: oidStr, Size: size})
+	if allowed, err := middleware.ReserveProxyTransfer(c, size); !allowed {
+		return err
+	}
 
 	c.Response().Header().Set(echo.HeaderContentLength, strconv.FormatInt(size, 10))
 	c.Response().Header().Set(echo.HeaderContentType, "application/octet-stream")
 
-	return c.Stream(http.StatusOK, "application/octet-stream", stream)
+	err = c.Stream(http.StatusOK, "application/octet-stream", stream)
+	middleware.RecordProxiedBytes(c, middleware.ProxyDirectionDownload, c.Response().Size)
+	return err
 }
 
 func (h *ProxyHandler) handleProxyError(c echo.Context, err error) error {
@@ -80,6 +101,14 @@ func (h *ProxyHandler) handleProxyError(c echo.Context, err error) error {
 		return SendLFSError(c, http.StatusNotFound, "オブジェクトが存在しません")
 	}
 
+	if errors.Is(err, usecase.ErrSizeMismatch) {
+		return SendLFSError(c, http.StatusUnprocessableEntity, "受信したデータのサイズが宣言されたサイズと一致しません")
+	}
+
+	if errors.Is(err, usecase.ErrHashMismatch) {
+		return SendLFSError(c, http.StatusUnprocessableEntity, "受信したデータのハッシュがOIDと一致しません")
+	}
+
 	if errors.Is(err, usecase.ErrNotUploaded) {
 		return SendLFSError(c, http.StatusNotFound, "オブジェクトがまだアップロードされていません")
 	}
@@ -98,3 +127,15 @@ func (h *ProxyHandler) handleProxyError(c echo.Context, err error) error {
 func (h *ProxyHandler) isStorageError(err error) bool {
 	return h.storageErrorChecker.IsStorageError(err)
 }
+
+// countingReader は読み込んだバイト数を数えるio.Reader
+type countingReader struct {
+	reader io.Reader
+	count  int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.count += int64(n)
+	return n, err
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -21,7 +22,22 @@ import (
 	"go.uber.org/mock/gomock"
 )
 
+func newProxyHandlerTestUserInfo(t *testing.T, perms domain.RepositoryPermissions) *domain.UserInfo {
+	t.Helper()
+	repo, err := domain.NewRepositoryIdentifier("testowner/testrepo")
+	if err != nil {
+		t.Fatalf("NewRepositoryIdentifier() failed: %v", err)
+	}
+	userInfo, err := domain.NewUserInfo("repo:testowner/testrepo:ref:refs/heads/main", "", "actor", domain.ProviderTypeGitHub, repo, "refs/heads/main")
+	if err != nil {
+		t.Fatalf("NewUserInfo() failed: %v", err)
+	}
+	userInfo.SetPermissions(&perms)
+	return userInfo
+}
+
 func TestProxyHandler_HandleUpload(t *testing.T) {
+	readOnlyPermissions := domain.NewRepositoryPermissions(false, false, true, false, false)
 	type fields struct {
 		setupUploadMock              func(ctrl *gomock.Controller) *mock_usecase.MockProxyUploadUseCase
 		setupDownloadMock            func(ctrl *gomock.Controller) *mock_usecase.MockProxyDownloadUseCase
@@ -41,6 +57,7 @@ func TestProxyHandler_HandleUpload(t *testing.T) {
 		name             string
 		fields           fields
 		args             args
+		permissions      *domain.RepositoryPermissions
 		wantStatusCode   int
 		wantBodyContains string
 	}{
@@ -132,6 +149,66 @@ func TestProxyHandler_HandleUpload(t *testing.T) {
 			},
 			wantStatusCode: http.StatusNotFound,
 		},
+		{
+			name: "異常系: サイズが一致しない場合、422エラーが返る",
+			fields: fields{
+				setupUploadMock: func(ctrl *gomock.Controller) *mock_usecase.MockProxyUploadUseCase {
+					m := mock_usecase.NewMockProxyUploadUseCase(ctrl)
+					m.EXPECT().Execute(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("%w: detail", usecase.ErrSizeMismatch))
+					return m
+				},
+				setupDownloadMock: func(ctrl *gomock.Controller) *mock_usecase.MockProxyDownloadUseCase {
+					return mock_usecase.NewMockProxyDownloadUseCase(ctrl)
+				},
+				setupStorageErrorCheckerMock: func(ctrl *gomock.Controller) *mock_usecase.MockStorageErrorChecker {
+					return mock_usecase.NewMockStorageErrorChecker(ctrl)
+				},
+				proxyTimeout: 10 * time.Minute,
+			},
+			args: args{
+				method: http.MethodPut,
+				path:   "/testowner/testrepo/info/lfs/objects/abcdef1234567890abcdef1234567890abcdef1234567890abcdef1234567890",
+				owner:  "testowner",
+				repo:   "testrepo",
+				oid:    "abcdef1234567890abcdef1234567890abcdef1234567890abcdef1234567890",
+				body:   "test file content",
+				headers: map[string]string{
+					"Accept":       "application/octet-stream",
+					"Content-Type": "application/octet-stream",
+				},
+			},
+			wantStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "異常系: ハッシュが一致しない場合、422エラーが返る",
+			fields: fields{
+				setupUploadMock: func(ctrl *gomock.Controller) *mock_usecase.MockProxyUploadUseCase {
+					m := mock_usecase.NewMockProxyUploadUseCase(ctrl)
+					m.EXPECT().Execute(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("%w: detail", usecase.ErrHashMismatch))
+					return m
+				},
+				setupDownloadMock: func(ctrl *gomock.Controller) *mock_usecase.MockProxyDownloadUseCase {
+					return mock_usecase.NewMockProxyDownloadUseCase(ctrl)
+				},
+				setupStorageErrorCheckerMock: func(ctrl *gomock.Controller) *mock_usecase.MockStorageErrorChecker {
+					return mock_usecase.NewMockStorageErrorChecker(ctrl)
+				},
+				proxyTimeout: 10 * time.Minute,
+			},
+			args: args{
+				method: http.MethodPut,
+				path:   "/testowner/testrepo/info/lfs/objects/abcdef1234567890abcdef1234567890abcdef1234567890abcdef1234567890",
+				owner:  "testowner",
+				repo:   "testrepo",
+				oid:    "abcdef1234567890abcdef1234567890abcdef1234567890abcdef1234567890",
+				body:   "test file content",
+				headers: map[string]string{
+					"Accept":       "application/octet-stream",
+					"Content-Type": "application/octet-stream",
+				},
+			},
+			wantStatusCode: http.StatusUnprocessableEntity,
+		},
 		{
 			name: "異常系: タイムアウトした場合、504エラーが返る",
 			fields: fields{
@@ -256,6 +333,35 @@ func TestProxyHandler_HandleUpload(t *testing.T) {
 			},
 			wantStatusCode: http.StatusForbidden,
 		},
+		{
+			name: "異常系: 読み取り権限のみの場合、403エラーが返りアップロードされない",
+			fields: fields{
+				setupUploadMock: func(ctrl *gomock.Controller) *mock_usecase.MockProxyUploadUseCase {
+					return mock_usecase.NewMockProxyUploadUseCase(ctrl)
+				},
+				setupDownloadMock: func(ctrl *gomock.Controller) *mock_usecase.MockProxyDownloadUseCase {
+					return mock_usecase.NewMockProxyDownloadUseCase(ctrl)
+				},
+				setupStorageErrorCheckerMock: func(ctrl *gomock.Controller) *mock_usecase.MockStorageErrorChecker {
+					return mock_usecase.NewMockStorageErrorChecker(ctrl)
+				},
+				proxyTimeout: 10 * time.Minute,
+			},
+			args: args{
+				method: http.MethodPut,
+				path:   "/testowner/testrepo/info/lfs/objects/abcdef1234567890abcdef1234567890abcdef1234567890abcdef1234567890",
+				owner:  "testowner",
+				repo:   "testrepo",
+				oid:    "abcdef1234567890abcdef1234567890abcdef1234567890abcdef1234567890",
+				body:   "test file content",
+				headers: map[string]string{
+					"Accept":       "application/octet-stream",
+					"Content-Type": "application/octet-stream",
+				},
+			},
+			permissions:    &readOnlyPermissions,
+			wantStatusCode: http.StatusForbidden,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -272,6 +378,11 @@ func TestProxyHandler_HandleUpload(t *testing.T) {
 			c := e.NewContext(req, rec)
 			c.SetParamNames("owner", "repo", "oid")
 			c.SetParamValues(tt.args.owner, tt.args.repo, tt.args.oid)
+			perms := domain.NewRepositoryPermissions(true, true, true, true, true)
+			if tt.permissions != nil {
+				perms = *tt.permissions
+			}
+			c.Set(middleware.UserInfoContextKey, newProxyHandlerTestUserInfo(t, perms))
 
 			mockUploadUC := tt.fields.setupUploadMock(ctrl)
 			mockDownloadUC := tt.fields.setupDownloadMock(ctrl)
@@ -290,6 +401,7 @@ func TestProxyHandler_HandleUpload(t *testing.T) {
 }
 
 func TestProxyHandler_HandleDownload(t *testing.T) {
+	noPermissions := domain.NewRepositoryPermissions(false, false, false, false, false)
 	type fields struct {
 		setupUploadMock              func(ctrl *gomock.Controller) *mock_usecase.MockProxyUploadUseCase
 		setupDownloadMock            func(ctrl *gomock.Controller) *mock_usecase.MockProxyDownloadUseCase
@@ -308,6 +420,7 @@ func TestProxyHandler_HandleDownload(t *testing.T) {
 		name              string
 		fields            fields
 		args              args
+		permissions       *domain.RepositoryPermissions
 		wantStatusCode    int
 		wantBody          string
 		wantContentLength string
@@ -545,6 +658,33 @@ func TestProxyHandler_HandleDownload(t *testing.T) {
 			},
 			wantStatusCode: http.StatusForbidden,
 		},
+		{
+			name: "異常系: 権限がない場合、403エラーが返りダウンロードされない",
+			fields: fields{
+				setupUploadMock: func(ctrl *gomock.Controller) *mock_usecase.MockProxyUploadUseCase {
+					return mock_usecase.NewMockProxyUploadUseCase(ctrl)
+				},
+				setupDownloadMock: func(ctrl *gomock.Controller) *mock_usecase.MockProxyDownloadUseCase {
+					return mock_usecase.NewMockProxyDownloadUseCase(ctrl)
+				},
+				setupStorageErrorCheckerMock: func(ctrl *gomock.Controller) *mock_usecase.MockStorageErrorChecker {
+					return mock_usecase.NewMockStorageErrorChecker(ctrl)
+				},
+				proxyTimeout: 10 * time.Minute,
+			},
+			args: args{
+				method: http.MethodGet,
+				path:   "/testowner/testrepo/info/lfs/objects/abcdef1234567890abcdef1234567890abcdef1234567890abcdef1234567890",
+				owner:  "testowner",
+				repo:   "testrepo",
+				oid:    "abcdef1234567890abcdef1234567890abcdef1234567890abcdef1234567890",
+				headers: map[string]string{
+					"Accept": "application/octet-stream",
+				},
+			},
+			permissions:    &noPermissions,
+			wantStatusCode: http.StatusForbidden,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -561,6 +701,11 @@ func TestProxyHandler_HandleDownload(t *testing.T) {
 			c := e.NewContext(req, rec)
 			c.SetParamNames("owner", "repo", "oid")
 			c.SetParamValues(tt.args.owner, tt.args.repo, tt.args.oid)
+			perms := domain.NewRepositoryPermissions(true, true, true, true, true)
+			if tt.permissions != nil {
+				perms = *tt.permissions
+			}
+			c.Set(middleware.UserInfoContextKey, newProxyHandlerTestUserInfo(t, perms))
 
 			mockUploadUC := tt.fields.setupUploadMock(ctrl)
 			mockDownloadUC := tt.fields.setupDownloadMock(ctrl)
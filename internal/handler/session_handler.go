@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/na2na-p/cargohold/internal/handler/common"
+	"github.com/na2na-p/cargohold/internal/handler/dto"
+	"github.com/na2na-p/cargohold/internal/handler/middleware"
+	"github.com/na2na-p/cargohold/internal/usecase"
+)
+
+// SessionHandler はセッションで認証したユーザーが自身のセッションを管理するハンドラー
+type SessionHandler struct {
+	sessionUseCase usecase.SessionUseCase
+}
+
+func NewSessionHandler(sessionUseCase usecase.SessionUseCase) *SessionHandler {
+	return &SessionHandler{
+		sessionUseCase: sessionUseCase,
+	}
+}
+
+// Logout はリクエストの認証に使用したセッションを失効させ、セッションCookieを削除する
+func (h *SessionHandler) Logout(c echo.Context) error {
+	sessionID, _ := c.Get(middleware.SessionIDContextKey).(string)
+	if err := h.sessionUseCase.Logout(c.Request().Context(), sessionID); err != nil {
+		return handleSessionUseCaseError(err)
+	}
+
+	c.SetCookie(&http.Cookie{
+		Name:     common.LFSSessionCookieName,
+		Value:    "",
+		Path:     "/",
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteLaxMode,
+		MaxAge:   -1,
+	})
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *SessionHandler) List(c echo.Context) error {
+	userInfo, err := sessionUserInfo(c)
+	if err != nil {
+		return err
+	}
+
+	sessions, err := h.sessionUseCase.ListForUser(c.Request().Context(), userInfo)
+	if err != nil {
+		return handleSessionUseCaseError(err)
+	}
+	sessionID, _ := c.Get(middleware.SessionIDContextKey).(string)
+	return c.JSON(http.StatusOK, dto.NewSessionsResponseDTO(sessions, sessionID))
+}
+
+func handleSessionUseCaseError(err error) error {
+	if errors.Is(err, usecase.ErrSessionNotFound) {
+		return middleware.NewAppError(http.StatusUnauthorized, "認証情報が見つかりません", err)
+	}
+	return middleware.NewAppError(http.StatusInternalServerError, "サーバー内部エラーが発生しました", err)
+}
@@ -0,0 +1,157 @@
+package handler_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/na2na-p/cargohold/internal/handler"
+	"github.com/na2na-p/cargohold/internal/handler/middleware"
+	"github.com/na2na-p/cargohold/internal/usecase"
+	mock_usecase "github.com/na2na-p/cargohold/tests/usecase"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSessionHandler(t *testing.T) {
+	repo, err := domain.NewRepositoryIdentifier("owner/repo")
+	if err != nil {
+		t.Fatalf("NewRepositoryIdentifier() failed: %v", err)
+	}
+	userInfo, err := domain.NewUserInfo("12345", "", "user", domain.ProviderTypeGitHub, repo, "")
+	if err != nil {
+		t.Fatalf("NewUserInfo() failed: %v", err)
+	}
+	scope, err := domain.ParseRepositoryScope("owner/repo,org/*")
+	if err != nil {
+		t.Fatalf("ParseRepositoryScope() failed: %v", err)
+	}
+	scopedUserInfo, err := domain.NewUserInfo("12345", "", "user", domain.ProviderTypeGitHub, nil, "")
+	if err != nil {
+		t.Fatalf("NewUserInfo() failed: %v", err)
+	}
+	scopedUserInfo.SetRepositoryScope(scope)
+	expiresAt := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		sessionUseCase func(ctrl *gomock.Controller) usecase.SessionUseCase
+		userInfo       *domain.UserInfo
+		method         string
+		target         string
+		wantStatusCode int
+		wantBodyJSON   map[string]any
+		wantCookie     bool
+	}{
+		{
+			name: "正常系: ログアウトでセッションが失効され、Cookieが削除される",
+			sessionUseCase: func(ctrl *gomock.Controller) usecase.SessionUseCase {
+				m := mock_usecase.NewMockSessionUseCase(ctrl)
+				m.EXPECT().Logout(gomock.Any(), "current-session").Return(nil)
+				return m
+			},
+			userInfo:       userInfo,
+			method:         http.MethodPost,
+			target:         "/auth/logout",
+			wantStatusCode: http.StatusNoContent,
+			wantCookie:     true,
+		},
+		{
+			name: "異常系: セッションの削除に失敗した場合、500が返る",
+			sessionUseCase: func(ctrl *gomock.Controller) usecase.SessionUseCase {
+				m := mock_usecase.NewMockSessionUseCase(ctrl)
+				m.EXPECT().Logout(gomock.Any(), "current-session").Return(errors.New("redis error"))
+				return m
+			},
+			userInfo:       userInfo,
+			method:         http.MethodPost,
+			target:         "/auth/logout",
+			wantStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name: "正常系: ユーザーのセッション一覧が返り、現在のセッションが示される",
+			sessionUseCase: func(ctrl *gomock.Controller) usecase.SessionUseCase {
+				m := mock_usecase.NewMockSessionUseCase(ctrl)
+				m.EXPECT().ListForUser(gomock.Any(), userInfo).Return([]*usecase.UserSession{
+					{ID: "current-session", UserInfo: userInfo, ExpiresAt: expiresAt},
+					{ID: "other-session", UserInfo: userInfo, ExpiresAt: expiresAt.Add(time.Hour)},
+				}, nil)
+				return m
+			},
+			userInfo:       userInfo,
+			method:         http.MethodGet,
+			target:         "/auth/sessions",
+			wantStatusCode: http.StatusOK,
+			wantBodyJSON: map[string]any{"sessions": []any{
+				map[string]any{"repository": "owner/repo", "expires_at": "2026-01-02T00:00:00Z", "current": true},
+				map[string]any{"repository": "owner/repo", "expires_at": "2026-01-02T01:00:00Z", "current": false},
+			}},
+		},
+		{
+			name: "正常系: 複数のリポジトリを対象とするセッションはスコープが返る",
+			sessionUseCase: func(ctrl *gomock.Controller) usecase.SessionUseCase {
+				m := mock_usecase.NewMockSessionUseCase(ctrl)
+				m.EXPECT().ListForUser(gomock.Any(), userInfo).Return([]*usecase.UserSession{
+					{ID: "other-session", UserInfo: scopedUserInfo, ExpiresAt: expiresAt},
+				}, nil)
+				return m
+			},
+			userInfo:       userInfo,
+			method:         http.MethodGet,
+			target:         "/auth/sessions",
+			wantStatusCode: http.StatusOK,
+			wantBodyJSON: map[string]any{"sessions": []any{
+				map[string]any{"repositories": []any{"owner/repo", "org/*"}, "expires_at": "2026-01-02T00:00:00Z", "current": false},
+			}},
+		},
+		{
+			name: "異常系: 認証情報がない場合、401が返る",
+			sessionUseCase: func(ctrl *gomock.Controller) usecase.SessionUseCase {
+				return mock_usecase.NewMockSessionUseCase(ctrl)
+			},
+			method:         http.MethodGet,
+			target:         "/auth/sessions",
+			wantStatusCode: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			h := handler.NewSessionHandler(tt.sessionUseCase(ctrl))
+
+			e := echo.New()
+			e.HTTPErrorHandler = middleware.CustomHTTPErrorHandler
+			group := e.Group("/auth")
+			group.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+				return func(c echo.Context) error {
+					if tt.userInfo != nil {
+						c.Set(middleware.UserInfoContextKey, tt.userInfo)
+						c.Set(middleware.SessionIDContextKey, "current-session")
+					}
+					return next(c)
+				}
+			})
+			group.POST("/logout", h.Logout)
+			group.GET("/sessions", h.List)
+
+			req := httptest.NewRequest(tt.method, tt.target, nil)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assertAdminResponse(t, rec, tt.wantStatusCode, tt.wantBodyJSON)
+			cleared := false
+			for _, cookie := range rec.Result().Cookies() {
+				if cookie.Name == "lfs_session" && cookie.MaxAge < 0 {
+					cleared = true
+				}
+			}
+			if cleared != tt.wantCookie {
+				t.Errorf("session cookie cleared = %v, want %v", cleared, tt.wantCookie)
+			}
+		})
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/na2na-p/cargohold/internal/handler/middleware"
 	"github.com/na2na-p/cargohold/internal/usecase"
 )
 
@@ -16,21 +18,28 @@ type VerifyResponse struct {
 func VerifyHandler(uc usecase.VerifyUseCaseInterface) echo.HandlerFunc {
 	return func(c echo.Context) error {
 		ctx := c.Request().Context()
+		middleware.SetAuditAction(c, domain.AuditActionVerify)
 
 		if err := ValidateLFSHeaders(c); err != nil {
 			return SendLFSError(c, http.StatusBadRequest, err.Error())
 		}
 
-		_, err := ExtractRepositoryIdentifier(c)
+		repository, err := ExtractRepositoryIdentifier(c)
 		if err != nil {
 			return SendLFSError(c, http.StatusBadRequest, "リポジトリ識別子の形式が不正です")
 		}
 
+		if err := checkPermissions(c, "upload"); err != nil {
+			return err
+		}
+
 		var req usecase.VerifyRequest
 		if err := json.NewDecoder(c.Request().Body).Decode(&req); err != nil {
 			return SendLFSError(c, http.StatusBadRequest, "リクエストボディの解析に失敗しました")
 		}
 
+		middleware.SetAuditObjects(c, middleware.AuditObject{OID: req.OID, Size: req.Size})
+
 		if err := req.Validate(); err != nil {
 			switch {
 			case errors.Is(err, usecase.ErrInvalidOID):
@@ -42,12 +51,14 @@ func VerifyHandler(uc usecase.VerifyUseCaseInterface) echo.HandlerFunc {
 			}
 		}
 
-		if err := uc.VerifyUpload(ctx, req.OID, req.Size); err != nil {
+		if err := uc.VerifyUpload(ctx, repository, req.OID, req.Size); err != nil {
 			switch {
 			case errors.Is(err, usecase.ErrInvalidOID):
 				return SendLFSError(c, http.StatusBadRequest, "OID形式が不正です")
 			case errors.Is(err, usecase.ErrObjectNotFound):
 				return SendLFSError(c, http.StatusNotFound, "オブジェクトが見つかりません")
+			case errors.Is(err, usecase.ErrNotUploaded):
+				return SendLFSError(c, http.StatusNotFound, "オブジェクトがストレージに存在しません")
 			case errors.Is(err, usecase.ErrSizeMismatch):
 				return SendLFSError(c, http.StatusUnprocessableEntity, "サイズが一致しません")
 			default:
@@ -10,13 +10,16 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/labstack/echo/v4"
+	"github.com/na2na-p/cargohold/internal/domain"
 	"github.com/na2na-p/cargohold/internal/handler"
+	"github.com/na2na-p/cargohold/internal/handler/middleware"
 	"github.com/na2na-p/cargohold/internal/usecase"
 	mock_usecase "github.com/na2na-p/cargohold/tests/usecase"
 	"go.uber.org/mock/gomock"
 )
 
 func TestVerifyHandler(t *testing.T) {
+	readOnlyPermissions := domain.NewRepositoryPermissions(false, false, true, false, false)
 	type fields struct {
 		usecase func(ctrl *gomock.Controller) usecase.VerifyUseCaseInterface
 	}
@@ -31,6 +34,7 @@ func TestVerifyHandler(t *testing.T) {
 		name           string
 		fields         fields
 		args           args
+		permissions    *domain.RepositoryPermissions
 		wantStatusCode int
 		wantBodyJSON   map[string]interface{}
 	}{
@@ -39,7 +43,9 @@ func TestVerifyHandler(t *testing.T) {
 			fields: fields{
 				usecase: func(ctrl *gomock.Controller) usecase.VerifyUseCaseInterface {
 					mock := mock_usecase.NewMockVerifyUseCaseInterface(ctrl)
-					mock.EXPECT().VerifyUpload(gomock.Any(), "abc123def4567890abc123def4567890abc123def4567890abc123def4567890", int64(1024)).Return(nil).Times(1)
+					mock.EXPECT().VerifyUpload(gomock.Any(), gomock.Cond(func(repository *domain.RepositoryIdentifier) bool {
+						return repository.FullName() == "testowner/testrepo"
+					}), "abc123def4567890abc123def4567890abc123def4567890abc123def4567890", int64(1024)).Return(nil).Times(1)
 					return mock
 				},
 			},
@@ -58,6 +64,29 @@ func TestVerifyHandler(t *testing.T) {
 				"message": "success",
 			},
 		},
+		{
+			name: "異常系: アップロード権限がない場合は検証せずに403を返す",
+			fields: fields{
+				usecase: func(ctrl *gomock.Controller) usecase.VerifyUseCaseInterface {
+					return mock_usecase.NewMockVerifyUseCaseInterface(ctrl)
+				},
+			},
+			args: args{
+				body: map[string]interface{}{
+					"oid":  "abc123def4567890abc123def4567890abc123def4567890abc123def4567890",
+					"size": 1024,
+				},
+				contentType: "application/vnd.git-lfs+json",
+				accept:      "application/vnd.git-lfs+json",
+				owner:       "testowner",
+				repo:        "testrepo",
+			},
+			permissions:    &readOnlyPermissions,
+			wantStatusCode: http.StatusForbidden,
+			wantBodyJSON: map[string]interface{}{
+				"message": "このオペレーションを実行する権限がありません",
+			},
+		},
 		{
 			name: "異常系: Content-Typeヘッダーが不正",
 			fields: fields{
@@ -198,7 +227,7 @@ func TestVerifyHandler(t *testing.T) {
 			fields: fields{
 				usecase: func(ctrl *gomock.Controller) usecase.VerifyUseCaseInterface {
 					mock := mock_usecase.NewMockVerifyUseCaseInterface(ctrl)
-					mock.EXPECT().VerifyUpload(gomock.Any(), "abc123def4567890abc123def4567890abc123def4567890abc123def4567890", int64(1024)).Return(usecase.ErrObjectNotFound).Times(1)
+					mock.EXPECT().VerifyUpload(gomock.Any(), gomock.Any(), "abc123def4567890abc123def4567890abc123def4567890abc123def4567890", int64(1024)).Return(usecase.ErrObjectNotFound).Times(1)
 					return mock
 				},
 			},
@@ -222,7 +251,7 @@ func TestVerifyHandler(t *testing.T) {
 			fields: fields{
 				usecase: func(ctrl *gomock.Controller) usecase.VerifyUseCaseInterface {
 					mock := mock_usecase.NewMockVerifyUseCaseInterface(ctrl)
-					mock.EXPECT().VerifyUpload(gomock.Any(), "abc123def4567890abc123def4567890abc123def4567890abc123def4567890", int64(1024)).Return(usecase.ErrSizeMismatch).Times(1)
+					mock.EXPECT().VerifyUpload(gomock.Any(), gomock.Any(), "abc123def4567890abc123def4567890abc123def4567890abc123def4567890", int64(1024)).Return(usecase.ErrSizeMismatch).Times(1)
 					return mock
 				},
 			},
@@ -241,12 +270,36 @@ func TestVerifyHandler(t *testing.T) {
 				"message": "サイズが一致しません",
 			},
 		},
+		{
+			name: "異常系: ストレージにオブジェクトが存在しない",
+			fields: fields{
+				usecase: func(ctrl *gomock.Controller) usecase.VerifyUseCaseInterface {
+					mock := mock_usecase.NewMockVerifyUseCaseInterface(ctrl)
+					mock.EXPECT().VerifyUpload(gomock.Any(), gomock.Any(), "abc123def4567890abc123def4567890abc123def4567890abc123def4567890", int64(1024)).Return(usecase.ErrNotUploaded).Times(1)
+					return mock
+				},
+			},
+			args: args{
+				body: map[string]interface{}{
+					"oid":  "abc123def4567890abc123def4567890abc123def4567890abc123def4567890",
+					"size": 1024,
+				},
+				contentType: "application/vnd.git-lfs+json",
+				accept:      "application/vnd.git-lfs+json",
+				owner:       "testowner",
+				repo:        "testrepo",
+			},
+			wantStatusCode: http.StatusNotFound,
+			wantBodyJSON: map[string]interface{}{
+				"message": "オブジェクトがストレージに存在しません",
+			},
+		},
 		{
 			name: "異常系: サーバー内部エラー",
 			fields: fields{
 				usecase: func(ctrl *gomock.Controller) usecase.VerifyUseCaseInterface {
 					mock := mock_usecase.NewMockVerifyUseCaseInterface(ctrl)
-					mock.EXPECT().VerifyUpload(gomock.Any(), "abc123def4567890abc123def4567890abc123def4567890abc123def4567890", int64(1024)).Return(errors.New("internal error")).Times(1)
+					mock.EXPECT().VerifyUpload(gomock.Any(), gomock.Any(), "abc123def4567890abc123def4567890abc123def4567890abc123def4567890", int64(1024)).Return(errors.New("internal error")).Times(1)
 					return mock
 				},
 			},
@@ -272,6 +325,7 @@ func TestVerifyHandler(t *testing.T) {
 			ctrl := gomock.NewController(t)
 
 			e := echo.New()
+			e.HTTPErrorHandler = middleware.CustomHTTPErrorHandler
 
 			var reqBody *bytes.Buffer
 			if str, ok := tt.args.body.(string); ok {
@@ -288,10 +342,17 @@ func TestVerifyHandler(t *testing.T) {
 			c := e.NewContext(req, rec)
 			c.SetParamNames("owner", "repo")
 			c.SetParamValues(tt.args.owner, tt.args.repo)
+			perms := domain.NewRepositoryPermissions(false, true, true, false, false)
+			if tt.permissions != nil {
+				perms = *tt.permissions
+			}
+			c.Set(middleware.UserInfoContextKey, newProxyHandlerTestUserInfo(t, perms))
 
 			h := handler.VerifyHandler(tt.fields.usecase(ctrl))
 
-			_ = h(c)
+			if err := h(c); err != nil {
+				e.HTTPErrorHandler(err, c)
+			}
 
 			if diff := cmp.Diff(tt.wantStatusCode, rec.Code); diff != "" {
 				t.Errorf("ステータスコードが一致しません (-want +got):\n%s", diff)
@@ -0,0 +1,28 @@
+package infrastructure
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/na2na-p/cargohold/internal/usecase"
+)
+
+// accessTokenSecretBytes はアクセストークンに含める乱数のバイト数
+const accessTokenSecretBytes = 32
+
+type accessTokenSecretGenerator struct{}
+
+// NewAccessTokenSecretGenerator は暗号論的乱数から接頭辞付きのアクセストークンを生成するジェネレーターを作成する
+func NewAccessTokenSecretGenerator() usecase.AccessTokenSecretGenerator {
+	return accessTokenSecretGenerator{}
+}
+
+func (accessTokenSecretGenerator) Generate() (string, error) {
+	b := make([]byte, accessTokenSecretBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("アクセストークンの生成に失敗しました: %w", err)
+	}
+	return domain.AccessTokenPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
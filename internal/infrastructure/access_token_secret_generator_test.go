@@ -0,0 +1,34 @@
+package infrastructure_test
+
+import (
+	"testing"
+
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/na2na-p/cargohold/internal/infrastructure"
+)
+
+func TestAccessTokenSecretGenerator_Generate(t *testing.T) {
+	t.Run("正常系: 接頭辞付きの異なるトークンが生成される", func(t *testing.T) {
+		generator := infrastructure.NewAccessTokenSecretGenerator()
+
+		first, err := generator.Generate()
+		if err != nil {
+			t.Fatalf("Generate() error = %v", err)
+		}
+		second, err := generator.Generate()
+		if err != nil {
+			t.Fatalf("Generate() error = %v", err)
+		}
+
+		if !domain.IsAccessTokenSecret(first) {
+			t.Errorf("Generate() = %q, want prefix %q", first, domain.AccessTokenPrefix)
+		}
+		// 32バイトの乱数をパディングなしのbase64urlで表すと43文字になる
+		if got, want := len(first), len(domain.AccessTokenPrefix)+43; got != want {
+			t.Errorf("len(Generate()) = %d, want %d", got, want)
+		}
+		if first == second {
+			t.Error("Generate() returned the same token twice")
+		}
+	})
+}
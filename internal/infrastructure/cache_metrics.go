@@ -0,0 +1,11 @@
+//go:generate mockgen -source=$GOFILE -destination=../../tests/infrastructure/mock_cache_metrics.go -package=infrastructure
+package infrastructure
+
+// CacheMetricsRecorder はキャッシュのヒット・ミスを記録する
+type CacheMetricsRecorder interface {
+	RecordCacheLookup(cache string, hit bool)
+}
+
+type nopCacheMetricsRecorder struct{}
+
+func (nopCacheMetricsRecorder) RecordCacheLookup(string, bool) {}
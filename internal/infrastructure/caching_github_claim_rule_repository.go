@@ -0,0 +1,139 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/na2na-p/cargohold/internal/infrastructure/redis"
+	"github.com/na2na-p/cargohold/internal/usecase"
+)
+
+// githubClaimRuleCacheName はメトリクスに記録するクレームルールキャッシュの名前
+const githubClaimRuleCacheName = "github_claim_rules"
+
+// CachingGitHubClaimRuleRepository はリポジトリごとのクレームルールをRedisにキャッシュする
+// ルールが存在しないリポジトリも空の一覧としてキャッシュし、認証のたびにPostgreSQLへ問い合わせないようにする
+type CachingGitHubClaimRuleRepository struct {
+	pgRepo      domain.GitHubClaimRuleRepository
+	cacheClient usecase.CacheClient
+	cacheTTL    time.Duration
+	metrics     CacheMetricsRecorder
+}
+
+// NewCachingGitHubClaimRuleRepository はキャッシュのヒット・ミスをmetricsに記録するCachingGitHubClaimRuleRepositoryを生成する
+// metricsがnilの場合は記録しない
+func NewCachingGitHubClaimRuleRepository(
+	pgRepo domain.GitHubClaimRuleRepository,
+	cacheClient usecase.CacheClient,
+	metrics CacheMetricsRecorder,
+) *CachingGitHubClaimRuleRepository {
+	if metrics == nil {
+		metrics = nopCacheMetricsRecorder{}
+	}
+	return &CachingGitHubClaimRuleRepository{
+		pgRepo:      pgRepo,
+		cacheClient: cacheClient,
+		cacheTTL:    redis.OIDCGitHubClaimRulesTTL,
+		metrics:     metrics,
+	}
+}
+
+func (r *CachingGitHubClaimRuleRepository) FindByRepository(ctx context.Context, repository *domain.RepositoryIdentifier) ([]*domain.GitHubClaimRule, error) {
+	cacheKey := redis.OIDCGitHubClaimRulesKey(repository.FullName())
+
+	var cached []cachedGitHubClaimRule
+	if err := r.cacheClient.GetJSON(ctx, cacheKey, &cached); err == nil {
+		if rules, decodeErr := decodeCachedGitHubClaimRules(cached); decodeErr == nil {
+			r.metrics.RecordCacheLookup(githubClaimRuleCacheName, true)
+			return rules, nil
+		}
+	}
+	r.metrics.RecordCacheLookup(githubClaimRuleCacheName, false)
+
+	rules, err := r.pgRepo.FindByRepository(ctx, repository)
+	if err != nil {
+		return nil, fmt.Errorf("クレームルールの取得に失敗しました: %w", err)
+	}
+
+	_ = r.cacheClient.SetJSON(ctx, cacheKey, encodeCachedGitHubClaimRules(rules), r.cacheTTL)
+
+	return rules, nil
+}
+
+func (r *CachingGitHubClaimRuleRepository) Create(ctx context.Context, rule *domain.GitHubClaimRule) (*domain.GitHubClaimRule, error) {
+	created, err := r.pgRepo.Create(ctx, rule)
+	if err != nil {
+		return nil, fmt.Errorf("クレームルールの追加に失敗しました: %w", err)
+	}
+
+	_ = r.cacheClient.Delete(ctx, redis.OIDCGitHubClaimRulesKey(rule.Repository().FullName()))
+
+	return created, nil
+}
+
+func (r *CachingGitHubClaimRuleRepository) Delete(ctx context.Context, repository *domain.RepositoryIdentifier, id domain.GitHubClaimRuleID) error {
+	if err := r.pgRepo.Delete(ctx, repository, id); err != nil {
+		return fmt.Errorf("クレームルールの削除に失敗しました: %w", err)
+	}
+
+	_ = r.cacheClient.Delete(ctx, redis.OIDCGitHubClaimRulesKey(repository.FullName()))
+
+	return nil
+}
+
+type cachedGitHubClaimRule struct {
+	ID              int64     `json:"id"`
+	Repository      string    `json:"repository"`
+	Priority        int       `json:"priority"`
+	Refs            []string  `json:"refs"`
+	EventNames      []string  `json:"event_names"`
+	Environments    []string  `json:"environments"`
+	JobWorkflowRefs []string  `json:"job_workflow_refs"`
+	Access          string    `json:"access"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+func encodeCachedGitHubClaimRules(rules []*domain.GitHubClaimRule) []cachedGitHubClaimRule {
+	cached := make([]cachedGitHubClaimRule, len(rules))
+	for i, rule := range rules {
+		condition := rule.Condition()
+		cached[i] = cachedGitHubClaimRule{
+			ID:              rule.ID().Int64(),
+			Repository:      rule.Repository().FullName(),
+			Priority:        rule.Priority(),
+			Refs:            condition.Refs(),
+			EventNames:      condition.EventNames(),
+			Environments:    condition.Environments(),
+			JobWorkflowRefs: condition.JobWorkflowRefs(),
+			Access:          rule.Access().String(),
+			CreatedAt:       rule.CreatedAt(),
+		}
+	}
+	return cached
+}
+
+func decodeCachedGitHubClaimRules(cached []cachedGitHubClaimRule) ([]*domain.GitHubClaimRule, error) {
+	rules := make([]*domain.GitHubClaimRule, 0, len(cached))
+	for _, c := range cached {
+		id, err := domain.NewGitHubClaimRuleID(c.ID)
+		if err != nil {
+			return nil, err
+		}
+		repo, err := domain.NewRepositoryIdentifier(c.Repository)
+		if err != nil {
+			return nil, err
+		}
+		condition, err := domain.NewGitHubClaimCondition(c.Refs, c.EventNames, c.Environments, c.JobWorkflowRefs)
+		if err != nil {
+			return nil, err
+		}
+		access, err := domain.NewRepositoryAccessLevel(c.Access)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, domain.ReconstructGitHubClaimRule(id, repo, c.Priority, condition, access, c.CreatedAt))
+	}
+	return rules, nil
+}
@@ -0,0 +1,262 @@
+package infrastructure_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/na2na-p/cargohold/internal/infrastructure"
+	"github.com/na2na-p/cargohold/internal/infrastructure/redis"
+	mockdomain "github.com/na2na-p/cargohold/tests/domain"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	claimRulesCacheKey = "lfs:oidc:github:rules:owner/repo"
+	cachedClaimRules   = `[{"id":1,"repository":"owner/repo","priority":10,"refs":["refs/heads/main"],"event_names":[],"environments":[],"job_workflow_refs":[],"access":"write","created_at":"2024-01-01T12:00:00Z"}]`
+)
+
+func newTestGitHubClaimRule(t *testing.T) *domain.GitHubClaimRule {
+	t.Helper()
+
+	repo, err := domain.NewRepositoryIdentifier("owner/repo")
+	if err != nil {
+		t.Fatalf("NewRepositoryIdentifier() failed: %v", err)
+	}
+	id, err := domain.NewGitHubClaimRuleID(1)
+	if err != nil {
+		t.Fatalf("NewGitHubClaimRuleID() failed: %v", err)
+	}
+	condition, err := domain.NewGitHubClaimCondition([]string{"refs/heads/main"}, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("NewGitHubClaimCondition() failed: %v", err)
+	}
+	return domain.ReconstructGitHubClaimRule(id, repo, 10, condition, domain.RepositoryAccessLevelWrite, time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+}
+
+func TestCachingGitHubClaimRuleRepository_FindByRepository(t *testing.T) {
+	tests := []struct {
+		name           string
+		pgRepo         func(ctrl *gomock.Controller, rule *domain.GitHubClaimRule) *mockdomain.MockGitHubClaimRuleRepository
+		redisMockSetup func(mock redismock.ClientMock)
+		wantIDs        []int64
+		wantErr        bool
+	}{
+		{
+			name: "正常系: Redisキャッシュにヒット",
+			pgRepo: func(ctrl *gomock.Controller, _ *domain.GitHubClaimRule) *mockdomain.MockGitHubClaimRuleRepository {
+				return mockdomain.NewMockGitHubClaimRuleRepository(ctrl)
+			},
+			redisMockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectGet(claimRulesCacheKey).SetVal(cachedClaimRules)
+			},
+			wantIDs: []int64{1},
+		},
+		{
+			name: "正常系: ルールが存在しないことがキャッシュされている",
+			pgRepo: func(ctrl *gomock.Controller, _ *domain.GitHubClaimRule) *mockdomain.MockGitHubClaimRuleRepository {
+				return mockdomain.NewMockGitHubClaimRuleRepository(ctrl)
+			},
+			redisMockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectGet(claimRulesCacheKey).SetVal(`[]`)
+			},
+			wantIDs: []int64{},
+		},
+		{
+			name: "正常系: Redisキャッシュミス、PostgreSQLから取得してキャッシュする",
+			pgRepo: func(ctrl *gomock.Controller, rule *domain.GitHubClaimRule) *mockdomain.MockGitHubClaimRuleRepository {
+				m := mockdomain.NewMockGitHubClaimRuleRepository(ctrl)
+				m.EXPECT().FindByRepository(gomock.Any(), rule.Repository()).Return([]*domain.GitHubClaimRule{rule}, nil)
+				return m
+			},
+			redisMockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectGet(claimRulesCacheKey).RedisNil()
+				mock.ExpectSet(claimRulesCacheKey, []byte(cachedClaimRules), 5*time.Minute).SetVal("OK")
+			},
+			wantIDs: []int64{1},
+		},
+		{
+			name: "正常系: Redis障害時はPostgreSQLから取得する",
+			pgRepo: func(ctrl *gomock.Controller, rule *domain.GitHubClaimRule) *mockdomain.MockGitHubClaimRuleRepository {
+				m := mockdomain.NewMockGitHubClaimRuleRepository(ctrl)
+				m.EXPECT().FindByRepository(gomock.Any(), rule.Repository()).Return([]*domain.GitHubClaimRule{rule}, nil)
+				return m
+			},
+			redisMockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectGet(claimRulesCacheKey).SetErr(errors.New("redis connection error"))
+				mock.ExpectSet(claimRulesCacheKey, []byte(cachedClaimRules), 5*time.Minute).SetErr(errors.New("redis connection error"))
+			},
+			wantIDs: []int64{1},
+		},
+		{
+			name: "異常系: PostgreSQLエラー",
+			pgRepo: func(ctrl *gomock.Controller, rule *domain.GitHubClaimRule) *mockdomain.MockGitHubClaimRuleRepository {
+				m := mockdomain.NewMockGitHubClaimRuleRepository(ctrl)
+				m.EXPECT().FindByRepository(gomock.Any(), rule.Repository()).Return(nil, errors.New("database error"))
+				return m
+			},
+			redisMockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectGet(claimRulesCacheKey).RedisNil()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			db, mock := redismock.NewClientMock()
+			defer func() { _ = db.Close() }()
+			tt.redisMockSetup(mock)
+
+			rule := newTestGitHubClaimRule(t)
+			repo := infrastructure.NewCachingGitHubClaimRuleRepository(tt.pgRepo(ctrl, rule), redis.NewRedisClient(db), nil)
+
+			got, err := repo.FindByRepository(context.Background(), rule.Repository())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FindByRepository() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr {
+				if len(got) != len(tt.wantIDs) {
+					t.Fatalf("FindByRepository() len = %v, want %v", len(got), len(tt.wantIDs))
+				}
+				for i, r := range got {
+					if r.ID().Int64() != tt.wantIDs[i] {
+						t.Errorf("FindByRepository()[%d].ID() = %v, want %v", i, r.ID().Int64(), tt.wantIDs[i])
+					}
+				}
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("期待されたモック呼び出しが行われませんでした: %v", err)
+			}
+		})
+	}
+}
+
+func TestCachingGitHubClaimRuleRepository_Create(t *testing.T) {
+	tests := []struct {
+		name           string
+		pgErr          error
+		redisMockSetup func(mock redismock.ClientMock)
+		wantErr        bool
+	}{
+		{
+			name: "正常系: 追加に成功しキャッシュが削除される",
+			redisMockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectDel(claimRulesCacheKey).SetVal(1)
+			},
+		},
+		{
+			name:           "異常系: PostgreSQLエラーの場合はキャッシュを削除しない",
+			pgErr:          errors.New("database error"),
+			redisMockSetup: func(mock redismock.ClientMock) {},
+			wantErr:        true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			db, mock := redismock.NewClientMock()
+			defer func() { _ = db.Close() }()
+			tt.redisMockSetup(mock)
+
+			rule := newTestGitHubClaimRule(t)
+			pgRepo := mockdomain.NewMockGitHubClaimRuleRepository(ctrl)
+			if tt.pgErr != nil {
+				pgRepo.EXPECT().Create(gomock.Any(), rule).Return(nil, tt.pgErr)
+			} else {
+				pgRepo.EXPECT().Create(gomock.Any(), rule).Return(rule, nil)
+			}
+
+			repo := infrastructure.NewCachingGitHubClaimRuleRepository(pgRepo, redis.NewRedisClient(db), nil)
+			_, err := repo.Create(context.Background(), rule)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Create() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("期待されたモック呼び出しが行われませんでした: %v", err)
+			}
+		})
+	}
+}
+
+func TestCachingGitHubClaimRuleRepository_Delete(t *testing.T) {
+	tests := []struct {
+		name           string
+		pgErr          error
+		redisMockSetup func(mock redismock.ClientMock)
+		wantErr        error
+	}{
+		{
+			name: "正常系: 削除に成功しキャッシュが削除される",
+			redisMockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectDel(claimRulesCacheKey).SetVal(1)
+			},
+		},
+		{
+			name:           "異常系: ルールが存在しない場合はErrNotFoundが返る",
+			pgErr:          domain.ErrNotFound,
+			redisMockSetup: func(mock redismock.ClientMock) {},
+			wantErr:        domain.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			db, mock := redismock.NewClientMock()
+			defer func() { _ = db.Close() }()
+			tt.redisMockSetup(mock)
+
+			rule := newTestGitHubClaimRule(t)
+			pgRepo := mockdomain.NewMockGitHubClaimRuleRepository(ctrl)
+			pgRepo.EXPECT().Delete(gomock.Any(), rule.Repository(), rule.ID()).Return(tt.pgErr)
+
+			repo := infrastructure.NewCachingGitHubClaimRuleRepository(pgRepo, redis.NewRedisClient(db), nil)
+			err := repo.Delete(context.Background(), rule.Repository(), rule.ID())
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Delete() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("期待されたモック呼び出しが行われませんでした: %v", err)
+			}
+		})
+	}
+}
+
+func TestCachingGitHubClaimRuleRepository_CaseInsensitiveCacheKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	db, mock := redismock.NewClientMock()
+	defer func() { _ = db.Close() }()
+	// 大文字を含む表記で参照しても、小文字の表記と同じキャッシュを使う
+	mock.ExpectGet(claimRulesCacheKey).SetVal(cachedClaimRules)
+
+	upper, err := domain.NewRepositoryIdentifier("Owner/Repo")
+	if err != nil {
+		t.Fatalf("NewRepositoryIdentifier() failed: %v", err)
+	}
+	repo := infrastructure.NewCachingGitHubClaimRuleRepository(mockdomain.NewMockGitHubClaimRuleRepository(ctrl), redis.NewRedisClient(db), nil)
+
+	got, err := repo.FindByRepository(context.Background(), upper)
+	if err != nil {
+		t.Fatalf("FindByRepository() unexpected error: %v", err)
+	}
+	if len(got) != 1 || got[0].ID().Int64() != 1 {
+		t.Errorf("FindByRepository() = %v, want rule 1", got)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("期待されたモック呼び出しが行われませんでした: %v", err)
+	}
+}
@@ -2,30 +2,42 @@ package infrastructure
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
 	"github.com/na2na-p/cargohold/internal/domain"
 	"github.com/na2na-p/cargohold/internal/usecase"
 )
 
+// lfsObjectCacheName はメトリクスに記録するLFSオブジェクトのメタデータキャッシュの名前
+const lfsObjectCacheName = "lfs_object"
+
 type CachingLFSObjectRepository struct {
 	repo         domain.LFSObjectRepository
 	cacheClient  usecase.CacheClient
 	keyGenerator usecase.CacheKeyGenerator
 	cacheConfig  usecase.CacheConfig
+	metrics      CacheMetricsRecorder
 }
 
+// NewCachingLFSObjectRepository はキャッシュのヒット・ミスをmetricsに記録するCachingLFSObjectRepositoryを生成する
+// metricsがnilの場合は記録しない
 func NewCachingLFSObjectRepository(
 	repo domain.LFSObjectRepository,
 	cacheClient usecase.CacheClient,
 	keyGenerator usecase.CacheKeyGenerator,
 	cacheConfig usecase.CacheConfig,
+	metrics CacheMetricsRecorder,
 ) *CachingLFSObjectRepository {
+	if metrics == nil {
+		metrics = nopCacheMetricsRecorder{}
+	}
 	return &CachingLFSObjectRepository{
 		repo:         repo,
 		cacheClient:  cacheClient,
 		keyGenerator: keyGenerator,
 		cacheConfig:  cacheConfig,
+		metrics:      metrics,
 	}
 }
 
@@ -35,23 +47,12 @@ func (r *CachingLFSObjectRepository) FindByOID(ctx context.Context, oid domain.O
 	var cached cachedMetadata
 	err := r.cacheClient.GetJSON(ctx, cacheKey, &cached)
 	if err == nil {
-		cachedOID, oidErr := domain.NewOID(cached.OID)
-		cachedSize, sizeErr := domain.NewSize(cached.Size)
-		if oidErr == nil && sizeErr == nil {
-			obj, reconstructErr := domain.ReconstructLFSObject(
-				cachedOID,
-				cachedSize,
-				cached.HashAlgo,
-				cached.StorageKey,
-				cached.Uploaded,
-				cached.CreatedAt,
-				cached.UpdatedAt,
-			)
-			if reconstructErr == nil {
-				return obj, nil
-			}
+		if obj, reconstructErr := cached.toDomain(); reconstructErr == nil {
+			r.metrics.RecordCacheLookup(lfsObjectCacheName, true)
+			return obj, nil
 		}
 	}
+	r.metrics.RecordCacheLookup(lfsObjectCacheName, false)
 
 	obj, err := r.repo.FindByOID(ctx, oid)
 	if err != nil {
@@ -63,6 +64,53 @@ func (r *CachingLFSObjectRepository) FindByOID(ctx context.Context, oid domain.O
 	return obj, nil
 }
 
+func (r *CachingLFSObjectRepository) FindByOIDs(ctx context.Context, oids []domain.OID) (map[domain.OID]*domain.LFSObject, error) {
+	result := make(map[domain.OID]*domain.LFSObject, len(oids))
+	if len(oids) == 0 {
+		return result, nil
+	}
+
+	cacheKeys := make([]string, len(oids))
+	for i, oid := range oids {
+		cacheKeys[i] = r.keyGenerator.MetadataKey(oid.String())
+	}
+
+	misses := oids
+	values, err := r.cacheClient.MGet(ctx, cacheKeys)
+	if err == nil {
+		misses = make([]domain.OID, 0, len(oids))
+		for i, oid := range oids {
+			if obj := decodeCachedMetadata(values[i]); obj != nil {
+				result[oid] = obj
+				continue
+			}
+			misses = append(misses, oid)
+		}
+	}
+	for range len(oids) - len(misses) {
+		r.metrics.RecordCacheLookup(lfsObjectCacheName, true)
+	}
+	for range misses {
+		r.metrics.RecordCacheLookup(lfsObjectCacheName, false)
+	}
+
+	if len(misses) == 0 {
+		return result, nil
+	}
+
+	found, err := r.repo.FindByOIDs(ctx, misses)
+	if err != nil {
+		return nil, err
+	}
+
+	for oid, obj := range found {
+		result[oid] = obj
+		r.cacheMetadata(ctx, oid, obj)
+	}
+
+	return result, nil
+}
+
 func (r *CachingLFSObjectRepository) Save(ctx context.Context, obj *domain.LFSObject) error {
 	if err := r.repo.Save(ctx, obj); err != nil {
 		return err
@@ -88,12 +136,45 @@ func (r *CachingLFSObjectRepository) ExistsByOID(ctx context.Context, oid domain
 
 	exists, err := r.cacheClient.Exists(ctx, cacheKey)
 	if err == nil && exists {
+		r.metrics.RecordCacheLookup(lfsObjectCacheName, true)
 		return true, nil
 	}
+	r.metrics.RecordCacheLookup(lfsObjectCacheName, false)
 
 	return r.repo.ExistsByOID(ctx, oid)
 }
 
+func (r *CachingLFSObjectRepository) FindStaleNotUploaded(ctx context.Context, olderThan time.Time, limit int) ([]*domain.LFSObject, error) {
+	return r.repo.FindStaleNotUploaded(ctx, olderThan, limit)
+}
+
+func (r *CachingLFSObjectRepository) FindUnreferenced(ctx context.Context, olderThan time.Time, limit int) ([]*domain.LFSObject, error) {
+	return r.repo.FindUnreferenced(ctx, olderThan, limit)
+}
+
+func (r *CachingLFSObjectRepository) ListAfter(ctx context.Context, after domain.OID, limit int) ([]*domain.LFSObject, error) {
+	return r.repo.ListAfter(ctx, after, limit)
+}
+
+// Delete はメタデータを削除し、キャッシュからも取り除きます
+func (r *CachingLFSObjectRepository) Delete(ctx context.Context, oid domain.OID) error {
+	if err := r.repo.Delete(ctx, oid); err != nil {
+		return err
+	}
+
+	return r.cacheClient.Delete(ctx, r.keyGenerator.MetadataKey(oid.String()))
+}
+
+// DeleteStaleNotUploaded は条件に一致した場合のみメタデータを削除し、削除した場合はキャッシュからも取り除きます
+func (r *CachingLFSObjectRepository) DeleteStaleNotUploaded(ctx context.Context, oid domain.OID, olderThan time.Time) (bool, error) {
+	deleted, err := r.repo.DeleteStaleNotUploaded(ctx, oid, olderThan)
+	if err != nil || !deleted {
+		return deleted, err
+	}
+
+	return true, r.cacheClient.Delete(ctx, r.keyGenerator.MetadataKey(oid.String()))
+}
+
 func (r *CachingLFSObjectRepository) DeleteBatchUploadKey(ctx context.Context, oid string) error {
 	batchKey := r.keyGenerator.BatchUploadKey(oid)
 	return r.cacheClient.Delete(ctx, batchKey)
@@ -113,6 +194,21 @@ func (r *CachingLFSObjectRepository) cacheMetadata(ctx context.Context, oid doma
 	_ = r.cacheClient.SetJSON(ctx, cacheKey, cached, r.cacheConfig.MetadataTTL())
 }
 
+func decodeCachedMetadata(data []byte) *domain.LFSObject {
+	if data == nil {
+		return nil
+	}
+	var cached cachedMetadata
+	if err := json.Unmarshal(data, &cached); err != nil {
+		return nil
+	}
+	obj, err := cached.toDomain()
+	if err != nil {
+		return nil
+	}
+	return obj
+}
+
 type cachedMetadata struct {
 	OID        string    `json:"oid"`
 	Size       int64     `json:"size"`
@@ -122,3 +218,23 @@ type cachedMetadata struct {
 	CreatedAt  time.Time `json:"created_at"`
 	UpdatedAt  time.Time `json:"updated_at"`
 }
+
+func (c cachedMetadata) toDomain() (*domain.LFSObject, error) {
+	oid, err := domain.NewOID(c.OID)
+	if err != nil {
+		return nil, err
+	}
+	size, err := domain.NewSize(c.Size)
+	if err != nil {
+		return nil, err
+	}
+	return domain.ReconstructLFSObject(
+		oid,
+		size,
+		c.HashAlgo,
+		c.StorageKey,
+		c.Uploaded,
+		c.CreatedAt,
+		c.UpdatedAt,
+	)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/na2na-p/cargohold/internal/infrastructure"
 	"github.com/na2na-p/cargohold/internal/usecase"
 	mock_domain "github.com/na2na-p/cargohold/tests/domain"
+	mock_infrastructure "github.com/na2na-p/cargohold/tests/infrastructure"
 	mock_usecase "github.com/na2na-p/cargohold/tests/usecase"
 	"go.uber.org/mock/gomock"
 )
@@ -161,6 +162,7 @@ func TestCachingLFSObjectRepository_FindByOID(t *testing.T) {
 				tt.fields.cacheClient(ctrl),
 				tt.fields.keyGenerator(ctrl),
 				tt.fields.cacheConfig(ctrl),
+				nil,
 			)
 
 			got, err := repo.FindByOID(tt.args.ctx, tt.args.oid)
@@ -185,6 +187,176 @@ func TestCachingLFSObjectRepository_FindByOID(t *testing.T) {
 	}
 }
 
+func TestCachingLFSObjectRepository_FindByOIDs(t *testing.T) {
+	hitOID, _ := domain.NewOID("1234567890123456789012345678901234567890123456789012345678901234")
+	missOID, _ := domain.NewOID("abcdef1234567890abcdef1234567890abcdef1234567890abcdef1234567890")
+	hashAlgo, _ := domain.NewHashAlgorithm("sha256")
+	size, _ := domain.NewSize(2048)
+	missObj, _ := domain.NewLFSObject(context.Background(), missOID, size, hashAlgo, "objects/sha256/ab/cd/"+missOID.String())
+	hitJSON := []byte(`{"oid":"1234567890123456789012345678901234567890123456789012345678901234","size":1024,"hash_algo":"sha256","storage_key":"objects/sha256/12/34/1234567890123456789012345678901234567890123456789012345678901234","uploaded":true,"created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"}`)
+
+	keyGenerator := func(ctrl *gomock.Controller) usecase.CacheKeyGenerator {
+		mock := mock_usecase.NewMockCacheKeyGenerator(ctrl)
+		mock.EXPECT().MetadataKey(gomock.Any()).DoAndReturn(func(oid string) string {
+			return "metadata:" + oid
+		}).AnyTimes()
+		return mock
+	}
+
+	type fields struct {
+		repo         func(ctrl *gomock.Controller) domain.LFSObjectRepository
+		cacheClient  func(ctrl *gomock.Controller) usecase.CacheClient
+		keyGenerator func(ctrl *gomock.Controller) usecase.CacheKeyGenerator
+		cacheConfig  func(ctrl *gomock.Controller) usecase.CacheConfig
+	}
+	type args struct {
+		ctx  context.Context
+		oids []domain.OID
+	}
+	tests := []struct {
+		name      string
+		fields    fields
+		args      args
+		wantSizes map[string]int64
+		wantErr   bool
+	}{
+		{
+			name: "正常系: 全てキャッシュヒットした場合はDBを参照しない",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					return mock_domain.NewMockLFSObjectRepository(ctrl)
+				},
+				cacheClient: func(ctrl *gomock.Controller) usecase.CacheClient {
+					mock := mock_usecase.NewMockCacheClient(ctrl)
+					mock.EXPECT().MGet(gomock.Any(), []string{"metadata:" + hitOID.String()}).Return([][]byte{hitJSON}, nil)
+					return mock
+				},
+				keyGenerator: keyGenerator,
+				cacheConfig: func(ctrl *gomock.Controller) usecase.CacheConfig {
+					return mock_usecase.NewMockCacheConfig(ctrl)
+				},
+			},
+			args: args{
+				ctx:  context.Background(),
+				oids: []domain.OID{hitOID},
+			},
+			wantSizes: map[string]int64{hitOID.String(): 1024},
+			wantErr:   false,
+		},
+		{
+			name: "正常系: キャッシュミスしたOIDのみDBから一括取得してキャッシュする",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().FindByOIDs(gomock.Any(), []domain.OID{missOID}).Return(map[domain.OID]*domain.LFSObject{missOID: missObj}, nil)
+					return mock
+				},
+				cacheClient: func(ctrl *gomock.Controller) usecase.CacheClient {
+					mock := mock_usecase.NewMockCacheClient(ctrl)
+					mock.EXPECT().MGet(gomock.Any(), []string{"metadata:" + hitOID.String(), "metadata:" + missOID.String()}).Return([][]byte{hitJSON, nil}, nil)
+					mock.EXPECT().SetJSON(gomock.Any(), "metadata:"+missOID.String(), gomock.Any(), time.Hour).Return(nil)
+					return mock
+				},
+				keyGenerator: keyGenerator,
+				cacheConfig: func(ctrl *gomock.Controller) usecase.CacheConfig {
+					mock := mock_usecase.NewMockCacheConfig(ctrl)
+					mock.EXPECT().MetadataTTL().Return(time.Hour)
+					return mock
+				},
+			},
+			args: args{
+				ctx:  context.Background(),
+				oids: []domain.OID{hitOID, missOID},
+			},
+			wantSizes: map[string]int64{hitOID.String(): 1024, missOID.String(): 2048},
+			wantErr:   false,
+		},
+		{
+			name: "正常系: MGETに失敗した場合は全てのOIDをDBから取得する",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().FindByOIDs(gomock.Any(), []domain.OID{missOID}).Return(map[domain.OID]*domain.LFSObject{missOID: missObj}, nil)
+					return mock
+				},
+				cacheClient: func(ctrl *gomock.Controller) usecase.CacheClient {
+					mock := mock_usecase.NewMockCacheClient(ctrl)
+					mock.EXPECT().MGet(gomock.Any(), gomock.Any()).Return(nil, errors.New("redis error"))
+					mock.EXPECT().SetJSON(gomock.Any(), "metadata:"+missOID.String(), gomock.Any(), time.Hour).Return(nil)
+					return mock
+				},
+				keyGenerator: keyGenerator,
+				cacheConfig: func(ctrl *gomock.Controller) usecase.CacheConfig {
+					mock := mock_usecase.NewMockCacheConfig(ctrl)
+					mock.EXPECT().MetadataTTL().Return(time.Hour)
+					return mock
+				},
+			},
+			args: args{
+				ctx:  context.Background(),
+				oids: []domain.OID{missOID},
+			},
+			wantSizes: map[string]int64{missOID.String(): 2048},
+			wantErr:   false,
+		},
+		{
+			name: "異常系: DBからの一括取得に失敗した場合はエラーを返す",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().FindByOIDs(gomock.Any(), []domain.OID{missOID}).Return(nil, errors.New("database error"))
+					return mock
+				},
+				cacheClient: func(ctrl *gomock.Controller) usecase.CacheClient {
+					mock := mock_usecase.NewMockCacheClient(ctrl)
+					mock.EXPECT().MGet(gomock.Any(), gomock.Any()).Return([][]byte{nil}, nil)
+					return mock
+				},
+				keyGenerator: keyGenerator,
+				cacheConfig: func(ctrl *gomock.Controller) usecase.CacheConfig {
+					return mock_usecase.NewMockCacheConfig(ctrl)
+				},
+			},
+			args: args{
+				ctx:  context.Background(),
+				oids: []domain.OID{missOID},
+			},
+			wantSizes: nil,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			repo := infrastructure.NewCachingLFSObjectRepository(
+				tt.fields.repo(ctrl),
+				tt.fields.cacheClient(ctrl),
+				tt.fields.keyGenerator(ctrl),
+				tt.fields.cacheConfig(ctrl),
+				nil,
+			)
+
+			got, err := repo.FindByOIDs(tt.args.ctx, tt.args.oids)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FindByOIDs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			gotSizes := make(map[string]int64, len(got))
+			for oid, obj := range got {
+				gotSizes[oid.String()] = obj.Size().Int64()
+			}
+			if diff := cmp.Diff(tt.wantSizes, gotSizes); diff != "" {
+				t.Errorf("FindByOIDs() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestCachingLFSObjectRepository_Save(t *testing.T) {
 	type fields struct {
 		repo         func(ctrl *gomock.Controller) domain.LFSObjectRepository
@@ -281,6 +453,7 @@ func TestCachingLFSObjectRepository_Save(t *testing.T) {
 				tt.fields.cacheClient(ctrl),
 				tt.fields.keyGenerator(ctrl),
 				tt.fields.cacheConfig(ctrl),
+				nil,
 			)
 
 			err := repo.Save(tt.args.ctx, tt.args.obj)
@@ -395,6 +568,7 @@ func TestCachingLFSObjectRepository_Update(t *testing.T) {
 				tt.fields.cacheClient(ctrl),
 				tt.fields.keyGenerator(ctrl),
 				tt.fields.cacheConfig(ctrl),
+				nil,
 			)
 
 			err := repo.Update(tt.args.ctx, tt.args.obj)
@@ -536,6 +710,7 @@ func TestCachingLFSObjectRepository_ExistsByOID(t *testing.T) {
 				tt.fields.cacheClient(ctrl),
 				tt.fields.keyGenerator(ctrl),
 				tt.fields.cacheConfig(ctrl),
+				nil,
 			)
 
 			got, err := repo.ExistsByOID(tt.args.ctx, tt.args.oid)
@@ -558,6 +733,229 @@ func TestCachingLFSObjectRepository_ExistsByOID(t *testing.T) {
 	}
 }
 
+func TestCachingLFSObjectRepository_Delete(t *testing.T) {
+	testOID := "1234567890123456789012345678901234567890123456789012345678901234"
+	oid, _ := domain.NewOID(testOID)
+
+	type fields struct {
+		repo         func(ctrl *gomock.Controller) domain.LFSObjectRepository
+		cacheClient  func(ctrl *gomock.Controller) usecase.CacheClient
+		keyGenerator func(ctrl *gomock.Controller) usecase.CacheKeyGenerator
+		cacheConfig  func(ctrl *gomock.Controller) usecase.CacheConfig
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr error
+	}{
+		{
+			name: "正常系: メタデータが削除され、キャッシュからも取り除かれる",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().Delete(gomock.Any(), oid).Return(nil)
+					return mock
+				},
+				cacheClient: func(ctrl *gomock.Controller) usecase.CacheClient {
+					mock := mock_usecase.NewMockCacheClient(ctrl)
+					mock.EXPECT().Delete(gomock.Any(), "lfs:meta:"+testOID).Return(nil)
+					return mock
+				},
+				keyGenerator: func(ctrl *gomock.Controller) usecase.CacheKeyGenerator {
+					mock := mock_usecase.NewMockCacheKeyGenerator(ctrl)
+					mock.EXPECT().MetadataKey(testOID).Return("lfs:meta:" + testOID)
+					return mock
+				},
+				cacheConfig: func(ctrl *gomock.Controller) usecase.CacheConfig {
+					return mock_usecase.NewMockCacheConfig(ctrl)
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "異常系: メタデータの削除に失敗した場合、キャッシュは削除されずエラーが返る",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().Delete(gomock.Any(), oid).Return(errors.New("database error"))
+					return mock
+				},
+				cacheClient: func(ctrl *gomock.Controller) usecase.CacheClient {
+					return mock_usecase.NewMockCacheClient(ctrl)
+				},
+				keyGenerator: func(ctrl *gomock.Controller) usecase.CacheKeyGenerator {
+					return mock_usecase.NewMockCacheKeyGenerator(ctrl)
+				},
+				cacheConfig: func(ctrl *gomock.Controller) usecase.CacheConfig {
+					return mock_usecase.NewMockCacheConfig(ctrl)
+				},
+			},
+			wantErr: errors.New("database error"),
+		},
+		{
+			name: "異常系: キャッシュの削除に失敗した場合、エラーが返る",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().Delete(gomock.Any(), oid).Return(nil)
+					return mock
+				},
+				cacheClient: func(ctrl *gomock.Controller) usecase.CacheClient {
+					mock := mock_usecase.NewMockCacheClient(ctrl)
+					mock.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(errors.New("redis delete error"))
+					return mock
+				},
+				keyGenerator: func(ctrl *gomock.Controller) usecase.CacheKeyGenerator {
+					mock := mock_usecase.NewMockCacheKeyGenerator(ctrl)
+					mock.EXPECT().MetadataKey(gomock.Any()).Return("lfs:meta:" + testOID)
+					return mock
+				},
+				cacheConfig: func(ctrl *gomock.Controller) usecase.CacheConfig {
+					return mock_usecase.NewMockCacheConfig(ctrl)
+				},
+			},
+			wantErr: errors.New("redis delete error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			repo := infrastructure.NewCachingLFSObjectRepository(
+				tt.fields.repo(ctrl),
+				tt.fields.cacheClient(ctrl),
+				tt.fields.keyGenerator(ctrl),
+				tt.fields.cacheConfig(ctrl),
+				nil,
+			)
+
+			err := repo.Delete(context.Background(), oid)
+
+			if tt.wantErr != nil {
+				if err == nil {
+					t.Fatalf("Delete() error = nil, wantErr %v", tt.wantErr)
+				}
+				if diff := cmp.Diff(tt.wantErr.Error(), err.Error()); diff != "" {
+					t.Errorf("Delete() error mismatch (-want +got):\n%s", diff)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Delete() unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestCachingLFSObjectRepository_DeleteStaleNotUploaded(t *testing.T) {
+	testOID := "1234567890123456789012345678901234567890123456789012345678901234"
+	oid, _ := domain.NewOID(testOID)
+	olderThan := time.Date(2024, 1, 14, 10, 0, 0, 0, time.UTC)
+
+	type fields struct {
+		repo         func(ctrl *gomock.Controller) domain.LFSObjectRepository
+		cacheClient  func(ctrl *gomock.Controller) usecase.CacheClient
+		keyGenerator func(ctrl *gomock.Controller) usecase.CacheKeyGenerator
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    bool
+		wantErr error
+	}{
+		{
+			name: "正常系: メタデータが削除された場合、キャッシュからも取り除かれる",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().DeleteStaleNotUploaded(gomock.Any(), oid, olderThan).Return(true, nil)
+					return mock
+				},
+				cacheClient: func(ctrl *gomock.Controller) usecase.CacheClient {
+					mock := mock_usecase.NewMockCacheClient(ctrl)
+					mock.EXPECT().Delete(gomock.Any(), "lfs:meta:"+testOID).Return(nil)
+					return mock
+				},
+				keyGenerator: func(ctrl *gomock.Controller) usecase.CacheKeyGenerator {
+					mock := mock_usecase.NewMockCacheKeyGenerator(ctrl)
+					mock.EXPECT().MetadataKey(testOID).Return("lfs:meta:" + testOID)
+					return mock
+				},
+			},
+			want: true,
+		},
+		{
+			name: "正常系: 条件に一致せず削除されなかった場合、キャッシュは削除されない",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().DeleteStaleNotUploaded(gomock.Any(), oid, olderThan).Return(false, nil)
+					return mock
+				},
+				cacheClient: func(ctrl *gomock.Controller) usecase.CacheClient {
+					return mock_usecase.NewMockCacheClient(ctrl)
+				},
+				keyGenerator: func(ctrl *gomock.Controller) usecase.CacheKeyGenerator {
+					return mock_usecase.NewMockCacheKeyGenerator(ctrl)
+				},
+			},
+			want: false,
+		},
+		{
+			name: "異常系: メタデータの削除に失敗した場合、キャッシュは削除されずエラーが返る",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().DeleteStaleNotUploaded(gomock.Any(), oid, olderThan).Return(false, errors.New("database error"))
+					return mock
+				},
+				cacheClient: func(ctrl *gomock.Controller) usecase.CacheClient {
+					return mock_usecase.NewMockCacheClient(ctrl)
+				},
+				keyGenerator: func(ctrl *gomock.Controller) usecase.CacheKeyGenerator {
+					return mock_usecase.NewMockCacheKeyGenerator(ctrl)
+				},
+			},
+			wantErr: errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			repo := infrastructure.NewCachingLFSObjectRepository(
+				tt.fields.repo(ctrl),
+				tt.fields.cacheClient(ctrl),
+				tt.fields.keyGenerator(ctrl),
+				mock_usecase.NewMockCacheConfig(ctrl),
+				nil,
+			)
+
+			got, err := repo.DeleteStaleNotUploaded(context.Background(), oid, olderThan)
+
+			if tt.wantErr != nil {
+				if err == nil {
+					t.Fatalf("DeleteStaleNotUploaded() error = nil, wantErr %v", tt.wantErr)
+				}
+				if diff := cmp.Diff(tt.wantErr.Error(), err.Error()); diff != "" {
+					t.Errorf("DeleteStaleNotUploaded() error mismatch (-want +got):\n%s", diff)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("DeleteStaleNotUploaded() unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("DeleteStaleNotUploaded() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestCachingLFSObjectRepository_DeleteBatchUploadKey(t *testing.T) {
 	type fields struct {
 		repo         func(ctrl *gomock.Controller) domain.LFSObjectRepository
@@ -638,6 +1036,7 @@ func TestCachingLFSObjectRepository_DeleteBatchUploadKey(t *testing.T) {
 				tt.fields.cacheClient(ctrl),
 				tt.fields.keyGenerator(ctrl),
 				tt.fields.cacheConfig(ctrl),
+				nil,
 			)
 
 			err := repo.DeleteBatchUploadKey(tt.args.ctx, tt.args.oid)
@@ -658,3 +1057,111 @@ func TestCachingLFSObjectRepository_DeleteBatchUploadKey(t *testing.T) {
 		})
 	}
 }
+
+func TestCachingLFSObjectRepository_Metrics(t *testing.T) {
+	hitOID, _ := domain.NewOID("1234567890123456789012345678901234567890123456789012345678901234")
+	missOID, _ := domain.NewOID("abcdef1234567890abcdef1234567890abcdef1234567890abcdef1234567890")
+	hashAlgo, _ := domain.NewHashAlgorithm("sha256")
+	size, _ := domain.NewSize(2048)
+	missObj, _ := domain.NewLFSObject(context.Background(), missOID, size, hashAlgo, "objects/sha256/ab/cd/"+missOID.String())
+	hitJSON := []byte(`{"oid":"1234567890123456789012345678901234567890123456789012345678901234","size":1024,"hash_algo":"sha256","storage_key":"objects/sha256/12/34/1234567890123456789012345678901234567890123456789012345678901234","uploaded":true,"created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"}`)
+
+	tests := []struct {
+		name       string
+		setupRepo  func(ctrl *gomock.Controller) domain.LFSObjectRepository
+		setupCache func(ctrl *gomock.Controller) usecase.CacheClient
+		call       func(repo *infrastructure.CachingLFSObjectRepository) error
+		wantHits   int
+		wantMisses int
+	}{
+		{
+			name: "正常系: FindByOIDsでヒットとミスがOIDごとに記録される",
+			setupRepo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+				mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+				mock.EXPECT().FindByOIDs(gomock.Any(), []domain.OID{missOID}).Return(map[domain.OID]*domain.LFSObject{missOID: missObj}, nil)
+				return mock
+			},
+			setupCache: func(ctrl *gomock.Controller) usecase.CacheClient {
+				mock := mock_usecase.NewMockCacheClient(ctrl)
+				mock.EXPECT().MGet(gomock.Any(), gomock.Any()).Return([][]byte{hitJSON, nil}, nil)
+				mock.EXPECT().SetJSON(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				return mock
+			},
+			call: func(repo *infrastructure.CachingLFSObjectRepository) error {
+				_, err := repo.FindByOIDs(context.Background(), []domain.OID{hitOID, missOID})
+				return err
+			},
+			wantHits:   1,
+			wantMisses: 1,
+		},
+		{
+			name: "正常系: FindByOIDでキャッシュにヒットした場合はヒットが記録される",
+			setupRepo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+				return mock_domain.NewMockLFSObjectRepository(ctrl)
+			},
+			setupCache: func(ctrl *gomock.Controller) usecase.CacheClient {
+				mock := mock_usecase.NewMockCacheClient(ctrl)
+				mock.EXPECT().GetJSON(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ string, dest interface{}) error {
+					return json.Unmarshal(hitJSON, dest)
+				})
+				return mock
+			},
+			call: func(repo *infrastructure.CachingLFSObjectRepository) error {
+				_, err := repo.FindByOID(context.Background(), hitOID)
+				return err
+			},
+			wantHits: 1,
+		},
+		{
+			name: "正常系: ExistsByOIDでキャッシュにない場合はミスが記録される",
+			setupRepo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+				mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+				mock.EXPECT().ExistsByOID(gomock.Any(), missOID).Return(false, nil)
+				return mock
+			},
+			setupCache: func(ctrl *gomock.Controller) usecase.CacheClient {
+				mock := mock_usecase.NewMockCacheClient(ctrl)
+				mock.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(false, nil)
+				return mock
+			},
+			call: func(repo *infrastructure.CachingLFSObjectRepository) error {
+				_, err := repo.ExistsByOID(context.Background(), missOID)
+				return err
+			},
+			wantMisses: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			keyGenerator := mock_usecase.NewMockCacheKeyGenerator(ctrl)
+			keyGenerator.EXPECT().MetadataKey(gomock.Any()).DoAndReturn(func(oid string) string {
+				return "metadata:" + oid
+			}).AnyTimes()
+			cacheConfig := mock_usecase.NewMockCacheConfig(ctrl)
+			cacheConfig.EXPECT().MetadataTTL().Return(time.Hour).AnyTimes()
+
+			metrics := mock_infrastructure.NewMockCacheMetricsRecorder(ctrl)
+			if tt.wantHits > 0 {
+				metrics.EXPECT().RecordCacheLookup("lfs_object", true).Times(tt.wantHits)
+			}
+			if tt.wantMisses > 0 {
+				metrics.EXPECT().RecordCacheLookup("lfs_object", false).Times(tt.wantMisses)
+			}
+
+			repo := infrastructure.NewCachingLFSObjectRepository(
+				tt.setupRepo(ctrl),
+				tt.setupCache(ctrl),
+				keyGenerator,
+				cacheConfig,
+				metrics,
+			)
+
+			if err := tt.call(repo); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
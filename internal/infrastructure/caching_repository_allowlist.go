@@ -12,22 +12,32 @@ import (
 
 const (
 	repositoryCacheTTL = 5 * time.Minute
+	// repositoryAllowlistCacheName はメトリクスに記録する許可リポジトリキャッシュの名前
+	repositoryAllowlistCacheName = "repository_allowlist"
 )
 
 type CachingRepositoryAllowlist struct {
 	pgRepo      domain.RepositoryAllowlistRepository
 	cacheClient usecase.RepositoryAllowlistCacheClient
 	cacheTTL    time.Duration
+	metrics     CacheMetricsRecorder
 }
 
+// NewCachingRepositoryAllowlist はキャッシュのヒット・ミスをmetricsに記録するCachingRepositoryAllowlistを生成する
+// metricsがnilの場合は記録しない
 func NewCachingRepositoryAllowlist(
 	pgRepo domain.RepositoryAllowlistRepository,
 	cacheClient usecase.RepositoryAllowlistCacheClient,
+	metrics CacheMetricsRecorder,
 ) *CachingRepositoryAllowlist {
+	if metrics == nil {
+		metrics = nopCacheMetricsRecorder{}
+	}
 	return &CachingRepositoryAllowlist{
 		pgRepo:      pgRepo,
 		cacheClient: cacheClient,
 		cacheTTL:    repositoryCacheTTL,
+		metrics:     metrics,
 	}
 }
 
@@ -40,6 +50,7 @@ func NewCachingRepositoryAllowlistWithTTL(
 		pgRepo:      pgRepo,
 		cacheClient: cacheClient,
 		cacheTTL:    ttl,
+		metrics:     nopCacheMetricsRecorder{},
 	}
 }
 
@@ -48,8 +59,10 @@ func (r *CachingRepositoryAllowlist) IsAllowed(ctx context.Context, repository *
 
 	allowed, err := r.checkCache(ctx, cacheKey)
 	if err == nil {
+		r.metrics.RecordCacheLookup(repositoryAllowlistCacheName, true)
 		return allowed, nil
 	}
+	r.metrics.RecordCacheLookup(repositoryAllowlistCacheName, false)
 
 	allowed, err = r.pgRepo.IsAllowed(ctx, repository)
 	if err != nil {
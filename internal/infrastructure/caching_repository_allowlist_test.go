@@ -11,6 +11,7 @@ import (
 	"github.com/na2na-p/cargohold/internal/infrastructure"
 	"github.com/na2na-p/cargohold/internal/infrastructure/redis"
 	mockdomain "github.com/na2na-p/cargohold/tests/domain"
+	mock_infrastructure "github.com/na2na-p/cargohold/tests/infrastructure"
 	"go.uber.org/mock/gomock"
 )
 
@@ -167,7 +168,7 @@ func TestCachingRepositoryAllowlist_IsAllowed(t *testing.T) {
 			redisClient := redis.NewRedisClient(db)
 			pgRepo := tt.fields.pgRepo(ctrl, tt.args)
 
-			allowlist := infrastructure.NewCachingRepositoryAllowlist(pgRepo, redisClient)
+			allowlist := infrastructure.NewCachingRepositoryAllowlist(pgRepo, redisClient, nil)
 			ctx := context.Background()
 
 			allowedRepo := mustNewAllowedRepository(t, tt.args.owner, tt.args.repo)
@@ -275,7 +276,7 @@ func TestCachingRepositoryAllowlist_Add(t *testing.T) {
 			redisClient := redis.NewRedisClient(db)
 			pgRepo := tt.fields.pgRepo(ctrl, tt.args)
 
-			allowlist := infrastructure.NewCachingRepositoryAllowlist(pgRepo, redisClient)
+			allowlist := infrastructure.NewCachingRepositoryAllowlist(pgRepo, redisClient, nil)
 			ctx := context.Background()
 
 			allowedRepo := mustNewAllowedRepository(t, tt.args.owner, tt.args.repo)
@@ -378,7 +379,7 @@ func TestCachingRepositoryAllowlist_Remove(t *testing.T) {
 			redisClient := redis.NewRedisClient(db)
 			pgRepo := tt.fields.pgRepo(ctrl, tt.args)
 
-			allowlist := infrastructure.NewCachingRepositoryAllowlist(pgRepo, redisClient)
+			allowlist := infrastructure.NewCachingRepositoryAllowlist(pgRepo, redisClient, nil)
 			ctx := context.Background()
 
 			allowedRepo := mustNewAllowedRepository(t, tt.args.owner, tt.args.repo)
@@ -461,7 +462,7 @@ func TestCachingRepositoryAllowlist_List(t *testing.T) {
 			redisClient := redis.NewRedisClient(db)
 			pgRepo := tt.fields.pgRepo(ctrl)
 
-			allowlist := infrastructure.NewCachingRepositoryAllowlist(pgRepo, redisClient)
+			allowlist := infrastructure.NewCachingRepositoryAllowlist(pgRepo, redisClient, nil)
 			ctx := context.Background()
 
 			got, err := allowlist.List(ctx)
@@ -584,3 +585,58 @@ func mustNewAllowedRepositoryHelper(owner, repo string) *domain.AllowedRepositor
 	}
 	return ar
 }
+
+func TestCachingRepositoryAllowlist_Metrics(t *testing.T) {
+	tests := []struct {
+		name           string
+		pgRepo         func(ctrl *gomock.Controller) *mockdomain.MockRepositoryAllowlistRepository
+		redisMockSetup func(mock redismock.ClientMock)
+		wantHit        bool
+	}{
+		{
+			name: "正常系: キャッシュにヒットした場合はヒットが記録される",
+			pgRepo: func(ctrl *gomock.Controller) *mockdomain.MockRepositoryAllowlistRepository {
+				return mockdomain.NewMockRepositoryAllowlistRepository(ctrl)
+			},
+			redisMockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectGet("lfs:oidc:github:repo:owner/repo").SetVal("true")
+			},
+			wantHit: true,
+		},
+		{
+			name: "正常系: キャッシュミスの場合はミスが記録される",
+			pgRepo: func(ctrl *gomock.Controller) *mockdomain.MockRepositoryAllowlistRepository {
+				m := mockdomain.NewMockRepositoryAllowlistRepository(ctrl)
+				m.EXPECT().IsAllowed(gomock.Any(), gomock.Any()).Return(true, nil)
+				return m
+			},
+			redisMockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectGet("lfs:oidc:github:repo:owner/repo").RedisNil()
+				mock.ExpectSet("lfs:oidc:github:repo:owner/repo", "true", 5*time.Minute).SetVal("OK")
+			},
+			wantHit: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			db, mock := redismock.NewClientMock()
+			defer func() { _ = db.Close() }()
+			tt.redisMockSetup(mock)
+
+			metrics := mock_infrastructure.NewMockCacheMetricsRecorder(ctrl)
+			metrics.EXPECT().RecordCacheLookup("repository_allowlist", tt.wantHit)
+
+			allowlist := infrastructure.NewCachingRepositoryAllowlist(tt.pgRepo(ctrl), redis.NewRedisClient(db), metrics)
+			if _, err := allowlist.IsAllowed(context.Background(), mustNewAllowedRepository(t, "owner", "repo")); err != nil {
+				t.Fatalf("IsAllowed() unexpected error: %v", err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("期待されたモック呼び出しが行われませんでした: %v", err)
+			}
+		})
+	}
+}
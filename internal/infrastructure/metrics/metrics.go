@@ -0,0 +1,164 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "cargohold"
+
+// Metrics はcargoholdが公開するPrometheusメトリクスを保持する
+// 専用のレジストリに登録するため、複数のインスタンスを生成しても衝突しない
+type Metrics struct {
+	registry *prometheus.Registry
+
+	httpRequests          *prometheus.CounterVec
+	httpRequestDuration   *prometheus.HistogramVec
+	proxiedBytes          *prometheus.CounterVec
+	batchObjects          *prometheus.HistogramVec
+	authAttempts          *prometheus.CounterVec
+	cacheLookups          *prometheus.CounterVec
+	storageDuration       *prometheus.HistogramVec
+	storageErrors         *prometheus.CounterVec
+	databaseQueryDuration *prometheus.HistogramVec
+	databaseQueryErrors   *prometheus.CounterVec
+}
+
+func NewMetrics() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		httpRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "requests_total",
+			Help:      "HTTPリクエスト数",
+		}, []string{"route", "method", "status"}),
+		httpRequestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "request_duration_seconds",
+			Help:      "HTTPリクエストの処理時間",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"route", "method"}),
+		proxiedBytes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "proxy",
+			Name:      "transferred_bytes_total",
+			Help:      "プロキシ経由で転送したバイト数",
+		}, []string{"direction"}),
+		batchObjects: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "batch",
+			Name:      "objects",
+			Help:      "バッチリクエストに含まれるオブジェクト数",
+			Buckets:   prometheus.ExponentialBuckets(1, 4, 7),
+		}, []string{"operation"}),
+		authAttempts: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "auth",
+			Name:      "attempts_total",
+			Help:      "認証方式ごとの認証結果",
+		}, []string{"method", "outcome"}),
+		cacheLookups: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "cache",
+			Name:      "lookups_total",
+			Help:      "Redisキャッシュの参照結果",
+		}, []string{"cache", "result"}),
+		storageDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "storage",
+			Name:      "operation_duration_seconds",
+			Help:      "S3操作の処理時間",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"operation"}),
+		storageErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "storage",
+			Name:      "operation_errors_total",
+			Help:      "失敗したS3操作の数",
+		}, []string{"operation"}),
+		databaseQueryDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "database",
+			Name:      "query_duration_seconds",
+			Help:      "PostgreSQLクエリの処理時間",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"operation"}),
+		databaseQueryErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "database",
+			Name:      "query_errors_total",
+			Help:      "失敗したPostgreSQLクエリの数",
+		}, []string{"operation"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.httpRequests,
+		m.httpRequestDuration,
+		m.proxiedBytes,
+		m.batchObjects,
+		m.authAttempts,
+		m.cacheLookups,
+		m.storageDuration,
+		m.storageErrors,
+		m.databaseQueryDuration,
+		m.databaseQueryErrors,
+	)
+
+	return m
+}
+
+// Handler はメトリクスをPrometheusのテキスト形式で返すHTTPハンドラーを返す
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+func (m *Metrics) ObserveHTTPRequest(route, method string, statusCode int, elapsed time.Duration) {
+	m.httpRequests.WithLabelValues(route, method, strconv.Itoa(statusCode)).Inc()
+	m.httpRequestDuration.WithLabelValues(route, method).Observe(elapsed.Seconds())
+}
+
+func (m *Metrics) AddProxiedBytes(direction string, bytes int64) {
+	if bytes <= 0 {
+		return
+	}
+	m.proxiedBytes.WithLabelValues(direction).Add(float64(bytes))
+}
+
+func (m *Metrics) ObserveBatchSize(operation string, objects int) {
+	m.batchObjects.WithLabelValues(operation).Observe(float64(objects))
+}
+
+func (m *Metrics) RecordAuthAttempt(method, outcome string) {
+	m.authAttempts.WithLabelValues(method, outcome).Inc()
+}
+
+func (m *Metrics) RecordCacheLookup(cache string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	m.cacheLookups.WithLabelValues(cache, result).Inc()
+}
+
+func (m *Metrics) ObserveStorageOperation(operation string, elapsed time.Duration, err error) {
+	m.storageDuration.WithLabelValues(operation).Observe(elapsed.Seconds())
+	if err != nil {
+		m.storageErrors.WithLabelValues(operation).Inc()
+	}
+}
+
+func (m *Metrics) ObserveDatabaseQuery(operation string, elapsed time.Duration, err error) {
+	m.databaseQueryDuration.WithLabelValues(operation).Observe(elapsed.Seconds())
+	if err != nil {
+		m.databaseQueryErrors.WithLabelValues(operation).Inc()
+	}
+}
@@ -0,0 +1,115 @@
+package metrics_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/na2na-p/cargohold/internal/infrastructure/metrics"
+)
+
+func scrape(t *testing.T, m *metrics.Metrics) string {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	m.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("scrape status code = %d, want %d", rec.Code, http.StatusOK)
+	}
+	body, err := io.ReadAll(rec.Body)
+	if err != nil {
+		t.Fatalf("failed to read body: %v", err)
+	}
+	return string(body)
+}
+
+func TestMetrics(t *testing.T) {
+	tests := []struct {
+		name      string
+		record    func(m *metrics.Metrics)
+		wantLines []string
+		skipLines []string
+	}{
+		{
+			name: "正常系: HTTPリクエストがルート・メソッド・ステータスごとに記録される",
+			record: func(m *metrics.Metrics) {
+				m.ObserveHTTPRequest("/:owner/:repo/info/lfs/objects/batch", http.MethodPost, http.StatusOK, 100*time.Millisecond)
+				m.ObserveHTTPRequest("/:owner/:repo/info/lfs/objects/batch", http.MethodPost, http.StatusOK, 200*time.Millisecond)
+			},
+			wantLines: []string{
+				`cargohold_http_requests_total{method="POST",route="/:owner/:repo/info/lfs/objects/batch",status="200"} 2`,
+				`cargohold_http_request_duration_seconds_count{method="POST",route="/:owner/:repo/info/lfs/objects/batch"} 2`,
+			},
+		},
+		{
+			name: "正常系: 転送バイト数が方向ごとに加算され、0以下は無視される",
+			record: func(m *metrics.Metrics) {
+				m.AddProxiedBytes("upload", 1024)
+				m.AddProxiedBytes("upload", 2048)
+				m.AddProxiedBytes("download", 0)
+			},
+			wantLines: []string{
+				`cargohold_proxy_transferred_bytes_total{direction="upload"} 3072`,
+			},
+			skipLines: []string{
+				`cargohold_proxy_transferred_bytes_total{direction="download"}`,
+			},
+		},
+		{
+			name: "正常系: バッチサイズ・認証結果・キャッシュ参照結果が記録される",
+			record: func(m *metrics.Metrics) {
+				m.ObserveBatchSize("download", 3)
+				m.RecordAuthAttempt("oidc", "success")
+				m.RecordCacheLookup("lfs_object", true)
+				m.RecordCacheLookup("lfs_object", false)
+			},
+			wantLines: []string{
+				`cargohold_batch_objects_sum{operation="download"} 3`,
+				`cargohold_auth_attempts_total{method="oidc",outcome="success"} 1`,
+				`cargohold_cache_lookups_total{cache="lfs_object",result="hit"} 1`,
+				`cargohold_cache_lookups_total{cache="lfs_object",result="miss"} 1`,
+			},
+		},
+		{
+			name: "正常系: ストレージとデータベースの操作はエラーの場合のみエラー数が加算される",
+			record: func(m *metrics.Metrics) {
+				m.ObserveStorageOperation("put", time.Second, nil)
+				m.ObserveStorageOperation("get", time.Second, errors.New("timeout"))
+				m.ObserveDatabaseQuery("select", time.Millisecond, nil)
+				m.ObserveDatabaseQuery("insert", time.Millisecond, errors.New("unique violation"))
+			},
+			wantLines: []string{
+				`cargohold_storage_operation_duration_seconds_count{operation="put"} 1`,
+				`cargohold_storage_operation_errors_total{operation="get"} 1`,
+				`cargohold_database_query_duration_seconds_count{operation="select"} 1`,
+				`cargohold_database_query_errors_total{operation="insert"} 1`,
+			},
+			skipLines: []string{
+				`cargohold_storage_operation_errors_total{operation="put"}`,
+				`cargohold_database_query_errors_total{operation="select"}`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := metrics.NewMetrics()
+			tt.record(m)
+
+			body := scrape(t, m)
+			for _, line := range tt.wantLines {
+				if !strings.Contains(body, line) {
+					t.Errorf("metrics output does not contain %q", line)
+				}
+			}
+			for _, line := range tt.skipLines {
+				if strings.Contains(body, line) {
+					t.Errorf("metrics output unexpectedly contains %q", line)
+				}
+			}
+		})
+	}
+}
@@ -22,4 +22,14 @@ var (
 	ErrInvalidRepository = errors.New("repository not allowed")
 	// ErrExponentOutOfRange は指数値がプラットフォームのint範囲外の場合に返されます
 	ErrExponentOutOfRange = errors.New("exponent out of range")
+	// ErrAuthorizationPending はデバイスフローでユーザーがまだ承認していない場合に返されます
+	ErrAuthorizationPending = errors.New("authorization pending")
+	// ErrSlowDown はデバイスフローのポーリング間隔が短すぎる場合に返されます
+	ErrSlowDown = errors.New("slow down")
+	// ErrExpiredDeviceCode はデバイスコードが期限切れの場合に返されます
+	ErrExpiredDeviceCode = errors.New("device code expired")
+	// ErrAccessDenied はユーザーがデバイスフローの認可を拒否した場合に返されます
+	ErrAccessDenied = errors.New("access denied")
+	// ErrRepositoryNotAccessible はOAuthトークンでリポジトリにアクセスできない場合に返されます
+	ErrRepositoryNotAccessible = errors.New("repository not accessible")
 )
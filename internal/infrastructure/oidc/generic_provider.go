@@ -0,0 +1,298 @@
+package oidc
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/na2na-p/cargohold/internal/domain"
+)
+
+const (
+	// GitLabIssuer はGitLab.comのCI/CDジョブが発行するIDトークンのIssuerです
+	GitLabIssuer = "https://gitlab.com"
+	// BuildkiteIssuer はBuildkiteエージェントが発行するOIDCトークンのIssuerです
+	BuildkiteIssuer = "https://agent.buildkite.com"
+	// BuildkiteJWKSURL はBuildkiteのJWKS Endpointです
+	BuildkiteJWKSURL = "https://agent.buildkite.com/.well-known/jwks"
+)
+
+// ClaimMapping はトークンのクレームからcargoholdのユーザー情報を組み立てるテンプレートです
+// {claim} の形式でクレームの値を埋め込みます。ネストしたクレームは {kubernetes.io/namespace} のように "/" で区切って指定します
+type ClaimMapping struct {
+	Subject    string
+	Repository string
+	Ref        string
+	Actor      string
+}
+
+// GenericOIDCProviderConfig はGenericOIDCProviderの設定です
+// Issuer・JWKSURL・Claimsの空のフィールドにはProviderTypeごとの既定値が使われます
+type GenericOIDCProviderConfig struct {
+	// Name はissuerを識別する名前です。JWKSのキャッシュキーとトレースの属性に使われます
+	Name         string
+	ProviderType domain.ProviderType
+	Issuer       string
+	JWKSURL      string
+	Audience     string
+	Claims       ClaimMapping
+}
+
+// GenericOIDCProvider はGitLab CI・Buildkite・KubernetesのServiceAccountなど、
+// 任意のissuerが発行したOIDCトークンを検証するプロバイダーです
+type GenericOIDCProvider struct {
+	name         string
+	providerType domain.ProviderType
+	jwtVerifier  *JWTVerifier
+	jwksURL      string
+	issuer       string
+	audience     string
+	subject      claimTemplate
+	repository   claimTemplate
+	ref          claimTemplate
+	actor        claimTemplate
+}
+
+// NewGenericOIDCProvider は新しいGenericOIDCProviderを作成します
+func NewGenericOIDCProvider(cfg GenericOIDCProviderConfig, cacheClient CacheClient) (*GenericOIDCProvider, error) {
+	if strings.TrimSpace(cfg.Name) == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+	audience := strings.TrimSpace(cfg.Audience)
+	if audience == "" {
+		return nil, fmt.Errorf("audience is required: name=%s", cfg.Name)
+	}
+	if cacheClient == nil {
+		return nil, fmt.Errorf("cacheClient is required")
+	}
+
+	cfg = withProviderDefaults(cfg)
+	if cfg.Issuer == "" {
+		return nil, fmt.Errorf("issuer is required: name=%s", cfg.Name)
+	}
+	if cfg.JWKSURL == "" {
+		return nil, fmt.Errorf("jwks url is required: name=%s", cfg.Name)
+	}
+	if cfg.Claims.Repository == "" {
+		return nil, fmt.Errorf("repository claim mapping is required: name=%s", cfg.Name)
+	}
+
+	p := &GenericOIDCProvider{
+		name:         cfg.Name,
+		providerType: cfg.ProviderType,
+		jwtVerifier:  NewJWTVerifier(cacheClient),
+		jwksURL:      cfg.JWKSURL,
+		issuer:       cfg.Issuer,
+		audience:     audience,
+	}
+	templates := []struct {
+		field    string
+		template string
+		dest     *claimTemplate
+	}{
+		{field: "subject", template: cfg.Claims.Subject, dest: &p.subject},
+		{field: "repository", template: cfg.Claims.Repository, dest: &p.repository},
+		{field: "ref", template: cfg.Claims.Ref, dest: &p.ref},
+		{field: "actor", template: cfg.Claims.Actor, dest: &p.actor},
+	}
+	for _, tmpl := range templates {
+		parsed, err := parseClaimTemplate(tmpl.template)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s claim mapping: name=%s: %w", tmpl.field, cfg.Name, err)
+		}
+		*tmpl.dest = parsed
+	}
+
+	return p, nil
+}
+
+// withProviderDefaults はProviderTypeごとの既定のissuer・JWKS URL・クレームマッピングで未設定の項目を補います
+func withProviderDefaults(cfg GenericOIDCProviderConfig) GenericOIDCProviderConfig {
+	var defaults GenericOIDCProviderConfig
+	switch cfg.ProviderType {
+	case domain.ProviderTypeGitLab:
+		defaults = GenericOIDCProviderConfig{
+			Issuer: GitLabIssuer,
+			Claims: ClaimMapping{
+				Repository: "{project_path}",
+				Ref:        "{ref_path}",
+				Actor:      "{user_login}",
+			},
+		}
+		// セルフホストのGitLabでもJWKSはissuer配下の同じパスで公開される
+		issuer := cfg.Issuer
+		if issuer == "" {
+			issuer = defaults.Issuer
+		}
+		defaults.JWKSURL = strings.TrimSuffix(issuer, "/") + "/oauth/discovery/keys"
+	case domain.ProviderTypeBuildkite:
+		defaults = GenericOIDCProviderConfig{
+			Issuer:  BuildkiteIssuer,
+			JWKSURL: BuildkiteJWKSURL,
+			Claims: ClaimMapping{
+				Repository: "{organization_slug}/{pipeline_slug}",
+				Ref:        "refs/heads/{build_branch}",
+			},
+		}
+	case domain.ProviderTypeKubernetes:
+		// KubernetesのissuerとJWKS URLはクラスターごとに異なるため既定値を持たない
+		defaults = GenericOIDCProviderConfig{
+			Claims: ClaimMapping{
+				Repository: "{kubernetes.io/namespace}/{kubernetes.io/serviceaccount/name}",
+				Actor:      "{sub}",
+			},
+		}
+	}
+
+	if cfg.Issuer == "" {
+		cfg.Issuer = defaults.Issuer
+	}
+	if cfg.JWKSURL == "" {
+		cfg.JWKSURL = defaults.JWKSURL
+	}
+	if cfg.Claims.Subject == "" {
+		cfg.Claims.Subject = "{sub}"
+	}
+	if cfg.Claims.Repository == "" {
+		cfg.Claims.Repository = defaults.Claims.Repository
+	}
+	if cfg.Claims.Ref == "" {
+		cfg.Claims.Ref = defaults.Claims.Ref
+	}
+	if cfg.Claims.Actor == "" {
+		cfg.Claims.Actor = defaults.Claims.Actor
+	}
+	return cfg
+}
+
+// Issuer はこのプロバイダーが受け付けるトークンのissuerを返します
+func (p *GenericOIDCProvider) Issuer() string {
+	return p.issuer
+}
+
+// VerifyIDToken はトークンの署名・issuer・audienceを検証し、クレームマッピングに従ってdomain.OIDCIdentityに変換します
+func (p *GenericOIDCProvider) VerifyIDToken(ctx context.Context, token string) (*domain.OIDCIdentity, error) {
+	verifiedToken, err := p.jwtVerifier.VerifyJWT(ctx, token, p.jwksURL, p.audience, p.issuer, p.name)
+	if err != nil {
+		return nil, fmt.Errorf("JWT検証に失敗しました: %w", err)
+	}
+
+	claims, ok := verifiedToken.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, fmt.Errorf("%w: claimsの取得に失敗しました", ErrInvalidToken)
+	}
+
+	sub, err := p.subject.render(claims)
+	if err != nil {
+		return nil, err
+	}
+	repository, err := p.repository.render(claims)
+	if err != nil {
+		return nil, err
+	}
+	ref, err := p.ref.render(claims)
+	if err != nil {
+		return nil, err
+	}
+	actor, err := p.actor.render(claims)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewOIDCIdentity(p.providerType, sub, repository, ref, actor), nil
+}
+
+// claimTemplate は解析済みのクレームマッピングのテンプレートです
+type claimTemplate []claimTemplateSegment
+
+// claimTemplateSegment は固定の文字列、またはクレームのパスのいずれかを表します
+type claimTemplateSegment struct {
+	literal string
+	path    []string
+}
+
+func parseClaimTemplate(template string) (claimTemplate, error) {
+	var segments claimTemplate
+	rest := template
+	for rest != "" {
+		start := strings.IndexByte(rest, '{')
+		if start < 0 {
+			if strings.IndexByte(rest, '}') >= 0 {
+				return nil, fmt.Errorf("unexpected '}' in %q", template)
+			}
+			segments = append(segments, claimTemplateSegment{literal: rest})
+			break
+		}
+		if start > 0 {
+			if strings.IndexByte(rest[:start], '}') >= 0 {
+				return nil, fmt.Errorf("unexpected '}' in %q", template)
+			}
+			segments = append(segments, claimTemplateSegment{literal: rest[:start]})
+		}
+		end := strings.IndexByte(rest[start:], '}')
+		if end < 0 {
+			return nil, fmt.Errorf("unclosed '{' in %q", template)
+		}
+		name := rest[start+1 : start+end]
+		if name == "" || strings.ContainsRune(name, '{') {
+			return nil, fmt.Errorf("invalid claim placeholder in %q", template)
+		}
+		path := strings.Split(name, "/")
+		for _, key := range path {
+			if key == "" {
+				return nil, fmt.Errorf("invalid claim placeholder in %q", template)
+			}
+		}
+		segments = append(segments, claimTemplateSegment{path: path})
+		rest = rest[start+end+1:]
+	}
+	return segments, nil
+}
+
+// render はクレームの値を埋め込んだ文字列を返します。参照するクレームが存在しない場合はErrInvalidTokenを返します
+func (t claimTemplate) render(claims jwt.MapClaims) (string, error) {
+	var b strings.Builder
+	for _, segment := range t {
+		if segment.path == nil {
+			b.WriteString(segment.literal)
+			continue
+		}
+		value, err := lookupClaim(claims, segment.path)
+		if err != nil {
+			return "", err
+		}
+		b.WriteString(value)
+	}
+	return b.String(), nil
+}
+
+func lookupClaim(claims jwt.MapClaims, path []string) (string, error) {
+	name := strings.Join(path, "/")
+	var current any = map[string]any(claims)
+	for _, key := range path {
+		object, ok := current.(map[string]any)
+		if !ok {
+			return "", fmt.Errorf("%w: %s claimが含まれていません", ErrInvalidToken, name)
+		}
+		current, ok = object[key]
+		if !ok {
+			return "", fmt.Errorf("%w: %s claimが含まれていません", ErrInvalidToken, name)
+		}
+	}
+
+	switch v := current.(type) {
+	case string:
+		if v == "" {
+			return "", fmt.Errorf("%w: %s claimが空です", ErrInvalidToken, name)
+		}
+		return v, nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	default:
+		return "", fmt.Errorf("%w: %s claimが文字列ではありません", ErrInvalidToken, name)
+	}
+}
@@ -0,0 +1,325 @@
+package oidc_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/go-cmp/cmp"
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/na2na-p/cargohold/internal/infrastructure/oidc"
+)
+
+func createTestClaimsJWT(t *testing.T, privateKey interface{}, keyID string, claims jwt.MapClaims) string {
+	t.Helper()
+
+	claims["exp"] = time.Now().Add(1 * time.Hour).Unix()
+	claims["iat"] = time.Now().Unix()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = keyID
+
+	tokenString, err := token.SignedString(privateKey)
+	if err != nil {
+		t.Fatalf("JWTトークンの署名に失敗しました: %v", err)
+	}
+	return tokenString
+}
+
+func TestGenericOIDCProvider_VerifyIDToken(t *testing.T) {
+	const issuer = "https://issuer.example.com"
+
+	tests := []struct {
+		name    string
+		config  oidc.GenericOIDCProviderConfig
+		claims  jwt.MapClaims
+		want    *domain.OIDCIdentity
+		wantErr error
+	}{
+		{
+			name: "正常系: GitLabの既定のマッピングでクレームが変換される",
+			config: oidc.GenericOIDCProviderConfig{
+				Name:         "gitlab",
+				ProviderType: domain.ProviderTypeGitLab,
+				Issuer:       issuer,
+			},
+			claims: jwt.MapClaims{
+				"iss":          issuer,
+				"aud":          "cargohold",
+				"sub":          "project_path:group/project:ref_type:branch:ref:main",
+				"project_path": "group/project",
+				"ref_path":     "refs/heads/main",
+				"user_login":   "gitlab-user",
+			},
+			want: domain.NewOIDCIdentity(
+				domain.ProviderTypeGitLab,
+				"project_path:group/project:ref_type:branch:ref:main",
+				"group/project",
+				"refs/heads/main",
+				"gitlab-user",
+			),
+		},
+		{
+			name: "正常系: Buildkiteの既定のマッピングで複数のクレームが連結される",
+			config: oidc.GenericOIDCProviderConfig{
+				Name:         "buildkite",
+				ProviderType: domain.ProviderTypeBuildkite,
+				Issuer:       issuer,
+			},
+			claims: jwt.MapClaims{
+				"iss":               issuer,
+				"aud":               "cargohold",
+				"sub":               "organization:acme:pipeline:assets:ref:refs/heads/main",
+				"organization_slug": "acme",
+				"pipeline_slug":     "assets",
+				"build_branch":      "main",
+			},
+			want: domain.NewOIDCIdentity(
+				domain.ProviderTypeBuildkite,
+				"organization:acme:pipeline:assets:ref:refs/heads/main",
+				"acme/assets",
+				"refs/heads/main",
+				"",
+			),
+		},
+		{
+			name: "正常系: Kubernetesの既定のマッピングでネストしたクレームが参照される",
+			config: oidc.GenericOIDCProviderConfig{
+				Name:         "kubernetes",
+				ProviderType: domain.ProviderTypeKubernetes,
+				Issuer:       issuer,
+			},
+			claims: jwt.MapClaims{
+				"iss": issuer,
+				"aud": "cargohold",
+				"sub": "system:serviceaccount:assets:builder",
+				"kubernetes.io": map[string]any{
+					"namespace":      "assets",
+					"serviceaccount": map[string]any{"name": "builder"},
+				},
+			},
+			want: domain.NewOIDCIdentity(
+				domain.ProviderTypeKubernetes,
+				"system:serviceaccount:assets:builder",
+				"assets/builder",
+				"",
+				"system:serviceaccount:assets:builder",
+			),
+		},
+		{
+			name: "正常系: 指定したマッピングが既定のマッピングより優先される",
+			config: oidc.GenericOIDCProviderConfig{
+				Name:         "gitlab",
+				ProviderType: domain.ProviderTypeGitLab,
+				Issuer:       issuer,
+				Claims: oidc.ClaimMapping{
+					Repository: "{namespace_path}/{project_id}",
+				},
+			},
+			claims: jwt.MapClaims{
+				"iss":            issuer,
+				"aud":            "cargohold",
+				"sub":            "project_path:group/project",
+				"namespace_path": "group",
+				"project_id":     float64(42),
+				"ref_path":       "refs/heads/main",
+				"user_login":     "gitlab-user",
+			},
+			want: domain.NewOIDCIdentity(
+				domain.ProviderTypeGitLab,
+				"project_path:group/project",
+				"group/42",
+				"refs/heads/main",
+				"gitlab-user",
+			),
+		},
+		{
+			name: "異常系: マッピングが参照するクレームがない場合、ErrInvalidTokenが返る",
+			config: oidc.GenericOIDCProviderConfig{
+				Name:         "gitlab",
+				ProviderType: domain.ProviderTypeGitLab,
+				Issuer:       issuer,
+			},
+			claims: jwt.MapClaims{
+				"iss":        issuer,
+				"aud":        "cargohold",
+				"sub":        "project_path:group/project",
+				"ref_path":   "refs/heads/main",
+				"user_login": "gitlab-user",
+			},
+			wantErr: oidc.ErrInvalidToken,
+		},
+		{
+			name: "異常系: issuerが一致しない場合、ErrInvalidIssuerが返る",
+			config: oidc.GenericOIDCProviderConfig{
+				Name:         "gitlab",
+				ProviderType: domain.ProviderTypeGitLab,
+				Issuer:       issuer,
+			},
+			claims: jwt.MapClaims{
+				"iss":          "https://other.example.com",
+				"aud":          "cargohold",
+				"sub":          "project_path:group/project",
+				"project_path": "group/project",
+				"ref_path":     "refs/heads/main",
+				"user_login":   "gitlab-user",
+			},
+			wantErr: oidc.ErrInvalidIssuer,
+		},
+		{
+			name: "異常系: audienceが一致しない場合、ErrInvalidAudienceが返る",
+			config: oidc.GenericOIDCProviderConfig{
+				Name:         "gitlab",
+				ProviderType: domain.ProviderTypeGitLab,
+				Issuer:       issuer,
+			},
+			claims: jwt.MapClaims{
+				"iss":          issuer,
+				"aud":          "other",
+				"sub":          "project_path:group/project",
+				"project_path": "group/project",
+				"ref_path":     "refs/heads/main",
+				"user_login":   "gitlab-user",
+			},
+			wantErr: oidc.ErrInvalidAudience,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			redisClient, _ := setupRedisMock(t)
+
+			privateKey := generateTestRSAKey(t)
+			keyID := "test-key-id"
+			jwkSet := createMockJWKSet(t, keyID, &privateKey.PublicKey)
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(jwkSet)
+			}))
+			defer server.Close()
+
+			cfg := tt.config
+			cfg.JWKSURL = server.URL
+			cfg.Audience = "cargohold"
+			provider, err := oidc.NewGenericOIDCProvider(cfg, redisClient)
+			if err != nil {
+				t.Fatalf("GenericOIDCProviderの作成に失敗しました: %v", err)
+			}
+
+			got, err := provider.VerifyIDToken(context.Background(), createTestClaimsJWT(t, privateKey, keyID, tt.claims))
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("VerifyIDToken() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("VerifyIDToken() unexpected error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(domain.OIDCIdentity{}, domain.ProviderType{})); diff != "" {
+				t.Errorf("VerifyIDToken() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewGenericOIDCProvider(t *testing.T) {
+	redisClient, _ := setupRedisMock(t)
+
+	tests := []struct {
+		name       string
+		config     oidc.GenericOIDCProviderConfig
+		wantIssuer string
+		wantErr    bool
+	}{
+		{
+			name: "正常系: GitLabでissuerを省略した場合、GitLab.comのissuerが使われる",
+			config: oidc.GenericOIDCProviderConfig{
+				Name:         "gitlab",
+				ProviderType: domain.ProviderTypeGitLab,
+				Audience:     "cargohold",
+			},
+			wantIssuer: oidc.GitLabIssuer,
+		},
+		{
+			name: "正常系: Buildkiteでissuerを省略した場合、Buildkiteのissuerが使われる",
+			config: oidc.GenericOIDCProviderConfig{
+				Name:         "buildkite",
+				ProviderType: domain.ProviderTypeBuildkite,
+				Audience:     "cargohold",
+			},
+			wantIssuer: oidc.BuildkiteIssuer,
+		},
+		{
+			name: "異常系: Kubernetesでissuerを省略した場合、エラーが返る",
+			config: oidc.GenericOIDCProviderConfig{
+				Name:         "kubernetes",
+				ProviderType: domain.ProviderTypeKubernetes,
+				Audience:     "cargohold",
+				JWKSURL:      "https://kubernetes.default.svc/openid/v1/jwks",
+			},
+			wantErr: true,
+		},
+		{
+			name: "異常系: 汎用のOIDCでリポジトリのマッピングを省略した場合、エラーが返る",
+			config: oidc.GenericOIDCProviderConfig{
+				Name:         "custom",
+				ProviderType: domain.ProviderTypeOIDC,
+				Issuer:       "https://issuer.example.com",
+				JWKSURL:      "https://issuer.example.com/jwks",
+				Audience:     "cargohold",
+			},
+			wantErr: true,
+		},
+		{
+			name: "異常系: audienceが空の場合、エラーが返る",
+			config: oidc.GenericOIDCProviderConfig{
+				Name:         "gitlab",
+				ProviderType: domain.ProviderTypeGitLab,
+			},
+			wantErr: true,
+		},
+		{
+			name: "異常系: マッピングの括弧が閉じられていない場合、エラーが返る",
+			config: oidc.GenericOIDCProviderConfig{
+				Name:         "gitlab",
+				ProviderType: domain.ProviderTypeGitLab,
+				Audience:     "cargohold",
+				Claims:       oidc.ClaimMapping{Repository: "{project_path"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "異常系: マッピングのクレーム名が空の場合、エラーが返る",
+			config: oidc.GenericOIDCProviderConfig{
+				Name:         "gitlab",
+				ProviderType: domain.ProviderTypeGitLab,
+				Audience:     "cargohold",
+				Claims:       oidc.ClaimMapping{Repository: "{}/{project_path}"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := oidc.NewGenericOIDCProvider(tt.config, redisClient)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("NewGenericOIDCProvider() error = nil, wantErr")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewGenericOIDCProvider() unexpected error = %v", err)
+			}
+			if got.Issuer() != tt.wantIssuer {
+				t.Errorf("Issuer() = %q, want %q", got.Issuer(), tt.wantIssuer)
+			}
+		})
+	}
+}
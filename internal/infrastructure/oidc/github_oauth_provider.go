@@ -2,15 +2,17 @@ package oidc
 
 import (
 	"context"
+	"time"
 
 	"github.com/na2na-p/cargohold/internal/domain"
 )
 
 const (
-	GitHubOAuthAuthorizeURL = "https://github.com/login/oauth/authorize"
-	GitHubOAuthTokenURL     = "https://github.com/login/oauth/access_token"
-	GitHubAPIUserURL        = "https://api.github.com/user"
-	GitHubAPIBaseURL        = "https://api.github.com"
+	GitHubOAuthAuthorizeURL  = "https://github.com/login/oauth/authorize"
+	GitHubOAuthTokenURL      = "https://github.com/login/oauth/access_token"
+	GitHubOAuthDeviceCodeURL = "https://github.com/login/device/code"
+	GitHubAPIUserURL         = "https://api.github.com/user"
+	GitHubAPIBaseURL         = "https://api.github.com"
 )
 
 type oauthToken struct {
@@ -19,6 +21,14 @@ type oauthToken struct {
 	Scope       string
 }
 
+type deviceCode struct {
+	DeviceCode      string
+	UserCode        string
+	VerificationURI string
+	ExpiresIn       time.Duration
+	Interval        time.Duration
+}
+
 type gitHubUser struct {
 	ID    int64
 	Login string
@@ -31,8 +41,8 @@ type GitHubOAuthProvider struct {
 	repositoryChecker *gitHubRepositoryChecker
 }
 
-func NewGitHubOAuthProvider(clientID, clientSecret, redirectURI string) (*GitHubOAuthProvider, error) {
-	tokenExchanger, err := NewGitHubTokenExchanger(clientID, clientSecret, redirectURI)
+func NewGitHubOAuthProvider(clientID, clientSecret string) (*GitHubOAuthProvider, error) {
+	tokenExchanger, err := NewGitHubTokenExchanger(clientID, clientSecret)
 	if err != nil {
 		return nil, err
 	}
@@ -48,6 +58,10 @@ func (p *GitHubOAuthProvider) SetTokenEndpoint(endpoint string) {
 	p.tokenExchanger.SetTokenEndpoint(endpoint)
 }
 
+func (p *GitHubOAuthProvider) SetDeviceCodeEndpoint(endpoint string) {
+	p.tokenExchanger.SetDeviceCodeEndpoint(endpoint)
+}
+
 func (p *GitHubOAuthProvider) SetUserInfoEndpoint(endpoint string) {
 	p.userInfoProvider.SetUserInfoEndpoint(endpoint)
 }
@@ -56,16 +70,20 @@ func (p *GitHubOAuthProvider) SetAPIEndpoint(endpoint string) {
 	p.repositoryChecker.SetAPIEndpoint(endpoint)
 }
 
-func (p *GitHubOAuthProvider) SetRedirectURI(redirectURI string) {
-	p.tokenExchanger.SetRedirectURI(redirectURI)
+func (p *GitHubOAuthProvider) GetAuthorizationURL(state, redirectURI, codeChallenge string) string {
+	return p.tokenExchanger.GetAuthorizationURL(state, redirectURI, codeChallenge)
+}
+
+func (p *GitHubOAuthProvider) ExchangeCode(ctx context.Context, code, redirectURI, codeVerifier string) (*oauthToken, error) {
+	return p.tokenExchanger.ExchangeCode(ctx, code, redirectURI, codeVerifier)
 }
 
-func (p *GitHubOAuthProvider) GetAuthorizationURL(state string) string {
-	return p.tokenExchanger.GetAuthorizationURL(state)
+func (p *GitHubOAuthProvider) RequestDeviceCode(ctx context.Context) (*deviceCode, error) {
+	return p.tokenExchanger.RequestDeviceCode(ctx)
 }
 
-func (p *GitHubOAuthProvider) ExchangeCode(ctx context.Context, code string) (*oauthToken, error) {
-	return p.tokenExchanger.ExchangeCode(ctx, code)
+func (p *GitHubOAuthProvider) PollDeviceToken(ctx context.Context, code string) (*oauthToken, error) {
+	return p.tokenExchanger.PollDeviceToken(ctx, code)
 }
 
 func (p *GitHubOAuthProvider) GetUserInfo(ctx context.Context, token *oauthToken) (*gitHubUser, error) {
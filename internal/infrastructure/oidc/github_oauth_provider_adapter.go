@@ -3,15 +3,18 @@ package oidc
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/na2na-p/cargohold/internal/domain"
 	"github.com/na2na-p/cargohold/internal/usecase"
 )
 
 type GitHubOAuthProviderInternal interface {
-	SetRedirectURI(redirectURI string)
-	GetAuthorizationURL(state string) string
-	ExchangeCode(ctx context.Context, code string) (*oauthToken, error)
+	GetAuthorizationURL(state, redirectURI, codeChallenge string) string
+	ExchangeCode(ctx context.Context, code, redirectURI, codeVerifier string) (*oauthToken, error)
+	RequestDeviceCode(ctx context.Context) (*deviceCode, error)
+	PollDeviceToken(ctx context.Context, code string) (*oauthToken, error)
 	GetUserInfo(ctx context.Context, token *oauthToken) (*gitHubUser, error)
 	CanAccessRepository(ctx context.Context, token *oauthToken, repo *domain.RepositoryIdentifier) (bool, error)
 	GetRepositoryPermissions(ctx context.Context, token *oauthToken, repo *domain.RepositoryIdentifier) (domain.RepositoryPermissions, error)
@@ -30,19 +33,53 @@ func NewGitHubOAuthProviderAdapter(provider GitHubOAuthProviderInternal) *GitHub
 	}
 }
 
-func (a *GitHubOAuthProviderAdapter) SetRedirectURI(redirectURI string) {
-	a.provider.SetRedirectURI(redirectURI)
+func (a *GitHubOAuthProviderAdapter) GetAuthorizationURL(state, redirectURI, codeChallenge string) string {
+	return a.provider.GetAuthorizationURL(state, redirectURI, codeChallenge)
 }
 
-func (a *GitHubOAuthProviderAdapter) GetAuthorizationURL(state string) string {
-	return a.provider.GetAuthorizationURL(state)
+func (a *GitHubOAuthProviderAdapter) ExchangeCode(ctx context.Context, code, redirectURI, codeVerifier string) (*usecase.OAuthTokenResult, error) {
+	token, err := a.provider.ExchangeCode(ctx, code, redirectURI, codeVerifier)
+	if err != nil {
+		return nil, err
+	}
+	return &usecase.OAuthTokenResult{
+		AccessToken: token.AccessToken,
+		TokenType:   token.TokenType,
+		Scope:       token.Scope,
+	}, nil
 }
 
-func (a *GitHubOAuthProviderAdapter) ExchangeCode(ctx context.Context, code string) (*usecase.OAuthTokenResult, error) {
-	token, err := a.provider.ExchangeCode(ctx, code)
+func (a *GitHubOAuthProviderAdapter) RequestDeviceCode(ctx context.Context) (*usecase.DeviceCodeResult, error) {
+	code, err := a.provider.RequestDeviceCode(ctx)
 	if err != nil {
 		return nil, err
 	}
+	return &usecase.DeviceCodeResult{
+		DeviceCode:      code.DeviceCode,
+		UserCode:        code.UserCode,
+		VerificationURI: code.VerificationURI,
+		ExpiresIn:       code.ExpiresIn,
+		Interval:        code.Interval,
+	}, nil
+}
+
+// PollDeviceToken はデバイスフローの状態を表すエラーをusecaseのエラーに変換して返す
+func (a *GitHubOAuthProviderAdapter) PollDeviceToken(ctx context.Context, deviceCode string) (*usecase.OAuthTokenResult, error) {
+	token, err := a.provider.PollDeviceToken(ctx, deviceCode)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrAuthorizationPending):
+			return nil, usecase.ErrAuthorizationPending
+		case errors.Is(err, ErrSlowDown):
+			return nil, usecase.ErrSlowDown
+		case errors.Is(err, ErrExpiredDeviceCode):
+			return nil, fmt.Errorf("%w: %v", usecase.ErrInvalidDeviceCode, err)
+		case errors.Is(err, ErrAccessDenied):
+			return nil, usecase.ErrDeviceAuthorizationDenied
+		default:
+			return nil, err
+		}
+	}
 	return &usecase.OAuthTokenResult{
 		AccessToken: token.AccessToken,
 		TokenType:   token.TokenType,
@@ -82,5 +119,9 @@ func (a *GitHubOAuthProviderAdapter) GetRepositoryPermissions(ctx context.Contex
 		TokenType:   token.TokenType,
 		Scope:       token.Scope,
 	}
-	return a.provider.GetRepositoryPermissions(ctx, internalToken, repo)
+	permissions, err := a.provider.GetRepositoryPermissions(ctx, internalToken, repo)
+	if errors.Is(err, ErrRepositoryNotAccessible) {
+		return domain.RepositoryPermissions{}, fmt.Errorf("%w: %v", usecase.ErrRepositoryAccessDenied, err)
+	}
+	return permissions, err
 }
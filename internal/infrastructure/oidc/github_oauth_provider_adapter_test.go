@@ -3,6 +3,7 @@ package oidc
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -86,7 +87,9 @@ func TestGitHubOAuthProviderAdapter_GetAuthorizationURL(t *testing.T) {
 		setupMock func(ctrl *gomock.Controller) *MockGitHubOAuthProviderInternal
 	}
 	type args struct {
-		state string
+		state         string
+		redirectURI   string
+		codeChallenge string
 	}
 	tests := []struct {
 		name   string
@@ -99,12 +102,14 @@ func TestGitHubOAuthProviderAdapter_GetAuthorizationURL(t *testing.T) {
 			fields: fields{
 				setupMock: func(ctrl *gomock.Controller) *MockGitHubOAuthProviderInternal {
 					mock := NewMockGitHubOAuthProviderInternal(ctrl)
-					mock.EXPECT().GetAuthorizationURL("test-state").Return("https://github.com/login/oauth/authorize?state=test-state")
+					mock.EXPECT().GetAuthorizationURL("test-state", "https://example.com/callback", "test-challenge").Return("https://github.com/login/oauth/authorize?state=test-state")
 					return mock
 				},
 			},
 			args: args{
-				state: "test-state",
+				state:         "test-state",
+				redirectURI:   "https://example.com/callback",
+				codeChallenge: "test-challenge",
 			},
 			want: "https://github.com/login/oauth/authorize?state=test-state",
 		},
@@ -113,12 +118,14 @@ func TestGitHubOAuthProviderAdapter_GetAuthorizationURL(t *testing.T) {
 			fields: fields{
 				setupMock: func(ctrl *gomock.Controller) *MockGitHubOAuthProviderInternal {
 					mock := NewMockGitHubOAuthProviderInternal(ctrl)
-					mock.EXPECT().GetAuthorizationURL("state-another").Return("https://github.com/login/oauth/authorize?state=state-another")
+					mock.EXPECT().GetAuthorizationURL("state-another", "https://other.example.com/callback", "another-challenge").Return("https://github.com/login/oauth/authorize?state=state-another")
 					return mock
 				},
 			},
 			args: args{
-				state: "state-another",
+				state:         "state-another",
+				redirectURI:   "https://other.example.com/callback",
+				codeChallenge: "another-challenge",
 			},
 			want: "https://github.com/login/oauth/authorize?state=state-another",
 		},
@@ -132,7 +139,7 @@ func TestGitHubOAuthProviderAdapter_GetAuthorizationURL(t *testing.T) {
 			mockProvider := tt.fields.setupMock(ctrl)
 			adapter := NewGitHubOAuthProviderAdapter(mockProvider)
 
-			got := adapter.GetAuthorizationURL(tt.args.state)
+			got := adapter.GetAuthorizationURL(tt.args.state, tt.args.redirectURI, tt.args.codeChallenge)
 
 			if got != tt.want {
 				t.Errorf("GetAuthorizationURL() = %v, want %v", got, tt.want)
@@ -161,7 +168,7 @@ func TestGitHubOAuthProviderAdapter_ExchangeCode(t *testing.T) {
 			fields: fields{
 				setupMock: func(ctrl *gomock.Controller) *MockGitHubOAuthProviderInternal {
 					mock := NewMockGitHubOAuthProviderInternal(ctrl)
-					mock.EXPECT().ExchangeCode(gomock.Any(), "valid-code").Return(&oauthToken{
+					mock.EXPECT().ExchangeCode(gomock.Any(), "valid-code", "https://example.com/callback", "test-verifier").Return(&oauthToken{
 						AccessToken: "gho_test_token",
 						TokenType:   "bearer",
 						Scope:       "read:user,repo",
@@ -185,7 +192,7 @@ func TestGitHubOAuthProviderAdapter_ExchangeCode(t *testing.T) {
 			fields: fields{
 				setupMock: func(ctrl *gomock.Controller) *MockGitHubOAuthProviderInternal {
 					mock := NewMockGitHubOAuthProviderInternal(ctrl)
-					mock.EXPECT().ExchangeCode(gomock.Any(), "invalid-code").Return(nil, errors.New("invalid code"))
+					mock.EXPECT().ExchangeCode(gomock.Any(), "invalid-code", "https://example.com/callback", "test-verifier").Return(nil, errors.New("invalid code"))
 					return mock
 				},
 			},
@@ -206,7 +213,7 @@ func TestGitHubOAuthProviderAdapter_ExchangeCode(t *testing.T) {
 			mockProvider := tt.fields.setupMock(ctrl)
 			adapter := NewGitHubOAuthProviderAdapter(mockProvider)
 
-			got, err := adapter.ExchangeCode(tt.args.ctx, tt.args.code)
+			got, err := adapter.ExchangeCode(tt.args.ctx, tt.args.code, "https://example.com/callback", "test-verifier")
 
 			if tt.wantErr != nil {
 				if err == nil {
@@ -229,6 +236,89 @@ func TestGitHubOAuthProviderAdapter_ExchangeCode(t *testing.T) {
 	}
 }
 
+func TestGitHubOAuthProviderAdapter_PollDeviceToken(t *testing.T) {
+	tests := []struct {
+		name      string
+		setupMock func(ctrl *gomock.Controller) *MockGitHubOAuthProviderInternal
+		want      *usecase.OAuthTokenResult
+		wantErr   error
+	}{
+		{
+			name: "正常系: 承認済みの場合、OAuthTokenResultに変換される",
+			setupMock: func(ctrl *gomock.Controller) *MockGitHubOAuthProviderInternal {
+				mock := NewMockGitHubOAuthProviderInternal(ctrl)
+				mock.EXPECT().PollDeviceToken(gomock.Any(), "device-code").Return(&oauthToken{
+					AccessToken: "gho_test_token",
+					TokenType:   "bearer",
+				}, nil)
+				return mock
+			},
+			want: &usecase.OAuthTokenResult{
+				AccessToken: "gho_test_token",
+				TokenType:   "bearer",
+			},
+		},
+		{
+			name: "異常系: 承認待ちの場合、usecase.ErrAuthorizationPendingが返る",
+			setupMock: func(ctrl *gomock.Controller) *MockGitHubOAuthProviderInternal {
+				mock := NewMockGitHubOAuthProviderInternal(ctrl)
+				mock.EXPECT().PollDeviceToken(gomock.Any(), "device-code").Return(nil, ErrAuthorizationPending)
+				return mock
+			},
+			wantErr: usecase.ErrAuthorizationPending,
+		},
+		{
+			name: "異常系: ポーリング間隔が短すぎる場合、usecase.ErrSlowDownが返る",
+			setupMock: func(ctrl *gomock.Controller) *MockGitHubOAuthProviderInternal {
+				mock := NewMockGitHubOAuthProviderInternal(ctrl)
+				mock.EXPECT().PollDeviceToken(gomock.Any(), "device-code").Return(nil, ErrSlowDown)
+				return mock
+			},
+			wantErr: usecase.ErrSlowDown,
+		},
+		{
+			name: "異常系: デバイスコードが期限切れの場合、usecase.ErrInvalidDeviceCodeが返る",
+			setupMock: func(ctrl *gomock.Controller) *MockGitHubOAuthProviderInternal {
+				mock := NewMockGitHubOAuthProviderInternal(ctrl)
+				mock.EXPECT().PollDeviceToken(gomock.Any(), "device-code").Return(nil, ErrExpiredDeviceCode)
+				return mock
+			},
+			wantErr: usecase.ErrInvalidDeviceCode,
+		},
+		{
+			name: "異常系: ユーザーが拒否した場合、usecase.ErrDeviceAuthorizationDeniedが返る",
+			setupMock: func(ctrl *gomock.Controller) *MockGitHubOAuthProviderInternal {
+				mock := NewMockGitHubOAuthProviderInternal(ctrl)
+				mock.EXPECT().PollDeviceToken(gomock.Any(), "device-code").Return(nil, ErrAccessDenied)
+				return mock
+			},
+			wantErr: usecase.ErrDeviceAuthorizationDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			adapter := NewGitHubOAuthProviderAdapter(tt.setupMock(ctrl))
+
+			got, err := adapter.PollDeviceToken(context.Background(), "device-code")
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("PollDeviceToken() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("PollDeviceToken() unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("PollDeviceToken() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestGitHubOAuthProviderAdapter_GetUserInfo(t *testing.T) {
 	type fields struct {
 		setupMock func(ctrl *gomock.Controller) *MockGitHubOAuthProviderInternal
@@ -539,6 +629,30 @@ func TestGitHubOAuthProviderAdapter_GetRepositoryPermissions(t *testing.T) {
 			wantCanDown:   false,
 			wantErr:       errors.New("server error"),
 		},
+		{
+			name: "異常系: リポジトリにアクセスできない場合、ErrRepositoryAccessDeniedでラップされたエラーが返される",
+			fields: fields{
+				setupMock: func(ctrl *gomock.Controller) *MockGitHubOAuthProviderInternal {
+					mock := NewMockGitHubOAuthProviderInternal(ctrl)
+					mock.EXPECT().GetRepositoryPermissions(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+						domain.RepositoryPermissions{},
+						fmt.Errorf("%w: status=404", ErrRepositoryNotAccessible),
+					)
+					return mock
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				token: &usecase.OAuthTokenResult{
+					AccessToken: "gho_test_token",
+					TokenType:   "bearer",
+				},
+				repo: mustCreateRepositoryIdentifier(t, "owner/repo"),
+			},
+			wantCanUpload: false,
+			wantCanDown:   false,
+			wantErr:       fmt.Errorf("%w: repository not accessible: status=404", usecase.ErrRepositoryAccessDenied),
+		},
 	}
 
 	for _, tt := range tests {
@@ -588,11 +702,19 @@ func TestGitHubOAuthProviderAdapter_ImplementsInterface(t *testing.T) {
 
 type mockInternalProvider struct{}
 
-func (m *mockInternalProvider) GetAuthorizationURL(state string) string {
+func (m *mockInternalProvider) GetAuthorizationURL(state, redirectURI, codeChallenge string) string {
 	return ""
 }
 
-func (m *mockInternalProvider) ExchangeCode(ctx context.Context, code string) (*oauthToken, error) {
+func (m *mockInternalProvider) ExchangeCode(ctx context.Context, code, redirectURI, codeVerifier string) (*oauthToken, error) {
+	return nil, nil
+}
+
+func (m *mockInternalProvider) RequestDeviceCode(ctx context.Context) (*deviceCode, error) {
+	return nil, nil
+}
+
+func (m *mockInternalProvider) PollDeviceToken(ctx context.Context, code string) (*oauthToken, error) {
 	return nil, nil
 }
 
@@ -608,9 +730,6 @@ func (m *mockInternalProvider) GetRepositoryPermissions(ctx context.Context, tok
 	return domain.RepositoryPermissions{}, nil
 }
 
-func (m *mockInternalProvider) SetRedirectURI(redirectURI string) {
-}
-
 func mustCreateRepositoryIdentifier(t *testing.T, fullName string) *domain.RepositoryIdentifier {
 	t.Helper()
 	repo, err := domain.NewRepositoryIdentifier(fullName)
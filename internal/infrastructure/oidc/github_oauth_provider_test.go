@@ -16,42 +16,31 @@ func TestNewGitHubOAuthProvider(t *testing.T) {
 		name         string
 		clientID     string
 		clientSecret string
-		redirectURI  string
 		wantErr      bool
 	}{
 		{
 			name:         "正常系: 全パラメータが正しい場合、プロバイダーが作成される",
 			clientID:     "test-client-id",
 			clientSecret: "test-client-secret",
-			redirectURI:  "http://localhost:8080/callback",
 			wantErr:      false,
 		},
 		{
 			name:         "異常系: clientIDが空の場合、エラーが返る",
 			clientID:     "",
 			clientSecret: "test-client-secret",
-			redirectURI:  "http://localhost:8080/callback",
 			wantErr:      true,
 		},
 		{
 			name:         "異常系: clientSecretが空の場合、エラーが返る",
 			clientID:     "test-client-id",
 			clientSecret: "",
-			redirectURI:  "http://localhost:8080/callback",
 			wantErr:      true,
 		},
-		{
-			name:         "正常系: redirectURIが空の場合も動的設定可能でプロバイダーが作成される",
-			clientID:     "test-client-id",
-			clientSecret: "test-client-secret",
-			redirectURI:  "",
-			wantErr:      false,
-		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			provider, err := NewGitHubOAuthProvider(tt.clientID, tt.clientSecret, tt.redirectURI)
+			provider, err := NewGitHubOAuthProvider(tt.clientID, tt.clientSecret)
 
 			if tt.wantErr {
 				if err == nil {
@@ -83,8 +72,10 @@ func TestGitHubOAuthProvider_GetAuthorizationURL(t *testing.T) {
 			wantContains: []string{
 				"https://github.com/login/oauth/authorize",
 				"client_id=test-client-id",
-				"redirect_uri=",
+				"redirect_uri=http%3A%2F%2Flocalhost%3A8080%2Fcallback",
 				"state=test-state-123",
+				"code_challenge=test-challenge",
+				"code_challenge_method=S256",
 			},
 		},
 		{
@@ -100,12 +91,12 @@ func TestGitHubOAuthProvider_GetAuthorizationURL(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			provider, err := NewGitHubOAuthProvider("test-client-id", "test-client-secret", "http://localhost:8080/callback")
+			provider, err := NewGitHubOAuthProvider("test-client-id", "test-client-secret")
 			if err != nil {
 				t.Fatalf("プロバイダー作成に失敗: %v", err)
 			}
 
-			authURL := provider.GetAuthorizationURL(tt.state)
+			authURL := provider.GetAuthorizationURL(tt.state, "http://localhost:8080/callback", "test-challenge")
 
 			for _, want := range tt.wantContains {
 				if !containsString(authURL, want) {
@@ -180,7 +171,7 @@ func TestGitHubOAuthProvider_ExchangeCode(t *testing.T) {
 			server := httptest.NewServer(http.HandlerFunc(tt.serverResponse))
 			defer server.Close()
 
-			provider, err := NewGitHubOAuthProvider("test-client-id", "test-client-secret", "http://localhost:8080/callback")
+			provider, err := NewGitHubOAuthProvider("test-client-id", "test-client-secret")
 			if err != nil {
 				t.Fatalf("プロバイダー作成に失敗: %v", err)
 			}
@@ -188,7 +179,7 @@ func TestGitHubOAuthProvider_ExchangeCode(t *testing.T) {
 			provider.SetTokenEndpoint(server.URL)
 
 			ctx := context.Background()
-			got, err := provider.ExchangeCode(ctx, tt.code)
+			got, err := provider.ExchangeCode(ctx, tt.code, "http://localhost:8080/callback", "test-verifier")
 
 			if tt.wantErr {
 				if err == nil {
@@ -289,7 +280,7 @@ func TestGitHubOAuthProvider_GetUserInfo(t *testing.T) {
 			server := httptest.NewServer(http.HandlerFunc(tt.serverResponse))
 			defer server.Close()
 
-			provider, err := NewGitHubOAuthProvider("test-client-id", "test-client-secret", "http://localhost:8080/callback")
+			provider, err := NewGitHubOAuthProvider("test-client-id", "test-client-secret")
 			if err != nil {
 				t.Fatalf("プロバイダー作成に失敗: %v", err)
 			}
@@ -420,7 +411,7 @@ func TestGitHubOAuthProvider_CanAccessRepository(t *testing.T) {
 			server := httptest.NewServer(http.HandlerFunc(tt.serverResponse))
 			defer server.Close()
 
-			provider, err := NewGitHubOAuthProvider("test-client-id", "test-client-secret", "http://localhost:8080/callback")
+			provider, err := NewGitHubOAuthProvider("test-client-id", "test-client-secret")
 			if err != nil {
 				t.Fatalf("プロバイダー作成に失敗: %v", err)
 			}
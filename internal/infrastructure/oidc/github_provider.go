@@ -13,7 +13,7 @@ const (
 	// GitHubJWKSURL はGitHub ActionsのJWKS Endpointです
 	GitHubJWKSURL = "https://token.actions.githubusercontent.com/.well-known/jwks"
 	// GitHubIssuer はGitHub ActionsのIssuerです
-	GitHubIssuer = "https://token.actions.githubusercontent.com"
+	GitHubIssuer = domain.GitHubActionsIssuer
 )
 
 // githubUserClaims はGitHub ActionsのJWTトークンから取得したクレーム情報を表します
@@ -23,6 +23,10 @@ type githubUserClaims struct {
 	repository string // リポジトリ: owner/repo
 	ref        string // ブランチ/タグ: refs/heads/main
 	actor      string // GitHub Actor
+	// 以下はクレームルールの評価に使用する任意のクレーム。トークンに含まれない場合は空文字
+	eventName      string // ワークフローを起動したイベント: push, pull_request など
+	environment    string // ジョブが参照するEnvironment
+	jobWorkflowRef string // ジョブを定義したワークフロー: owner/repo/.github/workflows/x.yml@ref
 }
 
 // GitHubOIDCProvider はGitHub Actions向けのOIDCプロバイダーです
@@ -100,15 +104,22 @@ func (p *GitHubOIDCProvider) VerifyIDToken(ctx context.Context, token string) (*
 		return nil, fmt.Errorf("%w: actor claimが含まれていません", ErrInvalidToken)
 	}
 
+	claims.eventName, _ = jwtClaims["event_name"].(string)
+	claims.environment, _ = jwtClaims["environment"].(string)
+	claims.jobWorkflowRef, _ = jwtClaims["job_workflow_ref"].(string)
+
 	return toGitHubDomainUserInfo(claims), nil
 }
 
 // toGitHubDomainUserInfo はgithubUserClaimsをdomain.GitHubUserInfoに変換します
 func toGitHubDomainUserInfo(claims *githubUserClaims) *domain.GitHubUserInfo {
-	return domain.NewGitHubUserInfo(
+	return domain.NewGitHubUserInfoWithWorkflow(
 		claims.sub,
 		claims.repository,
 		claims.ref,
 		claims.actor,
+		claims.eventName,
+		claims.environment,
+		claims.jobWorkflowRef,
 	)
 }
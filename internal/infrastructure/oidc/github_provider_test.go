@@ -70,6 +70,46 @@ func TestGitHubOIDCProvider_VerifyIDToken(t *testing.T) {
 			),
 			wantErr: nil,
 		},
+		{
+			name: "正常系: ワークフローのクレームが含まれる場合はGitHubUserInfoに設定される",
+			createToken: func(t *testing.T, privateKey interface{}, keyID string) string {
+				repository := "na2na-p/test-repo"
+				claims := jwt.MapClaims{
+					"iss":              oidc.GitHubIssuer,
+					"aud":              "cargohold",
+					"sub":              "repo:" + repository + ":environment:production",
+					"repository":       repository,
+					"ref":              "refs/tags/v1.0.0",
+					"actor":            "test-user",
+					"event_name":       "push",
+					"environment":      "production",
+					"job_workflow_ref": "na2na-p/workflows/.github/workflows/release.yml@refs/heads/main",
+					"exp":              time.Now().Add(1 * time.Hour).Unix(),
+					"nbf":              time.Now().Add(-1 * time.Minute).Unix(),
+					"iat":              time.Now().Unix(),
+				}
+
+				token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+				token.Header["kid"] = keyID
+
+				tokenString, err := token.SignedString(privateKey)
+				if err != nil {
+					t.Fatalf("JWTトークンの署名に失敗しました: %v", err)
+				}
+
+				return tokenString
+			},
+			want: domain.NewGitHubUserInfoWithWorkflow(
+				"repo:na2na-p/test-repo:environment:production",
+				"na2na-p/test-repo",
+				"refs/tags/v1.0.0",
+				"test-user",
+				"push",
+				"production",
+				"na2na-p/workflows/.github/workflows/release.yml@refs/heads/main",
+			),
+			wantErr: nil,
+		},
 		{
 			name: "異常系: 不正なissuer",
 			createToken: func(t *testing.T, privateKey interface{}, keyID string) string {
@@ -252,7 +292,7 @@ func TestRepositoryAllowlist_IsAllowed(t *testing.T) {
 			}
 
 			pgRepo := tt.setupPgMock(ctrl)
-			allowlist := infrastructure.NewCachingRepositoryAllowlist(pgRepo, redisClient)
+			allowlist := infrastructure.NewCachingRepositoryAllowlist(pgRepo, redisClient, nil)
 
 			ctx := context.Background()
 			allowedRepo := mustNewAllowedRepository(t, tt.args.owner, tt.args.repo)
@@ -307,7 +347,7 @@ func TestRepositoryAllowlist_Add(t *testing.T) {
 			redisClient, mock := setupRedisMock(t)
 
 			pgRepo := tt.setupPgMock(ctrl)
-			allowlist := infrastructure.NewCachingRepositoryAllowlist(pgRepo, redisClient)
+			allowlist := infrastructure.NewCachingRepositoryAllowlist(pgRepo, redisClient, nil)
 
 			if tt.setupRedisMock != nil {
 				tt.setupRedisMock(t, mock)
@@ -374,7 +414,7 @@ func TestRepositoryAllowlist_Remove(t *testing.T) {
 			redisClient, mock := setupRedisMock(t)
 
 			pgRepo := tt.setupPgMock(ctrl)
-			allowlist := infrastructure.NewCachingRepositoryAllowlist(pgRepo, redisClient)
+			allowlist := infrastructure.NewCachingRepositoryAllowlist(pgRepo, redisClient, nil)
 
 			if tt.setupRedisMock != nil {
 				tt.setupRedisMock(t, mock)
@@ -102,6 +102,10 @@ func (c *gitHubRepositoryChecker) GetRepositoryPermissions(ctx context.Context,
 	}
 	defer func() { _ = resp.Body.Close() }()
 
+	// 403はレート制限でも返るため、アクセス権を失ったとは判断しない
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusNotFound {
+		return domain.RepositoryPermissions{}, fmt.Errorf("%w: status=%d", ErrRepositoryNotAccessible, resp.StatusCode)
+	}
 	if resp.StatusCode != http.StatusOK {
 		return domain.RepositoryPermissions{}, fmt.Errorf("リポジトリ権限取得に失敗しました: status=%d", resp.StatusCode)
 	}
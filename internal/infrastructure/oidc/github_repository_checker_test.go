@@ -3,6 +3,7 @@ package oidc
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -174,6 +175,7 @@ func TestGitHubRepositoryChecker_GetRepositoryPermissions(t *testing.T) {
 		wantCanUpload  bool
 		wantCanDown    bool
 		wantErr        bool
+		wantErrIs      error
 	}{
 		{
 			name: "正常系: admin権限がある場合",
@@ -357,6 +359,22 @@ func TestGitHubRepositoryChecker_GetRepositoryPermissions(t *testing.T) {
 			wantCanUpload: false,
 			wantCanDown:   false,
 			wantErr:       true,
+			wantErrIs:     ErrRepositoryNotAccessible,
+		},
+		{
+			name: "異常系: トークンが失効している場合",
+			token: &oauthToken{
+				AccessToken: "gho_revoked_token",
+				TokenType:   "bearer",
+			},
+			repo: mustNewRepositoryIdentifierForChecker(t, "owner/repo"),
+			serverResponse: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusUnauthorized)
+			},
+			wantCanUpload: false,
+			wantCanDown:   false,
+			wantErr:       true,
+			wantErrIs:     ErrRepositoryNotAccessible,
 		},
 		{
 			name: "異常系: サーバーエラーの場合",
@@ -389,6 +407,9 @@ func TestGitHubRepositoryChecker_GetRepositoryPermissions(t *testing.T) {
 				if err == nil {
 					t.Fatalf("エラーが期待されましたが、nilが返りました")
 				}
+				if tt.wantErrIs != nil && !errors.Is(err, tt.wantErrIs) {
+					t.Errorf("エラーが一致しません: want=%v, got=%v", tt.wantErrIs, err)
+				}
 				return
 			}
 
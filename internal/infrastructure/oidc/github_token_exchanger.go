@@ -11,17 +11,34 @@ import (
 	"time"
 )
 
-const maxResponseSize = 1 << 20
+const (
+	maxResponseSize = 1 << 20
+
+	deviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+	// codeChallengeMethodS256 はPKCEのcode_challengeをcode_verifierのSHA-256で求める方式
+	codeChallengeMethodS256 = "S256"
+)
 
 type gitHubTokenExchanger struct {
-	clientID      string
-	clientSecret  string
-	redirectURI   string
-	httpClient    *http.Client
-	tokenEndpoint string
+	clientID           string
+	clientSecret       string
+	httpClient         *http.Client
+	tokenEndpoint      string
+	deviceCodeEndpoint string
+}
+
+type tokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	TokenType        string `json:"token_type"`
+	Scope            string `json:"scope"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
 }
 
-func NewGitHubTokenExchanger(clientID, clientSecret, redirectURI string) (*gitHubTokenExchanger, error) {
+// NewGitHubTokenExchanger はOAuth Appの認証情報でトークンを取得するExchangerを作成する
+// リダイレクトURIはリクエストごとに指定するため、複数のログインで並行して使用できる
+func NewGitHubTokenExchanger(clientID, clientSecret string) (*gitHubTokenExchanger, error) {
 	clientID = strings.TrimSpace(clientID)
 	if clientID == "" {
 		return nil, fmt.Errorf("clientID is required")
@@ -33,78 +50,132 @@ func NewGitHubTokenExchanger(clientID, clientSecret, redirectURI string) (*gitHu
 	}
 
 	return &gitHubTokenExchanger{
-		clientID:      clientID,
-		clientSecret:  clientSecret,
-		redirectURI:   strings.TrimSpace(redirectURI),
-		httpClient:    &http.Client{Timeout: 10 * time.Second},
-		tokenEndpoint: GitHubOAuthTokenURL,
+		clientID:           clientID,
+		clientSecret:       clientSecret,
+		httpClient:         &http.Client{Timeout: 10 * time.Second},
+		tokenEndpoint:      GitHubOAuthTokenURL,
+		deviceCodeEndpoint: GitHubOAuthDeviceCodeURL,
 	}, nil
 }
 
-func (e *gitHubTokenExchanger) SetRedirectURI(redirectURI string) {
-	e.redirectURI = strings.TrimSpace(redirectURI)
-}
-
 func (e *gitHubTokenExchanger) SetTokenEndpoint(endpoint string) {
 	e.tokenEndpoint = endpoint
 }
 
-func (e *gitHubTokenExchanger) GetAuthorizationURL(state string) string {
+func (e *gitHubTokenExchanger) SetDeviceCodeEndpoint(endpoint string) {
+	e.deviceCodeEndpoint = endpoint
+}
+
+// GetAuthorizationURL はPKCEのcode_challengeを含む認可URLを返す
+func (e *gitHubTokenExchanger) GetAuthorizationURL(state, redirectURI, codeChallenge string) string {
 	params := url.Values{}
 	params.Set("client_id", e.clientID)
-	params.Set("redirect_uri", e.redirectURI)
+	params.Set("redirect_uri", strings.TrimSpace(redirectURI))
 	params.Set("state", state)
+	params.Set("code_challenge", codeChallenge)
+	params.Set("code_challenge_method", codeChallengeMethodS256)
 
 	return fmt.Sprintf("%s?%s", GitHubOAuthAuthorizeURL, params.Encode())
 }
 
-func (e *gitHubTokenExchanger) ExchangeCode(ctx context.Context, code string) (*oauthToken, error) {
+// ExchangeCode は認可コードをトークンと交換する。redirectURIとcodeVerifierは認可URLの生成時と同じ値を指定する
+func (e *gitHubTokenExchanger) ExchangeCode(ctx context.Context, code, redirectURI, codeVerifier string) (*oauthToken, error) {
 	data := url.Values{}
 	data.Set("client_id", e.clientID)
 	data.Set("client_secret", e.clientSecret)
 	data.Set("code", code)
-	data.Set("redirect_uri", e.redirectURI)
+	data.Set("redirect_uri", strings.TrimSpace(redirectURI))
+	data.Set("code_verifier", codeVerifier)
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.tokenEndpoint, strings.NewReader(data.Encode()))
+	body, err := e.postForm(ctx, e.tokenEndpoint, data, "トークン取得")
 	if err != nil {
-		return nil, fmt.Errorf("リクエストの作成に失敗しました: %w", err)
+		return nil, err
 	}
 
-	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-	req.Header.Set("Accept", "application/json")
-
-	resp, err := e.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("トークン取得リクエストに失敗しました: %w", err)
+	var tokenResp tokenResponse
+	if err := json.Unmarshal(body, &tokenResp); err != nil {
+		return nil, fmt.Errorf("レスポンスのパースに失敗しました: %w", err)
 	}
-	defer func() { _ = resp.Body.Close() }()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("トークン取得に失敗しました: status=%d", resp.StatusCode)
+	if tokenResp.Error != "" {
+		return nil, fmt.Errorf("トークン取得エラー: %s - %s", tokenResp.Error, tokenResp.ErrorDescription)
 	}
 
-	limitedReader := io.LimitReader(resp.Body, maxResponseSize+1)
-	body, err := io.ReadAll(limitedReader)
+	return &oauthToken{
+		AccessToken: tokenResp.AccessToken,
+		TokenType:   tokenResp.TokenType,
+		Scope:       tokenResp.Scope,
+	}, nil
+}
+
+// RequestDeviceCode はデバイスフローを開始し、ユーザーに入力してもらうコードを取得する
+func (e *gitHubTokenExchanger) RequestDeviceCode(ctx context.Context) (*deviceCode, error) {
+	data := url.Values{}
+	data.Set("client_id", e.clientID)
+
+	body, err := e.postForm(ctx, e.deviceCodeEndpoint, data, "デバイスコード取得")
 	if err != nil {
-		return nil, fmt.Errorf("レスポンスの読み取りに失敗しました: %w", err)
-	}
-	if len(body) > maxResponseSize {
-		return nil, fmt.Errorf("レスポンスが大きすぎます: %d bytes (最大: %d bytes)", len(body), maxResponseSize)
+		return nil, err
 	}
 
-	var tokenResp struct {
-		AccessToken      string `json:"access_token"`
-		TokenType        string `json:"token_type"`
-		Scope            string `json:"scope"`
+	var codeResp struct {
+		DeviceCode       string `json:"device_code"`
+		UserCode         string `json:"user_code"`
+		VerificationURI  string `json:"verification_uri"`
+		ExpiresIn        int    `json:"expires_in"`
+		Interval         int    `json:"interval"`
 		Error            string `json:"error"`
 		ErrorDescription string `json:"error_description"`
 	}
+	if err := json.Unmarshal(body, &codeResp); err != nil {
+		return nil, fmt.Errorf("レスポンスのパースに失敗しました: %w", err)
+	}
 
+	if codeResp.Error != "" {
+		return nil, fmt.Errorf("デバイスコード取得エラー: %s - %s", codeResp.Error, codeResp.ErrorDescription)
+	}
+	if codeResp.DeviceCode == "" || codeResp.UserCode == "" {
+		return nil, fmt.Errorf("デバイスコードがレスポンスに含まれていません")
+	}
+
+	return &deviceCode{
+		DeviceCode:      codeResp.DeviceCode,
+		UserCode:        codeResp.UserCode,
+		VerificationURI: codeResp.VerificationURI,
+		ExpiresIn:       time.Duration(codeResp.ExpiresIn) * time.Second,
+		Interval:        time.Duration(codeResp.Interval) * time.Second,
+	}, nil
+}
+
+// PollDeviceToken はデバイスコードに対するアクセストークンを取得する
+// ユーザーが承認する前は ErrAuthorizationPending を返す
+func (e *gitHubTokenExchanger) PollDeviceToken(ctx context.Context, code string) (*oauthToken, error) {
+	data := url.Values{}
+	data.Set("client_id", e.clientID)
+	data.Set("device_code", code)
+	data.Set("grant_type", deviceCodeGrantType)
+
+	body, err := e.postForm(ctx, e.tokenEndpoint, data, "トークン取得")
+	if err != nil {
+		return nil, err
+	}
+
+	var tokenResp tokenResponse
 	if err := json.Unmarshal(body, &tokenResp); err != nil {
 		return nil, fmt.Errorf("レスポンスのパースに失敗しました: %w", err)
 	}
 
-	if tokenResp.Error != "" {
+	switch tokenResp.Error {
+	case "":
+	case "authorization_pending":
+		return nil, ErrAuthorizationPending
+	case "slow_down":
+		return nil, ErrSlowDown
+	case "expired_token":
+		return nil, ErrExpiredDeviceCode
+	case "access_denied":
+		return nil, ErrAccessDenied
+	default:
 		return nil, fmt.Errorf("トークン取得エラー: %s - %s", tokenResp.Error, tokenResp.ErrorDescription)
 	}
 
@@ -114,3 +185,33 @@ func (e *gitHubTokenExchanger) ExchangeCode(ctx context.Context, code string) (*
 		Scope:       tokenResp.Scope,
 	}, nil
 }
+
+func (e *gitHubTokenExchanger) postForm(ctx context.Context, endpoint string, data url.Values, action string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(data.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("リクエストの作成に失敗しました: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := e.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%sリクエストに失敗しました: %w", action, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%sに失敗しました: status=%d", action, resp.StatusCode)
+	}
+
+	limitedReader := io.LimitReader(resp.Body, maxResponseSize+1)
+	body, err := io.ReadAll(limitedReader)
+	if err != nil {
+		return nil, fmt.Errorf("レスポンスの読み取りに失敗しました: %w", err)
+	}
+	if len(body) > maxResponseSize {
+		return nil, fmt.Errorf("レスポンスが大きすぎます: %d bytes (最大: %d bytes)", len(body), maxResponseSize)
+	}
+	return body, nil
+}
@@ -3,10 +3,12 @@ package oidc
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -16,42 +18,31 @@ func TestNewGitHubTokenExchanger(t *testing.T) {
 		name         string
 		clientID     string
 		clientSecret string
-		redirectURI  string
 		wantErr      bool
 	}{
 		{
 			name:         "正常系: 全パラメータが正しい場合、Exchangerが作成される",
 			clientID:     "test-client-id",
 			clientSecret: "test-client-secret",
-			redirectURI:  "http://localhost:8080/callback",
 			wantErr:      false,
 		},
 		{
 			name:         "異常系: clientIDが空の場合、エラーが返る",
 			clientID:     "",
 			clientSecret: "test-client-secret",
-			redirectURI:  "http://localhost:8080/callback",
 			wantErr:      true,
 		},
 		{
 			name:         "異常系: clientSecretが空の場合、エラーが返る",
 			clientID:     "test-client-id",
 			clientSecret: "",
-			redirectURI:  "http://localhost:8080/callback",
 			wantErr:      true,
 		},
-		{
-			name:         "正常系: redirectURIが空の場合も動的設定可能でExchangerが作成される",
-			clientID:     "test-client-id",
-			clientSecret: "test-client-secret",
-			redirectURI:  "",
-			wantErr:      false,
-		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			exchanger, err := NewGitHubTokenExchanger(tt.clientID, tt.clientSecret, tt.redirectURI)
+			exchanger, err := NewGitHubTokenExchanger(tt.clientID, tt.clientSecret)
 
 			if tt.wantErr {
 				if err == nil {
@@ -83,8 +74,10 @@ func TestGitHubTokenExchanger_GetAuthorizationURL(t *testing.T) {
 			wantContains: []string{
 				"https://github.com/login/oauth/authorize",
 				"client_id=test-client-id",
-				"redirect_uri=",
+				"redirect_uri=http%3A%2F%2Flocalhost%3A8080%2Fcallback",
 				"state=test-state-123",
+				"code_challenge=test-challenge",
+				"code_challenge_method=S256",
 			},
 		},
 		{
@@ -100,12 +93,12 @@ func TestGitHubTokenExchanger_GetAuthorizationURL(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			exchanger, err := NewGitHubTokenExchanger("test-client-id", "test-client-secret", "http://localhost:8080/callback")
+			exchanger, err := NewGitHubTokenExchanger("test-client-id", "test-client-secret")
 			if err != nil {
 				t.Fatalf("Exchanger作成に失敗: %v", err)
 			}
 
-			authURL := exchanger.GetAuthorizationURL(tt.state)
+			authURL := exchanger.GetAuthorizationURL(tt.state, "http://localhost:8080/callback", "test-challenge")
 
 			for _, want := range tt.wantContains {
 				if !containsString(authURL, want) {
@@ -135,6 +128,15 @@ func TestGitHubTokenExchanger_ExchangeCode(t *testing.T) {
 				if r.Header.Get("Accept") != "application/json" {
 					t.Errorf("期待されるAcceptヘッダー: application/json, 実際: %s", r.Header.Get("Accept"))
 				}
+				if err := r.ParseForm(); err != nil {
+					t.Fatalf("フォームのパースに失敗: %v", err)
+				}
+				if r.PostForm.Get("redirect_uri") != "http://localhost:8080/callback" {
+					t.Errorf("期待されるredirect_uri: http://localhost:8080/callback, 実際: %s", r.PostForm.Get("redirect_uri"))
+				}
+				if r.PostForm.Get("code_verifier") != "test-verifier" {
+					t.Errorf("期待されるcode_verifier: test-verifier, 実際: %s", r.PostForm.Get("code_verifier"))
+				}
 
 				w.Header().Set("Content-Type", "application/json")
 				w.WriteHeader(http.StatusOK)
@@ -197,7 +199,7 @@ func TestGitHubTokenExchanger_ExchangeCode(t *testing.T) {
 			server := httptest.NewServer(http.HandlerFunc(tt.serverResponse))
 			defer server.Close()
 
-			exchanger, err := NewGitHubTokenExchanger("test-client-id", "test-client-secret", "http://localhost:8080/callback")
+			exchanger, err := NewGitHubTokenExchanger("test-client-id", "test-client-secret")
 			if err != nil {
 				t.Fatalf("Exchanger作成に失敗: %v", err)
 			}
@@ -205,7 +207,7 @@ func TestGitHubTokenExchanger_ExchangeCode(t *testing.T) {
 			exchanger.SetTokenEndpoint(server.URL)
 
 			ctx := context.Background()
-			got, err := exchanger.ExchangeCode(ctx, tt.code)
+			got, err := exchanger.ExchangeCode(ctx, tt.code, "http://localhost:8080/callback", "test-verifier")
 
 			if tt.wantErr {
 				if err == nil {
@@ -227,3 +229,162 @@ func TestGitHubTokenExchanger_ExchangeCode(t *testing.T) {
 		})
 	}
 }
+
+func TestGitHubTokenExchanger_RequestDeviceCode(t *testing.T) {
+	tests := []struct {
+		name           string
+		serverResponse func(w http.ResponseWriter, r *http.Request)
+		want           *deviceCode
+		wantErr        bool
+	}{
+		{
+			name: "正常系: デバイスコードが取得できる",
+			serverResponse: func(w http.ResponseWriter, r *http.Request) {
+				if err := r.ParseForm(); err != nil {
+					t.Fatalf("フォームのパースに失敗: %v", err)
+				}
+				if r.PostForm.Get("client_id") != "test-client-id" {
+					t.Errorf("期待されるclient_id: test-client-id, 実際: %s", r.PostForm.Get("client_id"))
+				}
+				if r.PostForm.Has("client_secret") {
+					t.Errorf("client_secretを送信してはいけません")
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(map[string]any{
+					"device_code":      "device-code-123",
+					"user_code":        "ABCD-1234",
+					"verification_uri": "https://github.com/login/device",
+					"expires_in":       900,
+					"interval":         5,
+				})
+			},
+			want: &deviceCode{
+				DeviceCode:      "device-code-123",
+				UserCode:        "ABCD-1234",
+				VerificationURI: "https://github.com/login/device",
+				ExpiresIn:       900 * time.Second,
+				Interval:        5 * time.Second,
+			},
+		},
+		{
+			name: "異常系: デバイスフローが無効なOAuth Appの場合、エラーが返る",
+			serverResponse: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(map[string]string{
+					"error":             "device_flow_disabled",
+					"error_description": "Device flow must be explicitly enabled for this App",
+				})
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(tt.serverResponse))
+			defer server.Close()
+
+			exchanger, err := NewGitHubTokenExchanger("test-client-id", "test-client-secret")
+			if err != nil {
+				t.Fatalf("Exchanger作成に失敗: %v", err)
+			}
+			exchanger.SetDeviceCodeEndpoint(server.URL)
+
+			got, err := exchanger.RequestDeviceCode(context.Background())
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("エラーが期待されましたが、nilが返りました")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("エラーは期待されていませんでしたが、%v が返りました", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("デバイスコードが一致しません (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGitHubTokenExchanger_PollDeviceToken(t *testing.T) {
+	tests := []struct {
+		name     string
+		response map[string]string
+		want     *oauthToken
+		wantErr  error
+	}{
+		{
+			name: "正常系: 承認済みの場合、トークンが取得できる",
+			response: map[string]string{
+				"access_token": "gho_test_token_123",
+				"token_type":   "bearer",
+			},
+			want: &oauthToken{
+				AccessToken: "gho_test_token_123",
+				TokenType:   "bearer",
+			},
+		},
+		{
+			name:     "異常系: 承認待ちの場合、ErrAuthorizationPendingが返る",
+			response: map[string]string{"error": "authorization_pending"},
+			wantErr:  ErrAuthorizationPending,
+		},
+		{
+			name:     "異常系: ポーリング間隔が短すぎる場合、ErrSlowDownが返る",
+			response: map[string]string{"error": "slow_down"},
+			wantErr:  ErrSlowDown,
+		},
+		{
+			name:     "異常系: デバイスコードが期限切れの場合、ErrExpiredDeviceCodeが返る",
+			response: map[string]string{"error": "expired_token"},
+			wantErr:  ErrExpiredDeviceCode,
+		},
+		{
+			name:     "異常系: ユーザーが拒否した場合、ErrAccessDeniedが返る",
+			response: map[string]string{"error": "access_denied"},
+			wantErr:  ErrAccessDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if err := r.ParseForm(); err != nil {
+					t.Fatalf("フォームのパースに失敗: %v", err)
+				}
+				if r.PostForm.Get("device_code") != "device-code-123" {
+					t.Errorf("期待されるdevice_code: device-code-123, 実際: %s", r.PostForm.Get("device_code"))
+				}
+				if r.PostForm.Get("grant_type") != deviceCodeGrantType {
+					t.Errorf("期待されるgrant_type: %s, 実際: %s", deviceCodeGrantType, r.PostForm.Get("grant_type"))
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(tt.response)
+			}))
+			defer server.Close()
+
+			exchanger, err := NewGitHubTokenExchanger("test-client-id", "test-client-secret")
+			if err != nil {
+				t.Fatalf("Exchanger作成に失敗: %v", err)
+			}
+			exchanger.SetTokenEndpoint(server.URL)
+
+			got, err := exchanger.PollDeviceToken(context.Background(), "device-code-123")
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("期待されるエラー: %v, 実際: %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("エラーは期待されていませんでしたが、%v が返りました", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("トークンが一致しません (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
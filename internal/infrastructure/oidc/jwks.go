@@ -13,6 +13,10 @@ import (
 	"time"
 
 	"github.com/na2na-p/cargohold/internal/infrastructure/redis"
+	"github.com/na2na-p/cargohold/internal/infrastructure/tracing"
+	"go.opentelemetry.io/otel"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -64,7 +68,12 @@ func (f *JWKSFetcher) GetPublicKey(ctx context.Context, jwksURL string, keyID st
 		}
 	}
 
-	jwkSet, err = f.fetchJWKSet(ctx, jwksURL)
+	fetchCtx, span := otel.Tracer(tracerName).Start(ctx, "JWKSFetcher.fetchJWKSet",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.URLFull(jwksURL)),
+	)
+	jwkSet, err = f.fetchJWKSet(fetchCtx, jwksURL)
+	tracing.End(span, err)
 	if err != nil {
 		return nil, fmt.Errorf("JWKS Endpointからの取得に失敗しました: %w", err)
 	}
@@ -6,8 +6,14 @@ import (
 	"fmt"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/na2na-p/cargohold/internal/infrastructure/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
+const tracerName = "github.com/na2na-p/cargohold/internal/infrastructure/oidc"
+
 // JWTVerifier はJWT検証を担当します
 type JWTVerifier struct {
 	jwksFetcher *JWKSFetcher
@@ -23,6 +29,15 @@ func NewJWTVerifier(cacheClient CacheClient) *JWTVerifier {
 // VerifyJWT はJWTトークンを検証します
 // provider: OIDCプロバイダー名（例: "github"）
 func (v *JWTVerifier) VerifyJWT(ctx context.Context, tokenString string, jwksURL string, audience string, issuer string, provider string) (*jwt.Token, error) {
+	ctx, span := otel.Tracer(tracerName).Start(ctx, "JWTVerifier.VerifyJWT",
+		trace.WithAttributes(attribute.String("oidc.provider", provider)),
+	)
+	token, err := v.verifyJWT(ctx, tokenString, jwksURL, audience, issuer, provider)
+	tracing.End(span, err)
+	return token, err
+}
+
+func (v *JWTVerifier) verifyJWT(ctx context.Context, tokenString string, jwksURL string, audience string, issuer string, provider string) (*jwt.Token, error) {
 	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
 			return nil, fmt.Errorf("予期しない署名アルゴリズムです: %v", token.Header["alg"])
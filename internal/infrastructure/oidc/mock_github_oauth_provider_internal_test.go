@@ -57,32 +57,32 @@ func (mr *MockGitHubOAuthProviderInternalMockRecorder) CanAccessRepository(ctx,
 }
 
 // ExchangeCode mocks base method.
-func (m *MockGitHubOAuthProviderInternal) ExchangeCode(ctx context.Context, code string) (*oauthToken, error) {
+func (m *MockGitHubOAuthProviderInternal) ExchangeCode(ctx context.Context, code, redirectURI, codeVerifier string) (*oauthToken, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ExchangeCode", ctx, code)
+	ret := m.ctrl.Call(m, "ExchangeCode", ctx, code, redirectURI, codeVerifier)
 	ret0, _ := ret[0].(*oauthToken)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ExchangeCode indicates an expected call of ExchangeCode.
-func (mr *MockGitHubOAuthProviderInternalMockRecorder) ExchangeCode(ctx, code any) *gomock.Call {
+func (mr *MockGitHubOAuthProviderInternalMockRecorder) ExchangeCode(ctx, code, redirectURI, codeVerifier any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExchangeCode", reflect.TypeOf((*MockGitHubOAuthProviderInternal)(nil).ExchangeCode), ctx, code)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExchangeCode", reflect.TypeOf((*MockGitHubOAuthProviderInternal)(nil).ExchangeCode), ctx, code, redirectURI, codeVerifier)
 }
 
 // GetAuthorizationURL mocks base method.
-func (m *MockGitHubOAuthProviderInternal) GetAuthorizationURL(state string) string {
+func (m *MockGitHubOAuthProviderInternal) GetAuthorizationURL(state, redirectURI, codeChallenge string) string {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAuthorizationURL", state)
+	ret := m.ctrl.Call(m, "GetAuthorizationURL", state, redirectURI, codeChallenge)
 	ret0, _ := ret[0].(string)
 	return ret0
 }
 
 // GetAuthorizationURL indicates an expected call of GetAuthorizationURL.
-func (mr *MockGitHubOAuthProviderInternalMockRecorder) GetAuthorizationURL(state any) *gomock.Call {
+func (mr *MockGitHubOAuthProviderInternalMockRecorder) GetAuthorizationURL(state, redirectURI, codeChallenge any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthorizationURL", reflect.TypeOf((*MockGitHubOAuthProviderInternal)(nil).GetAuthorizationURL), state)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthorizationURL", reflect.TypeOf((*MockGitHubOAuthProviderInternal)(nil).GetAuthorizationURL), state, redirectURI, codeChallenge)
 }
 
 // GetRepositoryPermissions mocks base method.
@@ -115,14 +115,32 @@ func (mr *MockGitHubOAuthProviderInternalMockRecorder) GetUserInfo(ctx, token an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserInfo", reflect.TypeOf((*MockGitHubOAuthProviderInternal)(nil).GetUserInfo), ctx, token)
 }
 
-// SetRedirectURI mocks base method.
-func (m *MockGitHubOAuthProviderInternal) SetRedirectURI(redirectURI string) {
+// PollDeviceToken mocks base method.
+func (m *MockGitHubOAuthProviderInternal) PollDeviceToken(ctx context.Context, code string) (*oauthToken, error) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "SetRedirectURI", redirectURI)
+	ret := m.ctrl.Call(m, "PollDeviceToken", ctx, code)
+	ret0, _ := ret[0].(*oauthToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PollDeviceToken indicates an expected call of PollDeviceToken.
+func (mr *MockGitHubOAuthProviderInternalMockRecorder) PollDeviceToken(ctx, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PollDeviceToken", reflect.TypeOf((*MockGitHubOAuthProviderInternal)(nil).PollDeviceToken), ctx, code)
+}
+
+// RequestDeviceCode mocks base method.
+func (m *MockGitHubOAuthProviderInternal) RequestDeviceCode(ctx context.Context) (*deviceCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestDeviceCode", ctx)
+	ret0, _ := ret[0].(*deviceCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// SetRedirectURI indicates an expected call of SetRedirectURI.
-func (mr *MockGitHubOAuthProviderInternalMockRecorder) SetRedirectURI(redirectURI any) *gomock.Call {
+// RequestDeviceCode indicates an expected call of RequestDeviceCode.
+func (mr *MockGitHubOAuthProviderInternalMockRecorder) RequestDeviceCode(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRedirectURI", reflect.TypeOf((*MockGitHubOAuthProviderInternal)(nil).SetRedirectURI), redirectURI)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestDeviceCode", reflect.TypeOf((*MockGitHubOAuthProviderInternal)(nil).RequestDeviceCode), ctx)
 }
@@ -41,30 +41,30 @@ func (m *MockTokenExchanger) EXPECT() *MockTokenExchangerMockRecorder {
 }
 
 // ExchangeCode mocks base method.
-func (m *MockTokenExchanger) ExchangeCode(ctx context.Context, code string) (*oauthToken, error) {
+func (m *MockTokenExchanger) ExchangeCode(ctx context.Context, code, redirectURI, codeVerifier string) (*oauthToken, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ExchangeCode", ctx, code)
+	ret := m.ctrl.Call(m, "ExchangeCode", ctx, code, redirectURI, codeVerifier)
 	ret0, _ := ret[0].(*oauthToken)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ExchangeCode indicates an expected call of ExchangeCode.
-func (mr *MockTokenExchangerMockRecorder) ExchangeCode(ctx, code any) *gomock.Call {
+func (mr *MockTokenExchangerMockRecorder) ExchangeCode(ctx, code, redirectURI, codeVerifier any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExchangeCode", reflect.TypeOf((*MockTokenExchanger)(nil).ExchangeCode), ctx, code)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExchangeCode", reflect.TypeOf((*MockTokenExchanger)(nil).ExchangeCode), ctx, code, redirectURI, codeVerifier)
 }
 
 // GetAuthorizationURL mocks base method.
-func (m *MockTokenExchanger) GetAuthorizationURL(state string) string {
+func (m *MockTokenExchanger) GetAuthorizationURL(state, redirectURI, codeChallenge string) string {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAuthorizationURL", state)
+	ret := m.ctrl.Call(m, "GetAuthorizationURL", state, redirectURI, codeChallenge)
 	ret0, _ := ret[0].(string)
 	return ret0
 }
 
 // GetAuthorizationURL indicates an expected call of GetAuthorizationURL.
-func (mr *MockTokenExchangerMockRecorder) GetAuthorizationURL(state any) *gomock.Call {
+func (mr *MockTokenExchangerMockRecorder) GetAuthorizationURL(state, redirectURI, codeChallenge any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthorizationURL", reflect.TypeOf((*MockTokenExchanger)(nil).GetAuthorizationURL), state)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthorizationURL", reflect.TypeOf((*MockTokenExchanger)(nil).GetAuthorizationURL), state, redirectURI, codeChallenge)
 }
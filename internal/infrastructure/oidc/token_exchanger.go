@@ -6,6 +6,6 @@ import (
 )
 
 type TokenExchanger interface {
-	GetAuthorizationURL(state string) string
-	ExchangeCode(ctx context.Context, code string) (*oauthToken, error)
+	GetAuthorizationURL(state, redirectURI, codeChallenge string) string
+	ExchangeCode(ctx context.Context, code, redirectURI, codeVerifier string) (*oauthToken, error)
 }
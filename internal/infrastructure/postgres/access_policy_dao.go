@@ -28,15 +28,31 @@ func NewAccessPolicyDAO(pool PoolInterface) *AccessPolicyDAO {
 	}
 }
 
-// FindByOID は指定されたLFS Object OIDに対応するレコードを取得する
-func (dao *AccessPolicyDAO) FindByOID(ctx context.Context, oid string) (*AccessPolicyRow, error) {
+// FindByOID は指定されたLFS Object OIDに紐付く全リポジトリのレコードを取得する
+func (dao *AccessPolicyDAO) FindByOID(ctx context.Context, oid string) ([]*AccessPolicyRow, error) {
 	query := `
 		SELECT id, lfs_object_oid, repository, created_at
 		FROM lfs_object_access_policies
 		WHERE lfs_object_oid = $1
+		ORDER BY id
 	`
 
-	row := dao.pool.QueryRow(ctx, query, oid)
+	rows, err := dao.pool.Query(ctx, query, oid)
+	if err != nil {
+		return nil, err
+	}
+	return scanAccessPolicyRows(rows)
+}
+
+// FindByOIDAndRepository は指定されたLFS Object OIDとリポジトリの組に対応するレコードを取得する
+func (dao *AccessPolicyDAO) FindByOIDAndRepository(ctx context.Context, oid, repository string) (*AccessPolicyRow, error) {
+	query := `
+		SELECT id, lfs_object_oid, repository, created_at
+		FROM lfs_object_access_policies
+		WHERE lfs_object_oid = $1 AND repository = $2
+	`
+
+	row := dao.pool.QueryRow(ctx, query, oid, repository)
 
 	var result AccessPolicyRow
 	err := row.Scan(
@@ -56,13 +72,29 @@ func (dao *AccessPolicyDAO) FindByOID(ctx context.Context, oid string) (*AccessP
 	return &result, nil
 }
 
-// Upsert は新しいレコードを挿入するか、既存のレコードを更新する（UPSERT処理）
-func (dao *AccessPolicyDAO) Upsert(ctx context.Context, row *AccessPolicyRow) error {
+// FindByOIDsAndRepository は指定された複数のLFS Object OIDのうち、リポジトリに紐付くレコードを1回のクエリで取得する
+// レコードが存在しないOIDは結果に含まれない
+func (dao *AccessPolicyDAO) FindByOIDsAndRepository(ctx context.Context, oids []string, repository string) ([]*AccessPolicyRow, error) {
+	query := `
+		SELECT id, lfs_object_oid, repository, created_at
+		FROM lfs_object_access_policies
+		WHERE lfs_object_oid = ANY($1) AND repository = $2
+	`
+
+	rows, err := dao.pool.Query(ctx, query, oids, repository)
+	if err != nil {
+		return nil, err
+	}
+	return scanAccessPolicyRows(rows)
+}
+
+// Insert はOIDとリポジトリの紐付けを挿入する（既に存在する場合は何もしない）
+func (dao *AccessPolicyDAO) Insert(ctx context.Context, row *AccessPolicyRow) error {
 	query := `
 		INSERT INTO lfs_object_access_policies (lfs_object_oid, repository, created_at)
 		VALUES ($1, $2, $3)
-		ON CONFLICT (lfs_object_oid)
-		DO UPDATE SET repository = EXCLUDED.repository
+		ON CONFLICT (lfs_object_oid, repository)
+		DO NOTHING
 	`
 
 	_, err := dao.pool.Exec(ctx, query,
@@ -74,7 +106,7 @@ func (dao *AccessPolicyDAO) Upsert(ctx context.Context, row *AccessPolicyRow) er
 	return err
 }
 
-// Delete は指定されたLFS Object OIDに対応するレコードを削除する
+// Delete は指定されたLFS Object OIDに紐付く全リポジトリのレコードを削除する
 func (dao *AccessPolicyDAO) Delete(ctx context.Context, oid string) error {
 	query := `
 		DELETE FROM lfs_object_access_policies
@@ -92,3 +124,27 @@ func (dao *AccessPolicyDAO) Delete(ctx context.Context, oid string) error {
 
 	return nil
 }
+
+func scanAccessPolicyRows(rows pgx.Rows) ([]*AccessPolicyRow, error) {
+	defer rows.Close()
+
+	var result []*AccessPolicyRow
+	for rows.Next() {
+		var row AccessPolicyRow
+		if err := rows.Scan(
+			&row.ID,
+			&row.LfsObjectOid,
+			&row.Repository,
+			&row.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		result = append(result, &row)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -2,6 +2,7 @@ package postgres_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -49,46 +50,53 @@ func TestAccessPolicyDAO_FindByOID(t *testing.T) {
 		name      string
 		args      args
 		mockSetup func(mock pgxmock.PgxPoolIface)
-		want      *postgres.AccessPolicyRow
+		want      []*postgres.AccessPolicyRow
 		wantErr   error
 	}{
 		{
-			name: "正常系: FindByOIDに成功",
+			name: "正常系: OIDに紐付く全リポジトリのレコードが返る",
 			args: args{
 				oid: "1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef",
 			},
 			mockSetup: func(mock pgxmock.PgxPoolIface) {
 				rows := pgxmock.NewRows([]string{"id", "lfs_object_oid", "repository", "created_at"}).
-					AddRow(
-						int64(1),
-						"1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef",
-						"owner/repo",
-						fixedTime,
-					)
-				mock.ExpectQuery(`SELECT id, lfs_object_oid, repository, created_at FROM lfs_object_access_policies WHERE lfs_object_oid`).
+					AddRow(int64(1), "1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef", "owner/repo", fixedTime).
+					AddRow(int64(2), "1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef", "other/repo", fixedTime)
+				mock.ExpectQuery(`SELECT id, lfs_object_oid, repository, created_at FROM lfs_object_access_policies WHERE lfs_object_oid = \$1 ORDER BY id`).
 					WithArgs("1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef").
 					WillReturnRows(rows)
 			},
-			want: &postgres.AccessPolicyRow{
-				ID:           1,
-				LfsObjectOid: "1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef",
-				Repository:   "owner/repo",
-				CreatedAt:    fixedTime,
+			want: []*postgres.AccessPolicyRow{
+				{ID: 1, LfsObjectOid: "1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef", Repository: "owner/repo", CreatedAt: fixedTime},
+				{ID: 2, LfsObjectOid: "1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef", Repository: "other/repo", CreatedAt: fixedTime},
 			},
 			wantErr: nil,
 		},
 		{
-			name: "異常系: 存在しないOID",
+			name: "正常系: 紐付けが存在しない場合は空の結果が返る",
 			args: args{
 				oid: "abcdef1234567890abcdef1234567890abcdef1234567890abcdef1234567890",
 			},
 			mockSetup: func(mock pgxmock.PgxPoolIface) {
-				mock.ExpectQuery(`SELECT id, lfs_object_oid, repository, created_at FROM lfs_object_access_policies WHERE lfs_object_oid`).
+				mock.ExpectQuery(`SELECT id, lfs_object_oid, repository, created_at FROM lfs_object_access_policies WHERE lfs_object_oid = \$1 ORDER BY id`).
 					WithArgs("abcdef1234567890abcdef1234567890abcdef1234567890abcdef1234567890").
-					WillReturnError(pgx.ErrNoRows)
+					WillReturnRows(pgxmock.NewRows([]string{"id", "lfs_object_oid", "repository", "created_at"}))
 			},
 			want:    nil,
-			wantErr: pgx.ErrNoRows,
+			wantErr: nil,
+		},
+		{
+			name: "異常系: クエリに失敗した場合はエラーが返る",
+			args: args{
+				oid: "abcdef1234567890abcdef1234567890abcdef1234567890abcdef1234567890",
+			},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`SELECT id, lfs_object_oid, repository, created_at FROM lfs_object_access_policies WHERE lfs_object_oid = \$1 ORDER BY id`).
+					WithArgs("abcdef1234567890abcdef1234567890abcdef1234567890abcdef1234567890").
+					WillReturnError(errors.New("database error"))
+			},
+			want:    nil,
+			wantErr: errors.New("database error"),
 		},
 	}
 
@@ -130,8 +138,98 @@ func TestAccessPolicyDAO_FindByOID(t *testing.T) {
 	}
 }
 
-// TestAccessPolicyDAO_Upsert はUpsert処理のテーブルドリブンテスト
-func TestAccessPolicyDAO_Upsert(t *testing.T) {
+// TestAccessPolicyDAO_FindByOIDAndRepository はFindByOIDAndRepository処理のテーブルドリブンテスト
+func TestAccessPolicyDAO_FindByOIDAndRepository(t *testing.T) {
+	fixedTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	type args struct {
+		oid        string
+		repository string
+	}
+	tests := []struct {
+		name      string
+		args      args
+		mockSetup func(mock pgxmock.PgxPoolIface)
+		want      *postgres.AccessPolicyRow
+		wantErr   error
+	}{
+		{
+			name: "正常系: OIDとリポジトリの組に対応するレコードが返る",
+			args: args{
+				oid:        "1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef",
+				repository: "owner/repo",
+			},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows([]string{"id", "lfs_object_oid", "repository", "created_at"}).
+					AddRow(int64(1), "1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef", "owner/repo", fixedTime)
+				mock.ExpectQuery(`SELECT id, lfs_object_oid, repository, created_at FROM lfs_object_access_policies WHERE lfs_object_oid = \$1 AND repository = \$2`).
+					WithArgs("1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef", "owner/repo").
+					WillReturnRows(rows)
+			},
+			want: &postgres.AccessPolicyRow{
+				ID:           1,
+				LfsObjectOid: "1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef",
+				Repository:   "owner/repo",
+				CreatedAt:    fixedTime,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "異常系: 紐付けが存在しない場合はpgx.ErrNoRowsが返る",
+			args: args{
+				oid:        "1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef",
+				repository: "other/repo",
+			},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`SELECT id, lfs_object_oid, repository, created_at FROM lfs_object_access_policies WHERE lfs_object_oid = \$1 AND repository = \$2`).
+					WithArgs("1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef", "other/repo").
+					WillReturnError(pgx.ErrNoRows)
+			},
+			want:    nil,
+			wantErr: pgx.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			if err != nil {
+				t.Fatalf("モックプールの作成に失敗しました: %v", err)
+			}
+			defer mock.Close()
+
+			tt.mockSetup(mock)
+
+			dao := postgres.NewAccessPolicyDAO(mock)
+			ctx := context.Background()
+
+			got, err := dao.FindByOIDAndRepository(ctx, tt.args.oid, tt.args.repository)
+
+			if tt.wantErr != nil {
+				if err == nil {
+					t.Fatalf("FindByOIDAndRepository() error = nil, wantErr %v", tt.wantErr)
+				}
+				if !cmp.Equal(err.Error(), tt.wantErr.Error()) {
+					t.Errorf("FindByOIDAndRepository() error = %v, wantErr %v", err, tt.wantErr)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("FindByOIDAndRepository() unexpected error = %v", err)
+				}
+				if diff := cmp.Diff(tt.want, got); diff != "" {
+					t.Errorf("FindByOIDAndRepository() mismatch (-want +got):\n%s", diff)
+				}
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("期待されたモック呼び出しが行われませんでした: %v", err)
+			}
+		})
+	}
+}
+
+// TestAccessPolicyDAO_Insert はInsert処理のテーブルドリブンテスト
+func TestAccessPolicyDAO_Insert(t *testing.T) {
 	fixedTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
 
 	type args struct {
@@ -153,7 +251,7 @@ func TestAccessPolicyDAO_Upsert(t *testing.T) {
 				},
 			},
 			mockSetup: func(mock pgxmock.PgxPoolIface) {
-				mock.ExpectExec(`INSERT INTO lfs_object_access_policies`).
+				mock.ExpectExec(`INSERT INTO lfs_object_access_policies .* ON CONFLICT \(lfs_object_oid, repository\) DO NOTHING`).
 					WithArgs(
 						"1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef",
 						"owner/repo",
@@ -164,25 +262,45 @@ func TestAccessPolicyDAO_Upsert(t *testing.T) {
 			wantErr: false,
 		},
 		{
-			name: "正常系: 既存レコードの更新に成功（UPSERT）",
+			name: "正常系: 同じOIDとリポジトリの紐付けが既に存在する場合は何もせず成功する",
 			args: args{
 				row: &postgres.AccessPolicyRow{
 					LfsObjectOid: "2222222222222222222222222222222222222222222222222222222222222222",
-					Repository:   "new-owner/new-repo",
+					Repository:   "owner/repo",
 					CreatedAt:    fixedTime,
 				},
 			},
 			mockSetup: func(mock pgxmock.PgxPoolIface) {
-				mock.ExpectExec(`INSERT INTO lfs_object_access_policies`).
+				mock.ExpectExec(`INSERT INTO lfs_object_access_policies .* ON CONFLICT \(lfs_object_oid, repository\) DO NOTHING`).
 					WithArgs(
 						"2222222222222222222222222222222222222222222222222222222222222222",
-						"new-owner/new-repo",
+						"owner/repo",
 						fixedTime,
 					).
-					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+					WillReturnResult(pgxmock.NewResult("INSERT", 0))
 			},
 			wantErr: false,
 		},
+		{
+			name: "異常系: 挿入に失敗した場合はエラーが返る",
+			args: args{
+				row: &postgres.AccessPolicyRow{
+					LfsObjectOid: "3333333333333333333333333333333333333333333333333333333333333333",
+					Repository:   "owner/repo",
+					CreatedAt:    fixedTime,
+				},
+			},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectExec(`INSERT INTO lfs_object_access_policies`).
+					WithArgs(
+						"3333333333333333333333333333333333333333333333333333333333333333",
+						"owner/repo",
+						fixedTime,
+					).
+					WillReturnError(errors.New("foreign key violation"))
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -198,9 +316,9 @@ func TestAccessPolicyDAO_Upsert(t *testing.T) {
 			dao := postgres.NewAccessPolicyDAO(mock)
 			ctx := context.Background()
 
-			err = dao.Upsert(ctx, tt.args.row)
+			err = dao.Insert(ctx, tt.args.row)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("Upsert() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("Insert() error = %v, wantErr %v", err, tt.wantErr)
 			}
 
 			if err := mock.ExpectationsWereMet(); err != nil {
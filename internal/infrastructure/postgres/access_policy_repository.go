@@ -18,8 +18,26 @@ func NewAccessPolicyRepository(pool PoolInterface) domain.AccessPolicyRepository
 	}
 }
 
-func (r *AccessPolicyRepositoryImpl) FindByOID(ctx context.Context, oid domain.OID) (*domain.AccessPolicy, error) {
-	row, err := r.dao.FindByOID(ctx, oid.String())
+func (r *AccessPolicyRepositoryImpl) FindByOID(ctx context.Context, oid domain.OID) ([]*domain.AccessPolicy, error) {
+	rows, err := r.dao.FindByOID(ctx, oid.String())
+	if err != nil {
+		return nil, err
+	}
+
+	policies := make([]*domain.AccessPolicy, 0, len(rows))
+	for _, row := range rows {
+		policy, err := rowToAccessPolicy(row)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, policy)
+	}
+
+	return policies, nil
+}
+
+func (r *AccessPolicyRepositoryImpl) FindByOIDAndRepository(ctx context.Context, oid domain.OID, repository *domain.RepositoryIdentifier) (*domain.AccessPolicy, error) {
+	row, err := r.dao.FindByOIDAndRepository(ctx, oid.String(), repository.FullName())
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, domain.ErrAccessPolicyNotFound
@@ -30,9 +48,31 @@ func (r *AccessPolicyRepositoryImpl) FindByOID(ctx context.Context, oid domain.O
 	return rowToAccessPolicy(row)
 }
 
+func (r *AccessPolicyRepositoryImpl) FindByOIDsAndRepository(ctx context.Context, oids []domain.OID, repository *domain.RepositoryIdentifier) (map[domain.OID]*domain.AccessPolicy, error) {
+	result := make(map[domain.OID]*domain.AccessPolicy, len(oids))
+	if len(oids) == 0 {
+		return result, nil
+	}
+
+	rows, err := r.dao.FindByOIDsAndRepository(ctx, oidsToStrings(oids), repository.FullName())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		policy, err := rowToAccessPolicy(row)
+		if err != nil {
+			return nil, err
+		}
+		result[policy.OID()] = policy
+	}
+
+	return result, nil
+}
+
 func (r *AccessPolicyRepositoryImpl) Save(ctx context.Context, policy *domain.AccessPolicy) error {
 	row := accessPolicyToRow(policy)
-	return r.dao.Upsert(ctx, row)
+	return r.dao.Insert(ctx, row)
 }
 
 func (r *AccessPolicyRepositoryImpl) Delete(ctx context.Context, oid domain.OID) error {
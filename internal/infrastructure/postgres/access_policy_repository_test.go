@@ -53,46 +53,141 @@ func TestAccessPolicyRepositoryImpl_FindByOID(t *testing.T) {
 		name      string
 		args      args
 		mockSetup func(mock pgxmock.PgxPoolIface)
-		wantID    int64
-		wantOID   string
-		wantRepo  string
-		wantNil   bool
-		wantErr   error
+		wantRepos []string
+		wantErr   bool
 	}{
 		{
-			name: "正常系: FindByOIDに成功",
+			name: "正常系: OIDに紐付く全リポジトリのポリシーが返る",
 			args: args{
 				oid: validOID,
 			},
 			mockSetup: func(mock pgxmock.PgxPoolIface) {
 				rows := pgxmock.NewRows([]string{"id", "lfs_object_oid", "repository", "created_at"}).
-					AddRow(
-						int64(1),
-						validOID,
-						"owner/repo",
-						fixedTime,
-					)
+					AddRow(int64(1), validOID, "owner/repo", fixedTime).
+					AddRow(int64(2), validOID, "other/repo", fixedTime)
 				mock.ExpectQuery(`SELECT id, lfs_object_oid, repository, created_at FROM lfs_object_access_policies WHERE lfs_object_oid`).
 					WithArgs(validOID).
 					WillReturnRows(rows)
 			},
-			wantID:   1,
-			wantOID:  validOID,
-			wantRepo: "owner/repo",
-			wantNil:  false,
-			wantErr:  nil,
+			wantRepos: []string{"owner/repo", "other/repo"},
+			wantErr:   false,
 		},
 		{
-			name: "異常系: 存在しないOIDの場合はErrAccessPolicyNotFoundを返す",
+			name: "正常系: 紐付けが存在しないOIDの場合は空の結果が返る",
 			args: args{
 				oid: notFoundOID,
 			},
 			mockSetup: func(mock pgxmock.PgxPoolIface) {
 				mock.ExpectQuery(`SELECT id, lfs_object_oid, repository, created_at FROM lfs_object_access_policies WHERE lfs_object_oid`).
 					WithArgs(notFoundOID).
+					WillReturnRows(pgxmock.NewRows([]string{"id", "lfs_object_oid", "repository", "created_at"}))
+			},
+			wantRepos: []string{},
+			wantErr:   false,
+		},
+		{
+			name: "異常系: クエリに失敗した場合はエラーを返す",
+			args: args{
+				oid: validOID,
+			},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`SELECT id, lfs_object_oid, repository, created_at FROM lfs_object_access_policies WHERE lfs_object_oid`).
+					WithArgs(validOID).
+					WillReturnError(errors.New("database error"))
+			},
+			wantRepos: nil,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			if err != nil {
+				t.Fatalf("モックプールの作成に失敗しました: %v", err)
+			}
+			defer mock.Close()
+
+			tt.mockSetup(mock)
+
+			repo := postgres.NewAccessPolicyRepository(mock)
+			ctx := context.Background()
+
+			oid, err := domain.NewOID(tt.args.oid)
+			if err != nil {
+				t.Fatalf("OIDの作成に失敗しました: %v", err)
+			}
+
+			got, err := repo.FindByOID(ctx, oid)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FindByOID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !tt.wantErr {
+				gotRepos := make([]string, 0, len(got))
+				for _, policy := range got {
+					if policy.OID().String() != tt.args.oid {
+						t.Errorf("FindByOID() OID = %v, want %v", policy.OID().String(), tt.args.oid)
+					}
+					gotRepos = append(gotRepos, policy.Repository().FullName())
+				}
+				if diff := cmp.Diff(tt.wantRepos, gotRepos); diff != "" {
+					t.Errorf("FindByOID() mismatch (-want +got):\n%s", diff)
+				}
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("期待されたモック呼び出しが行われませんでした: %v", err)
+			}
+		})
+	}
+}
+
+// TestAccessPolicyRepositoryImpl_FindByOIDAndRepository はFindByOIDAndRepository処理のテーブルドリブンテスト
+func TestAccessPolicyRepositoryImpl_FindByOIDAndRepository(t *testing.T) {
+	fixedTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	validOID := "1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef"
+
+	type args struct {
+		oid        string
+		repository string
+	}
+	tests := []struct {
+		name      string
+		args      args
+		mockSetup func(mock pgxmock.PgxPoolIface)
+		wantID    int64
+		wantRepo  string
+		wantErr   error
+	}{
+		{
+			name: "正常系: OIDとリポジトリの組に対応するポリシーが返る",
+			args: args{
+				oid:        validOID,
+				repository: "owner/repo",
+			},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows([]string{"id", "lfs_object_oid", "repository", "created_at"}).
+					AddRow(int64(1), validOID, "owner/repo", fixedTime)
+				mock.ExpectQuery(`SELECT id, lfs_object_oid, repository, created_at FROM lfs_object_access_policies WHERE lfs_object_oid = \$1 AND repository = \$2`).
+					WithArgs(validOID, "owner/repo").
+					WillReturnRows(rows)
+			},
+			wantID:   1,
+			wantRepo: "owner/repo",
+			wantErr:  nil,
+		},
+		{
+			name: "異常系: 紐付けが存在しない場合はErrAccessPolicyNotFoundを返す",
+			args: args{
+				oid:        validOID,
+				repository: "other/repo",
+			},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`SELECT id, lfs_object_oid, repository, created_at FROM lfs_object_access_policies WHERE lfs_object_oid = \$1 AND repository = \$2`).
+					WithArgs(validOID, "other/repo").
 					WillReturnError(pgx.ErrNoRows)
 			},
-			wantNil: true,
 			wantErr: domain.ErrAccessPolicyNotFound,
 		},
 	}
@@ -114,37 +209,118 @@ func TestAccessPolicyRepositoryImpl_FindByOID(t *testing.T) {
 			if err != nil {
 				t.Fatalf("OIDの作成に失敗しました: %v", err)
 			}
+			repoIdentifier, err := domain.NewRepositoryIdentifier(tt.args.repository)
+			if err != nil {
+				t.Fatalf("RepositoryIdentifierの作成に失敗しました: %v", err)
+			}
 
-			got, err := repo.FindByOID(ctx, oid)
+			got, err := repo.FindByOIDAndRepository(ctx, oid, repoIdentifier)
 
 			if tt.wantErr != nil {
-				if err == nil {
-					t.Fatalf("FindByOID() error = nil, wantErr %v", tt.wantErr)
-				}
 				if !errors.Is(err, tt.wantErr) {
-					t.Errorf("FindByOID() error = %v, wantErr %v", err, tt.wantErr)
+					t.Errorf("FindByOIDAndRepository() error = %v, wantErr %v", err, tt.wantErr)
 				}
 			} else {
 				if err != nil {
-					t.Fatalf("FindByOID() unexpected error = %v", err)
+					t.Fatalf("FindByOIDAndRepository() unexpected error = %v", err)
 				}
-				if tt.wantNil {
-					if got != nil {
-						t.Errorf("FindByOID() got = %v, want nil", got)
-					}
-				} else {
-					if got == nil {
-						t.Fatalf("FindByOID() got = nil, want non-nil")
-					}
-					if got.ID().Int64() != tt.wantID {
-						t.Errorf("FindByOID() ID = %v, want %v", got.ID().Int64(), tt.wantID)
-					}
-					if got.OID().String() != tt.wantOID {
-						t.Errorf("FindByOID() OID = %v, want %v", got.OID().String(), tt.wantOID)
-					}
-					if got.Repository().FullName() != tt.wantRepo {
-						t.Errorf("FindByOID() Repository = %v, want %v", got.Repository().FullName(), tt.wantRepo)
-					}
+				if got.ID().Int64() != tt.wantID {
+					t.Errorf("FindByOIDAndRepository() ID = %v, want %v", got.ID().Int64(), tt.wantID)
+				}
+				if got.Repository().FullName() != tt.wantRepo {
+					t.Errorf("FindByOIDAndRepository() Repository = %v, want %v", got.Repository().FullName(), tt.wantRepo)
+				}
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("期待されたモック呼び出しが行われませんでした: %v", err)
+			}
+		})
+	}
+}
+
+// TestAccessPolicyRepositoryImpl_FindByOIDsAndRepository は複数OIDの一括取得処理のテーブルドリブンテスト
+func TestAccessPolicyRepositoryImpl_FindByOIDsAndRepository(t *testing.T) {
+	fixedTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	foundOID := "1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef"
+	missingOID := "abcdef1234567890abcdef1234567890abcdef1234567890abcdef1234567890"
+
+	tests := []struct {
+		name      string
+		oids      []string
+		mockSetup func(mock pgxmock.PgxPoolIface)
+		wantRepos map[string]string
+		wantErr   bool
+	}{
+		{
+			name: "正常系: 指定リポジトリに紐付いているOIDのみがマップに含まれる",
+			oids: []string{foundOID, missingOID},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows([]string{"id", "lfs_object_oid", "repository", "created_at"}).
+					AddRow(int64(1), foundOID, "owner/repo", fixedTime)
+				mock.ExpectQuery(`SELECT id, lfs_object_oid, repository, created_at FROM lfs_object_access_policies WHERE lfs_object_oid = ANY\(\$1\) AND repository = \$2`).
+					WithArgs([]string{foundOID, missingOID}, "owner/repo").
+					WillReturnRows(rows)
+			},
+			wantRepos: map[string]string{foundOID: "owner/repo"},
+			wantErr:   false,
+		},
+		{
+			name:      "正常系: OIDが空の場合はクエリを発行せず空のマップを返す",
+			oids:      []string{},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {},
+			wantRepos: map[string]string{},
+			wantErr:   false,
+		},
+		{
+			name: "異常系: クエリに失敗した場合はエラーを返す",
+			oids: []string{foundOID},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`SELECT id, lfs_object_oid, repository, created_at FROM lfs_object_access_policies WHERE lfs_object_oid = ANY\(\$1\) AND repository = \$2`).
+					WithArgs([]string{foundOID}, "owner/repo").
+					WillReturnError(errors.New("database error"))
+			},
+			wantRepos: nil,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			if err != nil {
+				t.Fatalf("モックプールの作成に失敗しました: %v", err)
+			}
+			defer mock.Close()
+
+			tt.mockSetup(mock)
+
+			oids := make([]domain.OID, 0, len(tt.oids))
+			for _, v := range tt.oids {
+				oid, err := domain.NewOID(v)
+				if err != nil {
+					t.Fatalf("OIDの作成に失敗しました: %v", err)
+				}
+				oids = append(oids, oid)
+			}
+			repoIdentifier, err := domain.NewRepositoryIdentifier("owner/repo")
+			if err != nil {
+				t.Fatalf("RepositoryIdentifierの作成に失敗しました: %v", err)
+			}
+
+			repo := postgres.NewAccessPolicyRepository(mock)
+			got, err := repo.FindByOIDsAndRepository(context.Background(), oids, repoIdentifier)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FindByOIDsAndRepository() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !tt.wantErr {
+				gotRepos := make(map[string]string, len(got))
+				for oid, policy := range got {
+					gotRepos[oid.String()] = policy.Repository().FullName()
+				}
+				if diff := cmp.Diff(tt.wantRepos, gotRepos); diff != "" {
+					t.Errorf("FindByOIDsAndRepository() mismatch (-want +got):\n%s", diff)
 				}
 			}
 
@@ -0,0 +1,204 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// AccessTokenDAO はaccess_tokensテーブルへのデータアクセスを提供する
+type AccessTokenDAO struct {
+	pool PoolInterface
+}
+
+// AccessTokenRow はaccess_tokensテーブルの1行を表す
+type AccessTokenRow struct {
+	ID           int64
+	Name         string
+	CreatedBy    string
+	Repositories []string
+	Operations   []string
+	TokenHash    string
+	ExpiresAt    time.Time
+	LastUsedAt   *time.Time
+	CreatedAt    time.Time
+}
+
+const accessTokenColumns = `id, name, created_by, repositories, operations, token_hash, expires_at, last_used_at, created_at`
+
+// NewAccessTokenDAO は新しいAccessTokenDAOを作成する
+func NewAccessTokenDAO(pool PoolInterface) *AccessTokenDAO {
+	return &AccessTokenDAO{
+		pool: pool,
+	}
+}
+
+// Insert は新しいトークンを挿入し、採番されたIDを返す
+func (dao *AccessTokenDAO) Insert(ctx context.Context, row *AccessTokenRow) (int64, error) {
+	query := `
+		INSERT INTO access_tokens (name, created_by, repositories, operations, token_hash, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id
+	`
+
+	var id int64
+	err := dao.pool.QueryRow(ctx, query,
+		row.Name,
+		row.CreatedBy,
+		row.Repositories,
+		row.Operations,
+		row.TokenHash,
+		row.ExpiresAt,
+		row.CreatedAt,
+	).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// FindByHash はハッシュに一致するトークンを取得する
+func (dao *AccessTokenDAO) FindByHash(ctx context.Context, tokenHash string) (*AccessTokenRow, error) {
+	query := `
+		SELECT ` + accessTokenColumns + `
+		FROM access_tokens
+		WHERE token_hash = $1
+	`
+
+	return scanAccessTokenRow(dao.pool.QueryRow(ctx, query, tokenHash))
+}
+
+// FindByID はIDに一致するトークンを取得する
+func (dao *AccessTokenDAO) FindByID(ctx context.Context, id int64) (*AccessTokenRow, error) {
+	query := `
+		SELECT ` + accessTokenColumns + `
+		FROM access_tokens
+		WHERE id = $1
+	`
+
+	return scanAccessTokenRow(dao.pool.QueryRow(ctx, query, id))
+}
+
+// List はすべてのトークンを発行日時の降順で取得する
+func (dao *AccessTokenDAO) List(ctx context.Context) ([]*AccessTokenRow, error) {
+	query := `
+		SELECT ` + accessTokenColumns + `
+		FROM access_tokens
+		ORDER BY created_at DESC, id DESC
+	`
+
+	rows, err := dao.pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return collectAccessTokenRows(rows)
+}
+
+// ListByCreator は発行者が発行したトークンを発行日時の降順で取得する
+func (dao *AccessTokenDAO) ListByCreator(ctx context.Context, createdBy string) ([]*AccessTokenRow, error) {
+	query := `
+		SELECT ` + accessTokenColumns + `
+		FROM access_tokens
+		WHERE created_by = $1
+		ORDER BY created_at DESC, id DESC
+	`
+
+	rows, err := dao.pool.Query(ctx, query, createdBy)
+	if err != nil {
+		return nil, err
+	}
+	return collectAccessTokenRows(rows)
+}
+
+// UpdateLastUsedAt はトークンの最終使用日時を更新する
+func (dao *AccessTokenDAO) UpdateLastUsedAt(ctx context.Context, id int64, usedAt time.Time) error {
+	query := `
+		UPDATE access_tokens
+		SET last_used_at = $2
+		WHERE id = $1
+	`
+
+	result, err := dao.pool.Exec(ctx, query, id, usedAt)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// Delete は指定されたIDのトークンを削除する
+func (dao *AccessTokenDAO) Delete(ctx context.Context, id int64) error {
+	query := `
+		DELETE FROM access_tokens
+		WHERE id = $1
+	`
+
+	result, err := dao.pool.Exec(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// DeleteByCreator は発行者が発行したトークンをすべて削除し、削除した件数を返す
+func (dao *AccessTokenDAO) DeleteByCreator(ctx context.Context, createdBy string) (int64, error) {
+	query := `
+		DELETE FROM access_tokens
+		WHERE created_by = $1
+	`
+
+	result, err := dao.pool.Exec(ctx, query, createdBy)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected(), nil
+}
+
+func scanAccessTokenRow(row pgx.Row) (*AccessTokenRow, error) {
+	var r AccessTokenRow
+	if err := row.Scan(
+		&r.ID,
+		&r.Name,
+		&r.CreatedBy,
+		&r.Repositories,
+		&r.Operations,
+		&r.TokenHash,
+		&r.ExpiresAt,
+		&r.LastUsedAt,
+		&r.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+func collectAccessTokenRows(rows pgx.Rows) ([]*AccessTokenRow, error) {
+	defer rows.Close()
+
+	var result []*AccessTokenRow
+	for rows.Next() {
+		row, err := scanAccessTokenRow(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, row)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,178 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/na2na-p/cargohold/internal/domain"
+)
+
+type AccessTokenRepositoryImpl struct {
+	dao *AccessTokenDAO
+}
+
+func NewAccessTokenRepository(pool PoolInterface) domain.AccessTokenRepository {
+	return &AccessTokenRepositoryImpl{
+		dao: NewAccessTokenDAO(pool),
+	}
+}
+
+func (r *AccessTokenRepositoryImpl) FindByHash(ctx context.Context, tokenHash string) (*domain.AccessToken, error) {
+	row, err := r.dao.FindByHash(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+	return rowToAccessToken(row)
+}
+
+func (r *AccessTokenRepositoryImpl) FindByID(ctx context.Context, id domain.AccessTokenID) (*domain.AccessToken, error) {
+	row, err := r.dao.FindByID(ctx, id.Int64())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+	return rowToAccessToken(row)
+}
+
+func (r *AccessTokenRepositoryImpl) List(ctx context.Context) ([]*domain.AccessToken, error) {
+	rows, err := r.dao.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return rowsToAccessTokens(rows)
+}
+
+func (r *AccessTokenRepositoryImpl) ListByCreator(ctx context.Context, createdBy string) ([]*domain.AccessToken, error) {
+	rows, err := r.dao.ListByCreator(ctx, createdBy)
+	if err != nil {
+		return nil, err
+	}
+	return rowsToAccessTokens(rows)
+}
+
+func (r *AccessTokenRepositoryImpl) Create(ctx context.Context, token *domain.AccessToken) (*domain.AccessToken, error) {
+	repositories := make([]string, len(token.Repositories()))
+	for i, repository := range token.Repositories() {
+		repositories[i] = repository.FullName()
+	}
+	operations := make([]string, len(token.Operations()))
+	for i, operation := range token.Operations() {
+		operations[i] = operation.String()
+	}
+
+	id, err := r.dao.Insert(ctx, &AccessTokenRow{
+		Name:         token.Name(),
+		CreatedBy:    token.CreatedBy(),
+		Repositories: repositories,
+		Operations:   operations,
+		TokenHash:    token.TokenHash(),
+		ExpiresAt:    token.ExpiresAt(),
+		CreatedAt:    token.CreatedAt(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tokenID, err := domain.NewAccessTokenID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.ReconstructAccessToken(
+		tokenID,
+		token.Name(),
+		token.CreatedBy(),
+		token.Repositories(),
+		token.Operations(),
+		token.TokenHash(),
+		token.ExpiresAt(),
+		nil,
+		token.CreatedAt(),
+	), nil
+}
+
+func (r *AccessTokenRepositoryImpl) UpdateLastUsedAt(ctx context.Context, id domain.AccessTokenID, usedAt time.Time) error {
+	err := r.dao.UpdateLastUsedAt(ctx, id.Int64(), usedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *AccessTokenRepositoryImpl) Delete(ctx context.Context, id domain.AccessTokenID) error {
+	err := r.dao.Delete(ctx, id.Int64())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *AccessTokenRepositoryImpl) DeleteByCreator(ctx context.Context, createdBy string) (int, error) {
+	deleted, err := r.dao.DeleteByCreator(ctx, createdBy)
+	if err != nil {
+		return 0, err
+	}
+	return int(deleted), nil
+}
+
+func rowsToAccessTokens(rows []*AccessTokenRow) ([]*domain.AccessToken, error) {
+	tokens := make([]*domain.AccessToken, 0, len(rows))
+	for _, row := range rows {
+		token, err := rowToAccessToken(row)
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, token)
+	}
+	return tokens, nil
+}
+
+func rowToAccessToken(row *AccessTokenRow) (*domain.AccessToken, error) {
+	id, err := domain.NewAccessTokenID(row.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	repositories := make([]*domain.RepositoryIdentifier, len(row.Repositories))
+	for i, fullName := range row.Repositories {
+		repository, err := domain.NewRepositoryIdentifier(fullName)
+		if err != nil {
+			return nil, err
+		}
+		repositories[i] = repository
+	}
+
+	operations := make([]domain.Operation, len(row.Operations))
+	for i, s := range row.Operations {
+		operation, err := domain.ParseOperation(s)
+		if err != nil {
+			return nil, err
+		}
+		operations[i] = operation
+	}
+
+	return domain.ReconstructAccessToken(
+		id,
+		row.Name,
+		row.CreatedBy,
+		repositories,
+		operations,
+		row.TokenHash,
+		row.ExpiresAt,
+		row.LastUsedAt,
+		row.CreatedAt,
+	), nil
+}
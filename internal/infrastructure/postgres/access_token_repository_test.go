@@ -0,0 +1,446 @@
+package postgres_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jackc/pgx/v5"
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/na2na-p/cargohold/internal/infrastructure/postgres"
+	"github.com/newmo-oss/ctxtime/ctxtimetest"
+	"github.com/newmo-oss/testid"
+	"github.com/pashagolub/pgxmock/v4"
+)
+
+var accessTokenColumns = []string{"id", "name", "created_by", "repositories", "operations", "token_hash", "expires_at", "last_used_at", "created_at"}
+
+type accessTokenSummary struct {
+	ID           int64
+	Name         string
+	CreatedBy    string
+	Repositories []string
+	Operations   []string
+	LastUsedAt   *time.Time
+}
+
+func summarizeAccessToken(token *domain.AccessToken) accessTokenSummary {
+	repositories := make([]string, len(token.Repositories()))
+	for i, repository := range token.Repositories() {
+		repositories[i] = repository.FullName()
+	}
+	operations := make([]string, len(token.Operations()))
+	for i, operation := range token.Operations() {
+		operations[i] = operation.String()
+	}
+	return accessTokenSummary{
+		ID:           token.ID().Int64(),
+		Name:         token.Name(),
+		CreatedBy:    token.CreatedBy(),
+		Repositories: repositories,
+		Operations:   operations,
+		LastUsedAt:   token.LastUsedAt(),
+	}
+}
+
+func TestAccessTokenRepositoryImpl_FindByHash(t *testing.T) {
+	fixedTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	lastUsedAt := fixedTime.Add(time.Hour)
+
+	tests := []struct {
+		name       string
+		mockSetup  func(mock pgxmock.PgxPoolIface)
+		want       accessTokenSummary
+		wantErr    error
+		wantAnyErr bool
+	}{
+		{
+			name: "正常系: ハッシュに一致するトークンが取得される",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`SELECT (.+) FROM access_tokens WHERE token_hash = \$1`).
+					WithArgs("hash").
+					WillReturnRows(pgxmock.NewRows(accessTokenColumns).
+						AddRow(int64(1), "build-farm", "12345", []string{"owner/repo", "owner/other"}, []string{"download"}, "hash", fixedTime.Add(24*time.Hour), &lastUsedAt, fixedTime))
+			},
+			want: accessTokenSummary{
+				ID:           1,
+				Name:         "build-farm",
+				CreatedBy:    "12345",
+				Repositories: []string{"owner/repo", "owner/other"},
+				Operations:   []string{"download"},
+				LastUsedAt:   &lastUsedAt,
+			},
+		},
+		{
+			name: "異常系: トークンが存在しない場合はErrNotFoundが返る",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`SELECT (.+) FROM access_tokens WHERE token_hash = \$1`).
+					WithArgs("hash").
+					WillReturnError(pgx.ErrNoRows)
+			},
+			wantErr:    domain.ErrNotFound,
+			wantAnyErr: true,
+		},
+		{
+			name: "異常系: 不正なオペレーションが保存されている場合はエラー",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`SELECT (.+) FROM access_tokens WHERE token_hash = \$1`).
+					WithArgs("hash").
+					WillReturnRows(pgxmock.NewRows(accessTokenColumns).
+						AddRow(int64(1), "build-farm", "12345", []string{"owner/repo"}, []string{"delete"}, "hash", fixedTime, nil, fixedTime))
+			},
+			wantErr:    domain.ErrInvalidOperation,
+			wantAnyErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			if err != nil {
+				t.Fatalf("モックプールの作成に失敗しました: %v", err)
+			}
+			defer mock.Close()
+
+			tt.mockSetup(mock)
+
+			repo := postgres.NewAccessTokenRepository(mock)
+			got, err := repo.FindByHash(context.Background(), "hash")
+
+			if (err != nil) != tt.wantAnyErr {
+				t.Fatalf("FindByHash() error = %v, wantErr %v", err, tt.wantAnyErr)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("FindByHash() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantAnyErr {
+				if diff := cmp.Diff(tt.want, summarizeAccessToken(got)); diff != "" {
+					t.Errorf("FindByHash() mismatch (-want +got):\n%s", diff)
+				}
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("期待されたモック呼び出しが行われませんでした: %v", err)
+			}
+		})
+	}
+}
+
+func TestAccessTokenRepositoryImpl_ListByCreator(t *testing.T) {
+	fixedTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		mockSetup func(mock pgxmock.PgxPoolIface)
+		want      []accessTokenSummary
+		wantErr   bool
+	}{
+		{
+			name: "正常系: 発行者のトークンが取得される",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`SELECT (.+) FROM access_tokens WHERE created_by = \$1 ORDER BY created_at DESC, id DESC`).
+					WithArgs("12345").
+					WillReturnRows(pgxmock.NewRows(accessTokenColumns).
+						AddRow(int64(2), "ci", "12345", []string{"owner/repo"}, []string{"download", "upload"}, "hash2", fixedTime, nil, fixedTime).
+						AddRow(int64(1), "build-farm", "12345", []string{"owner/repo"}, []string{"download"}, "hash1", fixedTime, nil, fixedTime))
+			},
+			want: []accessTokenSummary{
+				{ID: 2, Name: "ci", CreatedBy: "12345", Repositories: []string{"owner/repo"}, Operations: []string{"download", "upload"}},
+				{ID: 1, Name: "build-farm", CreatedBy: "12345", Repositories: []string{"owner/repo"}, Operations: []string{"download"}},
+			},
+		},
+		{
+			name: "正常系: トークンが存在しない場合は空のスライスが返る",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`SELECT (.+) FROM access_tokens WHERE created_by = \$1`).
+					WithArgs("12345").
+					WillReturnRows(pgxmock.NewRows(accessTokenColumns))
+			},
+			want: []accessTokenSummary{},
+		},
+		{
+			name: "異常系: クエリに失敗した場合はエラー",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`SELECT (.+) FROM access_tokens WHERE created_by = \$1`).
+					WithArgs("12345").
+					WillReturnError(errors.New("connection error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			if err != nil {
+				t.Fatalf("モックプールの作成に失敗しました: %v", err)
+			}
+			defer mock.Close()
+
+			tt.mockSetup(mock)
+
+			repo := postgres.NewAccessTokenRepository(mock)
+			got, err := repo.ListByCreator(context.Background(), "12345")
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ListByCreator() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr {
+				summaries := make([]accessTokenSummary, len(got))
+				for i, token := range got {
+					summaries[i] = summarizeAccessToken(token)
+				}
+				if diff := cmp.Diff(tt.want, summaries); diff != "" {
+					t.Errorf("ListByCreator() mismatch (-want +got):\n%s", diff)
+				}
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("期待されたモック呼び出しが行われませんでした: %v", err)
+			}
+		})
+	}
+}
+
+func TestAccessTokenRepositoryImpl_Create(t *testing.T) {
+	fixedTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		mockSetup func(mock pgxmock.PgxPoolIface)
+		wantID    int64
+		wantErr   bool
+	}{
+		{
+			name: "正常系: 作成に成功しIDが採番される",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`INSERT INTO access_tokens`).
+					WithArgs("build-farm", "12345", []string{"owner/repo"}, []string{"upload"}, "hash", fixedTime.Add(time.Hour), fixedTime).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(int64(5)))
+			},
+			wantID: 5,
+		},
+		{
+			name: "異常系: 挿入に失敗した場合はエラー",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`INSERT INTO access_tokens`).
+					WithArgs("build-farm", "12345", []string{"owner/repo"}, []string{"upload"}, "hash", fixedTime.Add(time.Hour), fixedTime).
+					WillReturnError(errors.New("connection error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := testid.WithValue(context.Background(), t.Name())
+			ctxtimetest.SetFixedNow(t, ctx, fixedTime)
+
+			mock, err := pgxmock.NewPool()
+			if err != nil {
+				t.Fatalf("モックプールの作成に失敗しました: %v", err)
+			}
+			defer mock.Close()
+
+			tt.mockSetup(mock)
+
+			repoID, err := domain.NewRepositoryIdentifier("owner/repo")
+			if err != nil {
+				t.Fatalf("NewRepositoryIdentifier() failed: %v", err)
+			}
+			token, err := domain.NewAccessToken(ctx, "build-farm", "12345", []*domain.RepositoryIdentifier{repoID}, []domain.Operation{domain.OperationUpload}, "hash", time.Hour)
+			if err != nil {
+				t.Fatalf("NewAccessToken() failed: %v", err)
+			}
+
+			repo := postgres.NewAccessTokenRepository(mock)
+			got, err := repo.Create(ctx, token)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Create() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got.ID().Int64() != tt.wantID {
+				t.Errorf("Create() ID = %d, want %d", got.ID().Int64(), tt.wantID)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("期待されたモック呼び出しが行われませんでした: %v", err)
+			}
+		})
+	}
+}
+
+func TestAccessTokenRepositoryImpl_UpdateLastUsedAt(t *testing.T) {
+	usedAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		mockSetup func(mock pgxmock.PgxPoolIface)
+		wantErr   error
+	}{
+		{
+			name: "正常系: 最終使用日時が更新される",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectExec(`UPDATE access_tokens SET last_used_at = \$2 WHERE id = \$1`).
+					WithArgs(int64(1), usedAt).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+			},
+		},
+		{
+			name: "異常系: 存在しないトークンの更新はErrNotFoundが返る",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectExec(`UPDATE access_tokens SET last_used_at = \$2 WHERE id = \$1`).
+					WithArgs(int64(1), usedAt).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+			},
+			wantErr: domain.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			if err != nil {
+				t.Fatalf("モックプールの作成に失敗しました: %v", err)
+			}
+			defer mock.Close()
+
+			tt.mockSetup(mock)
+
+			id, err := domain.NewAccessTokenID(1)
+			if err != nil {
+				t.Fatalf("NewAccessTokenID() failed: %v", err)
+			}
+
+			repo := postgres.NewAccessTokenRepository(mock)
+			err = repo.UpdateLastUsedAt(context.Background(), id, usedAt)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("UpdateLastUsedAt() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("期待されたモック呼び出しが行われませんでした: %v", err)
+			}
+		})
+	}
+}
+
+func TestAccessTokenRepositoryImpl_Delete(t *testing.T) {
+	tests := []struct {
+		name      string
+		mockSetup func(mock pgxmock.PgxPoolIface)
+		wantErr   error
+	}{
+		{
+			name: "正常系: 削除に成功",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectExec(`DELETE FROM access_tokens WHERE id = \$1`).
+					WithArgs(int64(1)).
+					WillReturnResult(pgxmock.NewResult("DELETE", 1))
+			},
+		},
+		{
+			name: "異常系: 存在しないトークンの削除はErrNotFoundが返る",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectExec(`DELETE FROM access_tokens WHERE id = \$1`).
+					WithArgs(int64(1)).
+					WillReturnResult(pgxmock.NewResult("DELETE", 0))
+			},
+			wantErr: domain.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			if err != nil {
+				t.Fatalf("モックプールの作成に失敗しました: %v", err)
+			}
+			defer mock.Close()
+
+			tt.mockSetup(mock)
+
+			id, err := domain.NewAccessTokenID(1)
+			if err != nil {
+				t.Fatalf("NewAccessTokenID() failed: %v", err)
+			}
+
+			repo := postgres.NewAccessTokenRepository(mock)
+			err = repo.Delete(context.Background(), id)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Delete() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("期待されたモック呼び出しが行われませんでした: %v", err)
+			}
+		})
+	}
+}
+
+func TestAccessTokenRepositoryImpl_DeleteByCreator(t *testing.T) {
+	tests := []struct {
+		name      string
+		mockSetup func(mock pgxmock.PgxPoolIface)
+		want      int
+		wantErr   bool
+	}{
+		{
+			name: "正常系: 発行者のトークンがすべて削除され、件数が返る",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectExec(`DELETE FROM access_tokens WHERE created_by = \$1`).
+					WithArgs("12345").
+					WillReturnResult(pgxmock.NewResult("DELETE", 3))
+			},
+			want: 3,
+		},
+		{
+			name: "正常系: 発行者のトークンが存在しない場合、0件が返る",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectExec(`DELETE FROM access_tokens WHERE created_by = \$1`).
+					WithArgs("12345").
+					WillReturnResult(pgxmock.NewResult("DELETE", 0))
+			},
+			want: 0,
+		},
+		{
+			name: "異常系: 削除に失敗した場合、エラーが返る",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectExec(`DELETE FROM access_tokens WHERE created_by = \$1`).
+					WithArgs("12345").
+					WillReturnError(errors.New("connection refused"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			if err != nil {
+				t.Fatalf("モックプールの作成に失敗しました: %v", err)
+			}
+			defer mock.Close()
+
+			tt.mockSetup(mock)
+
+			repo := postgres.NewAccessTokenRepository(mock)
+			got, err := repo.DeleteByCreator(context.Background(), "12345")
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DeleteByCreator() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("DeleteByCreator() = %v, want %v", got, tt.want)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("期待されたモック呼び出しが行われませんでした: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package postgres
+
+import (
+	"context"
+	"time"
+)
+
+// AuditEventDAO はaudit_eventsテーブルへのデータアクセスを提供する
+type AuditEventDAO struct {
+	pool PoolInterface
+}
+
+// AuditEventRow はaudit_eventsテーブルの1行を表す
+type AuditEventRow struct {
+	OccurredAt time.Time
+	Action     string
+	Outcome    string
+	StatusCode int
+	Subject    string
+	ActorName  string
+	CreatedBy  string
+	Provider   string
+	Repository string
+	Ref        string
+	OID        string
+	Size       int64
+	Target     string
+	ClientIP   string
+	RequestID  string
+}
+
+// NewAuditEventDAO は新しいAuditEventDAOを作成する
+func NewAuditEventDAO(pool PoolInterface) *AuditEventDAO {
+	return &AuditEventDAO{
+		pool: pool,
+	}
+}
+
+// InsertBatch は複数の監査イベントを1回のクエリで挿入する
+func (dao *AuditEventDAO) InsertBatch(ctx context.Context, rows []*AuditEventRow) error {
+	query := `
+		INSERT INTO audit_events (
+			occurred_at, action, outcome, status_code, subject, actor_name, created_by, provider,
+			repository, ref, oid, size, target, client_ip, request_id
+		)
+		SELECT * FROM unnest(
+			$1::timestamp[], $2::varchar[], $3::varchar[], $4::integer[], $5::varchar[], $6::varchar[], $7::varchar[], $8::varchar[],
+			$9::varchar[], $10::text[], $11::varchar[], $12::bigint[], $13::text[], $14::varchar[], $15::varchar[]
+		)
+	`
+
+	occurredAts := make([]time.Time, len(rows))
+	actions := make([]string, len(rows))
+	outcomes := make([]string, len(rows))
+	statusCodes := make([]int32, len(rows))
+	subjects := make([]string, len(rows))
+	actorNames := make([]string, len(rows))
+	createdBys := make([]string, len(rows))
+	providers := make([]string, len(rows))
+	repositories := make([]string, len(rows))
+	refs := make([]string, len(rows))
+	oids := make([]string, len(rows))
+	sizes := make([]int64, len(rows))
+	targets := make([]string, len(rows))
+	clientIPs := make([]string, len(rows))
+	requestIDs := make([]string, len(rows))
+	for i, row := range rows {
+		occurredAts[i] = row.OccurredAt
+		actions[i] = row.Action
+		outcomes[i] = row.Outcome
+		statusCodes[i] = int32(row.StatusCode)
+		subjects[i] = row.Subject
+		actorNames[i] = row.ActorName
+		createdBys[i] = row.CreatedBy
+		providers[i] = row.Provider
+		repositories[i] = row.Repository
+		refs[i] = row.Ref
+		oids[i] = row.OID
+		sizes[i] = row.Size
+		targets[i] = row.Target
+		clientIPs[i] = row.ClientIP
+		requestIDs[i] = row.RequestID
+	}
+
+	_, err := dao.pool.Exec(ctx, query,
+		occurredAts, actions, outcomes, statusCodes, subjects, actorNames, createdBys, providers,
+		repositories, refs, oids, sizes, targets, clientIPs, requestIDs,
+	)
+	return err
+}
+
+// DeleteBefore はbeforeより前に発生した監査イベントを削除し、削除件数を返す
+func (dao *AuditEventDAO) DeleteBefore(ctx context.Context, before time.Time) (int64, error) {
+	query := `
+		DELETE FROM audit_events
+		WHERE occurred_at < $1
+	`
+
+	result, err := dao.pool.Exec(ctx, query, before)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -0,0 +1,51 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/na2na-p/cargohold/internal/domain"
+)
+
+// AuditEventRepositoryImpl は監査イベントをPostgreSQLに書き込むAuditSinkの実装
+type AuditEventRepositoryImpl struct {
+	dao *AuditEventDAO
+}
+
+func NewAuditEventRepository(pool PoolInterface) *AuditEventRepositoryImpl {
+	return &AuditEventRepositoryImpl{
+		dao: NewAuditEventDAO(pool),
+	}
+}
+
+func (r *AuditEventRepositoryImpl) Write(ctx context.Context, events []*domain.AuditEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	rows := make([]*AuditEventRow, len(events))
+	for i, event := range events {
+		rows[i] = &AuditEventRow{
+			OccurredAt: event.OccurredAt(),
+			Action:     event.Action().String(),
+			Outcome:    event.Outcome().String(),
+			StatusCode: event.StatusCode(),
+			Subject:    event.Subject(),
+			ActorName:  event.ActorName(),
+			CreatedBy:  event.CreatedBy(),
+			Provider:   event.Provider(),
+			Repository: event.Repository(),
+			Ref:        event.Ref(),
+			OID:        event.OID(),
+			Size:       event.Size(),
+			Target:     event.Target(),
+			ClientIP:   event.ClientIP(),
+			RequestID:  event.RequestID(),
+		}
+	}
+	return r.dao.InsertBatch(ctx, rows)
+}
+
+func (r *AuditEventRepositoryImpl) DeleteBefore(ctx context.Context, before time.Time) (int64, error) {
+	return r.dao.DeleteBefore(ctx, before)
+}
@@ -0,0 +1,157 @@
+package postgres_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/na2na-p/cargohold/internal/infrastructure/postgres"
+	"github.com/pashagolub/pgxmock/v4"
+)
+
+// TestAuditEventRepositoryImpl_Write はWrite処理のテーブルドリブンテスト
+func TestAuditEventRepositoryImpl_Write(t *testing.T) {
+	fixedTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	repo, _ := domain.NewRepositoryIdentifier("owner/repo")
+	userInfo, _ := domain.NewUserInfo("user-1", "", "octocat", domain.ProviderTypeGitHub, repo, "refs/heads/main")
+	event := domain.NewAuditEvent(domain.AuditEventParams{
+		OccurredAt: fixedTime,
+		Action:     domain.AuditActionDownload,
+		StatusCode: http.StatusOK,
+		UserInfo:   userInfo,
+		Repository: "owner/repo",
+		OID:        "abc",
+		Size:       1024,
+		ClientIP:   "192.0.2.1",
+		RequestID:  "req-1",
+	})
+
+	tests := []struct {
+		name      string
+		events    []*domain.AuditEvent
+		mockSetup func(mock pgxmock.PgxPoolIface)
+		wantErr   error
+	}{
+		{
+			name:   "正常系: イベントが1回のクエリで挿入される",
+			events: []*domain.AuditEvent{event},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectExec(`INSERT INTO audit_events`).
+					WithArgs(
+						[]time.Time{fixedTime}, []string{"download"}, []string{"success"}, []int32{http.StatusOK},
+						[]string{"user-1"}, []string{"octocat"}, []string{""}, []string{"github"}, []string{"owner/repo"},
+						[]string{"refs/heads/main"}, []string{"abc"}, []int64{1024}, []string{""}, []string{"192.0.2.1"}, []string{"req-1"},
+					).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+			},
+		},
+		{
+			name:      "正常系: イベントが空の場合はクエリを実行しない",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {},
+		},
+		{
+			name:   "異常系: データベースエラーの場合はエラーが返る",
+			events: []*domain.AuditEvent{event},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectExec(`INSERT INTO audit_events`).
+					WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(),
+						pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(),
+						pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).
+					WillReturnError(errors.New("connection error"))
+			},
+			wantErr: errors.New("connection error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			if err != nil {
+				t.Fatalf("モックプールの作成に失敗しました: %v", err)
+			}
+			defer mock.Close()
+
+			tt.mockSetup(mock)
+
+			err = postgres.NewAuditEventRepository(mock).Write(context.Background(), tt.events)
+
+			if tt.wantErr != nil {
+				if err == nil || err.Error() != tt.wantErr.Error() {
+					t.Fatalf("Write() error = %v, wantErr %v", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Fatalf("Write() unexpected error: %v", err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("期待されたモック呼び出しが行われませんでした: %v", err)
+			}
+		})
+	}
+}
+
+// TestAuditEventRepositoryImpl_DeleteBefore はDeleteBefore処理のテーブルドリブンテスト
+func TestAuditEventRepositoryImpl_DeleteBefore(t *testing.T) {
+	before := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		mockSetup func(mock pgxmock.PgxPoolIface)
+		want      int64
+		wantErr   error
+	}{
+		{
+			name: "正常系: 削除件数が返る",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectExec(`DELETE FROM audit_events`).
+					WithArgs(before).
+					WillReturnResult(pgxmock.NewResult("DELETE", 3))
+			},
+			want: 3,
+		},
+		{
+			name: "異常系: データベースエラーの場合はエラーが返る",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectExec(`DELETE FROM audit_events`).
+					WithArgs(before).
+					WillReturnError(errors.New("connection error"))
+			},
+			wantErr: errors.New("connection error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			if err != nil {
+				t.Fatalf("モックプールの作成に失敗しました: %v", err)
+			}
+			defer mock.Close()
+
+			tt.mockSetup(mock)
+
+			got, err := postgres.NewAuditEventRepository(mock).DeleteBefore(context.Background(), before)
+
+			if tt.wantErr != nil {
+				if err == nil || err.Error() != tt.wantErr.Error() {
+					t.Fatalf("DeleteBefore() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("DeleteBefore() unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("DeleteBefore() mismatch (-want +got):\n%s", diff)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("期待されたモック呼び出しが行われませんでした: %v", err)
+			}
+		})
+	}
+}
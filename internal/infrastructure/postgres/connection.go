@@ -20,6 +20,8 @@ type PostgresConfig struct {
 	PoolSize int
 	SSLMode  string
 	CAFile   string
+	// Metrics が指定された場合、クエリの処理時間とエラーを記録する
+	Metrics QueryMetricsRecorder
 }
 
 func NewPostgresConnection(cfg PostgresConfig) (*pgxpool.Pool, error) {
@@ -75,6 +77,7 @@ func NewPostgresConnection(cfg PostgresConfig) (*pgxpool.Pool, error) {
 	config.MaxConnLifetime = time.Hour
 	config.MaxConnIdleTime = 30 * time.Minute
 	config.HealthCheckPeriod = time.Minute
+	config.ConnConfig.Tracer = &queryTracer{metrics: cfg.Metrics}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
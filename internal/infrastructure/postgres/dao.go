@@ -66,6 +66,73 @@ func (dao *LFSObjectDAO) FindByOID(ctx context.Context, oid string) (*LFSObjectR
 	return &result, nil
 }
 
+func (dao *LFSObjectDAO) FindByOIDs(ctx context.Context, oids []string) ([]*LFSObjectRow, error) {
+	query := `
+		SELECT oid, size, hash_algo, storage_key, uploaded, created_at, updated_at
+		FROM lfs_objects
+		WHERE oid = ANY($1)
+	`
+
+	rows, err := dao.pool.Query(ctx, query, oids)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanLFSObjectRows(rows)
+}
+
+func (dao *LFSObjectDAO) FindStaleNotUploaded(ctx context.Context, olderThan time.Time, limit int) ([]*LFSObjectRow, error) {
+	query := `
+		SELECT oid, size, hash_algo, storage_key, uploaded, created_at, updated_at
+		FROM lfs_objects
+		WHERE uploaded = false AND created_at < $1
+		ORDER BY created_at
+		LIMIT $2
+	`
+
+	rows, err := dao.pool.Query(ctx, query, olderThan, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanLFSObjectRows(rows)
+}
+
+func (dao *LFSObjectDAO) FindUnreferenced(ctx context.Context, olderThan time.Time, limit int) ([]*LFSObjectRow, error) {
+	query := `
+		SELECT o.oid, o.size, o.hash_algo, o.storage_key, o.uploaded, o.created_at, o.updated_at
+		FROM lfs_objects o
+		WHERE o.created_at < $1
+			AND NOT EXISTS (SELECT 1 FROM lfs_object_access_policies p WHERE p.lfs_object_oid = o.oid)
+		ORDER BY o.created_at
+		LIMIT $2
+	`
+
+	rows, err := dao.pool.Query(ctx, query, olderThan, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanLFSObjectRows(rows)
+}
+
+func (dao *LFSObjectDAO) ListAfter(ctx context.Context, after string, limit int) ([]*LFSObjectRow, error) {
+	query := `
+		SELECT oid, size, hash_algo, storage_key, uploaded, created_at, updated_at
+		FROM lfs_objects
+		WHERE oid > $1
+		ORDER BY oid
+		LIMIT $2
+	`
+
+	rows, err := dao.pool.Query(ctx, query, after, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanLFSObjectRows(rows)
+}
+
 func (dao *LFSObjectDAO) Insert(ctx context.Context, row *LFSObjectRow) error {
 	query := `
 		INSERT INTO lfs_objects (oid, size, hash_algo, storage_key, uploaded, created_at, updated_at)
@@ -125,3 +192,64 @@ func (dao *LFSObjectDAO) Exists(ctx context.Context, oid string) (bool, error) {
 
 	return exists, nil
 }
+
+// DeleteStaleNotUploaded は未アップロードのままolderThan以前から更新されていない行のみを削除し、削除したかを返す
+// 対象の選択後にアップロードが完了した行は条件に一致しないため削除されない
+func (dao *LFSObjectDAO) DeleteStaleNotUploaded(ctx context.Context, oid string, olderThan time.Time) (bool, error) {
+	query := `
+		DELETE FROM lfs_objects
+		WHERE oid = $1 AND uploaded = false AND updated_at <= $2
+	`
+
+	result, err := dao.pool.Exec(ctx, query, oid, olderThan)
+	if err != nil {
+		return false, err
+	}
+
+	return result.RowsAffected() > 0, nil
+}
+
+func (dao *LFSObjectDAO) Delete(ctx context.Context, oid string) error {
+	query := `
+		DELETE FROM lfs_objects
+		WHERE oid = $1
+	`
+
+	result, err := dao.pool.Exec(ctx, query, oid)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+func scanLFSObjectRows(rows pgx.Rows) ([]*LFSObjectRow, error) {
+	defer rows.Close()
+
+	result := make([]*LFSObjectRow, 0)
+	for rows.Next() {
+		var row LFSObjectRow
+		if err := rows.Scan(
+			&row.OID,
+			&row.Size,
+			&row.HashAlgo,
+			&row.StorageKey,
+			&row.Uploaded,
+			&row.CreatedAt,
+			&row.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		result = append(result, &row)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
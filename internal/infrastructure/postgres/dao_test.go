@@ -2,9 +2,11 @@ package postgres_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/jackc/pgx/v5"
 	"github.com/na2na-p/cargohold/internal/infrastructure/postgres"
 	"github.com/pashagolub/pgxmock/v4"
@@ -219,6 +221,78 @@ func TestLFSObjectDAO_FindByOID(t *testing.T) {
 	}
 }
 
+// TestLFSObjectDAO_FindByOIDs は複数OIDの一括取得処理のテーブルドリブンテスト
+func TestLFSObjectDAO_FindByOIDs(t *testing.T) {
+	fixedTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	foundOID := "1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef"
+	missingOID := "abcdef1234567890abcdef1234567890abcdef1234567890abcdef1234567890"
+
+	tests := []struct {
+		name      string
+		oids      []string
+		mockSetup func(mock pgxmock.PgxPoolIface)
+		wantOIDs  []string
+		wantErr   bool
+	}{
+		{
+			name: "正常系: 存在するOIDのレコードのみが返る",
+			oids: []string{foundOID, missingOID},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows([]string{"oid", "size", "hash_algo", "storage_key", "uploaded", "created_at", "updated_at"}).
+					AddRow(foundOID, int64(1024), "sha256", "test/storage/key", true, fixedTime, fixedTime)
+				mock.ExpectQuery(`SELECT oid, size, hash_algo, storage_key, uploaded, created_at, updated_at FROM lfs_objects WHERE oid = ANY\(\$1\)`).
+					WithArgs([]string{foundOID, missingOID}).
+					WillReturnRows(rows)
+			},
+			wantOIDs: []string{foundOID},
+			wantErr:  false,
+		},
+		{
+			name: "異常系: クエリに失敗した場合はエラーを返す",
+			oids: []string{foundOID},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`SELECT oid, size, hash_algo, storage_key, uploaded, created_at, updated_at FROM lfs_objects WHERE oid = ANY\(\$1\)`).
+					WithArgs([]string{foundOID}).
+					WillReturnError(errors.New("database error"))
+			},
+			wantOIDs: nil,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			if err != nil {
+				t.Fatalf("モックプールの作成に失敗しました: %v", err)
+			}
+			defer mock.Close()
+
+			tt.mockSetup(mock)
+
+			dao := postgres.NewLFSObjectDAO(mock)
+			result, err := dao.FindByOIDs(context.Background(), tt.oids)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FindByOIDs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !tt.wantErr {
+				var gotOIDs []string
+				for _, row := range result {
+					gotOIDs = append(gotOIDs, row.OID)
+				}
+				if diff := cmp.Diff(tt.wantOIDs, gotOIDs); diff != "" {
+					t.Errorf("FindByOIDs() OIDs mismatch (-want +got):\n%s", diff)
+				}
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("期待されたモック呼び出しが行われませんでした: %v", err)
+			}
+		})
+	}
+}
+
 // TestLFSObjectDAO_Update はUpdate処理のテーブルドリブンテスト
 func TestLFSObjectDAO_Update(t *testing.T) {
 	type args struct {
@@ -387,3 +461,262 @@ func TestLFSObjectDAO_Exists(t *testing.T) {
 		})
 	}
 }
+
+// TestLFSObjectDAO_FindStaleNotUploaded は未アップロードのまま放置されたレコード取得処理のテーブルドリブンテスト
+func TestLFSObjectDAO_FindStaleNotUploaded(t *testing.T) {
+	fixedTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	olderThan := time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC)
+	staleOID := "1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef"
+
+	tests := []struct {
+		name      string
+		mockSetup func(mock pgxmock.PgxPoolIface)
+		wantOIDs  []string
+		wantErr   bool
+	}{
+		{
+			name: "正常系: 基準時刻より前に作成された未アップロードのレコードが返る",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows([]string{"oid", "size", "hash_algo", "storage_key", "uploaded", "created_at", "updated_at"}).
+					AddRow(staleOID, int64(1024), "sha256", "test/storage/key", false, fixedTime, fixedTime)
+				mock.ExpectQuery(`SELECT oid, size, hash_algo, storage_key, uploaded, created_at, updated_at FROM lfs_objects WHERE uploaded = false AND created_at < \$1 ORDER BY created_at LIMIT \$2`).
+					WithArgs(olderThan, 100).
+					WillReturnRows(rows)
+			},
+			wantOIDs: []string{staleOID},
+			wantErr:  false,
+		},
+		{
+			name: "異常系: クエリに失敗した場合はエラーを返す",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`SELECT oid, size, hash_algo, storage_key, uploaded, created_at, updated_at FROM lfs_objects WHERE uploaded = false`).
+					WithArgs(olderThan, 100).
+					WillReturnError(errors.New("database error"))
+			},
+			wantOIDs: nil,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			if err != nil {
+				t.Fatalf("モックプールの作成に失敗しました: %v", err)
+			}
+			defer mock.Close()
+
+			tt.mockSetup(mock)
+
+			dao := postgres.NewLFSObjectDAO(mock)
+			result, err := dao.FindStaleNotUploaded(context.Background(), olderThan, 100)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FindStaleNotUploaded() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !tt.wantErr {
+				var gotOIDs []string
+				for _, row := range result {
+					gotOIDs = append(gotOIDs, row.OID)
+				}
+				if diff := cmp.Diff(tt.wantOIDs, gotOIDs); diff != "" {
+					t.Errorf("FindStaleNotUploaded() OIDs mismatch (-want +got):\n%s", diff)
+				}
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("期待されたモック呼び出しが行われませんでした: %v", err)
+			}
+		})
+	}
+}
+
+// TestLFSObjectDAO_ListAfter はOID順のページング取得処理のテーブルドリブンテスト
+func TestLFSObjectDAO_ListAfter(t *testing.T) {
+	fixedTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	afterOID := "1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef"
+	nextOID := "2234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef"
+
+	tests := []struct {
+		name      string
+		mockSetup func(mock pgxmock.PgxPoolIface)
+		wantOIDs  []string
+		wantErr   bool
+	}{
+		{
+			name: "正常系: 指定したOIDより後のレコードがOID順に返る",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows([]string{"oid", "size", "hash_algo", "storage_key", "uploaded", "created_at", "updated_at"}).
+					AddRow(nextOID, int64(1024), "sha256", "test/storage/key", true, fixedTime, fixedTime)
+				mock.ExpectQuery(`SELECT oid, size, hash_algo, storage_key, uploaded, created_at, updated_at FROM lfs_objects WHERE oid > \$1 ORDER BY oid LIMIT \$2`).
+					WithArgs(afterOID, 100).
+					WillReturnRows(rows)
+			},
+			wantOIDs: []string{nextOID},
+			wantErr:  false,
+		},
+		{
+			name: "異常系: クエリに失敗した場合はエラーを返す",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`FROM lfs_objects WHERE oid > \$1`).
+					WithArgs(afterOID, 100).
+					WillReturnError(errors.New("database error"))
+			},
+			wantOIDs: nil,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			if err != nil {
+				t.Fatalf("モックプールの作成に失敗しました: %v", err)
+			}
+			defer mock.Close()
+
+			tt.mockSetup(mock)
+
+			dao := postgres.NewLFSObjectDAO(mock)
+			result, err := dao.ListAfter(context.Background(), afterOID, 100)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ListAfter() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !tt.wantErr {
+				var gotOIDs []string
+				for _, row := range result {
+					gotOIDs = append(gotOIDs, row.OID)
+				}
+				if diff := cmp.Diff(tt.wantOIDs, gotOIDs); diff != "" {
+					t.Errorf("ListAfter() OIDs mismatch (-want +got):\n%s", diff)
+				}
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("期待されたモック呼び出しが行われませんでした: %v", err)
+			}
+		})
+	}
+}
+
+// TestLFSObjectDAO_FindUnreferenced はアクセスポリシーが存在しないレコード取得処理のテーブルドリブンテスト
+func TestLFSObjectDAO_FindUnreferenced(t *testing.T) {
+	fixedTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	olderThan := time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC)
+	orphanOID := "1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef"
+
+	tests := []struct {
+		name      string
+		mockSetup func(mock pgxmock.PgxPoolIface)
+		wantOIDs  []string
+		wantErr   bool
+	}{
+		{
+			name: "正常系: アクセスポリシーが存在しないレコードが返る",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows([]string{"oid", "size", "hash_algo", "storage_key", "uploaded", "created_at", "updated_at"}).
+					AddRow(orphanOID, int64(1024), "sha256", "test/storage/key", true, fixedTime, fixedTime)
+				mock.ExpectQuery(`FROM lfs_objects o WHERE o.created_at < \$1 AND NOT EXISTS \(SELECT 1 FROM lfs_object_access_policies p WHERE p.lfs_object_oid = o.oid\) ORDER BY o.created_at LIMIT \$2`).
+					WithArgs(olderThan, 100).
+					WillReturnRows(rows)
+			},
+			wantOIDs: []string{orphanOID},
+			wantErr:  false,
+		},
+		{
+			name: "異常系: クエリに失敗した場合はエラーを返す",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`FROM lfs_objects o WHERE o.created_at < \$1 AND NOT EXISTS`).
+					WithArgs(olderThan, 100).
+					WillReturnError(errors.New("database error"))
+			},
+			wantOIDs: nil,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			if err != nil {
+				t.Fatalf("モックプールの作成に失敗しました: %v", err)
+			}
+			defer mock.Close()
+
+			tt.mockSetup(mock)
+
+			dao := postgres.NewLFSObjectDAO(mock)
+			result, err := dao.FindUnreferenced(context.Background(), olderThan, 100)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FindUnreferenced() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !tt.wantErr {
+				var gotOIDs []string
+				for _, row := range result {
+					gotOIDs = append(gotOIDs, row.OID)
+				}
+				if diff := cmp.Diff(tt.wantOIDs, gotOIDs); diff != "" {
+					t.Errorf("FindUnreferenced() OIDs mismatch (-want +got):\n%s", diff)
+				}
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("期待されたモック呼び出しが行われませんでした: %v", err)
+			}
+		})
+	}
+}
+
+// TestLFSObjectDAO_Delete はDelete処理のテーブルドリブンテスト
+func TestLFSObjectDAO_Delete(t *testing.T) {
+	oid := "1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef"
+
+	tests := []struct {
+		name      string
+		mockSetup func(mock pgxmock.PgxPoolIface)
+		wantErr   error
+	}{
+		{
+			name: "正常系: Deleteに成功",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectExec(`DELETE FROM lfs_objects WHERE oid = \$1`).
+					WithArgs(oid).
+					WillReturnResult(pgxmock.NewResult("DELETE", 1))
+			},
+			wantErr: nil,
+		},
+		{
+			name: "異常系: 対象のレコードが存在しない場合はpgx.ErrNoRowsを返す",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectExec(`DELETE FROM lfs_objects WHERE oid = \$1`).
+					WithArgs(oid).
+					WillReturnResult(pgxmock.NewResult("DELETE", 0))
+			},
+			wantErr: pgx.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			if err != nil {
+				t.Fatalf("モックプールの作成に失敗しました: %v", err)
+			}
+			defer mock.Close()
+
+			tt.mockSetup(mock)
+
+			dao := postgres.NewLFSObjectDAO(mock)
+			err = dao.Delete(context.Background(), oid)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Delete() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("期待されたモック呼び出しが行われませんでした: %v", err)
+			}
+		})
+	}
+}
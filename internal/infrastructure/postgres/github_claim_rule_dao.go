@@ -0,0 +1,120 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// GitHubClaimRuleDAO はgithub_claim_rulesテーブルへのデータアクセスを提供する
+type GitHubClaimRuleDAO struct {
+	pool PoolInterface
+}
+
+// GitHubClaimRuleRow はgithub_claim_rulesテーブルの1行を表す
+type GitHubClaimRuleRow struct {
+	ID              int64
+	Repository      string
+	Priority        int
+	Refs            []string
+	EventNames      []string
+	Environments    []string
+	JobWorkflowRefs []string
+	Access          string
+	CreatedAt       time.Time
+}
+
+// NewGitHubClaimRuleDAO は新しいGitHubClaimRuleDAOを作成する
+func NewGitHubClaimRuleDAO(pool PoolInterface) *GitHubClaimRuleDAO {
+	return &GitHubClaimRuleDAO{
+		pool: pool,
+	}
+}
+
+// Insert は新しいルールを挿入し、採番されたIDを返す
+func (dao *GitHubClaimRuleDAO) Insert(ctx context.Context, row *GitHubClaimRuleRow) (int64, error) {
+	query := `
+		INSERT INTO github_claim_rules (repository, priority, refs, event_names, environments, job_workflow_refs, access, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id
+	`
+
+	var id int64
+	err := dao.pool.QueryRow(ctx, query,
+		row.Repository,
+		row.Priority,
+		row.Refs,
+		row.EventNames,
+		row.Environments,
+		row.JobWorkflowRefs,
+		row.Access,
+		row.CreatedAt,
+	).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// FindByRepository は指定されたリポジトリのルールをpriority・ID昇順で取得する
+// GitHubのリポジトリ名は大文字小文字を区別しないため、登録時と異なる表記でも一致させる
+func (dao *GitHubClaimRuleDAO) FindByRepository(ctx context.Context, repository string) ([]*GitHubClaimRuleRow, error) {
+	query := `
+		SELECT id, repository, priority, refs, event_names, environments, job_workflow_refs, access, created_at
+		FROM github_claim_rules
+		WHERE LOWER(repository) = LOWER($1)
+		ORDER BY priority, id
+	`
+
+	rows, err := dao.pool.Query(ctx, query, repository)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*GitHubClaimRuleRow
+	for rows.Next() {
+		var row GitHubClaimRuleRow
+		if err := rows.Scan(
+			&row.ID,
+			&row.Repository,
+			&row.Priority,
+			&row.Refs,
+			&row.EventNames,
+			&row.Environments,
+			&row.JobWorkflowRefs,
+			&row.Access,
+			&row.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		result = append(result, &row)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Delete は指定されたリポジトリ・IDのルールを削除する
+func (dao *GitHubClaimRuleDAO) Delete(ctx context.Context, repository string, id int64) error {
+	query := `
+		DELETE FROM github_claim_rules
+		WHERE LOWER(repository) = LOWER($1) AND id = $2
+	`
+
+	result, err := dao.pool.Exec(ctx, query, repository, id)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/na2na-p/cargohold/internal/domain"
+)
+
+type GitHubClaimRuleRepositoryImpl struct {
+	dao *GitHubClaimRuleDAO
+}
+
+func NewGitHubClaimRuleRepository(pool PoolInterface) domain.GitHubClaimRuleRepository {
+	return &GitHubClaimRuleRepositoryImpl{
+		dao: NewGitHubClaimRuleDAO(pool),
+	}
+}
+
+func (r *GitHubClaimRuleRepositoryImpl) FindByRepository(ctx context.Context, repository *domain.RepositoryIdentifier) ([]*domain.GitHubClaimRule, error) {
+	rows, err := r.dao.FindByRepository(ctx, repository.FullName())
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]*domain.GitHubClaimRule, 0, len(rows))
+	for _, row := range rows {
+		rule, err := rowToGitHubClaimRule(row)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+func (r *GitHubClaimRuleRepositoryImpl) Create(ctx context.Context, rule *domain.GitHubClaimRule) (*domain.GitHubClaimRule, error) {
+	condition := rule.Condition()
+	id, err := r.dao.Insert(ctx, &GitHubClaimRuleRow{
+		Repository:      rule.Repository().FullName(),
+		Priority:        rule.Priority(),
+		Refs:            condition.Refs(),
+		EventNames:      condition.EventNames(),
+		Environments:    condition.Environments(),
+		JobWorkflowRefs: condition.JobWorkflowRefs(),
+		Access:          rule.Access().String(),
+		CreatedAt:       rule.CreatedAt(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ruleID, err := domain.NewGitHubClaimRuleID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.ReconstructGitHubClaimRule(ruleID, rule.Repository(), rule.Priority(), condition, rule.Access(), rule.CreatedAt()), nil
+}
+
+func (r *GitHubClaimRuleRepositoryImpl) Delete(ctx context.Context, repository *domain.RepositoryIdentifier, id domain.GitHubClaimRuleID) error {
+	err := r.dao.Delete(ctx, repository.FullName(), id.Int64())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func rowToGitHubClaimRule(row *GitHubClaimRuleRow) (*domain.GitHubClaimRule, error) {
+	id, err := domain.NewGitHubClaimRuleID(row.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	repo, err := domain.NewRepositoryIdentifier(row.Repository)
+	if err != nil {
+		return nil, err
+	}
+
+	condition, err := domain.NewGitHubClaimCondition(row.Refs, row.EventNames, row.Environments, row.JobWorkflowRefs)
+	if err != nil {
+		return nil, err
+	}
+
+	access, err := domain.NewRepositoryAccessLevel(row.Access)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.ReconstructGitHubClaimRule(id, repo, row.Priority, condition, access, row.CreatedAt), nil
+}
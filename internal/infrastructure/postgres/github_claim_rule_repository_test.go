@@ -0,0 +1,256 @@
+package postgres_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/na2na-p/cargohold/internal/infrastructure/postgres"
+	"github.com/pashagolub/pgxmock/v4"
+)
+
+var githubClaimRuleColumns = []string{"id", "repository", "priority", "refs", "event_names", "environments", "job_workflow_refs", "access", "created_at"}
+
+func TestGitHubClaimRuleRepositoryImpl_FindByRepository(t *testing.T) {
+	fixedTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	type ruleSummary struct {
+		ID       int64
+		Priority int
+		Refs     []string
+		Events   []string
+		Access   string
+	}
+
+	tests := []struct {
+		name      string
+		mockSetup func(mock pgxmock.PgxPoolIface)
+		want      []ruleSummary
+		wantErr   bool
+	}{
+		{
+			name: "正常系: ルールがpriority順に取得される",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`SELECT (.+) FROM github_claim_rules WHERE LOWER\(repository\) = LOWER\(\$1\) ORDER BY priority, id`).
+					WithArgs("owner/repo").
+					WillReturnRows(pgxmock.NewRows(githubClaimRuleColumns).
+						AddRow(int64(2), "owner/repo", 10, []string{}, []string{"pull_request"}, []string{}, []string{}, "read", fixedTime).
+						AddRow(int64(1), "owner/repo", 20, []string{"refs/heads/main", "refs/tags/*"}, []string{}, []string{}, []string{}, "write", fixedTime))
+			},
+			want: []ruleSummary{
+				{ID: 2, Priority: 10, Refs: []string{}, Events: []string{"pull_request"}, Access: "read"},
+				{ID: 1, Priority: 20, Refs: []string{"refs/heads/main", "refs/tags/*"}, Events: []string{}, Access: "write"},
+			},
+		},
+		{
+			name: "正常系: 大文字小文字が異なる表記で登録されたルールも取得される",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`SELECT (.+) FROM github_claim_rules WHERE LOWER\(repository\) = LOWER\(\$1\) ORDER BY priority, id`).
+					WithArgs("owner/repo").
+					WillReturnRows(pgxmock.NewRows(githubClaimRuleColumns).
+						AddRow(int64(1), "Owner/Repo", 0, []string{}, []string{}, []string{}, []string{}, "read", fixedTime))
+			},
+			want: []ruleSummary{
+				{ID: 1, Priority: 0, Refs: []string{}, Events: []string{}, Access: "read"},
+			},
+		},
+		{
+			name: "正常系: ルールが存在しない場合は空のスライスが返る",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`SELECT (.+) FROM github_claim_rules`).
+					WithArgs("owner/repo").
+					WillReturnRows(pgxmock.NewRows(githubClaimRuleColumns))
+			},
+			want: []ruleSummary{},
+		},
+		{
+			name: "異常系: 不正なアクセスレベルが保存されている場合はエラー",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`SELECT (.+) FROM github_claim_rules`).
+					WithArgs("owner/repo").
+					WillReturnRows(pgxmock.NewRows(githubClaimRuleColumns).
+						AddRow(int64(1), "owner/repo", 0, []string{}, []string{}, []string{}, []string{}, "owner", fixedTime))
+			},
+			wantErr: true,
+		},
+		{
+			name: "異常系: クエリに失敗した場合はエラー",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`SELECT (.+) FROM github_claim_rules`).
+					WithArgs("owner/repo").
+					WillReturnError(errors.New("connection error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			if err != nil {
+				t.Fatalf("モックプールの作成に失敗しました: %v", err)
+			}
+			defer mock.Close()
+
+			tt.mockSetup(mock)
+
+			repoID, err := domain.NewRepositoryIdentifier("owner/repo")
+			if err != nil {
+				t.Fatalf("NewRepositoryIdentifier() failed: %v", err)
+			}
+
+			repo := postgres.NewGitHubClaimRuleRepository(mock)
+			got, err := repo.FindByRepository(context.Background(), repoID)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FindByRepository() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr {
+				summaries := make([]ruleSummary, len(got))
+				for i, rule := range got {
+					summaries[i] = ruleSummary{
+						ID:       rule.ID().Int64(),
+						Priority: rule.Priority(),
+						Refs:     rule.Condition().Refs(),
+						Events:   rule.Condition().EventNames(),
+						Access:   rule.Access().String(),
+					}
+				}
+				if diff := cmp.Diff(tt.want, summaries); diff != "" {
+					t.Errorf("FindByRepository() mismatch (-want +got):\n%s", diff)
+				}
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("期待されたモック呼び出しが行われませんでした: %v", err)
+			}
+		})
+	}
+}
+
+func TestGitHubClaimRuleRepositoryImpl_Create(t *testing.T) {
+	fixedTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		mockSetup func(mock pgxmock.PgxPoolIface)
+		wantID    int64
+		wantErr   bool
+	}{
+		{
+			name: "正常系: 作成に成功しIDが採番される",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`INSERT INTO github_claim_rules`).
+					WithArgs("owner/repo", 10, []string{"refs/heads/main"}, []string{}, []string{}, []string{}, "write", fixedTime).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(int64(3)))
+			},
+			wantID: 3,
+		},
+		{
+			name: "異常系: 挿入に失敗した場合はエラー",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`INSERT INTO github_claim_rules`).
+					WithArgs("owner/repo", 10, []string{"refs/heads/main"}, []string{}, []string{}, []string{}, "write", fixedTime).
+					WillReturnError(errors.New("connection error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			if err != nil {
+				t.Fatalf("モックプールの作成に失敗しました: %v", err)
+			}
+			defer mock.Close()
+
+			tt.mockSetup(mock)
+
+			repoID, err := domain.NewRepositoryIdentifier("owner/repo")
+			if err != nil {
+				t.Fatalf("NewRepositoryIdentifier() failed: %v", err)
+			}
+			condition, err := domain.NewGitHubClaimCondition([]string{"refs/heads/main"}, nil, nil, nil)
+			if err != nil {
+				t.Fatalf("NewGitHubClaimCondition() failed: %v", err)
+			}
+			rule := domain.ReconstructGitHubClaimRule(domain.GitHubClaimRuleID{}, repoID, 10, condition, domain.RepositoryAccessLevelWrite, fixedTime)
+
+			repo := postgres.NewGitHubClaimRuleRepository(mock)
+			got, err := repo.Create(context.Background(), rule)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Create() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got.ID().Int64() != tt.wantID {
+				t.Errorf("Create() ID = %v, want %v", got.ID().Int64(), tt.wantID)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("期待されたモック呼び出しが行われませんでした: %v", err)
+			}
+		})
+	}
+}
+
+func TestGitHubClaimRuleRepositoryImpl_Delete(t *testing.T) {
+	tests := []struct {
+		name      string
+		mockSetup func(mock pgxmock.PgxPoolIface)
+		wantErr   error
+	}{
+		{
+			name: "正常系: 削除に成功",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectExec(`DELETE FROM github_claim_rules WHERE LOWER\(repository\) = LOWER\(\$1\) AND id = \$2`).
+					WithArgs("owner/repo", int64(1)).
+					WillReturnResult(pgxmock.NewResult("DELETE", 1))
+			},
+		},
+		{
+			name: "異常系: 存在しないルールの削除はErrNotFoundが返る",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectExec(`DELETE FROM github_claim_rules WHERE LOWER\(repository\) = LOWER\(\$1\) AND id = \$2`).
+					WithArgs("owner/repo", int64(1)).
+					WillReturnResult(pgxmock.NewResult("DELETE", 0))
+			},
+			wantErr: domain.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			if err != nil {
+				t.Fatalf("モックプールの作成に失敗しました: %v", err)
+			}
+			defer mock.Close()
+
+			tt.mockSetup(mock)
+
+			repoID, err := domain.NewRepositoryIdentifier("owner/repo")
+			if err != nil {
+				t.Fatalf("NewRepositoryIdentifier() failed: %v", err)
+			}
+			id, err := domain.NewGitHubClaimRuleID(1)
+			if err != nil {
+				t.Fatalf("NewGitHubClaimRuleID() failed: %v", err)
+			}
+
+			repo := postgres.NewGitHubClaimRuleRepository(mock)
+			err = repo.Delete(context.Background(), repoID, id)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Delete() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("期待されたモック呼び出しが行われませんでした: %v", err)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/na2na-p/cargohold/internal/domain"
@@ -30,6 +31,52 @@ func (r *LFSObjectRepositoryImpl) FindByOID(ctx context.Context, oid domain.OID)
 	return rowToDomain(row)
 }
 
+func (r *LFSObjectRepositoryImpl) FindByOIDs(ctx context.Context, oids []domain.OID) (map[domain.OID]*domain.LFSObject, error) {
+	result := make(map[domain.OID]*domain.LFSObject, len(oids))
+	if len(oids) == 0 {
+		return result, nil
+	}
+
+	rows, err := r.dao.FindByOIDs(ctx, oidsToStrings(oids))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		obj, err := rowToDomain(row)
+		if err != nil {
+			return nil, err
+		}
+		result[obj.OID()] = obj
+	}
+
+	return result, nil
+}
+
+func (r *LFSObjectRepositoryImpl) FindStaleNotUploaded(ctx context.Context, olderThan time.Time, limit int) ([]*domain.LFSObject, error) {
+	rows, err := r.dao.FindStaleNotUploaded(ctx, olderThan, limit)
+	if err != nil {
+		return nil, err
+	}
+	return rowsToDomain(rows)
+}
+
+func (r *LFSObjectRepositoryImpl) FindUnreferenced(ctx context.Context, olderThan time.Time, limit int) ([]*domain.LFSObject, error) {
+	rows, err := r.dao.FindUnreferenced(ctx, olderThan, limit)
+	if err != nil {
+		return nil, err
+	}
+	return rowsToDomain(rows)
+}
+
+func (r *LFSObjectRepositoryImpl) ListAfter(ctx context.Context, after domain.OID, limit int) ([]*domain.LFSObject, error) {
+	rows, err := r.dao.ListAfter(ctx, after.String(), limit)
+	if err != nil {
+		return nil, err
+	}
+	return rowsToDomain(rows)
+}
+
 func (r *LFSObjectRepositoryImpl) Save(ctx context.Context, obj *domain.LFSObject) error {
 	row := domainToRow(obj)
 	return r.dao.Insert(ctx, row)
@@ -51,6 +98,21 @@ func (r *LFSObjectRepositoryImpl) ExistsByOID(ctx context.Context, oid domain.OI
 	return r.dao.Exists(ctx, oid.String())
 }
 
+func (r *LFSObjectRepositoryImpl) Delete(ctx context.Context, oid domain.OID) error {
+	err := r.dao.Delete(ctx, oid.String())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *LFSObjectRepositoryImpl) DeleteStaleNotUploaded(ctx context.Context, oid domain.OID, olderThan time.Time) (bool, error) {
+	return r.dao.DeleteStaleNotUploaded(ctx, oid.String(), olderThan)
+}
+
 func rowToDomain(row *LFSObjectRow) (*domain.LFSObject, error) {
 	oid, err := domain.NewOID(row.OID)
 	if err != nil {
@@ -73,6 +135,26 @@ func rowToDomain(row *LFSObjectRow) (*domain.LFSObject, error) {
 	)
 }
 
+func rowsToDomain(rows []*LFSObjectRow) ([]*domain.LFSObject, error) {
+	objects := make([]*domain.LFSObject, 0, len(rows))
+	for _, row := range rows {
+		obj, err := rowToDomain(row)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, obj)
+	}
+	return objects, nil
+}
+
+func oidsToStrings(oids []domain.OID) []string {
+	values := make([]string, len(oids))
+	for i, oid := range oids {
+		values[i] = oid.String()
+	}
+	return values
+}
+
 func domainToRow(obj *domain.LFSObject) *LFSObjectRow {
 	return &LFSObjectRow{
 		OID:        obj.OID().String(),
@@ -332,6 +332,94 @@ func TestLFSObjectRepositoryImpl_FindByOID(t *testing.T) {
 	}
 }
 
+// TestLFSObjectRepositoryImpl_FindByOIDs は複数OIDの一括取得処理のテーブルドリブンテスト
+func TestLFSObjectRepositoryImpl_FindByOIDs(t *testing.T) {
+	fixedTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	foundOID := "1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef"
+	missingOID := "abcdef1234567890abcdef1234567890abcdef1234567890abcdef1234567890"
+
+	tests := []struct {
+		name      string
+		oids      []string
+		mockSetup func(mock pgxmock.PgxPoolIface)
+		wantSizes map[string]int64
+		wantErr   bool
+	}{
+		{
+			name: "正常系: 存在するOIDのみがマップに含まれる",
+			oids: []string{foundOID, missingOID},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows([]string{"oid", "size", "hash_algo", "storage_key", "uploaded", "created_at", "updated_at"}).
+					AddRow(foundOID, int64(1024), "sha256", "test/storage/key", true, fixedTime, fixedTime)
+				mock.ExpectQuery(`SELECT oid, size, hash_algo, storage_key, uploaded, created_at, updated_at FROM lfs_objects WHERE oid = ANY\(\$1\)`).
+					WithArgs([]string{foundOID, missingOID}).
+					WillReturnRows(rows)
+			},
+			wantSizes: map[string]int64{foundOID: 1024},
+			wantErr:   false,
+		},
+		{
+			name:      "正常系: OIDが空の場合はクエリを発行せず空のマップを返す",
+			oids:      []string{},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {},
+			wantSizes: map[string]int64{},
+			wantErr:   false,
+		},
+		{
+			name: "異常系: クエリに失敗した場合はエラーを返す",
+			oids: []string{foundOID},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`SELECT oid, size, hash_algo, storage_key, uploaded, created_at, updated_at FROM lfs_objects WHERE oid = ANY\(\$1\)`).
+					WithArgs([]string{foundOID}).
+					WillReturnError(errors.New("database error"))
+			},
+			wantSizes: nil,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			if err != nil {
+				t.Fatalf("モックプールの作成に失敗しました: %v", err)
+			}
+			defer mock.Close()
+
+			tt.mockSetup(mock)
+
+			oids := make([]domain.OID, 0, len(tt.oids))
+			for _, v := range tt.oids {
+				oid, err := domain.NewOID(v)
+				if err != nil {
+					t.Fatalf("OIDの作成に失敗しました: %v", err)
+				}
+				oids = append(oids, oid)
+			}
+
+			repo := postgres.NewLFSObjectRepository(mock)
+			got, err := repo.FindByOIDs(context.Background(), oids)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FindByOIDs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !tt.wantErr {
+				gotSizes := make(map[string]int64, len(got))
+				for oid, obj := range got {
+					gotSizes[oid.String()] = obj.Size().Int64()
+				}
+				if diff := cmp.Diff(tt.wantSizes, gotSizes); diff != "" {
+					t.Errorf("FindByOIDs() mismatch (-want +got):\n%s", diff)
+				}
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("期待されたモック呼び出しが行われませんでした: %v", err)
+			}
+		})
+	}
+}
+
 // TestLFSObjectRepositoryImpl_Update は更新処理のテーブルドリブンテスト
 func TestLFSObjectRepositoryImpl_Update(t *testing.T) {
 	type args struct {
@@ -531,6 +619,278 @@ func TestLFSObjectRepositoryImpl_ExistsByOID(t *testing.T) {
 	}
 }
 
+// TestLFSObjectRepositoryImpl_FindStaleNotUploaded は未アップロードのまま放置されたオブジェクト取得処理のテーブルドリブンテスト
+func TestLFSObjectRepositoryImpl_FindStaleNotUploaded(t *testing.T) {
+	fixedTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	olderThan := time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC)
+	staleOID := "1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef"
+
+	tests := []struct {
+		name      string
+		mockSetup func(mock pgxmock.PgxPoolIface)
+		wantOIDs  []string
+		wantErr   bool
+	}{
+		{
+			name: "正常系: 対象のオブジェクトがドメインモデルに変換されて返る",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows([]string{"oid", "size", "hash_algo", "storage_key", "uploaded", "created_at", "updated_at"}).
+					AddRow(staleOID, int64(1024), "sha256", "objects/sha256/12/34/"+staleOID, false, fixedTime, fixedTime)
+				mock.ExpectQuery(`FROM lfs_objects WHERE uploaded = false`).
+					WithArgs(olderThan, 10).
+					WillReturnRows(rows)
+			},
+			wantOIDs: []string{staleOID},
+			wantErr:  false,
+		},
+		{
+			name: "異常系: 不正なOIDのレコードが含まれる場合はエラーを返す",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows([]string{"oid", "size", "hash_algo", "storage_key", "uploaded", "created_at", "updated_at"}).
+					AddRow("invalid-oid", int64(1024), "sha256", "test/storage/key", false, fixedTime, fixedTime)
+				mock.ExpectQuery(`FROM lfs_objects WHERE uploaded = false`).
+					WithArgs(olderThan, 10).
+					WillReturnRows(rows)
+			},
+			wantOIDs: nil,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			if err != nil {
+				t.Fatalf("モックプールの作成に失敗しました: %v", err)
+			}
+			defer mock.Close()
+
+			tt.mockSetup(mock)
+
+			repo := postgres.NewLFSObjectRepository(mock)
+			got, err := repo.FindStaleNotUploaded(context.Background(), olderThan, 10)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FindStaleNotUploaded() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !tt.wantErr {
+				var gotOIDs []string
+				for _, obj := range got {
+					gotOIDs = append(gotOIDs, obj.OID().String())
+				}
+				if diff := cmp.Diff(tt.wantOIDs, gotOIDs); diff != "" {
+					t.Errorf("FindStaleNotUploaded() OIDs mismatch (-want +got):\n%s", diff)
+				}
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("期待されたモック呼び出しが行われませんでした: %v", err)
+			}
+		})
+	}
+}
+
+// TestLFSObjectRepositoryImpl_ListAfter はOID順のページング取得処理のテーブルドリブンテスト
+func TestLFSObjectRepositoryImpl_ListAfter(t *testing.T) {
+	fixedTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	validOID := "1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef"
+
+	tests := []struct {
+		name      string
+		mockSetup func(mock pgxmock.PgxPoolIface)
+		wantOIDs  []string
+		wantErr   bool
+	}{
+		{
+			name: "正常系: ゼロ値のOIDを指定すると先頭から取得される",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows([]string{"oid", "size", "hash_algo", "storage_key", "uploaded", "created_at", "updated_at"}).
+					AddRow(validOID, int64(1024), "sha256", "objects/sha256/12/34/"+validOID, true, fixedTime, fixedTime)
+				mock.ExpectQuery(`FROM lfs_objects WHERE oid > \$1`).
+					WithArgs("", 10).
+					WillReturnRows(rows)
+			},
+			wantOIDs: []string{validOID},
+			wantErr:  false,
+		},
+		{
+			name: "異常系: 不正なOIDのレコードが含まれる場合はエラーを返す",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows([]string{"oid", "size", "hash_algo", "storage_key", "uploaded", "created_at", "updated_at"}).
+					AddRow("invalid-oid", int64(1024), "sha256", "test/storage/key", true, fixedTime, fixedTime)
+				mock.ExpectQuery(`FROM lfs_objects WHERE oid > \$1`).
+					WithArgs("", 10).
+					WillReturnRows(rows)
+			},
+			wantOIDs: nil,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			if err != nil {
+				t.Fatalf("モックプールの作成に失敗しました: %v", err)
+			}
+			defer mock.Close()
+
+			tt.mockSetup(mock)
+
+			repo := postgres.NewLFSObjectRepository(mock)
+			got, err := repo.ListAfter(context.Background(), domain.OID{}, 10)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ListAfter() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !tt.wantErr {
+				var gotOIDs []string
+				for _, obj := range got {
+					gotOIDs = append(gotOIDs, obj.OID().String())
+				}
+				if diff := cmp.Diff(tt.wantOIDs, gotOIDs); diff != "" {
+					t.Errorf("ListAfter() OIDs mismatch (-want +got):\n%s", diff)
+				}
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("期待されたモック呼び出しが行われませんでした: %v", err)
+			}
+		})
+	}
+}
+
+// TestLFSObjectRepositoryImpl_Delete は削除処理のテーブルドリブンテスト
+func TestLFSObjectRepositoryImpl_Delete(t *testing.T) {
+	validOID := "1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef"
+
+	tests := []struct {
+		name      string
+		mockSetup func(mock pgxmock.PgxPoolIface)
+		wantErr   error
+	}{
+		{
+			name: "正常系: 削除に成功",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectExec(`DELETE FROM lfs_objects`).
+					WithArgs(validOID).
+					WillReturnResult(pgxmock.NewResult("DELETE", 1))
+			},
+			wantErr: nil,
+		},
+		{
+			name: "異常系: 存在しないOIDの場合はErrNotFoundを返す",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectExec(`DELETE FROM lfs_objects`).
+					WithArgs(validOID).
+					WillReturnResult(pgxmock.NewResult("DELETE", 0))
+			},
+			wantErr: domain.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			if err != nil {
+				t.Fatalf("モックプールの作成に失敗しました: %v", err)
+			}
+			defer mock.Close()
+
+			tt.mockSetup(mock)
+
+			oid, err := domain.NewOID(validOID)
+			if err != nil {
+				t.Fatalf("OIDの作成に失敗しました: %v", err)
+			}
+
+			repo := postgres.NewLFSObjectRepository(mock)
+			err = repo.Delete(context.Background(), oid)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Delete() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("期待されたモック呼び出しが行われませんでした: %v", err)
+			}
+		})
+	}
+}
+
+func TestLFSObjectRepositoryImpl_DeleteStaleNotUploaded(t *testing.T) {
+	validOID := "1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef"
+	olderThan := time.Date(2024, 1, 14, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		mockSetup func(mock pgxmock.PgxPoolIface)
+		want      bool
+		wantErr   error
+	}{
+		{
+			name: "正常系: 未アップロードのまま期限以前から更新されていない場合は削除されtrueが返る",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectExec(`DELETE FROM lfs_objects\s+WHERE oid = \$1 AND uploaded = false AND updated_at <= \$2`).
+					WithArgs(validOID, olderThan).
+					WillReturnResult(pgxmock.NewResult("DELETE", 1))
+			},
+			want: true,
+		},
+		{
+			name: "正常系: 選択後にアップロードが完了・更新され条件に一致しない場合はfalseが返る",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectExec(`DELETE FROM lfs_objects`).
+					WithArgs(validOID, olderThan).
+					WillReturnResult(pgxmock.NewResult("DELETE", 0))
+			},
+			want: false,
+		},
+		{
+			name: "異常系: データベースエラーの場合はエラーが返る",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectExec(`DELETE FROM lfs_objects`).
+					WithArgs(validOID, olderThan).
+					WillReturnError(errors.New("connection error"))
+			},
+			wantErr: errors.New("connection error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			if err != nil {
+				t.Fatalf("モックプールの作成に失敗しました: %v", err)
+			}
+			defer mock.Close()
+
+			tt.mockSetup(mock)
+
+			oid, err := domain.NewOID(validOID)
+			if err != nil {
+				t.Fatalf("OIDの作成に失敗しました: %v", err)
+			}
+
+			repo := postgres.NewLFSObjectRepository(mock)
+			got, err := repo.DeleteStaleNotUploaded(context.Background(), oid, olderThan)
+			if tt.wantErr != nil {
+				if err == nil || err.Error() != tt.wantErr.Error() {
+					t.Errorf("DeleteStaleNotUploaded() error = %v, wantErr %v", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Errorf("DeleteStaleNotUploaded() unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("DeleteStaleNotUploaded() = %v, want %v", got, tt.want)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("期待されたモック呼び出しが行われませんでした: %v", err)
+			}
+		})
+	}
+}
+
 // TestLFSObjectRepositoryImpl_SaveAndFindByOID は保存と取得の統合テスト
 func TestLFSObjectRepositoryImpl_SaveAndFindByOID(t *testing.T) {
 	// モックプールの作成
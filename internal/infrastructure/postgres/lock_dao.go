@@ -0,0 +1,185 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// uniqueViolationCode はPostgreSQLの一意制約違反のエラーコード
+const uniqueViolationCode = "23505"
+
+// ErrLockConflict は同一リポジトリ・同一パスのロックが既に存在する場合のエラー
+var ErrLockConflict = errors.New("lock conflict")
+
+// LockDAO はlfs_locksテーブルへのデータアクセスを提供する
+type LockDAO struct {
+	pool PoolInterface
+}
+
+// LockRow はlfs_locksテーブルの1行を表す
+type LockRow struct {
+	ID         int64
+	Repository string
+	Path       string
+	RefName    string
+	OwnerSub   string
+	OwnerName  string
+	LockedAt   time.Time
+}
+
+// LockListParams はロック一覧取得の条件を表す
+// 0または空文字のフィールドは絞り込みに使用しない
+type LockListParams struct {
+	Repository string
+	Path       string
+	ID         int64
+	Cursor     int64
+	Limit      int
+}
+
+// NewLockDAO は新しいLockDAOを作成する
+func NewLockDAO(pool PoolInterface) *LockDAO {
+	return &LockDAO{
+		pool: pool,
+	}
+}
+
+// Insert は新しいロックを挿入し、採番されたIDを返す
+func (dao *LockDAO) Insert(ctx context.Context, row *LockRow) (int64, error) {
+	query := `
+		INSERT INTO lfs_locks (repository, path, ref_name, owner_sub, owner_name, locked_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id
+	`
+
+	var id int64
+	err := dao.pool.QueryRow(ctx, query,
+		row.Repository,
+		row.Path,
+		row.RefName,
+		row.OwnerSub,
+		row.OwnerName,
+		row.LockedAt,
+	).Scan(&id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+			return 0, ErrLockConflict
+		}
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// FindByID は指定されたリポジトリ・IDのロックを取得する
+func (dao *LockDAO) FindByID(ctx context.Context, repository string, id int64) (*LockRow, error) {
+	query := `
+		SELECT id, repository, path, ref_name, owner_sub, owner_name, locked_at
+		FROM lfs_locks
+		WHERE repository = $1 AND id = $2
+	`
+
+	return dao.scanRow(dao.pool.QueryRow(ctx, query, repository, id))
+}
+
+// FindByPath は指定されたリポジトリ・パスのロックを取得する
+func (dao *LockDAO) FindByPath(ctx context.Context, repository string, path string) (*LockRow, error) {
+	query := `
+		SELECT id, repository, path, ref_name, owner_sub, owner_name, locked_at
+		FROM lfs_locks
+		WHERE repository = $1 AND path = $2
+	`
+
+	return dao.scanRow(dao.pool.QueryRow(ctx, query, repository, path))
+}
+
+// List は条件に一致するロックをID昇順で最大params.Limit件取得する
+func (dao *LockDAO) List(ctx context.Context, params LockListParams) ([]*LockRow, error) {
+	query := `
+		SELECT id, repository, path, ref_name, owner_sub, owner_name, locked_at
+		FROM lfs_locks
+		WHERE repository = $1
+			AND ($2 = '' OR path = $2)
+			AND ($3 = 0 OR id = $3)
+			AND id >= $4
+		ORDER BY id
+		LIMIT $5
+	`
+
+	rows, err := dao.pool.Query(ctx, query,
+		params.Repository,
+		params.Path,
+		params.ID,
+		params.Cursor,
+		params.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*LockRow
+	for rows.Next() {
+		var row LockRow
+		if err := rows.Scan(
+			&row.ID,
+			&row.Repository,
+			&row.Path,
+			&row.RefName,
+			&row.OwnerSub,
+			&row.OwnerName,
+			&row.LockedAt,
+		); err != nil {
+			return nil, err
+		}
+		result = append(result, &row)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Delete は指定されたリポジトリ・IDのロックを削除する
+func (dao *LockDAO) Delete(ctx context.Context, repository string, id int64) error {
+	query := `
+		DELETE FROM lfs_locks
+		WHERE repository = $1 AND id = $2
+	`
+
+	result, err := dao.pool.Exec(ctx, query, repository, id)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+func (dao *LockDAO) scanRow(row pgx.Row) (*LockRow, error) {
+	var result LockRow
+	err := row.Scan(
+		&result.ID,
+		&result.Repository,
+		&result.Path,
+		&result.RefName,
+		&result.OwnerSub,
+		&result.OwnerName,
+		&result.LockedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,358 @@
+package postgres_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/na2na-p/cargohold/internal/infrastructure/postgres"
+	"github.com/pashagolub/pgxmock/v4"
+)
+
+var lockColumns = []string{"id", "repository", "path", "ref_name", "owner_sub", "owner_name", "locked_at"}
+
+// TestLockDAO_Insert はInsert処理のテーブルドリブンテスト
+func TestLockDAO_Insert(t *testing.T) {
+	fixedTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	row := &postgres.LockRow{
+		Repository: "owner/repo",
+		Path:       "foo/bar.bin",
+		RefName:    "refs/heads/main",
+		OwnerSub:   "user-1",
+		OwnerName:  "octocat",
+		LockedAt:   fixedTime,
+	}
+
+	tests := []struct {
+		name      string
+		mockSetup func(mock pgxmock.PgxPoolIface)
+		want      int64
+		wantErr   error
+	}{
+		{
+			name: "正常系: 挿入に成功しIDが返る",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`INSERT INTO lfs_locks`).
+					WithArgs("owner/repo", "foo/bar.bin", "refs/heads/main", "user-1", "octocat", fixedTime).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(int64(10)))
+			},
+			want: 10,
+		},
+		{
+			name: "異常系: 一意制約違反の場合はErrLockConflictが返る",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`INSERT INTO lfs_locks`).
+					WithArgs("owner/repo", "foo/bar.bin", "refs/heads/main", "user-1", "octocat", fixedTime).
+					WillReturnError(&pgconn.PgError{Code: "23505"})
+			},
+			wantErr: postgres.ErrLockConflict,
+		},
+		{
+			name: "異常系: その他のエラーはそのまま返る",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`INSERT INTO lfs_locks`).
+					WithArgs("owner/repo", "foo/bar.bin", "refs/heads/main", "user-1", "octocat", fixedTime).
+					WillReturnError(errors.New("connection error"))
+			},
+			wantErr: errors.New("connection error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			if err != nil {
+				t.Fatalf("モックプールの作成に失敗しました: %v", err)
+			}
+			defer mock.Close()
+
+			tt.mockSetup(mock)
+
+			dao := postgres.NewLockDAO(mock)
+			got, err := dao.Insert(context.Background(), row)
+
+			if tt.wantErr != nil {
+				if err == nil {
+					t.Fatalf("Insert() error = nil, wantErr %v", tt.wantErr)
+				}
+				if !cmp.Equal(err.Error(), tt.wantErr.Error()) {
+					t.Errorf("Insert() error = %v, wantErr %v", err, tt.wantErr)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("Insert() unexpected error = %v", err)
+				}
+				if got != tt.want {
+					t.Errorf("Insert() = %v, want %v", got, tt.want)
+				}
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("期待されたモック呼び出しが行われませんでした: %v", err)
+			}
+		})
+	}
+}
+
+// TestLockDAO_FindByID はFindByID処理のテーブルドリブンテスト
+func TestLockDAO_FindByID(t *testing.T) {
+	fixedTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		mockSetup func(mock pgxmock.PgxPoolIface)
+		want      *postgres.LockRow
+		wantErr   error
+	}{
+		{
+			name: "正常系: FindByIDに成功",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`SELECT id, repository, path, ref_name, owner_sub, owner_name, locked_at FROM lfs_locks WHERE repository = \$1 AND id = \$2`).
+					WithArgs("owner/repo", int64(1)).
+					WillReturnRows(pgxmock.NewRows(lockColumns).
+						AddRow(int64(1), "owner/repo", "foo/bar.bin", "", "user-1", "octocat", fixedTime))
+			},
+			want: &postgres.LockRow{
+				ID:         1,
+				Repository: "owner/repo",
+				Path:       "foo/bar.bin",
+				OwnerSub:   "user-1",
+				OwnerName:  "octocat",
+				LockedAt:   fixedTime,
+			},
+		},
+		{
+			name: "異常系: 存在しないID",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`SELECT id, repository, path, ref_name, owner_sub, owner_name, locked_at FROM lfs_locks WHERE repository = \$1 AND id = \$2`).
+					WithArgs("owner/repo", int64(1)).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			wantErr: pgx.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			if err != nil {
+				t.Fatalf("モックプールの作成に失敗しました: %v", err)
+			}
+			defer mock.Close()
+
+			tt.mockSetup(mock)
+
+			dao := postgres.NewLockDAO(mock)
+			got, err := dao.FindByID(context.Background(), "owner/repo", 1)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("FindByID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FindByID() mismatch (-want +got):\n%s", diff)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("期待されたモック呼び出しが行われませんでした: %v", err)
+			}
+		})
+	}
+}
+
+// TestLockDAO_FindByPath はFindByPath処理のテーブルドリブンテスト
+func TestLockDAO_FindByPath(t *testing.T) {
+	fixedTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		mockSetup func(mock pgxmock.PgxPoolIface)
+		want      *postgres.LockRow
+		wantErr   error
+	}{
+		{
+			name: "正常系: FindByPathに成功",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`SELECT id, repository, path, ref_name, owner_sub, owner_name, locked_at FROM lfs_locks WHERE repository = \$1 AND path = \$2`).
+					WithArgs("owner/repo", "foo/bar.bin").
+					WillReturnRows(pgxmock.NewRows(lockColumns).
+						AddRow(int64(3), "owner/repo", "foo/bar.bin", "refs/heads/main", "user-1", "octocat", fixedTime))
+			},
+			want: &postgres.LockRow{
+				ID:         3,
+				Repository: "owner/repo",
+				Path:       "foo/bar.bin",
+				RefName:    "refs/heads/main",
+				OwnerSub:   "user-1",
+				OwnerName:  "octocat",
+				LockedAt:   fixedTime,
+			},
+		},
+		{
+			name: "異常系: 存在しないパス",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`SELECT id, repository, path, ref_name, owner_sub, owner_name, locked_at FROM lfs_locks WHERE repository = \$1 AND path = \$2`).
+					WithArgs("owner/repo", "foo/bar.bin").
+					WillReturnError(pgx.ErrNoRows)
+			},
+			wantErr: pgx.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			if err != nil {
+				t.Fatalf("モックプールの作成に失敗しました: %v", err)
+			}
+			defer mock.Close()
+
+			tt.mockSetup(mock)
+
+			dao := postgres.NewLockDAO(mock)
+			got, err := dao.FindByPath(context.Background(), "owner/repo", "foo/bar.bin")
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("FindByPath() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FindByPath() mismatch (-want +got):\n%s", diff)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("期待されたモック呼び出しが行われませんでした: %v", err)
+			}
+		})
+	}
+}
+
+// TestLockDAO_List はList処理のテーブルドリブンテスト
+func TestLockDAO_List(t *testing.T) {
+	fixedTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	params := postgres.LockListParams{
+		Repository: "owner/repo",
+		Path:       "",
+		ID:         0,
+		Cursor:     5,
+		Limit:      3,
+	}
+
+	tests := []struct {
+		name      string
+		mockSetup func(mock pgxmock.PgxPoolIface)
+		want      []*postgres.LockRow
+		wantErr   error
+	}{
+		{
+			name: "正常系: 条件に一致するロックが取得できる",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`SELECT id, repository, path, ref_name, owner_sub, owner_name, locked_at FROM lfs_locks WHERE repository = \$1`).
+					WithArgs("owner/repo", "", int64(0), int64(5), 3).
+					WillReturnRows(pgxmock.NewRows(lockColumns).
+						AddRow(int64(5), "owner/repo", "a.bin", "", "user-1", "octocat", fixedTime).
+						AddRow(int64(6), "owner/repo", "b.bin", "", "user-2", "hubot", fixedTime))
+			},
+			want: []*postgres.LockRow{
+				{ID: 5, Repository: "owner/repo", Path: "a.bin", OwnerSub: "user-1", OwnerName: "octocat", LockedAt: fixedTime},
+				{ID: 6, Repository: "owner/repo", Path: "b.bin", OwnerSub: "user-2", OwnerName: "hubot", LockedAt: fixedTime},
+			},
+		},
+		{
+			name: "異常系: クエリエラー",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`SELECT id, repository, path, ref_name, owner_sub, owner_name, locked_at FROM lfs_locks WHERE repository = \$1`).
+					WithArgs("owner/repo", "", int64(0), int64(5), 3).
+					WillReturnError(errors.New("query error"))
+			},
+			wantErr: errors.New("query error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			if err != nil {
+				t.Fatalf("モックプールの作成に失敗しました: %v", err)
+			}
+			defer mock.Close()
+
+			tt.mockSetup(mock)
+
+			dao := postgres.NewLockDAO(mock)
+			got, err := dao.List(context.Background(), params)
+
+			if tt.wantErr != nil {
+				if err == nil {
+					t.Fatalf("List() error = nil, wantErr %v", tt.wantErr)
+				}
+				if !cmp.Equal(err.Error(), tt.wantErr.Error()) {
+					t.Errorf("List() error = %v, wantErr %v", err, tt.wantErr)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("List() unexpected error = %v", err)
+				}
+				if diff := cmp.Diff(tt.want, got); diff != "" {
+					t.Errorf("List() mismatch (-want +got):\n%s", diff)
+				}
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("期待されたモック呼び出しが行われませんでした: %v", err)
+			}
+		})
+	}
+}
+
+// TestLockDAO_Delete はDelete処理のテーブルドリブンテスト
+func TestLockDAO_Delete(t *testing.T) {
+	tests := []struct {
+		name      string
+		mockSetup func(mock pgxmock.PgxPoolIface)
+		wantErr   error
+	}{
+		{
+			name: "正常系: 削除に成功",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectExec(`DELETE FROM lfs_locks WHERE repository = \$1 AND id = \$2`).
+					WithArgs("owner/repo", int64(1)).
+					WillReturnResult(pgxmock.NewResult("DELETE", 1))
+			},
+		},
+		{
+			name: "異常系: 存在しないIDの削除",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectExec(`DELETE FROM lfs_locks WHERE repository = \$1 AND id = \$2`).
+					WithArgs("owner/repo", int64(1)).
+					WillReturnResult(pgxmock.NewResult("DELETE", 0))
+			},
+			wantErr: pgx.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			if err != nil {
+				t.Fatalf("モックプールの作成に失敗しました: %v", err)
+			}
+			defer mock.Close()
+
+			tt.mockSetup(mock)
+
+			dao := postgres.NewLockDAO(mock)
+			err = dao.Delete(context.Background(), "owner/repo", 1)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Delete() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("期待されたモック呼び出しが行われませんでした: %v", err)
+			}
+		})
+	}
+}
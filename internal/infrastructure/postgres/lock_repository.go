@@ -0,0 +1,148 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/na2na-p/cargohold/internal/domain"
+)
+
+const defaultLockListLimit = 100
+
+type LockRepositoryImpl struct {
+	dao *LockDAO
+}
+
+func NewLockRepository(pool PoolInterface) domain.LockRepository {
+	return &LockRepositoryImpl{
+		dao: NewLockDAO(pool),
+	}
+}
+
+func (r *LockRepositoryImpl) Create(ctx context.Context, lock *domain.Lock) (*domain.Lock, error) {
+	row := lockToRow(lock)
+	id, err := r.dao.Insert(ctx, row)
+	if err != nil {
+		if errors.Is(err, ErrLockConflict) {
+			return nil, domain.ErrLockAlreadyExists
+		}
+		return nil, err
+	}
+
+	lockID, err := domain.NewLockID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.ReconstructLock(lockID, lock.Repository(), lock.Path(), lock.RefName(), lock.Owner(), lock.LockedAt()), nil
+}
+
+func (r *LockRepositoryImpl) FindByID(ctx context.Context, repository *domain.RepositoryIdentifier, id domain.LockID) (*domain.Lock, error) {
+	row, err := r.dao.FindByID(ctx, repository.FullName(), id.Int64())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrLockNotFound
+		}
+		return nil, err
+	}
+
+	return rowToLock(row)
+}
+
+func (r *LockRepositoryImpl) FindByPath(ctx context.Context, repository *domain.RepositoryIdentifier, path domain.LockPath) (*domain.Lock, error) {
+	row, err := r.dao.FindByPath(ctx, repository.FullName(), path.String())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrLockNotFound
+		}
+		return nil, err
+	}
+
+	return rowToLock(row)
+}
+
+func (r *LockRepositoryImpl) List(ctx context.Context, repository *domain.RepositoryIdentifier, filter domain.LockListFilter) ([]*domain.Lock, domain.LockID, error) {
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = defaultLockListLimit
+	}
+
+	rows, err := r.dao.List(ctx, LockListParams{
+		Repository: repository.FullName(),
+		Path:       filter.Path,
+		ID:         filter.ID.Int64(),
+		Cursor:     filter.Cursor.Int64(),
+		Limit:      limit + 1,
+	})
+	if err != nil {
+		return nil, domain.LockID{}, err
+	}
+
+	var nextCursor domain.LockID
+	if len(rows) > limit {
+		nextCursor, err = domain.NewLockID(rows[limit].ID)
+		if err != nil {
+			return nil, domain.LockID{}, err
+		}
+		rows = rows[:limit]
+	}
+
+	locks := make([]*domain.Lock, 0, len(rows))
+	for _, row := range rows {
+		lock, err := rowToLock(row)
+		if err != nil {
+			return nil, domain.LockID{}, err
+		}
+		locks = append(locks, lock)
+	}
+
+	return locks, nextCursor, nil
+}
+
+func (r *LockRepositoryImpl) Delete(ctx context.Context, repository *domain.RepositoryIdentifier, id domain.LockID) error {
+	err := r.dao.Delete(ctx, repository.FullName(), id.Int64())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ErrLockNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func rowToLock(row *LockRow) (*domain.Lock, error) {
+	id, err := domain.NewLockID(row.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	repo, err := domain.NewRepositoryIdentifier(row.Repository)
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := domain.NewLockPath(row.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := domain.NewLockOwner(row.OwnerSub, row.OwnerName)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.ReconstructLock(id, repo, path, row.RefName, owner, row.LockedAt), nil
+}
+
+func lockToRow(lock *domain.Lock) *LockRow {
+	return &LockRow{
+		ID:         lock.ID().Int64(),
+		Repository: lock.Repository().FullName(),
+		Path:       lock.Path().String(),
+		RefName:    lock.RefName(),
+		OwnerSub:   lock.Owner().Sub(),
+		OwnerName:  lock.Owner().Name(),
+		LockedAt:   lock.LockedAt(),
+	}
+}
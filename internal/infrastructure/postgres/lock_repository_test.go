@@ -0,0 +1,319 @@
+package postgres_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/na2na-p/cargohold/internal/infrastructure/postgres"
+	"github.com/pashagolub/pgxmock/v4"
+)
+
+func newTestLock(t *testing.T, lockedAt time.Time) *domain.Lock {
+	t.Helper()
+
+	repo, err := domain.NewRepositoryIdentifier("owner/repo")
+	if err != nil {
+		t.Fatalf("NewRepositoryIdentifier() failed: %v", err)
+	}
+	path, err := domain.NewLockPath("foo/bar.bin")
+	if err != nil {
+		t.Fatalf("NewLockPath() failed: %v", err)
+	}
+	owner, err := domain.NewLockOwner("user-1", "octocat")
+	if err != nil {
+		t.Fatalf("NewLockOwner() failed: %v", err)
+	}
+
+	return domain.ReconstructLock(domain.LockID{}, repo, path, "refs/heads/main", owner, lockedAt)
+}
+
+// TestLockRepositoryImpl_Create はCreate処理のテーブルドリブンテスト
+func TestLockRepositoryImpl_Create(t *testing.T) {
+	fixedTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		mockSetup func(mock pgxmock.PgxPoolIface)
+		wantID    int64
+		wantErr   error
+	}{
+		{
+			name: "正常系: 作成に成功しIDが採番される",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`INSERT INTO lfs_locks`).
+					WithArgs("owner/repo", "foo/bar.bin", "refs/heads/main", "user-1", "octocat", fixedTime).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(int64(7)))
+			},
+			wantID: 7,
+		},
+		{
+			name: "異常系: 一意制約違反の場合はErrLockAlreadyExistsが返る",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`INSERT INTO lfs_locks`).
+					WithArgs("owner/repo", "foo/bar.bin", "refs/heads/main", "user-1", "octocat", fixedTime).
+					WillReturnError(&pgconn.PgError{Code: "23505"})
+			},
+			wantErr: domain.ErrLockAlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			if err != nil {
+				t.Fatalf("モックプールの作成に失敗しました: %v", err)
+			}
+			defer mock.Close()
+
+			tt.mockSetup(mock)
+
+			repo := postgres.NewLockRepository(mock)
+			got, err := repo.Create(context.Background(), newTestLock(t, fixedTime))
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Create() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil {
+				if got.ID().Int64() != tt.wantID {
+					t.Errorf("Create() ID = %v, want %v", got.ID().Int64(), tt.wantID)
+				}
+				if !got.LockedAt().Equal(fixedTime) {
+					t.Errorf("Create() LockedAt = %v, want %v", got.LockedAt(), fixedTime)
+				}
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("期待されたモック呼び出しが行われませんでした: %v", err)
+			}
+		})
+	}
+}
+
+// TestLockRepositoryImpl_FindByID はFindByID処理のテーブルドリブンテスト
+func TestLockRepositoryImpl_FindByID(t *testing.T) {
+	fixedTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	repoID, _ := domain.NewRepositoryIdentifier("owner/repo")
+	lockID, _ := domain.NewLockID(1)
+
+	tests := []struct {
+		name      string
+		mockSetup func(mock pgxmock.PgxPoolIface)
+		wantPath  string
+		wantErr   error
+	}{
+		{
+			name: "正常系: FindByIDに成功",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`FROM lfs_locks WHERE repository = \$1 AND id = \$2`).
+					WithArgs("owner/repo", int64(1)).
+					WillReturnRows(pgxmock.NewRows(lockColumns).
+						AddRow(int64(1), "owner/repo", "foo/bar.bin", "", "user-1", "octocat", fixedTime))
+			},
+			wantPath: "foo/bar.bin",
+		},
+		{
+			name: "異常系: 存在しない場合はErrLockNotFoundが返る",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`FROM lfs_locks WHERE repository = \$1 AND id = \$2`).
+					WithArgs("owner/repo", int64(1)).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			wantErr: domain.ErrLockNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			if err != nil {
+				t.Fatalf("モックプールの作成に失敗しました: %v", err)
+			}
+			defer mock.Close()
+
+			tt.mockSetup(mock)
+
+			repo := postgres.NewLockRepository(mock)
+			got, err := repo.FindByID(context.Background(), repoID, lockID)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("FindByID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && got.Path().String() != tt.wantPath {
+				t.Errorf("FindByID() Path = %v, want %v", got.Path().String(), tt.wantPath)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("期待されたモック呼び出しが行われませんでした: %v", err)
+			}
+		})
+	}
+}
+
+// TestLockRepositoryImpl_FindByPath はFindByPath処理のテーブルドリブンテスト
+func TestLockRepositoryImpl_FindByPath(t *testing.T) {
+	repoID, _ := domain.NewRepositoryIdentifier("owner/repo")
+	path, _ := domain.NewLockPath("foo/bar.bin")
+
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("モックプールの作成に失敗しました: %v", err)
+	}
+	defer mock.Close()
+
+	mock.ExpectQuery(`FROM lfs_locks WHERE repository = \$1 AND path = \$2`).
+		WithArgs("owner/repo", "foo/bar.bin").
+		WillReturnError(pgx.ErrNoRows)
+
+	repo := postgres.NewLockRepository(mock)
+	if _, err := repo.FindByPath(context.Background(), repoID, path); !errors.Is(err, domain.ErrLockNotFound) {
+		t.Errorf("FindByPath() error = %v, want %v", err, domain.ErrLockNotFound)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("期待されたモック呼び出しが行われませんでした: %v", err)
+	}
+}
+
+// TestLockRepositoryImpl_List はList処理のテーブルドリブンテスト
+func TestLockRepositoryImpl_List(t *testing.T) {
+	fixedTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	repoID, _ := domain.NewRepositoryIdentifier("owner/repo")
+
+	tests := []struct {
+		name           string
+		filter         domain.LockListFilter
+		mockSetup      func(mock pgxmock.PgxPoolIface)
+		wantIDs        []int64
+		wantNextCursor int64
+		wantErr        bool
+	}{
+		{
+			name:   "正常系: 次のページがある場合はnextCursorが返る",
+			filter: domain.LockListFilter{Limit: 2},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`FROM lfs_locks WHERE repository = \$1`).
+					WithArgs("owner/repo", "", int64(0), int64(0), 3).
+					WillReturnRows(pgxmock.NewRows(lockColumns).
+						AddRow(int64(1), "owner/repo", "a.bin", "", "user-1", "octocat", fixedTime).
+						AddRow(int64(2), "owner/repo", "b.bin", "", "user-1", "octocat", fixedTime).
+						AddRow(int64(3), "owner/repo", "c.bin", "", "user-1", "octocat", fixedTime))
+			},
+			wantIDs:        []int64{1, 2},
+			wantNextCursor: 3,
+		},
+		{
+			name:   "正常系: 最終ページの場合はnextCursorが空",
+			filter: domain.LockListFilter{},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`FROM lfs_locks WHERE repository = \$1`).
+					WithArgs("owner/repo", "", int64(0), int64(0), 101).
+					WillReturnRows(pgxmock.NewRows(lockColumns).
+						AddRow(int64(1), "owner/repo", "a.bin", "", "user-1", "octocat", fixedTime))
+			},
+			wantIDs: []int64{1},
+		},
+		{
+			name:   "異常系: クエリエラー",
+			filter: domain.LockListFilter{Limit: 2},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(`FROM lfs_locks WHERE repository = \$1`).
+					WithArgs("owner/repo", "", int64(0), int64(0), 3).
+					WillReturnError(errors.New("query error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			if err != nil {
+				t.Fatalf("モックプールの作成に失敗しました: %v", err)
+			}
+			defer mock.Close()
+
+			tt.mockSetup(mock)
+
+			repo := postgres.NewLockRepository(mock)
+			got, nextCursor, err := repo.List(context.Background(), repoID, tt.filter)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("List() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got) != len(tt.wantIDs) {
+				t.Fatalf("List() len = %v, want %v", len(got), len(tt.wantIDs))
+			}
+			for i, lock := range got {
+				if lock.ID().Int64() != tt.wantIDs[i] {
+					t.Errorf("List()[%d] ID = %v, want %v", i, lock.ID().Int64(), tt.wantIDs[i])
+				}
+			}
+			if nextCursor.Int64() != tt.wantNextCursor {
+				t.Errorf("List() nextCursor = %v, want %v", nextCursor.Int64(), tt.wantNextCursor)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("期待されたモック呼び出しが行われませんでした: %v", err)
+			}
+		})
+	}
+}
+
+// TestLockRepositoryImpl_Delete はDelete処理のテーブルドリブンテスト
+func TestLockRepositoryImpl_Delete(t *testing.T) {
+	repoID, _ := domain.NewRepositoryIdentifier("owner/repo")
+	lockID, _ := domain.NewLockID(1)
+
+	tests := []struct {
+		name      string
+		mockSetup func(mock pgxmock.PgxPoolIface)
+		wantErr   error
+	}{
+		{
+			name: "正常系: 削除に成功",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectExec(`DELETE FROM lfs_locks`).
+					WithArgs("owner/repo", int64(1)).
+					WillReturnResult(pgxmock.NewResult("DELETE", 1))
+			},
+		},
+		{
+			name: "異常系: 存在しない場合はErrLockNotFoundが返る",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectExec(`DELETE FROM lfs_locks`).
+					WithArgs("owner/repo", int64(1)).
+					WillReturnResult(pgxmock.NewResult("DELETE", 0))
+			},
+			wantErr: domain.ErrLockNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			if err != nil {
+				t.Fatalf("モックプールの作成に失敗しました: %v", err)
+			}
+			defer mock.Close()
+
+			tt.mockSetup(mock)
+
+			repo := postgres.NewLockRepository(mock)
+			if err := repo.Delete(context.Background(), repoID, lockID); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Delete() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("期待されたモック呼び出しが行われませんでした: %v", err)
+			}
+		})
+	}
+}
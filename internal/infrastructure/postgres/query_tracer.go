@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/na2na-p/cargohold/internal/infrastructure/tracing"
+	"go.opentelemetry.io/otel"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/na2na-p/cargohold/internal/infrastructure/postgres"
+
+// QueryMetricsRecorder はクエリの処理時間とエラーを記録する
+type QueryMetricsRecorder interface {
+	ObserveDatabaseQuery(operation string, elapsed time.Duration, err error)
+}
+
+type queryTraceContextKey struct{}
+
+type queryTraceData struct {
+	operation string
+	start     time.Time
+	span      trace.Span
+}
+
+// queryTracer はpgxのクエリ実行をフックし、SQLの種類ごとにスパンを作成して処理時間とエラーを記録する
+type queryTracer struct {
+	// metrics がnilの場合はスパンのみを作成する
+	metrics QueryMetricsRecorder
+}
+
+var _ pgx.QueryTracer = (*queryTracer)(nil)
+
+func (t *queryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	operation := queryOperation(data.SQL)
+	ctx, span := otel.Tracer(tracerName).Start(ctx, "postgres "+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemNamePostgreSQL,
+			semconv.DBOperationName(operation),
+			semconv.DBQueryText(data.SQL),
+		),
+	)
+	return context.WithValue(ctx, queryTraceContextKey{}, queryTraceData{
+		operation: operation,
+		start:     time.Now(),
+		span:      span,
+	})
+}
+
+func (t *queryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	traceData, ok := ctx.Value(queryTraceContextKey{}).(queryTraceData)
+	if !ok {
+		return
+	}
+	if t.metrics != nil {
+		t.metrics.ObserveDatabaseQuery(traceData.operation, time.Since(traceData.start), data.Err)
+	}
+	tracing.End(traceData.span, data.Err)
+}
+
+// queryOperation はSQLの先頭のキーワードからクエリの種類を判定する
+func queryOperation(sql string) string {
+	fields := strings.Fields(sql)
+	if len(fields) == 0 {
+		return "other"
+	}
+
+	switch operation := strings.ToLower(fields[0]); operation {
+	case "select", "insert", "update", "delete", "with":
+		return operation
+	case "begin", "commit", "rollback":
+		return "transaction"
+	default:
+		return "other"
+	}
+}
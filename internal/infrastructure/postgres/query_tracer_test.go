@@ -0,0 +1,50 @@
+package postgres
+
+import "testing"
+
+func TestQueryOperation(t *testing.T) {
+	tests := []struct {
+		name string
+		sql  string
+		want string
+	}{
+		{
+			name: "正常系: SELECT文",
+			sql:  "SELECT oid FROM lfs_objects WHERE oid = $1",
+			want: "select",
+		},
+		{
+			name: "正常系: 先頭の空白と改行を無視する",
+			sql:  "\n\t\tINSERT INTO audit_events (action) VALUES ($1)",
+			want: "insert",
+		},
+		{
+			name: "正常系: 小文字のDELETE文",
+			sql:  "delete from lfs_objects where oid = $1",
+			want: "delete",
+		},
+		{
+			name: "正常系: トランザクション制御",
+			sql:  "begin",
+			want: "transaction",
+		},
+		{
+			name: "正常系: 未知のSQLはotherとする",
+			sql:  "VACUUM",
+			want: "other",
+		},
+		{
+			name: "正常系: 空のSQLはotherとする",
+			sql:  "",
+			want: "other",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := queryOperation(tt.sql); got != tt.want {
+				t.Errorf("queryOperation() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
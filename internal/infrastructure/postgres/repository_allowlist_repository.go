@@ -7,7 +7,6 @@ import (
 
 	"github.com/jackc/pgx/v5"
 	"github.com/na2na-p/cargohold/internal/domain"
-	infra "github.com/na2na-p/cargohold/internal/infrastructure"
 )
 
 // RepositoryAllowlistRepositoryImpl はRepositoryAllowlistRepositoryのPostgreSQL実装
@@ -46,7 +45,7 @@ func (r *RepositoryAllowlistRepositoryImpl) Remove(ctx context.Context, reposito
 	err := r.dao.Delete(ctx, repository.String())
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return infra.ErrNotFound
+			return domain.ErrNotFound
 		}
 		return err
 	}
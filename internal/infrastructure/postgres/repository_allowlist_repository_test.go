@@ -6,7 +6,6 @@ import (
 	"testing"
 
 	"github.com/na2na-p/cargohold/internal/domain"
-	infra "github.com/na2na-p/cargohold/internal/infrastructure"
 	"github.com/na2na-p/cargohold/internal/infrastructure/postgres"
 	"github.com/pashagolub/pgxmock/v4"
 )
@@ -215,7 +214,7 @@ func TestRepositoryAllowlistRepositoryImpl_Remove(t *testing.T) {
 					WithArgs("unknown/repo").
 					WillReturnResult(pgxmock.NewResult("DELETE", 0))
 			},
-			wantErr: infra.ErrNotFound,
+			wantErr: domain.ErrNotFound,
 		},
 		{
 			name: "異常系: データベースエラー",
@@ -51,6 +51,27 @@ func (c *RedisClient) Exists(ctx context.Context, key string) (bool, error) {
 	return result > 0, nil
 }
 
+// MGet は複数のキーの値を1回のMGETで取得します
+// 存在しないキーに対応する要素はnilになります
+func (c *RedisClient) MGet(ctx context.Context, keys []string) ([][]byte, error) {
+	if len(keys) == 0 {
+		return [][]byte{}, nil
+	}
+
+	vals, err := c.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("キーの一括取得に失敗しました: %w", err)
+	}
+
+	result := make([][]byte, len(keys))
+	for i, val := range vals {
+		if str, ok := val.(string); ok {
+			result[i] = []byte(str)
+		}
+	}
+	return result, nil
+}
+
 // SetJSON は指定されたキーにJSON形式で値を設定します
 func (c *RedisClient) SetJSON(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
 	jsonBytes, err := json.Marshal(value)
@@ -4,6 +4,7 @@ package redis
 
 import (
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -19,6 +20,14 @@ const (
 	// Format: lfs:session:{session_id}
 	SessionKeyPrefix = "lfs:session:"
 
+	// SessionTokenKeyPrefix is the prefix for the encrypted OAuth token used to re-validate session permissions
+	// Format: lfs:session_token:{session_id}
+	SessionTokenKeyPrefix = "lfs:session_token:"
+
+	// UserSessionsKeyPrefix is the prefix for the per-user session index (sorted set scored by session expiry)
+	// Format: lfs:user:sessions:{sub}
+	UserSessionsKeyPrefix = "lfs:user:sessions:"
+
 	// BatchUploadKeyPrefix is the prefix for batch upload cache keys
 	// Format: lfs:batch:upload:{oid}
 	BatchUploadKeyPrefix = "lfs:batch:upload:"
@@ -27,13 +36,25 @@ const (
 	// Format: lfs:oidc:github:repo:{repository}
 	OIDCGitHubRepoKeyPrefix = "lfs:oidc:github:repo:"
 
+	// OIDCGitHubClaimRulesKeyPrefix is the prefix for GitHub OIDC claim rule cache keys
+	// Format: lfs:oidc:github:rules:{repository}
+	OIDCGitHubClaimRulesKeyPrefix = "lfs:oidc:github:rules:"
+
 	// OIDCStateKeyPrefix is the prefix for OIDC state parameter cache keys
 	// Format: lfs:oidc:state:{state}
 	OIDCStateKeyPrefix = "lfs:oidc:state:"
 
+	// OIDCDeviceKeyPrefix is the prefix for pending GitHub device flow authorization keys
+	// Format: lfs:oidc:device:{device_code}
+	OIDCDeviceKeyPrefix = "lfs:oidc:device:"
+
 	// OIDCJWKSKeyPrefix is the prefix for OIDC JWKS cache keys
 	// Format: lfs:oidc:jwks:{provider}
 	OIDCJWKSKeyPrefix = "lfs:oidc:jwks:"
+
+	// RateLimitKeyPrefix is the prefix for rate limit state keys
+	// Format: lfs:ratelimit:{scope}:{dimension}:{value}
+	RateLimitKeyPrefix = "lfs:ratelimit:"
 )
 
 // Cache TTL Definitions
@@ -49,6 +70,9 @@ const (
 	// OIDCGitHubRepoTTL is the TTL for GitHub OIDC repository allowlist cache (5 minutes)
 	OIDCGitHubRepoTTL = 5 * time.Minute
 
+	// OIDCGitHubClaimRulesTTL is the TTL for GitHub OIDC claim rule cache (5 minutes)
+	OIDCGitHubClaimRulesTTL = 5 * time.Minute
+
 	// OIDCStateTTL is the TTL for OIDC state parameter cache (10 minutes)
 	OIDCStateTTL = 10 * time.Minute
 
@@ -70,6 +94,16 @@ func SessionKey(sessionID string) string {
 	return SessionKeyPrefix + sessionID
 }
 
+// SessionTokenKey generates a cache key for the OAuth token of a session
+func SessionTokenKey(sessionID string) string {
+	return SessionTokenKeyPrefix + sessionID
+}
+
+// UserSessionsKey generates a cache key for the session index of a user
+func UserSessionsKey(sub string) string {
+	return UserSessionsKeyPrefix + sub
+}
+
 // BatchUploadKey generates a cache key for batch upload data
 func BatchUploadKey(oid string) string {
 	return BatchUploadKeyPrefix + oid
@@ -80,12 +114,28 @@ func OIDCGitHubRepoKey(repository string) string {
 	return OIDCGitHubRepoKeyPrefix + repository
 }
 
+// OIDCGitHubClaimRulesKey generates a cache key for GitHub OIDC claim rules of a repository
+// The repository is lower-cased because rules are looked up case-insensitively
+func OIDCGitHubClaimRulesKey(repository string) string {
+	return OIDCGitHubClaimRulesKeyPrefix + strings.ToLower(repository)
+}
+
 // OIDCStateKey generates a cache key for OIDC state parameter
 func OIDCStateKey(state string) string {
 	return OIDCStateKeyPrefix + state
 }
 
+// OIDCDeviceKey generates a key for a pending GitHub device flow authorization
+func OIDCDeviceKey(deviceCode string) string {
+	return OIDCDeviceKeyPrefix + deviceCode
+}
+
 // OIDCJWKSKey generates a cache key for OIDC JWKS
 func OIDCJWKSKey(provider string) string {
 	return fmt.Sprintf("%s%s", OIDCJWKSKeyPrefix, provider)
 }
+
+// RateLimitKey generates a key for the rate limit state of a subject
+func RateLimitKey(scope, dimension, value string) string {
+	return fmt.Sprintf("%s%s:%s:%s", RateLimitKeyPrefix, scope, dimension, value)
+}
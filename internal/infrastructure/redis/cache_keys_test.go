@@ -74,6 +74,52 @@ func TestSessionKey(t *testing.T) {
 	}
 }
 
+func TestSessionTokenKey(t *testing.T) {
+	tests := []struct {
+		name      string
+		sessionID string
+		want      string
+	}{
+		{
+			name:      "正常系: セッションIDからOAuthトークンのキーが生成される",
+			sessionID: "550e8400-e29b-41d4-a716-446655440000",
+			want:      "lfs:session_token:550e8400-e29b-41d4-a716-446655440000",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := redis.SessionTokenKey(tt.sessionID)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("SessionTokenKey() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUserSessionsKey(t *testing.T) {
+	tests := []struct {
+		name string
+		sub  string
+		want string
+	}{
+		{
+			name: "正常系: subjectからセッション索引のキーが生成される",
+			sub:  "12345",
+			want: "lfs:user:sessions:12345",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := redis.UserSessionsKey(tt.sub)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("UserSessionsKey() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestBatchUploadKey(t *testing.T) {
 	tests := []struct {
 		name string
@@ -140,6 +186,34 @@ func TestOIDCGitHubRepoKey(t *testing.T) {
 	}
 }
 
+func TestOIDCGitHubClaimRulesKey(t *testing.T) {
+	tests := []struct {
+		name       string
+		repository string
+		want       string
+	}{
+		{
+			name:       "正常系: リポジトリ名からクレームルールのキーが生成される",
+			repository: "owner/repo",
+			want:       "lfs:oidc:github:rules:owner/repo",
+		},
+		{
+			name:       "正常系: 大文字を含むリポジトリ名は小文字のキーになる",
+			repository: "Owner/Repo",
+			want:       "lfs:oidc:github:rules:owner/repo",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := redis.OIDCGitHubClaimRulesKey(tt.repository)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("OIDCGitHubClaimRulesKey() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestOIDCStateKey(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -173,6 +247,29 @@ func TestOIDCStateKey(t *testing.T) {
 	}
 }
 
+func TestOIDCDeviceKey(t *testing.T) {
+	tests := []struct {
+		name       string
+		deviceCode string
+		want       string
+	}{
+		{
+			name:       "正常系: デバイスコードからデバイスフローのキーが生成される",
+			deviceCode: "550e8400-e29b-41d4-a716-446655440000",
+			want:       "lfs:oidc:device:550e8400-e29b-41d4-a716-446655440000",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := redis.OIDCDeviceKey(tt.deviceCode)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("OIDCDeviceKey() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestOIDCJWKSKey(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -205,3 +302,37 @@ func TestOIDCJWKSKey(t *testing.T) {
 		})
 	}
 }
+
+func TestRateLimitKey(t *testing.T) {
+	tests := []struct {
+		name      string
+		scope     string
+		dimension string
+		value     string
+		want      string
+	}{
+		{
+			name:      "正常系: スコープ・単位・値からレート制限キーが生成される",
+			scope:     "batch",
+			dimension: "repository",
+			value:     "owner/repo",
+			want:      "lfs:ratelimit:batch:repository:owner/repo",
+		},
+		{
+			name:      "正常系: IPv6アドレスでもキーが生成される",
+			scope:     "proxy_bytes",
+			dimension: "ip",
+			value:     "2001:db8::1",
+			want:      "lfs:ratelimit:proxy_bytes:ip:2001:db8::1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := redis.RateLimitKey(tt.scope, tt.dimension, tt.value)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("RateLimitKey() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package redis_test
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"testing"
 
 	"github.com/go-redis/redismock/v9"
@@ -89,3 +90,74 @@ func TestRedisClient_GetDelJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestRedisClient_MGet(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		keys []string
+	}
+	tests := []struct {
+		name      string
+		setupMock func(mock redismock.ClientMock, args args)
+		args      args
+		want      [][]byte
+		wantErr   bool
+	}{
+		{
+			name: "正常系: 存在するキーの値と存在しないキーのnilがキー順に返る",
+			setupMock: func(mock redismock.ClientMock, args args) {
+				mock.ExpectMGet(args.keys...).SetVal([]interface{}{`{"name":"a","value":1}`, nil, `{"name":"c","value":3}`})
+			},
+			args: args{
+				ctx:  context.Background(),
+				keys: []string{"key-a", "key-b", "key-c"},
+			},
+			want:    [][]byte{[]byte(`{"name":"a","value":1}`), nil, []byte(`{"name":"c","value":3}`)},
+			wantErr: false,
+		},
+		{
+			name:      "正常系: キーが空の場合はRedisにアクセスせず空のスライスを返す",
+			setupMock: func(mock redismock.ClientMock, args args) {},
+			args: args{
+				ctx:  context.Background(),
+				keys: []string{},
+			},
+			want:    [][]byte{},
+			wantErr: false,
+		},
+		{
+			name: "異常系: MGETに失敗した場合はエラーを返す",
+			setupMock: func(mock redismock.ClientMock, args args) {
+				mock.ExpectMGet(args.keys...).SetErr(errors.New("connection refused"))
+			},
+			args: args{
+				ctx:  context.Background(),
+				keys: []string{"key-a"},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mock := redismock.NewClientMock()
+			tt.setupMock(mock, tt.args)
+
+			redisClient := redis.NewRedisClient(client)
+			got, err := redisClient.MGet(tt.args.ctx, tt.args.keys)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("MGet() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("MGet() mismatch (-want +got):\n%s", diff)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("mock expectations not met: %v", err)
+			}
+		})
+	}
+}
@@ -98,7 +98,9 @@ func NewRedisConnection(cfg RedisConfig) (*redis.Client, error) {
 	if !ok {
 		return nil, fmt.Errorf("クライアントが*redis.Clientを返すアダプタではありません")
 	}
-	return unwrapper.UnwrapClient(), nil
+	conn := unwrapper.UnwrapClient()
+	conn.AddHook(tracingHook{})
+	return conn, nil
 }
 
 // NewRedisClient はネイティブのRedisクライアントからRedisClientを作成します（DI用）
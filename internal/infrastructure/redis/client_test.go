@@ -4,12 +4,15 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/go-redis/redismock/v9"
 	"github.com/google/go-cmp/cmp"
 	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/newmo-oss/ctxtime/ctxtimetest"
+	"github.com/newmo-oss/testid"
 	"github.com/redis/go-redis/v9"
 )
 
@@ -611,6 +614,24 @@ func TestRedisClientImpl_GetJSON(t *testing.T) {
 	}
 }
 
+var sessionFixedNow = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// expectSetSession はセッションの保存と索引への登録を行うトランザクションを期待する
+func expectSetSession(mock redismock.ClientMock, sessionID, sub string, data []byte, ttl time.Duration, err error) {
+	indexKey := "lfs:user:sessions:" + sub
+	mock.ExpectTxPipeline()
+	set := mock.ExpectSet("lfs:session:"+sessionID, data, ttl)
+	if err != nil {
+		set.SetErr(err)
+		return
+	}
+	set.SetVal("OK")
+	mock.ExpectZAdd(indexKey, redis.Z{Score: float64(sessionFixedNow.Add(ttl).Unix()), Member: sessionID}).SetVal(1)
+	mock.ExpectZRemRangeByScore(indexKey, "-inf", strconv.FormatInt(sessionFixedNow.Unix(), 10)).SetVal(0)
+	mock.ExpectExpire(indexKey, ttl).SetVal(true)
+	mock.ExpectTxPipelineExec()
+}
+
 // TestRedisClientImpl_SetSession はSetSession処理のテーブルドリブンテスト
 func TestRedisClientImpl_SetSession(t *testing.T) {
 	serializer := NewUserInfoSerializer()
@@ -639,10 +660,8 @@ func TestRedisClientImpl_SetSession(t *testing.T) {
 				ttl:          0,
 			},
 			mockSetup: func(mock redismock.ClientMock, args args, userInfo *domain.UserInfo) {
-				key := "lfs:session:" + args.sessionID
 				jsonData, _ := serializer.Serialize(userInfo)
-				expectedTTL := 24 * time.Hour
-				mock.ExpectSet(key, jsonData, expectedTTL).SetVal("OK")
+				expectSetSession(mock, args.sessionID, args.userInfoData.sub, jsonData, 24*time.Hour, nil)
 			},
 			wantErr: false,
 		},
@@ -654,9 +673,8 @@ func TestRedisClientImpl_SetSession(t *testing.T) {
 				ttl:          30 * time.Minute,
 			},
 			mockSetup: func(mock redismock.ClientMock, args args, userInfo *domain.UserInfo) {
-				key := "lfs:session:" + args.sessionID
 				jsonData, _ := serializer.Serialize(userInfo)
-				mock.ExpectSet(key, jsonData, args.ttl).SetVal("OK")
+				expectSetSession(mock, args.sessionID, args.userInfoData.sub, jsonData, args.ttl, nil)
 			},
 			wantErr: false,
 		},
@@ -668,10 +686,8 @@ func TestRedisClientImpl_SetSession(t *testing.T) {
 				ttl:          0,
 			},
 			mockSetup: func(mock redismock.ClientMock, args args, userInfo *domain.UserInfo) {
-				key := "lfs:session:" + args.sessionID
 				jsonData, _ := serializer.Serialize(userInfo)
-				expectedTTL := 24 * time.Hour
-				mock.ExpectSet(key, jsonData, expectedTTL).SetErr(redis.ErrClosed)
+				expectSetSession(mock, args.sessionID, args.userInfoData.sub, jsonData, 24*time.Hour, redis.ErrClosed)
 			},
 			wantErr: true,
 		},
@@ -689,7 +705,8 @@ func TestRedisClientImpl_SetSession(t *testing.T) {
 			}
 
 			client := NewRedisClient(db)
-			ctx := context.Background()
+			ctx := testid.WithValue(context.Background(), t.Name())
+			ctxtimetest.SetFixedNow(t, ctx, sessionFixedNow)
 
 			err := client.SetSession(ctx, tt.args.sessionID, userInfo, tt.args.ttl)
 			if (err != nil) != tt.wantErr {
@@ -801,23 +818,28 @@ func TestRedisClientImpl_GetSession(t *testing.T) {
 
 // TestRedisClientImpl_DeleteSession はDeleteSession処理のテーブルドリブンテスト
 func TestRedisClientImpl_DeleteSession(t *testing.T) {
+	serializer := NewUserInfoSerializer()
 	type args struct {
 		sessionID string
 	}
 	tests := []struct {
 		name      string
 		args      args
-		mockSetup func(mock redismock.ClientMock, args args)
+		mockSetup func(mock redismock.ClientMock, args args, data []byte)
 		wantErr   bool
 	}{
 		{
-			name: "正常系: DeleteSessionに成功",
+			name: "正常系: DeleteSessionでセッションと索引の登録が削除される",
 			args: args{
 				sessionID: "test-session-1",
 			},
-			mockSetup: func(mock redismock.ClientMock, args args) {
+			mockSetup: func(mock redismock.ClientMock, args args, data []byte) {
 				key := "lfs:session:" + args.sessionID
-				mock.ExpectDel(key).SetVal(1)
+				mock.ExpectGet(key).SetVal(string(data))
+				mock.ExpectTxPipeline()
+				mock.ExpectDel(key, "lfs:session_token:"+args.sessionID).SetVal(2)
+				mock.ExpectZRem("lfs:user:sessions:user123", args.sessionID).SetVal(1)
+				mock.ExpectTxPipelineExec()
 			},
 			wantErr: false,
 		},
@@ -826,9 +848,9 @@ func TestRedisClientImpl_DeleteSession(t *testing.T) {
 			args: args{
 				sessionID: "test-session-nonexistent",
 			},
-			mockSetup: func(mock redismock.ClientMock, args args) {
+			mockSetup: func(mock redismock.ClientMock, args args, data []byte) {
 				key := "lfs:session:" + args.sessionID
-				mock.ExpectDel(key).SetVal(0)
+				mock.ExpectGet(key).SetErr(redis.Nil)
 			},
 			wantErr: false,
 		},
@@ -837,9 +859,22 @@ func TestRedisClientImpl_DeleteSession(t *testing.T) {
 			args: args{
 				sessionID: "test-session-2",
 			},
-			mockSetup: func(mock redismock.ClientMock, args args) {
+			mockSetup: func(mock redismock.ClientMock, args args, data []byte) {
+				key := "lfs:session:" + args.sessionID
+				mock.ExpectGet(key).SetErr(redis.ErrClosed)
+			},
+			wantErr: true,
+		},
+		{
+			name: "異常系: 削除のトランザクションに失敗",
+			args: args{
+				sessionID: "test-session-3",
+			},
+			mockSetup: func(mock redismock.ClientMock, args args, data []byte) {
 				key := "lfs:session:" + args.sessionID
-				mock.ExpectDel(key).SetErr(redis.ErrClosed)
+				mock.ExpectGet(key).SetVal(string(data))
+				mock.ExpectTxPipeline()
+				mock.ExpectDel(key, "lfs:session_token:"+args.sessionID).SetErr(redis.ErrClosed)
 			},
 			wantErr: true,
 		},
@@ -850,8 +885,9 @@ func TestRedisClientImpl_DeleteSession(t *testing.T) {
 			db, mock := redismock.NewClientMock()
 			defer func() { _ = db.Close() }()
 
+			data, _ := serializer.Serialize(mustNewUserInfo(t, "user123", "test@example.com", "Test User", domain.ProviderTypeGitHub, nil, ""))
 			if tt.mockSetup != nil {
-				tt.mockSetup(mock, tt.args)
+				tt.mockSetup(mock, tt.args, data)
 			}
 
 			client := NewRedisClient(db)
@@ -869,6 +905,217 @@ func TestRedisClientImpl_DeleteSession(t *testing.T) {
 	}
 }
 
+// TestRedisClientImpl_SessionToken はOAuthトークンを伴うセッション処理のテーブルドリブンテスト
+func TestRedisClientImpl_SessionToken(t *testing.T) {
+	serializer := NewUserInfoSerializer()
+	userInfo := mustNewUserInfo(t, "user123", "test@example.com", "Test User", domain.ProviderTypeGitHub, nil, "")
+	data, _ := serializer.Serialize(userInfo)
+	token := &SessionTokenRecord{
+		EncryptedAccessToken: "encrypted",
+		TokenType:            "bearer",
+		Scope:                "repo",
+		CheckedAt:            sessionFixedNow,
+	}
+	tokenData, _ := json.Marshal(token)
+
+	tests := []struct {
+		name      string
+		mockSetup func(mock redismock.ClientMock)
+		run       func(ctx context.Context, client *RedisClient) (*SessionTokenRecord, error)
+		want      *SessionTokenRecord
+		wantErr   error
+	}{
+		{
+			name: "正常系: セッションとOAuthトークンが同じトランザクションで保存される",
+			mockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectTxPipeline()
+				mock.ExpectSet("lfs:session:session-1", data, time.Hour).SetVal("OK")
+				mock.ExpectSet("lfs:session_token:session-1", tokenData, time.Hour).SetVal("OK")
+				mock.ExpectZAdd("lfs:user:sessions:user123", redis.Z{Score: float64(sessionFixedNow.Add(time.Hour).Unix()), Member: "session-1"}).SetVal(1)
+				mock.ExpectZRemRangeByScore("lfs:user:sessions:user123", "-inf", strconv.FormatInt(sessionFixedNow.Unix(), 10)).SetVal(0)
+				mock.ExpectExpire("lfs:user:sessions:user123", time.Hour).SetVal(true)
+				mock.ExpectTxPipelineExec()
+			},
+			run: func(ctx context.Context, client *RedisClient) (*SessionTokenRecord, error) {
+				return nil, client.SetSessionWithToken(ctx, "session-1", userInfo, token, time.Hour)
+			},
+		},
+		{
+			name: "正常系: OAuthトークンが取得される",
+			mockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectGet("lfs:session_token:session-1").SetVal(string(tokenData))
+			},
+			run: func(ctx context.Context, client *RedisClient) (*SessionTokenRecord, error) {
+				return client.GetSessionToken(ctx, "session-1")
+			},
+			want: token,
+		},
+		{
+			name: "異常系: OAuthトークンが保存されていない場合、ErrCacheMissが返る",
+			mockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectGet("lfs:session_token:session-1").RedisNil()
+			},
+			run: func(ctx context.Context, client *RedisClient) (*SessionTokenRecord, error) {
+				return client.GetSessionToken(ctx, "session-1")
+			},
+			wantErr: ErrCacheMiss,
+		},
+		{
+			name: "正常系: 有効期限を変えずに存在するセッションのみが更新される",
+			mockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectTxPipeline()
+				mock.ExpectSetArgs("lfs:session:session-1", data, redis.SetArgs{Mode: "XX", KeepTTL: true}).SetVal("OK")
+				mock.ExpectSetArgs("lfs:session_token:session-1", tokenData, redis.SetArgs{Mode: "XX", KeepTTL: true}).SetVal("OK")
+				mock.ExpectTxPipelineExec()
+			},
+			run: func(ctx context.Context, client *RedisClient) (*SessionTokenRecord, error) {
+				return nil, client.UpdateSession(ctx, "session-1", userInfo, token)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := redismock.NewClientMock()
+			defer func() { _ = db.Close() }()
+			tt.mockSetup(mock)
+
+			client := NewRedisClient(db)
+			ctx := testid.WithValue(context.Background(), t.Name())
+			ctxtimetest.SetFixedNow(t, ctx, sessionFixedNow)
+
+			got, err := tt.run(ctx, client)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unfulfilled expectations: %v", err)
+			}
+		})
+	}
+}
+
+// TestRedisClientImpl_ListUserSessions はListUserSessions処理のテーブルドリブンテスト
+func TestRedisClientImpl_ListUserSessions(t *testing.T) {
+	serializer := NewUserInfoSerializer()
+	userInfo := mustNewUserInfo(t, "user123", "test@example.com", "Test User", domain.ProviderTypeGitHub, nil, "")
+	data, _ := serializer.Serialize(userInfo)
+	expiresAt := sessionFixedNow.Add(time.Hour)
+	rangeBy := &redis.ZRangeBy{Min: "(" + strconv.FormatInt(sessionFixedNow.Unix(), 10), Max: "+inf"}
+
+	tests := []struct {
+		name      string
+		mockSetup func(mock redismock.ClientMock)
+		want      []*SessionEntry
+		wantErr   bool
+	}{
+		{
+			name: "正常系: 有効なセッションのみが返り、削除済みのセッションは含まれない",
+			mockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectZRangeByScoreWithScores("lfs:user:sessions:user123", rangeBy).SetVal([]redis.Z{
+					{Score: float64(expiresAt.Unix()), Member: "session-1"},
+					{Score: float64(expiresAt.Unix()), Member: "session-deleted"},
+				})
+				mock.ExpectGet("lfs:session:session-1").SetVal(string(data))
+				mock.ExpectGet("lfs:session:session-deleted").SetErr(redis.Nil)
+			},
+			want: []*SessionEntry{
+				{ID: "session-1", UserInfo: userInfo, ExpiresAt: expiresAt},
+			},
+		},
+		{
+			name: "異常系: 索引の取得に失敗",
+			mockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectZRangeByScoreWithScores("lfs:user:sessions:user123", rangeBy).SetErr(redis.ErrClosed)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := redismock.NewClientMock()
+			defer func() { _ = db.Close() }()
+			tt.mockSetup(mock)
+
+			client := NewRedisClient(db)
+			ctx := testid.WithValue(context.Background(), t.Name())
+			ctxtimetest.SetFixedNow(t, ctx, sessionFixedNow)
+
+			got, err := client.ListUserSessions(ctx, "user123")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ListUserSessions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(domain.UserInfo{}, domain.ProviderType{}, domain.RepositoryIdentifier{})); diff != "" {
+				t.Errorf("ListUserSessions() mismatch (-want +got):\n%s", diff)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unfulfilled expectations: %v", err)
+			}
+		})
+	}
+}
+
+// TestRedisClientImpl_DeleteUserSessions はDeleteUserSessions処理のテーブルドリブンテスト
+func TestRedisClientImpl_DeleteUserSessions(t *testing.T) {
+	tests := []struct {
+		name      string
+		mockSetup func(mock redismock.ClientMock)
+		want      int
+		wantErr   bool
+	}{
+		{
+			name: "正常系: 索引に登録されたセッションがすべて削除される",
+			mockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectZRange("lfs:user:sessions:user123", 0, -1).SetVal([]string{"session-1", "session-2"})
+				mock.ExpectTxPipeline()
+				mock.ExpectDel("lfs:session:session-1", "lfs:session:session-2").SetVal(2)
+				mock.ExpectDel("lfs:session_token:session-1", "lfs:session_token:session-2").SetVal(2)
+				mock.ExpectZRem("lfs:user:sessions:user123", "session-1", "session-2").SetVal(2)
+				mock.ExpectTxPipelineExec()
+			},
+			want: 2,
+		},
+		{
+			name: "正常系: セッションがない場合、0が返る",
+			mockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectZRange("lfs:user:sessions:user123", 0, -1).SetVal([]string{})
+			},
+			want: 0,
+		},
+		{
+			name: "異常系: 索引の取得に失敗",
+			mockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectZRange("lfs:user:sessions:user123", 0, -1).SetErr(redis.ErrClosed)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := redismock.NewClientMock()
+			defer func() { _ = db.Close() }()
+			tt.mockSetup(mock)
+
+			client := NewRedisClient(db)
+			got, err := client.DeleteUserSessions(context.Background(), "user123")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DeleteUserSessions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("DeleteUserSessions() = %v, want %v", got, tt.want)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unfulfilled expectations: %v", err)
+			}
+		})
+	}
+}
+
 // TestRedisClientImpl_SessionWithCustomTTL はカスタムTTLのセッション処理テスト
 func TestRedisClientImpl_SessionWithCustomTTL(t *testing.T) {
 	serializer := NewUserInfoSerializer()
@@ -897,9 +1144,8 @@ func TestRedisClientImpl_SessionWithCustomTTL(t *testing.T) {
 				ttl:          2 * time.Second,
 			},
 			mockSetup: func(mock redismock.ClientMock, args args, userInfo *domain.UserInfo) {
-				key := "lfs:session:" + args.sessionID
 				jsonData, _ := serializer.Serialize(userInfo)
-				mock.ExpectSet(key, jsonData, args.ttl).SetVal("OK")
+				expectSetSession(mock, args.sessionID, args.userInfoData.sub, jsonData, args.ttl, nil)
 			},
 			wantErr: false,
 		},
@@ -911,9 +1157,8 @@ func TestRedisClientImpl_SessionWithCustomTTL(t *testing.T) {
 				ttl:          2 * time.Second,
 			},
 			mockSetup: func(mock redismock.ClientMock, args args, userInfo *domain.UserInfo) {
-				key := "lfs:session:" + args.sessionID
 				jsonData, _ := serializer.Serialize(userInfo)
-				mock.ExpectSet(key, jsonData, args.ttl).SetErr(redis.ErrClosed)
+				expectSetSession(mock, args.sessionID, args.userInfoData.sub, jsonData, args.ttl, redis.ErrClosed)
 			},
 			wantErr: true,
 		},
@@ -931,7 +1176,8 @@ func TestRedisClientImpl_SessionWithCustomTTL(t *testing.T) {
 			}
 
 			client := NewRedisClient(db)
-			ctx := context.Background()
+			ctx := testid.WithValue(context.Background(), t.Name())
+			ctxtimetest.SetFixedNow(t, ctx, sessionFixedNow)
 
 			err := client.SetSession(ctx, tt.args.sessionID, userInfo, tt.args.ttl)
 			if (err != nil) != tt.wantErr {
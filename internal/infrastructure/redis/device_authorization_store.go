@@ -0,0 +1,52 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/na2na-p/cargohold/internal/domain"
+)
+
+type deviceAuthorizationDTO struct {
+	DeviceCode string `json:"device_code"`
+	Repository string `json:"repository"`
+}
+
+// DeviceAuthorizationStore はGitHubのデバイスフローで承認を待っているリクエストを保持する
+type DeviceAuthorizationStore struct {
+	client *RedisClient
+}
+
+func NewDeviceAuthorizationStore(client *RedisClient) *DeviceAuthorizationStore {
+	return &DeviceAuthorizationStore{
+		client: client,
+	}
+}
+
+func (s *DeviceAuthorizationStore) SaveDeviceAuthorization(ctx context.Context, id string, data *domain.DeviceAuthorization, ttl time.Duration) error {
+	dto := &deviceAuthorizationDTO{
+		DeviceCode: data.DeviceCode(),
+		Repository: data.Repository(),
+	}
+	if err := s.client.SetJSON(ctx, OIDCDeviceKey(id), dto, ttl); err != nil {
+		return fmt.Errorf("デバイスフローのリクエストの保存に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// GetDeviceAuthorization はリクエストを取得する。承認待ちの間はポーリングされるため削除しない
+func (s *DeviceAuthorizationStore) GetDeviceAuthorization(ctx context.Context, id string) (*domain.DeviceAuthorization, error) {
+	var dto deviceAuthorizationDTO
+	if err := s.client.GetJSON(ctx, OIDCDeviceKey(id), &dto); err != nil {
+		return nil, fmt.Errorf("デバイスフローのリクエストの取得に失敗しました: %w", err)
+	}
+	return domain.NewDeviceAuthorization(dto.DeviceCode, dto.Repository), nil
+}
+
+func (s *DeviceAuthorizationStore) DeleteDeviceAuthorization(ctx context.Context, id string) error {
+	if err := s.client.Delete(ctx, OIDCDeviceKey(id)); err != nil {
+		return fmt.Errorf("デバイスフローのリクエストの削除に失敗しました: %w", err)
+	}
+	return nil
+}
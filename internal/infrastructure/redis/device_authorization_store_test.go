@@ -0,0 +1,87 @@
+package redis_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/google/go-cmp/cmp"
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/na2na-p/cargohold/internal/infrastructure/redis"
+)
+
+func TestDeviceAuthorizationStore(t *testing.T) {
+	payload, _ := json.Marshal(map[string]string{
+		"device_code": "github-device-code",
+		"repository":  "owner/repo",
+	})
+
+	tests := []struct {
+		name      string
+		setupMock func(mock redismock.ClientMock)
+		run       func(ctx context.Context, store *redis.DeviceAuthorizationStore) (*domain.DeviceAuthorization, error)
+		want      *domain.DeviceAuthorization
+		wantErr   bool
+	}{
+		{
+			name: "正常系: リクエストが有効期限付きで保存される",
+			setupMock: func(mock redismock.ClientMock) {
+				mock.ExpectSet(redis.OIDCDeviceKey("device-id"), payload, 15*time.Minute).SetVal("OK")
+			},
+			run: func(ctx context.Context, store *redis.DeviceAuthorizationStore) (*domain.DeviceAuthorization, error) {
+				return nil, store.SaveDeviceAuthorization(ctx, "device-id", domain.NewDeviceAuthorization("github-device-code", "owner/repo"), 15*time.Minute)
+			},
+		},
+		{
+			name: "正常系: リクエストが削除されずに取得される",
+			setupMock: func(mock redismock.ClientMock) {
+				mock.ExpectGet(redis.OIDCDeviceKey("device-id")).SetVal(string(payload))
+			},
+			run: func(ctx context.Context, store *redis.DeviceAuthorizationStore) (*domain.DeviceAuthorization, error) {
+				return store.GetDeviceAuthorization(ctx, "device-id")
+			},
+			want: domain.NewDeviceAuthorization("github-device-code", "owner/repo"),
+		},
+		{
+			name: "異常系: 存在しないリクエストを取得するとエラー",
+			setupMock: func(mock redismock.ClientMock) {
+				mock.ExpectGet(redis.OIDCDeviceKey("unknown")).RedisNil()
+			},
+			run: func(ctx context.Context, store *redis.DeviceAuthorizationStore) (*domain.DeviceAuthorization, error) {
+				return store.GetDeviceAuthorization(ctx, "unknown")
+			},
+			wantErr: true,
+		},
+		{
+			name: "正常系: リクエストが削除される",
+			setupMock: func(mock redismock.ClientMock) {
+				mock.ExpectDel(redis.OIDCDeviceKey("device-id")).SetVal(1)
+			},
+			run: func(ctx context.Context, store *redis.DeviceAuthorizationStore) (*domain.DeviceAuthorization, error) {
+				return nil, store.DeleteDeviceAuthorization(ctx, "device-id")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mock := redismock.NewClientMock()
+			tt.setupMock(mock)
+			store := redis.NewDeviceAuthorizationStore(redis.NewRedisClient(client))
+
+			got, err := tt.run(context.Background(), store)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(domain.DeviceAuthorization{})); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("mock expectations not met: %v", err)
+			}
+		})
+	}
+}
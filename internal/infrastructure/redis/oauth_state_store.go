@@ -9,9 +9,10 @@ import (
 )
 
 type oauthStateDTO struct {
-	Repository  string `json:"repository"`
-	RedirectURI string `json:"redirect_uri"`
-	Shell       string `json:"shell,omitempty"`
+	Repository   string `json:"repository"`
+	RedirectURI  string `json:"redirect_uri"`
+	CodeVerifier string `json:"code_verifier"`
+	Shell        string `json:"shell,omitempty"`
 }
 
 type OAuthStateStore struct {
@@ -27,9 +28,10 @@ func NewOAuthStateStore(client *RedisClient) *OAuthStateStore {
 func (s *OAuthStateStore) SaveState(ctx context.Context, state string, data *domain.OAuthState, ttl time.Duration) error {
 	key := OIDCStateKey(state)
 	dto := &oauthStateDTO{
-		Repository:  data.Repository(),
-		RedirectURI: data.RedirectURI(),
-		Shell:       data.Shell().String(),
+		Repository:   data.Repository(),
+		RedirectURI:  data.RedirectURI(),
+		CodeVerifier: data.CodeVerifier(),
+		Shell:        data.Shell().String(),
 	}
 	err := s.client.SetJSON(ctx, key, dto, ttl)
 	if err != nil {
@@ -51,5 +53,5 @@ func (s *OAuthStateStore) GetAndDeleteState(ctx context.Context, state string) (
 	if dto.Shell != "" {
 		shellType, _ = domain.ParseShellType(dto.Shell)
 	}
-	return domain.NewOAuthState(dto.Repository, dto.RedirectURI, shellType), nil
+	return domain.NewOAuthState(dto.Repository, dto.RedirectURI, dto.CodeVerifier, shellType), nil
 }
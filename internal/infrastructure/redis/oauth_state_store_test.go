@@ -13,9 +13,10 @@ import (
 )
 
 type oauthStateDTO struct {
-	Repository  string `json:"repository"`
-	RedirectURI string `json:"redirect_uri"`
-	Shell       string `json:"shell,omitempty"`
+	Repository   string `json:"repository"`
+	RedirectURI  string `json:"redirect_uri"`
+	CodeVerifier string `json:"code_verifier"`
+	Shell        string `json:"shell,omitempty"`
 }
 
 func TestOAuthStateStore_SaveState(t *testing.T) {
@@ -32,12 +33,13 @@ func TestOAuthStateStore_SaveState(t *testing.T) {
 		wantErr   bool
 	}{
 		{
-			name: "正常系: stateデータが正常に保存される",
+			name: "正常系: PKCEのcode_verifierを含むstateデータが正常に保存される",
 			setupMock: func(mock redismock.ClientMock, args args) {
 				key := redis.OIDCStateKey(args.state)
 				dto := &oauthStateDTO{
-					Repository:  args.data.Repository(),
-					RedirectURI: args.data.RedirectURI(),
+					Repository:   args.data.Repository(),
+					RedirectURI:  args.data.RedirectURI(),
+					CodeVerifier: "test-code-verifier",
 				}
 				jsonBytes, _ := json.Marshal(dto)
 				mock.ExpectSet(key, jsonBytes, args.ttl).SetVal("OK")
@@ -45,7 +47,7 @@ func TestOAuthStateStore_SaveState(t *testing.T) {
 			args: args{
 				ctx:   context.Background(),
 				state: "test-state-123",
-				data:  domain.NewOAuthState("owner/repo", "https://example.com/callback", domain.ShellType{}),
+				data:  domain.NewOAuthState("owner/repo", "https://example.com/callback", "test-code-verifier", domain.ShellType{}),
 				ttl:   redis.OIDCStateTTL,
 			},
 			wantErr: false,
@@ -89,8 +91,9 @@ func TestOAuthStateStore_GetAndDeleteState(t *testing.T) {
 			name: "正常系: stateデータがアトミックに取得・削除される",
 			setupMock: func(mock redismock.ClientMock, args args) {
 				dto := &oauthStateDTO{
-					Repository:  "owner/repo",
-					RedirectURI: "https://example.com/callback",
+					Repository:   "owner/repo",
+					RedirectURI:  "https://example.com/callback",
+					CodeVerifier: "test-code-verifier",
 				}
 				key := redis.OIDCStateKey(args.state)
 				jsonBytes, _ := json.Marshal(dto)
@@ -100,7 +103,7 @@ func TestOAuthStateStore_GetAndDeleteState(t *testing.T) {
 				ctx:   context.Background(),
 				state: "test-state-123",
 			},
-			want:    domain.NewOAuthState("owner/repo", "https://example.com/callback", domain.ShellType{}),
+			want:    domain.NewOAuthState("owner/repo", "https://example.com/callback", "test-code-verifier", domain.ShellType{}),
 			wantErr: false,
 		},
 		{
@@ -141,7 +144,7 @@ func TestOAuthStateStore_GetAndDeleteState(t *testing.T) {
 					if a == nil || b == nil {
 						return false
 					}
-					return a.Repository() == b.Repository() && a.RedirectURI() == b.RedirectURI() && a.Shell() == b.Shell()
+					return a.Repository() == b.Repository() && a.RedirectURI() == b.RedirectURI() && a.CodeVerifier() == b.CodeVerifier() && a.Shell() == b.Shell()
 				}),
 			}
 			if diff := cmp.Diff(tt.want, got, opts...); diff != "" {
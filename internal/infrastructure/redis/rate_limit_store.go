@@ -0,0 +1,85 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/na2na-p/cargohold/internal/usecase"
+	"github.com/redis/go-redis/v9"
+)
+
+var _ usecase.RateLimitStore = (*RateLimitStore)(nil)
+
+// takeScript は理論到着時刻 (マイクロ秒) を進め、許容範囲を超える場合は更新せずに待ち時間を返す
+// キーは理論到着時刻を過ぎると不要になるため、その時点で失効させる
+var takeScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local increment = tonumber(ARGV[2])
+local tolerance = tonumber(ARGV[3])
+local tat = tonumber(redis.call("GET", KEYS[1]))
+if not tat or tat < now then
+	tat = now
+end
+local new_tat = tat + increment
+if new_tat - now > tolerance then
+	return new_tat - now - tolerance
+end
+if increment > 0 then
+	redis.call("SET", KEYS[1], string.format("%.0f", new_tat), "PX", math.ceil((new_tat - now) / 1000) + 1)
+end
+return 0
+`)
+
+// chargeScript は許容範囲に関わらず理論到着時刻 (マイクロ秒) を進める
+// 払い戻しで理論到着時刻が現在時刻以前に戻る場合は、消費がない状態としてキーを削除する
+var chargeScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local increment = tonumber(ARGV[2])
+local tat = tonumber(redis.call("GET", KEYS[1]))
+if not tat or tat < now then
+	tat = now
+end
+local new_tat = tat + increment
+if new_tat <= now then
+	redis.call("DEL", KEYS[1])
+	return 0
+end
+redis.call("SET", KEYS[1], string.format("%.0f", new_tat), "PX", math.ceil((new_tat - now) / 1000) + 1)
+return 0
+`)
+
+// RateLimitStore はGCRAの理論到着時刻をRedisに保持する
+// 判定と更新はLuaスクリプトでアトミックに行うため、複数のPodから同時に呼び出しても制限を超えない
+type RateLimitStore struct {
+	client *RedisClient
+}
+
+func NewRateLimitStore(client *RedisClient) *RateLimitStore {
+	return &RateLimitStore{
+		client: client,
+	}
+}
+
+func (s *RateLimitStore) Take(ctx context.Context, scope string, subject domain.RateLimitSubject, now time.Time, increment, tolerance time.Duration) (time.Duration, error) {
+	key := RateLimitKey(scope, string(subject.Dimension), subject.Value)
+	retryAfter, err := takeScript.Run(ctx, s.client.client, []string{key},
+		now.UnixMicro(), increment.Microseconds(), tolerance.Microseconds(),
+	).Int64()
+	if err != nil {
+		return 0, fmt.Errorf("レート制限の判定に失敗しました: %w", err)
+	}
+	return time.Duration(retryAfter) * time.Microsecond, nil
+}
+
+func (s *RateLimitStore) Charge(ctx context.Context, scope string, subject domain.RateLimitSubject, now time.Time, increment time.Duration) error {
+	key := RateLimitKey(scope, string(subject.Dimension), subject.Value)
+	err := chargeScript.Run(ctx, s.client.client, []string{key},
+		now.UnixMicro(), increment.Microseconds(),
+	).Err()
+	if err != nil {
+		return fmt.Errorf("レート制限の消費に失敗しました: %w", err)
+	}
+	return nil
+}
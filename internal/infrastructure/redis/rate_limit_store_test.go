@@ -0,0 +1,109 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/google/go-cmp/cmp"
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/na2na-p/cargohold/internal/usecase"
+)
+
+func TestRateLimitStore_Take(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	subject := domain.RateLimitSubject{Dimension: domain.RateLimitDimensionIdentity, Value: "repo:owner/repo:ref:refs/heads/main"}
+	const key = "lfs:ratelimit:batch:identity:repo:owner/repo:ref:refs/heads/main"
+
+	tests := []struct {
+		name      string
+		setupMock func(mock redismock.ClientMock)
+		want      time.Duration
+		wantErr   bool
+	}{
+		{
+			name: "正常系: 許容範囲内の場合、待ち時間は0になる",
+			setupMock: func(mock redismock.ClientMock) {
+				mock.ExpectEvalSha(takeScript.Hash(), []string{key}, now.UnixMicro(), int64(100000), int64(1000000)).SetVal(int64(0))
+			},
+			want: 0,
+		},
+		{
+			name: "正常系: 許容範囲を超える場合、待ち時間が返る",
+			setupMock: func(mock redismock.ClientMock) {
+				mock.ExpectEvalSha(takeScript.Hash(), []string{key}, now.UnixMicro(), int64(100000), int64(1000000)).SetVal(int64(1500000))
+			},
+			want: 1500 * time.Millisecond,
+		},
+		{
+			name: "異常系: Redisがエラーを返した場合、エラーが返る",
+			setupMock: func(mock redismock.ClientMock) {
+				mock.ExpectEvalSha(takeScript.Hash(), []string{key}, now.UnixMicro(), int64(100000), int64(1000000)).SetErr(errors.New("connection refused"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mock := redismock.NewClientMock()
+			tt.setupMock(mock)
+			store := NewRateLimitStore(NewRedisClient(client))
+
+			got, err := store.Take(context.Background(), usecase.RateLimitScopeBatch, subject, now, 100*time.Millisecond, time.Second)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Take() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Take() mismatch (-want +got):\n%s", diff)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unfulfilled expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestRateLimitStore_Charge(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	subject := domain.RateLimitSubject{Dimension: domain.RateLimitDimensionClientIP, Value: "192.0.2.1"}
+	const key = "lfs:ratelimit:proxy_bytes:ip:192.0.2.1"
+
+	tests := []struct {
+		name      string
+		setupMock func(mock redismock.ClientMock)
+		wantErr   bool
+	}{
+		{
+			name: "正常系: 理論到着時刻が進められる",
+			setupMock: func(mock redismock.ClientMock) {
+				mock.ExpectEvalSha(chargeScript.Hash(), []string{key}, now.UnixMicro(), int64(2000000)).SetVal(int64(0))
+			},
+		},
+		{
+			name: "異常系: Redisがエラーを返した場合、エラーが返る",
+			setupMock: func(mock redismock.ClientMock) {
+				mock.ExpectEvalSha(chargeScript.Hash(), []string{key}, now.UnixMicro(), int64(2000000)).SetErr(errors.New("connection refused"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mock := redismock.NewClientMock()
+			tt.setupMock(mock)
+			store := NewRateLimitStore(NewRedisClient(client))
+
+			err := store.Charge(context.Background(), usecase.RateLimitScopeProxyBytes, subject, now, 2*time.Second)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Charge() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unfulfilled expectations: %v", err)
+			}
+		})
+	}
+}
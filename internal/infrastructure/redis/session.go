@@ -2,13 +2,41 @@ package redis
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/newmo-oss/ctxtime"
+	"github.com/redis/go-redis/v9"
 )
 
+// SessionEntry はユーザーのセッション索引から取得したセッション
+type SessionEntry struct {
+	ID        string
+	UserInfo  *domain.UserInfo
+	ExpiresAt time.Time
+}
+
+// SessionTokenRecord はセッションの権限を再検証するためにセッションと共に保存するOAuthトークン
+// アクセストークンは呼び出し側で暗号化した値を保存する
+type SessionTokenRecord struct {
+	EncryptedAccessToken string    `json:"access_token"`
+	TokenType            string    `json:"token_type"`
+	Scope                string    `json:"scope"`
+	CheckedAt            time.Time `json:"checked_at"`
+}
+
+// SetSession はセッションを保存し、ユーザーのセッション索引に有効期限をスコアとして登録する
 func (c *RedisClient) SetSession(ctx context.Context, sessionID string, userInfo *domain.UserInfo, ttl time.Duration) error {
+	return c.SetSessionWithToken(ctx, sessionID, userInfo, nil, ttl)
+}
+
+// SetSessionWithToken はセッションをOAuthトークンと共に保存する。tokenがnilの場合はトークンを保存しない
+// 索引に登録されないセッションは一括失効できないため、保存と登録は同じトランザクションで行う
+func (c *RedisClient) SetSessionWithToken(ctx context.Context, sessionID string, userInfo *domain.UserInfo, token *SessionTokenRecord, ttl time.Duration) error {
 	if userInfo == nil {
 		return fmt.Errorf("userInfo is nil")
 	}
@@ -20,15 +48,70 @@ func (c *RedisClient) SetSession(ctx context.Context, sessionID string, userInfo
 	if err != nil {
 		return fmt.Errorf("セッション情報のシリアライズに失敗しました: %w", err)
 	}
+	var tokenData []byte
+	if token != nil {
+		tokenData, err = json.Marshal(token)
+		if err != nil {
+			return fmt.Errorf("OAuthトークンのシリアライズに失敗しました: %w", err)
+		}
+	}
 
-	key := SessionKey(sessionID)
-	err = c.Set(ctx, key, data, ttl)
+	now := ctxtime.Now(ctx)
+	indexKey := UserSessionsKey(userInfo.Sub())
+	_, err = c.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, SessionKey(sessionID), data, ttl)
+		if tokenData != nil {
+			pipe.Set(ctx, SessionTokenKey(sessionID), tokenData, ttl)
+		}
+		pipe.ZAdd(ctx, indexKey, redis.Z{Score: float64(now.Add(ttl).Unix()), Member: sessionID})
+		// 期限切れのセッションを索引から取り除く
+		pipe.ZRemRangeByScore(ctx, indexKey, "-inf", strconv.FormatInt(now.Unix(), 10))
+		// セッションのTTLは一定のため、最後に作成したセッションの期限まで索引を保持すれば足りる
+		pipe.Expire(ctx, indexKey, ttl)
+		return nil
+	})
 	if err != nil {
 		return fmt.Errorf("セッション情報の保存に失敗しました: %w", err)
 	}
 	return nil
 }
 
+// UpdateSession は再検証した権限とトークンの確認日時で、有効期限を変えずにセッションを更新する
+func (c *RedisClient) UpdateSession(ctx context.Context, sessionID string, userInfo *domain.UserInfo, token *SessionTokenRecord) error {
+	if userInfo == nil || token == nil {
+		return fmt.Errorf("userInfo and token are required")
+	}
+
+	data, err := c.serializer.Serialize(userInfo)
+	if err != nil {
+		return fmt.Errorf("セッション情報のシリアライズに失敗しました: %w", err)
+	}
+	tokenData, err := json.Marshal(token)
+	if err != nil {
+		return fmt.Errorf("OAuthトークンのシリアライズに失敗しました: %w", err)
+	}
+
+	// 失効と並行して更新した場合にセッションを復活させないよう、存在する場合のみ上書きする
+	_, err = c.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.SetArgs(ctx, SessionKey(sessionID), data, redis.SetArgs{Mode: "XX", KeepTTL: true})
+		pipe.SetArgs(ctx, SessionTokenKey(sessionID), tokenData, redis.SetArgs{Mode: "XX", KeepTTL: true})
+		return nil
+	})
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return fmt.Errorf("セッション情報の更新に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// GetSessionToken はセッションのOAuthトークンを取得する。保存されていない場合はErrCacheMissを返す
+func (c *RedisClient) GetSessionToken(ctx context.Context, sessionID string) (*SessionTokenRecord, error) {
+	var token SessionTokenRecord
+	if err := c.GetJSON(ctx, SessionTokenKey(sessionID), &token); err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
 func (c *RedisClient) GetSession(ctx context.Context, sessionID string) (*domain.UserInfo, error) {
 	key := SessionKey(sessionID)
 	data, err := c.Get(ctx, key)
@@ -43,11 +126,95 @@ func (c *RedisClient) GetSession(ctx context.Context, sessionID string) (*domain
 	return userInfo, nil
 }
 
+// DeleteSession はセッションを削除し、ユーザーのセッション索引からも取り除く
 func (c *RedisClient) DeleteSession(ctx context.Context, sessionID string) error {
-	key := SessionKey(sessionID)
-	err := c.Delete(ctx, key)
+	userInfo, err := c.GetSession(ctx, sessionID)
+	if errors.Is(err, ErrCacheMiss) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("セッション情報の削除に失敗しました: %w", err)
+	}
+
+	_, err = c.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, SessionKey(sessionID), SessionTokenKey(sessionID))
+		pipe.ZRem(ctx, UserSessionsKey(userInfo.Sub()), sessionID)
+		return nil
+	})
 	if err != nil {
 		return fmt.Errorf("セッション情報の削除に失敗しました: %w", err)
 	}
 	return nil
 }
+
+// ListUserSessions はユーザーの有効なセッションを有効期限の昇順で返す
+func (c *RedisClient) ListUserSessions(ctx context.Context, sub string) ([]*SessionEntry, error) {
+	members, err := c.client.ZRangeByScoreWithScores(ctx, UserSessionsKey(sub), &redis.ZRangeBy{
+		Min: "(" + strconv.FormatInt(ctxtime.Now(ctx).Unix(), 10),
+		Max: "+inf",
+	}).Result()
+	if err != nil {
+		return nil, fmt.Errorf("セッション索引の取得に失敗しました: %w", err)
+	}
+
+	entries := make([]*SessionEntry, 0, len(members))
+	for _, member := range members {
+		sessionID, ok := member.Member.(string)
+		if !ok {
+			continue
+		}
+		userInfo, err := c.GetSession(ctx, sessionID)
+		if errors.Is(err, ErrCacheMiss) {
+			// 索引の更新と並行して削除されたセッションは一覧に含めない
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, &SessionEntry{
+			ID:        sessionID,
+			UserInfo:  userInfo,
+			ExpiresAt: time.Unix(int64(member.Score), 0).UTC(),
+		})
+	}
+	return entries, nil
+}
+
+// DeleteUserSessions はユーザーのセッションをすべて削除し、削除したセッション数を返す
+func (c *RedisClient) DeleteUserSessions(ctx context.Context, sub string) (int, error) {
+	indexKey := UserSessionsKey(sub)
+	sessionIDs, err := c.client.ZRange(ctx, indexKey, 0, -1).Result()
+	if err != nil {
+		return 0, fmt.Errorf("セッション索引の取得に失敗しました: %w", err)
+	}
+	if len(sessionIDs) == 0 {
+		return 0, nil
+	}
+
+	keys := make([]string, len(sessionIDs))
+	tokenKeys := make([]string, len(sessionIDs))
+	for i, sessionID := range sessionIDs {
+		keys[i] = SessionKey(sessionID)
+		tokenKeys[i] = SessionTokenKey(sessionID)
+	}
+
+	var deleted *redis.IntCmd
+	_, err = c.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		deleted = pipe.Del(ctx, keys...)
+		pipe.Del(ctx, tokenKeys...)
+		pipe.ZRem(ctx, indexKey, toMembers(sessionIDs)...)
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("セッションの一括削除に失敗しました: %w", err)
+	}
+	return int(deleted.Val()), nil
+}
+
+func toMembers(values []string) []interface{} {
+	members := make([]interface{}, len(values))
+	for i, v := range values {
+		members[i] = v
+	}
+	return members
+}
@@ -3,19 +3,37 @@ package redis
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/na2na-p/cargohold/internal/domain"
 	"github.com/na2na-p/cargohold/internal/usecase"
+	"github.com/newmo-oss/ctxtime"
 )
 
-var _ usecase.SessionStoreInterface = (*SessionStoreAdapter)(nil)
+var (
+	_ usecase.SessionStoreInterface     = (*SessionStoreAdapter)(nil)
+	_ usecase.UserSessionStoreInterface = (*SessionStoreAdapter)(nil)
+	_ usecase.SessionTokenStore         = (*SessionStoreAdapter)(nil)
+)
 
 type SessionClient interface {
 	SetSession(ctx context.Context, sessionID string, userInfo *domain.UserInfo, ttl time.Duration) error
+	SetSessionWithToken(ctx context.Context, sessionID string, userInfo *domain.UserInfo, token *SessionTokenRecord, ttl time.Duration) error
+	UpdateSession(ctx context.Context, sessionID string, userInfo *domain.UserInfo, token *SessionTokenRecord) error
+	GetSessionToken(ctx context.Context, sessionID string) (*SessionTokenRecord, error)
 	GetSession(ctx context.Context, sessionID string) (*domain.UserInfo, error)
 	DeleteSession(ctx context.Context, sessionID string) error
+	ListUserSessions(ctx context.Context, sub string) ([]*SessionEntry, error)
+	DeleteUserSessions(ctx context.Context, sub string) (int, error)
+}
+
+// TokenCipher はRedisに保存するOAuthトークンを暗号化・復号する
+type TokenCipher interface {
+	Encrypt(plaintext string) (string, error)
+	Decrypt(ciphertext string) (string, error)
 }
 
 type UUIDGenerator interface {
@@ -31,25 +49,38 @@ func (g *DefaultUUIDGenerator) Generate() string {
 type SessionStoreAdapter struct {
 	client        SessionClient
 	uuidGenerator UUIDGenerator
+	// tokenCipher がnilの場合、OAuthトークンは保存しない
+	tokenCipher TokenCipher
 }
 
-func NewSessionStoreAdapter(client SessionClient, uuidGenerator UUIDGenerator) *SessionStoreAdapter {
+// NewSessionStoreAdapter はセッションを保存するアダプタを作成する
+// tokenCipherを指定するとOAuthトークンを暗号化してセッションと共に保存し、nilの場合は保存しない
+func NewSessionStoreAdapter(client SessionClient, uuidGenerator UUIDGenerator, tokenCipher TokenCipher) *SessionStoreAdapter {
 	return &SessionStoreAdapter{
 		client:        client,
 		uuidGenerator: uuidGenerator,
+		tokenCipher:   tokenCipher,
 	}
 }
 
 func NewSessionStoreAdapterWithDefaults(client SessionClient) *SessionStoreAdapter {
-	return &SessionStoreAdapter{
-		client:        client,
-		uuidGenerator: &DefaultUUIDGenerator{},
-	}
+	return NewSessionStoreAdapter(client, &DefaultUUIDGenerator{}, nil)
 }
 
-func (a *SessionStoreAdapter) CreateSession(ctx context.Context, userInfo *domain.UserInfo, ttl time.Duration) (string, error) {
+func (a *SessionStoreAdapter) CreateSession(ctx context.Context, userInfo *domain.UserInfo, token *usecase.OAuthTokenResult, ttl time.Duration) (string, error) {
 	sessionID := a.uuidGenerator.Generate()
-	if err := a.client.SetSession(ctx, sessionID, userInfo, ttl); err != nil {
+	if a.tokenCipher == nil || token == nil {
+		if err := a.client.SetSession(ctx, sessionID, userInfo, ttl); err != nil {
+			return "", err
+		}
+		return sessionID, nil
+	}
+
+	record, err := a.encryptToken(&usecase.SessionToken{Token: token, CheckedAt: ctxtime.Now(ctx)})
+	if err != nil {
+		return "", err
+	}
+	if err := a.client.SetSessionWithToken(ctx, sessionID, userInfo, record, ttl); err != nil {
 		return "", err
 	}
 	return sessionID, nil
@@ -62,3 +93,75 @@ func (a *SessionStoreAdapter) GetSession(ctx context.Context, sessionID string)
 func (a *SessionStoreAdapter) DeleteSession(ctx context.Context, sessionID string) error {
 	return a.client.DeleteSession(ctx, sessionID)
 }
+
+func (a *SessionStoreAdapter) ListUserSessions(ctx context.Context, sub string) ([]*usecase.UserSession, error) {
+	entries, err := a.client.ListUserSessions(ctx, sub)
+	if err != nil {
+		return nil, err
+	}
+	sessions := make([]*usecase.UserSession, len(entries))
+	for i, entry := range entries {
+		sessions[i] = &usecase.UserSession{
+			ID:        entry.ID,
+			UserInfo:  entry.UserInfo,
+			ExpiresAt: entry.ExpiresAt,
+		}
+	}
+	return sessions, nil
+}
+
+func (a *SessionStoreAdapter) DeleteUserSessions(ctx context.Context, sub string) (int, error) {
+	return a.client.DeleteUserSessions(ctx, sub)
+}
+
+func (a *SessionStoreAdapter) GetSessionToken(ctx context.Context, sessionID string) (*usecase.SessionToken, error) {
+	if a.tokenCipher == nil {
+		return nil, usecase.ErrSessionTokenNotFound
+	}
+
+	record, err := a.client.GetSessionToken(ctx, sessionID)
+	if errors.Is(err, ErrCacheMiss) {
+		return nil, usecase.ErrSessionTokenNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	accessToken, err := a.tokenCipher.Decrypt(record.EncryptedAccessToken)
+	if err != nil {
+		return nil, fmt.Errorf("OAuthトークンの復号に失敗しました: %w", err)
+	}
+	return &usecase.SessionToken{
+		Token: &usecase.OAuthTokenResult{
+			AccessToken: accessToken,
+			TokenType:   record.TokenType,
+			Scope:       record.Scope,
+		},
+		CheckedAt: record.CheckedAt,
+	}, nil
+}
+
+func (a *SessionStoreAdapter) UpdateSession(ctx context.Context, sessionID string, userInfo *domain.UserInfo, token *usecase.SessionToken) error {
+	if a.tokenCipher == nil {
+		return usecase.ErrSessionTokenNotFound
+	}
+
+	record, err := a.encryptToken(token)
+	if err != nil {
+		return err
+	}
+	return a.client.UpdateSession(ctx, sessionID, userInfo, record)
+}
+
+func (a *SessionStoreAdapter) encryptToken(token *usecase.SessionToken) (*SessionTokenRecord, error) {
+	encrypted, err := a.tokenCipher.Encrypt(token.Token.AccessToken)
+	if err != nil {
+		return nil, fmt.Errorf("OAuthトークンの暗号化に失敗しました: %w", err)
+	}
+	return &SessionTokenRecord{
+		EncryptedAccessToken: encrypted,
+		TokenType:            token.Token.TokenType,
+		Scope:                token.Token.Scope,
+		CheckedAt:            token.CheckedAt,
+	}, nil
+}
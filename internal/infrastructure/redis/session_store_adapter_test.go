@@ -9,7 +9,10 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/na2na-p/cargohold/internal/domain"
 	"github.com/na2na-p/cargohold/internal/infrastructure/redis"
+	"github.com/na2na-p/cargohold/internal/usecase"
 	mockredis "github.com/na2na-p/cargohold/tests/infrastructure/redis"
+	"github.com/newmo-oss/ctxtime/ctxtimetest"
+	"github.com/newmo-oss/testid"
 	"go.uber.org/mock/gomock"
 )
 
@@ -83,9 +86,9 @@ func TestSessionStoreAdapter_CreateSession(t *testing.T) {
 			mockClient := tt.fields.setupSessionClient(ctrl)
 			mockUUID := tt.fields.setupUUIDGenerator(ctrl)
 
-			adapter := redis.NewSessionStoreAdapter(mockClient, mockUUID)
+			adapter := redis.NewSessionStoreAdapter(mockClient, mockUUID, nil)
 
-			got, err := adapter.CreateSession(tt.args.ctx, tt.args.userInfo, tt.args.ttl)
+			got, err := adapter.CreateSession(tt.args.ctx, tt.args.userInfo, nil, tt.args.ttl)
 
 			if tt.wantErr != nil {
 				if err == nil {
@@ -161,7 +164,7 @@ func TestSessionStoreAdapter_GetSession(t *testing.T) {
 			mockClient := tt.fields.setupSessionClient(ctrl)
 			mockUUID := mockredis.NewMockUUIDGenerator(ctrl)
 
-			adapter := redis.NewSessionStoreAdapter(mockClient, mockUUID)
+			adapter := redis.NewSessionStoreAdapter(mockClient, mockUUID, nil)
 
 			got, err := adapter.GetSession(tt.args.ctx, tt.args.sessionID)
 
@@ -239,7 +242,7 @@ func TestSessionStoreAdapter_DeleteSession(t *testing.T) {
 			mockClient := tt.fields.setupSessionClient(ctrl)
 			mockUUID := mockredis.NewMockUUIDGenerator(ctrl)
 
-			adapter := redis.NewSessionStoreAdapter(mockClient, mockUUID)
+			adapter := redis.NewSessionStoreAdapter(mockClient, mockUUID, nil)
 
 			err := adapter.DeleteSession(tt.args.ctx, tt.args.sessionID)
 
@@ -256,6 +259,200 @@ func TestSessionStoreAdapter_DeleteSession(t *testing.T) {
 	}
 }
 
+func TestSessionStoreAdapter_ListUserSessions(t *testing.T) {
+	userInfo := mustCreateUserInfo(t, "sub123", "test@example.com", "Test User")
+	expiresAt := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name               string
+		setupSessionClient func(ctrl *gomock.Controller) *mockredis.MockSessionClient
+		want               []*usecase.UserSession
+		wantErr            bool
+	}{
+		{
+			name: "正常系: セッション索引のセッションが返る",
+			setupSessionClient: func(ctrl *gomock.Controller) *mockredis.MockSessionClient {
+				m := mockredis.NewMockSessionClient(ctrl)
+				m.EXPECT().ListUserSessions(gomock.Any(), "sub123").Return([]*redis.SessionEntry{
+					{ID: "session-123", UserInfo: userInfo, ExpiresAt: expiresAt},
+				}, nil)
+				return m
+			},
+			want: []*usecase.UserSession{
+				{ID: "session-123", UserInfo: userInfo, ExpiresAt: expiresAt},
+			},
+		},
+		{
+			name: "異常系: ListUserSessionsがエラーを返す場合、エラーが返る",
+			setupSessionClient: func(ctrl *gomock.Controller) *mockredis.MockSessionClient {
+				m := mockredis.NewMockSessionClient(ctrl)
+				m.EXPECT().ListUserSessions(gomock.Any(), "sub123").Return(nil, errors.New("redis error"))
+				return m
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			adapter := redis.NewSessionStoreAdapter(tt.setupSessionClient(ctrl), mockredis.NewMockUUIDGenerator(ctrl), nil)
+
+			got, err := adapter.ListUserSessions(context.Background(), "sub123")
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(domain.UserInfo{}, domain.ProviderType{})); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSessionStoreAdapter_CreateSessionWithToken(t *testing.T) {
+	fixedNow := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	userInfo := mustCreateUserInfo(t, "sub123", "test@example.com", "Test User")
+	token := &usecase.OAuthTokenResult{AccessToken: "gho_token", TokenType: "bearer", Scope: "repo"}
+
+	tests := []struct {
+		name               string
+		setupSessionClient func(ctrl *gomock.Controller) *mockredis.MockSessionClient
+		setupTokenCipher   func(ctrl *gomock.Controller) *mockredis.MockTokenCipher
+		want               string
+		wantErr            bool
+	}{
+		{
+			name: "正常系: 暗号化したOAuthトークンがセッションと共に保存される",
+			setupSessionClient: func(ctrl *gomock.Controller) *mockredis.MockSessionClient {
+				m := mockredis.NewMockSessionClient(ctrl)
+				m.EXPECT().SetSessionWithToken(gomock.Any(), "test-uuid", userInfo, &redis.SessionTokenRecord{
+					EncryptedAccessToken: "encrypted",
+					TokenType:            "bearer",
+					Scope:                "repo",
+					CheckedAt:            fixedNow,
+				}, 24*time.Hour).Return(nil)
+				return m
+			},
+			setupTokenCipher: func(ctrl *gomock.Controller) *mockredis.MockTokenCipher {
+				m := mockredis.NewMockTokenCipher(ctrl)
+				m.EXPECT().Encrypt("gho_token").Return("encrypted", nil)
+				return m
+			},
+			want: "test-uuid",
+		},
+		{
+			name: "異常系: 暗号化に失敗した場合、セッションは保存されずエラーが返る",
+			setupSessionClient: func(ctrl *gomock.Controller) *mockredis.MockSessionClient {
+				return mockredis.NewMockSessionClient(ctrl)
+			},
+			setupTokenCipher: func(ctrl *gomock.Controller) *mockredis.MockTokenCipher {
+				m := mockredis.NewMockTokenCipher(ctrl)
+				m.EXPECT().Encrypt("gho_token").Return("", errors.New("cipher error"))
+				return m
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := testid.WithValue(context.Background(), t.Name())
+			ctxtimetest.SetFixedNow(t, ctx, fixedNow)
+			ctrl := gomock.NewController(t)
+			uuidGenerator := mockredis.NewMockUUIDGenerator(ctrl)
+			uuidGenerator.EXPECT().Generate().Return("test-uuid")
+			adapter := redis.NewSessionStoreAdapter(tt.setupSessionClient(ctrl), uuidGenerator, tt.setupTokenCipher(ctrl))
+
+			got, err := adapter.CreateSession(ctx, userInfo, token, 24*time.Hour)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSessionStoreAdapter_GetSessionToken(t *testing.T) {
+	checkedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name               string
+		setupSessionClient func(ctrl *gomock.Controller) *mockredis.MockSessionClient
+		setupTokenCipher   func(ctrl *gomock.Controller) redis.TokenCipher
+		want               *usecase.SessionToken
+		wantErr            error
+	}{
+		{
+			name: "正常系: 復号したOAuthトークンが返る",
+			setupSessionClient: func(ctrl *gomock.Controller) *mockredis.MockSessionClient {
+				m := mockredis.NewMockSessionClient(ctrl)
+				m.EXPECT().GetSessionToken(gomock.Any(), "session-123").Return(&redis.SessionTokenRecord{
+					EncryptedAccessToken: "encrypted",
+					TokenType:            "bearer",
+					Scope:                "repo",
+					CheckedAt:            checkedAt,
+				}, nil)
+				return m
+			},
+			setupTokenCipher: func(ctrl *gomock.Controller) redis.TokenCipher {
+				m := mockredis.NewMockTokenCipher(ctrl)
+				m.EXPECT().Decrypt("encrypted").Return("gho_token", nil)
+				return m
+			},
+			want: &usecase.SessionToken{
+				Token:     &usecase.OAuthTokenResult{AccessToken: "gho_token", TokenType: "bearer", Scope: "repo"},
+				CheckedAt: checkedAt,
+			},
+		},
+		{
+			name: "正常系: トークンが保存されていない場合、ErrSessionTokenNotFoundが返る",
+			setupSessionClient: func(ctrl *gomock.Controller) *mockredis.MockSessionClient {
+				m := mockredis.NewMockSessionClient(ctrl)
+				m.EXPECT().GetSessionToken(gomock.Any(), "session-123").Return(nil, redis.ErrCacheMiss)
+				return m
+			},
+			setupTokenCipher: func(ctrl *gomock.Controller) redis.TokenCipher {
+				return mockredis.NewMockTokenCipher(ctrl)
+			},
+			wantErr: usecase.ErrSessionTokenNotFound,
+		},
+		{
+			name: "正常系: 暗号鍵が設定されていない場合、ErrSessionTokenNotFoundが返る",
+			setupSessionClient: func(ctrl *gomock.Controller) *mockredis.MockSessionClient {
+				return mockredis.NewMockSessionClient(ctrl)
+			},
+			setupTokenCipher: func(ctrl *gomock.Controller) redis.TokenCipher {
+				return nil
+			},
+			wantErr: usecase.ErrSessionTokenNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			adapter := redis.NewSessionStoreAdapter(tt.setupSessionClient(ctrl), mockredis.NewMockUUIDGenerator(ctrl), tt.setupTokenCipher(ctrl))
+
+			got, err := adapter.GetSessionToken(context.Background(), "session-123")
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Fatalf("want no error, but got %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func mustCreateUserInfo(t *testing.T, sub, email, name string) *domain.UserInfo {
 	t.Helper()
 	userInfo, err := domain.NewUserInfo(sub, email, name, domain.ProviderTypeGitHub, nil, "")
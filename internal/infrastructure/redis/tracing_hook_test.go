@@ -0,0 +1,70 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/na2na-p/cargohold/internal/infrastructure/tracing"
+	goredis "github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracingHook(t *testing.T) {
+	tests := []struct {
+		name       string
+		nextErr    error
+		wantName   string
+		wantStatus codes.Code
+	}{
+		{
+			name:       "正常系: コマンドごとにスパンが記録される",
+			wantName:   "redis get",
+			wantStatus: codes.Unset,
+		},
+		{
+			name:       "正常系: キャッシュミスはエラーとして記録されない",
+			nextErr:    goredis.Nil,
+			wantName:   "redis get",
+			wantStatus: codes.Unset,
+		},
+		{
+			name:       "異常系: コマンドが失敗した場合、スパンにエラーが記録される",
+			nextErr:    errors.New("connection refused"),
+			wantName:   "redis get",
+			wantStatus: codes.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exporter := tracetest.NewInMemoryExporter()
+			prev := otel.GetTracerProvider()
+			otel.SetTracerProvider(tracing.NewTracerProvider(sdktrace.NewSimpleSpanProcessor(exporter), "cargohold", 1.0))
+			t.Cleanup(func() { otel.SetTracerProvider(prev) })
+
+			next := func(ctx context.Context, cmd goredis.Cmder) error {
+				return tt.nextErr
+			}
+			cmd := goredis.NewStringCmd(context.Background(), "get", "key")
+			err := tracingHook{}.ProcessHook(next)(context.Background(), cmd)
+			if !errors.Is(err, tt.nextErr) {
+				t.Errorf("ProcessHook() error = %v, want %v", err, tt.nextErr)
+			}
+
+			spans := exporter.GetSpans()
+			if len(spans) != 1 {
+				t.Fatalf("expected 1 span, got %d", len(spans))
+			}
+			if spans[0].Name != tt.wantName {
+				t.Errorf("span name = %q, want %q", spans[0].Name, tt.wantName)
+			}
+			if spans[0].Status.Code != tt.wantStatus {
+				t.Errorf("span status = %v, want %v", spans[0].Status.Code, tt.wantStatus)
+			}
+		})
+	}
+}
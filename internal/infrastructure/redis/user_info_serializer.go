@@ -36,6 +36,17 @@ type userInfoDTO struct {
 	PermPull     bool                `json:"perm_pull,omitempty"`
 	PermMaintain bool                `json:"perm_maintain,omitempty"`
 	PermTriage   bool                `json:"perm_triage,omitempty"`
+	// Scope は複数のリポジトリを対象とするセッションのスコープ（カンマ区切り）
+	Scope             string                    `json:"scope,omitempty"`
+	CachedPermissions map[string]permissionsDTO `json:"cached_permissions,omitempty"`
+}
+
+type permissionsDTO struct {
+	Admin    bool `json:"admin,omitempty"`
+	Push     bool `json:"push,omitempty"`
+	Pull     bool `json:"pull,omitempty"`
+	Maintain bool `json:"maintain,omitempty"`
+	Triage   bool `json:"triage,omitempty"`
 }
 
 type gitHubUserInfoDTO struct {
@@ -78,6 +89,22 @@ func (s *userInfoSerializerImpl) Serialize(userInfo *domain.UserInfo) ([]byte, e
 		dto.PermTriage = perms.Triage()
 	}
 
+	if scope := userInfo.RepositoryScope(); scope != nil {
+		dto.Scope = scope.String()
+	}
+	if cached := userInfo.AllCachedPermissions(); len(cached) > 0 {
+		dto.CachedPermissions = make(map[string]permissionsDTO, len(cached))
+		for repository, perms := range cached {
+			dto.CachedPermissions[repository] = permissionsDTO{
+				Admin:    perms.Admin(),
+				Push:     perms.Push(),
+				Pull:     perms.Pull(),
+				Maintain: perms.Maintain(),
+				Triage:   perms.Triage(),
+			}
+		}
+	}
+
 	data, err := json.Marshal(dto)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal UserInfo: %w", err)
@@ -123,6 +150,21 @@ func (s *userInfoSerializerImpl) Deserialize(data []byte) (*domain.UserInfo, err
 		userInfo.SetPermissions(&perms)
 	}
 
+	if dto.Scope != "" {
+		scope, err := domain.ParseRepositoryScope(dto.Scope)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse repository scope: %w", err)
+		}
+		userInfo.SetRepositoryScope(scope)
+	}
+	for repository, perms := range dto.CachedPermissions {
+		repo, err := domain.NewRepositoryIdentifier(repository)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse cached permissions repository: %w", err)
+		}
+		userInfo.CachePermissions(repo, domain.NewRepositoryPermissions(perms.Admin, perms.Push, perms.Pull, perms.Maintain, perms.Triage))
+	}
+
 	return userInfo, nil
 }
 
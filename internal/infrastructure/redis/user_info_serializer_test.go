@@ -1,6 +1,7 @@
 package redis_test
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -242,6 +243,39 @@ func TestUserInfoSerializerImpl_RoundTrip(t *testing.T) {
 	}
 }
 
+func TestUserInfoSerializerImpl_RoundTrip_RepositoryScope(t *testing.T) {
+	scope, err := domain.ParseRepositoryScope("owner/repo,org/*")
+	if err != nil {
+		t.Fatalf("ParseRepositoryScope failed: %v", err)
+	}
+	allowedRepo, _ := domain.NewRepositoryIdentifier("org/allowed")
+	deniedRepo, _ := domain.NewRepositoryIdentifier("org/denied")
+	pushPermissions := domain.NewRepositoryPermissions(false, true, true, false, false)
+
+	userInfo := mustNewUserInfo(t, "12345", "", "user", domain.ProviderTypeGitHub, nil, "")
+	userInfo.SetRepositoryScope(scope)
+	userInfo.CachePermissions(allowedRepo, pushPermissions)
+	userInfo.CachePermissions(deniedRepo, domain.RepositoryPermissions{})
+
+	serializer := redis.NewUserInfoSerializer()
+	data, err := serializer.Serialize(userInfo)
+	if err != nil {
+		t.Fatalf("Serialize failed: %v", err)
+	}
+	got, err := serializer.Deserialize(data)
+	if err != nil {
+		t.Fatalf("Deserialize failed: %v", err)
+	}
+
+	// RepositoryScopeは非公開の型を含むため、すべての非公開フィールドを比較する
+	if diff := cmp.Diff(userInfo, got, cmp.Exporter(func(reflect.Type) bool { return true })); diff != "" {
+		t.Errorf("round trip mismatch (-want +got):\n%s", diff)
+	}
+	if _, ok := got.CachedPermissions(deniedRepo); !ok {
+		t.Error("CachedPermissions() for a denied repository ok = false, want true")
+	}
+}
+
 func TestGitHubUserInfoSerializerImpl_Serialize(t *testing.T) {
 	type args struct {
 		userInfo *domain.GitHubUserInfo
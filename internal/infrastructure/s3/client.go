@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
@@ -13,10 +14,20 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/aws/smithy-go"
+	"github.com/na2na-p/cargohold/internal/infrastructure/tracing"
 	"github.com/na2na-p/cargohold/internal/usecase"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
-var _ usecase.ObjectStorage = (*S3Client)(nil)
+const tracerName = "github.com/na2na-p/cargohold/internal/infrastructure/s3"
+
+var (
+	_ usecase.ObjectStorage = (*S3Client)(nil)
+	_ usecase.ObjectLister  = (*S3Client)(nil)
+)
 
 type S3Config struct {
 	Endpoint        string
@@ -32,13 +43,26 @@ type S3API interface {
 	GetObject(context.Context, *s3.GetObjectInput, ...func(*s3.Options)) (*s3.GetObjectOutput, error)
 	HeadObject(context.Context, *s3.HeadObjectInput, ...func(*s3.Options)) (*s3.HeadObjectOutput, error)
 	HeadBucket(context.Context, *s3.HeadBucketInput, ...func(*s3.Options)) (*s3.HeadBucketOutput, error)
+	CopyObject(context.Context, *s3.CopyObjectInput, ...func(*s3.Options)) (*s3.CopyObjectOutput, error)
+	DeleteObject(context.Context, *s3.DeleteObjectInput, ...func(*s3.Options)) (*s3.DeleteObjectOutput, error)
+	ListObjectsV2(context.Context, *s3.ListObjectsV2Input, ...func(*s3.Options)) (*s3.ListObjectsV2Output, error)
 }
 
+// StorageMetricsRecorder はストレージ操作の処理時間とエラーを記録する
+type StorageMetricsRecorder interface {
+	ObserveStorageOperation(operation string, elapsed time.Duration, err error)
+}
+
+type nopStorageMetricsRecorder struct{}
+
+func (nopStorageMetricsRecorder) ObserveStorageOperation(string, time.Duration, error) {}
+
 type S3Client struct {
 	client               S3API
 	presignClient        *s3.Client
 	presignClientFactory PresignClientFactory
 	bucket               string
+	metrics              StorageMetricsRecorder
 }
 
 func NewS3Connection(cfg S3Config) (*s3.Client, error) {
@@ -57,8 +81,14 @@ func NewS3Connection(cfg S3Config) (*s3.Client, error) {
 	return client, nil
 }
 
-func NewS3Client(client *s3.Client, bucket string) *S3Client {
-	return NewS3ClientWithPresignFactory(client, client, bucket, nil)
+// NewS3Client はストレージ操作の処理時間とエラーをmetricsに記録するS3Clientを生成する
+// metricsがnilの場合は記録しない
+func NewS3Client(client *s3.Client, bucket string, metrics StorageMetricsRecorder) *S3Client {
+	c := NewS3ClientWithPresignFactory(client, client, bucket, nil)
+	if metrics != nil {
+		c.metrics = metrics
+	}
+	return c
 }
 
 func NewS3ClientWithPresignFactory(client S3API, presignClient *s3.Client, bucket string, factory PresignClientFactory) *S3Client {
@@ -70,6 +100,7 @@ func NewS3ClientWithPresignFactory(client S3API, presignClient *s3.Client, bucke
 		presignClient:        presignClient,
 		presignClientFactory: factory,
 		bucket:               bucket,
+		metrics:              nopStorageMetricsRecorder{},
 	}
 }
 
@@ -81,14 +112,16 @@ func (c *S3Client) PutObject(ctx context.Context, key string, body io.Reader, co
 		ContentLength: aws.Int64(contentLength),
 	}
 
+	opCtx, finish := c.startOperation(ctx, OperationPut, key)
 	var err error
 	if realClient, ok := c.client.(*s3.Client); ok {
-		_, err = realClient.PutObject(ctx, input,
+		_, err = realClient.PutObject(opCtx, input,
 			s3.WithAPIOptions(v4.SwapComputePayloadSHA256ForUnsignedPayloadMiddleware),
 		)
 	} else {
-		_, err = c.client.PutObject(ctx, input)
+		_, err = c.client.PutObject(opCtx, input)
 	}
+	finish(err)
 	if err != nil {
 		return NewStorageError(OperationPut, err)
 	}
@@ -97,10 +130,12 @@ func (c *S3Client) PutObject(ctx context.Context, key string, body io.Reader, co
 }
 
 func (c *S3Client) GetObject(ctx context.Context, key string) (io.ReadCloser, error) {
-	result, err := c.client.GetObject(ctx, &s3.GetObjectInput{
+	opCtx, finish := c.startOperation(ctx, OperationGet, key)
+	result, err := c.client.GetObject(opCtx, &s3.GetObjectInput{
 		Bucket: aws.String(c.bucket),
 		Key:    aws.String(key),
 	})
+	finish(err)
 	if err != nil {
 		return nil, NewStorageError(OperationGet, err)
 	}
@@ -109,26 +144,97 @@ func (c *S3Client) GetObject(ctx context.Context, key string) (io.ReadCloser, er
 }
 
 func (c *S3Client) HeadObject(ctx context.Context, key string) (bool, error) {
-	_, err := c.client.HeadObject(ctx, &s3.HeadObjectInput{
+	_, exists, err := c.HeadObjectSize(ctx, key)
+	return exists, err
+}
+
+func (c *S3Client) HeadObjectSize(ctx context.Context, key string) (int64, bool, error) {
+	opCtx, finish := c.startOperation(ctx, OperationHead, key)
+	result, err := c.client.HeadObject(opCtx, &s3.HeadObjectInput{
 		Bucket: aws.String(c.bucket),
 		Key:    aws.String(key),
 	})
 	if err != nil {
 		var nf *types.NotFound
 		if errors.As(err, &nf) {
-			return false, nil
+			finish(nil)
+			return 0, false, nil
 		}
 
 		var apiErr smithy.APIError
 		if errors.As(err, &apiErr) {
 			if apiErr.ErrorCode() == "NotFound" {
-				return false, nil
+				finish(nil)
+				return 0, false, nil
 			}
 		}
-		return false, NewStorageError(OperationHead, err)
+		finish(err)
+		return 0, false, NewStorageError(OperationHead, err)
+	}
+	finish(nil)
+
+	return aws.ToInt64(result.ContentLength), true, nil
+}
+
+func (c *S3Client) CopyObject(ctx context.Context, srcKey, dstKey string) error {
+	opCtx, finish := c.startOperation(ctx, OperationCopy, dstKey)
+	_, err := c.client.CopyObject(opCtx, &s3.CopyObjectInput{
+		Bucket:     aws.String(c.bucket),
+		Key:        aws.String(dstKey),
+		CopySource: aws.String(c.bucket + "/" + srcKey),
+	})
+	finish(err)
+	if err != nil {
+		return NewStorageError(OperationCopy, err)
+	}
+
+	return nil
+}
+
+func (c *S3Client) DeleteObject(ctx context.Context, key string) error {
+	opCtx, finish := c.startOperation(ctx, OperationDelete, key)
+	_, err := c.client.DeleteObject(opCtx, &s3.DeleteObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(key),
+	})
+	finish(err)
+	if err != nil {
+		return NewStorageError(OperationDelete, err)
+	}
+
+	return nil
+}
+
+// ListObjects はプレフィックス配下のオブジェクトをページングしながら列挙し、1件ごとにfnを呼び出す
+func (c *S3Client) ListObjects(ctx context.Context, prefix string, fn func(usecase.StoredObject) error) error {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(c.bucket),
+		Prefix: aws.String(prefix),
 	}
 
-	return true, nil
+	for {
+		opCtx, finish := c.startOperation(ctx, OperationList, prefix)
+		result, err := c.client.ListObjectsV2(opCtx, input)
+		finish(err)
+		if err != nil {
+			return NewStorageError(OperationList, err)
+		}
+
+		for _, obj := range result.Contents {
+			if err := fn(usecase.StoredObject{
+				Key:          aws.ToString(obj.Key),
+				Size:         aws.ToInt64(obj.Size),
+				LastModified: aws.ToTime(obj.LastModified),
+			}); err != nil {
+				return err
+			}
+		}
+
+		if !aws.ToBool(result.IsTruncated) {
+			return nil
+		}
+		input.ContinuationToken = result.NextContinuationToken
+	}
 }
 
 func (c *S3Client) HeadBucket(ctx context.Context) error {
@@ -140,3 +246,22 @@ func (c *S3Client) HeadBucket(ctx context.Context) error {
 	}
 	return nil
 }
+
+// startOperation はストレージ操作のスパンを開始し、操作の完了時に呼び出す関数を返す
+// 完了時の関数はスパンを終了し、処理時間とエラーをメトリクスに記録する
+func (c *S3Client) startOperation(ctx context.Context, operation StorageOperation, key string) (context.Context, func(error)) {
+	ctx, span := otel.Tracer(tracerName).Start(ctx, "s3 "+string(operation),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.RPCSystemKey.String("aws-api"),
+			semconv.RPCMethod(string(operation)),
+			attribute.String("aws.s3.bucket", c.bucket),
+			attribute.String("aws.s3.key", key),
+		),
+	)
+	start := time.Now()
+	return ctx, func(err error) {
+		c.metrics.ObserveStorageOperation(string(operation), time.Since(start), err)
+		tracing.End(span, err)
+	}
+}
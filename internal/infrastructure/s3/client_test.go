@@ -6,12 +6,15 @@ import (
 	"io"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/google/go-cmp/cmp"
 	"go.uber.org/mock/gomock"
 
+	"github.com/na2na-p/cargohold/internal/usecase"
 	mocks3 "github.com/na2na-p/cargohold/tests/infrastructure/s3"
 )
 
@@ -309,6 +312,328 @@ func TestS3Client_HeadObject(t *testing.T) {
 	}
 }
 
+func TestS3Client_HeadObjectSize(t *testing.T) {
+	type args struct {
+		key string
+	}
+	tests := []struct {
+		name       string
+		setupMock  func(ctrl *gomock.Controller) *mocks3.MockS3API
+		args       args
+		wantSize   int64
+		wantExists bool
+		wantErr    bool
+	}{
+		{
+			name: "正常系: オブジェクトが存在する場合、サイズが返る",
+			setupMock: func(ctrl *gomock.Controller) *mocks3.MockS3API {
+				mock := mocks3.NewMockS3API(ctrl)
+				mock.EXPECT().
+					HeadObject(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&s3.HeadObjectOutput{ContentLength: aws.Int64(100)}, nil)
+				return mock
+			},
+			args: args{
+				key: "test/existing.txt",
+			},
+			wantSize:   100,
+			wantExists: true,
+			wantErr:    false,
+		},
+		{
+			name: "正常系: オブジェクトが存在しない",
+			setupMock: func(ctrl *gomock.Controller) *mocks3.MockS3API {
+				mock := mocks3.NewMockS3API(ctrl)
+				mock.EXPECT().
+					HeadObject(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, NewMockNotFoundError())
+				return mock
+			},
+			args: args{
+				key: "test/non-existing.txt",
+			},
+			wantSize:   0,
+			wantExists: false,
+			wantErr:    false,
+		},
+		{
+			name: "異常系: NotFound以外のS3エラー",
+			setupMock: func(ctrl *gomock.Controller) *mocks3.MockS3API {
+				mock := mocks3.NewMockS3API(ctrl)
+				mock.EXPECT().
+					HeadObject(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("mock internal server error"))
+				return mock
+			},
+			args: args{
+				key: "test/error.txt",
+			},
+			wantSize:   0,
+			wantExists: false,
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ctx := context.Background()
+			mockAPI := tt.setupMock(ctrl)
+			client := NewMockS3Client(mockAPI, "test-bucket")
+
+			size, exists, err := client.HeadObjectSize(ctx, tt.args.key)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("HeadObjectSize() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.wantSize, size); diff != "" {
+				t.Errorf("HeadObjectSize() size mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantExists, exists); diff != "" {
+				t.Errorf("HeadObjectSize() exists mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestS3Client_CopyObject(t *testing.T) {
+	type args struct {
+		srcKey string
+		dstKey string
+	}
+	tests := []struct {
+		name      string
+		setupMock func(ctrl *gomock.Controller) *mocks3.MockS3API
+		args      args
+		wantErr   bool
+	}{
+		{
+			name: "正常系: コピー元とコピー先を指定してコピーできる",
+			setupMock: func(ctrl *gomock.Controller) *mocks3.MockS3API {
+				mock := mocks3.NewMockS3API(ctrl)
+				mock.EXPECT().
+					CopyObject(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input *s3.CopyObjectInput, _ ...func(*s3.Options)) (*s3.CopyObjectOutput, error) {
+						if diff := cmp.Diff("test-bucket", aws.ToString(input.Bucket)); diff != "" {
+							t.Errorf("Bucket mismatch (-want +got):\n%s", diff)
+						}
+						if diff := cmp.Diff("objects/dst.txt", aws.ToString(input.Key)); diff != "" {
+							t.Errorf("Key mismatch (-want +got):\n%s", diff)
+						}
+						if diff := cmp.Diff("test-bucket/staging/src.txt", aws.ToString(input.CopySource)); diff != "" {
+							t.Errorf("CopySource mismatch (-want +got):\n%s", diff)
+						}
+						return &s3.CopyObjectOutput{}, nil
+					})
+				return mock
+			},
+			args: args{
+				srcKey: "staging/src.txt",
+				dstKey: "objects/dst.txt",
+			},
+			wantErr: false,
+		},
+		{
+			name: "異常系: S3エラーの場合、StorageErrorが返る",
+			setupMock: func(ctrl *gomock.Controller) *mocks3.MockS3API {
+				mock := mocks3.NewMockS3API(ctrl)
+				mock.EXPECT().
+					CopyObject(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("mock copy error"))
+				return mock
+			},
+			args: args{
+				srcKey: "staging/src.txt",
+				dstKey: "objects/dst.txt",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ctx := context.Background()
+			mockAPI := tt.setupMock(ctrl)
+			client := NewMockS3Client(mockAPI, "test-bucket")
+
+			err := client.CopyObject(ctx, tt.args.srcKey, tt.args.dstKey)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CopyObject() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil && !errors.Is(err, NewStorageError(OperationCopy, nil)) {
+				t.Errorf("CopyObject() error = %v, want StorageError with operation %s", err, OperationCopy)
+			}
+		})
+	}
+}
+
+func TestS3Client_DeleteObject(t *testing.T) {
+	type args struct {
+		key string
+	}
+	tests := []struct {
+		name      string
+		setupMock func(ctrl *gomock.Controller) *mocks3.MockS3API
+		args      args
+		wantErr   bool
+	}{
+		{
+			name: "正常系: オブジェクトを削除できる",
+			setupMock: func(ctrl *gomock.Controller) *mocks3.MockS3API {
+				mock := mocks3.NewMockS3API(ctrl)
+				mock.EXPECT().
+					DeleteObject(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input *s3.DeleteObjectInput, _ ...func(*s3.Options)) (*s3.DeleteObjectOutput, error) {
+						if diff := cmp.Diff("staging/src.txt", aws.ToString(input.Key)); diff != "" {
+							t.Errorf("Key mismatch (-want +got):\n%s", diff)
+						}
+						return &s3.DeleteObjectOutput{}, nil
+					})
+				return mock
+			},
+			args: args{
+				key: "staging/src.txt",
+			},
+			wantErr: false,
+		},
+		{
+			name: "異常系: S3エラーの場合、StorageErrorが返る",
+			setupMock: func(ctrl *gomock.Controller) *mocks3.MockS3API {
+				mock := mocks3.NewMockS3API(ctrl)
+				mock.EXPECT().
+					DeleteObject(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("mock delete error"))
+				return mock
+			},
+			args: args{
+				key: "staging/src.txt",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ctx := context.Background()
+			mockAPI := tt.setupMock(ctrl)
+			client := NewMockS3Client(mockAPI, "test-bucket")
+
+			err := client.DeleteObject(ctx, tt.args.key)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DeleteObject() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil && !errors.Is(err, NewStorageError(OperationDelete, nil)) {
+				t.Errorf("DeleteObject() error = %v, want StorageError with operation %s", err, OperationDelete)
+			}
+		})
+	}
+}
+
+func TestS3Client_ListObjects(t *testing.T) {
+	type args struct {
+		prefix string
+	}
+	tests := []struct {
+		name      string
+		setupMock func(ctrl *gomock.Controller) *mocks3.MockS3API
+		args      args
+		want      []usecase.StoredObject
+		wantErr   bool
+	}{
+		{
+			name: "正常系: 複数ページにわたるオブジェクトを列挙できる",
+			setupMock: func(ctrl *gomock.Controller) *mocks3.MockS3API {
+				mock := mocks3.NewMockS3API(ctrl)
+				gomock.InOrder(
+					mock.EXPECT().
+						ListObjectsV2(gomock.Any(), gomock.Any(), gomock.Any()).
+						DoAndReturn(func(_ context.Context, input *s3.ListObjectsV2Input, _ ...func(*s3.Options)) (*s3.ListObjectsV2Output, error) {
+							if diff := cmp.Diff("objects/sha256/", aws.ToString(input.Prefix)); diff != "" {
+								t.Errorf("Prefix mismatch (-want +got):\n%s", diff)
+							}
+							if input.ContinuationToken != nil {
+								t.Errorf("ContinuationToken = %v, want nil", aws.ToString(input.ContinuationToken))
+							}
+							return &s3.ListObjectsV2Output{
+								Contents: []types.Object{
+									{Key: aws.String("objects/sha256/aa/bb/aabb"), Size: aws.Int64(10), LastModified: aws.Time(time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC))},
+								},
+								IsTruncated:           aws.Bool(true),
+								NextContinuationToken: aws.String("next-token"),
+							}, nil
+						}),
+					mock.EXPECT().
+						ListObjectsV2(gomock.Any(), gomock.Any(), gomock.Any()).
+						DoAndReturn(func(_ context.Context, input *s3.ListObjectsV2Input, _ ...func(*s3.Options)) (*s3.ListObjectsV2Output, error) {
+							if diff := cmp.Diff("next-token", aws.ToString(input.ContinuationToken)); diff != "" {
+								t.Errorf("ContinuationToken mismatch (-want +got):\n%s", diff)
+							}
+							return &s3.ListObjectsV2Output{
+								Contents: []types.Object{
+									{Key: aws.String("objects/sha256/cc/dd/ccdd"), Size: aws.Int64(20)},
+								},
+								IsTruncated: aws.Bool(false),
+							}, nil
+						}),
+				)
+				return mock
+			},
+			args: args{
+				prefix: "objects/sha256/",
+			},
+			want: []usecase.StoredObject{
+				{Key: "objects/sha256/aa/bb/aabb", Size: 10, LastModified: time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)},
+				{Key: "objects/sha256/cc/dd/ccdd", Size: 20},
+			},
+			wantErr: false,
+		},
+		{
+			name: "異常系: S3エラーの場合、StorageErrorが返る",
+			setupMock: func(ctrl *gomock.Controller) *mocks3.MockS3API {
+				mock := mocks3.NewMockS3API(ctrl)
+				mock.EXPECT().
+					ListObjectsV2(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("mock list error"))
+				return mock
+			},
+			args: args{
+				prefix: "objects/sha256/",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ctx := context.Background()
+			mockAPI := tt.setupMock(ctrl)
+			client := NewMockS3Client(mockAPI, "test-bucket")
+
+			var got []usecase.StoredObject
+			err := client.ListObjects(ctx, tt.args.prefix, func(obj usecase.StoredObject) error {
+				got = append(got, obj)
+				return nil
+			})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ListObjects() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil && !errors.Is(err, NewStorageError(OperationList, nil)) {
+				t.Errorf("ListObjects() error = %v, want StorageError with operation %s", err, OperationList)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ListObjects() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestS3Client_Integration(t *testing.T) {
 	type args struct {
 		key     string
@@ -534,7 +859,7 @@ func TestNewS3Client(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			client := NewS3Client(nil, tt.args.bucket)
+			client := NewS3Client(nil, tt.args.bucket, nil)
 
 			if client == nil {
 				t.Fatal("NewS3Client() returned nil")
@@ -701,3 +1026,73 @@ func TestS3Client_ErrorTypes(t *testing.T) {
 		}
 	})
 }
+
+func TestS3Client_Metrics(t *testing.T) {
+	tests := []struct {
+		name          string
+		setupMock     func(ctrl *gomock.Controller) *mocks3.MockS3API
+		call          func(client *S3Client) error
+		wantOperation string
+		wantErr       bool
+	}{
+		{
+			name: "正常系: 成功した操作はエラーなしで記録される",
+			setupMock: func(ctrl *gomock.Controller) *mocks3.MockS3API {
+				mock := mocks3.NewMockS3API(ctrl)
+				mock.EXPECT().DeleteObject(gomock.Any(), gomock.Any(), gomock.Any()).Return(&s3.DeleteObjectOutput{}, nil)
+				return mock
+			},
+			call: func(client *S3Client) error {
+				return client.DeleteObject(context.Background(), "objects/test.txt")
+			},
+			wantOperation: "delete",
+			wantErr:       false,
+		},
+		{
+			name: "正常系: HeadObjectでオブジェクトが存在しない場合はエラーとして記録されない",
+			setupMock: func(ctrl *gomock.Controller) *mocks3.MockS3API {
+				mock := mocks3.NewMockS3API(ctrl)
+				mock.EXPECT().HeadObject(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, NewMockNotFoundError())
+				return mock
+			},
+			call: func(client *S3Client) error {
+				_, err := client.HeadObject(context.Background(), "objects/missing.txt")
+				return err
+			},
+			wantOperation: "head",
+			wantErr:       false,
+		},
+		{
+			name: "異常系: 失敗した操作はエラーとともに記録される",
+			setupMock: func(ctrl *gomock.Controller) *mocks3.MockS3API {
+				mock := mocks3.NewMockS3API(ctrl)
+				mock.EXPECT().GetObject(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("mock internal server error"))
+				return mock
+			},
+			call: func(client *S3Client) error {
+				_, err := client.GetObject(context.Background(), "objects/test.txt")
+				return err
+			},
+			wantOperation: "get",
+			wantErr:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			metrics := mocks3.NewMockStorageMetricsRecorder(ctrl)
+			metrics.EXPECT().ObserveStorageOperation(tt.wantOperation, gomock.Any(), gomock.Any()).
+				Do(func(_ string, _ time.Duration, err error) {
+					if (err != nil) != tt.wantErr {
+						t.Errorf("ObserveStorageOperation() err = %v, wantErr %v", err, tt.wantErr)
+					}
+				})
+
+			client := NewMockS3Client(tt.setupMock(ctrl), "test-bucket")
+			client.metrics = metrics
+
+			_ = tt.call(client)
+		})
+	}
+}
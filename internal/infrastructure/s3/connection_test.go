@@ -128,7 +128,7 @@ func TestNewS3Connection_ClientConfiguration(t *testing.T) {
 			}
 
 			// S3Clientラッパーを作成してテスト
-			s3Client := NewS3Client(client, tt.args.cfg.Bucket)
+			s3Client := NewS3Client(client, tt.args.cfg.Bucket, nil)
 			if s3Client == nil {
 				t.Fatal("NewS3Client() returned nil")
 			}
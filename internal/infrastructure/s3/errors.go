@@ -8,9 +8,12 @@ import (
 type StorageOperation string
 
 const (
-	OperationPut  StorageOperation = "put"
-	OperationGet  StorageOperation = "get"
-	OperationHead StorageOperation = "head"
+	OperationPut    StorageOperation = "put"
+	OperationGet    StorageOperation = "get"
+	OperationHead   StorageOperation = "head"
+	OperationCopy   StorageOperation = "copy"
+	OperationDelete StorageOperation = "delete"
+	OperationList   StorageOperation = "list"
 )
 
 type StorageError struct {
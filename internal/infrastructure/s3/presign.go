@@ -4,6 +4,7 @@ package s3
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -26,23 +27,42 @@ const (
 	DefaultPresignTTL = 15 * time.Minute
 )
 
-func (c *S3Client) GeneratePutURL(ctx context.Context, key string, ttl time.Duration) (string, error) {
+// GeneratePutURL はcontentLengthとchecksumSHA256（SHA-256ハッシュのbase64）に一致する内容のみを
+// 保存できるPut用の署名付きURLと、アップロード時にクライアントが送信すべきヘッダーを生成する
+// 署名に含めることで、URLの有効期限内に別の内容で上書きされることを防ぐ
+func (c *S3Client) GeneratePutURL(ctx context.Context, key string, contentLength int64, checksumSHA256 string, ttl time.Duration) (string, map[string]string, error) {
 	if ttl == 0 {
 		ttl = DefaultPresignTTL
 	}
 
 	presignClient := c.presignClientFactory(c.presignClient)
 	presignResult, err := presignClient.PresignPutObject(ctx, &s3.PutObjectInput{
-		Bucket: aws.String(c.bucket),
-		Key:    aws.String(key),
+		Bucket:         aws.String(c.bucket),
+		Key:            aws.String(key),
+		ContentLength:  aws.Int64(contentLength),
+		ChecksumSHA256: aws.String(checksumSHA256),
 	}, func(opts *s3.PresignOptions) {
 		opts.Expires = ttl
 	})
 	if err != nil {
-		return "", fmt.Errorf("failed to presign put object: %w", err)
+		return "", nil, fmt.Errorf("failed to presign put object: %w", err)
 	}
 
-	return presignResult.URL, nil
+	return presignResult.URL, uploadHeader(presignResult.SignedHeader), nil
+}
+
+// uploadHeader は署名に含まれたヘッダーのうち、クライアントが明示的に送信する必要があるものを返す
+// HostとContent-LengthはHTTPクライアントがURLと本文から設定するため含めない
+func uploadHeader(signed http.Header) map[string]string {
+	header := map[string]string{}
+	for name, values := range signed {
+		canonical := http.CanonicalHeaderKey(name)
+		if canonical == "Host" || canonical == "Content-Length" || len(values) == 0 {
+			continue
+		}
+		header[canonical] = values[0]
+	}
+	return header
 }
 
 func (c *S3Client) GenerateGetURL(ctx context.Context, key string, ttl time.Duration) (string, error) {
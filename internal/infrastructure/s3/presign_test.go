@@ -3,9 +3,11 @@ package s3_test
 import (
 	"context"
 	"errors"
+	"net/http"
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/google/go-cmp/cmp"
@@ -56,20 +58,35 @@ func (m *mockS3API) HeadBucket(ctx context.Context, params *s3.HeadBucketInput,
 	return &s3.HeadBucketOutput{}, nil
 }
 
+func (m *mockS3API) CopyObject(ctx context.Context, params *s3.CopyObjectInput, optFns ...func(*s3.Options)) (*s3.CopyObjectOutput, error) {
+	return &s3.CopyObjectOutput{}, nil
+}
+
+func (m *mockS3API) DeleteObject(ctx context.Context, params *s3.DeleteObjectInput, optFns ...func(*s3.Options)) (*s3.DeleteObjectOutput, error) {
+	return &s3.DeleteObjectOutput{}, nil
+}
+
+func (m *mockS3API) ListObjectsV2(ctx context.Context, params *s3.ListObjectsV2Input, optFns ...func(*s3.Options)) (*s3.ListObjectsV2Output, error) {
+	return &s3.ListObjectsV2Output{}, nil
+}
+
 func TestS3Client_GeneratePutURL(t *testing.T) {
 	type fields struct {
 		mockPresign func() *mockPresignClient
 	}
 	type args struct {
-		key string
-		ttl time.Duration
+		key            string
+		contentLength  int64
+		checksumSHA256 string
+		ttl            time.Duration
 	}
 	tests := []struct {
-		name    string
-		fields  fields
-		args    args
-		want    string
-		wantErr error
+		name       string
+		fields     fields
+		args       args
+		want       string
+		wantHeader map[string]string
+		wantErr    error
 	}{
 		{
 			name: "正常系: デフォルトTTLでPut用URLが生成される",
@@ -83,6 +100,12 @@ func TestS3Client_GeneratePutURL(t *testing.T) {
 							if *params.Key != "test/object.txt" {
 								t.Errorf("unexpected key: got %v, want test/object.txt", *params.Key)
 							}
+							if aws.ToInt64(params.ContentLength) != 1024 {
+								t.Errorf("unexpected content length: got %v, want 1024", aws.ToInt64(params.ContentLength))
+							}
+							if aws.ToString(params.ChecksumSHA256) != "47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU=" {
+								t.Errorf("unexpected checksum: got %v", aws.ToString(params.ChecksumSHA256))
+							}
 							opts := &s3.PresignOptions{}
 							for _, fn := range optFns {
 								fn(opts)
@@ -96,11 +119,14 @@ func TestS3Client_GeneratePutURL(t *testing.T) {
 				},
 			},
 			args: args{
-				key: "test/object.txt",
-				ttl: 0,
+				key:            "test/object.txt",
+				contentLength:  1024,
+				checksumSHA256: "47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU=",
+				ttl:            0,
 			},
-			want:    "https://s3.example.com/test-bucket/test/object.txt?presigned",
-			wantErr: nil,
+			want:       "https://s3.example.com/test-bucket/test/object.txt?presigned",
+			wantHeader: map[string]string{},
+			wantErr:    nil,
 		},
 		{
 			name: "正常系: カスタムTTLでPut用URLが生成される",
@@ -121,10 +147,43 @@ func TestS3Client_GeneratePutURL(t *testing.T) {
 				},
 			},
 			args: args{
-				key: "test/custom.txt",
-				ttl: 30 * time.Minute,
+				key:            "test/custom.txt",
+				contentLength:  1024,
+				checksumSHA256: "47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU=",
+				ttl:            30 * time.Minute,
+			},
+			want:       "https://s3.example.com/custom-ttl",
+			wantHeader: map[string]string{},
+			wantErr:    nil,
+		},
+		{
+			name: "正常系: 署名に含まれたヘッダーのうちHostとContent-Length以外を返す",
+			fields: fields{
+				mockPresign: func() *mockPresignClient {
+					return &mockPresignClient{
+						presignPutObjectFunc: func(ctx context.Context, params *s3.PutObjectInput, optFns ...func(*s3.PresignOptions)) (*v4.PresignedHTTPRequest, error) {
+							return &v4.PresignedHTTPRequest{
+								URL: "https://s3.example.com/signed-header",
+								SignedHeader: http.Header{
+									"Host":                  []string{"s3.example.com"},
+									"Content-Length":        []string{"1024"},
+									"X-Amz-Checksum-Sha256": []string{"47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU="},
+								},
+							}, nil
+						},
+					}
+				},
+			},
+			args: args{
+				key:            "test/object.txt",
+				contentLength:  1024,
+				checksumSHA256: "47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU=",
+				ttl:            0,
+			},
+			want: "https://s3.example.com/signed-header",
+			wantHeader: map[string]string{
+				"X-Amz-Checksum-Sha256": "47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU=",
 			},
-			want:    "https://s3.example.com/custom-ttl",
 			wantErr: nil,
 		},
 		{
@@ -139,8 +198,10 @@ func TestS3Client_GeneratePutURL(t *testing.T) {
 				},
 			},
 			args: args{
-				key: "test/error.txt",
-				ttl: 0,
+				key:            "test/error.txt",
+				contentLength:  1024,
+				checksumSHA256: "47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU=",
+				ttl:            0,
 			},
 			want:    "",
 			wantErr: errors.New("failed to presign put object: presign failed"),
@@ -156,7 +217,7 @@ func TestS3Client_GeneratePutURL(t *testing.T) {
 
 			client := s3client.NewS3ClientWithPresignFactory(mockAPI, nil, "test-bucket", factory)
 
-			got, err := client.GeneratePutURL(ctx, tt.args.key, tt.args.ttl)
+			got, gotHeader, err := client.GeneratePutURL(ctx, tt.args.key, tt.args.contentLength, tt.args.checksumSHA256, tt.args.ttl)
 
 			if tt.wantErr != nil {
 				if err == nil {
@@ -174,6 +235,9 @@ func TestS3Client_GeneratePutURL(t *testing.T) {
 			if diff := cmp.Diff(tt.want, got); diff != "" {
 				t.Errorf("mismatch (-want +got):\n%s", diff)
 			}
+			if diff := cmp.Diff(tt.wantHeader, gotHeader); diff != "" {
+				t.Errorf("header mismatch (-want +got):\n%s", diff)
+			}
 		})
 	}
 }
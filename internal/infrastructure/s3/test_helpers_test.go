@@ -38,5 +38,6 @@ func NewMockS3Client(mockAPI S3API, bucket string) *S3Client {
 		presignClient:        nil,
 		presignClientFactory: DefaultPresignClientFactory,
 		bucket:               bucket,
+		metrics:              nopStorageMetricsRecorder{},
 	}
 }
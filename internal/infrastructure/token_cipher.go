@@ -0,0 +1,65 @@
+package infrastructure
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+// tokenCipherKeyBytes はAES-256の鍵のバイト数
+const tokenCipherKeyBytes = 32
+
+// TokenCipher はRedisに保存するOAuthトークンをAES-256-GCMで暗号化する
+type TokenCipher struct {
+	aead cipher.AEAD
+}
+
+// NewTokenCipher はbase64でエンコードされた32バイトの鍵からTokenCipherを作成する
+func NewTokenCipher(encodedKey string) (*TokenCipher, error) {
+	key, err := base64.StdEncoding.DecodeString(encodedKey)
+	if err != nil {
+		return nil, fmt.Errorf("暗号鍵のデコードに失敗しました: %w", err)
+	}
+	if len(key) != tokenCipherKeyBytes {
+		return nil, fmt.Errorf("暗号鍵は%dバイトである必要があります: got=%d", tokenCipherKeyBytes, len(key))
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("暗号器の作成に失敗しました: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("暗号器の作成に失敗しました: %w", err)
+	}
+	return &TokenCipher{aead: aead}, nil
+}
+
+// Encrypt は平文を暗号化し、nonceを先頭に付けてbase64でエンコードした文字列を返す
+func (c *TokenCipher) Encrypt(plaintext string) (string, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("nonceの生成に失敗しました: %w", err)
+	}
+	sealed := c.aead.Seal(nonce, nonce, []byte(plaintext), nil)
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+func (c *TokenCipher) Decrypt(ciphertext string) (string, error) {
+	sealed, err := base64.StdEncoding.DecodeString(ciphertext)
+	if err != nil {
+		return "", fmt.Errorf("暗号文のデコードに失敗しました: %w", err)
+	}
+	nonceSize := c.aead.NonceSize()
+	if len(sealed) < nonceSize {
+		return "", errors.New("暗号文が短すぎます")
+	}
+	plaintext, err := c.aead.Open(nil, sealed[:nonceSize], sealed[nonceSize:], nil)
+	if err != nil {
+		return "", fmt.Errorf("暗号文の復号に失敗しました: %w", err)
+	}
+	return string(plaintext), nil
+}
@@ -0,0 +1,88 @@
+package infrastructure_test
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/na2na-p/cargohold/internal/infrastructure"
+)
+
+func TestNewTokenCipher(t *testing.T) {
+	tests := []struct {
+		name       string
+		encodedKey string
+		wantErr    bool
+	}{
+		{
+			name:       "正常系: 32バイトの鍵で作成できる",
+			encodedKey: base64.StdEncoding.EncodeToString(make([]byte, 32)),
+		},
+		{
+			name:       "異常系: 鍵の長さが32バイトでない場合、エラーが返る",
+			encodedKey: base64.StdEncoding.EncodeToString(make([]byte, 16)),
+			wantErr:    true,
+		},
+		{
+			name:       "異常系: base64でない場合、エラーが返る",
+			encodedKey: "not base64!",
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := infrastructure.NewTokenCipher(tt.encodedKey)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewTokenCipher() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTokenCipher_EncryptDecrypt(t *testing.T) {
+	key := base64.StdEncoding.EncodeToString([]byte(strings.Repeat("k", 32)))
+	c, err := infrastructure.NewTokenCipher(key)
+	if err != nil {
+		t.Fatalf("NewTokenCipher() error = %v", err)
+	}
+
+	t.Run("正常系: 暗号化した値を復号すると元の値に戻り、暗号文には平文が含まれない", func(t *testing.T) {
+		first, err := c.Encrypt("gho_token")
+		if err != nil {
+			t.Fatalf("Encrypt() error = %v", err)
+		}
+		second, err := c.Encrypt("gho_token")
+		if err != nil {
+			t.Fatalf("Encrypt() error = %v", err)
+		}
+		if first == second {
+			t.Error("Encrypt() returned the same ciphertext twice")
+		}
+		if strings.Contains(first, "gho_token") {
+			t.Errorf("Encrypt() = %q contains plaintext", first)
+		}
+
+		got, err := c.Decrypt(first)
+		if err != nil {
+			t.Fatalf("Decrypt() error = %v", err)
+		}
+		if got != "gho_token" {
+			t.Errorf("Decrypt() = %q, want %q", got, "gho_token")
+		}
+	})
+
+	t.Run("異常系: 別の鍵で暗号化した値は復号できない", func(t *testing.T) {
+		other, err := infrastructure.NewTokenCipher(base64.StdEncoding.EncodeToString([]byte(strings.Repeat("o", 32))))
+		if err != nil {
+			t.Fatalf("NewTokenCipher() error = %v", err)
+		}
+		encrypted, err := other.Encrypt("gho_token")
+		if err != nil {
+			t.Fatalf("Encrypt() error = %v", err)
+		}
+		if _, err := c.Decrypt(encrypted); err == nil {
+			t.Error("Decrypt() error = nil, want error")
+		}
+	})
+}
@@ -0,0 +1,124 @@
+package tracing_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/na2na-p/cargohold/internal/infrastructure/tracing"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+)
+
+func TestSetup(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     tracing.Config
+		wantErr bool
+	}{
+		{
+			name:    "異常系: サンプリング割合が負の場合、エラーが返る",
+			cfg:     tracing.Config{ServiceName: "cargohold", SampleRatio: -0.1},
+			wantErr: true,
+		},
+		{
+			name:    "異常系: サンプリング割合が1を超える場合、エラーが返る",
+			cfg:     tracing.Config{ServiceName: "cargohold", SampleRatio: 1.5},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tracing.Setup(context.Background(), tt.cfg)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Setup() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewTracerProvider(t *testing.T) {
+	tests := []struct {
+		name        string
+		sampleRatio float64
+		wantSpans   int
+	}{
+		{
+			name:        "正常系: サンプリング割合が1の場合、スパンが記録される",
+			sampleRatio: 1.0,
+			wantSpans:   1,
+		},
+		{
+			name:        "正常系: サンプリング割合が0の場合、スパンは記録されない",
+			sampleRatio: 0,
+			wantSpans:   0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exporter := tracetest.NewInMemoryExporter()
+			tp := tracing.NewTracerProvider(sdktrace.NewSimpleSpanProcessor(exporter), "cargohold-test", tt.sampleRatio)
+
+			_, span := tp.Tracer("test").Start(context.Background(), "operation")
+			span.End()
+
+			spans := exporter.GetSpans()
+			if len(spans) != tt.wantSpans {
+				t.Fatalf("expected %d spans, got %d", tt.wantSpans, len(spans))
+			}
+			if tt.wantSpans == 0 {
+				return
+			}
+			got, ok := spans[0].Resource.Set().Value(semconv.ServiceNameKey)
+			if !ok || got.AsString() != "cargohold-test" {
+				t.Errorf("service.name = %q, want %q", got.AsString(), "cargohold-test")
+			}
+		})
+	}
+}
+
+func TestEnd(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus codes.Code
+		wantEvents int
+	}{
+		{
+			name:       "正常系: エラーがない場合、ステータスは設定されない",
+			wantStatus: codes.Unset,
+			wantEvents: 0,
+		},
+		{
+			name:       "異常系: エラーがある場合、エラーとして記録される",
+			err:        errors.New("failed"),
+			wantStatus: codes.Error,
+			wantEvents: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exporter := tracetest.NewInMemoryExporter()
+			tp := tracing.NewTracerProvider(sdktrace.NewSimpleSpanProcessor(exporter), "cargohold", 1.0)
+
+			_, span := tp.Tracer("test").Start(context.Background(), "operation")
+			tracing.End(span, tt.err)
+
+			spans := exporter.GetSpans()
+			if len(spans) != 1 {
+				t.Fatalf("expected 1 span, got %d", len(spans))
+			}
+			if spans[0].Status.Code != tt.wantStatus {
+				t.Errorf("span status = %v, want %v", spans[0].Status.Code, tt.wantStatus)
+			}
+			if len(spans[0].Events) != tt.wantEvents {
+				t.Errorf("span events = %d, want %d", len(spans[0].Events), tt.wantEvents)
+			}
+		})
+	}
+}
@@ -22,6 +22,10 @@ func (g *ProxyActionURLGenerator) GenerateDownloadURL(baseURL, owner, repo, oid
 	return g.generateURL(baseURL, owner, repo, oid)
 }
 
+func (g *ProxyActionURLGenerator) GenerateVerifyURL(baseURL, owner, repo string) string {
+	return g.generateURL(baseURL, owner, repo, "verify")
+}
+
 func (g *ProxyActionURLGenerator) generateURL(baseURL, owner, repo, oid string) string {
 	base := strings.TrimSuffix(baseURL, "/")
 	return base + "/" + owner + "/" + repo + "/info/lfs/objects/" + oid
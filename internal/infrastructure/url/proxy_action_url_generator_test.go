@@ -97,3 +97,44 @@ func TestProxyActionURLGenerator_GenerateDownloadURL(t *testing.T) {
 		})
 	}
 }
+
+func TestProxyActionURLGenerator_GenerateVerifyURL(t *testing.T) {
+	type args struct {
+		baseURL string
+		owner   string
+		repo    string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "正常系: 標準的なbaseURL",
+			args: args{
+				baseURL: "https://example.com",
+				owner:   "testowner",
+				repo:    "testrepo",
+			},
+			want: "https://example.com/testowner/testrepo/info/lfs/objects/verify",
+		},
+		{
+			name: "正常系: baseURLが末尾スラッシュあり",
+			args: args{
+				baseURL: "https://example.com/",
+				owner:   "testowner",
+				repo:    "testrepo",
+			},
+			want: "https://example.com/testowner/testrepo/info/lfs/objects/verify",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := url.NewProxyActionURLGenerator()
+			got := g.GenerateVerifyURL(tt.args.baseURL, tt.args.owner, tt.args.repo)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
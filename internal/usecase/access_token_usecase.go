@@ -0,0 +1,337 @@
+//go:generate mockgen -source=$GOFILE -destination=../../tests/usecase/mock_access_token_usecase.go -package=usecase
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/newmo-oss/ctxtime"
+)
+
+// accessTokenLastUsedInterval は最終使用日時を更新する最小間隔
+// リクエストごとの書き込みを避けるため、前回の更新から間隔が空いた場合のみ更新する
+const accessTokenLastUsedInterval = time.Minute
+
+// AccessTokenInput はアクセストークンの発行内容
+type AccessTokenInput struct {
+	Name string
+	// Repositories はトークンで操作できるリポジトリ。ユーザーが単一のリポジトリのセッションから発行する場合は省略するとセッションのリポジトリになる
+	Repositories []string
+	Operations   []string
+	ExpiresIn    time.Duration
+}
+
+// SessionRepositoryAuthorizer は複数のリポジトリを対象とするセッションについて、リポジトリを操作するユーザー情報を返す
+// リポジトリがスコープに含まれない場合やアクセスできない場合は、リポジトリを持たないユーザー情報を返す
+type SessionRepositoryAuthorizer interface {
+	Authenticate(ctx context.Context, sessionID string, repository *domain.RepositoryIdentifier) (*domain.UserInfo, error)
+}
+
+// IssuedAccessToken は発行したトークンと、発行時にのみ返すトークン文字列
+type IssuedAccessToken struct {
+	Token  *domain.AccessToken
+	Secret string
+}
+
+type AccessTokenUseCase interface {
+	// Issue は管理者としてトークンを発行する
+	Issue(ctx context.Context, createdBy string, input AccessTokenInput) (*IssuedAccessToken, error)
+	// IssueForUser はユーザーがセッションの権限の範囲内でトークンを発行する
+	IssueForUser(ctx context.Context, sessionID string, userInfo *domain.UserInfo, input AccessTokenInput) (*IssuedAccessToken, error)
+	List(ctx context.Context) ([]*domain.AccessToken, error)
+	ListForUser(ctx context.Context, userInfo *domain.UserInfo) ([]*domain.AccessToken, error)
+	Revoke(ctx context.Context, id string) error
+	// RevokeForUser はユーザーが発行したトークンを失効させる。他のユーザーのトークンは見つからないものとして扱う
+	RevokeForUser(ctx context.Context, userInfo *domain.UserInfo, id string) error
+	// Authenticate はトークンを検証し、repositoryを操作するユーザー情報を返す
+	Authenticate(ctx context.Context, secret string, repository *domain.RepositoryIdentifier) (*domain.UserInfo, error)
+}
+
+type accessTokenUseCaseImpl struct {
+	tokenRepo         domain.AccessTokenRepository
+	secretGenerator   AccessTokenSecretGenerator
+	sessionAuthorizer SessionRepositoryAuthorizer
+}
+
+// NewAccessTokenUseCase はcargoholdが発行するアクセストークンを管理・検証するユースケースを作成する
+// sessionAuthorizerがnilの場合、複数のリポジトリを対象とするセッションからはトークンを発行できない
+func NewAccessTokenUseCase(
+	tokenRepo domain.AccessTokenRepository,
+	secretGenerator AccessTokenSecretGenerator,
+	sessionAuthorizer SessionRepositoryAuthorizer,
+) AccessTokenUseCase {
+	return &accessTokenUseCaseImpl{
+		tokenRepo:         tokenRepo,
+		secretGenerator:   secretGenerator,
+		sessionAuthorizer: sessionAuthorizer,
+	}
+}
+
+func (u *accessTokenUseCaseImpl) Issue(ctx context.Context, createdBy string, input AccessTokenInput) (*IssuedAccessToken, error) {
+	repositories, err := parseAccessTokenRepositories(input.Repositories)
+	if err != nil {
+		return nil, err
+	}
+	operations, err := parseAccessTokenOperations(input.Operations)
+	if err != nil {
+		return nil, err
+	}
+	return u.issue(ctx, createdBy, input, repositories, operations)
+}
+
+func (u *accessTokenUseCaseImpl) IssueForUser(ctx context.Context, sessionID string, userInfo *domain.UserInfo, input AccessTokenInput) (*IssuedAccessToken, error) {
+	// アクセストークンから別のトークンを発行して有効期間を延ばせないよう、トークンによる認証では発行させない
+	if userInfo == nil || userInfo.Provider() == domain.ProviderTypeAccessToken {
+		return nil, ErrPermissionDenied
+	}
+
+	operations, err := parseAccessTokenOperations(input.Operations)
+	if err != nil {
+		return nil, err
+	}
+
+	var repositories []*domain.RepositoryIdentifier
+	switch {
+	case userInfo.RepositoryScope() != nil:
+		repositories, err = u.authorizeScopedRepositories(ctx, sessionID, userInfo, input.Repositories, operations)
+	case userInfo.Repository() != nil:
+		repositories, err = authorizeSessionRepository(userInfo, input.Repositories, operations)
+	default:
+		return nil, ErrPermissionDenied
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// 発行後に発行者の権限が剥奪されても使い続けられないよう、管理者が発行するトークンより短い有効期間に制限する
+	if input.ExpiresIn > domain.MaxUserAccessTokenLifetime {
+		return nil, fmt.Errorf("%w: 有効期間は最大%d日です", ErrInvalidAccessTokenRequest, domain.MaxUserAccessTokenLifetime/(24*time.Hour))
+	}
+
+	return u.issue(ctx, userInfo.Sub(), input, repositories, operations)
+}
+
+// authorizeSessionRepository は単一のリポジトリのセッションで、発行するトークンのリポジトリと操作を検証する
+func authorizeSessionRepository(userInfo *domain.UserInfo, requested []string, operations []domain.Operation) ([]*domain.RepositoryIdentifier, error) {
+	repositories := []*domain.RepositoryIdentifier{userInfo.Repository()}
+	if len(requested) > 0 {
+		var err error
+		repositories, err = parseAccessTokenRepositories(requested)
+		if err != nil {
+			return nil, err
+		}
+		for _, repository := range repositories {
+			if !repository.EqualsFold(userInfo.Repository()) {
+				return nil, fmt.Errorf("%w: %s", ErrRepositoryAccessDenied, repository.FullName())
+			}
+		}
+	}
+
+	if err := authorizeAccessTokenOperations(userInfo.Permissions(), operations); err != nil {
+		return nil, err
+	}
+	return repositories, nil
+}
+
+// authorizeScopedRepositories は複数のリポジトリを対象とするセッションで、発行するトークンのリポジトリと操作を検証する
+// スコープはパターンを含むためリポジトリの指定を必須とし、リポジトリごとの権限はセッションの認証と同じ方法で取得する
+func (u *accessTokenUseCaseImpl) authorizeScopedRepositories(
+	ctx context.Context,
+	sessionID string,
+	userInfo *domain.UserInfo,
+	requested []string,
+	operations []domain.Operation,
+) ([]*domain.RepositoryIdentifier, error) {
+	if len(requested) == 0 {
+		return nil, fmt.Errorf("%w: 複数のリポジトリを対象とするセッションではリポジトリの指定が必要です", ErrInvalidAccessTokenRequest)
+	}
+	repositories, err := parseAccessTokenRepositories(requested)
+	if err != nil {
+		return nil, err
+	}
+	if u.sessionAuthorizer == nil {
+		return nil, ErrPermissionDenied
+	}
+
+	for _, repository := range repositories {
+		if !userInfo.RepositoryScope().Contains(repository) {
+			return nil, fmt.Errorf("%w: %s", ErrRepositoryAccessDenied, repository.FullName())
+		}
+		scoped, err := u.sessionAuthorizer.Authenticate(ctx, sessionID, repository)
+		if err != nil {
+			return nil, err
+		}
+		if scoped.Repository() == nil {
+			return nil, fmt.Errorf("%w: %s", ErrRepositoryAccessDenied, repository.FullName())
+		}
+		if err := authorizeAccessTokenOperations(scoped.Permissions(), operations); err != nil {
+			return nil, err
+		}
+	}
+	return repositories, nil
+}
+
+// authorizeAccessTokenOperations はトークンに許可する操作がユーザーの権限の範囲内かを検証する
+func authorizeAccessTokenOperations(permissions *domain.RepositoryPermissions, operations []domain.Operation) error {
+	for _, operation := range operations {
+		if permissions == nil ||
+			(operation == domain.OperationUpload && !permissions.CanUpload()) ||
+			(operation == domain.OperationDownload && !permissions.CanDownload()) {
+			return fmt.Errorf("%w: %s", ErrPermissionDenied, operation.String())
+		}
+	}
+	return nil
+}
+
+func (u *accessTokenUseCaseImpl) issue(
+	ctx context.Context,
+	createdBy string,
+	input AccessTokenInput,
+	repositories []*domain.RepositoryIdentifier,
+	operations []domain.Operation,
+) (*IssuedAccessToken, error) {
+	secret, err := u.secretGenerator.Generate()
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := domain.NewAccessToken(ctx, input.Name, createdBy, repositories, operations, domain.HashAccessTokenSecret(secret), input.ExpiresIn)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidAccessTokenRequest, err)
+	}
+
+	created, err := u.tokenRepo.Create(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("アクセストークンの発行に失敗しました: %w", err)
+	}
+	return &IssuedAccessToken{Token: created, Secret: secret}, nil
+}
+
+func (u *accessTokenUseCaseImpl) List(ctx context.Context) ([]*domain.AccessToken, error) {
+	tokens, err := u.tokenRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("アクセストークン一覧の取得に失敗しました: %w", err)
+	}
+	return tokens, nil
+}
+
+func (u *accessTokenUseCaseImpl) ListForUser(ctx context.Context, userInfo *domain.UserInfo) ([]*domain.AccessToken, error) {
+	if userInfo == nil {
+		return nil, ErrPermissionDenied
+	}
+	tokens, err := u.tokenRepo.ListByCreator(ctx, userInfo.Sub())
+	if err != nil {
+		return nil, fmt.Errorf("アクセストークン一覧の取得に失敗しました: %w", err)
+	}
+	return tokens, nil
+}
+
+func (u *accessTokenUseCaseImpl) Revoke(ctx context.Context, id string) error {
+	tokenID, err := domain.ParseAccessTokenID(id)
+	if err != nil {
+		return ErrInvalidAccessTokenID
+	}
+	return u.delete(ctx, tokenID)
+}
+
+func (u *accessTokenUseCaseImpl) RevokeForUser(ctx context.Context, userInfo *domain.UserInfo, id string) error {
+	if userInfo == nil {
+		return ErrPermissionDenied
+	}
+	tokenID, err := domain.ParseAccessTokenID(id)
+	if err != nil {
+		return ErrInvalidAccessTokenID
+	}
+
+	token, err := u.tokenRepo.FindByID(ctx, tokenID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return ErrAccessTokenNotFound
+		}
+		return fmt.Errorf("アクセストークンの取得に失敗しました: %w", err)
+	}
+	// 他のユーザーのトークンの存在を明かさないよう、見つからない場合と同じエラーを返す
+	if token.CreatedBy() != userInfo.Sub() {
+		return ErrAccessTokenNotFound
+	}
+	return u.delete(ctx, tokenID)
+}
+
+func (u *accessTokenUseCaseImpl) delete(ctx context.Context, id domain.AccessTokenID) error {
+	if err := u.tokenRepo.Delete(ctx, id); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return ErrAccessTokenNotFound
+		}
+		return fmt.Errorf("アクセストークンの失効に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// Authenticate はトークンを検証してユーザー情報を返す
+// repositoryがトークンのスコープに含まれない場合はリポジトリを持たないユーザー情報を返し、リポジトリの検証で拒否させる
+func (u *accessTokenUseCaseImpl) Authenticate(ctx context.Context, secret string, repository *domain.RepositoryIdentifier) (*domain.UserInfo, error) {
+	if !domain.IsAccessTokenSecret(secret) {
+		return nil, ErrInvalidAccessToken
+	}
+
+	token, err := u.tokenRepo.FindByHash(ctx, domain.HashAccessTokenSecret(secret))
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, ErrInvalidAccessToken
+		}
+		return nil, fmt.Errorf("%w: %v", ErrAuthenticationFailed, err)
+	}
+
+	now := ctxtime.Now(ctx)
+	if token.IsExpired(now) {
+		return nil, fmt.Errorf("%w: token expired at %s", ErrInvalidAccessToken, token.ExpiresAt().Format(time.RFC3339))
+	}
+
+	if lastUsedAt := token.LastUsedAt(); lastUsedAt == nil || now.Sub(*lastUsedAt) >= accessTokenLastUsedInterval {
+		if err := u.tokenRepo.UpdateLastUsedAt(ctx, token.ID(), now); err != nil {
+			slog.Warn("アクセストークンの最終使用日時の更新に失敗しました", "token_id", token.ID().String(), "error", err)
+		}
+	}
+
+	var scopedRepository *domain.RepositoryIdentifier
+	if token.CanAccess(repository) {
+		scopedRepository = repository
+	}
+
+	userInfo, err := domain.NewUserInfo(token.Subject(), "", token.Name(), domain.ProviderTypeAccessToken, scopedRepository, "")
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUserInfoCreationFailed, err)
+	}
+	userInfo.SetCreatedBy(token.CreatedBy())
+	permissions := token.Permissions()
+	userInfo.SetPermissions(&permissions)
+	return userInfo, nil
+}
+
+func parseAccessTokenRepositories(fullNames []string) ([]*domain.RepositoryIdentifier, error) {
+	repositories := make([]*domain.RepositoryIdentifier, len(fullNames))
+	for i, fullName := range fullNames {
+		repository, err := domain.NewRepositoryIdentifier(fullName)
+		if err != nil {
+			return nil, ErrInvalidRepository
+		}
+		repositories[i] = repository
+	}
+	return repositories, nil
+}
+
+func parseAccessTokenOperations(values []string) ([]domain.Operation, error) {
+	operations := make([]domain.Operation, len(values))
+	for i, value := range values {
+		operation, err := domain.ParseOperation(value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidAccessTokenRequest, err)
+		}
+		operations[i] = operation
+	}
+	return operations, nil
+}
@@ -0,0 +1,608 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/na2na-p/cargohold/internal/usecase"
+	mock_domain "github.com/na2na-p/cargohold/tests/domain"
+	mock_usecase "github.com/na2na-p/cargohold/tests/usecase"
+	"github.com/newmo-oss/ctxtime/ctxtimetest"
+	"github.com/newmo-oss/testid"
+	"go.uber.org/mock/gomock"
+)
+
+const testAccessTokenSecret = "cht_secret"
+
+func mustReconstructAccessToken(t *testing.T, id int64, createdBy string, operations []domain.Operation, expiresAt time.Time, lastUsedAt *time.Time) *domain.AccessToken {
+	t.Helper()
+
+	tokenID, err := domain.NewAccessTokenID(id)
+	if err != nil {
+		t.Fatalf("NewAccessTokenID() failed: %v", err)
+	}
+	repo, err := domain.NewRepositoryIdentifier("owner/repo")
+	if err != nil {
+		t.Fatalf("NewRepositoryIdentifier() failed: %v", err)
+	}
+	return domain.ReconstructAccessToken(tokenID, "build-farm", createdBy, []*domain.RepositoryIdentifier{repo}, operations,
+		domain.HashAccessTokenSecret(testAccessTokenSecret), expiresAt, lastUsedAt, time.Time{})
+}
+
+func newSessionUserInfo(t *testing.T, sub string, permissions domain.RepositoryPermissions) *domain.UserInfo {
+	t.Helper()
+
+	repo, err := domain.NewRepositoryIdentifier("owner/repo")
+	if err != nil {
+		t.Fatalf("NewRepositoryIdentifier() failed: %v", err)
+	}
+	userInfo := mustNewUserInfo(t, sub, "", "user", domain.ProviderTypeGitHub, repo, "")
+	userInfo.SetPermissions(&permissions)
+	return userInfo
+}
+
+func newScopedSessionUserInfo(t *testing.T, sub string, scope string) *domain.UserInfo {
+	t.Helper()
+
+	userInfo := mustNewUserInfo(t, sub, "", "user", domain.ProviderTypeGitHub, nil, "")
+	userInfo.SetRepositoryScope(mustParseRepositoryScope(t, scope))
+	return userInfo
+}
+
+func TestAccessTokenUseCase_IssueForUser(t *testing.T) {
+	fixedNow := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	writer := domain.NewRepositoryPermissions(false, true, true, false, false)
+	reader := domain.NewRepositoryPermissions(false, false, true, false, false)
+	ownerRepo, _ := domain.NewRepositoryIdentifier("owner/repo")
+	ownerOther, _ := domain.NewRepositoryIdentifier("owner/other")
+
+	tests := []struct {
+		name              string
+		tokenRepo         func(ctrl *gomock.Controller) domain.AccessTokenRepository
+		sessionAuthorizer func(t *testing.T, ctrl *gomock.Controller) usecase.SessionRepositoryAuthorizer
+		userInfo          func(t *testing.T) *domain.UserInfo
+		input             usecase.AccessTokenInput
+		wantRepos         []string
+		wantErr           error
+	}{
+		{
+			name: "正常系: リポジトリを省略した場合、セッションのリポジトリに対して発行される",
+			tokenRepo: func(ctrl *gomock.Controller) domain.AccessTokenRepository {
+				mock := mock_domain.NewMockAccessTokenRepository(ctrl)
+				mock.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, token *domain.AccessToken) (*domain.AccessToken, error) {
+					if token.CreatedBy() != "12345" {
+						t.Errorf("Create() createdBy = %q, want %q", token.CreatedBy(), "12345")
+					}
+					if token.TokenHash() != domain.HashAccessTokenSecret(testAccessTokenSecret) {
+						t.Errorf("Create() tokenHash = %q, want hash of the generated secret", token.TokenHash())
+					}
+					if want := fixedNow.Add(24 * time.Hour); !token.ExpiresAt().Equal(want) {
+						t.Errorf("Create() expiresAt = %v, want %v", token.ExpiresAt(), want)
+					}
+					return token, nil
+				})
+				return mock
+			},
+			userInfo: func(t *testing.T) *domain.UserInfo { return newSessionUserInfo(t, "12345", writer) },
+			input: usecase.AccessTokenInput{
+				Name:       "build-farm",
+				Operations: []string{"upload", "download"},
+				ExpiresIn:  24 * time.Hour,
+			},
+			wantRepos: []string{"owner/repo"},
+		},
+		{
+			name: "異常系: セッションと異なるリポジトリを指定した場合、ErrRepositoryAccessDenied",
+			tokenRepo: func(ctrl *gomock.Controller) domain.AccessTokenRepository {
+				return mock_domain.NewMockAccessTokenRepository(ctrl)
+			},
+			userInfo: func(t *testing.T) *domain.UserInfo { return newSessionUserInfo(t, "12345", writer) },
+			input: usecase.AccessTokenInput{
+				Name:         "build-farm",
+				Repositories: []string{"owner/other"},
+				Operations:   []string{"download"},
+				ExpiresIn:    time.Hour,
+			},
+			wantErr: usecase.ErrRepositoryAccessDenied,
+		},
+		{
+			name: "異常系: 読み取り権限のみのユーザーがuploadを要求した場合、ErrPermissionDenied",
+			tokenRepo: func(ctrl *gomock.Controller) domain.AccessTokenRepository {
+				return mock_domain.NewMockAccessTokenRepository(ctrl)
+			},
+			userInfo: func(t *testing.T) *domain.UserInfo { return newSessionUserInfo(t, "12345", reader) },
+			input: usecase.AccessTokenInput{
+				Name:       "build-farm",
+				Operations: []string{"upload"},
+				ExpiresIn:  time.Hour,
+			},
+			wantErr: usecase.ErrPermissionDenied,
+		},
+		{
+			name: "異常系: アクセストークンで認証したユーザーは発行できない",
+			tokenRepo: func(ctrl *gomock.Controller) domain.AccessTokenRepository {
+				return mock_domain.NewMockAccessTokenRepository(ctrl)
+			},
+			userInfo: func(t *testing.T) *domain.UserInfo {
+				repo, _ := domain.NewRepositoryIdentifier("owner/repo")
+				return mustNewUserInfo(t, "12345", "", "build-farm", domain.ProviderTypeAccessToken, repo, "")
+			},
+			input: usecase.AccessTokenInput{
+				Name:       "build-farm",
+				Operations: []string{"download"},
+				ExpiresIn:  time.Hour,
+			},
+			wantErr: usecase.ErrPermissionDenied,
+		},
+		{
+			name: "正常系: 有効期間がユーザー発行の上限と等しい場合、発行される",
+			tokenRepo: func(ctrl *gomock.Controller) domain.AccessTokenRepository {
+				mock := mock_domain.NewMockAccessTokenRepository(ctrl)
+				mock.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, token *domain.AccessToken) (*domain.AccessToken, error) {
+					return token, nil
+				})
+				return mock
+			},
+			userInfo: func(t *testing.T) *domain.UserInfo { return newSessionUserInfo(t, "12345", writer) },
+			input: usecase.AccessTokenInput{
+				Name:       "build-farm",
+				Operations: []string{"download"},
+				ExpiresIn:  domain.MaxUserAccessTokenLifetime,
+			},
+			wantRepos: []string{"owner/repo"},
+		},
+		{
+			name: "異常系: 有効期間がユーザー発行の上限を超える場合、ErrInvalidAccessTokenRequest",
+			tokenRepo: func(ctrl *gomock.Controller) domain.AccessTokenRepository {
+				return mock_domain.NewMockAccessTokenRepository(ctrl)
+			},
+			userInfo: func(t *testing.T) *domain.UserInfo { return newSessionUserInfo(t, "12345", writer) },
+			input: usecase.AccessTokenInput{
+				Name:       "build-farm",
+				Operations: []string{"download"},
+				ExpiresIn:  domain.MaxUserAccessTokenLifetime + 24*time.Hour,
+			},
+			wantErr: usecase.ErrInvalidAccessTokenRequest,
+		},
+		{
+			name: "正常系: 複数のリポジトリを対象とするセッションでは、スコープ内のリポジトリごとの権限で発行される",
+			tokenRepo: func(ctrl *gomock.Controller) domain.AccessTokenRepository {
+				mock := mock_domain.NewMockAccessTokenRepository(ctrl)
+				mock.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, token *domain.AccessToken) (*domain.AccessToken, error) {
+					return token, nil
+				})
+				return mock
+			},
+			sessionAuthorizer: func(t *testing.T, ctrl *gomock.Controller) usecase.SessionRepositoryAuthorizer {
+				userInfo := newScopedSessionUserInfo(t, "12345", "owner/*")
+				mock := mock_usecase.NewMockSessionRepositoryAuthorizer(ctrl)
+				mock.EXPECT().Authenticate(gomock.Any(), "session-id", ownerRepo).Return(userInfo.ForRepository(ownerRepo, writer), nil)
+				mock.EXPECT().Authenticate(gomock.Any(), "session-id", ownerOther).Return(userInfo.ForRepository(ownerOther, writer), nil)
+				return mock
+			},
+			userInfo: func(t *testing.T) *domain.UserInfo { return newScopedSessionUserInfo(t, "12345", "owner/*") },
+			input: usecase.AccessTokenInput{
+				Name:         "build-farm",
+				Repositories: []string{"owner/repo", "owner/other"},
+				Operations:   []string{"upload"},
+				ExpiresIn:    time.Hour,
+			},
+			wantRepos: []string{"owner/repo", "owner/other"},
+		},
+		{
+			name: "異常系: 複数のリポジトリを対象とするセッションでリポジトリを省略した場合、ErrInvalidAccessTokenRequest",
+			tokenRepo: func(ctrl *gomock.Controller) domain.AccessTokenRepository {
+				return mock_domain.NewMockAccessTokenRepository(ctrl)
+			},
+			sessionAuthorizer: func(t *testing.T, ctrl *gomock.Controller) usecase.SessionRepositoryAuthorizer {
+				return mock_usecase.NewMockSessionRepositoryAuthorizer(ctrl)
+			},
+			userInfo: func(t *testing.T) *domain.UserInfo { return newScopedSessionUserInfo(t, "12345", "owner/*") },
+			input: usecase.AccessTokenInput{
+				Name:       "build-farm",
+				Operations: []string{"download"},
+				ExpiresIn:  time.Hour,
+			},
+			wantErr: usecase.ErrInvalidAccessTokenRequest,
+		},
+		{
+			name: "異常系: セッションのスコープに含まれないリポジトリを指定した場合、ErrRepositoryAccessDenied",
+			tokenRepo: func(ctrl *gomock.Controller) domain.AccessTokenRepository {
+				return mock_domain.NewMockAccessTokenRepository(ctrl)
+			},
+			sessionAuthorizer: func(t *testing.T, ctrl *gomock.Controller) usecase.SessionRepositoryAuthorizer {
+				return mock_usecase.NewMockSessionRepositoryAuthorizer(ctrl)
+			},
+			userInfo: func(t *testing.T) *domain.UserInfo { return newScopedSessionUserInfo(t, "12345", "owner/repo") },
+			input: usecase.AccessTokenInput{
+				Name:         "build-farm",
+				Repositories: []string{"owner/other"},
+				Operations:   []string{"download"},
+				ExpiresIn:    time.Hour,
+			},
+			wantErr: usecase.ErrRepositoryAccessDenied,
+		},
+		{
+			name: "異常系: スコープ内でもアクセスできないリポジトリを指定した場合、ErrRepositoryAccessDenied",
+			tokenRepo: func(ctrl *gomock.Controller) domain.AccessTokenRepository {
+				return mock_domain.NewMockAccessTokenRepository(ctrl)
+			},
+			sessionAuthorizer: func(t *testing.T, ctrl *gomock.Controller) usecase.SessionRepositoryAuthorizer {
+				mock := mock_usecase.NewMockSessionRepositoryAuthorizer(ctrl)
+				mock.EXPECT().Authenticate(gomock.Any(), "session-id", ownerRepo).Return(newScopedSessionUserInfo(t, "12345", "owner/*"), nil)
+				return mock
+			},
+			userInfo: func(t *testing.T) *domain.UserInfo { return newScopedSessionUserInfo(t, "12345", "owner/*") },
+			input: usecase.AccessTokenInput{
+				Name:         "build-farm",
+				Repositories: []string{"owner/repo"},
+				Operations:   []string{"download"},
+				ExpiresIn:    time.Hour,
+			},
+			wantErr: usecase.ErrRepositoryAccessDenied,
+		},
+		{
+			name: "異常系: 複数のリポジトリを対象とするセッションで読み取り権限のみのリポジトリにuploadを要求した場合、ErrPermissionDenied",
+			tokenRepo: func(ctrl *gomock.Controller) domain.AccessTokenRepository {
+				return mock_domain.NewMockAccessTokenRepository(ctrl)
+			},
+			sessionAuthorizer: func(t *testing.T, ctrl *gomock.Controller) usecase.SessionRepositoryAuthorizer {
+				userInfo := newScopedSessionUserInfo(t, "12345", "owner/*")
+				mock := mock_usecase.NewMockSessionRepositoryAuthorizer(ctrl)
+				mock.EXPECT().Authenticate(gomock.Any(), "session-id", ownerRepo).Return(userInfo.ForRepository(ownerRepo, reader), nil)
+				return mock
+			},
+			userInfo: func(t *testing.T) *domain.UserInfo { return newScopedSessionUserInfo(t, "12345", "owner/*") },
+			input: usecase.AccessTokenInput{
+				Name:         "build-farm",
+				Repositories: []string{"owner/repo"},
+				Operations:   []string{"upload"},
+				ExpiresIn:    time.Hour,
+			},
+			wantErr: usecase.ErrPermissionDenied,
+		},
+		{
+			name: "異常系: セッションの権限を取得できない場合、エラーが返る",
+			tokenRepo: func(ctrl *gomock.Controller) domain.AccessTokenRepository {
+				return mock_domain.NewMockAccessTokenRepository(ctrl)
+			},
+			sessionAuthorizer: func(t *testing.T, ctrl *gomock.Controller) usecase.SessionRepositoryAuthorizer {
+				mock := mock_usecase.NewMockSessionRepositoryAuthorizer(ctrl)
+				mock.EXPECT().Authenticate(gomock.Any(), "session-id", ownerRepo).Return(nil, usecase.ErrSessionNotFound)
+				return mock
+			},
+			userInfo: func(t *testing.T) *domain.UserInfo { return newScopedSessionUserInfo(t, "12345", "owner/*") },
+			input: usecase.AccessTokenInput{
+				Name:         "build-farm",
+				Repositories: []string{"owner/repo"},
+				Operations:   []string{"download"},
+				ExpiresIn:    time.Hour,
+			},
+			wantErr: usecase.ErrSessionNotFound,
+		},
+		{
+			name: "異常系: 権限を取得する手段がない場合、複数のリポジトリを対象とするセッションからは発行できない",
+			tokenRepo: func(ctrl *gomock.Controller) domain.AccessTokenRepository {
+				return mock_domain.NewMockAccessTokenRepository(ctrl)
+			},
+			userInfo: func(t *testing.T) *domain.UserInfo { return newScopedSessionUserInfo(t, "12345", "owner/*") },
+			input: usecase.AccessTokenInput{
+				Name:         "build-farm",
+				Repositories: []string{"owner/repo"},
+				Operations:   []string{"download"},
+				ExpiresIn:    time.Hour,
+			},
+			wantErr: usecase.ErrPermissionDenied,
+		},
+		{
+			name: "異常系: 不正なオペレーションの場合、ErrInvalidAccessTokenRequest",
+			tokenRepo: func(ctrl *gomock.Controller) domain.AccessTokenRepository {
+				return mock_domain.NewMockAccessTokenRepository(ctrl)
+			},
+			userInfo: func(t *testing.T) *domain.UserInfo { return newSessionUserInfo(t, "12345", writer) },
+			input: usecase.AccessTokenInput{
+				Name:       "build-farm",
+				Operations: []string{"delete"},
+				ExpiresIn:  time.Hour,
+			},
+			wantErr: usecase.ErrInvalidAccessTokenRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := testid.WithValue(context.Background(), t.Name())
+			ctxtimetest.SetFixedNow(t, ctx, fixedNow)
+			ctrl := gomock.NewController(t)
+
+			generator := mock_usecase.NewMockAccessTokenSecretGenerator(ctrl)
+			generator.EXPECT().Generate().Return(testAccessTokenSecret, nil).AnyTimes()
+
+			var sessionAuthorizer usecase.SessionRepositoryAuthorizer
+			if tt.sessionAuthorizer != nil {
+				sessionAuthorizer = tt.sessionAuthorizer(t, ctrl)
+			}
+
+			uc := usecase.NewAccessTokenUseCase(tt.tokenRepo(ctrl), generator, sessionAuthorizer)
+			got, err := uc.IssueForUser(ctx, "session-id", tt.userInfo(t), tt.input)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("IssueForUser() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if got.Secret != testAccessTokenSecret {
+				t.Errorf("IssueForUser() secret = %q, want %q", got.Secret, testAccessTokenSecret)
+			}
+			gotRepos := make([]string, len(got.Token.Repositories()))
+			for i, repository := range got.Token.Repositories() {
+				gotRepos[i] = repository.FullName()
+			}
+			if diff := cmp.Diff(tt.wantRepos, gotRepos); diff != "" {
+				t.Errorf("IssueForUser() repositories mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAccessTokenUseCase_Issue(t *testing.T) {
+	t.Run("異常系: 有効期間が上限を超える場合、ErrInvalidAccessTokenRequest", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		generator := mock_usecase.NewMockAccessTokenSecretGenerator(ctrl)
+		generator.EXPECT().Generate().Return(testAccessTokenSecret, nil)
+
+		uc := usecase.NewAccessTokenUseCase(mock_domain.NewMockAccessTokenRepository(ctrl), generator, nil)
+		_, err := uc.Issue(context.Background(), usecase.AdminStaticTokenSubject, usecase.AccessTokenInput{
+			Name:         "build-farm",
+			Repositories: []string{"owner/repo", "owner/other"},
+			Operations:   []string{"download"},
+			ExpiresIn:    domain.MaxAccessTokenLifetime + time.Hour,
+		})
+
+		if !errors.Is(err, usecase.ErrInvalidAccessTokenRequest) {
+			t.Errorf("Issue() error = %v, wantErr %v", err, usecase.ErrInvalidAccessTokenRequest)
+		}
+	})
+
+	t.Run("異常系: リポジトリの形式が不正な場合、ErrInvalidRepository", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		uc := usecase.NewAccessTokenUseCase(mock_domain.NewMockAccessTokenRepository(ctrl), mock_usecase.NewMockAccessTokenSecretGenerator(ctrl), nil)
+		_, err := uc.Issue(context.Background(), usecase.AdminStaticTokenSubject, usecase.AccessTokenInput{
+			Name:         "build-farm",
+			Repositories: []string{"invalid"},
+			Operations:   []string{"download"},
+			ExpiresIn:    time.Hour,
+		})
+
+		if !errors.Is(err, usecase.ErrInvalidRepository) {
+			t.Errorf("Issue() error = %v, wantErr %v", err, usecase.ErrInvalidRepository)
+		}
+	})
+}
+
+func TestAccessTokenUseCase_RevokeForUser(t *testing.T) {
+	tests := []struct {
+		name      string
+		tokenRepo func(ctrl *gomock.Controller) domain.AccessTokenRepository
+		id        string
+		wantErr   error
+	}{
+		{
+			name: "正常系: 自身が発行したトークンを失効できる",
+			tokenRepo: func(ctrl *gomock.Controller) domain.AccessTokenRepository {
+				mock := mock_domain.NewMockAccessTokenRepository(ctrl)
+				token := mustReconstructAccessToken(t, 1, "12345", []domain.Operation{domain.OperationDownload}, time.Time{}, nil)
+				mock.EXPECT().FindByID(gomock.Any(), token.ID()).Return(token, nil)
+				mock.EXPECT().Delete(gomock.Any(), token.ID()).Return(nil)
+				return mock
+			},
+			id: "1",
+		},
+		{
+			name: "異常系: 他のユーザーのトークンはErrAccessTokenNotFound",
+			tokenRepo: func(ctrl *gomock.Controller) domain.AccessTokenRepository {
+				mock := mock_domain.NewMockAccessTokenRepository(ctrl)
+				token := mustReconstructAccessToken(t, 1, "67890", []domain.Operation{domain.OperationDownload}, time.Time{}, nil)
+				mock.EXPECT().FindByID(gomock.Any(), token.ID()).Return(token, nil)
+				return mock
+			},
+			id:      "1",
+			wantErr: usecase.ErrAccessTokenNotFound,
+		},
+		{
+			name: "異常系: IDが不正な場合、ErrInvalidAccessTokenID",
+			tokenRepo: func(ctrl *gomock.Controller) domain.AccessTokenRepository {
+				return mock_domain.NewMockAccessTokenRepository(ctrl)
+			},
+			id:      "abc",
+			wantErr: usecase.ErrInvalidAccessTokenID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			uc := usecase.NewAccessTokenUseCase(tt.tokenRepo(ctrl), mock_usecase.NewMockAccessTokenSecretGenerator(ctrl), nil)
+			err := uc.RevokeForUser(context.Background(), newSessionUserInfo(t, "12345", domain.RepositoryPermissions{}), tt.id)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("RevokeForUser() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAccessTokenUseCase_Revoke(t *testing.T) {
+	t.Run("異常系: 存在しないトークンはErrAccessTokenNotFound", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		tokenRepo := mock_domain.NewMockAccessTokenRepository(ctrl)
+		tokenRepo.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(domain.ErrNotFound)
+
+		uc := usecase.NewAccessTokenUseCase(tokenRepo, mock_usecase.NewMockAccessTokenSecretGenerator(ctrl), nil)
+		err := uc.Revoke(context.Background(), "1")
+
+		if !errors.Is(err, usecase.ErrAccessTokenNotFound) {
+			t.Errorf("Revoke() error = %v, wantErr %v", err, usecase.ErrAccessTokenNotFound)
+		}
+	})
+}
+
+func TestAccessTokenUseCase_Authenticate(t *testing.T) {
+	fixedNow := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	recentlyUsed := fixedNow.Add(-10 * time.Second)
+	ownerRepo, _ := domain.NewRepositoryIdentifier("Owner/Repo")
+	otherRepo, _ := domain.NewRepositoryIdentifier("owner/other")
+
+	tests := []struct {
+		name           string
+		tokenRepo      func(ctrl *gomock.Controller) domain.AccessTokenRepository
+		secret         string
+		repository     *domain.RepositoryIdentifier
+		wantSub        string
+		wantRepository string
+		wantUpload     bool
+		wantErr        error
+	}{
+		{
+			name: "正常系: スコープ内のリポジトリの場合、リポジトリと権限を持つユーザー情報を返し最終使用日時を更新する",
+			tokenRepo: func(ctrl *gomock.Controller) domain.AccessTokenRepository {
+				mock := mock_domain.NewMockAccessTokenRepository(ctrl)
+				token := mustReconstructAccessToken(t, 1, "12345", []domain.Operation{domain.OperationUpload}, fixedNow.Add(time.Hour), nil)
+				mock.EXPECT().FindByHash(gomock.Any(), domain.HashAccessTokenSecret(testAccessTokenSecret)).Return(token, nil)
+				mock.EXPECT().UpdateLastUsedAt(gomock.Any(), token.ID(), fixedNow).Return(nil)
+				return mock
+			},
+			secret:         testAccessTokenSecret,
+			repository:     ownerRepo,
+			wantSub:        "token:1",
+			wantRepository: "Owner/Repo",
+			wantUpload:     true,
+		},
+		{
+			name: "正常系: 直前に使用されたトークンは最終使用日時を更新しない",
+			tokenRepo: func(ctrl *gomock.Controller) domain.AccessTokenRepository {
+				mock := mock_domain.NewMockAccessTokenRepository(ctrl)
+				token := mustReconstructAccessToken(t, 1, "12345", []domain.Operation{domain.OperationDownload}, fixedNow.Add(time.Hour), &recentlyUsed)
+				mock.EXPECT().FindByHash(gomock.Any(), gomock.Any()).Return(token, nil)
+				return mock
+			},
+			secret:         testAccessTokenSecret,
+			repository:     ownerRepo,
+			wantSub:        "token:1",
+			wantRepository: "Owner/Repo",
+		},
+		{
+			name: "正常系: 最終使用日時の更新に失敗しても認証は成功する",
+			tokenRepo: func(ctrl *gomock.Controller) domain.AccessTokenRepository {
+				mock := mock_domain.NewMockAccessTokenRepository(ctrl)
+				token := mustReconstructAccessToken(t, 1, "12345", []domain.Operation{domain.OperationDownload}, fixedNow.Add(time.Hour), nil)
+				mock.EXPECT().FindByHash(gomock.Any(), gomock.Any()).Return(token, nil)
+				mock.EXPECT().UpdateLastUsedAt(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("connection error"))
+				return mock
+			},
+			secret:         testAccessTokenSecret,
+			repository:     ownerRepo,
+			wantSub:        "token:1",
+			wantRepository: "Owner/Repo",
+		},
+		{
+			name: "正常系: スコープ外のリポジトリの場合、リポジトリを持たないユーザー情報を返す",
+			tokenRepo: func(ctrl *gomock.Controller) domain.AccessTokenRepository {
+				mock := mock_domain.NewMockAccessTokenRepository(ctrl)
+				token := mustReconstructAccessToken(t, 1, "12345", []domain.Operation{domain.OperationDownload}, fixedNow.Add(time.Hour), &recentlyUsed)
+				mock.EXPECT().FindByHash(gomock.Any(), gomock.Any()).Return(token, nil)
+				return mock
+			},
+			secret:     testAccessTokenSecret,
+			repository: otherRepo,
+			wantSub:    "token:1",
+		},
+		{
+			name: "正常系: 同じ発行者のトークンでもトークンごとに異なるsubjectを返す",
+			tokenRepo: func(ctrl *gomock.Controller) domain.AccessTokenRepository {
+				mock := mock_domain.NewMockAccessTokenRepository(ctrl)
+				token := mustReconstructAccessToken(t, 2, "12345", []domain.Operation{domain.OperationDownload}, fixedNow.Add(time.Hour), &recentlyUsed)
+				mock.EXPECT().FindByHash(gomock.Any(), gomock.Any()).Return(token, nil)
+				return mock
+			},
+			secret:         testAccessTokenSecret,
+			repository:     ownerRepo,
+			wantSub:        "token:2",
+			wantRepository: "Owner/Repo",
+		},
+		{
+			name: "異常系: 有効期限切れのトークンはErrInvalidAccessToken",
+			tokenRepo: func(ctrl *gomock.Controller) domain.AccessTokenRepository {
+				mock := mock_domain.NewMockAccessTokenRepository(ctrl)
+				token := mustReconstructAccessToken(t, 1, "12345", []domain.Operation{domain.OperationDownload}, fixedNow, nil)
+				mock.EXPECT().FindByHash(gomock.Any(), gomock.Any()).Return(token, nil)
+				return mock
+			},
+			secret:     testAccessTokenSecret,
+			repository: ownerRepo,
+			wantErr:    usecase.ErrInvalidAccessToken,
+		},
+		{
+			name: "異常系: 存在しないトークンはErrInvalidAccessToken",
+			tokenRepo: func(ctrl *gomock.Controller) domain.AccessTokenRepository {
+				mock := mock_domain.NewMockAccessTokenRepository(ctrl)
+				mock.EXPECT().FindByHash(gomock.Any(), gomock.Any()).Return(nil, domain.ErrNotFound)
+				return mock
+			},
+			secret:     testAccessTokenSecret,
+			repository: ownerRepo,
+			wantErr:    usecase.ErrInvalidAccessToken,
+		},
+		{
+			name: "異常系: 接頭辞を持たないトークンは照会せずにErrInvalidAccessToken",
+			tokenRepo: func(ctrl *gomock.Controller) domain.AccessTokenRepository {
+				return mock_domain.NewMockAccessTokenRepository(ctrl)
+			},
+			secret:     "secret",
+			repository: ownerRepo,
+			wantErr:    usecase.ErrInvalidAccessToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := testid.WithValue(context.Background(), t.Name())
+			ctxtimetest.SetFixedNow(t, ctx, fixedNow)
+			ctrl := gomock.NewController(t)
+
+			uc := usecase.NewAccessTokenUseCase(tt.tokenRepo(ctrl), mock_usecase.NewMockAccessTokenSecretGenerator(ctrl), nil)
+			got, err := uc.Authenticate(ctx, tt.secret, tt.repository)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Authenticate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			if got.Sub() != tt.wantSub || got.Provider() != domain.ProviderTypeAccessToken {
+				t.Errorf("Authenticate() sub = %q, provider = %q, want sub %q", got.Sub(), got.Provider().String(), tt.wantSub)
+			}
+			if got.CreatedBy() != "12345" {
+				t.Errorf("Authenticate() createdBy = %q, want %q", got.CreatedBy(), "12345")
+			}
+			gotRepository := ""
+			if got.Repository() != nil {
+				gotRepository = got.Repository().FullName()
+			}
+			if gotRepository != tt.wantRepository {
+				t.Errorf("Authenticate() repository = %q, want %q", gotRepository, tt.wantRepository)
+			}
+			if got.Permissions().CanUpload() != tt.wantUpload {
+				t.Errorf("Authenticate() CanUpload = %v, want %v", got.Permissions().CanUpload(), tt.wantUpload)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+//go:generate mockgen -source=$GOFILE -destination=../../tests/usecase/mock_admin_auth_usecase.go -package=usecase
+package usecase
+
+import (
+	"context"
+	"crypto/subtle"
+	"slices"
+)
+
+// AdminStaticTokenSubject は静的トークンで認証された管理者を表すsubject
+const AdminStaticTokenSubject = "static-token"
+
+type AdminAuthUseCase interface {
+	Authenticate(ctx context.Context, token string) (string, error)
+}
+
+type adminAuthUseCaseImpl struct {
+	staticToken    string
+	githubProvider GitHubOIDCProvider
+	oidcSubjects   []string
+}
+
+// NewAdminAuthUseCase は管理APIの認証ユースケースを作成する
+// staticTokenが空の場合は静的トークンによる認証を、githubProviderがnilまたはoidcSubjectsが空の場合はOIDCによる認証を無効にする
+func NewAdminAuthUseCase(staticToken string, githubProvider GitHubOIDCProvider, oidcSubjects []string) AdminAuthUseCase {
+	return &adminAuthUseCaseImpl{
+		staticToken:    staticToken,
+		githubProvider: githubProvider,
+		oidcSubjects:   oidcSubjects,
+	}
+}
+
+// Authenticate はBearerトークンを検証し、認証された管理者のsubjectを返す
+// 静的トークンと一致しない場合はGitHub OIDCトークンとして検証し、subjectが許可リストに含まれるかを確認する
+func (u *adminAuthUseCaseImpl) Authenticate(ctx context.Context, token string) (string, error) {
+	if token == "" {
+		return "", ErrAdminUnauthorized
+	}
+
+	if u.staticToken != "" && subtle.ConstantTimeCompare([]byte(token), []byte(u.staticToken)) == 1 {
+		return AdminStaticTokenSubject, nil
+	}
+
+	if u.githubProvider == nil || len(u.oidcSubjects) == 0 {
+		return "", ErrAdminUnauthorized
+	}
+
+	githubUserInfo, err := u.githubProvider.VerifyIDToken(ctx, token)
+	if err != nil || githubUserInfo == nil {
+		return "", ErrAdminUnauthorized
+	}
+	if !slices.Contains(u.oidcSubjects, githubUserInfo.Sub()) {
+		return "", ErrAdminUnauthorized
+	}
+
+	return githubUserInfo.Sub(), nil
+}
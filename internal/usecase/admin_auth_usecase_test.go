@@ -0,0 +1,124 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/na2na-p/cargohold/internal/usecase"
+	mock_usecase "github.com/na2na-p/cargohold/tests/usecase"
+	"go.uber.org/mock/gomock"
+)
+
+// TestAdminAuthUseCase_Authenticate は AdminAuthUseCase.Authenticate のテーブルドリブンテスト
+func TestAdminAuthUseCase_Authenticate(t *testing.T) {
+	const adminSubject = "repo:owner/infra:ref:refs/heads/main"
+
+	type fields struct {
+		staticToken    string
+		githubProvider func(ctrl *gomock.Controller) usecase.GitHubOIDCProvider
+		oidcSubjects   []string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		token   string
+		want    string
+		wantErr error
+	}{
+		{
+			name: "正常系: 静的トークンと一致する場合、認証に成功する",
+			fields: fields{
+				staticToken: "admin-token",
+			},
+			token: "admin-token",
+			want:  usecase.AdminStaticTokenSubject,
+		},
+		{
+			name: "正常系: OIDCトークンのsubjectが許可されている場合、認証に成功する",
+			fields: fields{
+				staticToken: "admin-token",
+				githubProvider: func(ctrl *gomock.Controller) usecase.GitHubOIDCProvider {
+					mock := mock_usecase.NewMockGitHubOIDCProvider(ctrl)
+					mock.EXPECT().VerifyIDToken(gomock.Any(), "oidc-token").
+						Return(domain.NewGitHubUserInfo(adminSubject, "owner/infra", "refs/heads/main", "octocat"), nil)
+					return mock
+				},
+				oidcSubjects: []string{adminSubject},
+			},
+			token: "oidc-token",
+			want:  adminSubject,
+		},
+		{
+			name: "異常系: OIDCトークンのsubjectが許可されていない場合、ErrAdminUnauthorizedが返る",
+			fields: fields{
+				githubProvider: func(ctrl *gomock.Controller) usecase.GitHubOIDCProvider {
+					mock := mock_usecase.NewMockGitHubOIDCProvider(ctrl)
+					mock.EXPECT().VerifyIDToken(gomock.Any(), "oidc-token").
+						Return(domain.NewGitHubUserInfo("repo:owner/app:ref:refs/heads/main", "owner/app", "refs/heads/main", "octocat"), nil)
+					return mock
+				},
+				oidcSubjects: []string{adminSubject},
+			},
+			token:   "oidc-token",
+			wantErr: usecase.ErrAdminUnauthorized,
+		},
+		{
+			name: "異常系: OIDCトークンの検証に失敗した場合、ErrAdminUnauthorizedが返る",
+			fields: fields{
+				githubProvider: func(ctrl *gomock.Controller) usecase.GitHubOIDCProvider {
+					mock := mock_usecase.NewMockGitHubOIDCProvider(ctrl)
+					mock.EXPECT().VerifyIDToken(gomock.Any(), "oidc-token").Return(nil, errors.New("invalid token"))
+					return mock
+				},
+				oidcSubjects: []string{adminSubject},
+			},
+			token:   "oidc-token",
+			wantErr: usecase.ErrAdminUnauthorized,
+		},
+		{
+			name: "異常系: 静的トークンと一致せずOIDCが設定されていない場合、ErrAdminUnauthorizedが返る",
+			fields: fields{
+				staticToken: "admin-token",
+			},
+			token:   "wrong-token",
+			wantErr: usecase.ErrAdminUnauthorized,
+		},
+		{
+			name:    "異常系: 静的トークンが未設定の場合、空のトークンで認証できない",
+			fields:  fields{},
+			token:   "",
+			wantErr: usecase.ErrAdminUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			var githubProvider usecase.GitHubOIDCProvider
+			if tt.fields.githubProvider != nil {
+				githubProvider = tt.fields.githubProvider(ctrl)
+			}
+
+			uc := usecase.NewAdminAuthUseCase(tt.fields.staticToken, githubProvider, tt.fields.oidcSubjects)
+			got, err := uc.Authenticate(context.Background(), tt.token)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Authenticate() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Authenticate() unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Authenticate() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+//go:generate mockgen -source=$GOFILE -destination=../../tests/usecase/mock_admin_object_usecase.go -package=usecase
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/na2na-p/cargohold/internal/domain"
+)
+
+// AdminObjectDetail は管理APIで参照するオブジェクトのメタデータとアクセスポリシー
+type AdminObjectDetail struct {
+	Object   *domain.LFSObject
+	Policies []*domain.AccessPolicy
+}
+
+type AdminObjectUseCase interface {
+	GetObject(ctx context.Context, oid string) (*AdminObjectDetail, error)
+	DeleteObject(ctx context.Context, oid string) error
+	FlushObjectCache(ctx context.Context, oid string) error
+}
+
+type adminObjectUseCaseImpl struct {
+	repo          domain.LFSObjectRepository
+	policyRepo    domain.AccessPolicyRepository
+	objectStorage ObjectStorage
+	cacheClient   CacheClient
+	keyGenerator  CacheKeyGenerator
+}
+
+func NewAdminObjectUseCase(
+	repo domain.LFSObjectRepository,
+	policyRepo domain.AccessPolicyRepository,
+	objectStorage ObjectStorage,
+	cacheClient CacheClient,
+	keyGenerator CacheKeyGenerator,
+) AdminObjectUseCase {
+	return &adminObjectUseCaseImpl{
+		repo:          repo,
+		policyRepo:    policyRepo,
+		objectStorage: objectStorage,
+		cacheClient:   cacheClient,
+		keyGenerator:  keyGenerator,
+	}
+}
+
+// GetObject はオブジェクトのメタデータと、オブジェクトに紐付く全リポジトリのアクセスポリシーを返す
+func (u *adminObjectUseCaseImpl) GetObject(ctx context.Context, oid string) (*AdminObjectDetail, error) {
+	obj, err := u.findObject(ctx, oid)
+	if err != nil {
+		return nil, err
+	}
+
+	policies, err := u.policyRepo.FindByOID(ctx, obj.OID())
+	if err != nil {
+		return nil, fmt.Errorf("アクセスポリシーの取得に失敗しました: %w", err)
+	}
+
+	return &AdminObjectDetail{
+		Object:   obj,
+		Policies: policies,
+	}, nil
+}
+
+// DeleteObject はストレージ上の実体を削除してからメタデータを削除し、キャッシュからも取り除く
+// アクセスポリシーはメタデータの削除に連動して削除される
+func (u *adminObjectUseCaseImpl) DeleteObject(ctx context.Context, oid string) error {
+	obj, err := u.findObject(ctx, oid)
+	if err != nil {
+		return err
+	}
+
+	if err := u.objectStorage.DeleteObject(ctx, obj.GetStorageKey()); err != nil {
+		return fmt.Errorf("オブジェクト実体の削除に失敗しました: %w", err)
+	}
+	if err := u.repo.Delete(ctx, obj.OID()); err != nil {
+		return fmt.Errorf("メタデータの削除に失敗しました: %w", err)
+	}
+	return u.deleteCache(ctx, obj.OID())
+}
+
+// FlushObjectCache はオブジェクトのメタデータキャッシュとバッチアップロードのキャッシュを削除する
+func (u *adminObjectUseCaseImpl) FlushObjectCache(ctx context.Context, oid string) error {
+	parsedOID, err := domain.NewOID(oid)
+	if err != nil {
+		return ErrInvalidOID
+	}
+	return u.deleteCache(ctx, parsedOID)
+}
+
+func (u *adminObjectUseCaseImpl) deleteCache(ctx context.Context, oid domain.OID) error {
+	for _, key := range []string{
+		u.keyGenerator.MetadataKey(oid.String()),
+		u.keyGenerator.BatchUploadKey(oid.String()),
+	} {
+		if err := u.cacheClient.Delete(ctx, key); err != nil {
+			return fmt.Errorf("%w: %w", ErrMetadataCache, err)
+		}
+	}
+	return nil
+}
+
+func (u *adminObjectUseCaseImpl) findObject(ctx context.Context, oid string) (*domain.LFSObject, error) {
+	parsedOID, err := domain.NewOID(oid)
+	if err != nil {
+		return nil, ErrInvalidOID
+	}
+
+	obj, err := u.repo.FindByOID(ctx, parsedOID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("メタデータの取得に失敗しました: %w", err)
+	}
+	return obj, nil
+}
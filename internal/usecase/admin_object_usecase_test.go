@@ -0,0 +1,279 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/na2na-p/cargohold/internal/usecase"
+	mock_domain "github.com/na2na-p/cargohold/tests/domain"
+	mock_usecase "github.com/na2na-p/cargohold/tests/usecase"
+	"go.uber.org/mock/gomock"
+)
+
+const adminTestOID = "abcd567890123456789012345678901234567890123456789012345678901234"
+
+type adminObjectFields struct {
+	repo          func(ctrl *gomock.Controller) domain.LFSObjectRepository
+	policyRepo    func(ctrl *gomock.Controller) domain.AccessPolicyRepository
+	objectStorage func(ctrl *gomock.Controller) usecase.ObjectStorage
+	cacheClient   func(ctrl *gomock.Controller) usecase.CacheClient
+}
+
+// newUseCase は指定されなかった依存関係に呼び出しを期待しないモックを使用してユースケースを作成する
+func (f adminObjectFields) newUseCase(ctrl *gomock.Controller) usecase.AdminObjectUseCase {
+	var repo domain.LFSObjectRepository = mock_domain.NewMockLFSObjectRepository(ctrl)
+	if f.repo != nil {
+		repo = f.repo(ctrl)
+	}
+	var policyRepo domain.AccessPolicyRepository = mock_domain.NewMockAccessPolicyRepository(ctrl)
+	if f.policyRepo != nil {
+		policyRepo = f.policyRepo(ctrl)
+	}
+	var objectStorage usecase.ObjectStorage = mock_usecase.NewMockObjectStorage(ctrl)
+	if f.objectStorage != nil {
+		objectStorage = f.objectStorage(ctrl)
+	}
+	var cacheClient usecase.CacheClient = mock_usecase.NewMockCacheClient(ctrl)
+	if f.cacheClient != nil {
+		cacheClient = f.cacheClient(ctrl)
+	}
+	return usecase.NewAdminObjectUseCase(repo, policyRepo, objectStorage, cacheClient, newAdminTestKeyGenerator(ctrl))
+}
+
+func newAdminTestKeyGenerator(ctrl *gomock.Controller) usecase.CacheKeyGenerator {
+	mock := mock_usecase.NewMockCacheKeyGenerator(ctrl)
+	mock.EXPECT().MetadataKey(gomock.Any()).DoAndReturn(func(oid string) string { return "lfs:meta:" + oid }).AnyTimes()
+	mock.EXPECT().BatchUploadKey(gomock.Any()).DoAndReturn(func(oid string) string { return "lfs:batch:upload:" + oid }).AnyTimes()
+	return mock
+}
+
+func newAdminTestObject(t *testing.T) *domain.LFSObject {
+	t.Helper()
+	return newGCTestObject(t, adminTestOID, true, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+}
+
+// TestAdminObjectUseCase_GetObject は AdminObjectUseCase.GetObject のテーブルドリブンテスト
+func TestAdminObjectUseCase_GetObject(t *testing.T) {
+	obj := newAdminTestObject(t)
+	repository, _ := domain.NewRepositoryIdentifier("owner/repo")
+	policyID, _ := domain.NewAccessPolicyID(1)
+	policy := domain.NewAccessPolicy(policyID, obj.OID(), repository, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name    string
+		fields  adminObjectFields
+		oid     string
+		want    *usecase.AdminObjectDetail
+		wantErr error
+	}{
+		{
+			name: "正常系: メタデータとアクセスポリシーが返る",
+			fields: adminObjectFields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().FindByOID(gomock.Any(), obj.OID()).Return(obj, nil)
+					return mock
+				},
+				policyRepo: func(ctrl *gomock.Controller) domain.AccessPolicyRepository {
+					mock := mock_domain.NewMockAccessPolicyRepository(ctrl)
+					mock.EXPECT().FindByOID(gomock.Any(), obj.OID()).Return([]*domain.AccessPolicy{policy}, nil)
+					return mock
+				},
+			},
+			oid:  adminTestOID,
+			want: &usecase.AdminObjectDetail{Object: obj, Policies: []*domain.AccessPolicy{policy}},
+		},
+		{
+			name:    "異常系: OIDの形式が不正な場合、ErrInvalidOIDが返る",
+			oid:     "invalid",
+			wantErr: usecase.ErrInvalidOID,
+		},
+		{
+			name: "異常系: オブジェクトが存在しない場合、ErrObjectNotFoundが返る",
+			fields: adminObjectFields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().FindByOID(gomock.Any(), obj.OID()).Return(nil, domain.ErrNotFound)
+					return mock
+				},
+			},
+			oid:     adminTestOID,
+			wantErr: usecase.ErrObjectNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			got, err := tt.fields.newUseCase(ctrl).GetObject(context.Background(), tt.oid)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("GetObject() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("GetObject() unexpected error: %v", err)
+			}
+			if got.Object != tt.want.Object {
+				t.Errorf("GetObject() object = %v, want %v", got.Object, tt.want.Object)
+			}
+			if diff := cmp.Diff(tt.want.Policies, got.Policies, cmp.Comparer(func(a, b *domain.AccessPolicy) bool { return a == b })); diff != "" {
+				t.Errorf("GetObject() policies mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestAdminObjectUseCase_DeleteObject は AdminObjectUseCase.DeleteObject のテーブルドリブンテスト
+func TestAdminObjectUseCase_DeleteObject(t *testing.T) {
+	obj := newAdminTestObject(t)
+
+	tests := []struct {
+		name    string
+		fields  adminObjectFields
+		oid     string
+		wantErr error
+	}{
+		{
+			name: "正常系: ストレージ・メタデータ・キャッシュから削除される",
+			fields: adminObjectFields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().FindByOID(gomock.Any(), obj.OID()).Return(obj, nil)
+					mock.EXPECT().Delete(gomock.Any(), obj.OID()).Return(nil)
+					return mock
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					mock := mock_usecase.NewMockObjectStorage(ctrl)
+					mock.EXPECT().DeleteObject(gomock.Any(), obj.GetStorageKey()).Return(nil)
+					return mock
+				},
+				cacheClient: func(ctrl *gomock.Controller) usecase.CacheClient {
+					mock := mock_usecase.NewMockCacheClient(ctrl)
+					mock.EXPECT().Delete(gomock.Any(), "lfs:meta:"+adminTestOID).Return(nil)
+					mock.EXPECT().Delete(gomock.Any(), "lfs:batch:upload:"+adminTestOID).Return(nil)
+					return mock
+				},
+			},
+			oid: adminTestOID,
+		},
+		{
+			name: "異常系: ストレージからの削除に失敗した場合、メタデータは削除されない",
+			fields: adminObjectFields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().FindByOID(gomock.Any(), obj.OID()).Return(obj, nil)
+					return mock
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					mock := mock_usecase.NewMockObjectStorage(ctrl)
+					mock.EXPECT().DeleteObject(gomock.Any(), obj.GetStorageKey()).Return(errors.New("s3 error"))
+					return mock
+				},
+			},
+			oid:     adminTestOID,
+			wantErr: errors.New("オブジェクト実体の削除に失敗しました: s3 error"),
+		},
+		{
+			name: "異常系: オブジェクトが存在しない場合、ErrObjectNotFoundが返る",
+			fields: adminObjectFields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().FindByOID(gomock.Any(), obj.OID()).Return(nil, domain.ErrNotFound)
+					return mock
+				},
+			},
+			oid:     adminTestOID,
+			wantErr: usecase.ErrObjectNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			err := tt.fields.newUseCase(ctrl).DeleteObject(context.Background(), tt.oid)
+
+			if tt.wantErr != nil {
+				if err == nil {
+					t.Fatalf("DeleteObject() error = nil, wantErr %v", tt.wantErr)
+				}
+				if diff := cmp.Diff(tt.wantErr.Error(), err.Error()); diff != "" {
+					t.Errorf("DeleteObject() error mismatch (-want +got):\n%s", diff)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("DeleteObject() unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+// TestAdminObjectUseCase_FlushObjectCache は AdminObjectUseCase.FlushObjectCache のテーブルドリブンテスト
+func TestAdminObjectUseCase_FlushObjectCache(t *testing.T) {
+	tests := []struct {
+		name    string
+		fields  adminObjectFields
+		oid     string
+		wantErr error
+	}{
+		{
+			name: "正常系: メタデータとバッチアップロードのキャッシュが削除される",
+			fields: adminObjectFields{
+				cacheClient: func(ctrl *gomock.Controller) usecase.CacheClient {
+					mock := mock_usecase.NewMockCacheClient(ctrl)
+					mock.EXPECT().Delete(gomock.Any(), "lfs:meta:"+adminTestOID).Return(nil)
+					mock.EXPECT().Delete(gomock.Any(), "lfs:batch:upload:"+adminTestOID).Return(nil)
+					return mock
+				},
+			},
+			oid: adminTestOID,
+		},
+		{
+			name:    "異常系: OIDの形式が不正な場合、ErrInvalidOIDが返る",
+			oid:     "invalid",
+			wantErr: usecase.ErrInvalidOID,
+		},
+		{
+			name: "異常系: キャッシュの削除に失敗した場合、ErrMetadataCacheが返る",
+			fields: adminObjectFields{
+				cacheClient: func(ctrl *gomock.Controller) usecase.CacheClient {
+					mock := mock_usecase.NewMockCacheClient(ctrl)
+					mock.EXPECT().Delete(gomock.Any(), "lfs:meta:"+adminTestOID).Return(errors.New("redis error"))
+					return mock
+				},
+			},
+			oid:     adminTestOID,
+			wantErr: usecase.ErrMetadataCache,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			err := tt.fields.newUseCase(ctrl).FlushObjectCache(context.Background(), tt.oid)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("FlushObjectCache() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("FlushObjectCache() unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+//go:generate mockgen -source=$GOFILE -destination=../../tests/usecase/mock_audit_usecase.go -package=usecase
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/newmo-oss/ctxtime"
+)
+
+// AuditSink は監査イベントの書き込み先
+// PostgreSQL以外にもファイルやsyslogなどへの書き込みを差し替えられるようにする
+type AuditSink interface {
+	Write(ctx context.Context, events []*domain.AuditEvent) error
+}
+
+// AuditEventPruner は保持期間を過ぎた監査イベントを削除する
+type AuditEventPruner interface {
+	DeleteBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+type AuditUseCase interface {
+	Record(ctx context.Context, events []*domain.AuditEvent)
+	Prune(ctx context.Context, retention time.Duration) (int64, error)
+}
+
+type auditUseCaseImpl struct {
+	sink   AuditSink
+	pruner AuditEventPruner
+}
+
+func NewAuditUseCase(sink AuditSink, pruner AuditEventPruner) AuditUseCase {
+	return &auditUseCaseImpl{
+		sink:   sink,
+		pruner: pruner,
+	}
+}
+
+// Record は監査イベントを書き込む
+// 監査ログの書き込み失敗でリクエスト自体を失敗させないよう、エラーはログに記録するのみとする
+func (u *auditUseCaseImpl) Record(ctx context.Context, events []*domain.AuditEvent) {
+	if len(events) == 0 {
+		return
+	}
+	if err := u.sink.Write(ctx, events); err != nil {
+		slog.Error("監査ログの書き込みに失敗しました", "count", len(events), "error", err)
+	}
+}
+
+// Prune はretentionより前に発生した監査イベントを削除し、削除件数を返す
+func (u *auditUseCaseImpl) Prune(ctx context.Context, retention time.Duration) (int64, error) {
+	if retention <= 0 {
+		return 0, ErrInvalidAuditRetention
+	}
+	return u.pruner.DeleteBefore(ctx, ctxtime.Now(ctx).Add(-retention))
+}
@@ -0,0 +1,137 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/na2na-p/cargohold/internal/usecase"
+	mock_usecase "github.com/na2na-p/cargohold/tests/usecase"
+	"github.com/newmo-oss/ctxtime/ctxtimetest"
+	"github.com/newmo-oss/testid"
+	"go.uber.org/mock/gomock"
+)
+
+// TestAuditUseCase_Record は AuditUseCase.Record のテーブルドリブンテスト
+func TestAuditUseCase_Record(t *testing.T) {
+	event := domain.NewAuditEvent(domain.AuditEventParams{
+		OccurredAt: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+		Action:     domain.AuditActionDownload,
+		StatusCode: http.StatusOK,
+	})
+
+	tests := []struct {
+		name   string
+		sink   func(ctrl *gomock.Controller) usecase.AuditSink
+		events []*domain.AuditEvent
+	}{
+		{
+			name: "正常系: イベントがシンクに書き込まれる",
+			sink: func(ctrl *gomock.Controller) usecase.AuditSink {
+				mock := mock_usecase.NewMockAuditSink(ctrl)
+				mock.EXPECT().Write(gomock.Any(), []*domain.AuditEvent{event}).Return(nil)
+				return mock
+			},
+			events: []*domain.AuditEvent{event},
+		},
+		{
+			name: "正常系: 書き込みに失敗してもパニックせずに処理を終える",
+			sink: func(ctrl *gomock.Controller) usecase.AuditSink {
+				mock := mock_usecase.NewMockAuditSink(ctrl)
+				mock.EXPECT().Write(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
+				return mock
+			},
+			events: []*domain.AuditEvent{event},
+		},
+		{
+			name: "正常系: イベントが空の場合、シンクは呼ばれない",
+			sink: func(ctrl *gomock.Controller) usecase.AuditSink {
+				return mock_usecase.NewMockAuditSink(ctrl)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			uc := usecase.NewAuditUseCase(tt.sink(ctrl), mock_usecase.NewMockAuditEventPruner(ctrl))
+			uc.Record(context.Background(), tt.events)
+		})
+	}
+}
+
+// TestAuditUseCase_Prune は AuditUseCase.Prune のテーブルドリブンテスト
+func TestAuditUseCase_Prune(t *testing.T) {
+	fixedNow := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		pruner    func(ctrl *gomock.Controller) usecase.AuditEventPruner
+		retention time.Duration
+		want      int64
+		wantErr   error
+	}{
+		{
+			name: "正常系: 保持期間より前のイベントが削除され、削除件数が返る",
+			pruner: func(ctrl *gomock.Controller) usecase.AuditEventPruner {
+				mock := mock_usecase.NewMockAuditEventPruner(ctrl)
+				mock.EXPECT().DeleteBefore(gomock.Any(), fixedNow.Add(-90*24*time.Hour)).Return(int64(42), nil)
+				return mock
+			},
+			retention: 90 * 24 * time.Hour,
+			want:      42,
+		},
+		{
+			name: "異常系: 保持期間が0以下の場合、ErrInvalidAuditRetentionが返る",
+			pruner: func(ctrl *gomock.Controller) usecase.AuditEventPruner {
+				return mock_usecase.NewMockAuditEventPruner(ctrl)
+			},
+			retention: 0,
+			wantErr:   usecase.ErrInvalidAuditRetention,
+		},
+		{
+			name: "異常系: 削除に失敗した場合、エラーが返る",
+			pruner: func(ctrl *gomock.Controller) usecase.AuditEventPruner {
+				mock := mock_usecase.NewMockAuditEventPruner(ctrl)
+				mock.EXPECT().DeleteBefore(gomock.Any(), gomock.Any()).Return(int64(0), errors.New("database error"))
+				return mock
+			},
+			retention: time.Hour,
+			wantErr:   errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			ctx := testid.WithValue(context.Background(), t.Name())
+			ctxtimetest.SetFixedNow(t, ctx, fixedNow)
+
+			uc := usecase.NewAuditUseCase(mock_usecase.NewMockAuditSink(ctrl), tt.pruner(ctrl))
+			got, err := uc.Prune(ctx, tt.retention)
+
+			if tt.wantErr != nil {
+				if err == nil {
+					t.Fatalf("Prune() error = nil, wantErr %v", tt.wantErr)
+				}
+				if diff := cmp.Diff(tt.wantErr.Error(), err.Error()); diff != "" {
+					t.Errorf("Prune() error mismatch (-want +got):\n%s", diff)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Prune() unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Prune() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -3,37 +3,62 @@ package usecase
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/na2na-p/cargohold/internal/domain"
 )
 
+// OIDCAuthenticator はOIDCトークンを検証し、認証済みのユーザー情報を返す
+type OIDCAuthenticator interface {
+	Authenticate(ctx context.Context, token string) (*domain.UserInfo, error)
+}
+
+// AccessTokenAuthenticator はcargoholdが発行したアクセストークンを検証し、リポジトリを操作するユーザー情報を返す
+type AccessTokenAuthenticator interface {
+	Authenticate(ctx context.Context, secret string, repository *domain.RepositoryIdentifier) (*domain.UserInfo, error)
+}
+
 type AuthUseCase struct {
-	githubOIDCUseCase  *GitHubOIDCUseCase
-	sessionAuthUseCase *SessionAuthUseCase
+	// oidcAuthenticators はトークンのissuerごとの認証処理
+	oidcAuthenticators       map[string]OIDCAuthenticator
+	sessionAuthUseCase       *SessionAuthUseCase
+	accessTokenAuthenticator AccessTokenAuthenticator
 }
 
+// NewAuthUseCase はOIDCトークン・セッション・アクセストークンでリクエストを認証するAuthUseCaseを生成する
+// oidcAuthenticatorsはトークンのissuerごとの認証処理で、accessTokenAuthenticatorがnilの場合はアクセストークンによる認証は常に失敗する
 func NewAuthUseCase(
-	githubProvider GitHubOIDCProvider,
-	repoAllowlistRepo domain.RepositoryAllowlistRepository,
-	sessionClient SessionClient,
+	oidcAuthenticators map[string]OIDCAuthenticator,
+	sessionAuthUseCase *SessionAuthUseCase,
+	accessTokenAuthenticator AccessTokenAuthenticator,
 ) *AuthUseCase {
-	var githubOIDCUseCase *GitHubOIDCUseCase
-	if githubProvider != nil && repoAllowlistRepo != nil {
-		githubOIDCUseCase = NewGitHubOIDCUseCase(githubProvider, repoAllowlistRepo)
-	}
 	return &AuthUseCase{
-		githubOIDCUseCase:  githubOIDCUseCase,
-		sessionAuthUseCase: NewSessionAuthUseCase(sessionClient),
+		oidcAuthenticators:       oidcAuthenticators,
+		sessionAuthUseCase:       sessionAuthUseCase,
+		accessTokenAuthenticator: accessTokenAuthenticator,
 	}
 }
 
-func (uc *AuthUseCase) AuthenticateSession(ctx context.Context, sessionID string) (*domain.UserInfo, error) {
-	return uc.sessionAuthUseCase.Authenticate(ctx, sessionID)
+// AuthenticateSession はセッションを検証し、repositoryを操作するユーザー情報を返す
+// リポジトリを含まないエンドポイントではrepositoryにnilを指定する
+func (uc *AuthUseCase) AuthenticateSession(ctx context.Context, sessionID string, repository *domain.RepositoryIdentifier) (*domain.UserInfo, error) {
+	return uc.sessionAuthUseCase.Authenticate(ctx, sessionID, repository)
+}
+
+// AuthenticateOIDC はトークンのissuerに対応する認証処理でOIDCトークンを検証する
+// issuerは署名検証前のトークンから取り出した値のため、振り分けにのみ使用する
+func (uc *AuthUseCase) AuthenticateOIDC(ctx context.Context, issuer, token string) (*domain.UserInfo, error) {
+	authenticator, ok := uc.oidcAuthenticators[issuer]
+	if !ok {
+		return nil, fmt.Errorf("%w: issuer=%s", ErrOIDCIssuerNotConfigured, issuer)
+	}
+	return authenticator.Authenticate(ctx, token)
 }
 
-func (uc *AuthUseCase) AuthenticateGitHubOIDC(ctx context.Context, token string) (*domain.UserInfo, error) {
-	if uc.githubOIDCUseCase == nil {
-		return nil, ErrGitHubOIDCNotConfigured
+// AuthenticateAccessToken はcargoholdが発行したアクセストークンを検証する
+func (uc *AuthUseCase) AuthenticateAccessToken(ctx context.Context, secret string, repository *domain.RepositoryIdentifier) (*domain.UserInfo, error) {
+	if uc.accessTokenAuthenticator == nil {
+		return nil, ErrInvalidAccessToken
 	}
-	return uc.githubOIDCUseCase.Authenticate(ctx, token)
+	return uc.accessTokenAuthenticator.Authenticate(ctx, secret, repository)
 }
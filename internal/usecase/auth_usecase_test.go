@@ -101,9 +101,9 @@ func TestAuthUseCase_AuthenticateSession(t *testing.T) {
 			defer ctrl.Finish()
 
 			mocks := tt.setupMocks(ctrl)
-			uc := usecase.NewAuthUseCase(nil, nil, mocks.sessionClient)
+			uc := usecase.NewAuthUseCase(nil, usecase.NewSessionAuthUseCase(mocks.sessionClient, nil, nil, 0), nil)
 
-			got, err := uc.AuthenticateSession(ctx, tt.args.sessionID)
+			got, err := uc.AuthenticateSession(ctx, tt.args.sessionID, nil)
 
 			if tt.wantErr != nil {
 				if err == nil {
@@ -124,7 +124,144 @@ func TestAuthUseCase_AuthenticateSession(t *testing.T) {
 	}
 }
 
-func TestAuthUseCase_AuthenticateGitHubOIDC(t *testing.T) {
+func TestAuthUseCase_AuthenticateOIDC(t *testing.T) {
+	ownerRepo, _ := domain.NewRepositoryIdentifier("owner/repo")
+	groupRepo, _ := domain.NewRepositoryIdentifier("group/project")
+
+	type args struct {
+		issuer string
+		token  string
+	}
+	tests := []struct {
+		name       string
+		setupMocks func(ctrl *gomock.Controller) map[string]usecase.OIDCAuthenticator
+		args       args
+		want       *domain.UserInfo
+		wantErr    error
+	}{
+		{
+			name: "正常系: GitHub Actionsのissuerの場合、GitHub向けの認証処理で検証される",
+			setupMocks: func(ctrl *gomock.Controller) map[string]usecase.OIDCAuthenticator {
+				github := mock_usecase.NewMockOIDCAuthenticator(ctrl)
+				github.EXPECT().Authenticate(gomock.Any(), "github-token").Return(
+					mustNewUserInfo(t, "repo:owner/repo:ref:refs/heads/main", "", "github-actor", domain.ProviderTypeGitHub, ownerRepo, "refs/heads/main"), nil)
+				gitlab := mock_usecase.NewMockOIDCAuthenticator(ctrl)
+				return map[string]usecase.OIDCAuthenticator{
+					domain.GitHubActionsIssuer: github,
+					"https://gitlab.com":       gitlab,
+				}
+			},
+			args: args{issuer: domain.GitHubActionsIssuer, token: "github-token"},
+			want: mustNewUserInfo(t, "repo:owner/repo:ref:refs/heads/main", "", "github-actor", domain.ProviderTypeGitHub, ownerRepo, "refs/heads/main"),
+		},
+		{
+			name: "正常系: 追加したissuerの場合、そのissuerの認証処理で検証される",
+			setupMocks: func(ctrl *gomock.Controller) map[string]usecase.OIDCAuthenticator {
+				github := mock_usecase.NewMockOIDCAuthenticator(ctrl)
+				gitlab := mock_usecase.NewMockOIDCAuthenticator(ctrl)
+				gitlab.EXPECT().Authenticate(gomock.Any(), "gitlab-token").Return(
+					mustNewUserInfo(t, "project_path:group/project", "", "gitlab-user", domain.ProviderTypeGitLab, groupRepo, "refs/heads/main"), nil)
+				return map[string]usecase.OIDCAuthenticator{
+					domain.GitHubActionsIssuer: github,
+					"https://gitlab.com":       gitlab,
+				}
+			},
+			args: args{issuer: "https://gitlab.com", token: "gitlab-token"},
+			want: mustNewUserInfo(t, "project_path:group/project", "", "gitlab-user", domain.ProviderTypeGitLab, groupRepo, "refs/heads/main"),
+		},
+		{
+			name: "異常系: 認証処理が失敗した場合、エラーを返す",
+			setupMocks: func(ctrl *gomock.Controller) map[string]usecase.OIDCAuthenticator {
+				github := mock_usecase.NewMockOIDCAuthenticator(ctrl)
+				github.EXPECT().Authenticate(gomock.Any(), "invalid-token").Return(nil, usecase.ErrInvalidRepository)
+				return map[string]usecase.OIDCAuthenticator{domain.GitHubActionsIssuer: github}
+			},
+			args:    args{issuer: domain.GitHubActionsIssuer, token: "invalid-token"},
+			wantErr: usecase.ErrInvalidRepository,
+		},
+		{
+			name: "異常系: 設定されていないissuerの場合、ErrOIDCIssuerNotConfigured",
+			setupMocks: func(ctrl *gomock.Controller) map[string]usecase.OIDCAuthenticator {
+				return map[string]usecase.OIDCAuthenticator{domain.GitHubActionsIssuer: mock_usecase.NewMockOIDCAuthenticator(ctrl)}
+			},
+			args:    args{issuer: "https://unknown.example.com", token: "any-token"},
+			wantErr: usecase.ErrOIDCIssuerNotConfigured,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			ctrl := gomock.NewController(t)
+
+			uc := usecase.NewAuthUseCase(tt.setupMocks(ctrl), nil, nil)
+
+			got, err := uc.AuthenticateOIDC(ctx, tt.args.issuer, tt.args.token)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("AuthenticateOIDC() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				if got != nil {
+					t.Errorf("AuthenticateOIDC() got = %v, want nil", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("AuthenticateOIDC() unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(domain.UserInfo{}, domain.ProviderType{}, domain.RepositoryIdentifier{})); diff != "" {
+				t.Errorf("AuthenticateOIDC() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAuthUseCase_AuthenticateAccessToken(t *testing.T) {
+	ownerRepo, _ := domain.NewRepositoryIdentifier("owner/repo")
+
+	tests := []struct {
+		name       string
+		setupMocks func(ctrl *gomock.Controller) usecase.AccessTokenAuthenticator
+		want       *domain.UserInfo
+		wantErr    error
+	}{
+		{
+			name: "正常系: アクセストークンの認証処理の結果を返す",
+			setupMocks: func(ctrl *gomock.Controller) usecase.AccessTokenAuthenticator {
+				authenticator := mock_usecase.NewMockAccessTokenAuthenticator(ctrl)
+				authenticator.EXPECT().Authenticate(gomock.Any(), "cht_secret", ownerRepo).Return(
+					mustNewUserInfo(t, "12345", "", "build-farm", domain.ProviderTypeAccessToken, ownerRepo, ""), nil)
+				return authenticator
+			},
+			want: mustNewUserInfo(t, "12345", "", "build-farm", domain.ProviderTypeAccessToken, ownerRepo, ""),
+		},
+		{
+			name: "異常系: アクセストークンの認証処理が設定されていない場合、ErrInvalidAccessToken",
+			setupMocks: func(ctrl *gomock.Controller) usecase.AccessTokenAuthenticator {
+				return nil
+			},
+			wantErr: usecase.ErrInvalidAccessToken,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			uc := usecase.NewAuthUseCase(nil, nil, tt.setupMocks(ctrl))
+
+			got, err := uc.AuthenticateAccessToken(context.Background(), "cht_secret", ownerRepo)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("AuthenticateAccessToken() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(domain.UserInfo{}, domain.ProviderType{}, domain.RepositoryIdentifier{})); diff != "" {
+				t.Errorf("AuthenticateAccessToken() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewAuthUseCase_GitHubOIDC(t *testing.T) {
 	ownerRepo, _ := domain.NewRepositoryIdentifier("owner/repo")
 
 	type args struct {
@@ -201,62 +338,60 @@ func TestAuthUseCase_AuthenticateGitHubOIDC(t *testing.T) {
 
 			githubProvider := tt.fields.setupGitHubProvider(ctrl)
 			repoAllowlist := tt.fields.setupRepoAllowlist(ctrl)
-			uc := usecase.NewAuthUseCase(githubProvider, repoAllowlist, nil)
+			uc := usecase.NewAuthUseCase(map[string]usecase.OIDCAuthenticator{
+				domain.GitHubActionsIssuer: usecase.NewGitHubOIDCUseCase(githubProvider, repoAllowlist, nil),
+			}, nil, nil)
 
-			got, err := uc.AuthenticateGitHubOIDC(ctx, tt.args.token)
+			got, err := uc.AuthenticateOIDC(ctx, domain.GitHubActionsIssuer, tt.args.token)
 
 			if tt.wantErrMsg != "" {
 				if err == nil {
-					t.Fatalf("AuthenticateGitHubOIDC() error = nil, wantErrMsg %q", tt.wantErrMsg)
+					t.Fatalf("AuthenticateOIDC() error = nil, wantErrMsg %q", tt.wantErrMsg)
 				}
 				if err.Error() != tt.wantErrMsg {
-					t.Errorf("AuthenticateGitHubOIDC() error = %q, wantErrMsg %q", err.Error(), tt.wantErrMsg)
+					t.Errorf("AuthenticateOIDC() error = %q, wantErrMsg %q", err.Error(), tt.wantErrMsg)
 				}
 			} else {
 				if err != nil {
-					t.Fatalf("AuthenticateGitHubOIDC() unexpected error: %v", err)
+					t.Fatalf("AuthenticateOIDC() unexpected error: %v", err)
 				}
 				if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(domain.UserInfo{}, domain.ProviderType{}, domain.RepositoryIdentifier{}, domain.RepositoryPermissions{})); diff != "" {
-					t.Errorf("AuthenticateGitHubOIDC() mismatch (-want +got):\n%s", diff)
+					t.Errorf("AuthenticateOIDC() mismatch (-want +got):\n%s", diff)
 				}
 			}
 		})
 	}
 }
 
-func TestAuthUseCase_AuthenticateGitHubOIDC_NotConfigured(t *testing.T) {
+func TestNewAuthUseCase_GitHubOIDCNotConfigured(t *testing.T) {
 	tests := []struct {
 		name    string
 		args    string
 		wantErr error
 	}{
 		{
-			name:    "異常系: GitHub OIDCが設定されていない場合、ErrGitHubOIDCNotConfigured",
+			name:    "異常系: GitHub OIDCが設定されていない場合、ErrOIDCIssuerNotConfigured",
 			args:    "any-token",
-			wantErr: usecase.ErrGitHubOIDCNotConfigured,
+			wantErr: usecase.ErrOIDCIssuerNotConfigured,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			ctx := context.Background()
-			ctrl := gomock.NewController(t)
-			defer ctrl.Finish()
-
-			sessionClient := mock_usecase.NewMockSessionClient(ctrl)
-			uc := usecase.NewAuthUseCase(nil, nil, sessionClient)
+			uc := usecase.NewAuthUseCase(nil, nil, nil)
 
-			got, err := uc.AuthenticateGitHubOIDC(ctx, tt.args)
+			got, err := uc.AuthenticateOIDC(ctx, domain.GitHubActionsIssuer, tt.args)
 
 			if tt.wantErr != nil {
 				if err == nil {
-					t.Fatalf("AuthenticateGitHubOIDC() error = nil, wantErr %v", tt.wantErr)
+					t.Fatalf("AuthenticateOIDC() error = nil, wantErr %v", tt.wantErr)
 				}
 				if !errors.Is(err, tt.wantErr) {
-					t.Errorf("AuthenticateGitHubOIDC() error = %v, wantErr %v", err, tt.wantErr)
+					t.Errorf("AuthenticateOIDC() error = %v, wantErr %v", err, tt.wantErr)
 				}
 			}
 			if got != nil {
-				t.Errorf("AuthenticateGitHubOIDC() got = %v, want nil", got)
+				t.Errorf("AuthenticateOIDC() got = %v, want nil", got)
 			}
 		})
 	}
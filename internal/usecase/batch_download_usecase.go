@@ -3,6 +3,7 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/na2na-p/cargohold/internal/domain"
@@ -15,15 +16,18 @@ type BatchDownloadUseCase interface {
 type batchDownloadUseCaseImpl struct {
 	downloadUseCase DownloadUseCase
 	authService     domain.AccessAuthorizationService
+	repo            domain.LFSObjectRepository
 }
 
 func NewBatchDownloadUseCase(
 	downloadUseCase DownloadUseCase,
 	authService domain.AccessAuthorizationService,
+	repo domain.LFSObjectRepository,
 ) BatchDownloadUseCase {
 	return &batchDownloadUseCaseImpl{
 		downloadUseCase: downloadUseCase,
 		authService:     authService,
+		repo:            repo,
 	}
 }
 
@@ -36,35 +40,56 @@ func (uc *batchDownloadUseCaseImpl) HandleBatchDownload(ctx context.Context, bas
 		return BatchResponse{}, ErrInvalidOperation
 	}
 
+	if req.Repository() == nil {
+		return BatchResponse{}, ErrAccessDenied
+	}
+
 	hashAlgo := req.HashAlgo()
 	if hashAlgo == "" {
 		hashAlgo = DefaultHashAlgorithm
 	}
 
-	objects := make([]ResponseObject, 0, len(req.Objects()))
+	oids, sizes, err := parseBatchObjects(req.Objects())
+	if err != nil {
+		return BatchResponse{}, err
+	}
 
-	for _, reqObj := range req.Objects() {
-		oid, err := domain.NewOID(reqObj.OID())
-		if err != nil {
-			return BatchResponse{}, fmt.Errorf("無効なOID: %w", err)
+	targets := uniqueOIDs(oids)
+	authResults, authErr := uc.authService.AuthorizeAll(ctx, domain.OperationDownload, req.Repository(), targets)
+	if authErr != nil {
+		if errors.Is(authErr, domain.ErrInvalidRepositoryIdentifier) {
+			return BatchResponse{}, ErrAccessDenied
 		}
-		size, err := domain.NewSize(reqObj.Size())
-		if err != nil {
-			return BatchResponse{}, fmt.Errorf("無効なサイズ: %w", err)
+		// オブジェクトごとの問題ではないため、リクエスト全体を失敗させる
+		return BatchResponse{}, fmt.Errorf("認可判定に失敗しました: %w", authErr)
+	}
+
+	allowed := make([]domain.OID, 0, len(targets))
+	for _, oid := range targets {
+		if authResults[oid].Allowed {
+			allowed = append(allowed, oid)
 		}
+	}
 
-		authResult, err := CheckAuthorization(ctx, uc.authService, domain.OperationDownload, req.Repository(), oid)
+	var lfsObjects map[domain.OID]*domain.LFSObject
+	if len(allowed) > 0 {
+		lfsObjects, err = uc.repo.FindByOIDs(ctx, allowed)
 		if err != nil {
-			return BatchResponse{}, err
+			return BatchResponse{}, fmt.Errorf("メタデータの取得に失敗しました: %w", err)
 		}
+	}
 
-		if !authResult.Allowed {
-			return BatchResponse{}, ErrAccessDenied
+	objects := processBatchObjects(oids, func(i int) ResponseObject {
+		oid, size := oids[i], sizes[i]
+
+		// 他リポジトリのオブジェクトの存在を開示しないよう、未登録と同じ404を返す
+		if !authResults[oid].Allowed {
+			objectError := NewObjectError(404, "オブジェクトが存在しません")
+			return NewResponseObject(oid.String(), size.Int64(), false, nil, &objectError)
 		}
 
-		respObj := uc.downloadUseCase.HandleDownloadObject(ctx, baseURL, owner, repo, oid, size, authHeader)
-		objects = append(objects, respObj)
-	}
+		return uc.downloadUseCase.HandleDownloadObject(ctx, baseURL, owner, repo, oid, size, lfsObjects[oid], authHeader)
+	})
 
 	return NewBatchResponse(DefaultTransferType, objects, hashAlgo), nil
 }
@@ -16,12 +16,18 @@ import (
 
 func TestBatchDownloadUseCase_HandleBatchDownload(t *testing.T) {
 	testOID := "1234567890123456789012345678901234567890123456789012345678901234"
+	foreignTestOID := "abcdefabcdefabcdefabcdefabcdefabcdefabcdefabcdefabcdefabcdefabcd"
 	testRepo, _ := domain.NewRepositoryIdentifier("owner/repo")
-	otherRepo, _ := domain.NewRepositoryIdentifier("other/repo")
+	errDatabase := errors.New("database error")
+	storedOID, _ := domain.NewOID(testOID)
+	storedSize, _ := domain.NewSize(1024)
+	hashAlgo, _ := domain.NewHashAlgorithm("sha256")
+	storedObj, _ := domain.NewLFSObject(context.Background(), storedOID, storedSize, hashAlgo, "objects/sha256/12/34/"+testOID)
 
 	type fields struct {
 		downloadUseCase func(ctrl *gomock.Controller) usecase.DownloadUseCase
 		policyRepo      func(ctrl *gomock.Controller) domain.AccessPolicyRepository
+		repo            func(ctrl *gomock.Controller) domain.LFSObjectRepository
 	}
 	type args struct {
 		ctx        context.Context
@@ -44,8 +50,8 @@ func TestBatchDownloadUseCase_HandleBatchDownload(t *testing.T) {
 				downloadUseCase: func(ctrl *gomock.Controller) usecase.DownloadUseCase {
 					mock := mock_usecase.NewMockDownloadUseCase(ctrl)
 					downloadAction := usecase.NewAction("https://s3.example.com/presigned-get-url", nil, 900)
-					actions := usecase.NewActions(nil, &downloadAction)
-					mock.EXPECT().HandleDownloadObject(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(
+					actions := usecase.NewActions(nil, &downloadAction, nil)
+					mock.EXPECT().HandleDownloadObject(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), storedObj, gomock.Any()).Return(
 						usecase.NewResponseObject(testOID, 1024, true, &actions, nil),
 					)
 					return mock
@@ -55,7 +61,12 @@ func TestBatchDownloadUseCase_HandleBatchDownload(t *testing.T) {
 					objOID, _ := domain.NewOID(testOID)
 					policyID, _ := domain.NewAccessPolicyID(1)
 					policy := domain.NewAccessPolicy(policyID, objOID, testRepo, time.Now())
-					mock.EXPECT().FindByOID(gomock.Any(), gomock.Any()).Return(policy, nil)
+					mock.EXPECT().FindByOIDsAndRepository(gomock.Any(), []domain.OID{objOID}, testRepo).Return(map[domain.OID]*domain.AccessPolicy{objOID: policy}, nil)
+					return mock
+				},
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().FindByOIDs(gomock.Any(), []domain.OID{storedOID}).Return(map[domain.OID]*domain.LFSObject{storedOID: storedObj}, nil)
 					return mock
 				},
 			},
@@ -75,7 +86,7 @@ func TestBatchDownloadUseCase_HandleBatchDownload(t *testing.T) {
 			},
 			want: func() usecase.BatchResponse {
 				downloadAction := usecase.NewAction("https://s3.example.com/presigned-get-url", nil, 900)
-				actions := usecase.NewActions(nil, &downloadAction)
+				actions := usecase.NewActions(nil, &downloadAction, nil)
 				return usecase.NewBatchResponse(
 					"basic",
 					[]usecase.ResponseObject{usecase.NewResponseObject(testOID, 1024, true, &actions, nil)},
@@ -85,7 +96,7 @@ func TestBatchDownloadUseCase_HandleBatchDownload(t *testing.T) {
 			wantErr: nil,
 		},
 		{
-			name: "異常系: Download操作で認可失敗（別リポジトリ）の場合、ErrAccessDeniedが返る",
+			name: "異常系: Download操作で他リポジトリにのみ紐付いている場合、オブジェクト単位の404エラーが返る",
 			fields: fields{
 				downloadUseCase: func(ctrl *gomock.Controller) usecase.DownloadUseCase {
 					return mock_usecase.NewMockDownloadUseCase(ctrl)
@@ -93,11 +104,12 @@ func TestBatchDownloadUseCase_HandleBatchDownload(t *testing.T) {
 				policyRepo: func(ctrl *gomock.Controller) domain.AccessPolicyRepository {
 					mock := mock_domain.NewMockAccessPolicyRepository(ctrl)
 					objOID, _ := domain.NewOID(testOID)
-					policyID, _ := domain.NewAccessPolicyID(1)
-					policy := domain.NewAccessPolicy(policyID, objOID, otherRepo, time.Now())
-					mock.EXPECT().FindByOID(gomock.Any(), gomock.Any()).Return(policy, nil)
+					mock.EXPECT().FindByOIDsAndRepository(gomock.Any(), []domain.OID{objOID}, testRepo).Return(map[domain.OID]*domain.AccessPolicy{}, nil)
 					return mock
 				},
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					return mock_domain.NewMockLFSObjectRepository(ctrl)
+				},
 			},
 			args: args{
 				ctx:     context.Background(),
@@ -113,20 +125,30 @@ func TestBatchDownloadUseCase_HandleBatchDownload(t *testing.T) {
 					testRepo,
 				),
 			},
-			want:    usecase.BatchResponse{},
-			wantErr: usecase.ErrAccessDenied,
+			want: func() usecase.BatchResponse {
+				objectError := usecase.NewObjectError(404, "オブジェクトが存在しません")
+				return usecase.NewBatchResponse(
+					"basic",
+					[]usecase.ResponseObject{usecase.NewResponseObject(testOID, 1024, false, nil, &objectError)},
+					"sha256",
+				)
+			}(),
+			wantErr: nil,
 		},
 		{
-			name: "異常系: Download操作でポリシー未存在の場合、ErrAccessDeniedが返る",
+			name: "異常系: Download操作でポリシー未存在の場合、オブジェクト単位の404エラーが返る",
 			fields: fields{
 				downloadUseCase: func(ctrl *gomock.Controller) usecase.DownloadUseCase {
 					return mock_usecase.NewMockDownloadUseCase(ctrl)
 				},
 				policyRepo: func(ctrl *gomock.Controller) domain.AccessPolicyRepository {
 					mock := mock_domain.NewMockAccessPolicyRepository(ctrl)
-					mock.EXPECT().FindByOID(gomock.Any(), gomock.Any()).Return(nil, nil)
+					mock.EXPECT().FindByOIDsAndRepository(gomock.Any(), gomock.Any(), testRepo).Return(map[domain.OID]*domain.AccessPolicy{}, nil)
 					return mock
 				},
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					return mock_domain.NewMockLFSObjectRepository(ctrl)
+				},
 			},
 			args: args{
 				ctx:     context.Background(),
@@ -142,8 +164,203 @@ func TestBatchDownloadUseCase_HandleBatchDownload(t *testing.T) {
 					testRepo,
 				),
 			},
-			want:    usecase.BatchResponse{},
-			wantErr: usecase.ErrAccessDenied,
+			want: func() usecase.BatchResponse {
+				objectError := usecase.NewObjectError(404, "オブジェクトが存在しません")
+				return usecase.NewBatchResponse(
+					"basic",
+					[]usecase.ResponseObject{usecase.NewResponseObject(testOID, 1024, false, nil, &objectError)},
+					"sha256",
+				)
+			}(),
+			wantErr: nil,
+		},
+		{
+			name: "正常系: 一部のオブジェクトのみ認可失敗の場合、リクエスト全体は成功し該当オブジェクトのみ404エラーになる",
+			fields: fields{
+				downloadUseCase: func(ctrl *gomock.Controller) usecase.DownloadUseCase {
+					mock := mock_usecase.NewMockDownloadUseCase(ctrl)
+					downloadAction := usecase.NewAction("https://s3.example.com/presigned-get-url", nil, 900)
+					actions := usecase.NewActions(nil, &downloadAction, nil)
+					mock.EXPECT().HandleDownloadObject(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), storedObj, gomock.Any()).Return(
+						usecase.NewResponseObject(testOID, 1024, true, &actions, nil),
+					)
+					return mock
+				},
+				policyRepo: func(ctrl *gomock.Controller) domain.AccessPolicyRepository {
+					mock := mock_domain.NewMockAccessPolicyRepository(ctrl)
+					objOID, _ := domain.NewOID(testOID)
+					foreignOID, _ := domain.NewOID(foreignTestOID)
+					policyID, _ := domain.NewAccessPolicyID(1)
+					mock.EXPECT().FindByOIDsAndRepository(gomock.Any(), []domain.OID{objOID, foreignOID}, testRepo).Return(map[domain.OID]*domain.AccessPolicy{
+						objOID: domain.NewAccessPolicy(policyID, objOID, testRepo, time.Now()),
+					}, nil)
+					return mock
+				},
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().FindByOIDs(gomock.Any(), []domain.OID{storedOID}).Return(map[domain.OID]*domain.LFSObject{storedOID: storedObj}, nil)
+					return mock
+				},
+			},
+			args: args{
+				ctx:     context.Background(),
+				baseURL: "http://localhost:8080",
+				owner:   "owner",
+				repo:    "repo",
+				req: usecase.NewBatchRequest(
+					domain.OperationDownload,
+					[]usecase.RequestObject{
+						usecase.NewRequestObject(testOID, 1024),
+						usecase.NewRequestObject(foreignTestOID, 2048),
+					},
+					[]string{"basic"},
+					nil,
+					"sha256",
+					testRepo,
+				),
+			},
+			want: func() usecase.BatchResponse {
+				downloadAction := usecase.NewAction("https://s3.example.com/presigned-get-url", nil, 900)
+				actions := usecase.NewActions(nil, &downloadAction, nil)
+				objectError := usecase.NewObjectError(404, "オブジェクトが存在しません")
+				return usecase.NewBatchResponse(
+					"basic",
+					[]usecase.ResponseObject{
+						usecase.NewResponseObject(testOID, 1024, true, &actions, nil),
+						usecase.NewResponseObject(foreignTestOID, 2048, false, nil, &objectError),
+					},
+					"sha256",
+				)
+			}(),
+			wantErr: nil,
+		},
+		{
+			name: "異常系: ポリシー取得でエラーが発生した場合、リクエスト全体のエラーが返る",
+			fields: fields{
+				downloadUseCase: func(ctrl *gomock.Controller) usecase.DownloadUseCase {
+					return mock_usecase.NewMockDownloadUseCase(ctrl)
+				},
+				policyRepo: func(ctrl *gomock.Controller) domain.AccessPolicyRepository {
+					mock := mock_domain.NewMockAccessPolicyRepository(ctrl)
+					mock.EXPECT().FindByOIDsAndRepository(gomock.Any(), gomock.Any(), testRepo).Return(nil, errDatabase)
+					return mock
+				},
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					return mock_domain.NewMockLFSObjectRepository(ctrl)
+				},
+			},
+			args: args{
+				ctx:     context.Background(),
+				baseURL: "http://localhost:8080",
+				owner:   "owner",
+				repo:    "repo",
+				req: usecase.NewBatchRequest(
+					domain.OperationDownload,
+					[]usecase.RequestObject{usecase.NewRequestObject(testOID, 1024)},
+					[]string{"basic"},
+					nil,
+					"sha256",
+					testRepo,
+				),
+			},
+			wantErr: errDatabase,
+		},
+		{
+			name: "異常系: メタデータの一括取得でエラーが発生した場合、リクエスト全体のエラーが返る",
+			fields: fields{
+				downloadUseCase: func(ctrl *gomock.Controller) usecase.DownloadUseCase {
+					return mock_usecase.NewMockDownloadUseCase(ctrl)
+				},
+				policyRepo: func(ctrl *gomock.Controller) domain.AccessPolicyRepository {
+					mock := mock_domain.NewMockAccessPolicyRepository(ctrl)
+					foreignOID, _ := domain.NewOID(foreignTestOID)
+					policyID, _ := domain.NewAccessPolicyID(1)
+					mock.EXPECT().FindByOIDsAndRepository(gomock.Any(), []domain.OID{storedOID, foreignOID}, testRepo).Return(map[domain.OID]*domain.AccessPolicy{
+						storedOID: domain.NewAccessPolicy(policyID, storedOID, testRepo, time.Now()),
+					}, nil)
+					return mock
+				},
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().FindByOIDs(gomock.Any(), []domain.OID{storedOID}).Return(nil, errDatabase)
+					return mock
+				},
+			},
+			args: args{
+				ctx:     context.Background(),
+				baseURL: "http://localhost:8080",
+				owner:   "owner",
+				repo:    "repo",
+				req: usecase.NewBatchRequest(
+					domain.OperationDownload,
+					[]usecase.RequestObject{
+						usecase.NewRequestObject(testOID, 1024),
+						usecase.NewRequestObject(foreignTestOID, 2048),
+					},
+					[]string{"basic"},
+					nil,
+					"sha256",
+					testRepo,
+				),
+			},
+			wantErr: errDatabase,
+		},
+		{
+			name: "正常系: 同一OIDが重複している場合、1度だけ処理され同じ結果がリクエスト順に返る",
+			fields: fields{
+				downloadUseCase: func(ctrl *gomock.Controller) usecase.DownloadUseCase {
+					mock := mock_usecase.NewMockDownloadUseCase(ctrl)
+					downloadAction := usecase.NewAction("https://s3.example.com/presigned-get-url", nil, 900)
+					actions := usecase.NewActions(nil, &downloadAction, nil)
+					mock.EXPECT().HandleDownloadObject(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), storedObj, gomock.Any()).Return(
+						usecase.NewResponseObject(testOID, 1024, true, &actions, nil),
+					).Times(1)
+					return mock
+				},
+				policyRepo: func(ctrl *gomock.Controller) domain.AccessPolicyRepository {
+					mock := mock_domain.NewMockAccessPolicyRepository(ctrl)
+					policyID, _ := domain.NewAccessPolicyID(1)
+					mock.EXPECT().FindByOIDsAndRepository(gomock.Any(), []domain.OID{storedOID}, testRepo).Return(map[domain.OID]*domain.AccessPolicy{
+						storedOID: domain.NewAccessPolicy(policyID, storedOID, testRepo, time.Now()),
+					}, nil)
+					return mock
+				},
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().FindByOIDs(gomock.Any(), []domain.OID{storedOID}).Return(map[domain.OID]*domain.LFSObject{storedOID: storedObj}, nil)
+					return mock
+				},
+			},
+			args: args{
+				ctx:     context.Background(),
+				baseURL: "http://localhost:8080",
+				owner:   "owner",
+				repo:    "repo",
+				req: usecase.NewBatchRequest(
+					domain.OperationDownload,
+					[]usecase.RequestObject{
+						usecase.NewRequestObject(testOID, 1024),
+						usecase.NewRequestObject(testOID, 1024),
+					},
+					[]string{"basic"},
+					nil,
+					"sha256",
+					testRepo,
+				),
+			},
+			want: func() usecase.BatchResponse {
+				downloadAction := usecase.NewAction("https://s3.example.com/presigned-get-url", nil, 900)
+				actions := usecase.NewActions(nil, &downloadAction, nil)
+				return usecase.NewBatchResponse(
+					"basic",
+					[]usecase.ResponseObject{
+						usecase.NewResponseObject(testOID, 1024, true, &actions, nil),
+						usecase.NewResponseObject(testOID, 1024, true, &actions, nil),
+					},
+					"sha256",
+				)
+			}(),
+			wantErr: nil,
 		},
 		{
 			name: "異常系: Repositoryがnilの場合、ErrAccessDeniedが返る",
@@ -154,6 +371,9 @@ func TestBatchDownloadUseCase_HandleBatchDownload(t *testing.T) {
 				policyRepo: func(ctrl *gomock.Controller) domain.AccessPolicyRepository {
 					return mock_domain.NewMockAccessPolicyRepository(ctrl)
 				},
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					return mock_domain.NewMockLFSObjectRepository(ctrl)
+				},
 			},
 			args: args{
 				ctx:     context.Background(),
@@ -181,6 +401,9 @@ func TestBatchDownloadUseCase_HandleBatchDownload(t *testing.T) {
 				policyRepo: func(ctrl *gomock.Controller) domain.AccessPolicyRepository {
 					return mock_domain.NewMockAccessPolicyRepository(ctrl)
 				},
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					return mock_domain.NewMockLFSObjectRepository(ctrl)
+				},
 			},
 			args: args{
 				ctx:     context.Background(),
@@ -208,6 +431,9 @@ func TestBatchDownloadUseCase_HandleBatchDownload(t *testing.T) {
 				policyRepo: func(ctrl *gomock.Controller) domain.AccessPolicyRepository {
 					return mock_domain.NewMockAccessPolicyRepository(ctrl)
 				},
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					return mock_domain.NewMockLFSObjectRepository(ctrl)
+				},
 			},
 			args: args{
 				ctx:     context.Background(),
@@ -235,6 +461,9 @@ func TestBatchDownloadUseCase_HandleBatchDownload(t *testing.T) {
 				policyRepo: func(ctrl *gomock.Controller) domain.AccessPolicyRepository {
 					return mock_domain.NewMockAccessPolicyRepository(ctrl)
 				},
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					return mock_domain.NewMockLFSObjectRepository(ctrl)
+				},
 			},
 			args: args{
 				ctx:     context.Background(),
@@ -262,6 +491,9 @@ func TestBatchDownloadUseCase_HandleBatchDownload(t *testing.T) {
 				policyRepo: func(ctrl *gomock.Controller) domain.AccessPolicyRepository {
 					return mock_domain.NewMockAccessPolicyRepository(ctrl)
 				},
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					return mock_domain.NewMockLFSObjectRepository(ctrl)
+				},
 			},
 			args: args{
 				ctx:     context.Background(),
@@ -291,6 +523,7 @@ func TestBatchDownloadUseCase_HandleBatchDownload(t *testing.T) {
 			uc := usecase.NewBatchDownloadUseCase(
 				tt.fields.downloadUseCase(ctrl),
 				authService,
+				tt.fields.repo(ctrl),
 			)
 
 			got, err := uc.HandleBatchDownload(tt.args.ctx, tt.args.baseURL, tt.args.owner, tt.args.repo, tt.args.req, tt.args.authHeader)
@@ -179,12 +179,14 @@ func (r *ResponseObject) UnmarshalJSON(data []byte) error {
 type Actions struct {
 	upload   *Action
 	download *Action
+	verify   *Action
 }
 
-func NewActions(upload *Action, download *Action) Actions {
+func NewActions(upload *Action, download *Action, verify *Action) Actions {
 	return Actions{
 		upload:   upload,
 		download: download,
+		verify:   verify,
 	}
 }
 
@@ -196,9 +198,14 @@ func (a Actions) Download() *Action {
 	return a.download
 }
 
+func (a Actions) Verify() *Action {
+	return a.verify
+}
+
 type actionsJSON struct {
 	Upload   *actionJSON `json:"upload,omitempty"`
 	Download *actionJSON `json:"download,omitempty"`
+	Verify   *actionJSON `json:"verify,omitempty"`
 }
 
 func (a Actions) toJSON() actionsJSON {
@@ -214,9 +221,16 @@ func (a Actions) toJSON() actionsJSON {
 		download = &dj
 	}
 
+	var verify *actionJSON
+	if a.verify != nil {
+		vj := a.verify.toJSON()
+		verify = &vj
+	}
+
 	return actionsJSON{
 		Upload:   upload,
 		Download: download,
+		Verify:   verify,
 	}
 }
 
@@ -233,9 +247,16 @@ func (a actionsJSON) toActions() Actions {
 		download = &d
 	}
 
+	var verify *Action
+	if a.Verify != nil {
+		v := a.Verify.toAction()
+		verify = &v
+	}
+
 	return Actions{
 		upload:   upload,
 		download: download,
+		verify:   verify,
 	}
 }
 
@@ -3,7 +3,9 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
 
 	"github.com/na2na-p/cargohold/internal/domain"
 	"github.com/newmo-oss/ctxtime"
@@ -17,17 +19,20 @@ type batchUploadUseCaseImpl struct {
 	uploadUseCase UploadUseCase
 	authService   domain.AccessAuthorizationService
 	policyRepo    domain.AccessPolicyRepository
+	repo          domain.LFSObjectRepository
 }
 
 func NewBatchUploadUseCase(
 	uploadUseCase UploadUseCase,
 	authService domain.AccessAuthorizationService,
 	policyRepo domain.AccessPolicyRepository,
+	repo domain.LFSObjectRepository,
 ) BatchUploadUseCase {
 	return &batchUploadUseCaseImpl{
 		uploadUseCase: uploadUseCase,
 		authService:   authService,
 		policyRepo:    policyRepo,
+		repo:          repo,
 	}
 }
 
@@ -40,41 +45,70 @@ func (uc *batchUploadUseCaseImpl) HandleBatchUpload(ctx context.Context, baseURL
 		return BatchResponse{}, ErrInvalidOperation
 	}
 
+	if req.Repository() == nil {
+		return BatchResponse{}, ErrAccessDenied
+	}
+
 	hashAlgo := req.HashAlgo()
 	if hashAlgo == "" {
 		hashAlgo = DefaultHashAlgorithm
 	}
 
-	objects := make([]ResponseObject, 0, len(req.Objects()))
+	oids, sizes, err := parseBatchObjects(req.Objects())
+	if err != nil {
+		return BatchResponse{}, err
+	}
 
-	for _, reqObj := range req.Objects() {
-		oid, err := domain.NewOID(reqObj.OID())
-		if err != nil {
-			return BatchResponse{}, fmt.Errorf("無効なOID: %w", err)
+	targets := uniqueOIDs(oids)
+	authResults, authErr := uc.authService.AuthorizeAll(ctx, domain.OperationUpload, req.Repository(), targets)
+	if authErr != nil {
+		if errors.Is(authErr, domain.ErrInvalidRepositoryIdentifier) {
+			return BatchResponse{}, ErrAccessDenied
 		}
-		size, err := domain.NewSize(reqObj.Size())
-		if err != nil {
-			return BatchResponse{}, fmt.Errorf("無効なサイズ: %w", err)
+		// オブジェクトごとの問題ではないため、リクエスト全体を失敗させる
+		return BatchResponse{}, fmt.Errorf("認可判定に失敗しました: %w", authErr)
+	}
+
+	allowed := make([]domain.OID, 0, len(targets))
+	for _, oid := range targets {
+		if authResults[oid].Allowed {
+			allowed = append(allowed, oid)
 		}
+	}
 
-		authResult, err := CheckAuthorization(ctx, uc.authService, domain.OperationUpload, req.Repository(), oid)
+	var lfsObjects map[domain.OID]*domain.LFSObject
+	if len(allowed) > 0 {
+		lfsObjects, err = uc.repo.FindByOIDs(ctx, allowed)
 		if err != nil {
-			return BatchResponse{}, err
+			return BatchResponse{}, fmt.Errorf("メタデータの取得に失敗しました: %w", err)
 		}
+	}
 
+	objects := processBatchObjects(oids, func(i int) ResponseObject {
+		oid, size := oids[i], sizes[i]
+
+		authResult := authResults[oid]
 		if !authResult.Allowed {
-			return BatchResponse{}, ErrAccessDenied
+			objectError := NewObjectError(403, "このオブジェクトへのアクセス権限がありません")
+			return NewResponseObject(oid.String(), size.Int64(), false, nil, &objectError)
 		}
 
-		respObj := uc.uploadUseCase.HandleUploadObject(ctx, baseURL, owner, repo, oid, size, hashAlgo, authHeader)
+		lfsObject := lfsObjects[oid]
+		if authResult.IsNewObject && lfsObject != nil {
+			// 他リポジトリが登録済みのオブジェクトは、内容の所持を証明するまで紐付けを作成しない
+			return uc.uploadUseCase.HandleProofUpload(ctx, baseURL, owner, repo, oid, size, lfsObject, authHeader)
+		}
+
+		respObj := uc.uploadUseCase.HandleUploadObject(ctx, baseURL, owner, repo, oid, size, lfsObject, hashAlgo, authHeader)
 		if authResult.IsNewObject && respObj.Error() == nil {
 			if err := uc.createAccessPolicy(ctx, oid, req.Repository()); err != nil {
-				return BatchResponse{}, fmt.Errorf("アクセスポリシーの作成に失敗しました: %w", err)
+				slog.Warn("アクセスポリシーの作成に失敗しました", "oid", oid.String(), "error", err)
+				objectError := NewObjectError(500, "アクセスポリシーの作成に失敗しました")
+				return NewResponseObject(oid.String(), size.Int64(), false, nil, &objectError)
 			}
 		}
-
-		objects = append(objects, respObj)
-	}
+		return respObj
+	})
 
 	return NewBatchResponse(DefaultTransferType, objects, hashAlgo), nil
 }
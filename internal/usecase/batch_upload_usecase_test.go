@@ -16,12 +16,18 @@ import (
 
 func TestBatchUploadUseCase_HandleBatchUpload(t *testing.T) {
 	testOID := "1234567890123456789012345678901234567890123456789012345678901234"
+	foreignTestOID := "abcdefabcdefabcdefabcdefabcdefabcdefabcdefabcdefabcdefabcdefabcd"
 	testRepo, _ := domain.NewRepositoryIdentifier("owner/repo")
-	otherRepo, _ := domain.NewRepositoryIdentifier("other/repo")
+	errDatabase := errors.New("database error")
+	storedOID, _ := domain.NewOID(testOID)
+	storedSize, _ := domain.NewSize(1024)
+	hashAlgo, _ := domain.NewHashAlgorithm("sha256")
+	storedObj, _ := domain.NewLFSObject(context.Background(), storedOID, storedSize, hashAlgo, "objects/sha256/12/34/"+testOID)
 
 	type fields struct {
 		uploadUseCase func(ctrl *gomock.Controller) usecase.UploadUseCase
 		policyRepo    func(ctrl *gomock.Controller) domain.AccessPolicyRepository
+		repo          func(ctrl *gomock.Controller) domain.LFSObjectRepository
 	}
 	type args struct {
 		ctx        context.Context
@@ -44,18 +50,23 @@ func TestBatchUploadUseCase_HandleBatchUpload(t *testing.T) {
 				uploadUseCase: func(ctrl *gomock.Controller) usecase.UploadUseCase {
 					mock := mock_usecase.NewMockUploadUseCase(ctrl)
 					uploadAction := usecase.NewAction("https://s3.example.com/presigned-put-url", nil, 900)
-					actions := usecase.NewActions(&uploadAction, nil)
-					mock.EXPECT().HandleUploadObject(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(
+					actions := usecase.NewActions(&uploadAction, nil, nil)
+					mock.EXPECT().HandleUploadObject(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Nil(), gomock.Any(), gomock.Any()).Return(
 						usecase.NewResponseObject(testOID, 1024, true, &actions, nil),
 					)
 					return mock
 				},
 				policyRepo: func(ctrl *gomock.Controller) domain.AccessPolicyRepository {
 					mock := mock_domain.NewMockAccessPolicyRepository(ctrl)
-					mock.EXPECT().FindByOID(gomock.Any(), gomock.Any()).Return(nil, nil)
+					mock.EXPECT().FindByOIDsAndRepository(gomock.Any(), []domain.OID{storedOID}, testRepo).Return(map[domain.OID]*domain.AccessPolicy{}, nil)
 					mock.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil)
 					return mock
 				},
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().FindByOIDs(gomock.Any(), []domain.OID{storedOID}).Return(map[domain.OID]*domain.LFSObject{}, nil)
+					return mock
+				},
 			},
 			args: args{
 				ctx:     context.Background(),
@@ -73,7 +84,7 @@ func TestBatchUploadUseCase_HandleBatchUpload(t *testing.T) {
 			},
 			want: func() usecase.BatchResponse {
 				uploadAction := usecase.NewAction("https://s3.example.com/presigned-put-url", nil, 900)
-				actions := usecase.NewActions(&uploadAction, nil)
+				actions := usecase.NewActions(&uploadAction, nil, nil)
 				return usecase.NewBatchResponse(
 					"basic",
 					[]usecase.ResponseObject{usecase.NewResponseObject(testOID, 1024, true, &actions, nil)},
@@ -87,7 +98,7 @@ func TestBatchUploadUseCase_HandleBatchUpload(t *testing.T) {
 			fields: fields{
 				uploadUseCase: func(ctrl *gomock.Controller) usecase.UploadUseCase {
 					mock := mock_usecase.NewMockUploadUseCase(ctrl)
-					mock.EXPECT().HandleUploadObject(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(
+					mock.EXPECT().HandleUploadObject(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), storedObj, gomock.Any(), gomock.Any()).Return(
 						usecase.NewResponseObject(testOID, 1024, true, nil, nil),
 					)
 					return mock
@@ -97,7 +108,12 @@ func TestBatchUploadUseCase_HandleBatchUpload(t *testing.T) {
 					objOID, _ := domain.NewOID(testOID)
 					policyID, _ := domain.NewAccessPolicyID(1)
 					policy := domain.NewAccessPolicy(policyID, objOID, testRepo, time.Now())
-					mock.EXPECT().FindByOID(gomock.Any(), gomock.Any()).Return(policy, nil)
+					mock.EXPECT().FindByOIDsAndRepository(gomock.Any(), []domain.OID{objOID}, testRepo).Return(map[domain.OID]*domain.AccessPolicy{objOID: policy}, nil)
+					return mock
+				},
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().FindByOIDs(gomock.Any(), []domain.OID{storedOID}).Return(map[domain.OID]*domain.LFSObject{storedOID: storedObj}, nil)
 					return mock
 				},
 			},
@@ -123,19 +139,130 @@ func TestBatchUploadUseCase_HandleBatchUpload(t *testing.T) {
 			wantErr: nil,
 		},
 		{
-			name: "異常系: Upload操作で認可失敗（別リポジトリ）の場合、ErrAccessDeniedが返る",
+			name: "正常系: Upload操作で他リポジトリが登録済みのオブジェクトの場合、所持証明のためのアップロードアクションが返り紐付けはまだ作成されない",
 			fields: fields{
 				uploadUseCase: func(ctrl *gomock.Controller) usecase.UploadUseCase {
-					return mock_usecase.NewMockUploadUseCase(ctrl)
+					mock := mock_usecase.NewMockUploadUseCase(ctrl)
+					uploadAction := usecase.NewAction("http://localhost:8080/owner/repo/info/lfs/objects/"+testOID, nil, 900)
+					actions := usecase.NewActions(&uploadAction, nil, nil)
+					mock.EXPECT().HandleProofUpload(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), storedOID, storedSize, storedObj, gomock.Any()).Return(
+						usecase.NewResponseObject(testOID, 1024, true, &actions, nil),
+					)
+					return mock
 				},
 				policyRepo: func(ctrl *gomock.Controller) domain.AccessPolicyRepository {
 					mock := mock_domain.NewMockAccessPolicyRepository(ctrl)
-					objOID, _ := domain.NewOID(testOID)
+					mock.EXPECT().FindByOIDsAndRepository(gomock.Any(), []domain.OID{storedOID}, testRepo).Return(map[domain.OID]*domain.AccessPolicy{}, nil)
+					return mock
+				},
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().FindByOIDs(gomock.Any(), []domain.OID{storedOID}).Return(map[domain.OID]*domain.LFSObject{storedOID: storedObj}, nil)
+					return mock
+				},
+			},
+			args: args{
+				ctx:     context.Background(),
+				baseURL: "http://localhost:8080",
+				owner:   "owner",
+				repo:    "repo",
+				req: usecase.NewBatchRequest(
+					domain.OperationUpload,
+					[]usecase.RequestObject{usecase.NewRequestObject(testOID, 1024)},
+					[]string{"basic"},
+					nil,
+					"sha256",
+					testRepo,
+				),
+			},
+			want: func() usecase.BatchResponse {
+				uploadAction := usecase.NewAction("http://localhost:8080/owner/repo/info/lfs/objects/"+testOID, nil, 900)
+				actions := usecase.NewActions(&uploadAction, nil, nil)
+				return usecase.NewBatchResponse(
+					"basic",
+					[]usecase.ResponseObject{usecase.NewResponseObject(testOID, 1024, true, &actions, nil)},
+					"sha256",
+				)
+			}(),
+			wantErr: nil,
+		},
+		{
+			name: "正常系: 紐付け済みと未紐付けのオブジェクトが混在する場合、未紐付けのオブジェクトのみ紐付けが作成されリクエスト順に返る",
+			fields: fields{
+				uploadUseCase: func(ctrl *gomock.Controller) usecase.UploadUseCase {
+					mock := mock_usecase.NewMockUploadUseCase(ctrl)
+					uploadAction := usecase.NewAction("https://s3.example.com/presigned-put-url", nil, 900)
+					actions := usecase.NewActions(&uploadAction, nil, nil)
+					mock.EXPECT().HandleUploadObject(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Nil(), gomock.Any(), gomock.Any()).Return(
+						usecase.NewResponseObject(foreignTestOID, 2048, true, &actions, nil),
+					)
+					mock.EXPECT().HandleUploadObject(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), storedObj, gomock.Any(), gomock.Any()).Return(
+						usecase.NewResponseObject(testOID, 1024, true, nil, nil),
+					)
+					return mock
+				},
+				policyRepo: func(ctrl *gomock.Controller) domain.AccessPolicyRepository {
+					mock := mock_domain.NewMockAccessPolicyRepository(ctrl)
+					foreignOID, _ := domain.NewOID(foreignTestOID)
 					policyID, _ := domain.NewAccessPolicyID(1)
-					policy := domain.NewAccessPolicy(policyID, objOID, otherRepo, time.Now())
-					mock.EXPECT().FindByOID(gomock.Any(), gomock.Any()).Return(policy, nil)
+					mock.EXPECT().FindByOIDsAndRepository(gomock.Any(), []domain.OID{foreignOID, storedOID}, testRepo).Return(map[domain.OID]*domain.AccessPolicy{
+						storedOID: domain.NewAccessPolicy(policyID, storedOID, testRepo, time.Now()),
+					}, nil)
+					mock.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+					return mock
+				},
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					foreignOID, _ := domain.NewOID(foreignTestOID)
+					mock.EXPECT().FindByOIDs(gomock.Any(), []domain.OID{foreignOID, storedOID}).Return(map[domain.OID]*domain.LFSObject{storedOID: storedObj}, nil)
+					return mock
+				},
+			},
+			args: args{
+				ctx:     context.Background(),
+				baseURL: "http://localhost:8080",
+				owner:   "owner",
+				repo:    "repo",
+				req: usecase.NewBatchRequest(
+					domain.OperationUpload,
+					[]usecase.RequestObject{
+						usecase.NewRequestObject(foreignTestOID, 2048),
+						usecase.NewRequestObject(testOID, 1024),
+					},
+					[]string{"basic"},
+					nil,
+					"sha256",
+					testRepo,
+				),
+			},
+			want: func() usecase.BatchResponse {
+				uploadAction := usecase.NewAction("https://s3.example.com/presigned-put-url", nil, 900)
+				actions := usecase.NewActions(&uploadAction, nil, nil)
+				return usecase.NewBatchResponse(
+					"basic",
+					[]usecase.ResponseObject{
+						usecase.NewResponseObject(foreignTestOID, 2048, true, &actions, nil),
+						usecase.NewResponseObject(testOID, 1024, true, nil, nil),
+					},
+					"sha256",
+				)
+			}(),
+			wantErr: nil,
+		},
+		{
+			name: "異常系: ポリシー取得でエラーが発生した場合、リクエスト全体のエラーが返る",
+			fields: fields{
+				uploadUseCase: func(ctrl *gomock.Controller) usecase.UploadUseCase {
+					return mock_usecase.NewMockUploadUseCase(ctrl)
+				},
+				policyRepo: func(ctrl *gomock.Controller) domain.AccessPolicyRepository {
+					mock := mock_domain.NewMockAccessPolicyRepository(ctrl)
+					mock.EXPECT().FindByOIDsAndRepository(gomock.Any(), gomock.Any(), testRepo).Return(nil, errDatabase)
 					return mock
 				},
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					return mock_domain.NewMockLFSObjectRepository(ctrl)
+				},
 			},
 			args: args{
 				ctx:     context.Background(),
@@ -151,8 +278,88 @@ func TestBatchUploadUseCase_HandleBatchUpload(t *testing.T) {
 					testRepo,
 				),
 			},
-			want:    usecase.BatchResponse{},
-			wantErr: usecase.ErrAccessDenied,
+			wantErr: errDatabase,
+		},
+		{
+			name: "異常系: アクセスポリシーの作成に失敗した場合、オブジェクト単位の500エラーが返る",
+			fields: fields{
+				uploadUseCase: func(ctrl *gomock.Controller) usecase.UploadUseCase {
+					mock := mock_usecase.NewMockUploadUseCase(ctrl)
+					uploadAction := usecase.NewAction("https://s3.example.com/presigned-put-url", nil, 900)
+					actions := usecase.NewActions(&uploadAction, nil, nil)
+					mock.EXPECT().HandleUploadObject(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Nil(), gomock.Any(), gomock.Any()).Return(
+						usecase.NewResponseObject(testOID, 1024, true, &actions, nil),
+					)
+					return mock
+				},
+				policyRepo: func(ctrl *gomock.Controller) domain.AccessPolicyRepository {
+					mock := mock_domain.NewMockAccessPolicyRepository(ctrl)
+					mock.EXPECT().FindByOIDsAndRepository(gomock.Any(), []domain.OID{storedOID}, testRepo).Return(map[domain.OID]*domain.AccessPolicy{}, nil)
+					mock.EXPECT().Save(gomock.Any(), gomock.Any()).Return(errors.New("save error"))
+					return mock
+				},
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().FindByOIDs(gomock.Any(), []domain.OID{storedOID}).Return(map[domain.OID]*domain.LFSObject{}, nil)
+					return mock
+				},
+			},
+			args: args{
+				ctx:     context.Background(),
+				baseURL: "http://localhost:8080",
+				owner:   "owner",
+				repo:    "repo",
+				req: usecase.NewBatchRequest(
+					domain.OperationUpload,
+					[]usecase.RequestObject{usecase.NewRequestObject(testOID, 1024)},
+					[]string{"basic"},
+					nil,
+					"sha256",
+					testRepo,
+				),
+			},
+			want: func() usecase.BatchResponse {
+				objectError := usecase.NewObjectError(500, "アクセスポリシーの作成に失敗しました")
+				return usecase.NewBatchResponse(
+					"basic",
+					[]usecase.ResponseObject{usecase.NewResponseObject(testOID, 1024, false, nil, &objectError)},
+					"sha256",
+				)
+			}(),
+			wantErr: nil,
+		},
+		{
+			name: "異常系: メタデータの一括取得でエラーが発生した場合、リクエスト全体のエラーが返りAccessPolicyは作成されない",
+			fields: fields{
+				uploadUseCase: func(ctrl *gomock.Controller) usecase.UploadUseCase {
+					return mock_usecase.NewMockUploadUseCase(ctrl)
+				},
+				policyRepo: func(ctrl *gomock.Controller) domain.AccessPolicyRepository {
+					mock := mock_domain.NewMockAccessPolicyRepository(ctrl)
+					mock.EXPECT().FindByOIDsAndRepository(gomock.Any(), []domain.OID{storedOID}, testRepo).Return(map[domain.OID]*domain.AccessPolicy{}, nil)
+					return mock
+				},
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().FindByOIDs(gomock.Any(), []domain.OID{storedOID}).Return(nil, errDatabase)
+					return mock
+				},
+			},
+			args: args{
+				ctx:     context.Background(),
+				baseURL: "http://localhost:8080",
+				owner:   "owner",
+				repo:    "repo",
+				req: usecase.NewBatchRequest(
+					domain.OperationUpload,
+					[]usecase.RequestObject{usecase.NewRequestObject(testOID, 1024)},
+					[]string{"basic"},
+					nil,
+					"sha256",
+					testRepo,
+				),
+			},
+			wantErr: errDatabase,
 		},
 		{
 			name: "異常系: Repositoryがnilの場合、ErrAccessDeniedが返る",
@@ -163,6 +370,9 @@ func TestBatchUploadUseCase_HandleBatchUpload(t *testing.T) {
 				policyRepo: func(ctrl *gomock.Controller) domain.AccessPolicyRepository {
 					return mock_domain.NewMockAccessPolicyRepository(ctrl)
 				},
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					return mock_domain.NewMockLFSObjectRepository(ctrl)
+				},
 			},
 			args: args{
 				ctx:     context.Background(),
@@ -190,6 +400,9 @@ func TestBatchUploadUseCase_HandleBatchUpload(t *testing.T) {
 				policyRepo: func(ctrl *gomock.Controller) domain.AccessPolicyRepository {
 					return mock_domain.NewMockAccessPolicyRepository(ctrl)
 				},
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					return mock_domain.NewMockLFSObjectRepository(ctrl)
+				},
 			},
 			args: args{
 				ctx:     context.Background(),
@@ -217,6 +430,9 @@ func TestBatchUploadUseCase_HandleBatchUpload(t *testing.T) {
 				policyRepo: func(ctrl *gomock.Controller) domain.AccessPolicyRepository {
 					return mock_domain.NewMockAccessPolicyRepository(ctrl)
 				},
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					return mock_domain.NewMockLFSObjectRepository(ctrl)
+				},
 			},
 			args: args{
 				ctx:     context.Background(),
@@ -244,6 +460,9 @@ func TestBatchUploadUseCase_HandleBatchUpload(t *testing.T) {
 				policyRepo: func(ctrl *gomock.Controller) domain.AccessPolicyRepository {
 					return mock_domain.NewMockAccessPolicyRepository(ctrl)
 				},
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					return mock_domain.NewMockLFSObjectRepository(ctrl)
+				},
 			},
 			args: args{
 				ctx:     context.Background(),
@@ -271,6 +490,9 @@ func TestBatchUploadUseCase_HandleBatchUpload(t *testing.T) {
 				policyRepo: func(ctrl *gomock.Controller) domain.AccessPolicyRepository {
 					return mock_domain.NewMockAccessPolicyRepository(ctrl)
 				},
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					return mock_domain.NewMockLFSObjectRepository(ctrl)
+				},
 			},
 			args: args{
 				ctx:     context.Background(),
@@ -302,6 +524,7 @@ func TestBatchUploadUseCase_HandleBatchUpload(t *testing.T) {
 				tt.fields.uploadUseCase(ctrl),
 				authService,
 				policyRepo,
+				tt.fields.repo(ctrl),
 			)
 
 			got, err := uc.HandleBatchUpload(tt.args.ctx, tt.args.baseURL, tt.args.owner, tt.args.repo, tt.args.req, tt.args.authHeader)
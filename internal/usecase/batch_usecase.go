@@ -3,15 +3,19 @@ package usecase
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/na2na-p/cargohold/internal/domain"
+	"golang.org/x/sync/errgroup"
 )
 
 const (
 	DefaultTransferType  = "basic"
 	DefaultHashAlgorithm = "sha256"
 	PresignedURLTTL      = 15 * time.Minute
+	// BatchConcurrency はバッチリクエスト内のオブジェクトを並行処理する際の最大並行数です
+	BatchConcurrency = 8
 )
 
 type BatchUseCaseInterface interface {
@@ -29,12 +33,15 @@ func NewBatchUseCase(
 	policyRepo domain.AccessPolicyRepository,
 	storageKeyGenerator StorageKeyGenerator,
 	accessAuthService domain.AccessAuthorizationService,
+	s3Client S3Client,
+	objectStorage ObjectStorage,
+	transferPolicy TransferPolicy,
 ) *BatchUseCase {
-	downloadUseCase := NewDownloadUseCase(repo, actionURLGenerator)
-	uploadUseCase := NewUploadUseCase(repo, actionURLGenerator, storageKeyGenerator)
+	downloadUseCase := NewDownloadUseCase(repo, actionURLGenerator, s3Client, objectStorage, transferPolicy)
+	uploadUseCase := NewUploadUseCase(repo, actionURLGenerator, storageKeyGenerator, s3Client, objectStorage, transferPolicy)
 
-	batchDownloadUseCase := NewBatchDownloadUseCase(downloadUseCase, accessAuthService)
-	batchUploadUseCase := NewBatchUploadUseCase(uploadUseCase, accessAuthService, policyRepo)
+	batchDownloadUseCase := NewBatchDownloadUseCase(downloadUseCase, accessAuthService, repo)
+	batchUploadUseCase := NewBatchUploadUseCase(uploadUseCase, accessAuthService, policyRepo, repo)
 
 	return &BatchUseCase{
 		batchDownloadUseCase: batchDownloadUseCase,
@@ -52,9 +59,75 @@ func NewBatchUseCaseWithDependencies(
 	}
 }
 
-func (uc *BatchUseCase) HandleBatchRequest(ctx context.Context, baseURL, owner, repo string, req BatchRequest, authHeader string) (BatchResponse, error) {
+func (uc *BatchUseCase) HandleBatchRequest(ctx context.Context, baseURL, owner, repo string, req BatchRequest, authHeader string) (resp BatchResponse, err error) {
+	ctx, span := startSpan(ctx, "BatchUseCase.HandleBatchRequest",
+		attrLFSRepository.String(owner+"/"+repo),
+		attrLFSOperation.String(req.Operation().String()),
+		attrLFSObjectCount.Int(len(req.Objects())),
+	)
+	defer func() { endSpan(span, err) }()
+
 	if req.Operation() == domain.OperationDownload {
 		return uc.batchDownloadUseCase.HandleBatchDownload(ctx, baseURL, owner, repo, req, authHeader)
 	}
 	return uc.batchUploadUseCase.HandleBatchUpload(ctx, baseURL, owner, repo, req, authHeader)
 }
+
+func parseBatchObjects(reqObjs []RequestObject) ([]domain.OID, []domain.Size, error) {
+	oids := make([]domain.OID, len(reqObjs))
+	sizes := make([]domain.Size, len(reqObjs))
+	for i, reqObj := range reqObjs {
+		oid, err := domain.NewOID(reqObj.OID())
+		if err != nil {
+			return nil, nil, fmt.Errorf("無効なOID: %w", err)
+		}
+		size, err := domain.NewSize(reqObj.Size())
+		if err != nil {
+			return nil, nil, fmt.Errorf("無効なサイズ: %w", err)
+		}
+		oids[i] = oid
+		sizes[i] = size
+	}
+	return oids, sizes, nil
+}
+
+// processBatchObjects は各オブジェクトをBatchConcurrencyを上限に並行処理し、リクエストと同じ順序で結果を返します
+// 同一OIDが複数含まれる場合は最初の1件のみ処理し、その結果を共有します
+func processBatchObjects(oids []domain.OID, process func(i int) ResponseObject) []ResponseObject {
+	results := make([]ResponseObject, len(oids))
+	firstIndex := make(map[domain.OID]int, len(oids))
+
+	var g errgroup.Group
+	g.SetLimit(BatchConcurrency)
+	for i, oid := range oids {
+		if _, ok := firstIndex[oid]; ok {
+			continue
+		}
+		firstIndex[oid] = i
+		g.Go(func() error {
+			results[i] = process(i)
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	for i, oid := range oids {
+		if first := firstIndex[oid]; first != i {
+			results[i] = results[first]
+		}
+	}
+	return results
+}
+
+func uniqueOIDs(oids []domain.OID) []domain.OID {
+	seen := make(map[domain.OID]struct{}, len(oids))
+	result := make([]domain.OID, 0, len(oids))
+	for _, oid := range oids {
+		if _, ok := seen[oid]; ok {
+			continue
+		}
+		seen[oid] = struct{}{}
+		result = append(result, oid)
+	}
+	return result
+}
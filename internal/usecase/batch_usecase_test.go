@@ -50,7 +50,7 @@ func TestBatchUseCase_HandleBatchRequest(t *testing.T) {
 					hashAlgo, _ := domain.NewHashAlgorithm("sha256")
 					obj, _ := domain.NewLFSObject(ctx, objOID, size, hashAlgo, "objects/sha256/12/34/"+testOID)
 					obj.MarkAsUploaded(ctx)
-					mock.EXPECT().FindByOID(gomock.Any(), gomock.Any()).Return(obj, nil)
+					mock.EXPECT().FindByOIDs(gomock.Any(), []domain.OID{objOID}).Return(map[domain.OID]*domain.LFSObject{objOID: obj}, nil)
 					return mock
 				},
 				actionURLGenerator: func(ctrl *gomock.Controller) usecase.ActionURLGenerator {
@@ -66,7 +66,8 @@ func TestBatchUseCase_HandleBatchRequest(t *testing.T) {
 				},
 				accessAuthService: func(ctrl *gomock.Controller) domain.AccessAuthorizationService {
 					mock := mock_domain.NewMockAccessAuthorizationService(ctrl)
-					mock.EXPECT().Authorize(gomock.Any(), domain.OperationDownload, gomock.Any(), gomock.Any()).Return(domain.AuthorizationResult{Allowed: true, IsNewObject: false}, nil)
+					objOID, _ := domain.NewOID(testOID)
+					mock.EXPECT().AuthorizeAll(gomock.Any(), domain.OperationDownload, gomock.Any(), []domain.OID{objOID}).Return(map[domain.OID]domain.AuthorizationResult{objOID: {Allowed: true, IsNewObject: false}}, nil)
 					return mock
 				},
 			},
@@ -86,7 +87,7 @@ func TestBatchUseCase_HandleBatchRequest(t *testing.T) {
 			},
 			want: func() usecase.BatchResponse {
 				downloadAction := usecase.NewAction("http://localhost:8080/owner/repo/objects/download/"+testOID, nil, 900)
-				actions := usecase.NewActions(nil, &downloadAction)
+				actions := usecase.NewActions(nil, &downloadAction, nil)
 				return usecase.NewBatchResponse(
 					"basic",
 					[]usecase.ResponseObject{usecase.NewResponseObject(testOID, 1024, true, &actions, nil)},
@@ -96,7 +97,7 @@ func TestBatchUseCase_HandleBatchRequest(t *testing.T) {
 			wantErr: nil,
 		},
 		{
-			name: "異常系: Download操作で認可失敗（別リポジトリ）の場合、ErrAccessDeniedが返る",
+			name: "異常系: Download操作で認可失敗（別リポジトリ）の場合、オブジェクト単位の404エラーが返る",
 			fields: fields{
 				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
 					return mock_domain.NewMockLFSObjectRepository(ctrl)
@@ -112,7 +113,8 @@ func TestBatchUseCase_HandleBatchRequest(t *testing.T) {
 				},
 				accessAuthService: func(ctrl *gomock.Controller) domain.AccessAuthorizationService {
 					mock := mock_domain.NewMockAccessAuthorizationService(ctrl)
-					mock.EXPECT().Authorize(gomock.Any(), domain.OperationDownload, gomock.Any(), gomock.Any()).Return(domain.AuthorizationResult{Allowed: false}, domain.ErrAuthorizationDenied)
+					objOID, _ := domain.NewOID(testOID)
+					mock.EXPECT().AuthorizeAll(gomock.Any(), domain.OperationDownload, gomock.Any(), []domain.OID{objOID}).Return(map[domain.OID]domain.AuthorizationResult{objOID: {Allowed: false, IsNewObject: false}}, nil)
 					return mock
 				},
 			},
@@ -130,11 +132,18 @@ func TestBatchUseCase_HandleBatchRequest(t *testing.T) {
 					testRepo,
 				),
 			},
-			want:    usecase.BatchResponse{},
-			wantErr: usecase.ErrAccessDenied,
+			want: func() usecase.BatchResponse {
+				objectError := usecase.NewObjectError(404, "オブジェクトが存在しません")
+				return usecase.NewBatchResponse(
+					"basic",
+					[]usecase.ResponseObject{usecase.NewResponseObject(testOID, 1024, false, nil, &objectError)},
+					"sha256",
+				)
+			}(),
+			wantErr: nil,
 		},
 		{
-			name: "異常系: Download操作でポリシー未存在の場合、ErrAccessDeniedが返る",
+			name: "異常系: Download操作でポリシー未存在の場合、オブジェクト単位の404エラーが返る",
 			fields: fields{
 				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
 					return mock_domain.NewMockLFSObjectRepository(ctrl)
@@ -150,7 +159,8 @@ func TestBatchUseCase_HandleBatchRequest(t *testing.T) {
 				},
 				accessAuthService: func(ctrl *gomock.Controller) domain.AccessAuthorizationService {
 					mock := mock_domain.NewMockAccessAuthorizationService(ctrl)
-					mock.EXPECT().Authorize(gomock.Any(), domain.OperationDownload, gomock.Any(), gomock.Any()).Return(domain.AuthorizationResult{Allowed: false}, domain.ErrAuthorizationDenied)
+					objOID, _ := domain.NewOID(testOID)
+					mock.EXPECT().AuthorizeAll(gomock.Any(), domain.OperationDownload, gomock.Any(), []domain.OID{objOID}).Return(map[domain.OID]domain.AuthorizationResult{objOID: {Allowed: false, IsNewObject: false}}, nil)
 					return mock
 				},
 			},
@@ -168,21 +178,29 @@ func TestBatchUseCase_HandleBatchRequest(t *testing.T) {
 					testRepo,
 				),
 			},
-			want:    usecase.BatchResponse{},
-			wantErr: usecase.ErrAccessDenied,
+			want: func() usecase.BatchResponse {
+				objectError := usecase.NewObjectError(404, "オブジェクトが存在しません")
+				return usecase.NewBatchResponse(
+					"basic",
+					[]usecase.ResponseObject{usecase.NewResponseObject(testOID, 1024, false, nil, &objectError)},
+					"sha256",
+				)
+			}(),
+			wantErr: nil,
 		},
 		{
 			name: "正常系: Upload操作で新規オブジェクト（ポリシー未存在）の場合、署名付きURLが返りAccessPolicyが作成される",
 			fields: fields{
 				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
 					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
-					mock.EXPECT().FindByOID(gomock.Any(), gomock.Any()).Return(nil, domain.ErrNotFound)
+					mock.EXPECT().FindByOIDs(gomock.Any(), gomock.Any()).Return(map[domain.OID]*domain.LFSObject{}, nil)
 					mock.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil)
 					return mock
 				},
 				actionURLGenerator: func(ctrl *gomock.Controller) usecase.ActionURLGenerator {
 					mock := mock_usecase.NewMockActionURLGenerator(ctrl)
 					mock.EXPECT().GenerateUploadURL(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("http://localhost:8080/owner/repo/objects/upload/" + testOID)
+					mock.EXPECT().GenerateVerifyURL("http://localhost:8080", "owner", "repo").Return("http://localhost:8080/owner/repo/info/lfs/objects/verify")
 					return mock
 				},
 				policyRepo: func(ctrl *gomock.Controller) domain.AccessPolicyRepository {
@@ -197,7 +215,8 @@ func TestBatchUseCase_HandleBatchRequest(t *testing.T) {
 				},
 				accessAuthService: func(ctrl *gomock.Controller) domain.AccessAuthorizationService {
 					mock := mock_domain.NewMockAccessAuthorizationService(ctrl)
-					mock.EXPECT().Authorize(gomock.Any(), domain.OperationUpload, gomock.Any(), gomock.Any()).Return(domain.AuthorizationResult{Allowed: true, IsNewObject: true}, nil)
+					objOID, _ := domain.NewOID(testOID)
+					mock.EXPECT().AuthorizeAll(gomock.Any(), domain.OperationUpload, gomock.Any(), []domain.OID{objOID}).Return(map[domain.OID]domain.AuthorizationResult{objOID: {Allowed: true, IsNewObject: true}}, nil)
 					return mock
 				},
 			},
@@ -217,7 +236,8 @@ func TestBatchUseCase_HandleBatchRequest(t *testing.T) {
 			},
 			want: func() usecase.BatchResponse {
 				uploadAction := usecase.NewAction("http://localhost:8080/owner/repo/objects/upload/"+testOID, nil, 900)
-				actions := usecase.NewActions(&uploadAction, nil)
+				verifyAction := usecase.NewAction("http://localhost:8080/owner/repo/info/lfs/objects/verify", nil, 900)
+				actions := usecase.NewActions(&uploadAction, nil, &verifyAction)
 				return usecase.NewBatchResponse(
 					"basic",
 					[]usecase.ResponseObject{usecase.NewResponseObject(testOID, 1024, true, &actions, nil)},
@@ -237,7 +257,7 @@ func TestBatchUseCase_HandleBatchRequest(t *testing.T) {
 					hashAlgo, _ := domain.NewHashAlgorithm("sha256")
 					obj, _ := domain.NewLFSObject(ctx, objOID, size, hashAlgo, "objects/sha256/12/34/"+testOID)
 					obj.MarkAsUploaded(ctx)
-					mock.EXPECT().FindByOID(gomock.Any(), gomock.Any()).Return(obj, nil)
+					mock.EXPECT().FindByOIDs(gomock.Any(), []domain.OID{objOID}).Return(map[domain.OID]*domain.LFSObject{objOID: obj}, nil)
 					return mock
 				},
 				actionURLGenerator: func(ctrl *gomock.Controller) usecase.ActionURLGenerator {
@@ -251,7 +271,8 @@ func TestBatchUseCase_HandleBatchRequest(t *testing.T) {
 				},
 				accessAuthService: func(ctrl *gomock.Controller) domain.AccessAuthorizationService {
 					mock := mock_domain.NewMockAccessAuthorizationService(ctrl)
-					mock.EXPECT().Authorize(gomock.Any(), domain.OperationUpload, gomock.Any(), gomock.Any()).Return(domain.AuthorizationResult{Allowed: true, IsNewObject: false}, nil)
+					objOID, _ := domain.NewOID(testOID)
+					mock.EXPECT().AuthorizeAll(gomock.Any(), domain.OperationUpload, gomock.Any(), []domain.OID{objOID}).Return(map[domain.OID]domain.AuthorizationResult{objOID: {Allowed: true, IsNewObject: false}}, nil)
 					return mock
 				},
 			},
@@ -277,7 +298,7 @@ func TestBatchUseCase_HandleBatchRequest(t *testing.T) {
 			wantErr: nil,
 		},
 		{
-			name: "異常系: Upload操作で認可失敗（別リポジトリ）の場合、ErrAccessDeniedが返る",
+			name: "異常系: Upload操作で認可失敗（別リポジトリ）の場合、オブジェクト単位の403エラーが返る",
 			fields: fields{
 				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
 					return mock_domain.NewMockLFSObjectRepository(ctrl)
@@ -293,7 +314,8 @@ func TestBatchUseCase_HandleBatchRequest(t *testing.T) {
 				},
 				accessAuthService: func(ctrl *gomock.Controller) domain.AccessAuthorizationService {
 					mock := mock_domain.NewMockAccessAuthorizationService(ctrl)
-					mock.EXPECT().Authorize(gomock.Any(), domain.OperationUpload, gomock.Any(), gomock.Any()).Return(domain.AuthorizationResult{Allowed: false}, domain.ErrAuthorizationDenied)
+					objOID, _ := domain.NewOID(testOID)
+					mock.EXPECT().AuthorizeAll(gomock.Any(), domain.OperationUpload, gomock.Any(), []domain.OID{objOID}).Return(map[domain.OID]domain.AuthorizationResult{objOID: {Allowed: false, IsNewObject: false}}, nil)
 					return mock
 				},
 			},
@@ -311,8 +333,15 @@ func TestBatchUseCase_HandleBatchRequest(t *testing.T) {
 					testRepo,
 				),
 			},
-			want:    usecase.BatchResponse{},
-			wantErr: usecase.ErrAccessDenied,
+			want: func() usecase.BatchResponse {
+				objectError := usecase.NewObjectError(403, "このオブジェクトへのアクセス権限がありません")
+				return usecase.NewBatchResponse(
+					"basic",
+					[]usecase.ResponseObject{usecase.NewResponseObject(testOID, 1024, false, nil, &objectError)},
+					"sha256",
+				)
+			}(),
+			wantErr: nil,
 		},
 		{
 			name: "異常系: Repositoryがnilの場合、ErrAccessDeniedが返る",
@@ -330,9 +359,7 @@ func TestBatchUseCase_HandleBatchRequest(t *testing.T) {
 					return mock_usecase.NewMockStorageKeyGenerator(ctrl)
 				},
 				accessAuthService: func(ctrl *gomock.Controller) domain.AccessAuthorizationService {
-					mock := mock_domain.NewMockAccessAuthorizationService(ctrl)
-					mock.EXPECT().Authorize(gomock.Any(), domain.OperationDownload, gomock.Any(), gomock.Any()).Return(domain.AuthorizationResult{Allowed: false}, domain.ErrInvalidRepositoryIdentifier)
-					return mock
+					return mock_domain.NewMockAccessAuthorizationService(ctrl)
 				},
 			},
 			args: args{
@@ -509,6 +536,9 @@ func TestBatchUseCase_HandleBatchRequest(t *testing.T) {
 				tt.fields.policyRepo(ctrl),
 				tt.fields.storageKeyGenerator(ctrl),
 				tt.fields.accessAuthService(ctrl),
+				mock_usecase.NewMockS3Client(ctrl),
+				mock_usecase.NewMockObjectStorage(ctrl),
+				usecase.DefaultTransferPolicy(),
 			)
 
 			got, err := uc.HandleBatchRequest(tt.args.ctx, tt.args.baseURL, tt.args.owner, tt.args.repo, tt.args.req, tt.args.authHeader)
@@ -20,6 +20,7 @@ type CacheClient interface {
 	Exists(ctx context.Context, key string) (bool, error)
 	Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error
 	GetJSON(ctx context.Context, key string, dest interface{}) error
+	MGet(ctx context.Context, keys []string) ([][]byte, error)
 	SetJSON(ctx context.Context, key string, value interface{}, ttl time.Duration) error
 	Delete(ctx context.Context, key string) error
 }
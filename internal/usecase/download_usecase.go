@@ -3,53 +3,70 @@ package usecase
 
 import (
 	"context"
-	"errors"
 
 	"github.com/na2na-p/cargohold/internal/domain"
 )
 
 type DownloadUseCase interface {
-	HandleDownloadObject(ctx context.Context, baseURL, owner, repo string, oid domain.OID, size domain.Size, authHeader string) ResponseObject
+	HandleDownloadObject(ctx context.Context, baseURL, owner, repo string, oid domain.OID, size domain.Size, obj *domain.LFSObject, authHeader string) ResponseObject
 }
 
 type downloadUseCaseImpl struct {
 	repo               domain.LFSObjectRepository
 	actionURLGenerator ActionURLGenerator
+	s3Client           S3Client
+	objectStorage      ObjectStorage
+	transferPolicy     TransferPolicy
 }
 
 func NewDownloadUseCase(
 	repo domain.LFSObjectRepository,
 	actionURLGenerator ActionURLGenerator,
+	s3Client S3Client,
+	objectStorage ObjectStorage,
+	transferPolicy TransferPolicy,
 ) DownloadUseCase {
 	return &downloadUseCaseImpl{
 		repo:               repo,
 		actionURLGenerator: actionURLGenerator,
+		s3Client:           s3Client,
+		objectStorage:      objectStorage,
+		transferPolicy:     transferPolicy,
 	}
 }
 
-func (uc *downloadUseCaseImpl) HandleDownloadObject(ctx context.Context, baseURL, owner, repo string, oid domain.OID, size domain.Size, authHeader string) ResponseObject {
-	obj, err := uc.repo.FindByOID(ctx, oid)
-	if err != nil {
-		if errors.Is(err, domain.ErrNotFound) {
-			objectError := NewObjectError(404, "オブジェクトが存在しません")
-			return NewResponseObject(oid.String(), size.Int64(), false, nil, &objectError)
-		}
-		objectError := NewObjectError(500, "メタデータの取得に失敗しました")
+func (uc *downloadUseCaseImpl) HandleDownloadObject(ctx context.Context, baseURL, owner, repo string, oid domain.OID, size domain.Size, obj *domain.LFSObject, authHeader string) ResponseObject {
+	if obj == nil {
+		objectError := NewObjectError(404, "オブジェクトが存在しません")
 		return NewResponseObject(oid.String(), size.Int64(), false, nil, &objectError)
 	}
 
 	if !obj.IsUploaded() {
-		objectError := NewObjectError(404, "オブジェクトがまだアップロードされていません")
-		return NewResponseObject(oid.String(), size.Int64(), false, nil, &objectError)
+		completed, err := completeUploadIfStored(ctx, uc.repo, uc.objectStorage, obj)
+		if err != nil {
+			objectError := NewObjectError(500, "アップロード状態の確認に失敗しました")
+			return NewResponseObject(oid.String(), size.Int64(), false, nil, &objectError)
+		}
+		if !completed {
+			objectError := NewObjectError(404, "オブジェクトがまだアップロードされていません")
+			return NewResponseObject(oid.String(), size.Int64(), false, nil, &objectError)
+		}
+	}
+
+	if uc.transferPolicy.UsePresignedURL(obj.Size()) {
+		downloadURL, err := uc.s3Client.GenerateGetURL(ctx, obj.GetStorageKey(), uc.transferPolicy.PresignTTL())
+		if err != nil {
+			objectError := NewObjectError(500, "署名付きURLの生成に失敗しました")
+			return NewResponseObject(oid.String(), size.Int64(), false, nil, &objectError)
+		}
+		downloadAction := NewAction(downloadURL, nil, int(uc.transferPolicy.PresignTTL().Seconds()))
+		actions := NewActions(nil, &downloadAction, nil)
+		return NewResponseObject(oid.String(), size.Int64(), true, &actions, nil)
 	}
 
 	downloadURL := uc.actionURLGenerator.GenerateDownloadURL(baseURL, owner, repo, oid.String())
 
-	header := map[string]string{}
-	if authHeader != "" {
-		header["Authorization"] = authHeader
-	}
-	downloadAction := NewAction(downloadURL, header, int(PresignedURLTTL.Seconds()))
-	actions := NewActions(nil, &downloadAction)
+	downloadAction := NewAction(downloadURL, authorizationHeader(authHeader), int(PresignedURLTTL.Seconds()))
+	actions := NewActions(nil, &downloadAction, nil)
 	return NewResponseObject(oid.String(), size.Int64(), true, &actions, nil)
 }
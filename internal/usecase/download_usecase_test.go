@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/na2na-p/cargohold/internal/domain"
@@ -17,6 +18,9 @@ func TestDownloadUseCase_HandleDownloadObject(t *testing.T) {
 	type fields struct {
 		repo               func(ctrl *gomock.Controller) domain.LFSObjectRepository
 		actionURLGenerator func(ctrl *gomock.Controller) usecase.ActionURLGenerator
+		s3Client           func(ctrl *gomock.Controller) usecase.S3Client
+		objectStorage      func(ctrl *gomock.Controller) usecase.ObjectStorage
+		transferPolicy     usecase.TransferPolicy
 	}
 	type args struct {
 		ctx        context.Context
@@ -25,6 +29,7 @@ func TestDownloadUseCase_HandleDownloadObject(t *testing.T) {
 		repo       string
 		oid        domain.OID
 		size       domain.Size
+		obj        *domain.LFSObject
 		authHeader string
 	}
 	tests := []struct {
@@ -37,25 +42,27 @@ func TestDownloadUseCase_HandleDownloadObject(t *testing.T) {
 			name: "正常系: オブジェクトが存在しアップロード済みの場合、ダウンロードURLが返る",
 			fields: fields{
 				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
-					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
-					ctx := context.Background()
-					oid, _ := domain.NewOID("1234567890123456789012345678901234567890123456789012345678901234")
-					size, _ := domain.NewSize(1024)
-					hashAlgo, _ := domain.NewHashAlgorithm("sha256")
-					obj, _ := domain.NewLFSObject(ctx, oid, size, hashAlgo, "objects/sha256/12/34/1234567890123456789012345678901234567890123456789012345678901234")
-					obj.MarkAsUploaded(ctx)
-					mock.EXPECT().FindByOID(gomock.Any(), gomock.Any()).Return(obj, nil)
-					return mock
+					return mock_domain.NewMockLFSObjectRepository(ctrl)
 				},
 				actionURLGenerator: func(ctrl *gomock.Controller) usecase.ActionURLGenerator {
 					mock := mock_usecase.NewMockActionURLGenerator(ctrl)
 					mock.EXPECT().GenerateDownloadURL("https://example.com", "owner", "repo", "1234567890123456789012345678901234567890123456789012345678901234").Return("https://example.com/owner/repo/objects/1234567890123456789012345678901234567890123456789012345678901234/download")
 					return mock
 				},
+				s3Client: func(ctrl *gomock.Controller) usecase.S3Client {
+					return mock_usecase.NewMockS3Client(ctrl)
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					return mock_usecase.NewMockObjectStorage(ctrl)
+				},
 			},
 			args: func() args {
 				oid, _ := domain.NewOID("1234567890123456789012345678901234567890123456789012345678901234")
 				size, _ := domain.NewSize(1024)
+				ctx := context.Background()
+				hashAlgo, _ := domain.NewHashAlgorithm("sha256")
+				obj, _ := domain.NewLFSObject(ctx, oid, size, hashAlgo, "objects/sha256/12/34/1234567890123456789012345678901234567890123456789012345678901234")
+				obj.MarkAsUploaded(ctx)
 				return args{
 					ctx:     context.Background(),
 					baseURL: "https://example.com",
@@ -63,11 +70,12 @@ func TestDownloadUseCase_HandleDownloadObject(t *testing.T) {
 					repo:    "repo",
 					oid:     oid,
 					size:    size,
+					obj:     obj,
 				}
 			}(),
 			want: func() usecase.ResponseObject {
 				downloadAction := usecase.NewAction("https://example.com/owner/repo/objects/1234567890123456789012345678901234567890123456789012345678901234/download", nil, 900)
-				actions := usecase.NewActions(nil, &downloadAction)
+				actions := usecase.NewActions(nil, &downloadAction, nil)
 				return usecase.NewResponseObject("1234567890123456789012345678901234567890123456789012345678901234", 1024, true, &actions, nil)
 			}(),
 		},
@@ -75,13 +83,17 @@ func TestDownloadUseCase_HandleDownloadObject(t *testing.T) {
 			name: "異常系: オブジェクトが存在しない場合、404エラーが返る",
 			fields: fields{
 				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
-					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
-					mock.EXPECT().FindByOID(gomock.Any(), gomock.Any()).Return(nil, domain.ErrNotFound)
-					return mock
+					return mock_domain.NewMockLFSObjectRepository(ctrl)
 				},
 				actionURLGenerator: func(ctrl *gomock.Controller) usecase.ActionURLGenerator {
 					return mock_usecase.NewMockActionURLGenerator(ctrl)
 				},
+				s3Client: func(ctrl *gomock.Controller) usecase.S3Client {
+					return mock_usecase.NewMockS3Client(ctrl)
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					return mock_usecase.NewMockObjectStorage(ctrl)
+				},
 			},
 			args: func() args {
 				oid, _ := domain.NewOID("1234567890123456789012345678901234567890123456789012345678901234")
@@ -93,6 +105,7 @@ func TestDownloadUseCase_HandleDownloadObject(t *testing.T) {
 					repo:    "repo",
 					oid:     oid,
 					size:    size,
+					obj:     nil,
 				}
 			}(),
 			want: func() usecase.ResponseObject {
@@ -101,20 +114,68 @@ func TestDownloadUseCase_HandleDownloadObject(t *testing.T) {
 			}(),
 		},
 		{
-			name: "異常系: メタデータ取得で内部エラーが発生した場合、500エラーが返る",
+			name: "異常系: オブジェクトが存在するがアップロード未完了の場合、404エラーが返る",
 			fields: fields{
 				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
-					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
-					mock.EXPECT().FindByOID(gomock.Any(), gomock.Any()).Return(nil, errors.New("database connection error"))
+					return mock_domain.NewMockLFSObjectRepository(ctrl)
+				},
+				actionURLGenerator: func(ctrl *gomock.Controller) usecase.ActionURLGenerator {
+					return mock_usecase.NewMockActionURLGenerator(ctrl)
+				},
+				s3Client: func(ctrl *gomock.Controller) usecase.S3Client {
+					return mock_usecase.NewMockS3Client(ctrl)
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					mock := mock_usecase.NewMockObjectStorage(ctrl)
+					mock.EXPECT().HeadObjectSize(gomock.Any(), "objects/sha256/12/34/1234567890123456789012345678901234567890123456789012345678901234").Return(int64(0), false, nil)
 					return mock
 				},
+			},
+			args: func() args {
+				oid, _ := domain.NewOID("1234567890123456789012345678901234567890123456789012345678901234")
+				size, _ := domain.NewSize(1024)
+				ctx := context.Background()
+				hashAlgo, _ := domain.NewHashAlgorithm("sha256")
+				obj, _ := domain.NewLFSObject(ctx, oid, size, hashAlgo, "objects/sha256/12/34/1234567890123456789012345678901234567890123456789012345678901234")
+				return args{
+					ctx:     context.Background(),
+					baseURL: "https://example.com",
+					owner:   "owner",
+					repo:    "repo",
+					oid:     oid,
+					size:    size,
+					obj:     obj,
+				}
+			}(),
+			want: func() usecase.ResponseObject {
+				objErr := usecase.NewObjectError(404, "オブジェクトがまだアップロードされていません")
+				return usecase.NewResponseObject("1234567890123456789012345678901234567890123456789012345678901234", 1024, false, nil, &objErr)
+			}(),
+		},
+		{
+			name: "異常系: 未完了でストレージのオブジェクトのサイズが異なる場合、アップロード済みとせずNotFoundを返す",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					return mock_domain.NewMockLFSObjectRepository(ctrl)
+				},
 				actionURLGenerator: func(ctrl *gomock.Controller) usecase.ActionURLGenerator {
 					return mock_usecase.NewMockActionURLGenerator(ctrl)
 				},
+				s3Client: func(ctrl *gomock.Controller) usecase.S3Client {
+					return mock_usecase.NewMockS3Client(ctrl)
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					mock := mock_usecase.NewMockObjectStorage(ctrl)
+					mock.EXPECT().HeadObjectSize(gomock.Any(), "objects/sha256/12/34/1234567890123456789012345678901234567890123456789012345678901234").Return(int64(512), true, nil)
+					return mock
+				},
 			},
 			args: func() args {
 				oid, _ := domain.NewOID("1234567890123456789012345678901234567890123456789012345678901234")
 				size, _ := domain.NewSize(1024)
+				ctx := context.Background()
+				hashAlgo, _ := domain.NewHashAlgorithm("sha256")
+				obj, _ := domain.NewLFSObject(ctx, oid, size, hashAlgo, "objects/sha256/12/34/1234567890123456789012345678901234567890123456789012345678901234")
 				return args{
 					ctx:     context.Background(),
 					baseURL: "https://example.com",
@@ -122,33 +183,132 @@ func TestDownloadUseCase_HandleDownloadObject(t *testing.T) {
 					repo:    "repo",
 					oid:     oid,
 					size:    size,
+					obj:     obj,
 				}
 			}(),
 			want: func() usecase.ResponseObject {
-				objErr := usecase.NewObjectError(500, "メタデータの取得に失敗しました")
+				objErr := usecase.NewObjectError(404, "オブジェクトがまだアップロードされていません")
 				return usecase.NewResponseObject("1234567890123456789012345678901234567890123456789012345678901234", 1024, false, nil, &objErr)
 			}(),
 		},
 		{
-			name: "異常系: オブジェクトが存在するがアップロード未完了の場合、404エラーが返る",
+			name: "正常系: 未完了だがストレージに存在する場合、アップロード済みに更新されダウンロードURLが返る",
 			fields: fields{
 				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
 					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
-					ctx := context.Background()
-					oid, _ := domain.NewOID("1234567890123456789012345678901234567890123456789012345678901234")
-					size, _ := domain.NewSize(1024)
-					hashAlgo, _ := domain.NewHashAlgorithm("sha256")
-					obj, _ := domain.NewLFSObject(ctx, oid, size, hashAlgo, "objects/sha256/12/34/1234567890123456789012345678901234567890123456789012345678901234")
-					mock.EXPECT().FindByOID(gomock.Any(), gomock.Any()).Return(obj, nil)
+					mock.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, o *domain.LFSObject) error {
+						if !o.IsUploaded() {
+							t.Error("Update() called with uploaded=false")
+						}
+						return nil
+					})
 					return mock
 				},
+				actionURLGenerator: func(ctrl *gomock.Controller) usecase.ActionURLGenerator {
+					mock := mock_usecase.NewMockActionURLGenerator(ctrl)
+					mock.EXPECT().GenerateDownloadURL("https://example.com", "owner", "repo", "1234567890123456789012345678901234567890123456789012345678901234").Return("https://example.com/download")
+					return mock
+				},
+				s3Client: func(ctrl *gomock.Controller) usecase.S3Client {
+					return mock_usecase.NewMockS3Client(ctrl)
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					mock := mock_usecase.NewMockObjectStorage(ctrl)
+					mock.EXPECT().HeadObjectSize(gomock.Any(), gomock.Any()).Return(int64(1024), true, nil)
+					return mock
+				},
+			},
+			args: func() args {
+				oid, _ := domain.NewOID("1234567890123456789012345678901234567890123456789012345678901234")
+				size, _ := domain.NewSize(1024)
+				ctx := context.Background()
+				hashAlgo, _ := domain.NewHashAlgorithm("sha256")
+				obj, _ := domain.NewLFSObject(ctx, oid, size, hashAlgo, "objects/sha256/12/34/1234567890123456789012345678901234567890123456789012345678901234")
+				return args{
+					ctx:     context.Background(),
+					baseURL: "https://example.com",
+					owner:   "owner",
+					repo:    "repo",
+					oid:     oid,
+					size:    size,
+					obj:     obj,
+				}
+			}(),
+			want: func() usecase.ResponseObject {
+				downloadAction := usecase.NewAction("https://example.com/download", nil, 900)
+				actions := usecase.NewActions(nil, &downloadAction, nil)
+				return usecase.NewResponseObject("1234567890123456789012345678901234567890123456789012345678901234", 1024, true, &actions, nil)
+			}(),
+		},
+		{
+			name: "正常系: presignedモードの場合、署名付きURLがAuthorizationヘッダーなしで返る",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					return mock_domain.NewMockLFSObjectRepository(ctrl)
+				},
 				actionURLGenerator: func(ctrl *gomock.Controller) usecase.ActionURLGenerator {
 					return mock_usecase.NewMockActionURLGenerator(ctrl)
 				},
+				s3Client: func(ctrl *gomock.Controller) usecase.S3Client {
+					mock := mock_usecase.NewMockS3Client(ctrl)
+					mock.EXPECT().GenerateGetURL(gomock.Any(), "objects/sha256/12/34/1234567890123456789012345678901234567890123456789012345678901234", 5*time.Minute).Return("https://s3.example.com/presigned-get", nil)
+					return mock
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					return mock_usecase.NewMockObjectStorage(ctrl)
+				},
+				transferPolicy: usecase.NewTransferPolicy(domain.TransferModePresigned, 0, 5*time.Minute),
 			},
 			args: func() args {
 				oid, _ := domain.NewOID("1234567890123456789012345678901234567890123456789012345678901234")
 				size, _ := domain.NewSize(1024)
+				ctx := context.Background()
+				hashAlgo, _ := domain.NewHashAlgorithm("sha256")
+				obj, _ := domain.NewLFSObject(ctx, oid, size, hashAlgo, "objects/sha256/12/34/1234567890123456789012345678901234567890123456789012345678901234")
+				obj.MarkAsUploaded(ctx)
+				return args{
+					ctx:        context.Background(),
+					baseURL:    "https://example.com",
+					owner:      "owner",
+					repo:       "repo",
+					oid:        oid,
+					size:       size,
+					obj:        obj,
+					authHeader: "Bearer token",
+				}
+			}(),
+			want: func() usecase.ResponseObject {
+				downloadAction := usecase.NewAction("https://s3.example.com/presigned-get", nil, 300)
+				actions := usecase.NewActions(nil, &downloadAction, nil)
+				return usecase.NewResponseObject("1234567890123456789012345678901234567890123456789012345678901234", 1024, true, &actions, nil)
+			}(),
+		},
+		{
+			name: "異常系: 署名付きURLの生成に失敗した場合、500エラーが返る",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					return mock_domain.NewMockLFSObjectRepository(ctrl)
+				},
+				actionURLGenerator: func(ctrl *gomock.Controller) usecase.ActionURLGenerator {
+					return mock_usecase.NewMockActionURLGenerator(ctrl)
+				},
+				s3Client: func(ctrl *gomock.Controller) usecase.S3Client {
+					mock := mock_usecase.NewMockS3Client(ctrl)
+					mock.EXPECT().GenerateGetURL(gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("presign error"))
+					return mock
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					return mock_usecase.NewMockObjectStorage(ctrl)
+				},
+				transferPolicy: usecase.NewTransferPolicy(domain.TransferModeThreshold, 1024, 5*time.Minute),
+			},
+			args: func() args {
+				oid, _ := domain.NewOID("1234567890123456789012345678901234567890123456789012345678901234")
+				size, _ := domain.NewSize(1024)
+				ctx := context.Background()
+				hashAlgo, _ := domain.NewHashAlgorithm("sha256")
+				obj, _ := domain.NewLFSObject(ctx, oid, size, hashAlgo, "objects/sha256/12/34/1234567890123456789012345678901234567890123456789012345678901234")
+				obj.MarkAsUploaded(ctx)
 				return args{
 					ctx:     context.Background(),
 					baseURL: "https://example.com",
@@ -156,10 +316,11 @@ func TestDownloadUseCase_HandleDownloadObject(t *testing.T) {
 					repo:    "repo",
 					oid:     oid,
 					size:    size,
+					obj:     obj,
 				}
 			}(),
 			want: func() usecase.ResponseObject {
-				objErr := usecase.NewObjectError(404, "オブジェクトがまだアップロードされていません")
+				objErr := usecase.NewObjectError(500, "署名付きURLの生成に失敗しました")
 				return usecase.NewResponseObject("1234567890123456789012345678901234567890123456789012345678901234", 1024, false, nil, &objErr)
 			}(),
 		},
@@ -172,9 +333,12 @@ func TestDownloadUseCase_HandleDownloadObject(t *testing.T) {
 			uc := usecase.NewDownloadUseCase(
 				tt.fields.repo(ctrl),
 				tt.fields.actionURLGenerator(ctrl),
+				tt.fields.s3Client(ctrl),
+				tt.fields.objectStorage(ctrl),
+				tt.fields.transferPolicy,
 			)
 
-			got := uc.HandleDownloadObject(tt.args.ctx, tt.args.baseURL, tt.args.owner, tt.args.repo, tt.args.oid, tt.args.size, tt.args.authHeader)
+			got := uc.HandleDownloadObject(tt.args.ctx, tt.args.baseURL, tt.args.owner, tt.args.repo, tt.args.oid, tt.args.size, tt.args.obj, tt.args.authHeader)
 
 			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(
 				usecase.ResponseObject{},
@@ -21,6 +21,9 @@ var (
 	// ErrSizeMismatch はサイズが一致しない場合のエラー
 	ErrSizeMismatch = errors.New("size mismatch")
 
+	// ErrHashMismatch は受信したデータのハッシュがOIDと一致しない場合のエラー
+	ErrHashMismatch = errors.New("hash mismatch")
+
 	// ErrS3URLGeneration はS3署名付きURL生成に失敗した場合のエラー
 	ErrS3URLGeneration = errors.New("failed to generate S3 presigned URL")
 
@@ -39,12 +42,21 @@ var (
 	// ErrSessionNotFound はセッションが見つからない場合のエラーです
 	ErrSessionNotFound = errors.New("session not found")
 
+	// ErrSessionTokenNotFound はセッションにOAuthトークンが保存されていない場合のエラーです
+	ErrSessionTokenNotFound = errors.New("session token not found")
+
+	// ErrInvalidGitHubUserID はGitHubユーザーIDの形式が不正な場合のエラーです
+	ErrInvalidGitHubUserID = errors.New("invalid GitHub user id")
+
 	// ErrInvalidSessionData はセッションデータの形式が不正な場合のエラーです
 	ErrInvalidSessionData = errors.New("invalid session data format")
 
 	// ErrInvalidRepository はリポジトリ識別子が不正な場合のエラーです
 	ErrInvalidRepository = errors.New("invalid repository identifier")
 
+	// ErrRepositoryScopeNotEnabled は複数のリポジトリを対象とするセッションが有効になっていない場合のエラーです
+	ErrRepositoryScopeNotEnabled = errors.New("multi-repository sessions are not enabled")
+
 	// ErrInvalidRedirectURI はリダイレクトURIが不正な場合のエラーです
 	ErrInvalidRedirectURI = errors.New("invalid redirect URI")
 
@@ -75,12 +87,78 @@ var (
 	// ErrInvalidCode は認証コードが無効な場合のエラーです
 	ErrInvalidCode = errors.New("invalid authorization code")
 
-	// ErrGitHubOIDCNotConfigured はGitHub OIDC認証が設定されていない場合のエラーです
-	ErrGitHubOIDCNotConfigured = errors.New("GitHub OIDC authentication is not configured")
+	// ErrInvalidDeviceCode はデバイスコードが存在しない、または期限切れの場合のエラーです
+	ErrInvalidDeviceCode = errors.New("invalid device code")
+
+	// ErrDeviceCodeRequestFailed はGitHubへのデバイスコードの要求に失敗した場合のエラーです
+	ErrDeviceCodeRequestFailed = errors.New("failed to request device code")
+
+	// ErrAuthorizationPending はデバイスフローでユーザーがまだ承認していない場合のエラーです
+	ErrAuthorizationPending = errors.New("authorization pending")
+
+	// ErrSlowDown はデバイスフローのポーリング間隔が短すぎる場合のエラーです
+	ErrSlowDown = errors.New("slow down")
+
+	// ErrDeviceAuthorizationDenied はユーザーがデバイスフローの認可を拒否した場合のエラーです
+	ErrDeviceAuthorizationDenied = errors.New("device authorization denied")
+
+	// ErrOIDCIssuerNotConfigured はトークンのissuerに対応するOIDC認証が設定されていない場合のエラーです
+	ErrOIDCIssuerNotConfigured = errors.New("OIDC issuer is not configured")
 
 	// ErrCacheMiss はキャッシュにデータが存在しない場合のエラーです
 	ErrCacheMiss = errors.New("cache miss")
 
 	// ErrNotUploaded はオブジェクトがまだアップロードされていない場合のエラーです
 	ErrNotUploaded = errors.New("object not uploaded yet")
+
+	// ErrPermissionDenied はリポジトリに対する操作権限がない場合のエラーです
+	ErrPermissionDenied = errors.New("permission denied")
+
+	// ErrInvalidLockPath はロック対象のパスが不正な場合のエラーです
+	ErrInvalidLockPath = errors.New("invalid lock path")
+
+	// ErrInvalidLockID はロックIDが不正な場合のエラーです
+	ErrInvalidLockID = errors.New("invalid lock id")
+
+	// ErrLockNotFound はロックが見つからない場合のエラーです
+	ErrLockNotFound = errors.New("lock not found")
+
+	// ErrLockConflict は同一パスのロックが既に存在する場合のエラーです
+	ErrLockConflict = errors.New("lock already exists")
+
+	// ErrLockOwnerMismatch は他のユーザーが所有するロックを強制なしで解除しようとした場合のエラーです
+	ErrLockOwnerMismatch = errors.New("lock is owned by another user")
+
+	// ErrAllowedRepositoryNotFound は許可リストにリポジトリが登録されていない場合のエラーです
+	ErrAllowedRepositoryNotFound = errors.New("repository is not in allowlist")
+
+	// ErrInvalidClaimRule はクレームルールの条件またはアクセスレベルが不正な場合のエラーです
+	ErrInvalidClaimRule = errors.New("invalid claim rule")
+
+	// ErrInvalidClaimRuleID はクレームルールのIDが不正な場合のエラーです
+	ErrInvalidClaimRuleID = errors.New("invalid claim rule id")
+
+	// ErrClaimRuleNotFound はクレームルールが見つからない場合のエラーです
+	ErrClaimRuleNotFound = errors.New("claim rule not found")
+
+	// ErrInvalidAccessToken はアクセストークンが存在しない、または有効期限切れの場合のエラーです
+	ErrInvalidAccessToken = errors.New("invalid access token")
+
+	// ErrInvalidAccessTokenRequest はアクセストークンの発行内容が不正な場合のエラーです
+	ErrInvalidAccessTokenRequest = errors.New("invalid access token request")
+
+	// ErrInvalidAccessTokenID はアクセストークンのIDが不正な場合のエラーです
+	ErrInvalidAccessTokenID = errors.New("invalid access token id")
+
+	// ErrAccessTokenNotFound はアクセストークンが見つからない場合のエラーです
+	ErrAccessTokenNotFound = errors.New("access token not found")
+
+	// ErrInvalidGCQuery はガベージコレクションの実行条件が不正な場合のエラーです
+	ErrInvalidGCQuery = errors.New("invalid gc query")
+
+	// ErrAdminUnauthorized は管理APIの認証に失敗した場合のエラーです
+	ErrAdminUnauthorized = errors.New("admin authentication failed")
+
+	// ErrInvalidAuditRetention は監査ログの保持期間が不正な場合のエラーです
+	ErrInvalidAuditRetention = errors.New("invalid audit retention")
 )
@@ -12,20 +12,45 @@ type StorageKeyGenerator interface {
 }
 
 type S3Client interface {
-	GeneratePutURL(ctx context.Context, key string, ttl time.Duration) (string, error)
+	// GeneratePutURL はサイズとSHA-256ハッシュ（base64）が一致する内容のみを保存できる署名付きURLと、
+	// アップロード時に送信すべきヘッダーを返す
+	GeneratePutURL(ctx context.Context, key string, contentLength int64, checksumSHA256 string, ttl time.Duration) (string, map[string]string, error)
 	GenerateGetURL(ctx context.Context, key string, ttl time.Duration) (string, error)
 }
 
 type ObjectStorage interface {
 	PutObject(ctx context.Context, key string, body io.Reader, contentLength int64) error
 	GetObject(ctx context.Context, key string) (io.ReadCloser, error)
+	HeadObject(ctx context.Context, key string) (bool, error)
+	HeadObjectSize(ctx context.Context, key string) (size int64, exists bool, err error)
+	CopyObject(ctx context.Context, srcKey, dstKey string) error
+	DeleteObject(ctx context.Context, key string) error
+}
+
+// StoredObject はオブジェクトストレージ上に存在するオブジェクトのキーとサイズ、最終更新日時
+type StoredObject struct {
+	Key          string
+	Size         int64
+	LastModified time.Time
+}
+
+// ObjectLister はオブジェクトストレージ上のオブジェクトをプレフィックス配下について列挙する
+// fnがエラーを返した場合は列挙を中断し、そのエラーを返す
+type ObjectLister interface {
+	ListObjects(ctx context.Context, prefix string, fn func(StoredObject) error) error
 }
 
 type ActionURLGenerator interface {
 	GenerateUploadURL(baseURL, owner, repo, oid string) string
 	GenerateDownloadURL(baseURL, owner, repo, oid string) string
+	GenerateVerifyURL(baseURL, owner, repo string) string
 }
 
 type StorageErrorChecker interface {
 	IsStorageError(err error) bool
 }
+
+// AccessTokenSecretGenerator はアクセストークンとして払い出す推測困難な文字列を生成する
+type AccessTokenSecretGenerator interface {
+	Generate() (string, error)
+}
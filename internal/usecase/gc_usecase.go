@@ -0,0 +1,207 @@
+//go:generate mockgen -source=$GOFILE -destination=../../tests/usecase/mock_gc_usecase.go -package=usecase
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/newmo-oss/ctxtime"
+)
+
+// GCQuery はガベージコレクションの実行条件
+// StaleAfter以上前に作成・更新されたオブジェクトを種類ごとに最大Limit件対象とし、DryRunの場合は削除せずに対象を報告する
+type GCQuery struct {
+	StaleAfter time.Duration
+	Limit      int
+	DryRun     bool
+}
+
+// GCResult はガベージコレクションの結果
+// Staleはアップロードされないまま放置されたオブジェクト、Unreferencedはアクセスポリシーが残っていないオブジェクト
+// Recoveredは未アップロードのまま放置されていたが、ストレージに期待するサイズで実体が存在したオブジェクトで、
+// 削除せずにアップロード済みとして記録する。Stagingは検証前のまま残された一時オブジェクト
+// Skippedは対象として選択した後にアップロードが完了・更新されたため削除しなかったStaleの件数
+type GCResult struct {
+	Stale        []*domain.LFSObject
+	Unreferenced []*domain.LFSObject
+	Recovered    []*domain.LFSObject
+	Staging      []StoredObject
+	Deleted      int
+	Completed    int
+	Skipped      int
+	Failed       int
+}
+
+// errGCListLimitReached は一時オブジェクトの列挙を上限件数で打ち切るための番兵エラー
+var errGCListLimitReached = errors.New("gc list limit reached")
+
+type GCUseCase interface {
+	Run(ctx context.Context, query GCQuery) (*GCResult, error)
+}
+
+type gcUseCaseImpl struct {
+	repo          domain.LFSObjectRepository
+	objectStorage ObjectStorage
+	objectLister  ObjectLister
+}
+
+func NewGCUseCase(repo domain.LFSObjectRepository, objectStorage ObjectStorage, objectLister ObjectLister) GCUseCase {
+	return &gcUseCaseImpl{
+		repo:          repo,
+		objectStorage: objectStorage,
+		objectLister:  objectLister,
+	}
+}
+
+// Run は削除対象のオブジェクトを収集し、DryRunでなければストレージとメタデータから削除する
+// 個々のオブジェクトの削除失敗は記録して処理を続行し、次回の実行で再試行される
+func (u *gcUseCaseImpl) Run(ctx context.Context, query GCQuery) (*GCResult, error) {
+	if query.StaleAfter <= 0 || query.Limit <= 0 {
+		return nil, ErrInvalidGCQuery
+	}
+	olderThan := ctxtime.Now(ctx).Add(-query.StaleAfter)
+
+	staleCandidates, err := u.repo.FindStaleNotUploaded(ctx, olderThan, query.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	unreferenced, err := u.repo.FindUnreferenced(ctx, olderThan, query.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	staging, err := u.findStaging(ctx, olderThan, query.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &GCResult{
+		Stale:        make([]*domain.LFSObject, 0, len(staleCandidates)),
+		Unreferenced: make([]*domain.LFSObject, 0, len(unreferenced)),
+		Recovered:    []*domain.LFSObject{},
+		Staging:      staging,
+	}
+
+	// 署名付きURLでのアップロード後にverifyが失敗したオブジェクトは実体が揃っているため、削除せずに完了として扱う
+	for _, obj := range staleCandidates {
+		storedSize, exists, err := u.objectStorage.HeadObjectSize(ctx, obj.GetStorageKey())
+		if err != nil {
+			slog.Warn("オブジェクトの存在確認に失敗しました", "oid", obj.OID().String(), "error", err)
+			result.Failed++
+			continue
+		}
+		if exists && storedSize == obj.Size().Int64() {
+			result.Recovered = append(result.Recovered, obj)
+			continue
+		}
+		result.Stale = append(result.Stale, obj)
+	}
+
+	// 未アップロードかつ未参照のオブジェクトは両方に現れるため、Staleとしてのみ扱う
+	seen := make(map[domain.OID]struct{}, len(staleCandidates))
+	for _, obj := range staleCandidates {
+		seen[obj.OID()] = struct{}{}
+	}
+	for _, obj := range unreferenced {
+		if _, ok := seen[obj.OID()]; ok {
+			continue
+		}
+		result.Unreferenced = append(result.Unreferenced, obj)
+	}
+
+	if query.DryRun {
+		return result, nil
+	}
+
+	for _, obj := range result.Recovered {
+		obj.MarkAsUploaded(ctx)
+		if err := u.repo.Update(ctx, obj); err != nil {
+			slog.Warn("オブジェクトのアップロード完了の記録に失敗しました", "oid", obj.OID().String(), "error", err)
+			result.Failed++
+			continue
+		}
+		result.Completed++
+	}
+
+	for _, obj := range result.Stale {
+		deleted, err := u.deleteStale(ctx, obj, olderThan)
+		if err != nil {
+			slog.Warn("オブジェクトの削除に失敗しました", "oid", obj.OID().String(), "error", err)
+			result.Failed++
+			continue
+		}
+		if !deleted {
+			slog.Info("対象の選択後にアップロードされたため削除しませんでした", "oid", obj.OID().String())
+			result.Skipped++
+			continue
+		}
+		result.Deleted++
+	}
+
+	for _, obj := range result.Unreferenced {
+		if err := u.delete(ctx, obj); err != nil {
+			slog.Warn("オブジェクトの削除に失敗しました", "oid", obj.OID().String(), "error", err)
+			result.Failed++
+			continue
+		}
+		result.Deleted++
+	}
+
+	for _, staged := range result.Staging {
+		if err := u.objectStorage.DeleteObject(ctx, staged.Key); err != nil {
+			slog.Warn("一時オブジェクトの削除に失敗しました", "key", staged.Key, "error", err)
+			result.Failed++
+			continue
+		}
+		result.Deleted++
+	}
+
+	return result, nil
+}
+
+// findStaging はolderThanより前に更新された検証前の一時オブジェクトを最大limit件返す
+// アップロード中にプロセスが停止すると一時オブジェクトが削除されずに残るため、一定時間が経過したものを回収する
+func (u *gcUseCaseImpl) findStaging(ctx context.Context, olderThan time.Time, limit int) ([]StoredObject, error) {
+	staging := []StoredObject{}
+	err := u.objectLister.ListObjects(ctx, stagingKeyPrefix, func(obj StoredObject) error {
+		if !obj.LastModified.Before(olderThan) {
+			return nil
+		}
+		staging = append(staging, obj)
+		if len(staging) >= limit {
+			return errGCListLimitReached
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errGCListLimitReached) {
+		return nil, err
+	}
+	return staging, nil
+}
+
+// deleteStale は未アップロードのまま放置されたメタデータを条件付きで削除してから、ストレージ上の実体を削除する
+// 対象の選択後にアップロードが完了した場合はメタデータが削除されないため、実体も削除せずにfalseを返す
+// 実体を先に削除すると、その間に完了したアップロードの実体を失うため、メタデータを先に削除する
+func (u *gcUseCaseImpl) deleteStale(ctx context.Context, obj *domain.LFSObject, olderThan time.Time) (bool, error) {
+	deleted, err := u.repo.DeleteStaleNotUploaded(ctx, obj.OID(), olderThan)
+	if err != nil || !deleted {
+		return false, err
+	}
+	if err := u.objectStorage.DeleteObject(ctx, obj.GetStorageKey()); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// delete はストレージ上の実体を削除してからメタデータを削除する
+// 実体の削除に失敗した場合はメタデータを残し、次回の実行で再び対象となるようにする
+func (u *gcUseCaseImpl) delete(ctx context.Context, obj *domain.LFSObject) error {
+	if err := u.objectStorage.DeleteObject(ctx, obj.GetStorageKey()); err != nil {
+		return err
+	}
+	return u.repo.Delete(ctx, obj.OID())
+}
@@ -0,0 +1,550 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/na2na-p/cargohold/internal/usecase"
+	mock_domain "github.com/na2na-p/cargohold/tests/domain"
+	mock_usecase "github.com/na2na-p/cargohold/tests/usecase"
+	"github.com/newmo-oss/ctxtime/ctxtimetest"
+	"github.com/newmo-oss/testid"
+	"go.uber.org/mock/gomock"
+)
+
+func newGCTestObject(t *testing.T, oidValue string, uploaded bool, createdAt time.Time) *domain.LFSObject {
+	t.Helper()
+	oid, err := domain.NewOID(oidValue)
+	if err != nil {
+		t.Fatalf("NewOID() failed: %v", err)
+	}
+	size, err := domain.NewSize(1024)
+	if err != nil {
+		t.Fatalf("NewSize() failed: %v", err)
+	}
+	obj, err := domain.ReconstructLFSObject(oid, size, "sha256", "objects/sha256/"+oidValue, uploaded, createdAt, createdAt)
+	if err != nil {
+		t.Fatalf("ReconstructLFSObject() failed: %v", err)
+	}
+	return obj
+}
+
+// newGCTestLister は列挙時にobjectsを順に渡すObjectListerのモックを返す
+func newGCTestLister(ctrl *gomock.Controller, objects ...usecase.StoredObject) usecase.ObjectLister {
+	mock := mock_usecase.NewMockObjectLister(ctrl)
+	mock.EXPECT().ListObjects(gomock.Any(), "staging/", gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, fn func(usecase.StoredObject) error) error {
+			for _, obj := range objects {
+				if err := fn(obj); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	return mock
+}
+
+// TestGCUseCase_Run は GCUseCase.Run のテーブルドリブンテスト
+func TestGCUseCase_Run(t *testing.T) {
+	fixedNow := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	createdAt := fixedNow.Add(-48 * time.Hour)
+	olderThan := fixedNow.Add(-24 * time.Hour)
+
+	staleOID := "aaaa567890123456789012345678901234567890123456789012345678901234"
+	unreferencedOID := "bbbb567890123456789012345678901234567890123456789012345678901234"
+
+	recoveredOID := "cccc567890123456789012345678901234567890123456789012345678901234"
+
+	staleObj := newGCTestObject(t, staleOID, false, createdAt)
+	unreferencedObj := newGCTestObject(t, unreferencedOID, true, createdAt)
+	recoveredObj := newGCTestObject(t, recoveredOID, false, createdAt)
+	wantRecoveredObj := func() *domain.LFSObject {
+		obj := newGCTestObject(t, recoveredOID, false, createdAt)
+		completed, err := domain.ReconstructLFSObject(obj.OID(), obj.Size(), "sha256", obj.GetStorageKey(), true, createdAt, fixedNow)
+		if err != nil {
+			t.Fatalf("ReconstructLFSObject() failed: %v", err)
+		}
+		return completed
+	}()
+
+	oldStaged := usecase.StoredObject{Key: "staging/11111111-1111-1111-1111-111111111111/objects/sha256/" + staleOID, Size: 512, LastModified: fixedNow.Add(-25 * time.Hour)}
+	otherOldStaged := usecase.StoredObject{Key: "staging/22222222-2222-2222-2222-222222222222/objects/sha256/" + staleOID, Size: 256, LastModified: fixedNow.Add(-30 * time.Hour)}
+	recentStaged := usecase.StoredObject{Key: "staging/33333333-3333-3333-3333-333333333333/objects/sha256/" + staleOID, Size: 128, LastModified: fixedNow.Add(-time.Hour)}
+
+	type fields struct {
+		repo          func(ctrl *gomock.Controller) domain.LFSObjectRepository
+		objectStorage func(ctrl *gomock.Controller) usecase.ObjectStorage
+		objectLister  func(ctrl *gomock.Controller) usecase.ObjectLister
+	}
+	type args struct {
+		query usecase.GCQuery
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *usecase.GCResult
+		wantErr error
+	}{
+		{
+			name: "正常系: DryRunの場合、対象を報告するだけで削除しない",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().FindStaleNotUploaded(gomock.Any(), olderThan, 100).Return([]*domain.LFSObject{staleObj}, nil)
+					mock.EXPECT().FindUnreferenced(gomock.Any(), olderThan, 100).Return([]*domain.LFSObject{unreferencedObj}, nil)
+					return mock
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					mock := mock_usecase.NewMockObjectStorage(ctrl)
+					mock.EXPECT().HeadObjectSize(gomock.Any(), staleObj.GetStorageKey()).Return(int64(0), false, nil)
+					return mock
+				},
+				objectLister: func(ctrl *gomock.Controller) usecase.ObjectLister {
+					return newGCTestLister(ctrl, oldStaged, recentStaged)
+				},
+			},
+			args: args{query: usecase.GCQuery{StaleAfter: 24 * time.Hour, Limit: 100, DryRun: true}},
+			want: &usecase.GCResult{
+				Stale:        []*domain.LFSObject{staleObj},
+				Unreferenced: []*domain.LFSObject{unreferencedObj},
+				Recovered:    []*domain.LFSObject{},
+				Staging:      []usecase.StoredObject{oldStaged},
+			},
+		},
+		{
+			name: "正常系: 対象のオブジェクトがストレージとメタデータから削除される",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().FindStaleNotUploaded(gomock.Any(), olderThan, 100).Return([]*domain.LFSObject{staleObj}, nil)
+					mock.EXPECT().FindUnreferenced(gomock.Any(), olderThan, 100).Return([]*domain.LFSObject{unreferencedObj}, nil)
+					mock.EXPECT().DeleteStaleNotUploaded(gomock.Any(), staleObj.OID(), olderThan).Return(true, nil)
+					mock.EXPECT().Delete(gomock.Any(), unreferencedObj.OID()).Return(nil)
+					return mock
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					mock := mock_usecase.NewMockObjectStorage(ctrl)
+					mock.EXPECT().HeadObjectSize(gomock.Any(), staleObj.GetStorageKey()).Return(int64(0), false, nil)
+					mock.EXPECT().DeleteObject(gomock.Any(), staleObj.GetStorageKey()).Return(nil)
+					mock.EXPECT().DeleteObject(gomock.Any(), unreferencedObj.GetStorageKey()).Return(nil)
+					return mock
+				},
+				objectLister: func(ctrl *gomock.Controller) usecase.ObjectLister {
+					return newGCTestLister(ctrl)
+				},
+			},
+			args: args{query: usecase.GCQuery{StaleAfter: 24 * time.Hour, Limit: 100}},
+			want: &usecase.GCResult{
+				Stale:        []*domain.LFSObject{staleObj},
+				Unreferenced: []*domain.LFSObject{unreferencedObj},
+				Recovered:    []*domain.LFSObject{},
+				Staging:      []usecase.StoredObject{},
+				Deleted:      2,
+			},
+		},
+		{
+			name: "正常系: 未アップロードかつ未参照のオブジェクトはStaleとしてのみ扱われ、1度だけ削除される",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().FindStaleNotUploaded(gomock.Any(), olderThan, 100).Return([]*domain.LFSObject{staleObj}, nil)
+					mock.EXPECT().FindUnreferenced(gomock.Any(), olderThan, 100).Return([]*domain.LFSObject{staleObj}, nil)
+					mock.EXPECT().DeleteStaleNotUploaded(gomock.Any(), staleObj.OID(), olderThan).Return(true, nil)
+					return mock
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					mock := mock_usecase.NewMockObjectStorage(ctrl)
+					mock.EXPECT().HeadObjectSize(gomock.Any(), staleObj.GetStorageKey()).Return(int64(0), false, nil)
+					mock.EXPECT().DeleteObject(gomock.Any(), staleObj.GetStorageKey()).Return(nil)
+					return mock
+				},
+				objectLister: func(ctrl *gomock.Controller) usecase.ObjectLister {
+					return newGCTestLister(ctrl)
+				},
+			},
+			args: args{query: usecase.GCQuery{StaleAfter: 24 * time.Hour, Limit: 100}},
+			want: &usecase.GCResult{
+				Stale:        []*domain.LFSObject{staleObj},
+				Unreferenced: []*domain.LFSObject{},
+				Recovered:    []*domain.LFSObject{},
+				Staging:      []usecase.StoredObject{},
+				Deleted:      1,
+			},
+		},
+		{
+			name: "正常系: 未アップロードでもストレージに期待するサイズの実体がある場合、削除せずにアップロード済みとして記録する",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().FindStaleNotUploaded(gomock.Any(), olderThan, 100).Return([]*domain.LFSObject{recoveredObj}, nil)
+					mock.EXPECT().FindUnreferenced(gomock.Any(), olderThan, 100).Return(nil, nil)
+					mock.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, obj *domain.LFSObject) error {
+						if !obj.IsUploaded() {
+							t.Error("Update() called with uploaded=false")
+						}
+						return nil
+					})
+					return mock
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					mock := mock_usecase.NewMockObjectStorage(ctrl)
+					mock.EXPECT().HeadObjectSize(gomock.Any(), recoveredObj.GetStorageKey()).Return(int64(1024), true, nil)
+					return mock
+				},
+				objectLister: func(ctrl *gomock.Controller) usecase.ObjectLister {
+					return newGCTestLister(ctrl)
+				},
+			},
+			args: args{query: usecase.GCQuery{StaleAfter: 24 * time.Hour, Limit: 100}},
+			want: &usecase.GCResult{
+				Stale:        []*domain.LFSObject{},
+				Unreferenced: []*domain.LFSObject{},
+				Recovered:    []*domain.LFSObject{wantRecoveredObj},
+				Staging:      []usecase.StoredObject{},
+				Completed:    1,
+			},
+		},
+		{
+			name: "正常系: ストレージの実体のサイズが異なる場合、放置されたオブジェクトとして削除される",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().FindStaleNotUploaded(gomock.Any(), olderThan, 100).Return([]*domain.LFSObject{staleObj}, nil)
+					mock.EXPECT().FindUnreferenced(gomock.Any(), olderThan, 100).Return(nil, nil)
+					mock.EXPECT().DeleteStaleNotUploaded(gomock.Any(), staleObj.OID(), olderThan).Return(true, nil)
+					return mock
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					mock := mock_usecase.NewMockObjectStorage(ctrl)
+					mock.EXPECT().HeadObjectSize(gomock.Any(), staleObj.GetStorageKey()).Return(int64(512), true, nil)
+					mock.EXPECT().DeleteObject(gomock.Any(), staleObj.GetStorageKey()).Return(nil)
+					return mock
+				},
+				objectLister: func(ctrl *gomock.Controller) usecase.ObjectLister {
+					return newGCTestLister(ctrl)
+				},
+			},
+			args: args{query: usecase.GCQuery{StaleAfter: 24 * time.Hour, Limit: 100}},
+			want: &usecase.GCResult{
+				Stale:        []*domain.LFSObject{staleObj},
+				Unreferenced: []*domain.LFSObject{},
+				Recovered:    []*domain.LFSObject{},
+				Staging:      []usecase.StoredObject{},
+				Deleted:      1,
+			},
+		},
+		{
+			name: "正常系: ストレージの確認に失敗した場合、削除せずに失敗件数に計上する",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().FindStaleNotUploaded(gomock.Any(), olderThan, 100).Return([]*domain.LFSObject{staleObj}, nil)
+					mock.EXPECT().FindUnreferenced(gomock.Any(), olderThan, 100).Return(nil, nil)
+					return mock
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					mock := mock_usecase.NewMockObjectStorage(ctrl)
+					mock.EXPECT().HeadObjectSize(gomock.Any(), staleObj.GetStorageKey()).Return(int64(0), false, errors.New("s3 error"))
+					return mock
+				},
+				objectLister: func(ctrl *gomock.Controller) usecase.ObjectLister {
+					return newGCTestLister(ctrl)
+				},
+			},
+			args: args{query: usecase.GCQuery{StaleAfter: 24 * time.Hour, Limit: 100}},
+			want: &usecase.GCResult{
+				Stale:        []*domain.LFSObject{},
+				Unreferenced: []*domain.LFSObject{},
+				Recovered:    []*domain.LFSObject{},
+				Staging:      []usecase.StoredObject{},
+				Failed:       1,
+			},
+		},
+		{
+			name: "正常系: 未参照オブジェクトのストレージからの削除に失敗した場合、メタデータは残して失敗件数に計上し処理を続行する",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().FindStaleNotUploaded(gomock.Any(), olderThan, 100).Return([]*domain.LFSObject{staleObj}, nil)
+					mock.EXPECT().FindUnreferenced(gomock.Any(), olderThan, 100).Return([]*domain.LFSObject{unreferencedObj}, nil)
+					mock.EXPECT().DeleteStaleNotUploaded(gomock.Any(), staleObj.OID(), olderThan).Return(true, nil)
+					return mock
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					mock := mock_usecase.NewMockObjectStorage(ctrl)
+					mock.EXPECT().HeadObjectSize(gomock.Any(), staleObj.GetStorageKey()).Return(int64(0), false, nil)
+					mock.EXPECT().DeleteObject(gomock.Any(), staleObj.GetStorageKey()).Return(nil)
+					mock.EXPECT().DeleteObject(gomock.Any(), unreferencedObj.GetStorageKey()).Return(errors.New("s3 error"))
+					return mock
+				},
+				objectLister: func(ctrl *gomock.Controller) usecase.ObjectLister {
+					return newGCTestLister(ctrl)
+				},
+			},
+			args: args{query: usecase.GCQuery{StaleAfter: 24 * time.Hour, Limit: 100}},
+			want: &usecase.GCResult{
+				Stale:        []*domain.LFSObject{staleObj},
+				Unreferenced: []*domain.LFSObject{unreferencedObj},
+				Recovered:    []*domain.LFSObject{},
+				Staging:      []usecase.StoredObject{},
+				Deleted:      1,
+				Failed:       1,
+			},
+		},
+		{
+			name: "正常系: 放置オブジェクトのストレージからの削除に失敗した場合、失敗件数に計上し処理を続行する",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().FindStaleNotUploaded(gomock.Any(), olderThan, 100).Return([]*domain.LFSObject{staleObj}, nil)
+					mock.EXPECT().FindUnreferenced(gomock.Any(), olderThan, 100).Return([]*domain.LFSObject{unreferencedObj}, nil)
+					mock.EXPECT().DeleteStaleNotUploaded(gomock.Any(), staleObj.OID(), olderThan).Return(true, nil)
+					mock.EXPECT().Delete(gomock.Any(), unreferencedObj.OID()).Return(nil)
+					return mock
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					mock := mock_usecase.NewMockObjectStorage(ctrl)
+					mock.EXPECT().HeadObjectSize(gomock.Any(), staleObj.GetStorageKey()).Return(int64(0), false, nil)
+					mock.EXPECT().DeleteObject(gomock.Any(), staleObj.GetStorageKey()).Return(errors.New("s3 error"))
+					mock.EXPECT().DeleteObject(gomock.Any(), unreferencedObj.GetStorageKey()).Return(nil)
+					return mock
+				},
+				objectLister: func(ctrl *gomock.Controller) usecase.ObjectLister {
+					return newGCTestLister(ctrl)
+				},
+			},
+			args: args{query: usecase.GCQuery{StaleAfter: 24 * time.Hour, Limit: 100}},
+			want: &usecase.GCResult{
+				Stale:        []*domain.LFSObject{staleObj},
+				Unreferenced: []*domain.LFSObject{unreferencedObj},
+				Recovered:    []*domain.LFSObject{},
+				Staging:      []usecase.StoredObject{},
+				Deleted:      1,
+				Failed:       1,
+			},
+		},
+		{
+			name: "正常系: 対象の選択後にアップロードが完了した放置オブジェクトは、メタデータも実体も削除せずスキップ件数に計上する",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().FindStaleNotUploaded(gomock.Any(), olderThan, 100).Return([]*domain.LFSObject{staleObj}, nil)
+					mock.EXPECT().FindUnreferenced(gomock.Any(), olderThan, 100).Return(nil, nil)
+					mock.EXPECT().DeleteStaleNotUploaded(gomock.Any(), staleObj.OID(), olderThan).Return(false, nil)
+					return mock
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					mock := mock_usecase.NewMockObjectStorage(ctrl)
+					mock.EXPECT().HeadObjectSize(gomock.Any(), staleObj.GetStorageKey()).Return(int64(0), false, nil)
+					return mock
+				},
+				objectLister: func(ctrl *gomock.Controller) usecase.ObjectLister {
+					return newGCTestLister(ctrl)
+				},
+			},
+			args: args{query: usecase.GCQuery{StaleAfter: 24 * time.Hour, Limit: 100}},
+			want: &usecase.GCResult{
+				Stale:        []*domain.LFSObject{staleObj},
+				Unreferenced: []*domain.LFSObject{},
+				Recovered:    []*domain.LFSObject{},
+				Staging:      []usecase.StoredObject{},
+				Skipped:      1,
+			},
+		},
+		{
+			name: "正常系: 放置オブジェクトのメタデータの削除に失敗した場合、実体を残して失敗件数に計上する",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().FindStaleNotUploaded(gomock.Any(), olderThan, 100).Return([]*domain.LFSObject{staleObj}, nil)
+					mock.EXPECT().FindUnreferenced(gomock.Any(), olderThan, 100).Return(nil, nil)
+					mock.EXPECT().DeleteStaleNotUploaded(gomock.Any(), staleObj.OID(), olderThan).Return(false, errors.New("database error"))
+					return mock
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					mock := mock_usecase.NewMockObjectStorage(ctrl)
+					mock.EXPECT().HeadObjectSize(gomock.Any(), staleObj.GetStorageKey()).Return(int64(0), false, nil)
+					return mock
+				},
+				objectLister: func(ctrl *gomock.Controller) usecase.ObjectLister {
+					return newGCTestLister(ctrl)
+				},
+			},
+			args: args{query: usecase.GCQuery{StaleAfter: 24 * time.Hour, Limit: 100}},
+			want: &usecase.GCResult{
+				Stale:        []*domain.LFSObject{staleObj},
+				Unreferenced: []*domain.LFSObject{},
+				Recovered:    []*domain.LFSObject{},
+				Staging:      []usecase.StoredObject{},
+				Failed:       1,
+			},
+		},
+		{
+			name: "正常系: 期限より前に更新された一時オブジェクトのみ削除される",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().FindStaleNotUploaded(gomock.Any(), olderThan, 100).Return(nil, nil)
+					mock.EXPECT().FindUnreferenced(gomock.Any(), olderThan, 100).Return(nil, nil)
+					return mock
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					mock := mock_usecase.NewMockObjectStorage(ctrl)
+					mock.EXPECT().DeleteObject(gomock.Any(), oldStaged.Key).Return(nil)
+					return mock
+				},
+				objectLister: func(ctrl *gomock.Controller) usecase.ObjectLister {
+					return newGCTestLister(ctrl, oldStaged, recentStaged)
+				},
+			},
+			args: args{query: usecase.GCQuery{StaleAfter: 24 * time.Hour, Limit: 100}},
+			want: &usecase.GCResult{
+				Stale:        []*domain.LFSObject{},
+				Unreferenced: []*domain.LFSObject{},
+				Recovered:    []*domain.LFSObject{},
+				Staging:      []usecase.StoredObject{oldStaged},
+				Deleted:      1,
+			},
+		},
+		{
+			name: "正常系: 一時オブジェクトはLimit件までしか対象としない",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().FindStaleNotUploaded(gomock.Any(), olderThan, 1).Return(nil, nil)
+					mock.EXPECT().FindUnreferenced(gomock.Any(), olderThan, 1).Return(nil, nil)
+					return mock
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					return mock_usecase.NewMockObjectStorage(ctrl)
+				},
+				objectLister: func(ctrl *gomock.Controller) usecase.ObjectLister {
+					return newGCTestLister(ctrl, oldStaged, otherOldStaged)
+				},
+			},
+			args: args{query: usecase.GCQuery{StaleAfter: 24 * time.Hour, Limit: 1, DryRun: true}},
+			want: &usecase.GCResult{
+				Stale:        []*domain.LFSObject{},
+				Unreferenced: []*domain.LFSObject{},
+				Recovered:    []*domain.LFSObject{},
+				Staging:      []usecase.StoredObject{oldStaged},
+			},
+		},
+		{
+			name: "異常系: 放置オブジェクトの取得に失敗した場合、エラーが返る",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().FindStaleNotUploaded(gomock.Any(), olderThan, 100).Return(nil, errors.New("database error"))
+					return mock
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					return mock_usecase.NewMockObjectStorage(ctrl)
+				},
+			},
+			args:    args{query: usecase.GCQuery{StaleAfter: 24 * time.Hour, Limit: 100}},
+			wantErr: errors.New("database error"),
+		},
+		{
+			name: "異常系: 未参照オブジェクトの取得に失敗した場合、エラーが返る",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().FindStaleNotUploaded(gomock.Any(), olderThan, 100).Return(nil, nil)
+					mock.EXPECT().FindUnreferenced(gomock.Any(), olderThan, 100).Return(nil, errors.New("database error"))
+					return mock
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					return mock_usecase.NewMockObjectStorage(ctrl)
+				},
+			},
+			args:    args{query: usecase.GCQuery{StaleAfter: 24 * time.Hour, Limit: 100}},
+			wantErr: errors.New("database error"),
+		},
+		{
+			name: "異常系: 一時オブジェクトの列挙に失敗した場合、エラーが返る",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().FindStaleNotUploaded(gomock.Any(), olderThan, 100).Return(nil, nil)
+					mock.EXPECT().FindUnreferenced(gomock.Any(), olderThan, 100).Return(nil, nil)
+					return mock
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					return mock_usecase.NewMockObjectStorage(ctrl)
+				},
+				objectLister: func(ctrl *gomock.Controller) usecase.ObjectLister {
+					mock := mock_usecase.NewMockObjectLister(ctrl)
+					mock.EXPECT().ListObjects(gomock.Any(), "staging/", gomock.Any()).Return(errors.New("list error"))
+					return mock
+				},
+			},
+			args:    args{query: usecase.GCQuery{StaleAfter: 24 * time.Hour, Limit: 100}},
+			wantErr: errors.New("list error"),
+		},
+		{
+			name: "異常系: StaleAfterが0以下の場合、ErrInvalidGCQueryが返る",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					return mock_domain.NewMockLFSObjectRepository(ctrl)
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					return mock_usecase.NewMockObjectStorage(ctrl)
+				},
+			},
+			args:    args{query: usecase.GCQuery{StaleAfter: 0, Limit: 100}},
+			wantErr: usecase.ErrInvalidGCQuery,
+		},
+		{
+			name: "異常系: Limitが0以下の場合、ErrInvalidGCQueryが返る",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					return mock_domain.NewMockLFSObjectRepository(ctrl)
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					return mock_usecase.NewMockObjectStorage(ctrl)
+				},
+			},
+			args:    args{query: usecase.GCQuery{StaleAfter: 24 * time.Hour, Limit: 0}},
+			wantErr: usecase.ErrInvalidGCQuery,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			ctx := testid.WithValue(context.Background(), t.Name())
+			ctxtimetest.SetFixedNow(t, ctx, fixedNow)
+
+			var objectLister usecase.ObjectLister = mock_usecase.NewMockObjectLister(ctrl)
+			if tt.fields.objectLister != nil {
+				objectLister = tt.fields.objectLister(ctrl)
+			}
+			uc := usecase.NewGCUseCase(tt.fields.repo(ctrl), tt.fields.objectStorage(ctrl), objectLister)
+			got, err := uc.Run(ctx, tt.args.query)
+
+			if tt.wantErr != nil {
+				if err == nil {
+					t.Fatalf("Run() error = nil, wantErr %v", tt.wantErr)
+				}
+				if diff := cmp.Diff(tt.wantErr.Error(), err.Error()); diff != "" {
+					t.Errorf("Run() error mismatch (-want +got):\n%s", diff)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Run() unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(domain.LFSObject{}, domain.OID{}, domain.Size{}, domain.HashAlgorithm{}, domain.StorageKey{})); diff != "" {
+				t.Errorf("Run() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
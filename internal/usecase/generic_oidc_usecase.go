@@ -0,0 +1,89 @@
+//go:generate mockgen -source=$GOFILE -destination=../../tests/usecase/mock_generic_oidc_usecase.go -package=usecase
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/na2na-p/cargohold/internal/domain"
+)
+
+// GenericOIDCProvider はGitHub Actions以外のOIDCプロバイダーのトークンを検証する
+type GenericOIDCProvider interface {
+	VerifyIDToken(ctx context.Context, token string) (*domain.OIDCIdentity, error)
+}
+
+// GenericOIDCUseCase はGitLab CIやBuildkite、KubernetesのServiceAccountなどが発行したOIDCトークンで認証する
+// クレームから求めたリポジトリ名はGitHubのリポジトリと同じ名前空間に対応付けられるため、
+// 他のサービス上の同名のプロジェクトがGitHubのリポジトリとして扱われないよう、issuerごとに許可したリポジトリのみを受け付ける
+type GenericOIDCUseCase struct {
+	provider          GenericOIDCProvider
+	repoAllowlistRepo domain.RepositoryAllowlistRepository
+	// repositories はこのissuerのトークンに操作を許可するリポジトリ
+	repositories *domain.RepositoryScope
+	// allowWrite がfalseの場合、ダウンロードのみを許可する
+	allowWrite bool
+}
+
+func NewGenericOIDCUseCase(
+	provider GenericOIDCProvider,
+	repoAllowlistRepo domain.RepositoryAllowlistRepository,
+	repositories *domain.RepositoryScope,
+	allowWrite bool,
+) *GenericOIDCUseCase {
+	return &GenericOIDCUseCase{
+		provider:          provider,
+		repoAllowlistRepo: repoAllowlistRepo,
+		repositories:      repositories,
+		allowWrite:        allowWrite,
+	}
+}
+
+func (uc *GenericOIDCUseCase) Authenticate(ctx context.Context, token string) (*domain.UserInfo, error) {
+	identity, err := uc.provider.VerifyIDToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if identity == nil {
+		return nil, fmt.Errorf("oidc provider returned nil identity")
+	}
+
+	allowedRepo, err := domain.NewAllowedRepositoryFromString(identity.Repository())
+	if err != nil {
+		return nil, fmt.Errorf("リポジトリ形式が不正です: %w", err)
+	}
+
+	repository, err := domain.NewRepositoryIdentifier(identity.Repository())
+	if err != nil {
+		return nil, fmt.Errorf("リポジトリ形式が不正です: %w", err)
+	}
+	if !uc.repositories.Contains(repository) {
+		return nil, fmt.Errorf("%w: repository is not allowed for this issuer: %s", ErrInvalidRepository, identity.Repository())
+	}
+
+	allowed, err := uc.repoAllowlistRepo.IsAllowed(ctx, allowedRepo)
+	if err != nil {
+		return nil, fmt.Errorf("リポジトリ許可チェックに失敗しました: %w", err)
+	}
+	if !allowed {
+		return nil, fmt.Errorf("%w: repository=%s", ErrInvalidRepository, identity.Repository())
+	}
+
+	userInfo, err := identity.ToUserInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	perms := domain.NewRepositoryPermissions(false, false, true, false, false)
+	if uc.allowWrite {
+		perms = domain.NewRepositoryPermissions(false, true, true, false, false)
+	}
+	userInfo.SetPermissions(&perms)
+
+	return userInfo, nil
+}
+
+var (
+	_ OIDCAuthenticator = (*GitHubOIDCUseCase)(nil)
+	_ OIDCAuthenticator = (*GenericOIDCUseCase)(nil)
+)
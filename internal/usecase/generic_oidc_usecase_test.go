@@ -0,0 +1,233 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/na2na-p/cargohold/internal/usecase"
+	mockdomain "github.com/na2na-p/cargohold/tests/domain"
+	mock_usecase "github.com/na2na-p/cargohold/tests/usecase"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGenericOIDCUseCase_Authenticate(t *testing.T) {
+	groupRepo, _ := domain.NewRepositoryIdentifier("group/project")
+
+	type fields struct {
+		setupProvider      func(ctrl *gomock.Controller) *mock_usecase.MockGenericOIDCProvider
+		setupRepoAllowlist func(ctrl *gomock.Controller) *mockdomain.MockRepositoryAllowlistRepository
+		repositories       string
+		allowWrite         bool
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		token   string
+		want    *domain.UserInfo
+		wantErr error
+	}{
+		{
+			name: "正常系: GitLab CIのトークンを検証し、ダウンロード権限のみのユーザー情報を返す",
+			fields: fields{
+				setupProvider: func(ctrl *gomock.Controller) *mock_usecase.MockGenericOIDCProvider {
+					provider := mock_usecase.NewMockGenericOIDCProvider(ctrl)
+					provider.EXPECT().VerifyIDToken(gomock.Any(), "gitlab-token").Return(
+						domain.NewOIDCIdentity(
+							domain.ProviderTypeGitLab,
+							"project_path:group/project:ref_type:branch:ref:main",
+							"group/project",
+							"refs/heads/main",
+							"gitlab-user",
+						), nil)
+					return provider
+				},
+				setupRepoAllowlist: func(ctrl *gomock.Controller) *mockdomain.MockRepositoryAllowlistRepository {
+					repoAllowlist := mockdomain.NewMockRepositoryAllowlistRepository(ctrl)
+					repoAllowlist.EXPECT().IsAllowed(gomock.Any(), gomock.Any()).Return(true, nil)
+					return repoAllowlist
+				},
+				repositories: "group/project",
+			},
+			token: "gitlab-token",
+			want: mustNewUserInfoWithPermissions(t,
+				"project_path:group/project:ref_type:branch:ref:main",
+				"gitlab-user",
+				domain.ProviderTypeGitLab,
+				groupRepo,
+				"refs/heads/main",
+				domain.NewRepositoryPermissions(false, false, true, false, false),
+			),
+		},
+		{
+			name: "正常系: 書き込みを許可したissuerの場合、アップロード権限も付与される",
+			fields: fields{
+				setupProvider: func(ctrl *gomock.Controller) *mock_usecase.MockGenericOIDCProvider {
+					provider := mock_usecase.NewMockGenericOIDCProvider(ctrl)
+					provider.EXPECT().VerifyIDToken(gomock.Any(), "k8s-token").Return(
+						domain.NewOIDCIdentity(
+							domain.ProviderTypeKubernetes,
+							"system:serviceaccount:group:project",
+							"group/project",
+							"",
+							"system:serviceaccount:group:project",
+						), nil)
+					return provider
+				},
+				setupRepoAllowlist: func(ctrl *gomock.Controller) *mockdomain.MockRepositoryAllowlistRepository {
+					repoAllowlist := mockdomain.NewMockRepositoryAllowlistRepository(ctrl)
+					repoAllowlist.EXPECT().IsAllowed(gomock.Any(), gomock.Any()).Return(true, nil)
+					return repoAllowlist
+				},
+				repositories: "group/*",
+				allowWrite:   true,
+			},
+			token: "k8s-token",
+			want: mustNewUserInfoWithPermissions(t,
+				"system:serviceaccount:group:project",
+				"system:serviceaccount:group:project",
+				domain.ProviderTypeKubernetes,
+				groupRepo,
+				"",
+				domain.NewRepositoryPermissions(false, true, true, false, false),
+			),
+		},
+		{
+			name: "異常系: トークン検証に失敗した場合、エラーを返す",
+			fields: fields{
+				setupProvider: func(ctrl *gomock.Controller) *mock_usecase.MockGenericOIDCProvider {
+					provider := mock_usecase.NewMockGenericOIDCProvider(ctrl)
+					provider.EXPECT().VerifyIDToken(gomock.Any(), "invalid-token").Return(nil, errors.New("トークン検証エラー"))
+					return provider
+				},
+				setupRepoAllowlist: func(ctrl *gomock.Controller) *mockdomain.MockRepositoryAllowlistRepository {
+					return mockdomain.NewMockRepositoryAllowlistRepository(ctrl)
+				},
+			},
+			token:   "invalid-token",
+			wantErr: errors.New("トークン検証エラー"),
+		},
+		{
+			name: "異常系: プロバイダーがnilを返した場合、エラーを返す",
+			fields: fields{
+				setupProvider: func(ctrl *gomock.Controller) *mock_usecase.MockGenericOIDCProvider {
+					provider := mock_usecase.NewMockGenericOIDCProvider(ctrl)
+					provider.EXPECT().VerifyIDToken(gomock.Any(), "nil-result-token").Return(nil, nil)
+					return provider
+				},
+				setupRepoAllowlist: func(ctrl *gomock.Controller) *mockdomain.MockRepositoryAllowlistRepository {
+					return mockdomain.NewMockRepositoryAllowlistRepository(ctrl)
+				},
+			},
+			token:   "nil-result-token",
+			wantErr: errors.New("oidc provider returned nil identity"),
+		},
+		{
+			name: "異常系: リポジトリ形式が不正な場合、エラーを返す",
+			fields: fields{
+				setupProvider: func(ctrl *gomock.Controller) *mock_usecase.MockGenericOIDCProvider {
+					provider := mock_usecase.NewMockGenericOIDCProvider(ctrl)
+					provider.EXPECT().VerifyIDToken(gomock.Any(), "nested-group-token").Return(
+						domain.NewOIDCIdentity(domain.ProviderTypeGitLab, "sub", "group/subgroup/project", "", ""), nil)
+					return provider
+				},
+				setupRepoAllowlist: func(ctrl *gomock.Controller) *mockdomain.MockRepositoryAllowlistRepository {
+					return mockdomain.NewMockRepositoryAllowlistRepository(ctrl)
+				},
+			},
+			token:   "nested-group-token",
+			wantErr: domain.ErrInvalidAllowedRepositoryFormat,
+		},
+		{
+			name: "異常系: 許可されていないリポジトリの場合、ErrInvalidRepositoryを返す",
+			fields: fields{
+				setupProvider: func(ctrl *gomock.Controller) *mock_usecase.MockGenericOIDCProvider {
+					provider := mock_usecase.NewMockGenericOIDCProvider(ctrl)
+					provider.EXPECT().VerifyIDToken(gomock.Any(), "unauthorized-repo-token").Return(
+						domain.NewOIDCIdentity(domain.ProviderTypeGitLab, "sub", "group/project", "", ""), nil)
+					return provider
+				},
+				setupRepoAllowlist: func(ctrl *gomock.Controller) *mockdomain.MockRepositoryAllowlistRepository {
+					repoAllowlist := mockdomain.NewMockRepositoryAllowlistRepository(ctrl)
+					repoAllowlist.EXPECT().IsAllowed(gomock.Any(), gomock.Any()).Return(false, nil)
+					return repoAllowlist
+				},
+			},
+			token:   "unauthorized-repo-token",
+			wantErr: usecase.ErrInvalidRepository,
+		},
+		{
+			name: "異常系: GitHubのリポジトリとして許可されていても、issuerに許可されていない同名のリポジトリの場合、ErrInvalidRepositoryを返す",
+			fields: fields{
+				setupProvider: func(ctrl *gomock.Controller) *mock_usecase.MockGenericOIDCProvider {
+					provider := mock_usecase.NewMockGenericOIDCProvider(ctrl)
+					provider.EXPECT().VerifyIDToken(gomock.Any(), "gitlab-org-repo-token").Return(
+						domain.NewOIDCIdentity(domain.ProviderTypeGitLab, "project_path:org/repo:ref_type:branch:ref:main", "org/repo", "refs/heads/main", "gitlab-user"), nil)
+					return provider
+				},
+				setupRepoAllowlist: func(ctrl *gomock.Controller) *mockdomain.MockRepositoryAllowlistRepository {
+					// org/repo はGitHubのリポジトリとして許可リストに登録されている
+					repoAllowlist := mockdomain.NewMockRepositoryAllowlistRepository(ctrl)
+					repoAllowlist.EXPECT().IsAllowed(gomock.Any(), gomock.Any()).Return(true, nil).AnyTimes()
+					return repoAllowlist
+				},
+				repositories: "group/*",
+				allowWrite:   true,
+			},
+			token:   "gitlab-org-repo-token",
+			wantErr: usecase.ErrInvalidRepository,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			ctrl := gomock.NewController(t)
+
+			repositories := "group/project"
+			if tt.fields.repositories != "" {
+				repositories = tt.fields.repositories
+			}
+			scope, err := domain.ParseRepositoryScope(repositories)
+			if err != nil {
+				t.Fatalf("ParseRepositoryScope() failed: %v", err)
+			}
+
+			uc := usecase.NewGenericOIDCUseCase(
+				tt.fields.setupProvider(ctrl),
+				tt.fields.setupRepoAllowlist(ctrl),
+				scope,
+				tt.fields.allowWrite,
+			)
+
+			got, err := uc.Authenticate(ctx, tt.token)
+
+			if tt.wantErr != nil {
+				if err == nil {
+					t.Fatalf("Authenticate() error = nil, wantErr %v", tt.wantErr)
+				}
+				if !errors.Is(err, tt.wantErr) && err.Error() != tt.wantErr.Error() {
+					t.Errorf("Authenticate() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Authenticate() unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(domain.UserInfo{}, domain.ProviderType{}, domain.RepositoryIdentifier{}, domain.RepositoryPermissions{})); diff != "" {
+				t.Errorf("Authenticate() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func mustNewUserInfoWithPermissions(t *testing.T, sub, name string, provider domain.ProviderType, repository *domain.RepositoryIdentifier, ref string, perms domain.RepositoryPermissions) *domain.UserInfo {
+	t.Helper()
+	userInfo, err := domain.NewUserInfo(sub, "", name, provider, repository, ref)
+	if err != nil {
+		t.Fatalf("mustNewUserInfoWithPermissions: %v", err)
+	}
+	userInfo.SetPermissions(&perms)
+	return userInfo
+}
@@ -0,0 +1,103 @@
+//go:generate mockgen -source=$GOFILE -destination=../../tests/usecase/mock_github_claim_rule_usecase.go -package=usecase
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/na2na-p/cargohold/internal/domain"
+)
+
+// GitHubClaimRuleInput はクレームルールの追加内容
+// パターンを指定しない項目は任意の値に一致する
+type GitHubClaimRuleInput struct {
+	Priority        int
+	Refs            []string
+	EventNames      []string
+	Environments    []string
+	JobWorkflowRefs []string
+	Access          string
+}
+
+type GitHubClaimRuleUseCase interface {
+	List(ctx context.Context, fullName string) ([]*domain.GitHubClaimRule, error)
+	Add(ctx context.Context, fullName string, input GitHubClaimRuleInput) (*domain.GitHubClaimRule, error)
+	Remove(ctx context.Context, fullName string, id string) error
+}
+
+type gitHubClaimRuleUseCaseImpl struct {
+	ruleRepo domain.GitHubClaimRuleRepository
+}
+
+// NewGitHubClaimRuleUseCase はGitHub Actionsのトークンに対するクレームルールを管理するユースケースを作成する
+// 認証時のキャッシュと整合させるため、ruleRepoにはキャッシュを破棄する実装を渡す
+func NewGitHubClaimRuleUseCase(ruleRepo domain.GitHubClaimRuleRepository) GitHubClaimRuleUseCase {
+	return &gitHubClaimRuleUseCaseImpl{
+		ruleRepo: ruleRepo,
+	}
+}
+
+// List はリポジトリに設定されたルールを評価順に返す
+func (u *gitHubClaimRuleUseCaseImpl) List(ctx context.Context, fullName string) ([]*domain.GitHubClaimRule, error) {
+	repository, err := domain.NewRepositoryIdentifier(fullName)
+	if err != nil {
+		return nil, ErrInvalidRepository
+	}
+
+	rules, err := u.ruleRepo.FindByRepository(ctx, repository)
+	if err != nil {
+		return nil, fmt.Errorf("クレームルール一覧の取得に失敗しました: %w", err)
+	}
+	return rules, nil
+}
+
+// Add はリポジトリにルールを追加する
+// リポジトリに最初のルールを追加すると、いずれのルールにも一致しないトークンは権限を持たなくなる
+func (u *gitHubClaimRuleUseCaseImpl) Add(ctx context.Context, fullName string, input GitHubClaimRuleInput) (*domain.GitHubClaimRule, error) {
+	repository, err := domain.NewRepositoryIdentifier(fullName)
+	if err != nil {
+		return nil, ErrInvalidRepository
+	}
+
+	condition, err := domain.NewGitHubClaimCondition(input.Refs, input.EventNames, input.Environments, input.JobWorkflowRefs)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidClaimRule, err)
+	}
+	access, err := domain.NewRepositoryAccessLevel(input.Access)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidClaimRule, err)
+	}
+
+	rule, err := domain.NewGitHubClaimRule(ctx, repository, input.Priority, condition, access)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidClaimRule, err)
+	}
+
+	created, err := u.ruleRepo.Create(ctx, rule)
+	if err != nil {
+		return nil, fmt.Errorf("クレームルールの追加に失敗しました: %w", err)
+	}
+	return created, nil
+}
+
+// Remove はリポジトリからルールを削除する
+func (u *gitHubClaimRuleUseCaseImpl) Remove(ctx context.Context, fullName string, id string) error {
+	repository, err := domain.NewRepositoryIdentifier(fullName)
+	if err != nil {
+		return ErrInvalidRepository
+	}
+
+	ruleID, err := domain.ParseGitHubClaimRuleID(id)
+	if err != nil {
+		return ErrInvalidClaimRuleID
+	}
+
+	if err := u.ruleRepo.Delete(ctx, repository, ruleID); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return ErrClaimRuleNotFound
+		}
+		return fmt.Errorf("クレームルールの削除に失敗しました: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,205 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/na2na-p/cargohold/internal/usecase"
+	mock_domain "github.com/na2na-p/cargohold/tests/domain"
+	"github.com/newmo-oss/ctxtime/ctxtimetest"
+	"github.com/newmo-oss/testid"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGitHubClaimRuleUseCase_Add(t *testing.T) {
+	fixedNow := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		ruleRepo func(ctrl *gomock.Controller) domain.GitHubClaimRuleRepository
+		fullName string
+		input    usecase.GitHubClaimRuleInput
+		wantID   int64
+		wantErr  error
+	}{
+		{
+			name: "正常系: ルールが追加される",
+			ruleRepo: func(ctrl *gomock.Controller) domain.GitHubClaimRuleRepository {
+				mock := mock_domain.NewMockGitHubClaimRuleRepository(ctrl)
+				mock.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, rule *domain.GitHubClaimRule) (*domain.GitHubClaimRule, error) {
+					if diff := cmp.Diff([]string{"refs/heads/main", "refs/tags/*"}, rule.Condition().Refs()); diff != "" {
+						t.Errorf("Create() refs mismatch (-want +got):\n%s", diff)
+					}
+					if !rule.CreatedAt().Equal(fixedNow) {
+						t.Errorf("Create() createdAt = %v, want %v", rule.CreatedAt(), fixedNow)
+					}
+					id, _ := domain.NewGitHubClaimRuleID(5)
+					return domain.ReconstructGitHubClaimRule(id, rule.Repository(), rule.Priority(), rule.Condition(), rule.Access(), rule.CreatedAt()), nil
+				})
+				return mock
+			},
+			fullName: "owner/repo",
+			input: usecase.GitHubClaimRuleInput{
+				Priority: 10,
+				Refs:     []string{"refs/heads/main", "refs/tags/*"},
+				Access:   "write",
+			},
+			wantID: 5,
+		},
+		{
+			name: "異常系: owner/repo形式でない場合、ErrInvalidRepositoryが返る",
+			ruleRepo: func(ctrl *gomock.Controller) domain.GitHubClaimRuleRepository {
+				return mock_domain.NewMockGitHubClaimRuleRepository(ctrl)
+			},
+			fullName: "invalid",
+			input:    usecase.GitHubClaimRuleInput{Access: "read"},
+			wantErr:  usecase.ErrInvalidRepository,
+		},
+		{
+			name: "異常系: アクセスレベルが不正な場合、ErrInvalidClaimRuleが返る",
+			ruleRepo: func(ctrl *gomock.Controller) domain.GitHubClaimRuleRepository {
+				return mock_domain.NewMockGitHubClaimRuleRepository(ctrl)
+			},
+			fullName: "owner/repo",
+			input:    usecase.GitHubClaimRuleInput{Access: "owner"},
+			wantErr:  usecase.ErrInvalidClaimRule,
+		},
+		{
+			name: "異常系: 空のパターンが含まれる場合、ErrInvalidClaimRuleが返る",
+			ruleRepo: func(ctrl *gomock.Controller) domain.GitHubClaimRuleRepository {
+				return mock_domain.NewMockGitHubClaimRuleRepository(ctrl)
+			},
+			fullName: "owner/repo",
+			input:    usecase.GitHubClaimRuleInput{EventNames: []string{""}, Access: "read"},
+			wantErr:  usecase.ErrInvalidClaimRule,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ctx := testid.WithValue(context.Background(), t.Name())
+			ctxtimetest.SetFixedNow(t, ctx, fixedNow)
+
+			uc := usecase.NewGitHubClaimRuleUseCase(tt.ruleRepo(ctrl))
+			got, err := uc.Add(ctx, tt.fullName, tt.input)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Add() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && got.ID().Int64() != tt.wantID {
+				t.Errorf("Add() ID = %v, want %v", got.ID().Int64(), tt.wantID)
+			}
+		})
+	}
+}
+
+func TestGitHubClaimRuleUseCase_Remove(t *testing.T) {
+	tests := []struct {
+		name     string
+		ruleRepo func(ctrl *gomock.Controller) domain.GitHubClaimRuleRepository
+		fullName string
+		id       string
+		wantErr  error
+	}{
+		{
+			name: "正常系: ルールが削除される",
+			ruleRepo: func(ctrl *gomock.Controller) domain.GitHubClaimRuleRepository {
+				mock := mock_domain.NewMockGitHubClaimRuleRepository(ctrl)
+				mock.EXPECT().Delete(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, repository *domain.RepositoryIdentifier, id domain.GitHubClaimRuleID) error {
+					if repository.FullName() != "owner/repo" || id.Int64() != 3 {
+						t.Errorf("Delete() args = %v, %v", repository.FullName(), id.Int64())
+					}
+					return nil
+				})
+				return mock
+			},
+			fullName: "owner/repo",
+			id:       "3",
+		},
+		{
+			name: "異常系: IDが数値でない場合、ErrInvalidClaimRuleIDが返る",
+			ruleRepo: func(ctrl *gomock.Controller) domain.GitHubClaimRuleRepository {
+				return mock_domain.NewMockGitHubClaimRuleRepository(ctrl)
+			},
+			fullName: "owner/repo",
+			id:       "abc",
+			wantErr:  usecase.ErrInvalidClaimRuleID,
+		},
+		{
+			name: "異常系: ルールが存在しない場合、ErrClaimRuleNotFoundが返る",
+			ruleRepo: func(ctrl *gomock.Controller) domain.GitHubClaimRuleRepository {
+				mock := mock_domain.NewMockGitHubClaimRuleRepository(ctrl)
+				mock.EXPECT().Delete(gomock.Any(), gomock.Any(), gomock.Any()).Return(domain.ErrNotFound)
+				return mock
+			},
+			fullName: "owner/repo",
+			id:       "3",
+			wantErr:  usecase.ErrClaimRuleNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			uc := usecase.NewGitHubClaimRuleUseCase(tt.ruleRepo(ctrl))
+			err := uc.Remove(context.Background(), tt.fullName, tt.id)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Remove() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGitHubClaimRuleUseCase_List(t *testing.T) {
+	tests := []struct {
+		name     string
+		ruleRepo func(ctrl *gomock.Controller) domain.GitHubClaimRuleRepository
+		fullName string
+		wantLen  int
+		wantErr  bool
+	}{
+		{
+			name: "正常系: リポジトリのルール一覧が返る",
+			ruleRepo: func(ctrl *gomock.Controller) domain.GitHubClaimRuleRepository {
+				mock := mock_domain.NewMockGitHubClaimRuleRepository(ctrl)
+				mock.EXPECT().FindByRepository(gomock.Any(), gomock.Any()).Return([]*domain.GitHubClaimRule{{}}, nil)
+				return mock
+			},
+			fullName: "owner/repo",
+			wantLen:  1,
+		},
+		{
+			name: "異常系: 取得に失敗した場合、エラーが返る",
+			ruleRepo: func(ctrl *gomock.Controller) domain.GitHubClaimRuleRepository {
+				mock := mock_domain.NewMockGitHubClaimRuleRepository(ctrl)
+				mock.EXPECT().FindByRepository(gomock.Any(), gomock.Any()).Return(nil, errors.New("database error"))
+				return mock
+			},
+			fullName: "owner/repo",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			uc := usecase.NewGitHubClaimRuleUseCase(tt.ruleRepo(ctrl))
+			got, err := uc.List(context.Background(), tt.fullName)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("List() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(got) != tt.wantLen {
+				t.Errorf("List() len = %v, want %v", len(got), tt.wantLen)
+			}
+		})
+	}
+}
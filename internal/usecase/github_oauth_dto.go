@@ -1,5 +1,7 @@
 package usecase
 
+import "time"
+
 // OAuthTokenResult はOAuthトークン情報を表すDTO
 type OAuthTokenResult struct {
 	AccessToken string
@@ -7,9 +9,32 @@ type OAuthTokenResult struct {
 	Scope       string
 }
 
+// SessionToken はセッションの権限を再検証するためにセッションと共に保存するOAuthトークン
+type SessionToken struct {
+	Token *OAuthTokenResult
+	// CheckedAt は最後に権限を確認した日時
+	CheckedAt time.Time
+}
+
+// AuthorizationRequest はブラウザをGitHubの認可画面へリダイレクトするための情報を表すDTO
+type AuthorizationRequest struct {
+	URL string
+	// State はコールバックで照合するためにブラウザのCookieに保存するstate
+	State string
+}
+
 // GitHubUserResult はGitHubユーザー情報を表すDTO
 type GitHubUserResult struct {
 	ID    int64
 	Login string
 	Name  string
 }
+
+// DeviceCodeResult はデバイスフローで発行されたコードを表すDTO
+type DeviceCodeResult struct {
+	DeviceCode      string
+	UserCode        string
+	VerificationURI string
+	ExpiresIn       time.Duration
+	Interval        time.Duration
+}
@@ -14,9 +14,13 @@ const (
 )
 
 type GitHubOAuthProviderInterface interface {
-	SetRedirectURI(redirectURI string)
-	GetAuthorizationURL(state string) string
-	ExchangeCode(ctx context.Context, code string) (*OAuthTokenResult, error)
+	// GetAuthorizationURL はstateとPKCEのcode_challengeを含む認可URLを返す
+	// リダイレクトURIをリクエストごとに受け取るため、実装は並行するログインで状態を共有してはならない
+	GetAuthorizationURL(state, redirectURI, codeChallenge string) string
+	// ExchangeCode は認可コードをトークンと交換する。redirectURIとcodeVerifierは認可URLの生成時と同じ値を指定する
+	ExchangeCode(ctx context.Context, code, redirectURI, codeVerifier string) (*OAuthTokenResult, error)
+	RequestDeviceCode(ctx context.Context) (*DeviceCodeResult, error)
+	PollDeviceToken(ctx context.Context, deviceCode string) (*OAuthTokenResult, error)
 	GetUserInfo(ctx context.Context, token *OAuthTokenResult) (*GitHubUserResult, error)
 	CanAccessRepository(ctx context.Context, token *OAuthTokenResult, repo *domain.RepositoryIdentifier) (bool, error)
 	GetRepositoryPermissions(ctx context.Context, token *OAuthTokenResult, repo *domain.RepositoryIdentifier) (domain.RepositoryPermissions, error)
@@ -27,8 +31,16 @@ type OAuthStateStoreInterface interface {
 	GetAndDeleteState(ctx context.Context, state string) (*domain.OAuthState, error)
 }
 
+// DeviceAuthorizationStoreInterface はデバイスフローで承認を待っているリクエストを保持する
+type DeviceAuthorizationStoreInterface interface {
+	SaveDeviceAuthorization(ctx context.Context, id string, data *domain.DeviceAuthorization, ttl time.Duration) error
+	GetDeviceAuthorization(ctx context.Context, id string) (*domain.DeviceAuthorization, error)
+	DeleteDeviceAuthorization(ctx context.Context, id string) error
+}
+
 type SessionStoreInterface interface {
-	CreateSession(ctx context.Context, userInfo *domain.UserInfo, ttl time.Duration) (string, error)
+	// CreateSession はセッションを作成する。tokenは権限の再検証のためにセッションと共に保存される
+	CreateSession(ctx context.Context, userInfo *domain.UserInfo, token *OAuthTokenResult, ttl time.Duration) (string, error)
 	GetSession(ctx context.Context, sessionID string) (*domain.UserInfo, error)
 	DeleteSession(ctx context.Context, sessionID string) error
 }
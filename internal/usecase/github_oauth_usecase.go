@@ -2,7 +2,12 @@ package usecase
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
 	"fmt"
+	"log/slog"
 	"strconv"
 
 	"github.com/google/uuid"
@@ -13,14 +18,22 @@ type GitHubOAuthUseCase struct {
 	oauthProvider       GitHubOAuthProviderInterface
 	sessionStore        SessionStoreInterface
 	stateStore          OAuthStateStoreInterface
+	deviceStore         DeviceAuthorizationStoreInterface
 	allowedRedirectURIs *domain.AllowedRedirectURIs
+	// repositoryScopeEnabled は複数のリポジトリを対象とするセッションでのログインを受け付けるかどうか
+	repositoryScopeEnabled bool
 }
 
+// NewGitHubOAuthUseCase はGitHub OAuthでログインしてセッションを作成するGitHubOAuthUseCaseを生成する
+// repositoryScopeEnabledがtrueの場合、複数のリポジトリやオーナー配下のすべてのリポジトリを対象とするセッションでのログインも受け付ける
+// 権限はリポジトリごとに初回のアクセス時に取得するため、その場合sessionStoreはOAuthトークンを保存できる必要がある
 func NewGitHubOAuthUseCase(
 	oauthProvider GitHubOAuthProviderInterface,
 	sessionStore SessionStoreInterface,
 	stateStore OAuthStateStoreInterface,
+	deviceStore DeviceAuthorizationStoreInterface,
 	allowedRedirectURIs *domain.AllowedRedirectURIs,
+	repositoryScopeEnabled bool,
 ) (*GitHubOAuthUseCase, error) {
 	if oauthProvider == nil {
 		return nil, fmt.Errorf("oauthProvider is nil")
@@ -31,53 +44,72 @@ func NewGitHubOAuthUseCase(
 	if stateStore == nil {
 		return nil, fmt.Errorf("stateStore is nil")
 	}
+	if deviceStore == nil {
+		return nil, fmt.Errorf("deviceStore is nil")
+	}
 	if allowedRedirectURIs == nil {
 		return nil, fmt.Errorf("allowedRedirectURIs is nil")
 	}
 	return &GitHubOAuthUseCase{
-		oauthProvider:       oauthProvider,
-		sessionStore:        sessionStore,
-		stateStore:          stateStore,
-		allowedRedirectURIs: allowedRedirectURIs,
+		oauthProvider:          oauthProvider,
+		sessionStore:           sessionStore,
+		stateStore:             stateStore,
+		deviceStore:            deviceStore,
+		allowedRedirectURIs:    allowedRedirectURIs,
+		repositoryScopeEnabled: repositoryScopeEnabled,
 	}, nil
 }
 
+// StartAuthentication はscopeのリポジトリを対象とするセッションを作成するためのGitHubの認可URLを返す
+// 返却したStateはブラウザに保存させ、コールバックでHandleCallbackに渡す
 func (u *GitHubOAuthUseCase) StartAuthentication(
 	ctx context.Context,
-	repository *domain.RepositoryIdentifier,
+	scope *domain.RepositoryScope,
 	redirectURI string,
 	shell domain.ShellType,
-) (string, error) {
-	if repository == nil {
-		return "", fmt.Errorf("%w: repository is nil", ErrInvalidRepository)
+) (*AuthorizationRequest, error) {
+	if err := u.validateScope(scope); err != nil {
+		return nil, err
 	}
 
 	if redirectURI == "" {
-		return "", fmt.Errorf("%w: redirectURI is empty", ErrInvalidRedirectURI)
+		return nil, fmt.Errorf("%w: redirectURI is empty", ErrInvalidRedirectURI)
 	}
 
 	if !u.allowedRedirectURIs.Contains(redirectURI) {
-		return "", fmt.Errorf("%w: redirectURI not in allowed list", ErrInvalidRedirectURI)
+		return nil, fmt.Errorf("%w: redirectURI not in allowed list", ErrInvalidRedirectURI)
+	}
+
+	codeVerifier, err := newRandomToken()
+	if err != nil {
+		return nil, fmt.Errorf("PKCEのcode_verifierの生成に失敗しました: %w", err)
 	}
 
-	state := uuid.New().String()
+	state, err := newRandomToken()
+	if err != nil {
+		return nil, fmt.Errorf("stateの生成に失敗しました: %w", err)
+	}
 
-	stateData := domain.NewOAuthState(repository.FullName(), redirectURI, shell)
+	stateData := domain.NewOAuthState(scope.String(), redirectURI, codeVerifier, shell)
 
 	if err := u.stateStore.SaveState(ctx, state, stateData, OIDCStateTTL); err != nil {
-		return "", fmt.Errorf("%w: %v", ErrStateSaveFailed, err)
+		return nil, fmt.Errorf("%w: %v", ErrStateSaveFailed, err)
 	}
 
-	u.oauthProvider.SetRedirectURI(redirectURI)
-	authURL := u.oauthProvider.GetAuthorizationURL(state)
-
-	return authURL, nil
+	return &AuthorizationRequest{
+		URL:   u.oauthProvider.GetAuthorizationURL(state, redirectURI, domain.PKCECodeChallenge(codeVerifier)),
+		State: state,
+	}, nil
 }
 
+// HandleCallback は認可コードをトークンと交換してセッションを作成する
+// browserStateにはStartAuthenticationでブラウザに保存させたstateを指定し、
+// 他のブラウザで開始されたログインのコールバック（ログインCSRF）を拒否する
 func (u *GitHubOAuthUseCase) HandleCallback(
 	ctx context.Context,
 	code string,
 	state string,
+	browserState string,
 ) (string, domain.ShellType, error) {
 	if code == "" {
 		return "", domain.ShellType{}, fmt.Errorf("%w: missing code", ErrInvalidCode)
@@ -87,34 +119,155 @@ func (u *GitHubOAuthUseCase) HandleCallback(
 		return "", domain.ShellType{}, fmt.Errorf("%w: missing state", ErrInvalidState)
 	}
 
+	if subtle.ConstantTimeCompare([]byte(state), []byte(browserState)) != 1 {
+		return "", domain.ShellType{}, fmt.Errorf("%w: state is not bound to this browser", ErrInvalidState)
+	}
+
 	stateData, err := u.stateStore.GetAndDeleteState(ctx, state)
 	if err != nil {
 		return "", domain.ShellType{}, fmt.Errorf("%w: %v", ErrInvalidState, err)
 	}
 
-	repository, err := domain.NewRepositoryIdentifier(stateData.Repository())
+	scope, err := domain.ParseRepositoryScope(stateData.Repository())
 	if err != nil {
 		return "", domain.ShellType{}, fmt.Errorf("%w: %v", ErrInvalidRepository, err)
 	}
 
-	u.oauthProvider.SetRedirectURI(stateData.RedirectURI())
-	token, err := u.oauthProvider.ExchangeCode(ctx, code)
+	token, err := u.oauthProvider.ExchangeCode(ctx, code, stateData.RedirectURI(), stateData.CodeVerifier())
 	if err != nil {
 		return "", domain.ShellType{}, fmt.Errorf("%w: %v", ErrCodeExchangeFailed, err)
 	}
 
-	githubUser, err := u.oauthProvider.GetUserInfo(ctx, token)
+	sessionID, err := u.createSession(ctx, token, scope)
+	if err != nil {
+		return "", domain.ShellType{}, err
+	}
+
+	return sessionID, stateData.Shell(), nil
+}
+
+// StartDeviceAuthorization はブラウザのないCLIからのログイン向けにデバイスフローを開始する
+// 返却するDeviceCodeはGitHubのデバイスコードではなく、ポーリング時にリクエストを識別するためのID
+func (u *GitHubOAuthUseCase) StartDeviceAuthorization(
+	ctx context.Context,
+	scope *domain.RepositoryScope,
+) (*DeviceCodeResult, error) {
+	if err := u.validateScope(scope); err != nil {
+		return nil, err
+	}
+
+	code, err := u.oauthProvider.RequestDeviceCode(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDeviceCodeRequestFailed, err)
+	}
+
+	// TTLが0の場合は期限なしで保存されてしまうため、stateと同じ有効期限を使う
+	ttl := code.ExpiresIn
+	if ttl <= 0 {
+		ttl = OIDCStateTTL
+	}
+
+	id := uuid.New().String()
+	data := domain.NewDeviceAuthorization(code.DeviceCode, scope.String())
+	if err := u.deviceStore.SaveDeviceAuthorization(ctx, id, data, ttl); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrStateSaveFailed, err)
+	}
+
+	return &DeviceCodeResult{
+		DeviceCode:      id,
+		UserCode:        code.UserCode,
+		VerificationURI: code.VerificationURI,
+		ExpiresIn:       code.ExpiresIn,
+		Interval:        code.Interval,
+	}, nil
+}
+
+// PollDeviceAuthorization はユーザーがデバイスフローを承認していればセッションを作成してIDを返す
+// 承認前は ErrAuthorizationPending、ポーリング間隔が短すぎる場合は ErrSlowDown を返す
+func (u *GitHubOAuthUseCase) PollDeviceAuthorization(ctx context.Context, deviceCode string) (string, error) {
+	if deviceCode == "" {
+		return "", fmt.Errorf("%w: missing device code", ErrInvalidDeviceCode)
+	}
+
+	data, err := u.deviceStore.GetDeviceAuthorization(ctx, deviceCode)
 	if err != nil {
-		return "", domain.ShellType{}, fmt.Errorf("%w: %v", ErrUserInfoFailed, err)
+		return "", fmt.Errorf("%w: %v", ErrInvalidDeviceCode, err)
 	}
 
-	permissions, err := u.oauthProvider.GetRepositoryPermissions(ctx, token, repository)
+	scope, err := domain.ParseRepositoryScope(data.Repository())
 	if err != nil {
-		return "", domain.ShellType{}, fmt.Errorf("%w: %v", ErrRepositoryAccessCheckFailed, err)
+		return "", fmt.Errorf("%w: %v", ErrInvalidRepository, err)
 	}
 
-	if !permissions.CanDownload() {
-		return "", domain.ShellType{}, fmt.Errorf("%w: user cannot access repository %s", ErrRepositoryAccessDenied, repository.FullName())
+	token, err := u.oauthProvider.PollDeviceToken(ctx, data.DeviceCode())
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrAuthorizationPending), errors.Is(err, ErrSlowDown):
+			return "", err
+		case errors.Is(err, ErrInvalidDeviceCode), errors.Is(err, ErrDeviceAuthorizationDenied):
+			u.deleteDeviceAuthorization(ctx, deviceCode)
+			return "", err
+		default:
+			return "", fmt.Errorf("%w: %v", ErrCodeExchangeFailed, err)
+		}
+	}
+
+	// アクセストークンは一度しか発行されないため、セッションの作成に失敗してもリクエストは破棄する
+	u.deleteDeviceAuthorization(ctx, deviceCode)
+
+	return u.createSession(ctx, token, scope)
+}
+
+func (u *GitHubOAuthUseCase) deleteDeviceAuthorization(ctx context.Context, deviceCode string) {
+	if err := u.deviceStore.DeleteDeviceAuthorization(ctx, deviceCode); err != nil {
+		slog.Warn("デバイスフローのリクエストの削除に失敗しました", "error", err)
+	}
+}
+
+// newRandomToken は32バイトの乱数をbase64urlで符号化した43文字の文字列を生成する
+// PKCEのcode_verifierとして使える文字数と文字種を満たす
+func newRandomToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// validateScope はログインを受け付けるスコープかを検証する
+func (u *GitHubOAuthUseCase) validateScope(scope *domain.RepositoryScope) error {
+	if scope == nil {
+		return fmt.Errorf("%w: repository is nil", ErrInvalidRepository)
+	}
+	if scope.SingleRepository() == nil && !u.repositoryScopeEnabled {
+		return fmt.Errorf("%w: %s", ErrRepositoryScopeNotEnabled, scope.String())
+	}
+	return nil
+}
+
+// createSession はscopeのリポジトリを対象とするセッションを作成する
+// 単一のリポジトリの場合は作成時に権限を確認し、それ以外は初回のアクセス時にリポジトリごとに権限を取得する
+func (u *GitHubOAuthUseCase) createSession(
+	ctx context.Context,
+	token *OAuthTokenResult,
+	scope *domain.RepositoryScope,
+) (string, error) {
+	githubUser, err := u.oauthProvider.GetUserInfo(ctx, token)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrUserInfoFailed, err)
+	}
+
+	repository := scope.SingleRepository()
+	var permissions domain.RepositoryPermissions
+	if repository != nil {
+		permissions, err = u.oauthProvider.GetRepositoryPermissions(ctx, token, repository)
+		if err != nil {
+			return "", fmt.Errorf("%w: %v", ErrRepositoryAccessCheckFailed, err)
+		}
+
+		if !permissions.CanDownload() {
+			return "", fmt.Errorf("%w: user cannot access repository %s", ErrRepositoryAccessDenied, repository.FullName())
+		}
 	}
 
 	userInfo, err := domain.NewUserInfo(
@@ -126,15 +279,19 @@ func (u *GitHubOAuthUseCase) HandleCallback(
 		"",
 	)
 	if err != nil {
-		return "", domain.ShellType{}, fmt.Errorf("%w: %v", ErrUserInfoCreationFailed, err)
+		return "", fmt.Errorf("%w: %v", ErrUserInfoCreationFailed, err)
 	}
 
-	userInfo.SetPermissions(&permissions)
+	if repository != nil {
+		userInfo.SetPermissions(&permissions)
+	} else {
+		userInfo.SetRepositoryScope(scope)
+	}
 
-	sessionID, err := u.sessionStore.CreateSession(ctx, userInfo, SessionTTL)
+	sessionID, err := u.sessionStore.CreateSession(ctx, userInfo, token, SessionTTL)
 	if err != nil {
-		return "", domain.ShellType{}, fmt.Errorf("%w: %v", ErrSessionCreationFailed, err)
+		return "", fmt.Errorf("%w: %v", ErrSessionCreationFailed, err)
 	}
 
-	return sessionID, stateData.Shell(), nil
+	return sessionID, nil
 }
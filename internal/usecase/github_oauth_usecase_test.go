@@ -5,6 +5,7 @@ import (
 	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/na2na-p/cargohold/internal/domain"
@@ -22,9 +23,18 @@ func mustNewAllowedRedirectURIs(t *testing.T, uris []string) *domain.AllowedRedi
 	return allowedURIs
 }
 
+func mustParseRepositoryScope(t *testing.T, value string) *domain.RepositoryScope {
+	t.Helper()
+	scope, err := domain.ParseRepositoryScope(value)
+	if err != nil {
+		t.Fatalf("failed to parse RepositoryScope: %v", err)
+	}
+	return scope
+}
+
 func TestGitHubOAuthUseCase_StartAuthentication(t *testing.T) {
 	type args struct {
-		repository  *domain.RepositoryIdentifier
+		scope       *domain.RepositoryScope
 		redirectURI string
 	}
 	tests := []struct {
@@ -38,10 +48,7 @@ func TestGitHubOAuthUseCase_StartAuthentication(t *testing.T) {
 		{
 			name: "正常系: 認証URLを生成し返却する",
 			args: args{
-				repository: func() *domain.RepositoryIdentifier {
-					r, _ := domain.NewRepositoryIdentifier("owner/repo")
-					return r
-				}(),
+				scope:       mustParseRepositoryScope(t, "owner/repo"),
 				redirectURI: "https://example.com/callback",
 			},
 			allowedRedirectURIs: func() *domain.AllowedRedirectURIs {
@@ -55,8 +62,7 @@ func TestGitHubOAuthUseCase_StartAuthentication(t *testing.T) {
 
 				stateStore.EXPECT().SaveState(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
-				oauthProvider.EXPECT().SetRedirectURI(gomock.Any())
-				oauthProvider.EXPECT().GetAuthorizationURL(gomock.Any()).Return("https://github.com/login/oauth/authorize?state=xxx")
+				oauthProvider.EXPECT().GetAuthorizationURL(gomock.Any(), gomock.Any(), gomock.Any()).Return("https://github.com/login/oauth/authorize?state=xxx")
 
 				return oauthProvider, sessionStore, stateStore
 			},
@@ -64,9 +70,9 @@ func TestGitHubOAuthUseCase_StartAuthentication(t *testing.T) {
 			wantURL: true,
 		},
 		{
-			name: "異常系: repositoryがnilの場合エラー",
+			name: "異常系: scopeがnilの場合エラー",
 			args: args{
-				repository:  nil,
+				scope:       nil,
 				redirectURI: "https://example.com/callback",
 			},
 			allowedRedirectURIs: func() *domain.AllowedRedirectURIs {
@@ -85,10 +91,7 @@ func TestGitHubOAuthUseCase_StartAuthentication(t *testing.T) {
 		{
 			name: "異常系: redirectURIが空の場合エラー",
 			args: args{
-				repository: func() *domain.RepositoryIdentifier {
-					r, _ := domain.NewRepositoryIdentifier("owner/repo")
-					return r
-				}(),
+				scope:       mustParseRepositoryScope(t, "owner/repo"),
 				redirectURI: "",
 			},
 			allowedRedirectURIs: func() *domain.AllowedRedirectURIs {
@@ -107,10 +110,7 @@ func TestGitHubOAuthUseCase_StartAuthentication(t *testing.T) {
 		{
 			name: "異常系: redirectURIがホワイトリストにない場合エラー",
 			args: args{
-				repository: func() *domain.RepositoryIdentifier {
-					r, _ := domain.NewRepositoryIdentifier("owner/repo")
-					return r
-				}(),
+				scope:       mustParseRepositoryScope(t, "owner/repo"),
 				redirectURI: "https://malicious.com/callback",
 			},
 			allowedRedirectURIs: func() *domain.AllowedRedirectURIs {
@@ -129,10 +129,7 @@ func TestGitHubOAuthUseCase_StartAuthentication(t *testing.T) {
 		{
 			name: "正常系: 複数のホワイトリストURIから一致",
 			args: args{
-				repository: func() *domain.RepositoryIdentifier {
-					r, _ := domain.NewRepositoryIdentifier("owner/repo")
-					return r
-				}(),
+				scope:       mustParseRepositoryScope(t, "owner/repo"),
 				redirectURI: "https://example2.com/callback",
 			},
 			allowedRedirectURIs: func() *domain.AllowedRedirectURIs {
@@ -146,21 +143,36 @@ func TestGitHubOAuthUseCase_StartAuthentication(t *testing.T) {
 
 				stateStore.EXPECT().SaveState(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
-				oauthProvider.EXPECT().SetRedirectURI(gomock.Any())
-				oauthProvider.EXPECT().GetAuthorizationURL(gomock.Any()).Return("https://github.com/login/oauth/authorize?state=xxx")
+				oauthProvider.EXPECT().GetAuthorizationURL(gomock.Any(), gomock.Any(), gomock.Any()).Return("https://github.com/login/oauth/authorize?state=xxx")
 
 				return oauthProvider, sessionStore, stateStore
 			},
 			wantErr: nil,
 			wantURL: true,
 		},
+		{
+			name: "異常系: 複数のリポジトリを対象とするセッションが有効でない場合エラー",
+			args: args{
+				scope:       mustParseRepositoryScope(t, "owner/*"),
+				redirectURI: "https://example.com/callback",
+			},
+			allowedRedirectURIs: func() *domain.AllowedRedirectURIs {
+				a, _ := domain.NewAllowedRedirectURIs([]string{"https://example.com/callback"})
+				return a
+			}(),
+			setupMocks: func(ctrl *gomock.Controller) (*mock_usecase.MockGitHubOAuthProviderInterface, *mock_usecase.MockSessionStoreInterface, *mock_usecase.MockOAuthStateStoreInterface) {
+				oauthProvider := mock_usecase.NewMockGitHubOAuthProviderInterface(ctrl)
+				sessionStore := mock_usecase.NewMockSessionStoreInterface(ctrl)
+				stateStore := mock_usecase.NewMockOAuthStateStoreInterface(ctrl)
+				return oauthProvider, sessionStore, stateStore
+			},
+			wantErr: usecase.ErrRepositoryScopeNotEnabled,
+			wantURL: false,
+		},
 		{
 			name: "異常系: state保存に失敗した場合エラー",
 			args: args{
-				repository: func() *domain.RepositoryIdentifier {
-					r, _ := domain.NewRepositoryIdentifier("owner/repo")
-					return r
-				}(),
+				scope:       mustParseRepositoryScope(t, "owner/repo"),
 				redirectURI: "https://example.com/callback",
 			},
 			allowedRedirectURIs: func() *domain.AllowedRedirectURIs {
@@ -188,12 +200,12 @@ func TestGitHubOAuthUseCase_StartAuthentication(t *testing.T) {
 			defer ctrl.Finish()
 
 			oauthProvider, sessionStore, stateStore := tt.setupMocks(ctrl)
-			uc, err := usecase.NewGitHubOAuthUseCase(oauthProvider, sessionStore, stateStore, tt.allowedRedirectURIs)
+			uc, err := usecase.NewGitHubOAuthUseCase(oauthProvider, sessionStore, stateStore, mock_usecase.NewMockDeviceAuthorizationStoreInterface(ctrl), tt.allowedRedirectURIs, false)
 			if err != nil {
 				t.Fatalf("NewGitHubOAuthUseCase() unexpected error: %v", err)
 			}
 
-			authURL, err := uc.StartAuthentication(ctx, tt.args.repository, tt.args.redirectURI, domain.ShellType{})
+			got, err := uc.StartAuthentication(ctx, tt.args.scope, tt.args.redirectURI, domain.ShellType{})
 
 			if tt.wantErr != nil {
 				if err == nil {
@@ -206,8 +218,8 @@ func TestGitHubOAuthUseCase_StartAuthentication(t *testing.T) {
 				if err != nil {
 					t.Fatalf("StartAuthentication() unexpected error: %v", err)
 				}
-				if tt.wantURL && authURL == "" {
-					t.Errorf("StartAuthentication() authURL is empty")
+				if tt.wantURL && (got.URL == "" || got.State == "") {
+					t.Errorf("StartAuthentication() = %+v, want URL and State", got)
 				}
 			}
 		})
@@ -216,8 +228,9 @@ func TestGitHubOAuthUseCase_StartAuthentication(t *testing.T) {
 
 func TestGitHubOAuthUseCase_HandleCallback(t *testing.T) {
 	type args struct {
-		code  string
-		state string
+		code         string
+		state        string
+		browserState string
 	}
 	tests := []struct {
 		name          string
@@ -230,8 +243,9 @@ func TestGitHubOAuthUseCase_HandleCallback(t *testing.T) {
 		{
 			name: "正常系: コールバックを処理し、セッションIDを返す",
 			args: args{
-				code:  "valid-code",
-				state: "valid-state",
+				code:         "valid-code",
+				state:        "valid-state",
+				browserState: "valid-state",
 			},
 			setupMocks: func(ctrl *gomock.Controller) (*mock_usecase.MockGitHubOAuthProviderInterface, *mock_usecase.MockSessionStoreInterface, *mock_usecase.MockOAuthStateStoreInterface) {
 				oauthProvider := mock_usecase.NewMockGitHubOAuthProviderInterface(ctrl)
@@ -240,16 +254,14 @@ func TestGitHubOAuthUseCase_HandleCallback(t *testing.T) {
 
 				repo, _ := domain.NewRepositoryIdentifier("owner/repo")
 
-				stateStore.EXPECT().GetAndDeleteState(gomock.Any(), "valid-state").Return(domain.NewOAuthState("owner/repo", "https://example.com/callback", domain.ShellTypeBash), nil)
-
-				oauthProvider.EXPECT().SetRedirectURI("https://example.com/callback")
+				stateStore.EXPECT().GetAndDeleteState(gomock.Any(), "valid-state").Return(domain.NewOAuthState("owner/repo", "https://example.com/callback", "test-code-verifier", domain.ShellTypeBash), nil)
 
 				token := &usecase.OAuthTokenResult{
 					AccessToken: "access-token",
 					TokenType:   "Bearer",
 					Scope:       "repo",
 				}
-				oauthProvider.EXPECT().ExchangeCode(gomock.Any(), "valid-code").Return(token, nil)
+				oauthProvider.EXPECT().ExchangeCode(gomock.Any(), "valid-code", "https://example.com/callback", "test-code-verifier").Return(token, nil)
 
 				oauthProvider.EXPECT().GetUserInfo(gomock.Any(), token).Return(&usecase.GitHubUserResult{
 					ID:    12345,
@@ -260,7 +272,7 @@ func TestGitHubOAuthUseCase_HandleCallback(t *testing.T) {
 				perms := domain.NewRepositoryPermissions(false, true, true, false, false)
 				oauthProvider.EXPECT().GetRepositoryPermissions(gomock.Any(), token, repo).Return(perms, nil)
 
-				sessionStore.EXPECT().CreateSession(gomock.Any(), gomock.Any(), gomock.Any()).Return("session-id-123", nil)
+				sessionStore.EXPECT().CreateSession(gomock.Any(), gomock.Any(), token, gomock.Any()).Return("session-id-123", nil)
 
 				return oauthProvider, sessionStore, stateStore
 			},
@@ -271,8 +283,9 @@ func TestGitHubOAuthUseCase_HandleCallback(t *testing.T) {
 		{
 			name: "異常系: codeが空の場合エラー",
 			args: args{
-				code:  "",
-				state: "valid-state",
+				code:         "",
+				state:        "valid-state",
+				browserState: "valid-state",
 			},
 			setupMocks: func(ctrl *gomock.Controller) (*mock_usecase.MockGitHubOAuthProviderInterface, *mock_usecase.MockSessionStoreInterface, *mock_usecase.MockOAuthStateStoreInterface) {
 				oauthProvider := mock_usecase.NewMockGitHubOAuthProviderInterface(ctrl)
@@ -286,8 +299,41 @@ func TestGitHubOAuthUseCase_HandleCallback(t *testing.T) {
 		{
 			name: "異常系: stateが空の場合エラー（storeを呼ばない）",
 			args: args{
-				code:  "valid-code",
-				state: "",
+				code:         "valid-code",
+				state:        "",
+				browserState: "",
+			},
+			setupMocks: func(ctrl *gomock.Controller) (*mock_usecase.MockGitHubOAuthProviderInterface, *mock_usecase.MockSessionStoreInterface, *mock_usecase.MockOAuthStateStoreInterface) {
+				oauthProvider := mock_usecase.NewMockGitHubOAuthProviderInterface(ctrl)
+				sessionStore := mock_usecase.NewMockSessionStoreInterface(ctrl)
+				stateStore := mock_usecase.NewMockOAuthStateStoreInterface(ctrl)
+				return oauthProvider, sessionStore, stateStore
+			},
+			wantErr:       usecase.ErrInvalidState,
+			wantSessionID: false,
+		},
+		{
+			name: "異常系: ブラウザに保存したstateと一致しない場合エラー（storeを呼ばない）",
+			args: args{
+				code:         "valid-code",
+				state:        "valid-state",
+				browserState: "other-state",
+			},
+			setupMocks: func(ctrl *gomock.Controller) (*mock_usecase.MockGitHubOAuthProviderInterface, *mock_usecase.MockSessionStoreInterface, *mock_usecase.MockOAuthStateStoreInterface) {
+				oauthProvider := mock_usecase.NewMockGitHubOAuthProviderInterface(ctrl)
+				sessionStore := mock_usecase.NewMockSessionStoreInterface(ctrl)
+				stateStore := mock_usecase.NewMockOAuthStateStoreInterface(ctrl)
+				return oauthProvider, sessionStore, stateStore
+			},
+			wantErr:       usecase.ErrInvalidState,
+			wantSessionID: false,
+		},
+		{
+			name: "異常系: ブラウザにstateが保存されていない場合エラー（storeを呼ばない）",
+			args: args{
+				code:         "valid-code",
+				state:        "valid-state",
+				browserState: "",
 			},
 			setupMocks: func(ctrl *gomock.Controller) (*mock_usecase.MockGitHubOAuthProviderInterface, *mock_usecase.MockSessionStoreInterface, *mock_usecase.MockOAuthStateStoreInterface) {
 				oauthProvider := mock_usecase.NewMockGitHubOAuthProviderInterface(ctrl)
@@ -301,8 +347,9 @@ func TestGitHubOAuthUseCase_HandleCallback(t *testing.T) {
 		{
 			name: "異常系: state検証に失敗した場合エラー",
 			args: args{
-				code:  "valid-code",
-				state: "invalid-state",
+				code:         "valid-code",
+				state:        "invalid-state",
+				browserState: "invalid-state",
 			},
 			setupMocks: func(ctrl *gomock.Controller) (*mock_usecase.MockGitHubOAuthProviderInterface, *mock_usecase.MockSessionStoreInterface, *mock_usecase.MockOAuthStateStoreInterface) {
 				oauthProvider := mock_usecase.NewMockGitHubOAuthProviderInterface(ctrl)
@@ -319,19 +366,18 @@ func TestGitHubOAuthUseCase_HandleCallback(t *testing.T) {
 		{
 			name: "異常系: コード交換に失敗した場合エラー",
 			args: args{
-				code:  "invalid-code",
-				state: "valid-state",
+				code:         "invalid-code",
+				state:        "valid-state",
+				browserState: "valid-state",
 			},
 			setupMocks: func(ctrl *gomock.Controller) (*mock_usecase.MockGitHubOAuthProviderInterface, *mock_usecase.MockSessionStoreInterface, *mock_usecase.MockOAuthStateStoreInterface) {
 				oauthProvider := mock_usecase.NewMockGitHubOAuthProviderInterface(ctrl)
 				sessionStore := mock_usecase.NewMockSessionStoreInterface(ctrl)
 				stateStore := mock_usecase.NewMockOAuthStateStoreInterface(ctrl)
 
-				stateStore.EXPECT().GetAndDeleteState(gomock.Any(), "valid-state").Return(domain.NewOAuthState("owner/repo", "https://example.com/callback", domain.ShellType{}), nil)
-
-				oauthProvider.EXPECT().SetRedirectURI("https://example.com/callback")
+				stateStore.EXPECT().GetAndDeleteState(gomock.Any(), "valid-state").Return(domain.NewOAuthState("owner/repo", "https://example.com/callback", "test-code-verifier", domain.ShellType{}), nil)
 
-				oauthProvider.EXPECT().ExchangeCode(gomock.Any(), "invalid-code").Return(nil, errors.New("invalid code"))
+				oauthProvider.EXPECT().ExchangeCode(gomock.Any(), "invalid-code", "https://example.com/callback", "test-code-verifier").Return(nil, errors.New("invalid code"))
 
 				return oauthProvider, sessionStore, stateStore
 			},
@@ -341,23 +387,22 @@ func TestGitHubOAuthUseCase_HandleCallback(t *testing.T) {
 		{
 			name: "異常系: ユーザー情報取得に失敗した場合エラー",
 			args: args{
-				code:  "valid-code",
-				state: "valid-state",
+				code:         "valid-code",
+				state:        "valid-state",
+				browserState: "valid-state",
 			},
 			setupMocks: func(ctrl *gomock.Controller) (*mock_usecase.MockGitHubOAuthProviderInterface, *mock_usecase.MockSessionStoreInterface, *mock_usecase.MockOAuthStateStoreInterface) {
 				oauthProvider := mock_usecase.NewMockGitHubOAuthProviderInterface(ctrl)
 				sessionStore := mock_usecase.NewMockSessionStoreInterface(ctrl)
 				stateStore := mock_usecase.NewMockOAuthStateStoreInterface(ctrl)
 
-				stateStore.EXPECT().GetAndDeleteState(gomock.Any(), "valid-state").Return(domain.NewOAuthState("owner/repo", "https://example.com/callback", domain.ShellType{}), nil)
-
-				oauthProvider.EXPECT().SetRedirectURI("https://example.com/callback")
+				stateStore.EXPECT().GetAndDeleteState(gomock.Any(), "valid-state").Return(domain.NewOAuthState("owner/repo", "https://example.com/callback", "test-code-verifier", domain.ShellType{}), nil)
 
 				token := &usecase.OAuthTokenResult{
 					AccessToken: "access-token",
 					TokenType:   "Bearer",
 				}
-				oauthProvider.EXPECT().ExchangeCode(gomock.Any(), "valid-code").Return(token, nil)
+				oauthProvider.EXPECT().ExchangeCode(gomock.Any(), "valid-code", "https://example.com/callback", "test-code-verifier").Return(token, nil)
 
 				oauthProvider.EXPECT().GetUserInfo(gomock.Any(), token).Return(nil, errors.New("user info error"))
 
@@ -369,8 +414,9 @@ func TestGitHubOAuthUseCase_HandleCallback(t *testing.T) {
 		{
 			name: "異常系: リポジトリアクセス権がない場合エラー",
 			args: args{
-				code:  "valid-code",
-				state: "valid-state",
+				code:         "valid-code",
+				state:        "valid-state",
+				browserState: "valid-state",
 			},
 			setupMocks: func(ctrl *gomock.Controller) (*mock_usecase.MockGitHubOAuthProviderInterface, *mock_usecase.MockSessionStoreInterface, *mock_usecase.MockOAuthStateStoreInterface) {
 				oauthProvider := mock_usecase.NewMockGitHubOAuthProviderInterface(ctrl)
@@ -379,15 +425,13 @@ func TestGitHubOAuthUseCase_HandleCallback(t *testing.T) {
 
 				repo, _ := domain.NewRepositoryIdentifier("owner/repo")
 
-				stateStore.EXPECT().GetAndDeleteState(gomock.Any(), "valid-state").Return(domain.NewOAuthState("owner/repo", "https://example.com/callback", domain.ShellType{}), nil)
-
-				oauthProvider.EXPECT().SetRedirectURI("https://example.com/callback")
+				stateStore.EXPECT().GetAndDeleteState(gomock.Any(), "valid-state").Return(domain.NewOAuthState("owner/repo", "https://example.com/callback", "test-code-verifier", domain.ShellType{}), nil)
 
 				token := &usecase.OAuthTokenResult{
 					AccessToken: "access-token",
 					TokenType:   "Bearer",
 				}
-				oauthProvider.EXPECT().ExchangeCode(gomock.Any(), "valid-code").Return(token, nil)
+				oauthProvider.EXPECT().ExchangeCode(gomock.Any(), "valid-code", "https://example.com/callback", "test-code-verifier").Return(token, nil)
 
 				oauthProvider.EXPECT().GetUserInfo(gomock.Any(), token).Return(&usecase.GitHubUserResult{
 					ID:    12345,
@@ -406,8 +450,9 @@ func TestGitHubOAuthUseCase_HandleCallback(t *testing.T) {
 		{
 			name: "異常系: リポジトリアクセス権検証でエラー発生",
 			args: args{
-				code:  "valid-code",
-				state: "valid-state",
+				code:         "valid-code",
+				state:        "valid-state",
+				browserState: "valid-state",
 			},
 			setupMocks: func(ctrl *gomock.Controller) (*mock_usecase.MockGitHubOAuthProviderInterface, *mock_usecase.MockSessionStoreInterface, *mock_usecase.MockOAuthStateStoreInterface) {
 				oauthProvider := mock_usecase.NewMockGitHubOAuthProviderInterface(ctrl)
@@ -416,15 +461,13 @@ func TestGitHubOAuthUseCase_HandleCallback(t *testing.T) {
 
 				repo, _ := domain.NewRepositoryIdentifier("owner/repo")
 
-				stateStore.EXPECT().GetAndDeleteState(gomock.Any(), "valid-state").Return(domain.NewOAuthState("owner/repo", "https://example.com/callback", domain.ShellType{}), nil)
-
-				oauthProvider.EXPECT().SetRedirectURI("https://example.com/callback")
+				stateStore.EXPECT().GetAndDeleteState(gomock.Any(), "valid-state").Return(domain.NewOAuthState("owner/repo", "https://example.com/callback", "test-code-verifier", domain.ShellType{}), nil)
 
 				token := &usecase.OAuthTokenResult{
 					AccessToken: "access-token",
 					TokenType:   "Bearer",
 				}
-				oauthProvider.EXPECT().ExchangeCode(gomock.Any(), "valid-code").Return(token, nil)
+				oauthProvider.EXPECT().ExchangeCode(gomock.Any(), "valid-code", "https://example.com/callback", "test-code-verifier").Return(token, nil)
 
 				oauthProvider.EXPECT().GetUserInfo(gomock.Any(), token).Return(&usecase.GitHubUserResult{
 					ID:    12345,
@@ -442,8 +485,9 @@ func TestGitHubOAuthUseCase_HandleCallback(t *testing.T) {
 		{
 			name: "異常系: セッション作成に失敗した場合エラー",
 			args: args{
-				code:  "valid-code",
-				state: "valid-state",
+				code:         "valid-code",
+				state:        "valid-state",
+				browserState: "valid-state",
 			},
 			setupMocks: func(ctrl *gomock.Controller) (*mock_usecase.MockGitHubOAuthProviderInterface, *mock_usecase.MockSessionStoreInterface, *mock_usecase.MockOAuthStateStoreInterface) {
 				oauthProvider := mock_usecase.NewMockGitHubOAuthProviderInterface(ctrl)
@@ -452,15 +496,13 @@ func TestGitHubOAuthUseCase_HandleCallback(t *testing.T) {
 
 				repo, _ := domain.NewRepositoryIdentifier("owner/repo")
 
-				stateStore.EXPECT().GetAndDeleteState(gomock.Any(), "valid-state").Return(domain.NewOAuthState("owner/repo", "https://example.com/callback", domain.ShellType{}), nil)
-
-				oauthProvider.EXPECT().SetRedirectURI("https://example.com/callback")
+				stateStore.EXPECT().GetAndDeleteState(gomock.Any(), "valid-state").Return(domain.NewOAuthState("owner/repo", "https://example.com/callback", "test-code-verifier", domain.ShellType{}), nil)
 
 				token := &usecase.OAuthTokenResult{
 					AccessToken: "access-token",
 					TokenType:   "Bearer",
 				}
-				oauthProvider.EXPECT().ExchangeCode(gomock.Any(), "valid-code").Return(token, nil)
+				oauthProvider.EXPECT().ExchangeCode(gomock.Any(), "valid-code", "https://example.com/callback", "test-code-verifier").Return(token, nil)
 
 				oauthProvider.EXPECT().GetUserInfo(gomock.Any(), token).Return(&usecase.GitHubUserResult{
 					ID:    12345,
@@ -471,7 +513,7 @@ func TestGitHubOAuthUseCase_HandleCallback(t *testing.T) {
 				perms := domain.NewRepositoryPermissions(false, true, true, false, false)
 				oauthProvider.EXPECT().GetRepositoryPermissions(gomock.Any(), token, repo).Return(perms, nil)
 
-				sessionStore.EXPECT().CreateSession(gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("Redis error"))
+				sessionStore.EXPECT().CreateSession(gomock.Any(), gomock.Any(), token, gomock.Any()).Return("", errors.New("Redis error"))
 
 				return oauthProvider, sessionStore, stateStore
 			},
@@ -488,12 +530,12 @@ func TestGitHubOAuthUseCase_HandleCallback(t *testing.T) {
 
 			oauthProvider, sessionStore, stateStore := tt.setupMocks(ctrl)
 			allowedURIs := mustNewAllowedRedirectURIs(t, []string{"https://example.com/callback"})
-			uc, err := usecase.NewGitHubOAuthUseCase(oauthProvider, sessionStore, stateStore, allowedURIs)
+			uc, err := usecase.NewGitHubOAuthUseCase(oauthProvider, sessionStore, stateStore, mock_usecase.NewMockDeviceAuthorizationStoreInterface(ctrl), allowedURIs, false)
 			if err != nil {
 				t.Fatalf("NewGitHubOAuthUseCase() unexpected error: %v", err)
 			}
 
-			sessionID, shell, err := uc.HandleCallback(ctx, tt.args.code, tt.args.state)
+			sessionID, shell, err := uc.HandleCallback(ctx, tt.args.code, tt.args.state, tt.args.browserState)
 
 			if tt.wantErr != nil {
 				if err == nil {
@@ -528,15 +570,13 @@ func TestGitHubOAuthUseCase_HandleCallback_UserInfoMapping(t *testing.T) {
 
 	repo, _ := domain.NewRepositoryIdentifier("owner/repo")
 
-	stateStore.EXPECT().GetAndDeleteState(gomock.Any(), "valid-state").Return(domain.NewOAuthState("owner/repo", "https://example.com/callback", domain.ShellType{}), nil)
-
-	oauthProvider.EXPECT().SetRedirectURI("https://example.com/callback")
+	stateStore.EXPECT().GetAndDeleteState(gomock.Any(), "valid-state").Return(domain.NewOAuthState("owner/repo", "https://example.com/callback", "test-code-verifier", domain.ShellType{}), nil)
 
 	token := &usecase.OAuthTokenResult{
 		AccessToken: "access-token",
 		TokenType:   "Bearer",
 	}
-	oauthProvider.EXPECT().ExchangeCode(gomock.Any(), "valid-code").Return(token, nil)
+	oauthProvider.EXPECT().ExchangeCode(gomock.Any(), "valid-code", "https://example.com/callback", "test-code-verifier").Return(token, nil)
 
 	oauthProvider.EXPECT().GetUserInfo(gomock.Any(), token).Return(&usecase.GitHubUserResult{
 		ID:    12345,
@@ -548,19 +588,19 @@ func TestGitHubOAuthUseCase_HandleCallback_UserInfoMapping(t *testing.T) {
 	oauthProvider.EXPECT().GetRepositoryPermissions(gomock.Any(), token, repo).Return(perms, nil)
 
 	var capturedUserInfo *domain.UserInfo
-	sessionStore.EXPECT().CreateSession(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
-		func(_ context.Context, userInfo *domain.UserInfo, _ interface{}) (string, error) {
+	sessionStore.EXPECT().CreateSession(gomock.Any(), gomock.Any(), token, gomock.Any()).DoAndReturn(
+		func(_ context.Context, userInfo *domain.UserInfo, _ *usecase.OAuthTokenResult, _ interface{}) (string, error) {
 			capturedUserInfo = userInfo
 			return "session-id", nil
 		},
 	)
 
 	allowedURIs := mustNewAllowedRedirectURIs(t, []string{"https://example.com/callback"})
-	uc, err := usecase.NewGitHubOAuthUseCase(oauthProvider, sessionStore, stateStore, allowedURIs)
+	uc, err := usecase.NewGitHubOAuthUseCase(oauthProvider, sessionStore, stateStore, mock_usecase.NewMockDeviceAuthorizationStoreInterface(ctrl), allowedURIs, false)
 	if err != nil {
 		t.Fatalf("NewGitHubOAuthUseCase() unexpected error: %v", err)
 	}
-	_, _, err = uc.HandleCallback(ctx, "valid-code", "valid-state")
+	_, _, err = uc.HandleCallback(ctx, "valid-code", "valid-state", "valid-state")
 	if err != nil {
 		t.Fatalf("HandleCallback() unexpected error: %v", err)
 	}
@@ -582,11 +622,358 @@ func TestGitHubOAuthUseCase_HandleCallback_UserInfoMapping(t *testing.T) {
 	}
 }
 
+func TestGitHubOAuthUseCase_HandleCallback_RepositoryScope(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	oauthProvider := mock_usecase.NewMockGitHubOAuthProviderInterface(ctrl)
+	sessionStore := mock_usecase.NewMockSessionStoreInterface(ctrl)
+	stateStore := mock_usecase.NewMockOAuthStateStoreInterface(ctrl)
+
+	stateStore.EXPECT().GetAndDeleteState(gomock.Any(), "valid-state").Return(domain.NewOAuthState("owner/repo1,org/*", "https://example.com/callback", "test-code-verifier", domain.ShellType{}), nil)
+
+	token := &usecase.OAuthTokenResult{
+		AccessToken: "access-token",
+		TokenType:   "Bearer",
+	}
+	oauthProvider.EXPECT().ExchangeCode(gomock.Any(), "valid-code", "https://example.com/callback", "test-code-verifier").Return(token, nil)
+	oauthProvider.EXPECT().GetUserInfo(gomock.Any(), token).Return(&usecase.GitHubUserResult{
+		ID:    12345,
+		Login: "testuser",
+		Name:  "Test User",
+	}, nil)
+	// 権限は初回のアクセス時に取得するため、セッションの作成時には問い合わせない
+	oauthProvider.EXPECT().GetRepositoryPermissions(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	var capturedUserInfo *domain.UserInfo
+	sessionStore.EXPECT().CreateSession(gomock.Any(), gomock.Any(), token, gomock.Any()).DoAndReturn(
+		func(_ context.Context, userInfo *domain.UserInfo, _ *usecase.OAuthTokenResult, _ interface{}) (string, error) {
+			capturedUserInfo = userInfo
+			return "session-id", nil
+		},
+	)
+
+	allowedURIs := mustNewAllowedRedirectURIs(t, []string{"https://example.com/callback"})
+	uc, err := usecase.NewGitHubOAuthUseCase(oauthProvider, sessionStore, stateStore, mock_usecase.NewMockDeviceAuthorizationStoreInterface(ctrl), allowedURIs, true)
+	if err != nil {
+		t.Fatalf("NewGitHubOAuthUseCase() unexpected error: %v", err)
+	}
+	sessionID, _, err := uc.HandleCallback(ctx, "valid-code", "valid-state", "valid-state")
+	if err != nil {
+		t.Fatalf("HandleCallback() unexpected error: %v", err)
+	}
+	if sessionID != "session-id" {
+		t.Errorf("HandleCallback() sessionID = %v, want session-id", sessionID)
+	}
+
+	if capturedUserInfo.Repository() != nil {
+		t.Errorf("Repository() = %v, want nil", capturedUserInfo.Repository().FullName())
+	}
+	if capturedUserInfo.Permissions() != nil {
+		t.Errorf("Permissions() = %v, want nil", capturedUserInfo.Permissions())
+	}
+	if diff := cmp.Diff([]string{"owner/repo1", "org/*"}, capturedUserInfo.RepositoryScope().Patterns()); diff != "" {
+		t.Errorf("RepositoryScope() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestGitHubOAuthUseCase_StartAuthentication_RepositoryScopeEnabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	oauthProvider := mock_usecase.NewMockGitHubOAuthProviderInterface(ctrl)
+	stateStore := mock_usecase.NewMockOAuthStateStoreInterface(ctrl)
+	stateStore.EXPECT().SaveState(gomock.Any(), gomock.Any(), gomock.Any(), usecase.OIDCStateTTL).DoAndReturn(
+		func(_ context.Context, _ string, data *domain.OAuthState, _ time.Duration) error {
+			if data.Repository() != "owner/*" {
+				t.Errorf("OAuthState.Repository() = %v, want owner/*", data.Repository())
+			}
+			return nil
+		},
+	)
+	oauthProvider.EXPECT().GetAuthorizationURL(gomock.Any(), "https://example.com/callback", gomock.Any()).Return("https://github.com/login/oauth/authorize")
+
+	allowedURIs := mustNewAllowedRedirectURIs(t, []string{"https://example.com/callback"})
+	uc, err := usecase.NewGitHubOAuthUseCase(oauthProvider, mock_usecase.NewMockSessionStoreInterface(ctrl), stateStore, mock_usecase.NewMockDeviceAuthorizationStoreInterface(ctrl), allowedURIs, true)
+	if err != nil {
+		t.Fatalf("NewGitHubOAuthUseCase() unexpected error: %v", err)
+	}
+
+	got, err := uc.StartAuthentication(context.Background(), mustParseRepositoryScope(t, "owner/*"), "https://example.com/callback", domain.ShellType{})
+	if err != nil {
+		t.Fatalf("StartAuthentication() unexpected error: %v", err)
+	}
+	if got.URL != "https://github.com/login/oauth/authorize" {
+		t.Errorf("StartAuthentication() URL = %v, want https://github.com/login/oauth/authorize", got.URL)
+	}
+}
+
+func TestGitHubOAuthUseCase_StartAuthentication_PKCE(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	oauthProvider := mock_usecase.NewMockGitHubOAuthProviderInterface(ctrl)
+	stateStore := mock_usecase.NewMockOAuthStateStoreInterface(ctrl)
+
+	var savedState string
+	var savedData *domain.OAuthState
+	stateStore.EXPECT().SaveState(gomock.Any(), gomock.Any(), gomock.Any(), usecase.OIDCStateTTL).DoAndReturn(
+		func(_ context.Context, state string, data *domain.OAuthState, _ time.Duration) error {
+			savedState = state
+			savedData = data
+			return nil
+		},
+	)
+	var gotState, gotChallenge string
+	oauthProvider.EXPECT().GetAuthorizationURL(gomock.Any(), "https://example.com/callback", gomock.Any()).DoAndReturn(
+		func(state, _, codeChallenge string) string {
+			gotState = state
+			gotChallenge = codeChallenge
+			return "https://github.com/login/oauth/authorize"
+		},
+	)
+
+	allowedURIs := mustNewAllowedRedirectURIs(t, []string{"https://example.com/callback"})
+	uc, err := usecase.NewGitHubOAuthUseCase(oauthProvider, mock_usecase.NewMockSessionStoreInterface(ctrl), stateStore, mock_usecase.NewMockDeviceAuthorizationStoreInterface(ctrl), allowedURIs, false)
+	if err != nil {
+		t.Fatalf("NewGitHubOAuthUseCase() unexpected error: %v", err)
+	}
+
+	got, err := uc.StartAuthentication(context.Background(), mustParseRepositoryScope(t, "owner/repo"), "https://example.com/callback", domain.ShellType{})
+	if err != nil {
+		t.Fatalf("StartAuthentication() unexpected error: %v", err)
+	}
+
+	// RFC 7636 はcode_verifierを43文字以上128文字以下と定めている
+	if n := len(savedData.CodeVerifier()); n < 43 || n > 128 {
+		t.Errorf("CodeVerifier() length = %d, want 43..128", n)
+	}
+	if diff := cmp.Diff(domain.PKCECodeChallenge(savedData.CodeVerifier()), gotChallenge); diff != "" {
+		t.Errorf("code_challenge mismatch (-want +got):\n%s", diff)
+	}
+	if got.State != savedState || gotState != savedState {
+		t.Errorf("State = %v, authorization URL state = %v, want saved state %v", got.State, gotState, savedState)
+	}
+}
+
+func TestGitHubOAuthUseCase_StartDeviceAuthorization(t *testing.T) {
+	scope := mustParseRepositoryScope(t, "owner/repo")
+	deviceCode := &usecase.DeviceCodeResult{
+		DeviceCode:      "github-device-code",
+		UserCode:        "ABCD-1234",
+		VerificationURI: "https://github.com/login/device",
+		ExpiresIn:       15 * time.Minute,
+		Interval:        5 * time.Second,
+	}
+
+	tests := []struct {
+		name       string
+		scope      *domain.RepositoryScope
+		setupMocks func(oauthProvider *mock_usecase.MockGitHubOAuthProviderInterface, deviceStore *mock_usecase.MockDeviceAuthorizationStoreInterface)
+		wantErr    error
+	}{
+		{
+			name:  "正常系: デバイスコードを要求し、リポジトリと紐付けて保存する",
+			scope: scope,
+			setupMocks: func(oauthProvider *mock_usecase.MockGitHubOAuthProviderInterface, deviceStore *mock_usecase.MockDeviceAuthorizationStoreInterface) {
+				oauthProvider.EXPECT().RequestDeviceCode(gomock.Any()).Return(deviceCode, nil)
+				deviceStore.EXPECT().SaveDeviceAuthorization(gomock.Any(), gomock.Any(),
+					domain.NewDeviceAuthorization("github-device-code", "owner/repo"), 15*time.Minute).Return(nil)
+			},
+		},
+		{
+			name:  "異常系: scopeがnilの場合エラー",
+			scope: nil,
+			setupMocks: func(oauthProvider *mock_usecase.MockGitHubOAuthProviderInterface, deviceStore *mock_usecase.MockDeviceAuthorizationStoreInterface) {
+			},
+			wantErr: usecase.ErrInvalidRepository,
+		},
+		{
+			name:  "異常系: 複数のリポジトリを対象とするセッションが有効でない場合エラー",
+			scope: mustParseRepositoryScope(t, "owner/repo1,owner/repo2"),
+			setupMocks: func(oauthProvider *mock_usecase.MockGitHubOAuthProviderInterface, deviceStore *mock_usecase.MockDeviceAuthorizationStoreInterface) {
+			},
+			wantErr: usecase.ErrRepositoryScopeNotEnabled,
+		},
+		{
+			name:  "異常系: デバイスコードの要求に失敗した場合エラー",
+			scope: scope,
+			setupMocks: func(oauthProvider *mock_usecase.MockGitHubOAuthProviderInterface, deviceStore *mock_usecase.MockDeviceAuthorizationStoreInterface) {
+				oauthProvider.EXPECT().RequestDeviceCode(gomock.Any()).Return(nil, errors.New("device_flow_disabled"))
+			},
+			wantErr: usecase.ErrDeviceCodeRequestFailed,
+		},
+		{
+			name:  "異常系: 保存に失敗した場合エラー",
+			scope: scope,
+			setupMocks: func(oauthProvider *mock_usecase.MockGitHubOAuthProviderInterface, deviceStore *mock_usecase.MockDeviceAuthorizationStoreInterface) {
+				oauthProvider.EXPECT().RequestDeviceCode(gomock.Any()).Return(deviceCode, nil)
+				deviceStore.EXPECT().SaveDeviceAuthorization(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("Redis保存エラー"))
+			},
+			wantErr: usecase.ErrStateSaveFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			oauthProvider := mock_usecase.NewMockGitHubOAuthProviderInterface(ctrl)
+			deviceStore := mock_usecase.NewMockDeviceAuthorizationStoreInterface(ctrl)
+			tt.setupMocks(oauthProvider, deviceStore)
+
+			allowedURIs := mustNewAllowedRedirectURIs(t, []string{"https://example.com/callback"})
+			uc, err := usecase.NewGitHubOAuthUseCase(oauthProvider, mock_usecase.NewMockSessionStoreInterface(ctrl),
+				mock_usecase.NewMockOAuthStateStoreInterface(ctrl), deviceStore, allowedURIs, false)
+			if err != nil {
+				t.Fatalf("NewGitHubOAuthUseCase() unexpected error: %v", err)
+			}
+
+			got, err := uc.StartDeviceAuthorization(context.Background(), tt.scope)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("StartDeviceAuthorization() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("StartDeviceAuthorization() unexpected error: %v", err)
+			}
+			if got.DeviceCode == "" || got.DeviceCode == deviceCode.DeviceCode {
+				t.Errorf("StartDeviceAuthorization() DeviceCode = %q, want generated id", got.DeviceCode)
+			}
+			want := &usecase.DeviceCodeResult{
+				DeviceCode:      got.DeviceCode,
+				UserCode:        "ABCD-1234",
+				VerificationURI: "https://github.com/login/device",
+				ExpiresIn:       15 * time.Minute,
+				Interval:        5 * time.Second,
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("StartDeviceAuthorization() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGitHubOAuthUseCase_PollDeviceAuthorization(t *testing.T) {
+	repo, _ := domain.NewRepositoryIdentifier("owner/repo")
+	authorization := domain.NewDeviceAuthorization("github-device-code", "owner/repo")
+	token := &usecase.OAuthTokenResult{AccessToken: "access-token", TokenType: "bearer"}
+
+	tests := []struct {
+		name          string
+		deviceCode    string
+		setupMocks    func(oauthProvider *mock_usecase.MockGitHubOAuthProviderInterface, sessionStore *mock_usecase.MockSessionStoreInterface, deviceStore *mock_usecase.MockDeviceAuthorizationStoreInterface)
+		wantSessionID string
+		wantErr       error
+	}{
+		{
+			name:       "正常系: 承認済みの場合、セッションを作成しリクエストを削除する",
+			deviceCode: "device-id",
+			setupMocks: func(oauthProvider *mock_usecase.MockGitHubOAuthProviderInterface, sessionStore *mock_usecase.MockSessionStoreInterface, deviceStore *mock_usecase.MockDeviceAuthorizationStoreInterface) {
+				deviceStore.EXPECT().GetDeviceAuthorization(gomock.Any(), "device-id").Return(authorization, nil)
+				oauthProvider.EXPECT().PollDeviceToken(gomock.Any(), "github-device-code").Return(token, nil)
+				deviceStore.EXPECT().DeleteDeviceAuthorization(gomock.Any(), "device-id").Return(nil)
+				oauthProvider.EXPECT().GetUserInfo(gomock.Any(), token).Return(&usecase.GitHubUserResult{ID: 12345, Login: "testuser", Name: "Test User"}, nil)
+				oauthProvider.EXPECT().GetRepositoryPermissions(gomock.Any(), token, repo).Return(domain.NewRepositoryPermissions(false, false, true, false, false), nil)
+				sessionStore.EXPECT().CreateSession(gomock.Any(), gomock.Any(), token, usecase.SessionTTL).Return("session-id-123", nil)
+			},
+			wantSessionID: "session-id-123",
+		},
+		{
+			name:       "異常系: 承認待ちの場合、ErrAuthorizationPendingを返しリクエストは残す",
+			deviceCode: "device-id",
+			setupMocks: func(oauthProvider *mock_usecase.MockGitHubOAuthProviderInterface, sessionStore *mock_usecase.MockSessionStoreInterface, deviceStore *mock_usecase.MockDeviceAuthorizationStoreInterface) {
+				deviceStore.EXPECT().GetDeviceAuthorization(gomock.Any(), "device-id").Return(authorization, nil)
+				oauthProvider.EXPECT().PollDeviceToken(gomock.Any(), "github-device-code").Return(nil, usecase.ErrAuthorizationPending)
+			},
+			wantErr: usecase.ErrAuthorizationPending,
+		},
+		{
+			name:       "異常系: ポーリング間隔が短すぎる場合、ErrSlowDownを返す",
+			deviceCode: "device-id",
+			setupMocks: func(oauthProvider *mock_usecase.MockGitHubOAuthProviderInterface, sessionStore *mock_usecase.MockSessionStoreInterface, deviceStore *mock_usecase.MockDeviceAuthorizationStoreInterface) {
+				deviceStore.EXPECT().GetDeviceAuthorization(gomock.Any(), "device-id").Return(authorization, nil)
+				oauthProvider.EXPECT().PollDeviceToken(gomock.Any(), "github-device-code").Return(nil, usecase.ErrSlowDown)
+			},
+			wantErr: usecase.ErrSlowDown,
+		},
+		{
+			name:       "異常系: ユーザーが拒否した場合、リクエストを削除しエラーを返す",
+			deviceCode: "device-id",
+			setupMocks: func(oauthProvider *mock_usecase.MockGitHubOAuthProviderInterface, sessionStore *mock_usecase.MockSessionStoreInterface, deviceStore *mock_usecase.MockDeviceAuthorizationStoreInterface) {
+				deviceStore.EXPECT().GetDeviceAuthorization(gomock.Any(), "device-id").Return(authorization, nil)
+				oauthProvider.EXPECT().PollDeviceToken(gomock.Any(), "github-device-code").Return(nil, usecase.ErrDeviceAuthorizationDenied)
+				deviceStore.EXPECT().DeleteDeviceAuthorization(gomock.Any(), "device-id").Return(nil)
+			},
+			wantErr: usecase.ErrDeviceAuthorizationDenied,
+		},
+		{
+			name:       "異常系: 存在しないデバイスコードの場合エラー",
+			deviceCode: "unknown",
+			setupMocks: func(oauthProvider *mock_usecase.MockGitHubOAuthProviderInterface, sessionStore *mock_usecase.MockSessionStoreInterface, deviceStore *mock_usecase.MockDeviceAuthorizationStoreInterface) {
+				deviceStore.EXPECT().GetDeviceAuthorization(gomock.Any(), "unknown").Return(nil, errors.New("cache miss"))
+			},
+			wantErr: usecase.ErrInvalidDeviceCode,
+		},
+		{
+			name:       "異常系: デバイスコードが空の場合エラー",
+			deviceCode: "",
+			setupMocks: func(oauthProvider *mock_usecase.MockGitHubOAuthProviderInterface, sessionStore *mock_usecase.MockSessionStoreInterface, deviceStore *mock_usecase.MockDeviceAuthorizationStoreInterface) {
+			},
+			wantErr: usecase.ErrInvalidDeviceCode,
+		},
+		{
+			name:       "異常系: リポジトリへのアクセス権がない場合エラー",
+			deviceCode: "device-id",
+			setupMocks: func(oauthProvider *mock_usecase.MockGitHubOAuthProviderInterface, sessionStore *mock_usecase.MockSessionStoreInterface, deviceStore *mock_usecase.MockDeviceAuthorizationStoreInterface) {
+				deviceStore.EXPECT().GetDeviceAuthorization(gomock.Any(), "device-id").Return(authorization, nil)
+				oauthProvider.EXPECT().PollDeviceToken(gomock.Any(), "github-device-code").Return(token, nil)
+				deviceStore.EXPECT().DeleteDeviceAuthorization(gomock.Any(), "device-id").Return(nil)
+				oauthProvider.EXPECT().GetUserInfo(gomock.Any(), token).Return(&usecase.GitHubUserResult{ID: 12345, Login: "testuser"}, nil)
+				oauthProvider.EXPECT().GetRepositoryPermissions(gomock.Any(), token, repo).Return(domain.NewRepositoryPermissions(false, false, false, false, false), nil)
+			},
+			wantErr: usecase.ErrRepositoryAccessDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			oauthProvider := mock_usecase.NewMockGitHubOAuthProviderInterface(ctrl)
+			sessionStore := mock_usecase.NewMockSessionStoreInterface(ctrl)
+			deviceStore := mock_usecase.NewMockDeviceAuthorizationStoreInterface(ctrl)
+			tt.setupMocks(oauthProvider, sessionStore, deviceStore)
+
+			allowedURIs := mustNewAllowedRedirectURIs(t, []string{"https://example.com/callback"})
+			uc, err := usecase.NewGitHubOAuthUseCase(oauthProvider, sessionStore, mock_usecase.NewMockOAuthStateStoreInterface(ctrl), deviceStore, allowedURIs, false)
+			if err != nil {
+				t.Fatalf("NewGitHubOAuthUseCase() unexpected error: %v", err)
+			}
+
+			got, err := uc.PollDeviceAuthorization(context.Background(), tt.deviceCode)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("PollDeviceAuthorization() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("PollDeviceAuthorization() unexpected error: %v", err)
+			}
+			if got != tt.wantSessionID {
+				t.Errorf("PollDeviceAuthorization() = %q, want %q", got, tt.wantSessionID)
+			}
+		})
+	}
+}
+
 func TestNewGitHubOAuthUseCase(t *testing.T) {
 	type fields struct {
 		oauthProvider       usecase.GitHubOAuthProviderInterface
 		sessionStore        usecase.SessionStoreInterface
 		stateStore          usecase.OAuthStateStoreInterface
+		deviceStore         usecase.DeviceAuthorizationStoreInterface
 		allowedRedirectURIs *domain.AllowedRedirectURIs
 	}
 	tests := []struct {
@@ -603,6 +990,7 @@ func TestNewGitHubOAuthUseCase(t *testing.T) {
 					oauthProvider:       mock_usecase.NewMockGitHubOAuthProviderInterface(ctrl),
 					sessionStore:        mock_usecase.NewMockSessionStoreInterface(ctrl),
 					stateStore:          mock_usecase.NewMockOAuthStateStoreInterface(ctrl),
+					deviceStore:         mock_usecase.NewMockDeviceAuthorizationStoreInterface(ctrl),
 					allowedRedirectURIs: allowedURIs,
 				}
 			},
@@ -616,6 +1004,7 @@ func TestNewGitHubOAuthUseCase(t *testing.T) {
 					oauthProvider:       nil,
 					sessionStore:        mock_usecase.NewMockSessionStoreInterface(ctrl),
 					stateStore:          mock_usecase.NewMockOAuthStateStoreInterface(ctrl),
+					deviceStore:         mock_usecase.NewMockDeviceAuthorizationStoreInterface(ctrl),
 					allowedRedirectURIs: allowedURIs,
 				}
 			},
@@ -630,6 +1019,7 @@ func TestNewGitHubOAuthUseCase(t *testing.T) {
 					oauthProvider:       mock_usecase.NewMockGitHubOAuthProviderInterface(ctrl),
 					sessionStore:        nil,
 					stateStore:          mock_usecase.NewMockOAuthStateStoreInterface(ctrl),
+					deviceStore:         mock_usecase.NewMockDeviceAuthorizationStoreInterface(ctrl),
 					allowedRedirectURIs: allowedURIs,
 				}
 			},
@@ -644,12 +1034,28 @@ func TestNewGitHubOAuthUseCase(t *testing.T) {
 					oauthProvider:       mock_usecase.NewMockGitHubOAuthProviderInterface(ctrl),
 					sessionStore:        mock_usecase.NewMockSessionStoreInterface(ctrl),
 					stateStore:          nil,
+					deviceStore:         mock_usecase.NewMockDeviceAuthorizationStoreInterface(ctrl),
 					allowedRedirectURIs: allowedURIs,
 				}
 			},
 			wantErr:     true,
 			errContains: "stateStore is nil",
 		},
+		{
+			name: "異常系: deviceStoreがnilの場合エラー",
+			setupFields: func(ctrl *gomock.Controller) fields {
+				allowedURIs, _ := domain.NewAllowedRedirectURIs([]string{"https://example.com/callback"})
+				return fields{
+					oauthProvider:       mock_usecase.NewMockGitHubOAuthProviderInterface(ctrl),
+					sessionStore:        mock_usecase.NewMockSessionStoreInterface(ctrl),
+					stateStore:          mock_usecase.NewMockOAuthStateStoreInterface(ctrl),
+					deviceStore:         nil,
+					allowedRedirectURIs: allowedURIs,
+				}
+			},
+			wantErr:     true,
+			errContains: "deviceStore is nil",
+		},
 		{
 			name: "異常系: allowedRedirectURIsがnilの場合エラー",
 			setupFields: func(ctrl *gomock.Controller) fields {
@@ -657,6 +1063,7 @@ func TestNewGitHubOAuthUseCase(t *testing.T) {
 					oauthProvider:       mock_usecase.NewMockGitHubOAuthProviderInterface(ctrl),
 					sessionStore:        mock_usecase.NewMockSessionStoreInterface(ctrl),
 					stateStore:          mock_usecase.NewMockOAuthStateStoreInterface(ctrl),
+					deviceStore:         mock_usecase.NewMockDeviceAuthorizationStoreInterface(ctrl),
 					allowedRedirectURIs: nil,
 				}
 			},
@@ -671,7 +1078,7 @@ func TestNewGitHubOAuthUseCase(t *testing.T) {
 			defer ctrl.Finish()
 
 			f := tt.setupFields(ctrl)
-			uc, err := usecase.NewGitHubOAuthUseCase(f.oauthProvider, f.sessionStore, f.stateStore, f.allowedRedirectURIs)
+			uc, err := usecase.NewGitHubOAuthUseCase(f.oauthProvider, f.sessionStore, f.stateStore, f.deviceStore, f.allowedRedirectURIs, false)
 
 			if tt.wantErr {
 				if err == nil {
@@ -15,15 +15,20 @@ type GitHubOIDCProvider interface {
 type GitHubOIDCUseCase struct {
 	githubProvider    GitHubOIDCProvider
 	repoAllowlistRepo domain.RepositoryAllowlistRepository
+	claimRuleRepo     domain.GitHubClaimRuleRepository
 }
 
+// NewGitHubOIDCUseCase はリポジトリごとのクレームルールで権限を決めるGitHubOIDCUseCaseを生成する
+// claimRuleRepoがnilの場合は許可リストに含まれるリポジトリのトークンにすべての権限を与える
 func NewGitHubOIDCUseCase(
 	githubProvider GitHubOIDCProvider,
 	repoAllowlistRepo domain.RepositoryAllowlistRepository,
+	claimRuleRepo domain.GitHubClaimRuleRepository,
 ) *GitHubOIDCUseCase {
 	return &GitHubOIDCUseCase{
 		githubProvider:    githubProvider,
 		repoAllowlistRepo: repoAllowlistRepo,
+		claimRuleRepo:     claimRuleRepo,
 	}
 }
 
@@ -54,8 +59,23 @@ func (uc *GitHubOIDCUseCase) Authenticate(ctx context.Context, token string) (*d
 		return nil, err
 	}
 
-	fullPerms := domain.NewRepositoryPermissions(true, true, true, true, true)
-	userInfo.SetPermissions(&fullPerms)
+	perms, err := uc.evaluatePermissions(ctx, userInfo.Repository(), githubUserInfo)
+	if err != nil {
+		return nil, err
+	}
+	userInfo.SetPermissions(&perms)
 
 	return userInfo, nil
 }
+
+func (uc *GitHubOIDCUseCase) evaluatePermissions(ctx context.Context, repository *domain.RepositoryIdentifier, githubUserInfo *domain.GitHubUserInfo) (domain.RepositoryPermissions, error) {
+	if uc.claimRuleRepo == nil {
+		return domain.RepositoryAccessLevelAdmin.Permissions(), nil
+	}
+
+	rules, err := uc.claimRuleRepo.FindByRepository(ctx, repository)
+	if err != nil {
+		return domain.RepositoryPermissions{}, fmt.Errorf("クレームルールの取得に失敗しました: %w", err)
+	}
+	return domain.EvaluateGitHubClaimRules(rules, githubUserInfo), nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/na2na-p/cargohold/internal/domain"
@@ -262,7 +263,7 @@ func TestGitHubOIDCUseCase_Authenticate(t *testing.T) {
 
 			githubProvider := tt.fields.setupGitHubProvider(ctrl)
 			repoAllowlist := tt.fields.setupRepoAllowlist(ctrl)
-			uc := usecase.NewGitHubOIDCUseCase(githubProvider, repoAllowlist)
+			uc := usecase.NewGitHubOIDCUseCase(githubProvider, repoAllowlist, nil)
 
 			got, err := uc.Authenticate(ctx, tt.args.token)
 
@@ -301,3 +302,118 @@ func mustNewUserInfoWithFullPermissions(t *testing.T, sub, email, name string, p
 	userInfo.SetPermissions(&fullPerms)
 	return userInfo
 }
+
+func TestGitHubOIDCUseCase_Authenticate_ClaimRules(t *testing.T) {
+	ownerRepo, _ := domain.NewRepositoryIdentifier("owner/repo")
+	mainOrTags, err := domain.NewGitHubClaimCondition([]string{"refs/heads/main", "refs/tags/*"}, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("NewGitHubClaimCondition() failed: %v", err)
+	}
+	pullRequest, err := domain.NewGitHubClaimCondition(nil, []string{"pull_request"}, nil, nil)
+	if err != nil {
+		t.Fatalf("NewGitHubClaimCondition() failed: %v", err)
+	}
+	ruleID1, _ := domain.NewGitHubClaimRuleID(1)
+	ruleID2, _ := domain.NewGitHubClaimRuleID(2)
+	rules := []*domain.GitHubClaimRule{
+		domain.ReconstructGitHubClaimRule(ruleID1, ownerRepo, 10, pullRequest, domain.RepositoryAccessLevelRead, time.Time{}),
+		domain.ReconstructGitHubClaimRule(ruleID2, ownerRepo, 20, mainOrTags, domain.RepositoryAccessLevelWrite, time.Time{}),
+	}
+	upperOwnerRepo, _ := domain.NewRepositoryIdentifier("Owner/Repo")
+	upperCaseRules := []*domain.GitHubClaimRule{
+		domain.ReconstructGitHubClaimRule(ruleID1, upperOwnerRepo, 10, pullRequest, domain.RepositoryAccessLevelRead, time.Time{}),
+	}
+
+	tests := []struct {
+		name           string
+		githubUserInfo *domain.GitHubUserInfo
+		setupRuleRepo  func(ctrl *gomock.Controller) *mockdomain.MockGitHubClaimRuleRepository
+		want           *domain.UserInfo
+		wantErr        bool
+	}{
+		{
+			name:           "正常系: pull_requestイベントのトークンは読み取り専用になる",
+			githubUserInfo: domain.NewGitHubUserInfoWithWorkflow("sub", "owner/repo", "refs/heads/main", "actor", "pull_request", "", ""),
+			setupRuleRepo: func(ctrl *gomock.Controller) *mockdomain.MockGitHubClaimRuleRepository {
+				m := mockdomain.NewMockGitHubClaimRuleRepository(ctrl)
+				m.EXPECT().FindByRepository(gomock.Any(), ownerRepo).Return(rules, nil)
+				return m
+			},
+			want: mustNewUserInfoWithPermissions(t, "sub", "actor", domain.ProviderTypeGitHub, ownerRepo, "refs/heads/main",
+				domain.NewRepositoryPermissions(false, false, true, false, false)),
+		},
+		{
+			name:           "正常系: タグへのpushイベントのトークンは書き込みが許可される",
+			githubUserInfo: domain.NewGitHubUserInfoWithWorkflow("sub", "owner/repo", "refs/tags/v1.0.0", "actor", "push", "", ""),
+			setupRuleRepo: func(ctrl *gomock.Controller) *mockdomain.MockGitHubClaimRuleRepository {
+				m := mockdomain.NewMockGitHubClaimRuleRepository(ctrl)
+				m.EXPECT().FindByRepository(gomock.Any(), ownerRepo).Return(rules, nil)
+				return m
+			},
+			want: mustNewUserInfoWithPermissions(t, "sub", "actor", domain.ProviderTypeGitHub, ownerRepo, "refs/tags/v1.0.0",
+				domain.NewRepositoryPermissions(false, true, true, false, false)),
+		},
+		{
+			name:           "正常系: 大文字小文字が異なる表記で登録されたルールも適用される",
+			githubUserInfo: domain.NewGitHubUserInfoWithWorkflow("sub", "owner/repo", "refs/heads/main", "actor", "pull_request", "", ""),
+			setupRuleRepo: func(ctrl *gomock.Controller) *mockdomain.MockGitHubClaimRuleRepository {
+				m := mockdomain.NewMockGitHubClaimRuleRepository(ctrl)
+				m.EXPECT().FindByRepository(gomock.Any(), ownerRepo).Return(upperCaseRules, nil)
+				return m
+			},
+			want: mustNewUserInfoWithPermissions(t, "sub", "actor", domain.ProviderTypeGitHub, ownerRepo, "refs/heads/main",
+				domain.NewRepositoryPermissions(false, false, true, false, false)),
+		},
+		{
+			name:           "正常系: いずれのルールにも一致しない場合は権限が与えられない",
+			githubUserInfo: domain.NewGitHubUserInfoWithWorkflow("sub", "owner/repo", "refs/heads/feature", "actor", "push", "", ""),
+			setupRuleRepo: func(ctrl *gomock.Controller) *mockdomain.MockGitHubClaimRuleRepository {
+				m := mockdomain.NewMockGitHubClaimRuleRepository(ctrl)
+				m.EXPECT().FindByRepository(gomock.Any(), ownerRepo).Return(rules, nil)
+				return m
+			},
+			want: mustNewUserInfoWithPermissions(t, "sub", "actor", domain.ProviderTypeGitHub, ownerRepo, "refs/heads/feature",
+				domain.NewRepositoryPermissions(false, false, false, false, false)),
+		},
+		{
+			name:           "正常系: ルールが設定されていない場合はすべての権限が与えられる",
+			githubUserInfo: domain.NewGitHubUserInfoWithWorkflow("sub", "owner/repo", "refs/heads/feature", "actor", "push", "", ""),
+			setupRuleRepo: func(ctrl *gomock.Controller) *mockdomain.MockGitHubClaimRuleRepository {
+				m := mockdomain.NewMockGitHubClaimRuleRepository(ctrl)
+				m.EXPECT().FindByRepository(gomock.Any(), ownerRepo).Return(nil, nil)
+				return m
+			},
+			want: mustNewUserInfoWithFullPermissions(t, "sub", "", "actor", domain.ProviderTypeGitHub, ownerRepo, "refs/heads/feature"),
+		},
+		{
+			name:           "異常系: ルールの取得に失敗した場合はエラー",
+			githubUserInfo: domain.NewGitHubUserInfoWithWorkflow("sub", "owner/repo", "refs/heads/main", "actor", "push", "", ""),
+			setupRuleRepo: func(ctrl *gomock.Controller) *mockdomain.MockGitHubClaimRuleRepository {
+				m := mockdomain.NewMockGitHubClaimRuleRepository(ctrl)
+				m.EXPECT().FindByRepository(gomock.Any(), ownerRepo).Return(nil, errors.New("DB接続エラー"))
+				return m
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			githubProvider := mock_usecase.NewMockGitHubOIDCProvider(ctrl)
+			githubProvider.EXPECT().VerifyIDToken(gomock.Any(), "token").Return(tt.githubUserInfo, nil)
+			repoAllowlist := mockdomain.NewMockRepositoryAllowlistRepository(ctrl)
+			repoAllowlist.EXPECT().IsAllowed(gomock.Any(), gomock.Any()).Return(true, nil)
+
+			uc := usecase.NewGitHubOIDCUseCase(githubProvider, repoAllowlist, tt.setupRuleRepo(ctrl))
+			got, err := uc.Authenticate(context.Background(), "token")
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Authenticate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(domain.UserInfo{}, domain.ProviderType{}, domain.RepositoryIdentifier{}, domain.RepositoryPermissions{})); diff != "" {
+				t.Errorf("Authenticate() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
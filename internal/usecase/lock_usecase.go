@@ -0,0 +1,240 @@
+//go:generate mockgen -source=$GOFILE -destination=../../tests/usecase/mock_lock_usecase.go -package=usecase
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/na2na-p/cargohold/internal/domain"
+)
+
+const (
+	// DefaultLockListLimit はロック一覧取得時のデフォルト件数
+	DefaultLockListLimit = 100
+	// MaxLockListLimit はロック一覧取得時の最大件数
+	MaxLockListLimit = 1000
+)
+
+// LockListQuery はロック一覧取得の検索条件
+type LockListQuery struct {
+	Path   string
+	ID     string
+	Cursor string
+	Limit  int
+}
+
+// LockVerifyQuery はロック検証のページネーション条件
+type LockVerifyQuery struct {
+	Cursor string
+	Limit  int
+}
+
+// LockListResult はロック一覧取得の結果
+type LockListResult struct {
+	Locks      []*domain.Lock
+	NextCursor string
+}
+
+// LockVerifyResult はロック検証の結果
+// Oursはリクエストユーザーが所有するロック、Theirsはそれ以外のユーザーが所有するロック
+type LockVerifyResult struct {
+	Ours       []*domain.Lock
+	Theirs     []*domain.Lock
+	NextCursor string
+}
+
+type LockUseCase interface {
+	CreateLock(ctx context.Context, userInfo *domain.UserInfo, repository *domain.RepositoryIdentifier, path, refName string) (*domain.Lock, error)
+	ListLocks(ctx context.Context, userInfo *domain.UserInfo, repository *domain.RepositoryIdentifier, query LockListQuery) (*LockListResult, error)
+	VerifyLocks(ctx context.Context, userInfo *domain.UserInfo, repository *domain.RepositoryIdentifier, query LockVerifyQuery) (*LockVerifyResult, error)
+	Unlock(ctx context.Context, userInfo *domain.UserInfo, repository *domain.RepositoryIdentifier, id string, force bool) (*domain.Lock, error)
+}
+
+type lockUseCaseImpl struct {
+	lockRepo domain.LockRepository
+}
+
+func NewLockUseCase(lockRepo domain.LockRepository) LockUseCase {
+	return &lockUseCaseImpl{
+		lockRepo: lockRepo,
+	}
+}
+
+// CreateLock は指定パスのロックを作成する
+// 同一パスのロックが既に存在する場合は、既存のロックとErrLockConflictを返す
+func (u *lockUseCaseImpl) CreateLock(ctx context.Context, userInfo *domain.UserInfo, repository *domain.RepositoryIdentifier, path, refName string) (*domain.Lock, error) {
+	if !canUpload(userInfo) {
+		return nil, ErrPermissionDenied
+	}
+
+	lockPath, err := domain.NewLockPath(path)
+	if err != nil {
+		return nil, ErrInvalidLockPath
+	}
+
+	owner, err := domain.NewLockOwner(userInfo.Sub(), userInfo.Name())
+	if err != nil {
+		return nil, ErrPermissionDenied
+	}
+
+	lock, err := domain.NewLock(ctx, repository, lockPath, refName, owner)
+	if err != nil {
+		return nil, ErrInvalidRepository
+	}
+
+	created, err := u.lockRepo.Create(ctx, lock)
+	if err != nil {
+		if !errors.Is(err, domain.ErrLockAlreadyExists) {
+			return nil, fmt.Errorf("ロックの作成に失敗しました: %w", err)
+		}
+		existing, findErr := u.lockRepo.FindByPath(ctx, repository, lockPath)
+		if findErr != nil {
+			return nil, fmt.Errorf("既存ロックの取得に失敗しました: %w", findErr)
+		}
+		return existing, ErrLockConflict
+	}
+
+	return created, nil
+}
+
+// ListLocks はリポジトリのロック一覧を取得する
+func (u *lockUseCaseImpl) ListLocks(ctx context.Context, userInfo *domain.UserInfo, repository *domain.RepositoryIdentifier, query LockListQuery) (*LockListResult, error) {
+	if !canDownload(userInfo) {
+		return nil, ErrPermissionDenied
+	}
+
+	filter := domain.LockListFilter{
+		Path:  query.Path,
+		Limit: normalizeLockListLimit(query.Limit),
+	}
+
+	if query.ID != "" {
+		id, err := domain.ParseLockID(query.ID)
+		if err != nil {
+			return nil, ErrInvalidLockID
+		}
+		filter.ID = id
+	}
+
+	if query.Cursor != "" {
+		cursor, err := domain.ParseLockID(query.Cursor)
+		if err != nil {
+			return nil, ErrInvalidLockID
+		}
+		filter.Cursor = cursor
+	}
+
+	locks, nextCursor, err := u.lockRepo.List(ctx, repository, filter)
+	if err != nil {
+		return nil, fmt.Errorf("ロック一覧の取得に失敗しました: %w", err)
+	}
+
+	return &LockListResult{
+		Locks:      locks,
+		NextCursor: cursorString(nextCursor),
+	}, nil
+}
+
+// VerifyLocks はリポジトリのロックをリクエストユーザーの所有かどうかで振り分けて返す
+func (u *lockUseCaseImpl) VerifyLocks(ctx context.Context, userInfo *domain.UserInfo, repository *domain.RepositoryIdentifier, query LockVerifyQuery) (*LockVerifyResult, error) {
+	if !canUpload(userInfo) {
+		return nil, ErrPermissionDenied
+	}
+
+	filter := domain.LockListFilter{
+		Limit: normalizeLockListLimit(query.Limit),
+	}
+
+	if query.Cursor != "" {
+		cursor, err := domain.ParseLockID(query.Cursor)
+		if err != nil {
+			return nil, ErrInvalidLockID
+		}
+		filter.Cursor = cursor
+	}
+
+	locks, nextCursor, err := u.lockRepo.List(ctx, repository, filter)
+	if err != nil {
+		return nil, fmt.Errorf("ロック一覧の取得に失敗しました: %w", err)
+	}
+
+	result := &LockVerifyResult{
+		Ours:       make([]*domain.Lock, 0),
+		Theirs:     make([]*domain.Lock, 0),
+		NextCursor: cursorString(nextCursor),
+	}
+	for _, lock := range locks {
+		if lock.Owner().IsOwnedBy(userInfo) {
+			result.Ours = append(result.Ours, lock)
+		} else {
+			result.Theirs = append(result.Theirs, lock)
+		}
+	}
+
+	return result, nil
+}
+
+// Unlock はロックを解除する
+// 他のユーザーが所有するロックはforce指定かつadmin/maintain権限を持つ場合のみ解除できる
+func (u *lockUseCaseImpl) Unlock(ctx context.Context, userInfo *domain.UserInfo, repository *domain.RepositoryIdentifier, id string, force bool) (*domain.Lock, error) {
+	if !canUpload(userInfo) {
+		return nil, ErrPermissionDenied
+	}
+
+	lockID, err := domain.ParseLockID(id)
+	if err != nil {
+		return nil, ErrInvalidLockID
+	}
+
+	lock, err := u.lockRepo.FindByID(ctx, repository, lockID)
+	if err != nil {
+		if errors.Is(err, domain.ErrLockNotFound) {
+			return nil, ErrLockNotFound
+		}
+		return nil, fmt.Errorf("ロックの取得に失敗しました: %w", err)
+	}
+
+	if !lock.Owner().IsOwnedBy(userInfo) {
+		if !force {
+			return nil, ErrLockOwnerMismatch
+		}
+		if !userInfo.Permissions().CanForceUnlock() {
+			return nil, ErrPermissionDenied
+		}
+	}
+
+	if err := u.lockRepo.Delete(ctx, repository, lockID); err != nil {
+		if errors.Is(err, domain.ErrLockNotFound) {
+			return nil, ErrLockNotFound
+		}
+		return nil, fmt.Errorf("ロックの削除に失敗しました: %w", err)
+	}
+
+	return lock, nil
+}
+
+func canUpload(userInfo *domain.UserInfo) bool {
+	return userInfo != nil && userInfo.Permissions() != nil && userInfo.Permissions().CanUpload()
+}
+
+func canDownload(userInfo *domain.UserInfo) bool {
+	return userInfo != nil && userInfo.Permissions() != nil && userInfo.Permissions().CanDownload()
+}
+
+func normalizeLockListLimit(limit int) int {
+	if limit <= 0 {
+		return DefaultLockListLimit
+	}
+	if limit > MaxLockListLimit {
+		return MaxLockListLimit
+	}
+	return limit
+}
+
+func cursorString(cursor domain.LockID) string {
+	if cursor.IsZero() {
+		return ""
+	}
+	return cursor.String()
+}
@@ -0,0 +1,324 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/na2na-p/cargohold/internal/usecase"
+	mock_domain "github.com/na2na-p/cargohold/tests/domain"
+)
+
+func newLockTestUserInfo(t *testing.T, sub string, permissions domain.RepositoryPermissions) *domain.UserInfo {
+	t.Helper()
+
+	repo, err := domain.NewRepositoryIdentifier("owner/repo")
+	if err != nil {
+		t.Fatalf("NewRepositoryIdentifier() failed: %v", err)
+	}
+	userInfo, err := domain.NewUserInfo(sub, "", sub, domain.ProviderTypeGitHub, repo, "")
+	if err != nil {
+		t.Fatalf("NewUserInfo() failed: %v", err)
+	}
+	userInfo.SetPermissions(&permissions)
+	return userInfo
+}
+
+func newLockTestLock(t *testing.T, id int64, path, ownerSub string) *domain.Lock {
+	t.Helper()
+
+	repo, _ := domain.NewRepositoryIdentifier("owner/repo")
+	lockID, err := domain.NewLockID(id)
+	if err != nil {
+		t.Fatalf("NewLockID() failed: %v", err)
+	}
+	lockPath, err := domain.NewLockPath(path)
+	if err != nil {
+		t.Fatalf("NewLockPath() failed: %v", err)
+	}
+	owner, err := domain.NewLockOwner(ownerSub, ownerSub)
+	if err != nil {
+		t.Fatalf("NewLockOwner() failed: %v", err)
+	}
+	return domain.ReconstructLock(lockID, repo, lockPath, "", owner, time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+}
+
+var (
+	lockTestPushPermissions  = domain.NewRepositoryPermissions(false, true, true, false, false)
+	lockTestPullPermissions  = domain.NewRepositoryPermissions(false, false, true, false, false)
+	lockTestAdminPermissions = domain.NewRepositoryPermissions(true, true, true, false, false)
+)
+
+func TestLockUseCase_CreateLock(t *testing.T) {
+	type args struct {
+		userInfo func(t *testing.T) *domain.UserInfo
+		path     string
+	}
+	tests := []struct {
+		name     string
+		lockRepo func(t *testing.T, ctrl *gomock.Controller) domain.LockRepository
+		args     args
+		wantID   int64
+		wantErr  error
+	}{
+		{
+			name: "正常系: ロックが作成される",
+			lockRepo: func(t *testing.T, ctrl *gomock.Controller) domain.LockRepository {
+				m := mock_domain.NewMockLockRepository(ctrl)
+				m.EXPECT().Create(gomock.Any(), gomock.Any()).Return(newLockTestLock(t, 1, "foo.bin", "user-1"), nil)
+				return m
+			},
+			args: args{
+				userInfo: func(t *testing.T) *domain.UserInfo { return newLockTestUserInfo(t, "user-1", lockTestPushPermissions) },
+				path:     "foo.bin",
+			},
+			wantID: 1,
+		},
+		{
+			name: "異常系: 既にロックが存在する場合は既存ロックとErrLockConflictが返る",
+			lockRepo: func(t *testing.T, ctrl *gomock.Controller) domain.LockRepository {
+				m := mock_domain.NewMockLockRepository(ctrl)
+				m.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, domain.ErrLockAlreadyExists)
+				m.EXPECT().FindByPath(gomock.Any(), gomock.Any(), gomock.Any()).Return(newLockTestLock(t, 9, "foo.bin", "user-2"), nil)
+				return m
+			},
+			args: args{
+				userInfo: func(t *testing.T) *domain.UserInfo { return newLockTestUserInfo(t, "user-1", lockTestPushPermissions) },
+				path:     "foo.bin",
+			},
+			wantID:  9,
+			wantErr: usecase.ErrLockConflict,
+		},
+		{
+			name: "異常系: push権限がない場合はErrPermissionDeniedが返る",
+			lockRepo: func(t *testing.T, ctrl *gomock.Controller) domain.LockRepository {
+				return mock_domain.NewMockLockRepository(ctrl)
+			},
+			args: args{
+				userInfo: func(t *testing.T) *domain.UserInfo { return newLockTestUserInfo(t, "user-1", lockTestPullPermissions) },
+				path:     "foo.bin",
+			},
+			wantErr: usecase.ErrPermissionDenied,
+		},
+		{
+			name: "異常系: パスが不正な場合はErrInvalidLockPathが返る",
+			lockRepo: func(t *testing.T, ctrl *gomock.Controller) domain.LockRepository {
+				return mock_domain.NewMockLockRepository(ctrl)
+			},
+			args: args{
+				userInfo: func(t *testing.T) *domain.UserInfo { return newLockTestUserInfo(t, "user-1", lockTestPushPermissions) },
+				path:     "",
+			},
+			wantErr: usecase.ErrInvalidLockPath,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			uc := usecase.NewLockUseCase(tt.lockRepo(t, ctrl))
+			repo, _ := domain.NewRepositoryIdentifier("owner/repo")
+
+			got, err := uc.CreateLock(context.Background(), tt.args.userInfo(t), repo, tt.args.path, "refs/heads/main")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CreateLock() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantID != 0 && got.ID().Int64() != tt.wantID {
+				t.Errorf("CreateLock() ID = %v, want %v", got.ID().Int64(), tt.wantID)
+			}
+		})
+	}
+}
+
+func TestLockUseCase_ListLocks(t *testing.T) {
+	tests := []struct {
+		name           string
+		lockRepo       func(t *testing.T, ctrl *gomock.Controller) domain.LockRepository
+		permissions    domain.RepositoryPermissions
+		query          usecase.LockListQuery
+		wantCount      int
+		wantNextCursor string
+		wantErr        error
+	}{
+		{
+			name: "正常系: 条件がリポジトリに渡され次のカーソルが返る",
+			lockRepo: func(t *testing.T, ctrl *gomock.Controller) domain.LockRepository {
+				m := mock_domain.NewMockLockRepository(ctrl)
+				cursor, _ := domain.NewLockID(5)
+				next, _ := domain.NewLockID(7)
+				m.EXPECT().List(gomock.Any(), gomock.Any(), domain.LockListFilter{Path: "foo.bin", Cursor: cursor, Limit: usecase.MaxLockListLimit}).
+					Return([]*domain.Lock{newLockTestLock(t, 5, "foo.bin", "user-1")}, next, nil)
+				return m
+			},
+			permissions:    lockTestPullPermissions,
+			query:          usecase.LockListQuery{Path: "foo.bin", Cursor: "5", Limit: 5000},
+			wantCount:      1,
+			wantNextCursor: "7",
+		},
+		{
+			name: "異常系: 不正なカーソルの場合はErrInvalidLockIDが返る",
+			lockRepo: func(t *testing.T, ctrl *gomock.Controller) domain.LockRepository {
+				return mock_domain.NewMockLockRepository(ctrl)
+			},
+			permissions: lockTestPullPermissions,
+			query:       usecase.LockListQuery{Cursor: "abc"},
+			wantErr:     usecase.ErrInvalidLockID,
+		},
+		{
+			name: "異常系: 読み取り権限がない場合はErrPermissionDeniedが返る",
+			lockRepo: func(t *testing.T, ctrl *gomock.Controller) domain.LockRepository {
+				return mock_domain.NewMockLockRepository(ctrl)
+			},
+			permissions: domain.NewRepositoryPermissions(false, false, false, false, false),
+			wantErr:     usecase.ErrPermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			uc := usecase.NewLockUseCase(tt.lockRepo(t, ctrl))
+			repo, _ := domain.NewRepositoryIdentifier("owner/repo")
+
+			got, err := uc.ListLocks(context.Background(), newLockTestUserInfo(t, "user-1", tt.permissions), repo, tt.query)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ListLocks() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if len(got.Locks) != tt.wantCount {
+				t.Errorf("ListLocks() len = %v, want %v", len(got.Locks), tt.wantCount)
+			}
+			if got.NextCursor != tt.wantNextCursor {
+				t.Errorf("ListLocks() NextCursor = %v, want %v", got.NextCursor, tt.wantNextCursor)
+			}
+		})
+	}
+}
+
+func TestLockUseCase_VerifyLocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	m := mock_domain.NewMockLockRepository(ctrl)
+	m.EXPECT().List(gomock.Any(), gomock.Any(), domain.LockListFilter{Limit: usecase.DefaultLockListLimit}).
+		Return([]*domain.Lock{
+			newLockTestLock(t, 1, "a.bin", "user-1"),
+			newLockTestLock(t, 2, "b.bin", "user-2"),
+			newLockTestLock(t, 3, "c.bin", "user-1"),
+		}, domain.LockID{}, nil)
+
+	uc := usecase.NewLockUseCase(m)
+	repo, _ := domain.NewRepositoryIdentifier("owner/repo")
+
+	got, err := uc.VerifyLocks(context.Background(), newLockTestUserInfo(t, "user-1", lockTestPushPermissions), repo, usecase.LockVerifyQuery{})
+	if err != nil {
+		t.Fatalf("VerifyLocks() unexpected error = %v", err)
+	}
+	if len(got.Ours) != 2 {
+		t.Errorf("VerifyLocks() Ours len = %v, want 2", len(got.Ours))
+	}
+	if len(got.Theirs) != 1 || got.Theirs[0].ID().Int64() != 2 {
+		t.Errorf("VerifyLocks() Theirs = %v, want [2]", got.Theirs)
+	}
+	if got.NextCursor != "" {
+		t.Errorf("VerifyLocks() NextCursor = %v, want empty", got.NextCursor)
+	}
+}
+
+func TestLockUseCase_Unlock(t *testing.T) {
+	tests := []struct {
+		name        string
+		lockRepo    func(t *testing.T, ctrl *gomock.Controller) domain.LockRepository
+		permissions domain.RepositoryPermissions
+		id          string
+		force       bool
+		wantErr     error
+	}{
+		{
+			name: "正常系: 自分のロックを解除できる",
+			lockRepo: func(t *testing.T, ctrl *gomock.Controller) domain.LockRepository {
+				m := mock_domain.NewMockLockRepository(ctrl)
+				m.EXPECT().FindByID(gomock.Any(), gomock.Any(), gomock.Any()).Return(newLockTestLock(t, 1, "a.bin", "user-1"), nil)
+				m.EXPECT().Delete(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				return m
+			},
+			permissions: lockTestPushPermissions,
+			id:          "1",
+		},
+		{
+			name: "正常系: admin権限でforce指定すると他人のロックを解除できる",
+			lockRepo: func(t *testing.T, ctrl *gomock.Controller) domain.LockRepository {
+				m := mock_domain.NewMockLockRepository(ctrl)
+				m.EXPECT().FindByID(gomock.Any(), gomock.Any(), gomock.Any()).Return(newLockTestLock(t, 1, "a.bin", "user-2"), nil)
+				m.EXPECT().Delete(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				return m
+			},
+			permissions: lockTestAdminPermissions,
+			id:          "1",
+			force:       true,
+		},
+		{
+			name: "異常系: force指定なしで他人のロックを解除するとErrLockOwnerMismatchが返る",
+			lockRepo: func(t *testing.T, ctrl *gomock.Controller) domain.LockRepository {
+				m := mock_domain.NewMockLockRepository(ctrl)
+				m.EXPECT().FindByID(gomock.Any(), gomock.Any(), gomock.Any()).Return(newLockTestLock(t, 1, "a.bin", "user-2"), nil)
+				return m
+			},
+			permissions: lockTestAdminPermissions,
+			id:          "1",
+			wantErr:     usecase.ErrLockOwnerMismatch,
+		},
+		{
+			name: "異常系: push権限のみでforce指定するとErrPermissionDeniedが返る",
+			lockRepo: func(t *testing.T, ctrl *gomock.Controller) domain.LockRepository {
+				m := mock_domain.NewMockLockRepository(ctrl)
+				m.EXPECT().FindByID(gomock.Any(), gomock.Any(), gomock.Any()).Return(newLockTestLock(t, 1, "a.bin", "user-2"), nil)
+				return m
+			},
+			permissions: lockTestPushPermissions,
+			id:          "1",
+			force:       true,
+			wantErr:     usecase.ErrPermissionDenied,
+		},
+		{
+			name: "異常系: ロックが存在しない場合はErrLockNotFoundが返る",
+			lockRepo: func(t *testing.T, ctrl *gomock.Controller) domain.LockRepository {
+				m := mock_domain.NewMockLockRepository(ctrl)
+				m.EXPECT().FindByID(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, domain.ErrLockNotFound)
+				return m
+			},
+			permissions: lockTestPushPermissions,
+			id:          "1",
+			wantErr:     usecase.ErrLockNotFound,
+		},
+		{
+			name: "異常系: 不正なIDの場合はErrInvalidLockIDが返る",
+			lockRepo: func(t *testing.T, ctrl *gomock.Controller) domain.LockRepository {
+				return mock_domain.NewMockLockRepository(ctrl)
+			},
+			permissions: lockTestPushPermissions,
+			id:          "abc",
+			wantErr:     usecase.ErrInvalidLockID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			uc := usecase.NewLockUseCase(tt.lockRepo(t, ctrl))
+			repo, _ := domain.NewRepositoryIdentifier("owner/repo")
+
+			got, err := uc.Unlock(context.Background(), newLockTestUserInfo(t, "user-1", tt.permissions), repo, tt.id, tt.force)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Unlock() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && got == nil {
+				t.Error("Unlock() returned nil lock")
+			}
+		})
+	}
+}
@@ -31,7 +31,13 @@ func NewProxyDownloadUseCase(
 	}
 }
 
-func (u *proxyDownloadUseCaseImpl) Execute(ctx context.Context, owner, repo string, oid domain.OID) (io.ReadCloser, int64, error) {
+func (u *proxyDownloadUseCaseImpl) Execute(ctx context.Context, owner, repo string, oid domain.OID) (stream io.ReadCloser, size int64, err error) {
+	ctx, span := startSpan(ctx, "ProxyDownloadUseCase.Execute",
+		attrLFSRepository.String(owner+"/"+repo),
+		attrLFSOID.String(oid.String()),
+	)
+	defer func() { endSpan(span, err) }()
+
 	repoIdentifier, err := domain.NewRepositoryIdentifier(owner + "/" + repo)
 	if err != nil {
 		return nil, 0, ErrAccessDenied
@@ -58,12 +64,10 @@ func (u *proxyDownloadUseCaseImpl) Execute(ctx context.Context, owner, repo stri
 	}
 
 	storageKey := obj.GetStorageKey()
-	size := obj.Size().Int64()
-
-	stream, err := u.objectStorage.GetObject(ctx, storageKey)
+	stream, err = u.objectStorage.GetObject(ctx, storageKey)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	return stream, size, nil
+	return stream, obj.Size().Int64(), nil
 }
@@ -12,6 +12,10 @@ import (
 	"github.com/na2na-p/cargohold/internal/usecase"
 	mock_domain "github.com/na2na-p/cargohold/tests/domain"
 	mock_usecase "github.com/na2na-p/cargohold/tests/usecase"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	"go.uber.org/mock/gomock"
 )
 
@@ -343,3 +347,39 @@ func TestProxyDownloadUseCase_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestProxyDownloadUseCase_Execute_Tracing(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(tp)
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+
+	ctrl := gomock.NewController(t)
+	authService := mock_domain.NewMockAccessAuthorizationService(ctrl)
+	authService.EXPECT().Authorize(gomock.Any(), domain.OperationDownload, gomock.Any(), gomock.Any()).Return(domain.AuthorizationResult{Allowed: false}, nil)
+	uc := usecase.NewProxyDownloadUseCase(mock_domain.NewMockLFSObjectRepository(ctrl), mock_usecase.NewMockObjectStorage(ctrl), authService)
+
+	oid, _ := domain.NewOID("1234567890123456789012345678901234567890123456789012345678901234")
+	ctx, parent := tp.Tracer("test").Start(context.Background(), "parent")
+	_, _, err := uc.Execute(ctx, "testowner", "testrepo", oid)
+	parent.End()
+	if !errors.Is(err, usecase.ErrAccessDenied) {
+		t.Fatalf("Execute() error = %v, want %v", err, usecase.ErrAccessDenied)
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("expected 2 spans, got %d", len(spans))
+	}
+	span := spans[0]
+	if span.Name != "ProxyDownloadUseCase.Execute" {
+		t.Errorf("span name = %q, want %q", span.Name, "ProxyDownloadUseCase.Execute")
+	}
+	if span.Parent.SpanID() != parent.SpanContext().SpanID() {
+		t.Errorf("parent span id = %s, want %s", span.Parent.SpanID(), parent.SpanContext().SpanID())
+	}
+	if span.Status.Code != codes.Error {
+		t.Errorf("span status = %v, want %v", span.Status.Code, codes.Error)
+	}
+}
@@ -3,12 +3,22 @@ package usecase
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
+	"fmt"
+	"hash"
 	"io"
+	"log/slog"
 
+	"github.com/google/uuid"
 	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/newmo-oss/ctxtime"
 )
 
+// stagingKeyPrefix は検証前のアップロードを一時的に配置するキーのプレフィックスです
+const stagingKeyPrefix = "staging/"
+
 type ProxyUploadUseCase interface {
 	Execute(ctx context.Context, owner, repo string, oid domain.OID, body io.Reader) error
 }
@@ -17,21 +27,30 @@ type proxyUploadUseCaseImpl struct {
 	repo          domain.LFSObjectRepository
 	objectStorage ObjectStorage
 	authService   domain.AccessAuthorizationService
+	policyRepo    domain.AccessPolicyRepository
 }
 
 func NewProxyUploadUseCase(
 	repo domain.LFSObjectRepository,
 	objectStorage ObjectStorage,
 	authService domain.AccessAuthorizationService,
+	policyRepo domain.AccessPolicyRepository,
 ) ProxyUploadUseCase {
 	return &proxyUploadUseCaseImpl{
 		repo:          repo,
 		objectStorage: objectStorage,
 		authService:   authService,
+		policyRepo:    policyRepo,
 	}
 }
 
-func (u *proxyUploadUseCaseImpl) Execute(ctx context.Context, owner, repo string, oid domain.OID, body io.Reader) error {
+func (u *proxyUploadUseCaseImpl) Execute(ctx context.Context, owner, repo string, oid domain.OID, body io.Reader) (err error) {
+	ctx, span := startSpan(ctx, "ProxyUploadUseCase.Execute",
+		attrLFSRepository.String(owner+"/"+repo),
+		attrLFSOID.String(oid.String()),
+	)
+	defer func() { endSpan(span, err) }()
+
 	repoIdentifier, err := domain.NewRepositoryIdentifier(owner + "/" + repo)
 	if err != nil {
 		return ErrAccessDenied
@@ -53,11 +72,40 @@ func (u *proxyUploadUseCaseImpl) Execute(ctx context.Context, owner, repo string
 		return err
 	}
 
+	if authResult.IsNewObject {
+		return u.proveAndGrant(ctx, repoIdentifier, lfsObject, body)
+	}
+	return u.store(ctx, lfsObject, body)
+}
+
+// store は受信したバイト列を一時キーに配置し、検証後に正式なキーへ昇格します
+func (u *proxyUploadUseCaseImpl) store(ctx context.Context, lfsObject *domain.LFSObject, body io.Reader) error {
+	oid := lfsObject.OID()
 	storageKey := lfsObject.GetStorageKey()
-	if err := u.objectStorage.PutObject(ctx, storageKey, body, lfsObject.Size().Int64()); err != nil {
+	stagingKey := stagingKeyPrefix + uuid.NewString() + "/" + storageKey
+	size := lfsObject.Size().Int64()
+
+	// 宣言サイズを超えて読み込まないように制限しつつ、ストリームを流しながらハッシュを計算する
+	reader := newDigestReader(io.LimitReader(body, size), sha256.New())
+	if err := u.objectStorage.PutObject(ctx, stagingKey, reader, size); err != nil {
+		if reader.eof && reader.n < size {
+			u.discardStagedObject(ctx, stagingKey)
+			return fmt.Errorf("%w: 宣言サイズ %d に対して %d バイトしか受信していません", ErrSizeMismatch, size, reader.n)
+		}
+		return err
+	}
+
+	if err := verifyStagedUpload(reader, body, oid, size); err != nil {
+		u.discardStagedObject(ctx, stagingKey)
 		return err
 	}
 
+	if err := u.objectStorage.CopyObject(ctx, stagingKey, storageKey); err != nil {
+		u.discardStagedObject(ctx, stagingKey)
+		return err
+	}
+	u.discardStagedObject(ctx, stagingKey)
+
 	lfsObject.MarkAsUploaded(ctx)
 	if err := u.repo.Update(ctx, lfsObject); err != nil {
 		return err
@@ -65,3 +113,85 @@ func (u *proxyUploadUseCaseImpl) Execute(ctx context.Context, owner, repo string
 
 	return nil
 }
+
+// proveAndGrant は紐付けのないリポジトリが内容を所持していることを確認し、紐付けを作成します
+// 実体が保存済みの場合、受信したバイト列はハッシュの計算にのみ使用して破棄します
+func (u *proxyUploadUseCaseImpl) proveAndGrant(ctx context.Context, repoIdentifier *domain.RepositoryIdentifier, lfsObject *domain.LFSObject, body io.Reader) error {
+	if lfsObject.IsUploaded() {
+		size := lfsObject.Size().Int64()
+		reader := newDigestReader(io.LimitReader(body, size), sha256.New())
+		if _, err := io.Copy(io.Discard, reader); err != nil {
+			return fmt.Errorf("リクエストボディの読み込みに失敗しました: %w", err)
+		}
+		if err := verifyStagedUpload(reader, body, lfsObject.OID(), size); err != nil {
+			return err
+		}
+	} else if err := u.store(ctx, lfsObject, body); err != nil {
+		return err
+	}
+
+	policyID, err := domain.NewAccessPolicyID(0)
+	if err != nil {
+		return err
+	}
+	policy := domain.NewAccessPolicy(policyID, lfsObject.OID(), repoIdentifier, ctxtime.Now(ctx))
+	if err := u.policyRepo.Save(ctx, policy); err != nil {
+		return fmt.Errorf("アクセスポリシーの作成に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// verifyStagedUpload は受信したバイト列のサイズとハッシュがLFSオブジェクトの宣言と一致するかを検証します
+func verifyStagedUpload(reader *digestReader, body io.Reader, oid domain.OID, size int64) error {
+	if reader.n != size {
+		return fmt.Errorf("%w: 宣言サイズ %d に対して %d バイトを受信しました", ErrSizeMismatch, size, reader.n)
+	}
+
+	// 宣言サイズを超えるデータが残っていないかを確認する
+	extra, err := io.CopyN(io.Discard, body, 1)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("リクエストボディの読み込みに失敗しました: %w", err)
+	}
+	if extra > 0 {
+		return fmt.Errorf("%w: 宣言サイズ %d を超えるデータを受信しました", ErrSizeMismatch, size)
+	}
+
+	digest := hex.EncodeToString(reader.hash.Sum(nil))
+	if digest != oid.String() {
+		return fmt.Errorf("%w: 期待値 %s に対して %s を受信しました", ErrHashMismatch, oid.String(), digest)
+	}
+
+	return nil
+}
+
+// discardStagedObject は一時キーに配置したオブジェクトを削除します
+// リクエストのキャンセル後も削除できるよう、キャンセルを伝播しないコンテキストを使用します
+func (u *proxyUploadUseCaseImpl) discardStagedObject(ctx context.Context, stagingKey string) {
+	if err := u.objectStorage.DeleteObject(context.WithoutCancel(ctx), stagingKey); err != nil {
+		slog.Warn("一時オブジェクトの削除に失敗しました", "key", stagingKey, "error", err)
+	}
+}
+
+// digestReader は読み込んだバイト数とハッシュを記録するio.Readerです
+type digestReader struct {
+	r    io.Reader
+	hash hash.Hash
+	n    int64
+	eof  bool
+}
+
+func newDigestReader(r io.Reader, h hash.Hash) *digestReader {
+	return &digestReader{r: r, hash: h}
+}
+
+func (d *digestReader) Read(p []byte) (int, error) {
+	n, err := d.r.Read(p)
+	if n > 0 {
+		d.n += int64(n)
+		_, _ = d.hash.Write(p[:n])
+	}
+	if errors.Is(err, io.EOF) {
+		d.eof = true
+	}
+	return n, err
+}
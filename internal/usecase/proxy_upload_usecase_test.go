@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"io"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -16,11 +17,44 @@ import (
 	mock_usecase "github.com/na2na-p/cargohold/tests/usecase"
 )
 
+const (
+	// proxyUploadTestOID は proxyUploadTestBody のSHA-256ハッシュ
+	proxyUploadTestOID        = "916f0027a575074ce72a331777c3478d6513f786a591bd892da1a577bf2335f9"
+	proxyUploadTestStorageKey = "objects/sha256/91/6f/916f0027a575074ce72a331777c3478d6513f786a591bd892da1a577bf2335f9"
+	proxyUploadTestBody       = "test data"
+)
+
+func newProxyUploadTestObject(size int64) *domain.LFSObject {
+	oid, _ := domain.NewOID(proxyUploadTestOID)
+	s, _ := domain.NewSize(size)
+	hashAlgo, _ := domain.NewHashAlgorithm("sha256")
+	obj, _ := domain.NewLFSObject(context.Background(), oid, s, hashAlgo, proxyUploadTestStorageKey)
+	return obj
+}
+
+// stagingKeyMatcher は一時キー（staging/{uuid}/{storageKey}）に一致するgomock.Matcher
+type stagingKeyMatcher struct{}
+
+func (stagingKeyMatcher) Matches(x any) bool {
+	key, ok := x.(string)
+	return ok && strings.HasPrefix(key, "staging/") && strings.HasSuffix(key, "/"+proxyUploadTestStorageKey)
+}
+
+func (stagingKeyMatcher) String() string {
+	return "is staging key for " + proxyUploadTestStorageKey
+}
+
+func consumeBody(_ context.Context, _ string, body io.Reader, _ int64) error {
+	_, err := io.Copy(io.Discard, body)
+	return err
+}
+
 func TestProxyUploadUseCase_Execute(t *testing.T) {
 	type fields struct {
 		repo          func(ctrl *gomock.Controller) domain.LFSObjectRepository
 		objectStorage func(ctrl *gomock.Controller) usecase.ObjectStorage
 		authService   func(ctrl *gomock.Controller) domain.AccessAuthorizationService
+		policyRepo    func(ctrl *gomock.Controller) domain.AccessPolicyRepository
 	}
 	type args struct {
 		ctx   context.Context
@@ -29,6 +63,29 @@ func TestProxyUploadUseCase_Execute(t *testing.T) {
 		oid   domain.OID
 		body  io.Reader
 	}
+	allowedAuthService := func(ctrl *gomock.Controller) domain.AccessAuthorizationService {
+		mock := mock_domain.NewMockAccessAuthorizationService(ctrl)
+		mock.EXPECT().Authorize(gomock.Any(), domain.OperationUpload, gomock.Any(), gomock.Any()).Return(domain.AuthorizationResult{Allowed: true}, nil)
+		return mock
+	}
+	grantMissingAuthService := func(ctrl *gomock.Controller) domain.AccessAuthorizationService {
+		mock := mock_domain.NewMockAccessAuthorizationService(ctrl)
+		mock.EXPECT().Authorize(gomock.Any(), domain.OperationUpload, gomock.Any(), gomock.Any()).Return(domain.AuthorizationResult{Allowed: true, IsNewObject: true}, nil)
+		return mock
+	}
+	unusedPolicyRepo := func(ctrl *gomock.Controller) domain.AccessPolicyRepository {
+		return mock_domain.NewMockAccessPolicyRepository(ctrl)
+	}
+	newArgs := func(owner, repo, body string) args {
+		oid, _ := domain.NewOID(proxyUploadTestOID)
+		return args{
+			ctx:   context.Background(),
+			owner: owner,
+			repo:  repo,
+			oid:   oid,
+			body:  bytes.NewReader([]byte(body)),
+		}
+	}
 	tests := []struct {
 		name    string
 		fields  fields
@@ -36,40 +93,54 @@ func TestProxyUploadUseCase_Execute(t *testing.T) {
 		wantErr error
 	}{
 		{
-			name: "正常系: LFSObjectが見つかりアップロードが成功する",
+			name: "正常系: 一時キーにアップロードし、検証後に正式なキーへ昇格される",
 			fields: fields{
-				authService: func(ctrl *gomock.Controller) domain.AccessAuthorizationService {
-					mock := mock_domain.NewMockAccessAuthorizationService(ctrl)
-					mock.EXPECT().Authorize(gomock.Any(), domain.OperationUpload, gomock.Any(), gomock.Any()).Return(domain.AuthorizationResult{Allowed: true}, nil)
+				authService: allowedAuthService,
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().FindByOID(gomock.Any(), gomock.Any()).Return(newProxyUploadTestObject(9), nil)
+					mock.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, obj *domain.LFSObject) error {
+						if !obj.IsUploaded() {
+							t.Errorf("Update() called with not uploaded object")
+						}
+						return nil
+					})
+					return mock
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					mock := mock_usecase.NewMockObjectStorage(ctrl)
+					gomock.InOrder(
+						mock.EXPECT().PutObject(gomock.Any(), stagingKeyMatcher{}, gomock.Any(), int64(9)).DoAndReturn(consumeBody),
+						mock.EXPECT().CopyObject(gomock.Any(), stagingKeyMatcher{}, proxyUploadTestStorageKey).Return(nil),
+						mock.EXPECT().DeleteObject(gomock.Any(), stagingKeyMatcher{}).Return(nil),
+					)
 					return mock
 				},
+				policyRepo: unusedPolicyRepo,
+			},
+			args:    newArgs("testowner", "testrepo", proxyUploadTestBody),
+			wantErr: nil,
+		},
+		{
+			name: "正常系: 一時オブジェクトの削除に失敗しても、アップロードは成功する",
+			fields: fields{
+				authService: allowedAuthService,
 				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
 					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
-					ctx := context.Background()
-					oid, _ := domain.NewOID("1234567890123456789012345678901234567890123456789012345678901234")
-					size, _ := domain.NewSize(1024)
-					hashAlgo, _ := domain.NewHashAlgorithm("sha256")
-					obj, _ := domain.NewLFSObject(ctx, oid, size, hashAlgo, "objects/sha256/12/34/1234567890123456789012345678901234567890123456789012345678901234")
-					mock.EXPECT().FindByOID(gomock.Any(), gomock.Any()).Return(obj, nil)
+					mock.EXPECT().FindByOID(gomock.Any(), gomock.Any()).Return(newProxyUploadTestObject(9), nil)
 					mock.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
 					return mock
 				},
 				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
 					mock := mock_usecase.NewMockObjectStorage(ctrl)
-					mock.EXPECT().PutObject(gomock.Any(), "objects/sha256/12/34/1234567890123456789012345678901234567890123456789012345678901234", gomock.Any(), int64(1024)).Return(nil)
+					mock.EXPECT().PutObject(gomock.Any(), stagingKeyMatcher{}, gomock.Any(), int64(9)).DoAndReturn(consumeBody)
+					mock.EXPECT().CopyObject(gomock.Any(), stagingKeyMatcher{}, proxyUploadTestStorageKey).Return(nil)
+					mock.EXPECT().DeleteObject(gomock.Any(), stagingKeyMatcher{}).Return(errors.New("delete error"))
 					return mock
 				},
+				policyRepo: unusedPolicyRepo,
 			},
-			args: func() args {
-				oid, _ := domain.NewOID("1234567890123456789012345678901234567890123456789012345678901234")
-				return args{
-					ctx:   context.Background(),
-					owner: "testowner",
-					repo:  "testrepo",
-					oid:   oid,
-					body:  bytes.NewReader([]byte("test data")),
-				}
-			}(),
+			args:    newArgs("testowner", "testrepo", proxyUploadTestBody),
 			wantErr: nil,
 		},
 		{
@@ -86,19 +157,129 @@ func TestProxyUploadUseCase_Execute(t *testing.T) {
 				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
 					return mock_usecase.NewMockObjectStorage(ctrl)
 				},
+				policyRepo: unusedPolicyRepo,
 			},
-			args: func() args {
-				oid, _ := domain.NewOID("1234567890123456789012345678901234567890123456789012345678901234")
-				return args{
-					ctx:   context.Background(),
-					owner: "testowner",
-					repo:  "testrepo",
-					oid:   oid,
-					body:  bytes.NewReader([]byte("test data")),
-				}
-			}(),
+			args:    newArgs("testowner", "testrepo", proxyUploadTestBody),
 			wantErr: usecase.ErrAccessDenied,
 		},
+		{
+			name: "正常系: 紐付けのないリポジトリが保存済みオブジェクトと同じ内容を送信した場合、保存せずに紐付けが作成される",
+			fields: fields{
+				authService: grantMissingAuthService,
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					obj := newProxyUploadTestObject(9)
+					obj.MarkAsUploaded(context.Background())
+					mock.EXPECT().FindByOID(gomock.Any(), gomock.Any()).Return(obj, nil)
+					return mock
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					return mock_usecase.NewMockObjectStorage(ctrl)
+				},
+				policyRepo: func(ctrl *gomock.Controller) domain.AccessPolicyRepository {
+					mock := mock_domain.NewMockAccessPolicyRepository(ctrl)
+					mock.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, policy *domain.AccessPolicy) error {
+						if policy.OID().String() != proxyUploadTestOID {
+							t.Errorf("Save() OID = %v, want %v", policy.OID().String(), proxyUploadTestOID)
+						}
+						if policy.Repository().FullName() != "testowner/testrepo" {
+							t.Errorf("Save() Repository = %v, want %v", policy.Repository().FullName(), "testowner/testrepo")
+						}
+						return nil
+					})
+					return mock
+				},
+			},
+			args:    newArgs("testowner", "testrepo", proxyUploadTestBody),
+			wantErr: nil,
+		},
+		{
+			name: "正常系: 紐付けのないリポジトリが未保存のオブジェクトを送信した場合、保存後に紐付けが作成される",
+			fields: fields{
+				authService: grantMissingAuthService,
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().FindByOID(gomock.Any(), gomock.Any()).Return(newProxyUploadTestObject(9), nil)
+					mock.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+					return mock
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					mock := mock_usecase.NewMockObjectStorage(ctrl)
+					mock.EXPECT().PutObject(gomock.Any(), stagingKeyMatcher{}, gomock.Any(), int64(9)).DoAndReturn(consumeBody)
+					mock.EXPECT().CopyObject(gomock.Any(), stagingKeyMatcher{}, proxyUploadTestStorageKey).Return(nil)
+					mock.EXPECT().DeleteObject(gomock.Any(), stagingKeyMatcher{}).Return(nil)
+					return mock
+				},
+				policyRepo: func(ctrl *gomock.Controller) domain.AccessPolicyRepository {
+					mock := mock_domain.NewMockAccessPolicyRepository(ctrl)
+					mock.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil)
+					return mock
+				},
+			},
+			args:    newArgs("testowner", "testrepo", proxyUploadTestBody),
+			wantErr: nil,
+		},
+		{
+			name: "異常系: 紐付けのないリポジトリが異なる内容を送信した場合、ErrHashMismatchが返り紐付けは作成されない",
+			fields: fields{
+				authService: grantMissingAuthService,
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					obj := newProxyUploadTestObject(9)
+					obj.MarkAsUploaded(context.Background())
+					mock.EXPECT().FindByOID(gomock.Any(), gomock.Any()).Return(obj, nil)
+					return mock
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					return mock_usecase.NewMockObjectStorage(ctrl)
+				},
+				policyRepo: unusedPolicyRepo,
+			},
+			args:    newArgs("testowner", "testrepo", "fake data"),
+			wantErr: usecase.ErrHashMismatch,
+		},
+		{
+			name: "異常系: 紐付けのないリポジトリが宣言サイズと異なる内容を送信した場合、ErrSizeMismatchが返り紐付けは作成されない",
+			fields: fields{
+				authService: grantMissingAuthService,
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					obj := newProxyUploadTestObject(9)
+					obj.MarkAsUploaded(context.Background())
+					mock.EXPECT().FindByOID(gomock.Any(), gomock.Any()).Return(obj, nil)
+					return mock
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					return mock_usecase.NewMockObjectStorage(ctrl)
+				},
+				policyRepo: unusedPolicyRepo,
+			},
+			args:    newArgs("testowner", "testrepo", "test"),
+			wantErr: usecase.ErrSizeMismatch,
+		},
+		{
+			name: "異常系: 所持確認後の紐付け作成に失敗した場合、エラーが返る",
+			fields: fields{
+				authService: grantMissingAuthService,
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					obj := newProxyUploadTestObject(9)
+					obj.MarkAsUploaded(context.Background())
+					mock.EXPECT().FindByOID(gomock.Any(), gomock.Any()).Return(obj, nil)
+					return mock
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					return mock_usecase.NewMockObjectStorage(ctrl)
+				},
+				policyRepo: func(ctrl *gomock.Controller) domain.AccessPolicyRepository {
+					mock := mock_domain.NewMockAccessPolicyRepository(ctrl)
+					mock.EXPECT().Save(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
+					return mock
+				},
+			},
+			args:    newArgs("testowner", "testrepo", proxyUploadTestBody),
+			wantErr: errors.New("アクセスポリシーの作成に失敗しました: database error"),
+		},
 		{
 			name: "異常系: 認可サービスがエラーを返した場合、ErrAccessDeniedが返る",
 			fields: fields{
@@ -113,27 +294,15 @@ func TestProxyUploadUseCase_Execute(t *testing.T) {
 				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
 					return mock_usecase.NewMockObjectStorage(ctrl)
 				},
+				policyRepo: unusedPolicyRepo,
 			},
-			args: func() args {
-				oid, _ := domain.NewOID("1234567890123456789012345678901234567890123456789012345678901234")
-				return args{
-					ctx:   context.Background(),
-					owner: "testowner",
-					repo:  "testrepo",
-					oid:   oid,
-					body:  bytes.NewReader([]byte("test data")),
-				}
-			}(),
+			args:    newArgs("testowner", "testrepo", proxyUploadTestBody),
 			wantErr: usecase.ErrAccessDenied,
 		},
 		{
 			name: "異常系: LFSObjectが見つからない場合、ErrObjectNotFoundが返る",
 			fields: fields{
-				authService: func(ctrl *gomock.Controller) domain.AccessAuthorizationService {
-					mock := mock_domain.NewMockAccessAuthorizationService(ctrl)
-					mock.EXPECT().Authorize(gomock.Any(), domain.OperationUpload, gomock.Any(), gomock.Any()).Return(domain.AuthorizationResult{Allowed: true}, nil)
-					return mock
-				},
+				authService: allowedAuthService,
 				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
 					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
 					mock.EXPECT().FindByOID(gomock.Any(), gomock.Any()).Return(nil, domain.ErrNotFound)
@@ -142,27 +311,15 @@ func TestProxyUploadUseCase_Execute(t *testing.T) {
 				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
 					return mock_usecase.NewMockObjectStorage(ctrl)
 				},
+				policyRepo: unusedPolicyRepo,
 			},
-			args: func() args {
-				oid, _ := domain.NewOID("1234567890123456789012345678901234567890123456789012345678901234")
-				return args{
-					ctx:   context.Background(),
-					owner: "testowner",
-					repo:  "testrepo",
-					oid:   oid,
-					body:  bytes.NewReader([]byte("test data")),
-				}
-			}(),
+			args:    newArgs("testowner", "testrepo", proxyUploadTestBody),
 			wantErr: usecase.ErrObjectNotFound,
 		},
 		{
 			name: "異常系: リポジトリ検索でエラーが発生した場合、そのエラーが返る",
 			fields: fields{
-				authService: func(ctrl *gomock.Controller) domain.AccessAuthorizationService {
-					mock := mock_domain.NewMockAccessAuthorizationService(ctrl)
-					mock.EXPECT().Authorize(gomock.Any(), domain.OperationUpload, gomock.Any(), gomock.Any()).Return(domain.AuthorizationResult{Allowed: true}, nil)
-					return mock
-				},
+				authService: allowedAuthService,
 				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
 					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
 					mock.EXPECT().FindByOID(gomock.Any(), gomock.Any()).Return(nil, errors.New("database error"))
@@ -171,90 +328,174 @@ func TestProxyUploadUseCase_Execute(t *testing.T) {
 				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
 					return mock_usecase.NewMockObjectStorage(ctrl)
 				},
+				policyRepo: unusedPolicyRepo,
 			},
-			args: func() args {
-				oid, _ := domain.NewOID("1234567890123456789012345678901234567890123456789012345678901234")
-				return args{
-					ctx:   context.Background(),
-					owner: "testowner",
-					repo:  "testrepo",
-					oid:   oid,
-					body:  bytes.NewReader([]byte("test data")),
-				}
-			}(),
+			args:    newArgs("testowner", "testrepo", proxyUploadTestBody),
 			wantErr: errors.New("database error"),
 		},
 		{
 			name: "異常系: ObjectStorage.PutObjectでエラーが発生した場合、そのエラーが返る",
 			fields: fields{
-				authService: func(ctrl *gomock.Controller) domain.AccessAuthorizationService {
-					mock := mock_domain.NewMockAccessAuthorizationService(ctrl)
-					mock.EXPECT().Authorize(gomock.Any(), domain.OperationUpload, gomock.Any(), gomock.Any()).Return(domain.AuthorizationResult{Allowed: true}, nil)
+				authService: allowedAuthService,
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().FindByOID(gomock.Any(), gomock.Any()).Return(newProxyUploadTestObject(9), nil)
+					return mock
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					mock := mock_usecase.NewMockObjectStorage(ctrl)
+					mock.EXPECT().PutObject(gomock.Any(), stagingKeyMatcher{}, gomock.Any(), int64(9)).Return(errors.New("storage error"))
 					return mock
 				},
+				policyRepo: unusedPolicyRepo,
+			},
+			args:    newArgs("testowner", "testrepo", proxyUploadTestBody),
+			wantErr: errors.New("storage error"),
+		},
+		{
+			name: "異常系: ボディが宣言サイズに満たずPutObjectが失敗した場合、ErrSizeMismatchが返り一時オブジェクトが削除される",
+			fields: fields{
+				authService: allowedAuthService,
 				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
 					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
-					ctx := context.Background()
-					oid, _ := domain.NewOID("1234567890123456789012345678901234567890123456789012345678901234")
-					size, _ := domain.NewSize(1024)
-					hashAlgo, _ := domain.NewHashAlgorithm("sha256")
-					obj, _ := domain.NewLFSObject(ctx, oid, size, hashAlgo, "objects/sha256/12/34/1234567890123456789012345678901234567890123456789012345678901234")
-					mock.EXPECT().FindByOID(gomock.Any(), gomock.Any()).Return(obj, nil)
+					mock.EXPECT().FindByOID(gomock.Any(), gomock.Any()).Return(newProxyUploadTestObject(1024), nil)
 					return mock
 				},
 				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
 					mock := mock_usecase.NewMockObjectStorage(ctrl)
-					mock.EXPECT().PutObject(gomock.Any(), gomock.Any(), gomock.Any(), int64(1024)).Return(errors.New("storage error"))
+					mock.EXPECT().PutObject(gomock.Any(), stagingKeyMatcher{}, gomock.Any(), int64(1024)).DoAndReturn(func(ctx context.Context, key string, body io.Reader, size int64) error {
+						_ = consumeBody(ctx, key, body, size)
+						return errors.New("unexpected EOF")
+					})
+					mock.EXPECT().DeleteObject(gomock.Any(), stagingKeyMatcher{}).Return(nil)
 					return mock
 				},
+				policyRepo: unusedPolicyRepo,
 			},
-			args: func() args {
-				oid, _ := domain.NewOID("1234567890123456789012345678901234567890123456789012345678901234")
-				return args{
-					ctx:   context.Background(),
-					owner: "testowner",
-					repo:  "testrepo",
-					oid:   oid,
-					body:  bytes.NewReader([]byte("test data")),
-				}
-			}(),
-			wantErr: errors.New("storage error"),
+			args:    newArgs("testowner", "testrepo", proxyUploadTestBody),
+			wantErr: usecase.ErrSizeMismatch,
 		},
 		{
-			name: "異常系: リポジトリ更新でエラーが発生した場合、そのエラーが返る",
+			name: "異常系: ボディが宣言サイズより短い場合、ErrSizeMismatchが返り昇格されない",
 			fields: fields{
-				authService: func(ctrl *gomock.Controller) domain.AccessAuthorizationService {
-					mock := mock_domain.NewMockAccessAuthorizationService(ctrl)
-					mock.EXPECT().Authorize(gomock.Any(), domain.OperationUpload, gomock.Any(), gomock.Any()).Return(domain.AuthorizationResult{Allowed: true}, nil)
+				authService: allowedAuthService,
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().FindByOID(gomock.Any(), gomock.Any()).Return(newProxyUploadTestObject(1024), nil)
 					return mock
 				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					mock := mock_usecase.NewMockObjectStorage(ctrl)
+					mock.EXPECT().PutObject(gomock.Any(), stagingKeyMatcher{}, gomock.Any(), int64(1024)).DoAndReturn(consumeBody)
+					mock.EXPECT().DeleteObject(gomock.Any(), stagingKeyMatcher{}).Return(nil)
+					return mock
+				},
+				policyRepo: unusedPolicyRepo,
+			},
+			args:    newArgs("testowner", "testrepo", proxyUploadTestBody),
+			wantErr: usecase.ErrSizeMismatch,
+		},
+		{
+			name: "異常系: ボディが宣言サイズより長い場合、ErrSizeMismatchが返り昇格されない",
+			fields: fields{
+				authService: allowedAuthService,
 				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
 					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
-					ctx := context.Background()
-					oid, _ := domain.NewOID("1234567890123456789012345678901234567890123456789012345678901234")
-					size, _ := domain.NewSize(1024)
-					hashAlgo, _ := domain.NewHashAlgorithm("sha256")
-					obj, _ := domain.NewLFSObject(ctx, oid, size, hashAlgo, "objects/sha256/12/34/1234567890123456789012345678901234567890123456789012345678901234")
-					mock.EXPECT().FindByOID(gomock.Any(), gomock.Any()).Return(obj, nil)
+					mock.EXPECT().FindByOID(gomock.Any(), gomock.Any()).Return(newProxyUploadTestObject(9), nil)
+					return mock
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					mock := mock_usecase.NewMockObjectStorage(ctrl)
+					mock.EXPECT().PutObject(gomock.Any(), stagingKeyMatcher{}, gomock.Any(), int64(9)).DoAndReturn(consumeBody)
+					mock.EXPECT().DeleteObject(gomock.Any(), stagingKeyMatcher{}).Return(nil)
+					return mock
+				},
+				policyRepo: unusedPolicyRepo,
+			},
+			args:    newArgs("testowner", "testrepo", proxyUploadTestBody+" with trailing bytes"),
+			wantErr: usecase.ErrSizeMismatch,
+		},
+		{
+			name: "異常系: ボディのハッシュがOIDと一致しない場合、ErrHashMismatchが返り昇格されない",
+			fields: fields{
+				authService: allowedAuthService,
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().FindByOID(gomock.Any(), gomock.Any()).Return(newProxyUploadTestObject(9), nil)
+					return mock
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					mock := mock_usecase.NewMockObjectStorage(ctrl)
+					mock.EXPECT().PutObject(gomock.Any(), stagingKeyMatcher{}, gomock.Any(), int64(9)).DoAndReturn(consumeBody)
+					mock.EXPECT().DeleteObject(gomock.Any(), stagingKeyMatcher{}).Return(nil)
+					return mock
+				},
+				policyRepo: unusedPolicyRepo,
+			},
+			args:    newArgs("testowner", "testrepo", "tampered!"),
+			wantErr: usecase.ErrHashMismatch,
+		},
+		{
+			name: "異常系: 一時オブジェクトの削除に失敗しても、検証エラーが返る",
+			fields: fields{
+				authService: allowedAuthService,
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().FindByOID(gomock.Any(), gomock.Any()).Return(newProxyUploadTestObject(9), nil)
+					return mock
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					mock := mock_usecase.NewMockObjectStorage(ctrl)
+					mock.EXPECT().PutObject(gomock.Any(), stagingKeyMatcher{}, gomock.Any(), int64(9)).DoAndReturn(consumeBody)
+					mock.EXPECT().DeleteObject(gomock.Any(), stagingKeyMatcher{}).Return(errors.New("delete error"))
+					return mock
+				},
+				policyRepo: unusedPolicyRepo,
+			},
+			args:    newArgs("testowner", "testrepo", "tampered!"),
+			wantErr: usecase.ErrHashMismatch,
+		},
+		{
+			name: "異常系: 正式なキーへのコピーに失敗した場合、そのエラーが返り一時オブジェクトが削除される",
+			fields: fields{
+				authService: allowedAuthService,
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().FindByOID(gomock.Any(), gomock.Any()).Return(newProxyUploadTestObject(9), nil)
+					return mock
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					mock := mock_usecase.NewMockObjectStorage(ctrl)
+					mock.EXPECT().PutObject(gomock.Any(), stagingKeyMatcher{}, gomock.Any(), int64(9)).DoAndReturn(consumeBody)
+					mock.EXPECT().CopyObject(gomock.Any(), stagingKeyMatcher{}, proxyUploadTestStorageKey).Return(errors.New("copy error"))
+					mock.EXPECT().DeleteObject(gomock.Any(), stagingKeyMatcher{}).Return(nil)
+					return mock
+				},
+				policyRepo: unusedPolicyRepo,
+			},
+			args:    newArgs("testowner", "testrepo", proxyUploadTestBody),
+			wantErr: errors.New("copy error"),
+		},
+		{
+			name: "異常系: リポジトリ更新でエラーが発生した場合、そのエラーが返る",
+			fields: fields{
+				authService: allowedAuthService,
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().FindByOID(gomock.Any(), gomock.Any()).Return(newProxyUploadTestObject(9), nil)
 					mock.EXPECT().Update(gomock.Any(), gomock.Any()).Return(errors.New("update error"))
 					return mock
 				},
 				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
 					mock := mock_usecase.NewMockObjectStorage(ctrl)
-					mock.EXPECT().PutObject(gomock.Any(), gomock.Any(), gomock.Any(), int64(1024)).Return(nil)
+					mock.EXPECT().PutObject(gomock.Any(), stagingKeyMatcher{}, gomock.Any(), int64(9)).DoAndReturn(consumeBody)
+					mock.EXPECT().CopyObject(gomock.Any(), stagingKeyMatcher{}, proxyUploadTestStorageKey).Return(nil)
+					mock.EXPECT().DeleteObject(gomock.Any(), stagingKeyMatcher{}).Return(nil)
 					return mock
 				},
+				policyRepo: unusedPolicyRepo,
 			},
-			args: func() args {
-				oid, _ := domain.NewOID("1234567890123456789012345678901234567890123456789012345678901234")
-				return args{
-					ctx:   context.Background(),
-					owner: "testowner",
-					repo:  "testrepo",
-					oid:   oid,
-					body:  bytes.NewReader([]byte("test data")),
-				}
-			}(),
+			args:    newArgs("testowner", "testrepo", proxyUploadTestBody),
 			wantErr: errors.New("update error"),
 		},
 		{
@@ -269,17 +510,9 @@ func TestProxyUploadUseCase_Execute(t *testing.T) {
 				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
 					return mock_usecase.NewMockObjectStorage(ctrl)
 				},
+				policyRepo: unusedPolicyRepo,
 			},
-			args: func() args {
-				oid, _ := domain.NewOID("1234567890123456789012345678901234567890123456789012345678901234")
-				return args{
-					ctx:   context.Background(),
-					owner: "",
-					repo:  "",
-					oid:   oid,
-					body:  bytes.NewReader([]byte("test data")),
-				}
-			}(),
+			args:    newArgs("", "", proxyUploadTestBody),
 			wantErr: usecase.ErrAccessDenied,
 		},
 	}
@@ -292,6 +525,7 @@ func TestProxyUploadUseCase_Execute(t *testing.T) {
 				tt.fields.repo(ctrl),
 				tt.fields.objectStorage(ctrl),
 				tt.fields.authService(ctrl),
+				tt.fields.policyRepo(ctrl),
 			)
 
 			err := uc.Execute(tt.args.ctx, tt.args.owner, tt.args.repo, tt.args.oid, tt.args.body)
@@ -300,11 +534,15 @@ func TestProxyUploadUseCase_Execute(t *testing.T) {
 				if err == nil {
 					t.Fatalf("want error %v, but got nil", tt.wantErr)
 				}
-				if errors.Is(tt.wantErr, usecase.ErrAccessDenied) || errors.Is(tt.wantErr, usecase.ErrObjectNotFound) {
+				switch {
+				case errors.Is(tt.wantErr, usecase.ErrAccessDenied),
+					errors.Is(tt.wantErr, usecase.ErrObjectNotFound),
+					errors.Is(tt.wantErr, usecase.ErrSizeMismatch),
+					errors.Is(tt.wantErr, usecase.ErrHashMismatch):
 					if !errors.Is(err, tt.wantErr) {
 						t.Errorf("want error %v, but got %v", tt.wantErr, err)
 					}
-				} else {
+				default:
 					if diff := cmp.Diff(tt.wantErr.Error(), err.Error()); diff != "" {
 						t.Errorf("error mismatch (-want +got):\n%s", diff)
 					}
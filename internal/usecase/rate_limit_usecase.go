@@ -0,0 +1,123 @@
+//go:generate mockgen -source=$GOFILE -destination=../../tests/usecase/mock_rate_limit_usecase.go -package=usecase
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/newmo-oss/ctxtime"
+)
+
+const (
+	RateLimitScopeBatch      = "batch"
+	RateLimitScopeProxyBytes = "proxy_bytes"
+)
+
+// RateLimitStore はGCRA (Generic Cell Rate Algorithm) の理論到着時刻を対象ごとに保持するストア
+// 複数のPodで制限を共有するため、判定と更新はアトミックに行う
+type RateLimitStore interface {
+	// Take は理論到着時刻をincrementだけ進める
+	// 進めた結果が現在時刻からtoleranceを超える場合は更新せず、再試行できるまでの待ち時間を返す
+	Take(ctx context.Context, scope string, subject domain.RateLimitSubject, now time.Time, increment, tolerance time.Duration) (time.Duration, error)
+	// Charge は制限を判定せずに理論到着時刻をincrementだけ進める。負のincrementは払い戻しとして理論到着時刻を戻す
+	Charge(ctx context.Context, scope string, subject domain.RateLimitSubject, now time.Time, increment time.Duration) error
+}
+
+// RateLimitPolicy はレート制限の上限
+type RateLimitPolicy struct {
+	// Rate は1秒あたりに許可する量。0以下の場合は制限しない
+	Rate float64
+	// Burst は待たずに許可する量の上限
+	Burst float64
+}
+
+func (p RateLimitPolicy) enabled() bool {
+	return p.Rate > 0
+}
+
+// interval は量amountを消費したときに理論到着時刻を進める時間を返す
+func (p RateLimitPolicy) interval(amount float64) time.Duration {
+	return time.Duration(amount / p.Rate * float64(time.Second))
+}
+
+type RateLimitUseCase interface {
+	// AllowBatch はバッチリクエスト1回分を消費できるかを判定する
+	AllowBatch(ctx context.Context, subjects []domain.RateLimitSubject) (domain.RateLimitDecision, error)
+	// AllowProxyTransfer は転送予定のバイト数を前払いで消費できるかを判定する
+	AllowProxyTransfer(ctx context.Context, subjects []domain.RateLimitSubject, expectedBytes int64) (domain.RateLimitDecision, error)
+	// RecordProxyTransfer は前払いしたバイト数と実際に転送したバイト数の差を精算する
+	RecordProxyTransfer(ctx context.Context, subjects []domain.RateLimitSubject, expectedBytes, transferredBytes int64) error
+}
+
+type rateLimitUseCaseImpl struct {
+	store      RateLimitStore
+	batch      RateLimitPolicy
+	proxyBytes RateLimitPolicy
+}
+
+func NewRateLimitUseCase(store RateLimitStore, batch, proxyBytes RateLimitPolicy) RateLimitUseCase {
+	return &rateLimitUseCaseImpl{
+		store:      store,
+		batch:      batch,
+		proxyBytes: proxyBytes,
+	}
+}
+
+func (u *rateLimitUseCaseImpl) AllowBatch(ctx context.Context, subjects []domain.RateLimitSubject) (domain.RateLimitDecision, error) {
+	if !u.batch.enabled() {
+		return domain.RateLimitDecision{Allowed: true}, nil
+	}
+	return u.take(ctx, RateLimitScopeBatch, subjects, u.batch.interval(1), u.batch.interval(u.batch.Burst))
+}
+
+// AllowProxyTransfer は転送予定のバイト数を転送の開始前に消費する
+// 同時に開始した転送がそれぞれ上限内と判定されないよう、判定と消費をアトミックに行う
+// バースト量を超える転送は常に拒否されないよう、前払いはバースト量までとし、残りはRecordProxyTransferで消費する
+func (u *rateLimitUseCaseImpl) AllowProxyTransfer(ctx context.Context, subjects []domain.RateLimitSubject, expectedBytes int64) (domain.RateLimitDecision, error) {
+	if !u.proxyBytes.enabled() {
+		return domain.RateLimitDecision{Allowed: true}, nil
+	}
+	return u.take(ctx, RateLimitScopeProxyBytes, subjects, u.proxyBytes.interval(u.prepaidBytes(expectedBytes)), u.proxyBytes.interval(u.proxyBytes.Burst))
+}
+
+// RecordProxyTransfer はAllowProxyTransferで前払いした分との差を消費する
+// 予定より少なく転送した場合は差分を払い戻す
+func (u *rateLimitUseCaseImpl) RecordProxyTransfer(ctx context.Context, subjects []domain.RateLimitSubject, expectedBytes, transferredBytes int64) error {
+	if !u.proxyBytes.enabled() {
+		return nil
+	}
+	diff := float64(max(transferredBytes, 0)) - u.prepaidBytes(expectedBytes)
+	if diff == 0 {
+		return nil
+	}
+	now := ctxtime.Now(ctx)
+	increment := u.proxyBytes.interval(diff)
+	for _, subject := range subjects {
+		if err := u.store.Charge(ctx, RateLimitScopeProxyBytes, subject, now, increment); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// prepaidBytes はAllowProxyTransferで前払いするバイト数を返す
+func (u *rateLimitUseCaseImpl) prepaidBytes(expectedBytes int64) float64 {
+	return min(float64(max(expectedBytes, 0)), u.proxyBytes.Burst)
+}
+
+// take は対象ごとに順に判定し、最初に拒否された時点で打ち切る
+// 拒否される前に判定した対象は消費済みとなるが、制限を緩める方向には働かないため許容する
+func (u *rateLimitUseCaseImpl) take(ctx context.Context, scope string, subjects []domain.RateLimitSubject, increment, tolerance time.Duration) (domain.RateLimitDecision, error) {
+	now := ctxtime.Now(ctx)
+	for _, subject := range subjects {
+		retryAfter, err := u.store.Take(ctx, scope, subject, now, increment, tolerance)
+		if err != nil {
+			return domain.RateLimitDecision{}, err
+		}
+		if retryAfter > 0 {
+			return domain.RateLimitDecision{Allowed: false, RetryAfter: retryAfter}, nil
+		}
+	}
+	return domain.RateLimitDecision{Allowed: true}, nil
+}
@@ -0,0 +1,343 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/na2na-p/cargohold/internal/usecase"
+	mock_usecase "github.com/na2na-p/cargohold/tests/usecase"
+	"github.com/newmo-oss/ctxtime/ctxtimetest"
+	"github.com/newmo-oss/testid"
+	"go.uber.org/mock/gomock"
+)
+
+var rateLimitSubjects = []domain.RateLimitSubject{
+	{Dimension: domain.RateLimitDimensionIdentity, Value: "user-1"},
+	{Dimension: domain.RateLimitDimensionRepository, Value: "owner/repo"},
+	{Dimension: domain.RateLimitDimensionClientIP, Value: "192.0.2.1"},
+}
+
+// TestRateLimitUseCase_AllowBatch は RateLimitUseCase.AllowBatch のテーブルドリブンテスト
+func TestRateLimitUseCase_AllowBatch(t *testing.T) {
+	fixedNow := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		policy  usecase.RateLimitPolicy
+		store   func(ctrl *gomock.Controller) usecase.RateLimitStore
+		want    domain.RateLimitDecision
+		wantErr bool
+	}{
+		{
+			name:   "正常系: すべての対象が許容範囲内の場合、許可される",
+			policy: usecase.RateLimitPolicy{Rate: 10, Burst: 5},
+			store: func(ctrl *gomock.Controller) usecase.RateLimitStore {
+				mock := mock_usecase.NewMockRateLimitStore(ctrl)
+				for _, subject := range rateLimitSubjects {
+					mock.EXPECT().Take(gomock.Any(), usecase.RateLimitScopeBatch, subject, fixedNow, 100*time.Millisecond, 500*time.Millisecond).Return(time.Duration(0), nil)
+				}
+				return mock
+			},
+			want: domain.RateLimitDecision{Allowed: true},
+		},
+		{
+			name:   "正常系: いずれかの対象が超過した場合、待ち時間とともに拒否され、以降の対象は判定されない",
+			policy: usecase.RateLimitPolicy{Rate: 10, Burst: 5},
+			store: func(ctrl *gomock.Controller) usecase.RateLimitStore {
+				mock := mock_usecase.NewMockRateLimitStore(ctrl)
+				mock.EXPECT().Take(gomock.Any(), usecase.RateLimitScopeBatch, rateLimitSubjects[0], gomock.Any(), gomock.Any(), gomock.Any()).Return(time.Duration(0), nil)
+				mock.EXPECT().Take(gomock.Any(), usecase.RateLimitScopeBatch, rateLimitSubjects[1], gomock.Any(), gomock.Any(), gomock.Any()).Return(3*time.Second, nil)
+				return mock
+			},
+			want: domain.RateLimitDecision{Allowed: false, RetryAfter: 3 * time.Second},
+		},
+		{
+			name:   "正常系: 制限が無効な場合、ストアを参照せずに許可される",
+			policy: usecase.RateLimitPolicy{},
+			store: func(ctrl *gomock.Controller) usecase.RateLimitStore {
+				return mock_usecase.NewMockRateLimitStore(ctrl)
+			},
+			want: domain.RateLimitDecision{Allowed: true},
+		},
+		{
+			name:   "異常系: ストアがエラーを返した場合、エラーが返る",
+			policy: usecase.RateLimitPolicy{Rate: 10, Burst: 5},
+			store: func(ctrl *gomock.Controller) usecase.RateLimitStore {
+				mock := mock_usecase.NewMockRateLimitStore(ctrl)
+				mock.EXPECT().Take(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(time.Duration(0), errors.New("redis error"))
+				return mock
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ctx := testid.WithValue(context.Background(), t.Name())
+			ctxtimetest.SetFixedNow(t, ctx, fixedNow)
+
+			uc := usecase.NewRateLimitUseCase(tt.store(ctrl), tt.policy, usecase.RateLimitPolicy{})
+			got, err := uc.AllowBatch(ctx, rateLimitSubjects)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("AllowBatch() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("AllowBatch() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestRateLimitUseCase_AllowProxyTransfer は RateLimitUseCase.AllowProxyTransfer のテーブルドリブンテスト
+func TestRateLimitUseCase_AllowProxyTransfer(t *testing.T) {
+	fixedNow := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		policy        usecase.RateLimitPolicy
+		expectedBytes int64
+		store         func(ctrl *gomock.Controller) usecase.RateLimitStore
+		want          domain.RateLimitDecision
+	}{
+		{
+			name:          "正常系: 転送予定のバイト数が前払いで消費される",
+			policy:        usecase.RateLimitPolicy{Rate: 1024, Burst: 4096},
+			expectedBytes: 2048,
+			store: func(ctrl *gomock.Controller) usecase.RateLimitStore {
+				mock := mock_usecase.NewMockRateLimitStore(ctrl)
+				for _, subject := range rateLimitSubjects {
+					mock.EXPECT().Take(gomock.Any(), usecase.RateLimitScopeProxyBytes, subject, fixedNow, 2*time.Second, 4*time.Second).Return(time.Duration(0), nil)
+				}
+				return mock
+			},
+			want: domain.RateLimitDecision{Allowed: true},
+		},
+		{
+			name:          "正常系: バースト量を超える転送はバースト量まで前払いされる",
+			policy:        usecase.RateLimitPolicy{Rate: 1024, Burst: 4096},
+			expectedBytes: 10240,
+			store: func(ctrl *gomock.Controller) usecase.RateLimitStore {
+				mock := mock_usecase.NewMockRateLimitStore(ctrl)
+				for _, subject := range rateLimitSubjects {
+					mock.EXPECT().Take(gomock.Any(), usecase.RateLimitScopeProxyBytes, subject, fixedNow, 4*time.Second, 4*time.Second).Return(time.Duration(0), nil)
+				}
+				return mock
+			},
+			want: domain.RateLimitDecision{Allowed: true},
+		},
+		{
+			name:          "正常系: 転送予定が0の場合も過去の転送で超過していれば拒否される",
+			policy:        usecase.RateLimitPolicy{Rate: 1024, Burst: 4096},
+			expectedBytes: 0,
+			store: func(ctrl *gomock.Controller) usecase.RateLimitStore {
+				mock := mock_usecase.NewMockRateLimitStore(ctrl)
+				mock.EXPECT().Take(gomock.Any(), usecase.RateLimitScopeProxyBytes, rateLimitSubjects[0], fixedNow, time.Duration(0), 4*time.Second).Return(10*time.Second, nil)
+				return mock
+			},
+			want: domain.RateLimitDecision{Allowed: false, RetryAfter: 10 * time.Second},
+		},
+		{
+			name:          "正常系: 制限が無効の場合、ストアは呼ばれない",
+			policy:        usecase.RateLimitPolicy{},
+			expectedBytes: 2048,
+			store: func(ctrl *gomock.Controller) usecase.RateLimitStore {
+				return mock_usecase.NewMockRateLimitStore(ctrl)
+			},
+			want: domain.RateLimitDecision{Allowed: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ctx := testid.WithValue(context.Background(), t.Name())
+			ctxtimetest.SetFixedNow(t, ctx, fixedNow)
+
+			uc := usecase.NewRateLimitUseCase(tt.store(ctrl), usecase.RateLimitPolicy{}, tt.policy)
+			got, err := uc.AllowProxyTransfer(ctx, rateLimitSubjects, tt.expectedBytes)
+			if err != nil {
+				t.Fatalf("AllowProxyTransfer() unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("AllowProxyTransfer() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestRateLimitUseCase_AllowProxyTransfer_Concurrent は同時に開始した転送が上限を超えて許可されないことを確認する
+func TestRateLimitUseCase_AllowProxyTransfer_Concurrent(t *testing.T) {
+	fixedNow := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := testid.WithValue(context.Background(), t.Name())
+	ctxtimetest.SetFixedNow(t, ctx, fixedNow)
+
+	// 1秒あたり1024バイト、バースト4096バイトの場合、2048バイトの転送は同時に2件まで許可される
+	uc := usecase.NewRateLimitUseCase(newInMemoryRateLimitStore(), usecase.RateLimitPolicy{}, usecase.RateLimitPolicy{Rate: 1024, Burst: 4096})
+	const transfers = 8
+	var wg sync.WaitGroup
+	var allowed atomic.Int32
+	for range transfers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			decision, err := uc.AllowProxyTransfer(ctx, rateLimitSubjects, 2048)
+			if err != nil {
+				t.Errorf("AllowProxyTransfer() unexpected error: %v", err)
+				return
+			}
+			if decision.Allowed {
+				allowed.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := allowed.Load(); got != 2 {
+		t.Errorf("allowed transfers = %d, want 2", got)
+	}
+
+	// 許可された転送が予定より少なく終わった場合は払い戻され、次の転送が許可される
+	if err := uc.RecordProxyTransfer(ctx, rateLimitSubjects, 2048, 0); err != nil {
+		t.Fatalf("RecordProxyTransfer() unexpected error: %v", err)
+	}
+	decision, err := uc.AllowProxyTransfer(ctx, rateLimitSubjects, 2048)
+	if err != nil {
+		t.Fatalf("AllowProxyTransfer() unexpected error: %v", err)
+	}
+	if !decision.Allowed {
+		t.Errorf("AllowProxyTransfer() after refund = %+v, want allowed", decision)
+	}
+}
+
+// inMemoryRateLimitStore はRedisのスクリプトと同じ判定をプロセス内で行うRateLimitStore
+type inMemoryRateLimitStore struct {
+	mu   sync.Mutex
+	tats map[string]time.Time
+}
+
+func newInMemoryRateLimitStore() *inMemoryRateLimitStore {
+	return &inMemoryRateLimitStore{tats: make(map[string]time.Time)}
+}
+
+func (s *inMemoryRateLimitStore) Take(_ context.Context, scope string, subject domain.RateLimitSubject, now time.Time, increment, tolerance time.Duration) (time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := scope + ":" + string(subject.Dimension) + ":" + subject.Value
+	tat := s.tat(key, now)
+	newTAT := tat.Add(increment)
+	if over := newTAT.Sub(now) - tolerance; over > 0 {
+		return over, nil
+	}
+	s.tats[key] = newTAT
+	return 0, nil
+}
+
+func (s *inMemoryRateLimitStore) Charge(_ context.Context, scope string, subject domain.RateLimitSubject, now time.Time, increment time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := scope + ":" + string(subject.Dimension) + ":" + subject.Value
+	s.tats[key] = s.tat(key, now).Add(increment)
+	return nil
+}
+
+func (s *inMemoryRateLimitStore) tat(key string, now time.Time) time.Time {
+	if tat, ok := s.tats[key]; ok && tat.After(now) {
+		return tat
+	}
+	return now
+}
+
+// TestRateLimitUseCase_RecordProxyTransfer は RateLimitUseCase.RecordProxyTransfer のテーブルドリブンテスト
+func TestRateLimitUseCase_RecordProxyTransfer(t *testing.T) {
+	fixedNow := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		policy        usecase.RateLimitPolicy
+		expectedBytes int64
+		bytes         int64
+		store         func(ctrl *gomock.Controller) usecase.RateLimitStore
+		wantErr       bool
+	}{
+		{
+			name:          "正常系: 前払いを超えて転送した分が各対象で消費される",
+			policy:        usecase.RateLimitPolicy{Rate: 1024, Burst: 4096},
+			expectedBytes: 1024,
+			bytes:         3072,
+			store: func(ctrl *gomock.Controller) usecase.RateLimitStore {
+				mock := mock_usecase.NewMockRateLimitStore(ctrl)
+				for _, subject := range rateLimitSubjects {
+					mock.EXPECT().Charge(gomock.Any(), usecase.RateLimitScopeProxyBytes, subject, fixedNow, 2*time.Second).Return(nil)
+				}
+				return mock
+			},
+		},
+		{
+			name:          "正常系: 前払いより少なく転送した場合、差分が払い戻される",
+			policy:        usecase.RateLimitPolicy{Rate: 1024, Burst: 4096},
+			expectedBytes: 2048,
+			bytes:         1024,
+			store: func(ctrl *gomock.Controller) usecase.RateLimitStore {
+				mock := mock_usecase.NewMockRateLimitStore(ctrl)
+				for _, subject := range rateLimitSubjects {
+					mock.EXPECT().Charge(gomock.Any(), usecase.RateLimitScopeProxyBytes, subject, fixedNow, -time.Second).Return(nil)
+				}
+				return mock
+			},
+		},
+		{
+			name:          "正常系: バースト量を超える転送は前払いしたバースト量との差が消費される",
+			policy:        usecase.RateLimitPolicy{Rate: 1024, Burst: 4096},
+			expectedBytes: 10240,
+			bytes:         10240,
+			store: func(ctrl *gomock.Controller) usecase.RateLimitStore {
+				mock := mock_usecase.NewMockRateLimitStore(ctrl)
+				for _, subject := range rateLimitSubjects {
+					mock.EXPECT().Charge(gomock.Any(), usecase.RateLimitScopeProxyBytes, subject, fixedNow, 6*time.Second).Return(nil)
+				}
+				return mock
+			},
+		},
+		{
+			name:          "正常系: 前払いどおりに転送した場合、ストアは呼ばれない",
+			policy:        usecase.RateLimitPolicy{Rate: 1024, Burst: 4096},
+			expectedBytes: 2048,
+			bytes:         2048,
+			store: func(ctrl *gomock.Controller) usecase.RateLimitStore {
+				return mock_usecase.NewMockRateLimitStore(ctrl)
+			},
+		},
+		{
+			name:   "異常系: ストアがエラーを返した場合、エラーが返る",
+			policy: usecase.RateLimitPolicy{Rate: 1024, Burst: 4096},
+			bytes:  2048,
+			store: func(ctrl *gomock.Controller) usecase.RateLimitStore {
+				mock := mock_usecase.NewMockRateLimitStore(ctrl)
+				mock.EXPECT().Charge(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("redis error"))
+				return mock
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ctx := testid.WithValue(context.Background(), t.Name())
+			ctxtimetest.SetFixedNow(t, ctx, fixedNow)
+
+			uc := usecase.NewRateLimitUseCase(tt.store(ctrl), usecase.RateLimitPolicy{}, tt.policy)
+			err := uc.RecordProxyTransfer(ctx, rateLimitSubjects, tt.expectedBytes, tt.bytes)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RecordProxyTransfer() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+//go:generate mockgen -source=$GOFILE -destination=../../tests/usecase/mock_reconcile_usecase.go -package=usecase
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/na2na-p/cargohold/internal/domain"
+)
+
+// reconcilePageSize はメタデータを走査する際に1回で取得する件数
+const reconcilePageSize = 1000
+
+// ReconcileQuery は突き合わせの実行条件
+// HashAlgoのストレージキー配下を対象とし、Fixの場合は検出した不整合を修復する
+type ReconcileQuery struct {
+	HashAlgo string
+	Fix      bool
+}
+
+// SizeMismatch はメタデータとストレージ上の実体でサイズが異なるオブジェクト
+type SizeMismatch struct {
+	Object     *domain.LFSObject
+	StoredSize int64
+}
+
+// ReconcileResult は突き合わせの結果
+// MissingBlobsはアップロード済みだが実体が存在しないオブジェクト、OrphanedBlobsはメタデータが存在しない実体
+type ReconcileResult struct {
+	MissingBlobs   []*domain.LFSObject
+	OrphanedBlobs  []StoredObject
+	SizeMismatches []SizeMismatch
+	Fixed          int
+	Failed         int
+}
+
+type ReconcileUseCase interface {
+	Run(ctx context.Context, query ReconcileQuery) (*ReconcileResult, error)
+}
+
+type reconcileUseCaseImpl struct {
+	repo          domain.LFSObjectRepository
+	objectStorage ObjectStorage
+	objectLister  ObjectLister
+}
+
+func NewReconcileUseCase(repo domain.LFSObjectRepository, objectStorage ObjectStorage, objectLister ObjectLister) ReconcileUseCase {
+	return &reconcileUseCaseImpl{
+		repo:          repo,
+		objectStorage: objectStorage,
+		objectLister:  objectLister,
+	}
+}
+
+// Run はストレージ上の実体を列挙してからメタデータを走査し、両者の不整合を検出する
+// Fixの場合、実体が欠損またはサイズが不一致のメタデータを未アップロードに戻し、サイズが不一致の実体とメタデータのない実体を削除する
+func (u *reconcileUseCaseImpl) Run(ctx context.Context, query ReconcileQuery) (*ReconcileResult, error) {
+	hashAlgo, err := domain.NewHashAlgorithm(query.HashAlgo)
+	if err != nil {
+		return nil, err
+	}
+
+	stored := make(map[string]int64)
+	prefix := "objects/" + hashAlgo.String() + "/"
+	if err := u.objectLister.ListObjects(ctx, prefix, func(obj StoredObject) error {
+		stored[obj.Key] = obj.Size
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	result := &ReconcileResult{}
+	var after domain.OID
+	for {
+		objects, err := u.repo.ListAfter(ctx, after, reconcilePageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, obj := range objects {
+			if obj.HashAlgo() != hashAlgo.String() {
+				continue
+			}
+			if err := u.check(ctx, obj, stored, result); err != nil {
+				return nil, err
+			}
+		}
+
+		if len(objects) < reconcilePageSize {
+			break
+		}
+		after = objects[len(objects)-1].OID()
+	}
+
+	for key, size := range stored {
+		result.OrphanedBlobs = append(result.OrphanedBlobs, StoredObject{Key: key, Size: size})
+	}
+	slices.SortFunc(result.OrphanedBlobs, func(a, b StoredObject) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+
+	if !query.Fix {
+		return result, nil
+	}
+
+	for _, obj := range result.MissingBlobs {
+		u.record(result, "メタデータの修復に失敗しました", obj.GetStorageKey(), u.unmark(ctx, obj))
+	}
+	for _, mismatch := range result.SizeMismatches {
+		u.record(result, "サイズが不一致のオブジェクトの修復に失敗しました", mismatch.Object.GetStorageKey(), u.discard(ctx, mismatch.Object))
+	}
+	for _, blob := range result.OrphanedBlobs {
+		u.record(result, "孤立したオブジェクトの削除に失敗しました", blob.Key, u.objectStorage.DeleteObject(ctx, blob.Key))
+	}
+
+	return result, nil
+}
+
+// check はメタデータ1件をストレージの列挙結果と照合し、照合済みの実体をstoredから取り除く
+// 列挙後にアップロードされた実体を欠損と誤判定しないよう、欠損の候補は個別に存在を再確認する
+func (u *reconcileUseCaseImpl) check(ctx context.Context, obj *domain.LFSObject, stored map[string]int64, result *ReconcileResult) error {
+	key := obj.GetStorageKey()
+	size, ok := stored[key]
+	delete(stored, key)
+
+	if !ok {
+		if !obj.IsUploaded() {
+			return nil
+		}
+		headSize, exists, err := u.objectStorage.HeadObjectSize(ctx, key)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			result.MissingBlobs = append(result.MissingBlobs, obj)
+			return nil
+		}
+		size = headSize
+	}
+
+	// 未アップロードのオブジェクトはアップロード中の可能性があるため、サイズは照合しない
+	if obj.IsUploaded() && size != obj.Size().Int64() {
+		result.SizeMismatches = append(result.SizeMismatches, SizeMismatch{Object: obj, StoredSize: size})
+	}
+	return nil
+}
+
+// unmark はメタデータを未アップロードに戻し、クライアントが再アップロードできるようにする
+func (u *reconcileUseCaseImpl) unmark(ctx context.Context, obj *domain.LFSObject) error {
+	obj.MarkAsNotUploaded(ctx)
+	return u.repo.Update(ctx, obj)
+}
+
+// discard はサイズが不一致の実体を削除してからメタデータを未アップロードに戻す
+// 実体を残すと、以降のバッチリクエストでストレージの確認により再びアップロード済みとされ、誤った内容が配信されうる
+// 実体の削除に失敗した場合はメタデータを残し、次回の実行で再び対象となるようにする
+func (u *reconcileUseCaseImpl) discard(ctx context.Context, obj *domain.LFSObject) error {
+	if err := u.objectStorage.DeleteObject(ctx, obj.GetStorageKey()); err != nil {
+		return err
+	}
+	return u.unmark(ctx, obj)
+}
+
+func (u *reconcileUseCaseImpl) record(result *ReconcileResult, msg, key string, err error) {
+	if err != nil {
+		slog.Warn(msg, "key", key, "error", err)
+		result.Failed++
+		return
+	}
+	result.Fixed++
+}
@@ -0,0 +1,366 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/na2na-p/cargohold/internal/usecase"
+	mock_domain "github.com/na2na-p/cargohold/tests/domain"
+	mock_usecase "github.com/na2na-p/cargohold/tests/usecase"
+	"go.uber.org/mock/gomock"
+)
+
+// reconcileSummary は ReconcileResult を比較しやすい形に変換したもの
+type reconcileSummary struct {
+	MissingBlobs   []string
+	OrphanedBlobs  []string
+	SizeMismatches []string
+	Fixed          int
+	Failed         int
+}
+
+func summarizeReconcileResult(result *usecase.ReconcileResult) reconcileSummary {
+	var summary reconcileSummary
+	for _, obj := range result.MissingBlobs {
+		summary.MissingBlobs = append(summary.MissingBlobs, obj.OID().String())
+	}
+	for _, blob := range result.OrphanedBlobs {
+		summary.OrphanedBlobs = append(summary.OrphanedBlobs, blob.Key)
+	}
+	for _, mismatch := range result.SizeMismatches {
+		summary.SizeMismatches = append(summary.SizeMismatches, mismatch.Object.OID().String())
+	}
+	summary.Fixed = result.Fixed
+	summary.Failed = result.Failed
+	return summary
+}
+
+func listStoredObjects(objects ...usecase.StoredObject) func(context.Context, string, func(usecase.StoredObject) error) error {
+	return func(_ context.Context, _ string, fn func(usecase.StoredObject) error) error {
+		for _, obj := range objects {
+			if err := fn(obj); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// TestReconcileUseCase_Run は ReconcileUseCase.Run のテーブルドリブンテスト
+func TestReconcileUseCase_Run(t *testing.T) {
+	createdAt := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	okOID := "aaaa567890123456789012345678901234567890123456789012345678901234"
+	missingOID := "bbbb567890123456789012345678901234567890123456789012345678901234"
+	mismatchOID := "cccc567890123456789012345678901234567890123456789012345678901234"
+	pendingOID := "dddd567890123456789012345678901234567890123456789012345678901234"
+	orphanKey := "objects/sha256/ee/ee/eeee567890123456789012345678901234567890123456789012345678901234"
+
+	newObjects := func() []*domain.LFSObject {
+		return []*domain.LFSObject{
+			newGCTestObject(t, okOID, true, createdAt),
+			newGCTestObject(t, missingOID, true, createdAt),
+			newGCTestObject(t, mismatchOID, true, createdAt),
+			newGCTestObject(t, pendingOID, false, createdAt),
+		}
+	}
+	stored := []usecase.StoredObject{
+		{Key: "objects/sha256/" + okOID, Size: 1024},
+		{Key: "objects/sha256/" + mismatchOID, Size: 10},
+		{Key: orphanKey, Size: 2048},
+	}
+
+	type fields struct {
+		repo          func(ctrl *gomock.Controller) domain.LFSObjectRepository
+		objectStorage func(ctrl *gomock.Controller) usecase.ObjectStorage
+		objectLister  func(ctrl *gomock.Controller) usecase.ObjectLister
+	}
+	type args struct {
+		query usecase.ReconcileQuery
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    reconcileSummary
+		wantErr error
+	}{
+		{
+			name: "正常系: 欠損・サイズ不一致・孤立した実体が報告され、修復は行われない",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().ListAfter(gomock.Any(), domain.OID{}, 1000).Return(newObjects(), nil)
+					return mock
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					mock := mock_usecase.NewMockObjectStorage(ctrl)
+					mock.EXPECT().HeadObjectSize(gomock.Any(), "objects/sha256/"+missingOID).Return(int64(0), false, nil)
+					return mock
+				},
+				objectLister: func(ctrl *gomock.Controller) usecase.ObjectLister {
+					mock := mock_usecase.NewMockObjectLister(ctrl)
+					mock.EXPECT().ListObjects(gomock.Any(), "objects/sha256/", gomock.Any()).DoAndReturn(listStoredObjects(stored...))
+					return mock
+				},
+			},
+			args: args{query: usecase.ReconcileQuery{HashAlgo: "sha256"}},
+			want: reconcileSummary{
+				MissingBlobs:   []string{missingOID},
+				OrphanedBlobs:  []string{orphanKey},
+				SizeMismatches: []string{mismatchOID},
+			},
+		},
+		{
+			name: "正常系: 列挙後にアップロードされた実体は欠損として扱われない",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().ListAfter(gomock.Any(), domain.OID{}, 1000).Return(newObjects()[1:2], nil)
+					return mock
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					mock := mock_usecase.NewMockObjectStorage(ctrl)
+					mock.EXPECT().HeadObjectSize(gomock.Any(), "objects/sha256/"+missingOID).Return(int64(1024), true, nil)
+					return mock
+				},
+				objectLister: func(ctrl *gomock.Controller) usecase.ObjectLister {
+					mock := mock_usecase.NewMockObjectLister(ctrl)
+					mock.EXPECT().ListObjects(gomock.Any(), "objects/sha256/", gomock.Any()).DoAndReturn(listStoredObjects())
+					return mock
+				},
+			},
+			args: args{query: usecase.ReconcileQuery{HashAlgo: "sha256"}},
+			want: reconcileSummary{},
+		},
+		{
+			name: "正常系: Fixの場合、メタデータが未アップロードに戻され、サイズ不一致の実体と孤立した実体が削除される",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().ListAfter(gomock.Any(), domain.OID{}, 1000).Return(newObjects(), nil)
+					mock.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, obj *domain.LFSObject) error {
+						if obj.IsUploaded() {
+							t.Errorf("Update() called with uploaded object: %s", obj.OID().String())
+						}
+						return nil
+					}).Times(2)
+					return mock
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					mock := mock_usecase.NewMockObjectStorage(ctrl)
+					mock.EXPECT().HeadObjectSize(gomock.Any(), "objects/sha256/"+missingOID).Return(int64(0), false, nil)
+					mock.EXPECT().DeleteObject(gomock.Any(), "objects/sha256/"+mismatchOID).Return(nil)
+					mock.EXPECT().DeleteObject(gomock.Any(), orphanKey).Return(nil)
+					return mock
+				},
+				objectLister: func(ctrl *gomock.Controller) usecase.ObjectLister {
+					mock := mock_usecase.NewMockObjectLister(ctrl)
+					mock.EXPECT().ListObjects(gomock.Any(), "objects/sha256/", gomock.Any()).DoAndReturn(listStoredObjects(stored...))
+					return mock
+				},
+			},
+			args: args{query: usecase.ReconcileQuery{HashAlgo: "sha256", Fix: true}},
+			want: reconcileSummary{
+				MissingBlobs:   []string{missingOID},
+				OrphanedBlobs:  []string{orphanKey},
+				SizeMismatches: []string{mismatchOID},
+				Fixed:          3,
+			},
+		},
+		{
+			name: "正常系: Fixで修復に失敗した場合、失敗件数に計上して処理を続行する",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().ListAfter(gomock.Any(), domain.OID{}, 1000).Return(newObjects()[2:3], nil)
+					mock.EXPECT().Update(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
+					return mock
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					mock := mock_usecase.NewMockObjectStorage(ctrl)
+					mock.EXPECT().DeleteObject(gomock.Any(), "objects/sha256/"+mismatchOID).Return(nil)
+					mock.EXPECT().DeleteObject(gomock.Any(), orphanKey).Return(nil)
+					return mock
+				},
+				objectLister: func(ctrl *gomock.Controller) usecase.ObjectLister {
+					mock := mock_usecase.NewMockObjectLister(ctrl)
+					mock.EXPECT().ListObjects(gomock.Any(), "objects/sha256/", gomock.Any()).DoAndReturn(listStoredObjects(stored[1:]...))
+					return mock
+				},
+			},
+			args: args{query: usecase.ReconcileQuery{HashAlgo: "sha256", Fix: true}},
+			want: reconcileSummary{
+				OrphanedBlobs:  []string{orphanKey},
+				SizeMismatches: []string{mismatchOID},
+				Fixed:          1,
+				Failed:         1,
+			},
+		},
+		{
+			name: "正常系: Fixでサイズ不一致の実体の削除に失敗した場合、メタデータを残して失敗件数に計上する",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().ListAfter(gomock.Any(), domain.OID{}, 1000).Return(newObjects()[2:3], nil)
+					return mock
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					mock := mock_usecase.NewMockObjectStorage(ctrl)
+					mock.EXPECT().DeleteObject(gomock.Any(), "objects/sha256/"+mismatchOID).Return(errors.New("s3 error"))
+					return mock
+				},
+				objectLister: func(ctrl *gomock.Controller) usecase.ObjectLister {
+					mock := mock_usecase.NewMockObjectLister(ctrl)
+					mock.EXPECT().ListObjects(gomock.Any(), "objects/sha256/", gomock.Any()).DoAndReturn(listStoredObjects(stored[1:2]...))
+					return mock
+				},
+			},
+			args: args{query: usecase.ReconcileQuery{HashAlgo: "sha256", Fix: true}},
+			want: reconcileSummary{
+				SizeMismatches: []string{mismatchOID},
+				Failed:         1,
+			},
+		},
+		{
+			name: "異常系: ストレージの列挙に失敗した場合、エラーが返る",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					return mock_domain.NewMockLFSObjectRepository(ctrl)
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					return mock_usecase.NewMockObjectStorage(ctrl)
+				},
+				objectLister: func(ctrl *gomock.Controller) usecase.ObjectLister {
+					mock := mock_usecase.NewMockObjectLister(ctrl)
+					mock.EXPECT().ListObjects(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("s3 error"))
+					return mock
+				},
+			},
+			args:    args{query: usecase.ReconcileQuery{HashAlgo: "sha256"}},
+			wantErr: errors.New("s3 error"),
+		},
+		{
+			name: "異常系: メタデータの取得に失敗した場合、エラーが返る",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().ListAfter(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("database error"))
+					return mock
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					return mock_usecase.NewMockObjectStorage(ctrl)
+				},
+				objectLister: func(ctrl *gomock.Controller) usecase.ObjectLister {
+					mock := mock_usecase.NewMockObjectLister(ctrl)
+					mock.EXPECT().ListObjects(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(listStoredObjects())
+					return mock
+				},
+			},
+			args:    args{query: usecase.ReconcileQuery{HashAlgo: "sha256"}},
+			wantErr: errors.New("database error"),
+		},
+		{
+			name: "異常系: 未対応のハッシュアルゴリズムの場合、ErrInvalidHashAlgorithmが返る",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					return mock_domain.NewMockLFSObjectRepository(ctrl)
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					return mock_usecase.NewMockObjectStorage(ctrl)
+				},
+				objectLister: func(ctrl *gomock.Controller) usecase.ObjectLister {
+					return mock_usecase.NewMockObjectLister(ctrl)
+				},
+			},
+			args:    args{query: usecase.ReconcileQuery{HashAlgo: "md5"}},
+			wantErr: domain.ErrInvalidHashAlgorithm,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			uc := usecase.NewReconcileUseCase(tt.fields.repo(ctrl), tt.fields.objectStorage(ctrl), tt.fields.objectLister(ctrl))
+			got, err := uc.Run(context.Background(), tt.args.query)
+
+			if tt.wantErr != nil {
+				if err == nil {
+					t.Fatalf("Run() error = nil, wantErr %v", tt.wantErr)
+				}
+				if diff := cmp.Diff(tt.wantErr.Error(), err.Error()); diff != "" {
+					t.Errorf("Run() error mismatch (-want +got):\n%s", diff)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Run() unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, summarizeReconcileResult(got)); diff != "" {
+				t.Errorf("Run() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestReconcileUseCase_Run_FixedSizeMismatchIsNotServedAgain は修復したサイズ不一致のオブジェクトが
+// 以降のダウンロードでアップロード済みとして扱われないことを確認する
+func TestReconcileUseCase_Run_FixedSizeMismatchIsNotServedAgain(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+
+	mismatchOID := "cccc567890123456789012345678901234567890123456789012345678901234"
+	key := "objects/sha256/" + mismatchOID
+	obj := newGCTestObject(t, mismatchOID, true, time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC))
+
+	repo := mock_domain.NewMockLFSObjectRepository(ctrl)
+	repo.EXPECT().ListAfter(gomock.Any(), domain.OID{}, 1000).Return([]*domain.LFSObject{obj}, nil)
+	repo.EXPECT().Update(gomock.Any(), obj).Return(nil)
+
+	// ストレージの状態を保持し、削除後の存在確認に反映する
+	blobExists := true
+	objectStorage := mock_usecase.NewMockObjectStorage(ctrl)
+	objectStorage.EXPECT().DeleteObject(gomock.Any(), key).DoAndReturn(func(context.Context, string) error {
+		blobExists = false
+		return nil
+	})
+	objectStorage.EXPECT().HeadObjectSize(gomock.Any(), key).DoAndReturn(func(context.Context, string) (int64, bool, error) {
+		if !blobExists {
+			return 0, false, nil
+		}
+		return 10, true, nil
+	})
+
+	objectLister := mock_usecase.NewMockObjectLister(ctrl)
+	objectLister.EXPECT().ListObjects(gomock.Any(), "objects/sha256/", gomock.Any()).DoAndReturn(listStoredObjects(usecase.StoredObject{Key: key, Size: 10}))
+
+	reconcileUC := usecase.NewReconcileUseCase(repo, objectStorage, objectLister)
+	result, err := reconcileUC.Run(ctx, usecase.ReconcileQuery{HashAlgo: "sha256", Fix: true})
+	if err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(reconcileSummary{SizeMismatches: []string{mismatchOID}, Fixed: 1}, summarizeReconcileResult(result)); diff != "" {
+		t.Fatalf("Run() mismatch (-want +got):\n%s", diff)
+	}
+
+	downloadUC := usecase.NewDownloadUseCase(
+		repo,
+		mock_usecase.NewMockActionURLGenerator(ctrl),
+		mock_usecase.NewMockS3Client(ctrl),
+		objectStorage,
+		usecase.NewTransferPolicy(domain.TransferModeProxy, 0, 5*time.Minute),
+	)
+	got := downloadUC.HandleDownloadObject(ctx, "https://example.com", "owner", "repo", obj.OID(), obj.Size(), obj, "")
+
+	objErr := usecase.NewObjectError(404, "オブジェクトがまだアップロードされていません")
+	want := usecase.NewResponseObject(mismatchOID, 1024, false, nil, &objErr)
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(usecase.ResponseObject{}, usecase.ObjectError{})); diff != "" {
+		t.Errorf("HandleDownloadObject() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,65 @@
+//go:generate mockgen -source=$GOFILE -destination=../../tests/usecase/mock_repository_allowlist_usecase.go -package=usecase
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/na2na-p/cargohold/internal/domain"
+)
+
+type RepositoryAllowlistUseCase interface {
+	Add(ctx context.Context, fullName string) (*domain.AllowedRepository, error)
+	Remove(ctx context.Context, fullName string) (*domain.AllowedRepository, error)
+	List(ctx context.Context) ([]*domain.AllowedRepository, error)
+}
+
+type repositoryAllowlistUseCaseImpl struct {
+	allowlistRepo domain.RepositoryAllowlistRepository
+}
+
+// NewRepositoryAllowlistUseCase は許可リポジトリを管理するユースケースを作成する
+// 認証時のキャッシュと整合させるため、allowlistRepoにはキャッシュを更新する実装を渡す
+func NewRepositoryAllowlistUseCase(allowlistRepo domain.RepositoryAllowlistRepository) RepositoryAllowlistUseCase {
+	return &repositoryAllowlistUseCaseImpl{
+		allowlistRepo: allowlistRepo,
+	}
+}
+
+// Add は owner/repo 形式のリポジトリを許可リストに追加する。既に存在する場合も成功として扱う
+func (u *repositoryAllowlistUseCaseImpl) Add(ctx context.Context, fullName string) (*domain.AllowedRepository, error) {
+	repository, err := domain.NewAllowedRepositoryFromString(fullName)
+	if err != nil {
+		return nil, ErrInvalidRepository
+	}
+
+	if err := u.allowlistRepo.Add(ctx, repository); err != nil {
+		return nil, fmt.Errorf("許可リポジトリの追加に失敗しました: %w", err)
+	}
+	return repository, nil
+}
+
+// Remove は owner/repo 形式のリポジトリを許可リストから削除する
+func (u *repositoryAllowlistUseCaseImpl) Remove(ctx context.Context, fullName string) (*domain.AllowedRepository, error) {
+	repository, err := domain.NewAllowedRepositoryFromString(fullName)
+	if err != nil {
+		return nil, ErrInvalidRepository
+	}
+
+	if err := u.allowlistRepo.Remove(ctx, repository); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, ErrAllowedRepositoryNotFound
+		}
+		return nil, fmt.Errorf("許可リポジトリの削除に失敗しました: %w", err)
+	}
+	return repository, nil
+}
+
+func (u *repositoryAllowlistUseCaseImpl) List(ctx context.Context) ([]*domain.AllowedRepository, error) {
+	repositories, err := u.allowlistRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("許可リポジトリ一覧の取得に失敗しました: %w", err)
+	}
+	return repositories, nil
+}
@@ -0,0 +1,249 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/na2na-p/cargohold/internal/usecase"
+	mock_domain "github.com/na2na-p/cargohold/tests/domain"
+	"go.uber.org/mock/gomock"
+)
+
+// TestRepositoryAllowlistUseCase_Add は RepositoryAllowlistUseCase.Add のテーブルドリブンテスト
+func TestRepositoryAllowlistUseCase_Add(t *testing.T) {
+	type fields struct {
+		allowlistRepo func(ctrl *gomock.Controller) domain.RepositoryAllowlistRepository
+	}
+	tests := []struct {
+		name     string
+		fields   fields
+		fullName string
+		want     string
+		wantErr  error
+	}{
+		{
+			name: "正常系: リポジトリが許可リストに追加される",
+			fields: fields{
+				allowlistRepo: func(ctrl *gomock.Controller) domain.RepositoryAllowlistRepository {
+					mock := mock_domain.NewMockRepositoryAllowlistRepository(ctrl)
+					mock.EXPECT().Add(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, repository *domain.AllowedRepository) error {
+						if diff := cmp.Diff("owner/repo", repository.String()); diff != "" {
+							t.Errorf("Add() repository mismatch (-want +got):\n%s", diff)
+						}
+						return nil
+					})
+					return mock
+				},
+			},
+			fullName: "owner/repo",
+			want:     "owner/repo",
+		},
+		{
+			name: "異常系: owner/repo形式でない場合、ErrInvalidRepositoryが返る",
+			fields: fields{
+				allowlistRepo: func(ctrl *gomock.Controller) domain.RepositoryAllowlistRepository {
+					return mock_domain.NewMockRepositoryAllowlistRepository(ctrl)
+				},
+			},
+			fullName: "invalid",
+			wantErr:  usecase.ErrInvalidRepository,
+		},
+		{
+			name: "異常系: 追加に失敗した場合、エラーが返る",
+			fields: fields{
+				allowlistRepo: func(ctrl *gomock.Controller) domain.RepositoryAllowlistRepository {
+					mock := mock_domain.NewMockRepositoryAllowlistRepository(ctrl)
+					mock.EXPECT().Add(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
+					return mock
+				},
+			},
+			fullName: "owner/repo",
+			wantErr:  errors.New("許可リポジトリの追加に失敗しました: database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			uc := usecase.NewRepositoryAllowlistUseCase(tt.fields.allowlistRepo(ctrl))
+			got, err := uc.Add(context.Background(), tt.fullName)
+
+			if tt.wantErr != nil {
+				if err == nil {
+					t.Fatalf("Add() error = nil, wantErr %v", tt.wantErr)
+				}
+				if diff := cmp.Diff(tt.wantErr.Error(), err.Error()); diff != "" {
+					t.Errorf("Add() error mismatch (-want +got):\n%s", diff)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Add() unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got.String()); diff != "" {
+				t.Errorf("Add() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestRepositoryAllowlistUseCase_Remove は RepositoryAllowlistUseCase.Remove のテーブルドリブンテスト
+func TestRepositoryAllowlistUseCase_Remove(t *testing.T) {
+	type fields struct {
+		allowlistRepo func(ctrl *gomock.Controller) domain.RepositoryAllowlistRepository
+	}
+	tests := []struct {
+		name     string
+		fields   fields
+		fullName string
+		want     string
+		wantErr  error
+	}{
+		{
+			name: "正常系: リポジトリが許可リストから削除される",
+			fields: fields{
+				allowlistRepo: func(ctrl *gomock.Controller) domain.RepositoryAllowlistRepository {
+					mock := mock_domain.NewMockRepositoryAllowlistRepository(ctrl)
+					mock.EXPECT().Remove(gomock.Any(), gomock.Any()).Return(nil)
+					return mock
+				},
+			},
+			fullName: "owner/repo",
+			want:     "owner/repo",
+		},
+		{
+			name: "異常系: 登録されていない場合、ErrAllowedRepositoryNotFoundが返る",
+			fields: fields{
+				allowlistRepo: func(ctrl *gomock.Controller) domain.RepositoryAllowlistRepository {
+					mock := mock_domain.NewMockRepositoryAllowlistRepository(ctrl)
+					mock.EXPECT().Remove(gomock.Any(), gomock.Any()).Return(fmt.Errorf("リポジトリの削除に失敗しました: %w", domain.ErrNotFound))
+					return mock
+				},
+			},
+			fullName: "owner/repo",
+			wantErr:  usecase.ErrAllowedRepositoryNotFound,
+		},
+		{
+			name: "異常系: owner/repo形式でない場合、ErrInvalidRepositoryが返る",
+			fields: fields{
+				allowlistRepo: func(ctrl *gomock.Controller) domain.RepositoryAllowlistRepository {
+					return mock_domain.NewMockRepositoryAllowlistRepository(ctrl)
+				},
+			},
+			fullName: "owner/repo/extra",
+			wantErr:  usecase.ErrInvalidRepository,
+		},
+		{
+			name: "異常系: 削除に失敗した場合、エラーが返る",
+			fields: fields{
+				allowlistRepo: func(ctrl *gomock.Controller) domain.RepositoryAllowlistRepository {
+					mock := mock_domain.NewMockRepositoryAllowlistRepository(ctrl)
+					mock.EXPECT().Remove(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
+					return mock
+				},
+			},
+			fullName: "owner/repo",
+			wantErr:  errors.New("許可リポジトリの削除に失敗しました: database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			uc := usecase.NewRepositoryAllowlistUseCase(tt.fields.allowlistRepo(ctrl))
+			got, err := uc.Remove(context.Background(), tt.fullName)
+
+			if tt.wantErr != nil {
+				if err == nil {
+					t.Fatalf("Remove() error = nil, wantErr %v", tt.wantErr)
+				}
+				if diff := cmp.Diff(tt.wantErr.Error(), err.Error()); diff != "" {
+					t.Errorf("Remove() error mismatch (-want +got):\n%s", diff)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Remove() unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got.String()); diff != "" {
+				t.Errorf("Remove() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestRepositoryAllowlistUseCase_List は RepositoryAllowlistUseCase.List のテーブルドリブンテスト
+func TestRepositoryAllowlistUseCase_List(t *testing.T) {
+	type fields struct {
+		allowlistRepo func(ctrl *gomock.Controller) domain.RepositoryAllowlistRepository
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    []string
+		wantErr error
+	}{
+		{
+			name: "正常系: 許可リポジトリの一覧が返る",
+			fields: fields{
+				allowlistRepo: func(ctrl *gomock.Controller) domain.RepositoryAllowlistRepository {
+					repo1, _ := domain.NewAllowedRepository("owner", "repo1")
+					repo2, _ := domain.NewAllowedRepository("owner", "repo2")
+					mock := mock_domain.NewMockRepositoryAllowlistRepository(ctrl)
+					mock.EXPECT().List(gomock.Any()).Return([]*domain.AllowedRepository{repo1, repo2}, nil)
+					return mock
+				},
+			},
+			want: []string{"owner/repo1", "owner/repo2"},
+		},
+		{
+			name: "異常系: 取得に失敗した場合、エラーが返る",
+			fields: fields{
+				allowlistRepo: func(ctrl *gomock.Controller) domain.RepositoryAllowlistRepository {
+					mock := mock_domain.NewMockRepositoryAllowlistRepository(ctrl)
+					mock.EXPECT().List(gomock.Any()).Return(nil, errors.New("database error"))
+					return mock
+				},
+			},
+			wantErr: errors.New("許可リポジトリ一覧の取得に失敗しました: database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			uc := usecase.NewRepositoryAllowlistUseCase(tt.fields.allowlistRepo(ctrl))
+			got, err := uc.List(context.Background())
+
+			if tt.wantErr != nil {
+				if err == nil {
+					t.Fatalf("List() error = nil, wantErr %v", tt.wantErr)
+				}
+				if diff := cmp.Diff(tt.wantErr.Error(), err.Error()); diff != "" {
+					t.Errorf("List() error mismatch (-want +got):\n%s", diff)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("List() unexpected error: %v", err)
+			}
+			var gotNames []string
+			for _, repository := range got {
+				gotNames = append(gotNames, repository.String())
+			}
+			if diff := cmp.Diff(tt.want, gotNames); diff != "" {
+				t.Errorf("List() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -3,29 +3,171 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
+	"time"
 
 	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/newmo-oss/ctxtime"
 )
 
+// sessionRecheckGracePeriod は再検証の間隔を過ぎた後、GitHubの一時的な障害で権限を確認できないセッションを使い続けられる時間
+const sessionRecheckGracePeriod = time.Hour
+
 type SessionClient interface {
 	GetSession(ctx context.Context, sessionID string) (*domain.UserInfo, error)
 }
 
+// SessionTokenStore はセッションの権限を再検証するためのOAuthトークンを参照し、再検証の結果を保存する
+type SessionTokenStore interface {
+	// GetSessionToken はセッションのOAuthトークンを返す。保存されていない場合はErrSessionTokenNotFoundを返す
+	GetSessionToken(ctx context.Context, sessionID string) (*SessionToken, error)
+	// UpdateSession は有効期限を変えずにセッションのユーザー情報とトークンを更新する
+	UpdateSession(ctx context.Context, sessionID string, userInfo *domain.UserInfo, token *SessionToken) error
+	DeleteSession(ctx context.Context, sessionID string) error
+}
+
+// RepositoryPermissionsFetcher はOAuthトークンでリポジトリに対するユーザーの現在の権限を取得する
+// アクセス権を失っている場合はErrRepositoryAccessDeniedを返す
+type RepositoryPermissionsFetcher interface {
+	GetRepositoryPermissions(ctx context.Context, token *OAuthTokenResult, repo *domain.RepositoryIdentifier) (domain.RepositoryPermissions, error)
+}
+
 type SessionAuthUseCase struct {
-	sessionClient SessionClient
+	sessionClient      SessionClient
+	tokenStore         SessionTokenStore
+	permissionsFetcher RepositoryPermissionsFetcher
+	// recheckInterval はセッションの権限をGitHubに再度問い合わせるまでの間隔
+	recheckInterval time.Duration
 }
 
-func NewSessionAuthUseCase(sessionClient SessionClient) *SessionAuthUseCase {
+// NewSessionAuthUseCase はセッションでリクエストを認証するSessionAuthUseCaseを生成する
+// tokenStoreとpermissionsFetcherを指定すると、前回の確認からrecheckIntervalが経過したセッションの権限をGitHubに問い合わせて再検証し、
+// 複数のリポジトリを対象とするセッションの権限をリポジトリごとに取得する。nilの場合は作成時の権限をそのまま使う
+func NewSessionAuthUseCase(
+	sessionClient SessionClient,
+	tokenStore SessionTokenStore,
+	permissionsFetcher RepositoryPermissionsFetcher,
+	recheckInterval time.Duration,
+) *SessionAuthUseCase {
 	return &SessionAuthUseCase{
-		sessionClient: sessionClient,
+		sessionClient:      sessionClient,
+		tokenStore:         tokenStore,
+		permissionsFetcher: permissionsFetcher,
+		recheckInterval:    recheckInterval,
 	}
 }
 
-func (uc *SessionAuthUseCase) Authenticate(ctx context.Context, sessionID string) (*domain.UserInfo, error) {
+// Authenticate はセッションを検証し、repositoryを操作するユーザー情報を返す
+// 複数のリポジトリを対象とするセッションでは、repositoryがスコープに含まれない場合や
+// アクセスできない場合にリポジトリを持たないユーザー情報を返し、リポジトリの検証で拒否させる
+func (uc *SessionAuthUseCase) Authenticate(ctx context.Context, sessionID string, repository *domain.RepositoryIdentifier) (*domain.UserInfo, error) {
 	userInfo, err := uc.sessionClient.GetSession(ctx, sessionID)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %v", ErrSessionNotFound, err)
 	}
+	if userInfo.RepositoryScope() != nil {
+		return uc.authorizeScope(ctx, sessionID, userInfo, repository)
+	}
+	if uc.tokenStore == nil || uc.permissionsFetcher == nil || uc.recheckInterval <= 0 {
+		return userInfo, nil
+	}
+	return uc.revalidate(ctx, sessionID, userInfo)
+}
+
+// authorizeScope はスコープのリポジトリに対する権限をセッションに保持した値から求め、
+// 未取得の場合はGitHubに問い合わせてセッションに保持する
+func (uc *SessionAuthUseCase) authorizeScope(ctx context.Context, sessionID string, userInfo *domain.UserInfo, repository *domain.RepositoryIdentifier) (*domain.UserInfo, error) {
+	if !userInfo.RepositoryScope().Contains(repository) || uc.tokenStore == nil || uc.permissionsFetcher == nil {
+		return userInfo, nil
+	}
+
+	token, err := uc.tokenStore.GetSessionToken(ctx, sessionID)
+	if errors.Is(err, ErrSessionTokenNotFound) {
+		// トークンがなければ権限を取得できないため、どのリポジトリも操作させない
+		return userInfo, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSessionNotFound, err)
+	}
+
+	now := ctxtime.Now(ctx)
+	changed := false
+	if uc.recheckInterval > 0 && now.Sub(token.CheckedAt) >= uc.recheckInterval {
+		// 再検証の間隔が経過した権限は破棄し、リポジトリごとに次のアクセス時に取得し直す
+		userInfo.ClearCachedPermissions()
+		token.CheckedAt = now
+		changed = true
+	}
+
+	permissions, ok := userInfo.CachedPermissions(repository)
+	if !ok {
+		permissions, err = uc.permissionsFetcher.GetRepositoryPermissions(ctx, token.Token, repository)
+		if err != nil && !errors.Is(err, ErrRepositoryAccessDenied) {
+			// 一時的な障害の結果は保持せず、次のリクエストで再度問い合わせる
+			slog.Warn("セッションのリポジトリの権限の取得に失敗しました", "repository", repository.FullName(), "error", err)
+			return userInfo, nil
+		}
+		userInfo.CachePermissions(repository, permissions)
+		changed = true
+	}
+
+	if changed {
+		// 並行するリクエストの更新で取得した権限が失われても、次のアクセス時に再度取得される
+		if err := uc.tokenStore.UpdateSession(ctx, sessionID, userInfo, token); err != nil {
+			slog.Warn("取得した権限のセッションへの保存に失敗しました", "error", err)
+		}
+	}
+
+	if !permissions.CanDownload() {
+		return userInfo, nil
+	}
+	return userInfo.ForRepository(repository, permissions), nil
+}
+
+// revalidate はセッションの権限をGitHubの現在の権限で縮小し、アクセス権を失っている場合はセッションを失効させる
+func (uc *SessionAuthUseCase) revalidate(ctx context.Context, sessionID string, userInfo *domain.UserInfo) (*domain.UserInfo, error) {
+	token, err := uc.tokenStore.GetSessionToken(ctx, sessionID)
+	if errors.Is(err, ErrSessionTokenNotFound) {
+		// トークンを保存していないセッションは再検証できないため、有効期限まで作成時の権限を使う
+		return userInfo, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSessionNotFound, err)
+	}
+
+	now := ctxtime.Now(ctx)
+	if userInfo.Repository() == nil || now.Sub(token.CheckedAt) < uc.recheckInterval {
+		return userInfo, nil
+	}
+
+	current, err := uc.permissionsFetcher.GetRepositoryPermissions(ctx, token.Token, userInfo.Repository())
+	if err != nil && !errors.Is(err, ErrRepositoryAccessDenied) {
+		slog.Warn("セッションの権限の再検証に失敗しました", "repository", userInfo.Repository().FullName(), "error", err)
+		if now.Sub(token.CheckedAt) >= uc.recheckInterval+sessionRecheckGracePeriod {
+			// 障害が続いて長時間確認できていないセッションは、失効させずに確認できるまで拒否する
+			return nil, fmt.Errorf("%w: permissions not re-validated since %s", ErrSessionNotFound, token.CheckedAt.Format(time.RFC3339))
+		}
+		// GitHubの一時的な障害ではセッションを失効させず、次のリクエストで再度確認する
+		return userInfo, nil
+	}
+	if err == nil && userInfo.Permissions() != nil {
+		// 作成後に権限が付与されても、セッションの権限は作成時の範囲を超えない
+		current = userInfo.Permissions().Intersect(current)
+	}
+	if err != nil || !current.CanDownload() {
+		if err := uc.tokenStore.DeleteSession(ctx, sessionID); err != nil {
+			slog.Warn("アクセス権を失ったセッションの削除に失敗しました", "error", err)
+		}
+		return nil, fmt.Errorf("%w: repository access revoked: %s", ErrSessionNotFound, userInfo.Repository().FullName())
+	}
+
+	userInfo.SetPermissions(&current)
+	token.CheckedAt = now
+	if err := uc.tokenStore.UpdateSession(ctx, sessionID, userInfo, token); err != nil {
+		// 保存できなくても、このリクエストは再検証した権限で処理する
+		slog.Warn("再検証したセッションの保存に失敗しました", "error", err)
+	}
 	return userInfo, nil
 }
@@ -4,11 +4,14 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/na2na-p/cargohold/internal/domain"
 	"github.com/na2na-p/cargohold/internal/usecase"
 	mock_usecase "github.com/na2na-p/cargohold/tests/usecase"
+	"github.com/newmo-oss/ctxtime/ctxtimetest"
+	"github.com/newmo-oss/testid"
 	"go.uber.org/mock/gomock"
 )
 
@@ -148,9 +151,9 @@ func TestSessionAuthUseCase_Authenticate(t *testing.T) {
 			defer ctrl.Finish()
 
 			mocks := tt.setupMocks(ctrl)
-			uc := usecase.NewSessionAuthUseCase(mocks.sessionClient)
+			uc := usecase.NewSessionAuthUseCase(mocks.sessionClient, nil, nil, 0)
 
-			got, err := uc.Authenticate(ctx, tt.args.sessionID)
+			got, err := uc.Authenticate(ctx, tt.args.sessionID, nil)
 
 			if tt.wantErr != nil {
 				if err == nil {
@@ -170,3 +173,488 @@ func TestSessionAuthUseCase_Authenticate(t *testing.T) {
 		})
 	}
 }
+
+func TestSessionAuthUseCase_Authenticate_Revalidation(t *testing.T) {
+	fixedNow := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	recheckInterval := 15 * time.Minute
+	ownerRepo, _ := domain.NewRepositoryIdentifier("owner/repo")
+	pushPermissions := domain.NewRepositoryPermissions(false, true, true, false, false)
+	pullPermissions := domain.NewRepositoryPermissions(false, false, true, false, false)
+	adminPermissions := domain.NewRepositoryPermissions(true, true, true, true, true)
+	oauthToken := &usecase.OAuthTokenResult{AccessToken: "gho_token", TokenType: "bearer", Scope: "repo"}
+
+	newSessionUserInfo := func(t *testing.T) *domain.UserInfo {
+		userInfo := mustNewUserInfoInSessionTest(t, "12345", "", "user", domain.ProviderTypeGitHub, ownerRepo, "")
+		permissions := pushPermissions
+		userInfo.SetPermissions(&permissions)
+		return userInfo
+	}
+	staleToken := func() *usecase.SessionToken {
+		return &usecase.SessionToken{Token: oauthToken, CheckedAt: fixedNow.Add(-recheckInterval)}
+	}
+
+	type mockFields struct {
+		sessionClient      *mock_usecase.MockSessionClient
+		tokenStore         *mock_usecase.MockSessionTokenStore
+		permissionsFetcher *mock_usecase.MockRepositoryPermissionsFetcher
+	}
+	tests := []struct {
+		name            string
+		setupMocks      func(ctrl *gomock.Controller, userInfo *domain.UserInfo) mockFields
+		wantPermissions *domain.RepositoryPermissions
+		wantErr         error
+	}{
+		{
+			name: "正常系: 前回の確認から再検証の間隔が経過していない場合、GitHubに問い合わせない",
+			setupMocks: func(ctrl *gomock.Controller, userInfo *domain.UserInfo) mockFields {
+				sessionClient := mock_usecase.NewMockSessionClient(ctrl)
+				sessionClient.EXPECT().GetSession(gomock.Any(), "session-id").Return(userInfo, nil)
+				tokenStore := mock_usecase.NewMockSessionTokenStore(ctrl)
+				tokenStore.EXPECT().GetSessionToken(gomock.Any(), "session-id").Return(&usecase.SessionToken{
+					Token:     oauthToken,
+					CheckedAt: fixedNow.Add(-recheckInterval + time.Second),
+				}, nil)
+				return mockFields{
+					sessionClient:      sessionClient,
+					tokenStore:         tokenStore,
+					permissionsFetcher: mock_usecase.NewMockRepositoryPermissionsFetcher(ctrl),
+				}
+			},
+			wantPermissions: &pushPermissions,
+		},
+		{
+			name: "正常系: トークンを保存していないセッションの場合、作成時の権限を返す",
+			setupMocks: func(ctrl *gomock.Controller, userInfo *domain.UserInfo) mockFields {
+				sessionClient := mock_usecase.NewMockSessionClient(ctrl)
+				sessionClient.EXPECT().GetSession(gomock.Any(), "session-id").Return(userInfo, nil)
+				tokenStore := mock_usecase.NewMockSessionTokenStore(ctrl)
+				tokenStore.EXPECT().GetSessionToken(gomock.Any(), "session-id").Return(nil, usecase.ErrSessionTokenNotFound)
+				return mockFields{
+					sessionClient:      sessionClient,
+					tokenStore:         tokenStore,
+					permissionsFetcher: mock_usecase.NewMockRepositoryPermissionsFetcher(ctrl),
+				}
+			},
+			wantPermissions: &pushPermissions,
+		},
+		{
+			name: "正常系: 権限が縮小された場合、セッションの権限を縮小して保存する",
+			setupMocks: func(ctrl *gomock.Controller, userInfo *domain.UserInfo) mockFields {
+				sessionClient := mock_usecase.NewMockSessionClient(ctrl)
+				sessionClient.EXPECT().GetSession(gomock.Any(), "session-id").Return(userInfo, nil)
+				tokenStore := mock_usecase.NewMockSessionTokenStore(ctrl)
+				tokenStore.EXPECT().GetSessionToken(gomock.Any(), "session-id").Return(staleToken(), nil)
+				tokenStore.EXPECT().UpdateSession(gomock.Any(), "session-id", userInfo, &usecase.SessionToken{
+					Token:     oauthToken,
+					CheckedAt: fixedNow,
+				}).Return(nil)
+				permissionsFetcher := mock_usecase.NewMockRepositoryPermissionsFetcher(ctrl)
+				permissionsFetcher.EXPECT().GetRepositoryPermissions(gomock.Any(), oauthToken, ownerRepo).Return(pullPermissions, nil)
+				return mockFields{
+					sessionClient:      sessionClient,
+					tokenStore:         tokenStore,
+					permissionsFetcher: permissionsFetcher,
+				}
+			},
+			wantPermissions: &pullPermissions,
+		},
+		{
+			name: "正常系: 権限が拡大された場合でも、セッション作成時の権限を超えない",
+			setupMocks: func(ctrl *gomock.Controller, userInfo *domain.UserInfo) mockFields {
+				sessionClient := mock_usecase.NewMockSessionClient(ctrl)
+				sessionClient.EXPECT().GetSession(gomock.Any(), "session-id").Return(userInfo, nil)
+				tokenStore := mock_usecase.NewMockSessionTokenStore(ctrl)
+				tokenStore.EXPECT().GetSessionToken(gomock.Any(), "session-id").Return(staleToken(), nil)
+				tokenStore.EXPECT().UpdateSession(gomock.Any(), "session-id", userInfo, gomock.Any()).Return(nil)
+				permissionsFetcher := mock_usecase.NewMockRepositoryPermissionsFetcher(ctrl)
+				permissionsFetcher.EXPECT().GetRepositoryPermissions(gomock.Any(), oauthToken, ownerRepo).Return(adminPermissions, nil)
+				return mockFields{
+					sessionClient:      sessionClient,
+					tokenStore:         tokenStore,
+					permissionsFetcher: permissionsFetcher,
+				}
+			},
+			wantPermissions: &pushPermissions,
+		},
+		{
+			name: "正常系: セッションの保存に失敗した場合でも、再検証した権限を返す",
+			setupMocks: func(ctrl *gomock.Controller, userInfo *domain.UserInfo) mockFields {
+				sessionClient := mock_usecase.NewMockSessionClient(ctrl)
+				sessionClient.EXPECT().GetSession(gomock.Any(), "session-id").Return(userInfo, nil)
+				tokenStore := mock_usecase.NewMockSessionTokenStore(ctrl)
+				tokenStore.EXPECT().GetSessionToken(gomock.Any(), "session-id").Return(staleToken(), nil)
+				tokenStore.EXPECT().UpdateSession(gomock.Any(), "session-id", userInfo, gomock.Any()).Return(errors.New("redis error"))
+				permissionsFetcher := mock_usecase.NewMockRepositoryPermissionsFetcher(ctrl)
+				permissionsFetcher.EXPECT().GetRepositoryPermissions(gomock.Any(), oauthToken, ownerRepo).Return(pullPermissions, nil)
+				return mockFields{
+					sessionClient:      sessionClient,
+					tokenStore:         tokenStore,
+					permissionsFetcher: permissionsFetcher,
+				}
+			},
+			wantPermissions: &pullPermissions,
+		},
+		{
+			name: "正常系: GitHubへの問い合わせが一時的に失敗した場合、セッションを維持する",
+			setupMocks: func(ctrl *gomock.Controller, userInfo *domain.UserInfo) mockFields {
+				sessionClient := mock_usecase.NewMockSessionClient(ctrl)
+				sessionClient.EXPECT().GetSession(gomock.Any(), "session-id").Return(userInfo, nil)
+				tokenStore := mock_usecase.NewMockSessionTokenStore(ctrl)
+				tokenStore.EXPECT().GetSessionToken(gomock.Any(), "session-id").Return(staleToken(), nil)
+				permissionsFetcher := mock_usecase.NewMockRepositoryPermissionsFetcher(ctrl)
+				permissionsFetcher.EXPECT().GetRepositoryPermissions(gomock.Any(), oauthToken, ownerRepo).Return(domain.RepositoryPermissions{}, errors.New("status=502"))
+				return mockFields{
+					sessionClient:      sessionClient,
+					tokenStore:         tokenStore,
+					permissionsFetcher: permissionsFetcher,
+				}
+			},
+			wantPermissions: &pushPermissions,
+		},
+		{
+			name: "異常系: GitHubへの問い合わせが失敗し続けて猶予を超えた場合、セッションを削除せずErrSessionNotFound",
+			setupMocks: func(ctrl *gomock.Controller, userInfo *domain.UserInfo) mockFields {
+				sessionClient := mock_usecase.NewMockSessionClient(ctrl)
+				sessionClient.EXPECT().GetSession(gomock.Any(), "session-id").Return(userInfo, nil)
+				tokenStore := mock_usecase.NewMockSessionTokenStore(ctrl)
+				tokenStore.EXPECT().GetSessionToken(gomock.Any(), "session-id").Return(&usecase.SessionToken{
+					Token:     oauthToken,
+					CheckedAt: fixedNow.Add(-recheckInterval - time.Hour),
+				}, nil)
+				permissionsFetcher := mock_usecase.NewMockRepositoryPermissionsFetcher(ctrl)
+				permissionsFetcher.EXPECT().GetRepositoryPermissions(gomock.Any(), oauthToken, ownerRepo).Return(domain.RepositoryPermissions{}, errors.New("status=502"))
+				return mockFields{
+					sessionClient:      sessionClient,
+					tokenStore:         tokenStore,
+					permissionsFetcher: permissionsFetcher,
+				}
+			},
+			wantErr: usecase.ErrSessionNotFound,
+		},
+		{
+			name: "異常系: リポジトリへのアクセス権を失った場合、セッションを削除しErrSessionNotFound",
+			setupMocks: func(ctrl *gomock.Controller, userInfo *domain.UserInfo) mockFields {
+				sessionClient := mock_usecase.NewMockSessionClient(ctrl)
+				sessionClient.EXPECT().GetSession(gomock.Any(), "session-id").Return(userInfo, nil)
+				tokenStore := mock_usecase.NewMockSessionTokenStore(ctrl)
+				tokenStore.EXPECT().GetSessionToken(gomock.Any(), "session-id").Return(staleToken(), nil)
+				tokenStore.EXPECT().DeleteSession(gomock.Any(), "session-id").Return(nil)
+				permissionsFetcher := mock_usecase.NewMockRepositoryPermissionsFetcher(ctrl)
+				permissionsFetcher.EXPECT().GetRepositoryPermissions(gomock.Any(), oauthToken, ownerRepo).Return(domain.RepositoryPermissions{}, usecase.ErrRepositoryAccessDenied)
+				return mockFields{
+					sessionClient:      sessionClient,
+					tokenStore:         tokenStore,
+					permissionsFetcher: permissionsFetcher,
+				}
+			},
+			wantErr: usecase.ErrSessionNotFound,
+		},
+		{
+			name: "異常系: 読み取り権限を失った場合、セッションを削除しErrSessionNotFound",
+			setupMocks: func(ctrl *gomock.Controller, userInfo *domain.UserInfo) mockFields {
+				sessionClient := mock_usecase.NewMockSessionClient(ctrl)
+				sessionClient.EXPECT().GetSession(gomock.Any(), "session-id").Return(userInfo, nil)
+				tokenStore := mock_usecase.NewMockSessionTokenStore(ctrl)
+				tokenStore.EXPECT().GetSessionToken(gomock.Any(), "session-id").Return(staleToken(), nil)
+				tokenStore.EXPECT().DeleteSession(gomock.Any(), "session-id").Return(nil)
+				permissionsFetcher := mock_usecase.NewMockRepositoryPermissionsFetcher(ctrl)
+				permissionsFetcher.EXPECT().GetRepositoryPermissions(gomock.Any(), oauthToken, ownerRepo).Return(domain.RepositoryPermissions{}, nil)
+				return mockFields{
+					sessionClient:      sessionClient,
+					tokenStore:         tokenStore,
+					permissionsFetcher: permissionsFetcher,
+				}
+			},
+			wantErr: usecase.ErrSessionNotFound,
+		},
+		{
+			name: "異常系: トークンの取得に失敗した場合、ErrSessionNotFound",
+			setupMocks: func(ctrl *gomock.Controller, userInfo *domain.UserInfo) mockFields {
+				sessionClient := mock_usecase.NewMockSessionClient(ctrl)
+				sessionClient.EXPECT().GetSession(gomock.Any(), "session-id").Return(userInfo, nil)
+				tokenStore := mock_usecase.NewMockSessionTokenStore(ctrl)
+				tokenStore.EXPECT().GetSessionToken(gomock.Any(), "session-id").Return(nil, errors.New("decrypt error"))
+				return mockFields{
+					sessionClient:      sessionClient,
+					tokenStore:         tokenStore,
+					permissionsFetcher: mock_usecase.NewMockRepositoryPermissionsFetcher(ctrl),
+				}
+			},
+			wantErr: usecase.ErrSessionNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := testid.WithValue(context.Background(), t.Name())
+			ctxtimetest.SetFixedNow(t, ctx, fixedNow)
+			ctrl := gomock.NewController(t)
+
+			mocks := tt.setupMocks(ctrl, newSessionUserInfo(t))
+			uc := usecase.NewSessionAuthUseCase(mocks.sessionClient, mocks.tokenStore, mocks.permissionsFetcher, recheckInterval)
+
+			got, err := uc.Authenticate(ctx, "session-id", ownerRepo)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Authenticate() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Authenticate() unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantPermissions, got.Permissions(), cmp.AllowUnexported(domain.RepositoryPermissions{})); diff != "" {
+				t.Errorf("Permissions() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSessionAuthUseCase_Authenticate_RepositoryScope(t *testing.T) {
+	fixedNow := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	recheckInterval := 15 * time.Minute
+	ownerRepo, _ := domain.NewRepositoryIdentifier("owner/repo")
+	orgRepo, _ := domain.NewRepositoryIdentifier("org/any-repo")
+	otherRepo, _ := domain.NewRepositoryIdentifier("other/repo")
+	pushPermissions := domain.NewRepositoryPermissions(false, true, true, false, false)
+	pullPermissions := domain.NewRepositoryPermissions(false, false, true, false, false)
+	oauthToken := &usecase.OAuthTokenResult{AccessToken: "gho_token", TokenType: "bearer", Scope: "repo"}
+	scope, err := domain.ParseRepositoryScope("owner/repo,org/*")
+	if err != nil {
+		t.Fatalf("ParseRepositoryScope() failed: %v", err)
+	}
+
+	freshToken := func() *usecase.SessionToken {
+		return &usecase.SessionToken{Token: oauthToken, CheckedAt: fixedNow.Add(-time.Minute)}
+	}
+	staleToken := func() *usecase.SessionToken {
+		return &usecase.SessionToken{Token: oauthToken, CheckedAt: fixedNow.Add(-recheckInterval)}
+	}
+
+	type mockFields struct {
+		sessionClient      *mock_usecase.MockSessionClient
+		tokenStore         *mock_usecase.MockSessionTokenStore
+		permissionsFetcher *mock_usecase.MockRepositoryPermissionsFetcher
+	}
+	tests := []struct {
+		name            string
+		repository      *domain.RepositoryIdentifier
+		setupUserInfo   func(userInfo *domain.UserInfo)
+		setupMocks      func(ctrl *gomock.Controller, userInfo *domain.UserInfo) mockFields
+		wantRepository  *domain.RepositoryIdentifier
+		wantPermissions *domain.RepositoryPermissions
+		wantErr         error
+	}{
+		{
+			name:       "正常系: 取得済みの権限がある場合、GitHubに問い合わせずにリポジトリの権限を返す",
+			repository: ownerRepo,
+			setupUserInfo: func(userInfo *domain.UserInfo) {
+				userInfo.CachePermissions(ownerRepo, pushPermissions)
+			},
+			setupMocks: func(ctrl *gomock.Controller, userInfo *domain.UserInfo) mockFields {
+				sessionClient := mock_usecase.NewMockSessionClient(ctrl)
+				sessionClient.EXPECT().GetSession(gomock.Any(), "session-id").Return(userInfo, nil)
+				tokenStore := mock_usecase.NewMockSessionTokenStore(ctrl)
+				tokenStore.EXPECT().GetSessionToken(gomock.Any(), "session-id").Return(freshToken(), nil)
+				return mockFields{
+					sessionClient:      sessionClient,
+					tokenStore:         tokenStore,
+					permissionsFetcher: mock_usecase.NewMockRepositoryPermissionsFetcher(ctrl),
+				}
+			},
+			wantRepository:  ownerRepo,
+			wantPermissions: &pushPermissions,
+		},
+		{
+			name:       "正常系: 権限が未取得の場合、GitHubから取得してセッションに保存する",
+			repository: orgRepo,
+			setupMocks: func(ctrl *gomock.Controller, userInfo *domain.UserInfo) mockFields {
+				sessionClient := mock_usecase.NewMockSessionClient(ctrl)
+				sessionClient.EXPECT().GetSession(gomock.Any(), "session-id").Return(userInfo, nil)
+				tokenStore := mock_usecase.NewMockSessionTokenStore(ctrl)
+				tokenStore.EXPECT().GetSessionToken(gomock.Any(), "session-id").Return(freshToken(), nil)
+				tokenStore.EXPECT().UpdateSession(gomock.Any(), "session-id", userInfo, freshToken()).DoAndReturn(
+					func(_ context.Context, _ string, saved *domain.UserInfo, _ *usecase.SessionToken) error {
+						if got, ok := saved.CachedPermissions(orgRepo); !ok || got != pullPermissions {
+							t.Errorf("CachedPermissions() = %v, %v, want %v, true", got, ok, pullPermissions)
+						}
+						return nil
+					},
+				)
+				permissionsFetcher := mock_usecase.NewMockRepositoryPermissionsFetcher(ctrl)
+				permissionsFetcher.EXPECT().GetRepositoryPermissions(gomock.Any(), oauthToken, orgRepo).Return(pullPermissions, nil)
+				return mockFields{
+					sessionClient:      sessionClient,
+					tokenStore:         tokenStore,
+					permissionsFetcher: permissionsFetcher,
+				}
+			},
+			wantRepository:  orgRepo,
+			wantPermissions: &pullPermissions,
+		},
+		{
+			name:       "正常系: 再検証の間隔が経過した場合、取得済みの権限を破棄して取得し直す",
+			repository: ownerRepo,
+			setupUserInfo: func(userInfo *domain.UserInfo) {
+				userInfo.CachePermissions(ownerRepo, pushPermissions)
+				userInfo.CachePermissions(orgRepo, pushPermissions)
+			},
+			setupMocks: func(ctrl *gomock.Controller, userInfo *domain.UserInfo) mockFields {
+				sessionClient := mock_usecase.NewMockSessionClient(ctrl)
+				sessionClient.EXPECT().GetSession(gomock.Any(), "session-id").Return(userInfo, nil)
+				tokenStore := mock_usecase.NewMockSessionTokenStore(ctrl)
+				tokenStore.EXPECT().GetSessionToken(gomock.Any(), "session-id").Return(staleToken(), nil)
+				tokenStore.EXPECT().UpdateSession(gomock.Any(), "session-id", userInfo, &usecase.SessionToken{
+					Token:     oauthToken,
+					CheckedAt: fixedNow,
+				}).DoAndReturn(
+					func(_ context.Context, _ string, saved *domain.UserInfo, _ *usecase.SessionToken) error {
+						if _, ok := saved.CachedPermissions(orgRepo); ok {
+							t.Error("CachedPermissions() for org/any-repo should be cleared")
+						}
+						return nil
+					},
+				)
+				permissionsFetcher := mock_usecase.NewMockRepositoryPermissionsFetcher(ctrl)
+				permissionsFetcher.EXPECT().GetRepositoryPermissions(gomock.Any(), oauthToken, ownerRepo).Return(pullPermissions, nil)
+				return mockFields{
+					sessionClient:      sessionClient,
+					tokenStore:         tokenStore,
+					permissionsFetcher: permissionsFetcher,
+				}
+			},
+			wantRepository:  ownerRepo,
+			wantPermissions: &pullPermissions,
+		},
+		{
+			name:       "異常系: スコープに含まれないリポジトリの場合、リポジトリを持たないユーザー情報を返す",
+			repository: otherRepo,
+			setupMocks: func(ctrl *gomock.Controller, userInfo *domain.UserInfo) mockFields {
+				sessionClient := mock_usecase.NewMockSessionClient(ctrl)
+				sessionClient.EXPECT().GetSession(gomock.Any(), "session-id").Return(userInfo, nil)
+				return mockFields{
+					sessionClient:      sessionClient,
+					tokenStore:         mock_usecase.NewMockSessionTokenStore(ctrl),
+					permissionsFetcher: mock_usecase.NewMockRepositoryPermissionsFetcher(ctrl),
+				}
+			},
+		},
+		{
+			name:       "異常系: アクセス権がないことを取得済みの場合、GitHubに問い合わせずにリポジトリを持たないユーザー情報を返す",
+			repository: ownerRepo,
+			setupUserInfo: func(userInfo *domain.UserInfo) {
+				userInfo.CachePermissions(ownerRepo, domain.RepositoryPermissions{})
+			},
+			setupMocks: func(ctrl *gomock.Controller, userInfo *domain.UserInfo) mockFields {
+				sessionClient := mock_usecase.NewMockSessionClient(ctrl)
+				sessionClient.EXPECT().GetSession(gomock.Any(), "session-id").Return(userInfo, nil)
+				tokenStore := mock_usecase.NewMockSessionTokenStore(ctrl)
+				tokenStore.EXPECT().GetSessionToken(gomock.Any(), "session-id").Return(freshToken(), nil)
+				return mockFields{
+					sessionClient:      sessionClient,
+					tokenStore:         tokenStore,
+					permissionsFetcher: mock_usecase.NewMockRepositoryPermissionsFetcher(ctrl),
+				}
+			},
+		},
+		{
+			name:       "異常系: リポジトリへのアクセス権がない場合、その結果を保存しリポジトリを持たないユーザー情報を返す",
+			repository: ownerRepo,
+			setupMocks: func(ctrl *gomock.Controller, userInfo *domain.UserInfo) mockFields {
+				sessionClient := mock_usecase.NewMockSessionClient(ctrl)
+				sessionClient.EXPECT().GetSession(gomock.Any(), "session-id").Return(userInfo, nil)
+				tokenStore := mock_usecase.NewMockSessionTokenStore(ctrl)
+				tokenStore.EXPECT().GetSessionToken(gomock.Any(), "session-id").Return(freshToken(), nil)
+				tokenStore.EXPECT().UpdateSession(gomock.Any(), "session-id", userInfo, gomock.Any()).Return(nil)
+				permissionsFetcher := mock_usecase.NewMockRepositoryPermissionsFetcher(ctrl)
+				permissionsFetcher.EXPECT().GetRepositoryPermissions(gomock.Any(), oauthToken, ownerRepo).Return(domain.RepositoryPermissions{}, usecase.ErrRepositoryAccessDenied)
+				return mockFields{
+					sessionClient:      sessionClient,
+					tokenStore:         tokenStore,
+					permissionsFetcher: permissionsFetcher,
+				}
+			},
+		},
+		{
+			name:       "異常系: GitHubへの問い合わせが一時的に失敗した場合、結果を保存せずリポジトリを持たないユーザー情報を返す",
+			repository: ownerRepo,
+			setupMocks: func(ctrl *gomock.Controller, userInfo *domain.UserInfo) mockFields {
+				sessionClient := mock_usecase.NewMockSessionClient(ctrl)
+				sessionClient.EXPECT().GetSession(gomock.Any(), "session-id").Return(userInfo, nil)
+				tokenStore := mock_usecase.NewMockSessionTokenStore(ctrl)
+				tokenStore.EXPECT().GetSessionToken(gomock.Any(), "session-id").Return(freshToken(), nil)
+				permissionsFetcher := mock_usecase.NewMockRepositoryPermissionsFetcher(ctrl)
+				permissionsFetcher.EXPECT().GetRepositoryPermissions(gomock.Any(), oauthToken, ownerRepo).Return(domain.RepositoryPermissions{}, errors.New("status=502"))
+				return mockFields{
+					sessionClient:      sessionClient,
+					tokenStore:         tokenStore,
+					permissionsFetcher: permissionsFetcher,
+				}
+			},
+		},
+		{
+			name:       "異常系: トークンを保存していないセッションの場合、リポジトリを持たないユーザー情報を返す",
+			repository: ownerRepo,
+			setupMocks: func(ctrl *gomock.Controller, userInfo *domain.UserInfo) mockFields {
+				sessionClient := mock_usecase.NewMockSessionClient(ctrl)
+				sessionClient.EXPECT().GetSession(gomock.Any(), "session-id").Return(userInfo, nil)
+				tokenStore := mock_usecase.NewMockSessionTokenStore(ctrl)
+				tokenStore.EXPECT().GetSessionToken(gomock.Any(), "session-id").Return(nil, usecase.ErrSessionTokenNotFound)
+				return mockFields{
+					sessionClient:      sessionClient,
+					tokenStore:         tokenStore,
+					permissionsFetcher: mock_usecase.NewMockRepositoryPermissionsFetcher(ctrl),
+				}
+			},
+		},
+		{
+			name:       "異常系: トークンの取得に失敗した場合、ErrSessionNotFound",
+			repository: ownerRepo,
+			setupMocks: func(ctrl *gomock.Controller, userInfo *domain.UserInfo) mockFields {
+				sessionClient := mock_usecase.NewMockSessionClient(ctrl)
+				sessionClient.EXPECT().GetSession(gomock.Any(), "session-id").Return(userInfo, nil)
+				tokenStore := mock_usecase.NewMockSessionTokenStore(ctrl)
+				tokenStore.EXPECT().GetSessionToken(gomock.Any(), "session-id").Return(nil, errors.New("decrypt error"))
+				return mockFields{
+					sessionClient:      sessionClient,
+					tokenStore:         tokenStore,
+					permissionsFetcher: mock_usecase.NewMockRepositoryPermissionsFetcher(ctrl),
+				}
+			},
+			wantErr: usecase.ErrSessionNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := testid.WithValue(context.Background(), t.Name())
+			ctxtimetest.SetFixedNow(t, ctx, fixedNow)
+			ctrl := gomock.NewController(t)
+
+			userInfo := mustNewUserInfoInSessionTest(t, "12345", "", "user", domain.ProviderTypeGitHub, nil, "")
+			userInfo.SetRepositoryScope(scope)
+			if tt.setupUserInfo != nil {
+				tt.setupUserInfo(userInfo)
+			}
+			mocks := tt.setupMocks(ctrl, userInfo)
+			uc := usecase.NewSessionAuthUseCase(mocks.sessionClient, mocks.tokenStore, mocks.permissionsFetcher, recheckInterval)
+
+			got, err := uc.Authenticate(ctx, "session-id", tt.repository)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Authenticate() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Authenticate() unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantRepository, got.Repository(), cmp.AllowUnexported(domain.RepositoryIdentifier{})); diff != "" {
+				t.Errorf("Repository() mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantPermissions, got.Permissions(), cmp.AllowUnexported(domain.RepositoryPermissions{})); diff != "" {
+				t.Errorf("Permissions() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+//go:generate mockgen -source=$GOFILE -destination=../../tests/usecase/mock_session_usecase.go -package=usecase
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/na2na-p/cargohold/internal/domain"
+)
+
+// UserSession はユーザーのセッション索引から取得したセッション
+type UserSession struct {
+	ID        string
+	UserInfo  *domain.UserInfo
+	ExpiresAt time.Time
+}
+
+// RevokedUserCredentials はユーザーの認証情報を一括失効した結果
+type RevokedUserCredentials struct {
+	Sessions     int
+	AccessTokens int
+}
+
+// UserSessionStoreInterface はユーザー単位でセッションを参照・削除する
+type UserSessionStoreInterface interface {
+	DeleteSession(ctx context.Context, sessionID string) error
+	ListUserSessions(ctx context.Context, sub string) ([]*UserSession, error)
+	DeleteUserSessions(ctx context.Context, sub string) (int, error)
+}
+
+type SessionUseCase interface {
+	// Logout はリクエストの認証に使用したセッションを失効させる
+	Logout(ctx context.Context, sessionID string) error
+	// ListForUser はユーザーの有効なセッションを有効期限の昇順で返す
+	ListForUser(ctx context.Context, userInfo *domain.UserInfo) ([]*UserSession, error)
+	// RevokeAllForGitHubUser はGitHubユーザーIDに紐づくセッションと、そのユーザーが発行したアクセストークンをすべて失効させる
+	RevokeAllForGitHubUser(ctx context.Context, githubUserID string) (*RevokedUserCredentials, error)
+}
+
+type sessionUseCaseImpl struct {
+	sessionStore UserSessionStoreInterface
+	tokenRepo    domain.AccessTokenRepository
+}
+
+// NewSessionUseCase はGitHub OAuthで作成したセッションを管理するユースケースを作成する
+func NewSessionUseCase(sessionStore UserSessionStoreInterface, tokenRepo domain.AccessTokenRepository) SessionUseCase {
+	return &sessionUseCaseImpl{
+		sessionStore: sessionStore,
+		tokenRepo:    tokenRepo,
+	}
+}
+
+func (u *sessionUseCaseImpl) Logout(ctx context.Context, sessionID string) error {
+	if sessionID == "" {
+		return ErrSessionNotFound
+	}
+	return u.sessionStore.DeleteSession(ctx, sessionID)
+}
+
+func (u *sessionUseCaseImpl) ListForUser(ctx context.Context, userInfo *domain.UserInfo) ([]*UserSession, error) {
+	if userInfo == nil {
+		return nil, ErrSessionNotFound
+	}
+	return u.sessionStore.ListUserSessions(ctx, userInfo.Sub())
+}
+
+func (u *sessionUseCaseImpl) RevokeAllForGitHubUser(ctx context.Context, githubUserID string) (*RevokedUserCredentials, error) {
+	// セッションのsubjectとアクセストークンの発行者はGitHubユーザーIDの10進表記で保存している
+	id, err := strconv.ParseInt(githubUserID, 10, 64)
+	if err != nil || id <= 0 || strconv.FormatInt(id, 10) != githubUserID {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidGitHubUserID, githubUserID)
+	}
+
+	sessions, err := u.sessionStore.DeleteUserSessions(ctx, githubUserID)
+	if err != nil {
+		return nil, err
+	}
+	// セッションを失効させても、セッションから発行したトークンは残るため合わせて削除する
+	accessTokens, err := u.tokenRepo.DeleteByCreator(ctx, githubUserID)
+	if err != nil {
+		return nil, fmt.Errorf("アクセストークンの失効に失敗しました: %w", err)
+	}
+	return &RevokedUserCredentials{Sessions: sessions, AccessTokens: accessTokens}, nil
+}
@@ -0,0 +1,200 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/na2na-p/cargohold/internal/usecase"
+	mock_domain "github.com/na2na-p/cargohold/tests/domain"
+	mock_usecase "github.com/na2na-p/cargohold/tests/usecase"
+	"go.uber.org/mock/gomock"
+)
+
+var errSessionStore = errors.New("redis error")
+
+func TestSessionUseCase_Logout(t *testing.T) {
+	tests := []struct {
+		name         string
+		sessionStore func(ctrl *gomock.Controller) usecase.UserSessionStoreInterface
+		sessionID    string
+		wantErr      error
+	}{
+		{
+			name: "正常系: セッションが削除される",
+			sessionStore: func(ctrl *gomock.Controller) usecase.UserSessionStoreInterface {
+				m := mock_usecase.NewMockUserSessionStoreInterface(ctrl)
+				m.EXPECT().DeleteSession(gomock.Any(), "session-id").Return(nil)
+				return m
+			},
+			sessionID: "session-id",
+		},
+		{
+			name: "異常系: セッションIDが空の場合、ErrSessionNotFoundが返る",
+			sessionStore: func(ctrl *gomock.Controller) usecase.UserSessionStoreInterface {
+				return mock_usecase.NewMockUserSessionStoreInterface(ctrl)
+			},
+			wantErr: usecase.ErrSessionNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			uc := usecase.NewSessionUseCase(tt.sessionStore(ctrl), mock_domain.NewMockAccessTokenRepository(ctrl))
+
+			err := uc.Logout(context.Background(), tt.sessionID)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Logout() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSessionUseCase_ListForUser(t *testing.T) {
+	userInfo := mustNewUserInfo(t, "12345", "", "user", domain.ProviderTypeGitHub, nil, "")
+	sessions := []*usecase.UserSession{
+		{ID: "session-id", UserInfo: userInfo, ExpiresAt: time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)},
+	}
+
+	tests := []struct {
+		name         string
+		sessionStore func(ctrl *gomock.Controller) usecase.UserSessionStoreInterface
+		userInfo     *domain.UserInfo
+		want         []*usecase.UserSession
+		wantErr      error
+	}{
+		{
+			name: "正常系: ユーザーのsubjectのセッションが返る",
+			sessionStore: func(ctrl *gomock.Controller) usecase.UserSessionStoreInterface {
+				m := mock_usecase.NewMockUserSessionStoreInterface(ctrl)
+				m.EXPECT().ListUserSessions(gomock.Any(), "12345").Return(sessions, nil)
+				return m
+			},
+			userInfo: userInfo,
+			want:     sessions,
+		},
+		{
+			name: "異常系: ユーザー情報がない場合、ErrSessionNotFoundが返る",
+			sessionStore: func(ctrl *gomock.Controller) usecase.UserSessionStoreInterface {
+				return mock_usecase.NewMockUserSessionStoreInterface(ctrl)
+			},
+			wantErr: usecase.ErrSessionNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			uc := usecase.NewSessionUseCase(tt.sessionStore(ctrl), mock_domain.NewMockAccessTokenRepository(ctrl))
+
+			got, err := uc.ListForUser(context.Background(), tt.userInfo)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ListForUser() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(domain.UserInfo{}, domain.ProviderType{})); diff != "" {
+				t.Errorf("ListForUser() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSessionUseCase_RevokeAllForGitHubUser(t *testing.T) {
+	errTokenRepo := errors.New("database error")
+
+	tests := []struct {
+		name         string
+		sessionStore func(ctrl *gomock.Controller) usecase.UserSessionStoreInterface
+		tokenRepo    func(ctrl *gomock.Controller) domain.AccessTokenRepository
+		githubUserID string
+		want         *usecase.RevokedUserCredentials
+		wantErr      error
+	}{
+		{
+			name: "正常系: GitHubユーザーIDのセッションと発行したアクセストークンがすべて失効される",
+			sessionStore: func(ctrl *gomock.Controller) usecase.UserSessionStoreInterface {
+				m := mock_usecase.NewMockUserSessionStoreInterface(ctrl)
+				m.EXPECT().DeleteUserSessions(gomock.Any(), "12345").Return(2, nil)
+				return m
+			},
+			tokenRepo: func(ctrl *gomock.Controller) domain.AccessTokenRepository {
+				m := mock_domain.NewMockAccessTokenRepository(ctrl)
+				m.EXPECT().DeleteByCreator(gomock.Any(), "12345").Return(3, nil)
+				return m
+			},
+			githubUserID: "12345",
+			want:         &usecase.RevokedUserCredentials{Sessions: 2, AccessTokens: 3},
+		},
+		{
+			name: "異常系: 数値でないIDの場合、ErrInvalidGitHubUserIDが返る",
+			sessionStore: func(ctrl *gomock.Controller) usecase.UserSessionStoreInterface {
+				return mock_usecase.NewMockUserSessionStoreInterface(ctrl)
+			},
+			tokenRepo: func(ctrl *gomock.Controller) domain.AccessTokenRepository {
+				return mock_domain.NewMockAccessTokenRepository(ctrl)
+			},
+			githubUserID: "octocat",
+			wantErr:      usecase.ErrInvalidGitHubUserID,
+		},
+		{
+			name: "異常系: 先頭に0を含むIDの場合、ErrInvalidGitHubUserIDが返る",
+			sessionStore: func(ctrl *gomock.Controller) usecase.UserSessionStoreInterface {
+				return mock_usecase.NewMockUserSessionStoreInterface(ctrl)
+			},
+			tokenRepo: func(ctrl *gomock.Controller) domain.AccessTokenRepository {
+				return mock_domain.NewMockAccessTokenRepository(ctrl)
+			},
+			githubUserID: "012345",
+			wantErr:      usecase.ErrInvalidGitHubUserID,
+		},
+		{
+			name: "異常系: セッションの削除に失敗した場合、エラーが返る",
+			sessionStore: func(ctrl *gomock.Controller) usecase.UserSessionStoreInterface {
+				m := mock_usecase.NewMockUserSessionStoreInterface(ctrl)
+				m.EXPECT().DeleteUserSessions(gomock.Any(), "12345").Return(0, errSessionStore)
+				return m
+			},
+			tokenRepo: func(ctrl *gomock.Controller) domain.AccessTokenRepository {
+				return mock_domain.NewMockAccessTokenRepository(ctrl)
+			},
+			githubUserID: "12345",
+			wantErr:      errSessionStore,
+		},
+		{
+			name: "異常系: アクセストークンの削除に失敗した場合、エラーが返る",
+			sessionStore: func(ctrl *gomock.Controller) usecase.UserSessionStoreInterface {
+				m := mock_usecase.NewMockUserSessionStoreInterface(ctrl)
+				m.EXPECT().DeleteUserSessions(gomock.Any(), "12345").Return(2, nil)
+				return m
+			},
+			tokenRepo: func(ctrl *gomock.Controller) domain.AccessTokenRepository {
+				m := mock_domain.NewMockAccessTokenRepository(ctrl)
+				m.EXPECT().DeleteByCreator(gomock.Any(), "12345").Return(0, errTokenRepo)
+				return m
+			},
+			githubUserID: "12345",
+			wantErr:      errTokenRepo,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			uc := usecase.NewSessionUseCase(tt.sessionStore(ctrl), tt.tokenRepo(ctrl))
+
+			got, err := uc.RevokeAllForGitHubUser(context.Background(), tt.githubUserID)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("RevokeAllForGitHubUser() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("RevokeAllForGitHubUser() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/na2na-p/cargohold/internal/usecase"
+
+const (
+	attrLFSOperation   = attribute.Key("lfs.operation")
+	attrLFSObjectCount = attribute.Key("lfs.object_count")
+	attrLFSOID         = attribute.Key("lfs.oid")
+	attrLFSRepository  = attribute.Key("lfs.repository")
+)
+
+// startSpan はユースケースの処理単位を表すスパンを開始する
+func startSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// endSpan はエラーがあればスパンに記録してからスパンを終了する
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/na2na-p/cargohold/internal/domain"
+)
+
+// TransferPolicy はバッチレスポンスでプロキシURLと署名付きURLのどちらを返すかを決定する
+type TransferPolicy struct {
+	mode       domain.TransferMode
+	threshold  int64
+	presignTTL time.Duration
+}
+
+// NewTransferPolicy は新しいTransferPolicyを作成する
+// thresholdはTransferModeThresholdの場合のみ使用され、この値以上のサイズのオブジェクトに署名付きURLを返す
+func NewTransferPolicy(mode domain.TransferMode, threshold int64, presignTTL time.Duration) TransferPolicy {
+	if mode.IsZero() {
+		mode = domain.TransferModeProxy
+	}
+	if presignTTL <= 0 {
+		presignTTL = PresignedURLTTL
+	}
+	return TransferPolicy{
+		mode:       mode,
+		threshold:  threshold,
+		presignTTL: presignTTL,
+	}
+}
+
+// DefaultTransferPolicy は全てのオブジェクトをプロキシ経由で転送するポリシーを返す
+func DefaultTransferPolicy() TransferPolicy {
+	return NewTransferPolicy(domain.TransferModeProxy, 0, PresignedURLTTL)
+}
+
+func (p TransferPolicy) Mode() domain.TransferMode {
+	return p.mode
+}
+
+func (p TransferPolicy) PresignTTL() time.Duration {
+	return p.presignTTL
+}
+
+// UsePresignedURL は指定サイズのオブジェクトに署名付きURLを使用するかを返す
+func (p TransferPolicy) UsePresignedURL(size domain.Size) bool {
+	switch p.mode {
+	case domain.TransferModePresigned:
+		return true
+	case domain.TransferModeThreshold:
+		return size.Int64() >= p.threshold
+	default:
+		return false
+	}
+}
@@ -0,0 +1,71 @@
+package usecase_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/na2na-p/cargohold/internal/domain"
+	"github.com/na2na-p/cargohold/internal/usecase"
+)
+
+func TestTransferPolicy_UsePresignedURL(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy usecase.TransferPolicy
+		size   int64
+		want   bool
+	}{
+		{
+			name:   "正常系: proxyモードでは常にfalse",
+			policy: usecase.NewTransferPolicy(domain.TransferModeProxy, 0, time.Minute),
+			size:   1 << 30,
+			want:   false,
+		},
+		{
+			name:   "正常系: presignedモードでは常にtrue",
+			policy: usecase.NewTransferPolicy(domain.TransferModePresigned, 0, time.Minute),
+			size:   1,
+			want:   true,
+		},
+		{
+			name:   "正常系: thresholdモードでしきい値以上の場合はtrue",
+			policy: usecase.NewTransferPolicy(domain.TransferModeThreshold, 1024, time.Minute),
+			size:   1024,
+			want:   true,
+		},
+		{
+			name:   "正常系: thresholdモードでしきい値未満の場合はfalse",
+			policy: usecase.NewTransferPolicy(domain.TransferModeThreshold, 1024, time.Minute),
+			size:   1023,
+			want:   false,
+		},
+		{
+			name:   "正常系: ゼロ値のポリシーはproxyとして扱われる",
+			policy: usecase.TransferPolicy{},
+			size:   1 << 30,
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			size, err := domain.NewSize(tt.size)
+			if err != nil {
+				t.Fatalf("NewSize() failed: %v", err)
+			}
+			if got := tt.policy.UsePresignedURL(size); got != tt.want {
+				t.Errorf("UsePresignedURL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewTransferPolicy_Defaults(t *testing.T) {
+	policy := usecase.NewTransferPolicy(domain.TransferMode{}, 0, 0)
+	if policy.Mode() != domain.TransferModeProxy {
+		t.Errorf("Mode() = %v, want %v", policy.Mode(), domain.TransferModeProxy)
+	}
+	if policy.PresignTTL() != usecase.PresignedURLTTL {
+		t.Errorf("PresignTTL() = %v, want %v", policy.PresignTTL(), usecase.PresignedURLTTL)
+	}
+}
@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/na2na-p/cargohold/internal/domain"
+)
+
+// completeUploadIfStored は未完了のオブジェクトがストレージに期待するサイズで存在する場合にアップロード済みとして記録する
+// 署名付きURLでのアップロードはサーバーを経由しないため、メタデータ参照時にストレージを確認して完了を検出する
+func completeUploadIfStored(ctx context.Context, repo domain.LFSObjectRepository, objectStorage ObjectStorage, obj *domain.LFSObject) (bool, error) {
+	storedSize, exists, err := objectStorage.HeadObjectSize(ctx, obj.GetStorageKey())
+	if err != nil {
+		return false, fmt.Errorf("ストレージの確認に失敗しました: %w", err)
+	}
+	if !exists {
+		return false, nil
+	}
+	if storedSize != obj.Size().Int64() {
+		// サイズの異なるオブジェクトは完了として扱わず、再度のアップロードで上書きさせる
+		slog.Warn("ストレージのオブジェクトサイズがメタデータと一致しません",
+			"oid", obj.OID().String(), "expected", obj.Size().Int64(), "actual", storedSize)
+		return false, nil
+	}
+
+	obj.MarkAsUploaded(ctx)
+	if err := repo.Update(ctx, obj); err != nil {
+		return false, fmt.Errorf("メタデータの更新に失敗しました: %w", err)
+	}
+
+	return true, nil
+}
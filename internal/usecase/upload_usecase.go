@@ -3,42 +3,47 @@ package usecase
 
 import (
 	"context"
-	"errors"
+	"log/slog"
 
 	"github.com/na2na-p/cargohold/internal/domain"
 )
 
 type UploadUseCase interface {
-	HandleUploadObject(ctx context.Context, baseURL, owner, repo string, oid domain.OID, size domain.Size, hashAlgo string, authHeader string) ResponseObject
+	HandleUploadObject(ctx context.Context, baseURL, owner, repo string, oid domain.OID, size domain.Size, obj *domain.LFSObject, hashAlgo string, authHeader string) ResponseObject
+	HandleProofUpload(ctx context.Context, baseURL, owner, repo string, oid domain.OID, size domain.Size, obj *domain.LFSObject, authHeader string) ResponseObject
 }
 
 type uploadUseCaseImpl struct {
 	repo                domain.LFSObjectRepository
 	actionURLGenerator  ActionURLGenerator
 	storageKeyGenerator StorageKeyGenerator
+	s3Client            S3Client
+	objectStorage       ObjectStorage
+	transferPolicy      TransferPolicy
 }
 
 func NewUploadUseCase(
 	repo domain.LFSObjectRepository,
 	actionURLGenerator ActionURLGenerator,
 	storageKeyGenerator StorageKeyGenerator,
+	s3Client S3Client,
+	objectStorage ObjectStorage,
+	transferPolicy TransferPolicy,
 ) UploadUseCase {
 	return &uploadUseCaseImpl{
 		repo:                repo,
 		actionURLGenerator:  actionURLGenerator,
 		storageKeyGenerator: storageKeyGenerator,
+		s3Client:            s3Client,
+		objectStorage:       objectStorage,
+		transferPolicy:      transferPolicy,
 	}
 }
 
-func (uc *uploadUseCaseImpl) HandleUploadObject(ctx context.Context, baseURL, owner, repo string, oid domain.OID, size domain.Size, hashAlgo string, authHeader string) ResponseObject {
-	obj, err := uc.repo.FindByOID(ctx, oid)
-
+func (uc *uploadUseCaseImpl) HandleUploadObject(ctx context.Context, baseURL, owner, repo string, oid domain.OID, size domain.Size, obj *domain.LFSObject, hashAlgo string, authHeader string) ResponseObject {
 	var storageKey string
-	if err != nil {
-		if !errors.Is(err, domain.ErrNotFound) {
-			objectError := NewObjectError(500, "メタデータの取得に失敗しました")
-			return NewResponseObject(oid.String(), size.Int64(), false, nil, &objectError)
-		}
+	if obj == nil {
+		var err error
 		storageKey, err = uc.storageKeyGenerator.GenerateStorageKey(oid.String(), hashAlgo)
 		if err != nil {
 			objectError := NewObjectError(400, "無効なストレージキーパラメータです")
@@ -58,21 +63,70 @@ func (uc *uploadUseCaseImpl) HandleUploadObject(ctx context.Context, baseURL, ow
 			objectError := NewObjectError(500, "メタデータの保存に失敗しました")
 			return NewResponseObject(oid.String(), size.Int64(), false, nil, &objectError)
 		}
-	} else if obj.IsUploaded() {
-		if obj.Size().Int64() != size.Int64() {
-			objectError := NewObjectError(409, "オブジェクトサイズが一致しません")
+	} else {
+		storageKey = obj.GetStorageKey()
+		if !obj.IsUploaded() {
+			if _, err := completeUploadIfStored(ctx, uc.repo, uc.objectStorage, obj); err != nil {
+				slog.Warn("アップロード完了状態の確認に失敗しました", "oid", oid.String(), "error", err)
+			}
+		}
+		if obj.IsUploaded() {
+			if obj.Size().Int64() != size.Int64() {
+				objectError := NewObjectError(409, "オブジェクトサイズが一致しません")
+				return NewResponseObject(oid.String(), size.Int64(), false, nil, &objectError)
+			}
+			return NewResponseObject(oid.String(), size.Int64(), true, nil, nil)
+		}
+	}
+
+	if uc.transferPolicy.UsePresignedURL(size) {
+		// サーバーを経由せずに保存されるため、宣言されたサイズとOIDに一致する内容のみを受け付ける署名にする
+		uploadURL, uploadHeader, err := uc.s3Client.GeneratePutURL(ctx, storageKey, size.Int64(), oid.Base64(), uc.transferPolicy.PresignTTL())
+		if err != nil {
+			objectError := NewObjectError(500, "署名付きURLの生成に失敗しました")
 			return NewResponseObject(oid.String(), size.Int64(), false, nil, &objectError)
 		}
-		return NewResponseObject(oid.String(), size.Int64(), true, nil, nil)
+		expiresIn := int(uc.transferPolicy.PresignTTL().Seconds())
+		uploadAction := NewAction(uploadURL, uploadHeader, expiresIn)
+		verifyAction := uc.newVerifyAction(baseURL, owner, repo, authHeader, expiresIn)
+		actions := NewActions(&uploadAction, nil, &verifyAction)
+		return NewResponseObject(oid.String(), size.Int64(), true, &actions, nil)
+	}
+
+	uploadURL := uc.actionURLGenerator.GenerateUploadURL(baseURL, owner, repo, oid.String())
+
+	expiresIn := int(PresignedURLTTL.Seconds())
+	uploadAction := NewAction(uploadURL, authorizationHeader(authHeader), expiresIn)
+	verifyAction := uc.newVerifyAction(baseURL, owner, repo, authHeader, expiresIn)
+	actions := NewActions(&uploadAction, nil, &verifyAction)
+	return NewResponseObject(oid.String(), size.Int64(), true, &actions, nil)
+}
+
+// HandleProofUpload は既存オブジェクトへの紐付けを求めるリポジトリに、所持証明のためのアップロードアクションを返します
+// 受信したバイト列はサーバー側でハッシュを検証する必要があるため、署名付きURLは使わず常にプロキシ経由とします
+func (uc *uploadUseCaseImpl) HandleProofUpload(ctx context.Context, baseURL, owner, repo string, oid domain.OID, size domain.Size, obj *domain.LFSObject, authHeader string) ResponseObject {
+	if obj.Size().Int64() != size.Int64() {
+		objectError := NewObjectError(409, "オブジェクトサイズが一致しません")
+		return NewResponseObject(oid.String(), size.Int64(), false, nil, &objectError)
 	}
 
 	uploadURL := uc.actionURLGenerator.GenerateUploadURL(baseURL, owner, repo, oid.String())
+	uploadAction := NewAction(uploadURL, authorizationHeader(authHeader), int(PresignedURLTTL.Seconds()))
+	actions := NewActions(&uploadAction, nil, nil)
+	return NewResponseObject(oid.String(), size.Int64(), true, &actions, nil)
+}
 
+// newVerifyAction はアップロード完了後にクライアントが呼び出すverifyアクションを生成します
+// 署名付きURLでアップロードする場合もverifyはこのサーバーに送られるため、認証ヘッダーを常に付与します
+func (uc *uploadUseCaseImpl) newVerifyAction(baseURL, owner, repo, authHeader string, expiresIn int) Action {
+	verifyURL := uc.actionURLGenerator.GenerateVerifyURL(baseURL, owner, repo)
+	return NewAction(verifyURL, authorizationHeader(authHeader), expiresIn)
+}
+
+func authorizationHeader(authHeader string) map[string]string {
 	header := map[string]string{}
 	if authHeader != "" {
 		header["Authorization"] = authHeader
 	}
-	uploadAction := NewAction(uploadURL, header, int(PresignedURLTTL.Seconds()))
-	actions := NewActions(&uploadAction, nil)
-	return NewResponseObject(oid.String(), size.Int64(), true, &actions, nil)
+	return header
 }
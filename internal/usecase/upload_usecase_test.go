@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/na2na-p/cargohold/internal/domain"
@@ -18,6 +19,9 @@ func TestUploadUseCase_HandleUploadObject(t *testing.T) {
 		repo                func(ctrl *gomock.Controller) domain.LFSObjectRepository
 		actionURLGenerator  func(ctrl *gomock.Controller) usecase.ActionURLGenerator
 		storageKeyGenerator func(ctrl *gomock.Controller) usecase.StorageKeyGenerator
+		s3Client            func(ctrl *gomock.Controller) usecase.S3Client
+		objectStorage       func(ctrl *gomock.Controller) usecase.ObjectStorage
+		transferPolicy      usecase.TransferPolicy
 	}
 	type args struct {
 		ctx        context.Context
@@ -26,6 +30,7 @@ func TestUploadUseCase_HandleUploadObject(t *testing.T) {
 		repo       string
 		oid        domain.OID
 		size       domain.Size
+		obj        *domain.LFSObject
 		hashAlgo   string
 		authHeader string
 	}
@@ -39,15 +44,7 @@ func TestUploadUseCase_HandleUploadObject(t *testing.T) {
 			name: "正常系: オブジェクトがアップロード済みの場合、アクションなしで返る",
 			fields: fields{
 				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
-					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
-					ctx := context.Background()
-					oid, _ := domain.NewOID("1234567890123456789012345678901234567890123456789012345678901234")
-					size, _ := domain.NewSize(1024)
-					hashAlgo, _ := domain.NewHashAlgorithm("sha256")
-					obj, _ := domain.NewLFSObject(ctx, oid, size, hashAlgo, "objects/sha256/12/34/1234567890123456789012345678901234567890123456789012345678901234")
-					obj.MarkAsUploaded(ctx)
-					mock.EXPECT().FindByOID(gomock.Any(), gomock.Any()).Return(obj, nil)
-					return mock
+					return mock_domain.NewMockLFSObjectRepository(ctrl)
 				},
 				actionURLGenerator: func(ctrl *gomock.Controller) usecase.ActionURLGenerator {
 					return mock_usecase.NewMockActionURLGenerator(ctrl)
@@ -55,10 +52,20 @@ func TestUploadUseCase_HandleUploadObject(t *testing.T) {
 				storageKeyGenerator: func(ctrl *gomock.Controller) usecase.StorageKeyGenerator {
 					return mock_usecase.NewMockStorageKeyGenerator(ctrl)
 				},
+				s3Client: func(ctrl *gomock.Controller) usecase.S3Client {
+					return mock_usecase.NewMockS3Client(ctrl)
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					return mock_usecase.NewMockObjectStorage(ctrl)
+				},
 			},
 			args: func() args {
 				oid, _ := domain.NewOID("1234567890123456789012345678901234567890123456789012345678901234")
 				size, _ := domain.NewSize(1024)
+				ctx := context.Background()
+				hashAlgo, _ := domain.NewHashAlgorithm("sha256")
+				obj, _ := domain.NewLFSObject(ctx, oid, size, hashAlgo, "objects/sha256/12/34/1234567890123456789012345678901234567890123456789012345678901234")
+				obj.MarkAsUploaded(ctx)
 				return args{
 					ctx:      context.Background(),
 					baseURL:  "https://example.com",
@@ -66,6 +73,7 @@ func TestUploadUseCase_HandleUploadObject(t *testing.T) {
 					repo:     "test-repo",
 					oid:      oid,
 					size:     size,
+					obj:      obj,
 					hashAlgo: "sha256",
 				}
 			}(),
@@ -76,13 +84,13 @@ func TestUploadUseCase_HandleUploadObject(t *testing.T) {
 			fields: fields{
 				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
 					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
-					mock.EXPECT().FindByOID(gomock.Any(), gomock.Any()).Return(nil, domain.ErrNotFound)
 					mock.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil)
 					return mock
 				},
 				actionURLGenerator: func(ctrl *gomock.Controller) usecase.ActionURLGenerator {
 					mock := mock_usecase.NewMockActionURLGenerator(ctrl)
 					mock.EXPECT().GenerateUploadURL("https://example.com", "test-owner", "test-repo", "1234567890123456789012345678901234567890123456789012345678901234").Return("https://example.com/test-owner/test-repo/objects/1234567890123456789012345678901234567890123456789012345678901234/upload")
+					mock.EXPECT().GenerateVerifyURL("https://example.com", "test-owner", "test-repo").Return("https://example.com/test-owner/test-repo/info/lfs/objects/verify")
 					return mock
 				},
 				storageKeyGenerator: func(ctrl *gomock.Controller) usecase.StorageKeyGenerator {
@@ -90,6 +98,12 @@ func TestUploadUseCase_HandleUploadObject(t *testing.T) {
 					mock.EXPECT().GenerateStorageKey(gomock.Any(), gomock.Any()).Return("objects/sha256/12/34/1234567890123456789012345678901234567890123456789012345678901234", nil)
 					return mock
 				},
+				s3Client: func(ctrl *gomock.Controller) usecase.S3Client {
+					return mock_usecase.NewMockS3Client(ctrl)
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					return mock_usecase.NewMockObjectStorage(ctrl)
+				},
 			},
 			args: func() args {
 				oid, _ := domain.NewOID("1234567890123456789012345678901234567890123456789012345678901234")
@@ -101,12 +115,14 @@ func TestUploadUseCase_HandleUploadObject(t *testing.T) {
 					repo:     "test-repo",
 					oid:      oid,
 					size:     size,
+					obj:      nil,
 					hashAlgo: "sha256",
 				}
 			}(),
 			want: func() usecase.ResponseObject {
 				uploadAction := usecase.NewAction("https://example.com/test-owner/test-repo/objects/1234567890123456789012345678901234567890123456789012345678901234/upload", nil, 900)
-				actions := usecase.NewActions(&uploadAction, nil)
+				verifyAction := usecase.NewAction("https://example.com/test-owner/test-repo/info/lfs/objects/verify", nil, 900)
+				actions := usecase.NewActions(&uploadAction, nil, &verifyAction)
 				return usecase.NewResponseObject("1234567890123456789012345678901234567890123456789012345678901234", 1024, true, &actions, nil)
 			}(),
 		},
@@ -114,27 +130,80 @@ func TestUploadUseCase_HandleUploadObject(t *testing.T) {
 			name: "正常系: オブジェクトが登録済みだがアップロード未完了の場合、アップロードURLが返る",
 			fields: fields{
 				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
-					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
-					ctx := context.Background()
-					oid, _ := domain.NewOID("1234567890123456789012345678901234567890123456789012345678901234")
-					size, _ := domain.NewSize(1024)
-					hashAlgo, _ := domain.NewHashAlgorithm("sha256")
-					obj, _ := domain.NewLFSObject(ctx, oid, size, hashAlgo, "existing-storage-key-from-db")
-					mock.EXPECT().FindByOID(gomock.Any(), gomock.Any()).Return(obj, nil)
+					return mock_domain.NewMockLFSObjectRepository(ctrl)
+				},
+				actionURLGenerator: func(ctrl *gomock.Controller) usecase.ActionURLGenerator {
+					mock := mock_usecase.NewMockActionURLGenerator(ctrl)
+					mock.EXPECT().GenerateUploadURL("https://example.com", "test-owner", "test-repo", "1234567890123456789012345678901234567890123456789012345678901234").Return("https://example.com/test-owner/test-repo/objects/1234567890123456789012345678901234567890123456789012345678901234/upload")
+					mock.EXPECT().GenerateVerifyURL("https://example.com", "test-owner", "test-repo").Return("https://example.com/test-owner/test-repo/info/lfs/objects/verify")
 					return mock
 				},
+				storageKeyGenerator: func(ctrl *gomock.Controller) usecase.StorageKeyGenerator {
+					return mock_usecase.NewMockStorageKeyGenerator(ctrl)
+				},
+				s3Client: func(ctrl *gomock.Controller) usecase.S3Client {
+					return mock_usecase.NewMockS3Client(ctrl)
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					mock := mock_usecase.NewMockObjectStorage(ctrl)
+					mock.EXPECT().HeadObjectSize(gomock.Any(), "existing-storage-key-from-db").Return(int64(0), false, nil)
+					return mock
+				},
+			},
+			args: func() args {
+				oid, _ := domain.NewOID("1234567890123456789012345678901234567890123456789012345678901234")
+				size, _ := domain.NewSize(1024)
+				ctx := context.Background()
+				hashAlgo, _ := domain.NewHashAlgorithm("sha256")
+				obj, _ := domain.NewLFSObject(ctx, oid, size, hashAlgo, "existing-storage-key-from-db")
+				return args{
+					ctx:      context.Background(),
+					baseURL:  "https://example.com",
+					owner:    "test-owner",
+					repo:     "test-repo",
+					oid:      oid,
+					size:     size,
+					obj:      obj,
+					hashAlgo: "sha256",
+				}
+			}(),
+			want: func() usecase.ResponseObject {
+				uploadAction := usecase.NewAction("https://example.com/test-owner/test-repo/objects/1234567890123456789012345678901234567890123456789012345678901234/upload", nil, 900)
+				verifyAction := usecase.NewAction("https://example.com/test-owner/test-repo/info/lfs/objects/verify", nil, 900)
+				actions := usecase.NewActions(&uploadAction, nil, &verifyAction)
+				return usecase.NewResponseObject("1234567890123456789012345678901234567890123456789012345678901234", 1024, true, &actions, nil)
+			}(),
+		},
+		{
+			name: "正常系: 未完了でストレージのオブジェクトのサイズが異なる場合、アップロード済みとせずアップロードURLを返す",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					return mock_domain.NewMockLFSObjectRepository(ctrl)
+				},
 				actionURLGenerator: func(ctrl *gomock.Controller) usecase.ActionURLGenerator {
 					mock := mock_usecase.NewMockActionURLGenerator(ctrl)
 					mock.EXPECT().GenerateUploadURL("https://example.com", "test-owner", "test-repo", "1234567890123456789012345678901234567890123456789012345678901234").Return("https://example.com/test-owner/test-repo/objects/1234567890123456789012345678901234567890123456789012345678901234/upload")
+					mock.EXPECT().GenerateVerifyURL("https://example.com", "test-owner", "test-repo").Return("https://example.com/test-owner/test-repo/info/lfs/objects/verify")
 					return mock
 				},
 				storageKeyGenerator: func(ctrl *gomock.Controller) usecase.StorageKeyGenerator {
 					return mock_usecase.NewMockStorageKeyGenerator(ctrl)
 				},
+				s3Client: func(ctrl *gomock.Controller) usecase.S3Client {
+					return mock_usecase.NewMockS3Client(ctrl)
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					mock := mock_usecase.NewMockObjectStorage(ctrl)
+					mock.EXPECT().HeadObjectSize(gomock.Any(), "existing-storage-key-from-db").Return(int64(512), true, nil)
+					return mock
+				},
 			},
 			args: func() args {
 				oid, _ := domain.NewOID("1234567890123456789012345678901234567890123456789012345678901234")
 				size, _ := domain.NewSize(1024)
+				ctx := context.Background()
+				hashAlgo, _ := domain.NewHashAlgorithm("sha256")
+				obj, _ := domain.NewLFSObject(ctx, oid, size, hashAlgo, "existing-storage-key-from-db")
 				return args{
 					ctx:      context.Background(),
 					baseURL:  "https://example.com",
@@ -142,12 +211,14 @@ func TestUploadUseCase_HandleUploadObject(t *testing.T) {
 					repo:     "test-repo",
 					oid:      oid,
 					size:     size,
+					obj:      obj,
 					hashAlgo: "sha256",
 				}
 			}(),
 			want: func() usecase.ResponseObject {
 				uploadAction := usecase.NewAction("https://example.com/test-owner/test-repo/objects/1234567890123456789012345678901234567890123456789012345678901234/upload", nil, 900)
-				actions := usecase.NewActions(&uploadAction, nil)
+				verifyAction := usecase.NewAction("https://example.com/test-owner/test-repo/info/lfs/objects/verify", nil, 900)
+				actions := usecase.NewActions(&uploadAction, nil, &verifyAction)
 				return usecase.NewResponseObject("1234567890123456789012345678901234567890123456789012345678901234", 1024, true, &actions, nil)
 			}(),
 		},
@@ -156,7 +227,6 @@ func TestUploadUseCase_HandleUploadObject(t *testing.T) {
 			fields: fields{
 				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
 					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
-					mock.EXPECT().FindByOID(gomock.Any(), gomock.Any()).Return(nil, domain.ErrNotFound)
 					mock.EXPECT().Save(gomock.Any(), gomock.Any()).Return(errors.New("save error"))
 					return mock
 				},
@@ -168,6 +238,12 @@ func TestUploadUseCase_HandleUploadObject(t *testing.T) {
 					mock.EXPECT().GenerateStorageKey(gomock.Any(), gomock.Any()).Return("objects/sha256/12/34/1234567890123456789012345678901234567890123456789012345678901234", nil)
 					return mock
 				},
+				s3Client: func(ctrl *gomock.Controller) usecase.S3Client {
+					return mock_usecase.NewMockS3Client(ctrl)
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					return mock_usecase.NewMockObjectStorage(ctrl)
+				},
 			},
 			args: func() args {
 				oid, _ := domain.NewOID("1234567890123456789012345678901234567890123456789012345678901234")
@@ -179,6 +255,7 @@ func TestUploadUseCase_HandleUploadObject(t *testing.T) {
 					repo:     "test-repo",
 					oid:      oid,
 					size:     size,
+					obj:      nil,
 					hashAlgo: "sha256",
 				}
 			}(),
@@ -191,9 +268,7 @@ func TestUploadUseCase_HandleUploadObject(t *testing.T) {
 			name: "異常系: 無効なハッシュアルゴリズムの場合、400エラーが返る",
 			fields: fields{
 				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
-					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
-					mock.EXPECT().FindByOID(gomock.Any(), gomock.Any()).Return(nil, domain.ErrNotFound)
-					return mock
+					return mock_domain.NewMockLFSObjectRepository(ctrl)
 				},
 				actionURLGenerator: func(ctrl *gomock.Controller) usecase.ActionURLGenerator {
 					return mock_usecase.NewMockActionURLGenerator(ctrl)
@@ -203,6 +278,12 @@ func TestUploadUseCase_HandleUploadObject(t *testing.T) {
 					mock.EXPECT().GenerateStorageKey(gomock.Any(), gomock.Any()).Return("objects/invalid_algo/12/34/1234567890123456789012345678901234567890123456789012345678901234", nil)
 					return mock
 				},
+				s3Client: func(ctrl *gomock.Controller) usecase.S3Client {
+					return mock_usecase.NewMockS3Client(ctrl)
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					return mock_usecase.NewMockObjectStorage(ctrl)
+				},
 			},
 			args: func() args {
 				oid, _ := domain.NewOID("1234567890123456789012345678901234567890123456789012345678901234")
@@ -214,6 +295,7 @@ func TestUploadUseCase_HandleUploadObject(t *testing.T) {
 					repo:     "test-repo",
 					oid:      oid,
 					size:     size,
+					obj:      nil,
 					hashAlgo: "invalid_algo",
 				}
 			}(),
@@ -223,12 +305,10 @@ func TestUploadUseCase_HandleUploadObject(t *testing.T) {
 			}(),
 		},
 		{
-			name: "異常系: FindByOIDでNotFound以外のエラーが発生した場合、500エラーが返る",
+			name: "異常系: アップロード済みオブジェクトとサイズが一致しない場合、409エラーが返る",
 			fields: fields{
 				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
-					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
-					mock.EXPECT().FindByOID(gomock.Any(), gomock.Any()).Return(nil, errors.New("database connection error"))
-					return mock
+					return mock_domain.NewMockLFSObjectRepository(ctrl)
 				},
 				actionURLGenerator: func(ctrl *gomock.Controller) usecase.ActionURLGenerator {
 					return mock_usecase.NewMockActionURLGenerator(ctrl)
@@ -236,10 +316,21 @@ func TestUploadUseCase_HandleUploadObject(t *testing.T) {
 				storageKeyGenerator: func(ctrl *gomock.Controller) usecase.StorageKeyGenerator {
 					return mock_usecase.NewMockStorageKeyGenerator(ctrl)
 				},
+				s3Client: func(ctrl *gomock.Controller) usecase.S3Client {
+					return mock_usecase.NewMockS3Client(ctrl)
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					return mock_usecase.NewMockObjectStorage(ctrl)
+				},
 			},
 			args: func() args {
 				oid, _ := domain.NewOID("1234567890123456789012345678901234567890123456789012345678901234")
 				size, _ := domain.NewSize(1024)
+				ctx := context.Background()
+				storedSize, _ := domain.NewSize(2048)
+				hashAlgo, _ := domain.NewHashAlgorithm("sha256")
+				obj, _ := domain.NewLFSObject(ctx, oid, storedSize, hashAlgo, "objects/sha256/12/34/1234567890123456789012345678901234567890123456789012345678901234")
+				obj.MarkAsUploaded(ctx)
 				return args{
 					ctx:      context.Background(),
 					baseURL:  "https://example.com",
@@ -247,26 +338,21 @@ func TestUploadUseCase_HandleUploadObject(t *testing.T) {
 					repo:     "test-repo",
 					oid:      oid,
 					size:     size,
+					obj:      obj,
 					hashAlgo: "sha256",
 				}
 			}(),
 			want: func() usecase.ResponseObject {
-				objErr := usecase.NewObjectError(500, "メタデータの取得に失敗しました")
+				objErr := usecase.NewObjectError(409, "オブジェクトサイズが一致しません")
 				return usecase.NewResponseObject("1234567890123456789012345678901234567890123456789012345678901234", 1024, false, nil, &objErr)
 			}(),
 		},
 		{
-			name: "異常系: アップロード済みオブジェクトとサイズが一致しない場合、409エラーが返る",
+			name: "正常系: 未完了だがストレージに存在する場合、アップロード済みに更新されアクションなしで返る",
 			fields: fields{
 				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
 					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
-					ctx := context.Background()
-					oid, _ := domain.NewOID("1234567890123456789012345678901234567890123456789012345678901234")
-					storedSize, _ := domain.NewSize(2048)
-					hashAlgo, _ := domain.NewHashAlgorithm("sha256")
-					obj, _ := domain.NewLFSObject(ctx, oid, storedSize, hashAlgo, "objects/sha256/12/34/1234567890123456789012345678901234567890123456789012345678901234")
-					obj.MarkAsUploaded(ctx)
-					mock.EXPECT().FindByOID(gomock.Any(), gomock.Any()).Return(obj, nil)
+					mock.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
 					return mock
 				},
 				actionURLGenerator: func(ctrl *gomock.Controller) usecase.ActionURLGenerator {
@@ -275,10 +361,21 @@ func TestUploadUseCase_HandleUploadObject(t *testing.T) {
 				storageKeyGenerator: func(ctrl *gomock.Controller) usecase.StorageKeyGenerator {
 					return mock_usecase.NewMockStorageKeyGenerator(ctrl)
 				},
+				s3Client: func(ctrl *gomock.Controller) usecase.S3Client {
+					return mock_usecase.NewMockS3Client(ctrl)
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					mock := mock_usecase.NewMockObjectStorage(ctrl)
+					mock.EXPECT().HeadObjectSize(gomock.Any(), "existing-storage-key-from-db").Return(int64(1024), true, nil)
+					return mock
+				},
 			},
 			args: func() args {
 				oid, _ := domain.NewOID("1234567890123456789012345678901234567890123456789012345678901234")
 				size, _ := domain.NewSize(1024)
+				ctx := context.Background()
+				hashAlgo, _ := domain.NewHashAlgorithm("sha256")
+				obj, _ := domain.NewLFSObject(ctx, oid, size, hashAlgo, "existing-storage-key-from-db")
 				return args{
 					ctx:      context.Background(),
 					baseURL:  "https://example.com",
@@ -286,12 +383,108 @@ func TestUploadUseCase_HandleUploadObject(t *testing.T) {
 					repo:     "test-repo",
 					oid:      oid,
 					size:     size,
+					obj:      obj,
 					hashAlgo: "sha256",
 				}
 			}(),
+			want: usecase.NewResponseObject("1234567890123456789012345678901234567890123456789012345678901234", 1024, true, nil, nil),
+		},
+		{
+			name: "正常系: presignedモードの場合、署名付きURLがAuthorizationヘッダーなしで返る",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil)
+					return mock
+				},
+				actionURLGenerator: func(ctrl *gomock.Controller) usecase.ActionURLGenerator {
+					mock := mock_usecase.NewMockActionURLGenerator(ctrl)
+					mock.EXPECT().GenerateVerifyURL("https://example.com", "test-owner", "test-repo").Return("https://example.com/test-owner/test-repo/info/lfs/objects/verify")
+					return mock
+				},
+				storageKeyGenerator: func(ctrl *gomock.Controller) usecase.StorageKeyGenerator {
+					mock := mock_usecase.NewMockStorageKeyGenerator(ctrl)
+					mock.EXPECT().GenerateStorageKey(gomock.Any(), gomock.Any()).Return("objects/sha256/12/34/1234567890123456789012345678901234567890123456789012345678901234", nil)
+					return mock
+				},
+				s3Client: func(ctrl *gomock.Controller) usecase.S3Client {
+					mock := mock_usecase.NewMockS3Client(ctrl)
+					mock.EXPECT().GeneratePutURL(gomock.Any(), "objects/sha256/12/34/1234567890123456789012345678901234567890123456789012345678901234", int64(1024), "EjRWeJASNFZ4kBI0VniQEjRWeJASNFZ4kBI0VniQEjQ=", 10*time.Minute).Return("https://s3.example.com/presigned-put", map[string]string{}, nil)
+					return mock
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					return mock_usecase.NewMockObjectStorage(ctrl)
+				},
+				transferPolicy: usecase.NewTransferPolicy(domain.TransferModePresigned, 0, 10*time.Minute),
+			},
+			args: func() args {
+				oid, _ := domain.NewOID("1234567890123456789012345678901234567890123456789012345678901234")
+				size, _ := domain.NewSize(1024)
+				return args{
+					ctx:        context.Background(),
+					baseURL:    "https://example.com",
+					owner:      "test-owner",
+					repo:       "test-repo",
+					oid:        oid,
+					size:       size,
+					obj:        nil,
+					hashAlgo:   "sha256",
+					authHeader: "Bearer token",
+				}
+			}(),
 			want: func() usecase.ResponseObject {
-				objErr := usecase.NewObjectError(409, "オブジェクトサイズが一致しません")
-				return usecase.NewResponseObject("1234567890123456789012345678901234567890123456789012345678901234", 1024, false, nil, &objErr)
+				uploadAction := usecase.NewAction("https://s3.example.com/presigned-put", nil, 600)
+				verifyAction := usecase.NewAction("https://example.com/test-owner/test-repo/info/lfs/objects/verify", map[string]string{"Authorization": "Bearer token"}, 600)
+				actions := usecase.NewActions(&uploadAction, nil, &verifyAction)
+				return usecase.NewResponseObject("1234567890123456789012345678901234567890123456789012345678901234", 1024, true, &actions, nil)
+			}(),
+		},
+		{
+			name: "正常系: thresholdモードでしきい値未満の場合、プロキシURLが返る",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil)
+					return mock
+				},
+				actionURLGenerator: func(ctrl *gomock.Controller) usecase.ActionURLGenerator {
+					mock := mock_usecase.NewMockActionURLGenerator(ctrl)
+					mock.EXPECT().GenerateUploadURL(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("https://example.com/upload")
+					mock.EXPECT().GenerateVerifyURL("https://example.com", "test-owner", "test-repo").Return("https://example.com/test-owner/test-repo/info/lfs/objects/verify")
+					return mock
+				},
+				storageKeyGenerator: func(ctrl *gomock.Controller) usecase.StorageKeyGenerator {
+					mock := mock_usecase.NewMockStorageKeyGenerator(ctrl)
+					mock.EXPECT().GenerateStorageKey(gomock.Any(), gomock.Any()).Return("objects/sha256/12/34/1234567890123456789012345678901234567890123456789012345678901234", nil)
+					return mock
+				},
+				s3Client: func(ctrl *gomock.Controller) usecase.S3Client {
+					return mock_usecase.NewMockS3Client(ctrl)
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					return mock_usecase.NewMockObjectStorage(ctrl)
+				},
+				transferPolicy: usecase.NewTransferPolicy(domain.TransferModeThreshold, 2048, 10*time.Minute),
+			},
+			args: func() args {
+				oid, _ := domain.NewOID("1234567890123456789012345678901234567890123456789012345678901234")
+				size, _ := domain.NewSize(1024)
+				return args{
+					ctx:      context.Background(),
+					baseURL:  "https://example.com",
+					owner:    "test-owner",
+					repo:     "test-repo",
+					oid:      oid,
+					size:     size,
+					obj:      nil,
+					hashAlgo: "sha256",
+				}
+			}(),
+			want: func() usecase.ResponseObject {
+				uploadAction := usecase.NewAction("https://example.com/upload", nil, 900)
+				verifyAction := usecase.NewAction("https://example.com/test-owner/test-repo/info/lfs/objects/verify", nil, 900)
+				actions := usecase.NewActions(&uploadAction, nil, &verifyAction)
+				return usecase.NewResponseObject("1234567890123456789012345678901234567890123456789012345678901234", 1024, true, &actions, nil)
 			}(),
 		},
 	}
@@ -304,9 +497,12 @@ func TestUploadUseCase_HandleUploadObject(t *testing.T) {
 				tt.fields.repo(ctrl),
 				tt.fields.actionURLGenerator(ctrl),
 				tt.fields.storageKeyGenerator(ctrl),
+				tt.fields.s3Client(ctrl),
+				tt.fields.objectStorage(ctrl),
+				tt.fields.transferPolicy,
 			)
 
-			got := uc.HandleUploadObject(tt.args.ctx, tt.args.baseURL, tt.args.owner, tt.args.repo, tt.args.oid, tt.args.size, tt.args.hashAlgo, tt.args.authHeader)
+			got := uc.HandleUploadObject(tt.args.ctx, tt.args.baseURL, tt.args.owner, tt.args.repo, tt.args.oid, tt.args.size, tt.args.obj, tt.args.hashAlgo, tt.args.authHeader)
 
 			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(
 				usecase.ResponseObject{},
@@ -319,3 +515,105 @@ func TestUploadUseCase_HandleUploadObject(t *testing.T) {
 		})
 	}
 }
+
+func TestUploadUseCase_HandleProofUpload(t *testing.T) {
+	const testOID = "1234567890123456789012345678901234567890123456789012345678901234"
+	newStoredObject := func(size int64) *domain.LFSObject {
+		ctx := context.Background()
+		oid, _ := domain.NewOID(testOID)
+		s, _ := domain.NewSize(size)
+		hashAlgo, _ := domain.NewHashAlgorithm("sha256")
+		obj, _ := domain.NewLFSObject(ctx, oid, s, hashAlgo, "objects/sha256/12/34/"+testOID)
+		obj.MarkAsUploaded(ctx)
+		return obj
+	}
+
+	type fields struct {
+		actionURLGenerator func(ctrl *gomock.Controller) usecase.ActionURLGenerator
+		s3Client           func(ctrl *gomock.Controller) usecase.S3Client
+		transferPolicy     usecase.TransferPolicy
+	}
+	type args struct {
+		size       int64
+		obj        *domain.LFSObject
+		authHeader string
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		want   usecase.ResponseObject
+	}{
+		{
+			name: "正常系: 署名付きURLモードでもプロキシ経由のアップロードアクションのみが返る",
+			fields: fields{
+				actionURLGenerator: func(ctrl *gomock.Controller) usecase.ActionURLGenerator {
+					mock := mock_usecase.NewMockActionURLGenerator(ctrl)
+					mock.EXPECT().GenerateUploadURL("https://example.com", "test-owner", "test-repo", testOID).Return("https://example.com/test-owner/test-repo/info/lfs/objects/" + testOID)
+					return mock
+				},
+				s3Client: func(ctrl *gomock.Controller) usecase.S3Client {
+					return mock_usecase.NewMockS3Client(ctrl)
+				},
+				transferPolicy: usecase.NewTransferPolicy(domain.TransferModePresigned, 0, 10*time.Minute),
+			},
+			args: args{
+				size:       1024,
+				obj:        newStoredObject(1024),
+				authHeader: "Bearer token",
+			},
+			want: func() usecase.ResponseObject {
+				uploadAction := usecase.NewAction("https://example.com/test-owner/test-repo/info/lfs/objects/"+testOID, map[string]string{"Authorization": "Bearer token"}, 900)
+				actions := usecase.NewActions(&uploadAction, nil, nil)
+				return usecase.NewResponseObject(testOID, 1024, true, &actions, nil)
+			}(),
+		},
+		{
+			name: "異常系: 登録済みのサイズと一致しない場合、409エラーが返る",
+			fields: fields{
+				actionURLGenerator: func(ctrl *gomock.Controller) usecase.ActionURLGenerator {
+					return mock_usecase.NewMockActionURLGenerator(ctrl)
+				},
+				s3Client: func(ctrl *gomock.Controller) usecase.S3Client {
+					return mock_usecase.NewMockS3Client(ctrl)
+				},
+			},
+			args: args{
+				size: 2048,
+				obj:  newStoredObject(1024),
+			},
+			want: func() usecase.ResponseObject {
+				objectError := usecase.NewObjectError(409, "オブジェクトサイズが一致しません")
+				return usecase.NewResponseObject(testOID, 2048, false, nil, &objectError)
+			}(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			uc := usecase.NewUploadUseCase(
+				mock_domain.NewMockLFSObjectRepository(ctrl),
+				tt.fields.actionURLGenerator(ctrl),
+				mock_usecase.NewMockStorageKeyGenerator(ctrl),
+				tt.fields.s3Client(ctrl),
+				mock_usecase.NewMockObjectStorage(ctrl),
+				tt.fields.transferPolicy,
+			)
+
+			oid, _ := domain.NewOID(testOID)
+			size, _ := domain.NewSize(tt.args.size)
+			got := uc.HandleProofUpload(context.Background(), "https://example.com", "test-owner", "test-repo", oid, size, tt.args.obj, tt.args.authHeader)
+
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(
+				usecase.ResponseObject{},
+				usecase.Actions{},
+				usecase.Action{},
+				usecase.ObjectError{},
+			)); diff != "" {
+				t.Errorf("HandleProofUpload() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -12,25 +12,33 @@ import (
 //go:generate mockgen -source=$GOFILE -destination=../../tests/usecase/mock_verify_usecase.go -package=usecase
 
 type VerifyUseCaseInterface interface {
-	VerifyUpload(ctx context.Context, oid string, size int64) error
+	VerifyUpload(ctx context.Context, repository *domain.RepositoryIdentifier, oid string, size int64) error
 }
 
 type VerifyUseCase struct {
 	repo            domain.LFSObjectRepository
 	cacheKeyManager CacheKeyManager
+	objectStorage   ObjectStorage
+	authService     domain.AccessAuthorizationService
 }
 
 func NewVerifyUseCase(
 	repo domain.LFSObjectRepository,
 	cacheKeyManager CacheKeyManager,
+	objectStorage ObjectStorage,
+	authService domain.AccessAuthorizationService,
 ) *VerifyUseCase {
 	return &VerifyUseCase{
 		repo:            repo,
 		cacheKeyManager: cacheKeyManager,
+		objectStorage:   objectStorage,
+		authService:     authService,
 	}
 }
 
-func (uc *VerifyUseCase) VerifyUpload(ctx context.Context, oid string, size int64) error {
+// VerifyUpload はrepositoryに紐付けられたオブジェクトのアップロード完了を確認する
+// 紐付けのないオブジェクトは、存在を推測されないよう存在しない場合と同じErrObjectNotFoundを返す
+func (uc *VerifyUseCase) VerifyUpload(ctx context.Context, repository *domain.RepositoryIdentifier, oid string, size int64) error {
 	domainOID, err := domain.NewOID(oid)
 	if err != nil {
 		if errors.Is(err, domain.ErrInvalidOIDFormat) {
@@ -47,6 +55,17 @@ func (uc *VerifyUseCase) VerifyUpload(ctx context.Context, oid string, size int6
 		return fmt.Errorf("サイズの検証に失敗しました: %w", err)
 	}
 
+	canAccess, err := uc.authService.CanAccess(ctx, repository, domainOID)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidRepositoryIdentifier) {
+			return ErrObjectNotFound
+		}
+		return fmt.Errorf("認可判定に失敗しました: %w", err)
+	}
+	if !canAccess {
+		return ErrObjectNotFound
+	}
+
 	obj, err := uc.repo.FindByOID(ctx, domainOID)
 	if err != nil {
 		if errors.Is(err, domain.ErrNotFound) {
@@ -59,6 +78,18 @@ func (uc *VerifyUseCase) VerifyUpload(ctx context.Context, oid string, size int6
 		return ErrSizeMismatch
 	}
 
+	// メタデータではなくストレージ上の実体を確認する
+	storedSize, exists, err := uc.objectStorage.HeadObjectSize(ctx, obj.GetStorageKey())
+	if err != nil {
+		return fmt.Errorf("ストレージ上のオブジェクトの確認に失敗しました: %w", err)
+	}
+	if !exists {
+		return ErrNotUploaded
+	}
+	if storedSize != domainSize.Int64() {
+		return ErrSizeMismatch
+	}
+
 	obj.MarkAsUploaded(ctx)
 
 	if err := uc.repo.Update(ctx, obj); err != nil {
@@ -17,12 +17,16 @@ func TestVerifyUseCase_VerifyUpload(t *testing.T) {
 	type fields struct {
 		repo            func(ctrl *gomock.Controller) domain.LFSObjectRepository
 		cacheKeyManager func(ctrl *gomock.Controller) usecase.CacheKeyManager
+		objectStorage   func(ctrl *gomock.Controller) usecase.ObjectStorage
+		// authService が nil の場合は、リポジトリにオブジェクトが紐付けられているものとする
+		authService func(ctrl *gomock.Controller) domain.AccessAuthorizationService
 	}
 	type args struct {
 		ctx  context.Context
 		oid  string
 		size int64
 	}
+	repository, _ := domain.NewRepositoryIdentifier("owner/repo")
 	tests := []struct {
 		name    string
 		fields  fields
@@ -49,6 +53,11 @@ func TestVerifyUseCase_VerifyUpload(t *testing.T) {
 					mock.EXPECT().DeleteBatchUploadKey(gomock.Any(), "a1b2c3d4e5f6789012345678901234567890123456789012345678901234abcd").Return(nil)
 					return mock
 				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					mock := mock_usecase.NewMockObjectStorage(ctrl)
+					mock.EXPECT().HeadObjectSize(gomock.Any(), "test-storage-key").Return(int64(1024), true, nil)
+					return mock
+				},
 			},
 			args: args{
 				ctx:  context.Background(),
@@ -66,6 +75,9 @@ func TestVerifyUseCase_VerifyUpload(t *testing.T) {
 				cacheKeyManager: func(ctrl *gomock.Controller) usecase.CacheKeyManager {
 					return mock_usecase.NewMockCacheKeyManager(ctrl)
 				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					return mock_usecase.NewMockObjectStorage(ctrl)
+				},
 			},
 			args: args{
 				ctx:  context.Background(),
@@ -83,6 +95,9 @@ func TestVerifyUseCase_VerifyUpload(t *testing.T) {
 				cacheKeyManager: func(ctrl *gomock.Controller) usecase.CacheKeyManager {
 					return mock_usecase.NewMockCacheKeyManager(ctrl)
 				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					return mock_usecase.NewMockObjectStorage(ctrl)
+				},
 			},
 			args: args{
 				ctx:  context.Background(),
@@ -91,6 +106,56 @@ func TestVerifyUseCase_VerifyUpload(t *testing.T) {
 			},
 			wantErr: usecase.ErrInvalidSize,
 		},
+		{
+			name: "異常系: リポジトリにオブジェクトが紐付けられていない場合、存在しない場合と同じErrObjectNotFoundが返る",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					return mock_domain.NewMockLFSObjectRepository(ctrl)
+				},
+				cacheKeyManager: func(ctrl *gomock.Controller) usecase.CacheKeyManager {
+					return mock_usecase.NewMockCacheKeyManager(ctrl)
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					return mock_usecase.NewMockObjectStorage(ctrl)
+				},
+				authService: func(ctrl *gomock.Controller) domain.AccessAuthorizationService {
+					mock := mock_domain.NewMockAccessAuthorizationService(ctrl)
+					mock.EXPECT().CanAccess(gomock.Any(), repository, gomock.Any()).Return(false, nil)
+					return mock
+				},
+			},
+			args: args{
+				ctx:  context.Background(),
+				oid:  "a1b2c3d4e5f6789012345678901234567890123456789012345678901234abcd",
+				size: 1024,
+			},
+			wantErr: usecase.ErrObjectNotFound,
+		},
+		{
+			name: "異常系: 認可判定に失敗した場合、エラーが返る",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					return mock_domain.NewMockLFSObjectRepository(ctrl)
+				},
+				cacheKeyManager: func(ctrl *gomock.Controller) usecase.CacheKeyManager {
+					return mock_usecase.NewMockCacheKeyManager(ctrl)
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					return mock_usecase.NewMockObjectStorage(ctrl)
+				},
+				authService: func(ctrl *gomock.Controller) domain.AccessAuthorizationService {
+					mock := mock_domain.NewMockAccessAuthorizationService(ctrl)
+					mock.EXPECT().CanAccess(gomock.Any(), gomock.Any(), gomock.Any()).Return(false, errors.New("connection error"))
+					return mock
+				},
+			},
+			args: args{
+				ctx:  context.Background(),
+				oid:  "a1b2c3d4e5f6789012345678901234567890123456789012345678901234abcd",
+				size: 1024,
+			},
+			wantErr: errors.New("認可判定に失敗しました: connection error"),
+		},
 		{
 			name: "異常系: オブジェクトが存在しない場合、ErrObjectNotFoundが返る",
 			fields: fields{
@@ -102,6 +167,9 @@ func TestVerifyUseCase_VerifyUpload(t *testing.T) {
 				cacheKeyManager: func(ctrl *gomock.Controller) usecase.CacheKeyManager {
 					return mock_usecase.NewMockCacheKeyManager(ctrl)
 				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					return mock_usecase.NewMockObjectStorage(ctrl)
+				},
 			},
 			args: args{
 				ctx:  context.Background(),
@@ -127,6 +195,9 @@ func TestVerifyUseCase_VerifyUpload(t *testing.T) {
 				cacheKeyManager: func(ctrl *gomock.Controller) usecase.CacheKeyManager {
 					return mock_usecase.NewMockCacheKeyManager(ctrl)
 				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					return mock_usecase.NewMockObjectStorage(ctrl)
+				},
 			},
 			args: args{
 				ctx:  context.Background(),
@@ -135,6 +206,96 @@ func TestVerifyUseCase_VerifyUpload(t *testing.T) {
 			},
 			wantErr: usecase.ErrSizeMismatch,
 		},
+		{
+			name: "異常系: ストレージにオブジェクトが存在しない場合、ErrNotUploadedが返る",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					ctx := context.Background()
+					oid, _ := domain.NewOID("a1b2c3d4e5f6789012345678901234567890123456789012345678901234abcd")
+					size, _ := domain.NewSize(1024)
+					hashAlgo, _ := domain.NewHashAlgorithm("sha256")
+					obj, _ := domain.NewLFSObject(ctx, oid, size, hashAlgo, "test-storage-key")
+
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().FindByOID(gomock.Any(), gomock.Any()).Return(obj, nil)
+					return mock
+				},
+				cacheKeyManager: func(ctrl *gomock.Controller) usecase.CacheKeyManager {
+					return mock_usecase.NewMockCacheKeyManager(ctrl)
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					mock := mock_usecase.NewMockObjectStorage(ctrl)
+					mock.EXPECT().HeadObjectSize(gomock.Any(), "test-storage-key").Return(int64(0), false, nil)
+					return mock
+				},
+			},
+			args: args{
+				ctx:  context.Background(),
+				oid:  "a1b2c3d4e5f6789012345678901234567890123456789012345678901234abcd",
+				size: 1024,
+			},
+			wantErr: usecase.ErrNotUploaded,
+		},
+		{
+			name: "異常系: ストレージ上のサイズが一致しない場合、ErrSizeMismatchが返る",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					ctx := context.Background()
+					oid, _ := domain.NewOID("a1b2c3d4e5f6789012345678901234567890123456789012345678901234abcd")
+					size, _ := domain.NewSize(1024)
+					hashAlgo, _ := domain.NewHashAlgorithm("sha256")
+					obj, _ := domain.NewLFSObject(ctx, oid, size, hashAlgo, "test-storage-key")
+
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().FindByOID(gomock.Any(), gomock.Any()).Return(obj, nil)
+					return mock
+				},
+				cacheKeyManager: func(ctrl *gomock.Controller) usecase.CacheKeyManager {
+					return mock_usecase.NewMockCacheKeyManager(ctrl)
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					mock := mock_usecase.NewMockObjectStorage(ctrl)
+					mock.EXPECT().HeadObjectSize(gomock.Any(), "test-storage-key").Return(int64(512), true, nil)
+					return mock
+				},
+			},
+			args: args{
+				ctx:  context.Background(),
+				oid:  "a1b2c3d4e5f6789012345678901234567890123456789012345678901234abcd",
+				size: 1024,
+			},
+			wantErr: usecase.ErrSizeMismatch,
+		},
+		{
+			name: "異常系: ストレージの確認に失敗した場合、エラーが返る",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) domain.LFSObjectRepository {
+					ctx := context.Background()
+					oid, _ := domain.NewOID("a1b2c3d4e5f6789012345678901234567890123456789012345678901234abcd")
+					size, _ := domain.NewSize(1024)
+					hashAlgo, _ := domain.NewHashAlgorithm("sha256")
+					obj, _ := domain.NewLFSObject(ctx, oid, size, hashAlgo, "test-storage-key")
+
+					mock := mock_domain.NewMockLFSObjectRepository(ctrl)
+					mock.EXPECT().FindByOID(gomock.Any(), gomock.Any()).Return(obj, nil)
+					return mock
+				},
+				cacheKeyManager: func(ctrl *gomock.Controller) usecase.CacheKeyManager {
+					return mock_usecase.NewMockCacheKeyManager(ctrl)
+				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					mock := mock_usecase.NewMockObjectStorage(ctrl)
+					mock.EXPECT().HeadObjectSize(gomock.Any(), "test-storage-key").Return(int64(0), false, errors.New("head failed"))
+					return mock
+				},
+			},
+			args: args{
+				ctx:  context.Background(),
+				oid:  "a1b2c3d4e5f6789012345678901234567890123456789012345678901234abcd",
+				size: 1024,
+			},
+			wantErr: errors.New("ストレージ上のオブジェクトの確認に失敗しました: head failed"),
+		},
 		{
 			name: "異常系: PostgreSQLの更新に失敗した場合、エラーが返る",
 			fields: fields{
@@ -153,6 +314,11 @@ func TestVerifyUseCase_VerifyUpload(t *testing.T) {
 				cacheKeyManager: func(ctrl *gomock.Controller) usecase.CacheKeyManager {
 					return mock_usecase.NewMockCacheKeyManager(ctrl)
 				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					mock := mock_usecase.NewMockObjectStorage(ctrl)
+					mock.EXPECT().HeadObjectSize(gomock.Any(), "test-storage-key").Return(int64(1024), true, nil)
+					return mock
+				},
 			},
 			args: args{
 				ctx:  context.Background(),
@@ -181,6 +347,11 @@ func TestVerifyUseCase_VerifyUpload(t *testing.T) {
 					mock.EXPECT().DeleteBatchUploadKey(gomock.Any(), gomock.Any()).Return(errors.New("delete error"))
 					return mock
 				},
+				objectStorage: func(ctrl *gomock.Controller) usecase.ObjectStorage {
+					mock := mock_usecase.NewMockObjectStorage(ctrl)
+					mock.EXPECT().HeadObjectSize(gomock.Any(), "test-storage-key").Return(int64(1024), true, nil)
+					return mock
+				},
 			},
 			args: args{
 				ctx:  context.Background(),
@@ -197,10 +368,19 @@ func TestVerifyUseCase_VerifyUpload(t *testing.T) {
 
 			repo := tt.fields.repo(ctrl)
 			cacheKeyManager := tt.fields.cacheKeyManager(ctrl)
+			objectStorage := tt.fields.objectStorage(ctrl)
+			var authService domain.AccessAuthorizationService
+			if tt.fields.authService != nil {
+				authService = tt.fields.authService(ctrl)
+			} else {
+				mock := mock_domain.NewMockAccessAuthorizationService(ctrl)
+				mock.EXPECT().CanAccess(gomock.Any(), repository, gomock.Any()).Return(true, nil).AnyTimes()
+				authService = mock
+			}
 
-			uc := usecase.NewVerifyUseCase(repo, cacheKeyManager)
+			uc := usecase.NewVerifyUseCase(repo, cacheKeyManager, objectStorage, authService)
 
-			err := uc.VerifyUpload(tt.args.ctx, tt.args.oid, tt.args.size)
+			err := uc.VerifyUpload(tt.args.ctx, repository, tt.args.oid, tt.args.size)
 
 			if tt.wantErr != nil {
 				if err == nil {
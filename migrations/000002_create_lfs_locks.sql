@@ -0,0 +1,19 @@
+-- +goose Up
+-- Git LFS File Locking API 用のロックテーブルを作成
+
+CREATE TABLE lfs_locks (
+	id BIGSERIAL PRIMARY KEY,
+	repository VARCHAR(255) NOT NULL,
+	path TEXT NOT NULL,
+	ref_name TEXT NOT NULL DEFAULT '',
+	owner_sub VARCHAR(255) NOT NULL,
+	owner_name VARCHAR(255) NOT NULL,
+	locked_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	UNIQUE(repository, path)
+);
+
+-- リポジトリ単位のカーソルページネーション用インデックス
+CREATE INDEX idx_lfs_locks_repository_id ON lfs_locks(repository, id);
+
+-- +goose Down
+DROP TABLE IF EXISTS lfs_locks;
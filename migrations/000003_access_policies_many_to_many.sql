@@ -0,0 +1,27 @@
+-- +goose Up
+-- LFSオブジェクトとリポジトリの紐付けを多対多に変更
+-- 同一内容のオブジェクトをS3上で重複させずに、リポジトリごとにアクセス権を付与できるようにする
+
+ALTER TABLE lfs_object_access_policies
+	DROP CONSTRAINT lfs_object_access_policies_lfs_object_oid_key;
+
+ALTER TABLE lfs_object_access_policies
+	ADD CONSTRAINT lfs_object_access_policies_oid_repository_key UNIQUE (lfs_object_oid, repository);
+
+-- OID検索は複合一意制約のインデックスで賄えるため削除
+DROP INDEX IF EXISTS idx_access_policies_oid;
+
+-- +goose Down
+-- 1つのOIDに複数リポジトリが紐付いている場合は、最も古い紐付けのみを残す
+DELETE FROM lfs_object_access_policies p
+	USING lfs_object_access_policies q
+	WHERE p.lfs_object_oid = q.lfs_object_oid
+	AND p.id > q.id;
+
+ALTER TABLE lfs_object_access_policies
+	DROP CONSTRAINT lfs_object_access_policies_oid_repository_key;
+
+ALTER TABLE lfs_object_access_policies
+	ADD CONSTRAINT lfs_object_access_policies_lfs_object_oid_key UNIQUE (lfs_object_oid);
+
+CREATE INDEX idx_access_policies_oid ON lfs_object_access_policies(lfs_object_oid);
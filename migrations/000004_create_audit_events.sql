@@ -0,0 +1,28 @@
+-- +goose Up
+-- オブジェクトへのアクセスと認証失敗を記録する監査ログテーブルを作成
+
+CREATE TABLE audit_events (
+	id BIGSERIAL PRIMARY KEY,
+	occurred_at TIMESTAMP NOT NULL,
+	action VARCHAR(32) NOT NULL,
+	outcome VARCHAR(16) NOT NULL,
+	status_code INTEGER NOT NULL,
+	subject VARCHAR(255) NOT NULL DEFAULT '',
+	actor_name VARCHAR(255) NOT NULL DEFAULT '',
+	provider VARCHAR(32) NOT NULL DEFAULT '',
+	repository VARCHAR(255) NOT NULL DEFAULT '',
+	ref TEXT NOT NULL DEFAULT '',
+	oid VARCHAR(64) NOT NULL DEFAULT '',
+	size BIGINT NOT NULL DEFAULT 0,
+	client_ip VARCHAR(45) NOT NULL DEFAULT '',
+	request_id VARCHAR(64) NOT NULL DEFAULT ''
+);
+
+-- 保持期間を過ぎたイベントの削除用インデックス
+CREATE INDEX idx_audit_events_occurred_at ON audit_events(occurred_at);
+
+-- オブジェクト単位のアクセス履歴の検索用インデックス
+CREATE INDEX idx_audit_events_oid ON audit_events(oid, occurred_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS audit_events;
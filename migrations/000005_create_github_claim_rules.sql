@@ -0,0 +1,21 @@
+-- +goose Up
+-- GitHub ActionsのOIDCトークンのクレームに応じてリポジトリごとの権限を決めるルールテーブルを作成
+-- パターンの配列が空の項目は任意の値に一致する
+
+CREATE TABLE github_claim_rules (
+	id BIGSERIAL PRIMARY KEY,
+	repository VARCHAR(255) NOT NULL,
+	priority INTEGER NOT NULL DEFAULT 0,
+	refs TEXT[] NOT NULL DEFAULT '{}',
+	event_names TEXT[] NOT NULL DEFAULT '{}',
+	environments TEXT[] NOT NULL DEFAULT '{}',
+	job_workflow_refs TEXT[] NOT NULL DEFAULT '{}',
+	access VARCHAR(16) NOT NULL CHECK (access IN ('admin', 'write', 'read', 'none')),
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- 認証時にリポジトリ単位で評価順に取得するためのインデックス
+CREATE INDEX idx_github_claim_rules_repository ON github_claim_rules(repository, priority, id);
+
+-- +goose Down
+DROP TABLE IF EXISTS github_claim_rules;
@@ -0,0 +1,21 @@
+-- +goose Up
+-- ブラウザでのOAuth認証を使えない環境向けに、cargoholdが発行するアクセストークンのテーブルを作成
+-- トークンはSHA-256のハッシュのみを保存する
+
+CREATE TABLE access_tokens (
+	id BIGSERIAL PRIMARY KEY,
+	name VARCHAR(255) NOT NULL,
+	created_by VARCHAR(255) NOT NULL,
+	repositories TEXT[] NOT NULL,
+	operations TEXT[] NOT NULL,
+	token_hash CHAR(64) NOT NULL UNIQUE,
+	expires_at TIMESTAMP NOT NULL,
+	last_used_at TIMESTAMP,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- 発行者ごとの一覧取得のためのインデックス
+CREATE INDEX idx_access_tokens_created_by ON access_tokens(created_by, created_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS access_tokens;
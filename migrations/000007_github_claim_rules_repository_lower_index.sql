@@ -0,0 +1,9 @@
+-- +goose Up
+-- クレームルールはリポジトリ名の大文字小文字を区別せずに取得するため、LOWER(repository)のインデックスに置き換える
+
+DROP INDEX IF EXISTS idx_github_claim_rules_repository;
+CREATE INDEX idx_github_claim_rules_repository ON github_claim_rules(LOWER(repository), priority, id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_github_claim_rules_repository;
+CREATE INDEX idx_github_claim_rules_repository ON github_claim_rules(repository, priority, id);
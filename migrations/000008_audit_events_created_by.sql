@@ -0,0 +1,8 @@
+-- +goose Up
+-- アクセストークンで認証したイベントについて、トークンの発行者を記録する列を追加
+-- subjectはトークンごとに異なる値となるため、発行者単位の追跡にはこの列を使用する
+
+ALTER TABLE audit_events ADD COLUMN created_by VARCHAR(255) NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE audit_events DROP COLUMN IF EXISTS created_by;
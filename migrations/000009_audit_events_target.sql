@@ -0,0 +1,7 @@
+-- +goose Up
+-- ロックや管理APIの操作について、ロックのパスやアクセストークンのIDなどの操作対象を記録する列を追加
+
+ALTER TABLE audit_events ADD COLUMN target TEXT NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE audit_events DROP COLUMN IF EXISTS target;
@@ -56,6 +56,21 @@ func (mr *MockAccessAuthorizationServiceMockRecorder) Authorize(ctx, operation,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*MockAccessAuthorizationService)(nil).Authorize), ctx, operation, userRepo, oid)
 }
 
+// AuthorizeAll mocks base method.
+func (m *MockAccessAuthorizationService) AuthorizeAll(ctx context.Context, operation domain.Operation, userRepo *domain.RepositoryIdentifier, oids []domain.OID) (map[domain.OID]domain.AuthorizationResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthorizeAll", ctx, operation, userRepo, oids)
+	ret0, _ := ret[0].(map[domain.OID]domain.AuthorizationResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthorizeAll indicates an expected call of AuthorizeAll.
+func (mr *MockAccessAuthorizationServiceMockRecorder) AuthorizeAll(ctx, operation, userRepo, oids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizeAll", reflect.TypeOf((*MockAccessAuthorizationService)(nil).AuthorizeAll), ctx, operation, userRepo, oids)
+}
+
 // CanAccess mocks base method.
 func (m *MockAccessAuthorizationService) CanAccess(ctx context.Context, userRepo *domain.RepositoryIdentifier, oid domain.OID) (bool, error) {
 	m.ctrl.T.Helper()
@@ -56,10 +56,10 @@ func (mr *MockAccessPolicyRepositoryMockRecorder) Delete(ctx, oid any) *gomock.C
 }
 
 // FindByOID mocks base method.
-func (m *MockAccessPolicyRepository) FindByOID(ctx context.Context, oid domain.OID) (*domain.AccessPolicy, error) {
+func (m *MockAccessPolicyRepository) FindByOID(ctx context.Context, oid domain.OID) ([]*domain.AccessPolicy, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "FindByOID", ctx, oid)
-	ret0, _ := ret[0].(*domain.AccessPolicy)
+	ret0, _ := ret[0].([]*domain.AccessPolicy)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -70,6 +70,36 @@ func (mr *MockAccessPolicyRepositoryMockRecorder) FindByOID(ctx, oid any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByOID", reflect.TypeOf((*MockAccessPolicyRepository)(nil).FindByOID), ctx, oid)
 }
 
+// FindByOIDAndRepository mocks base method.
+func (m *MockAccessPolicyRepository) FindByOIDAndRepository(ctx context.Context, oid domain.OID, repository *domain.RepositoryIdentifier) (*domain.AccessPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByOIDAndRepository", ctx, oid, repository)
+	ret0, _ := ret[0].(*domain.AccessPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByOIDAndRepository indicates an expected call of FindByOIDAndRepository.
+func (mr *MockAccessPolicyRepositoryMockRecorder) FindByOIDAndRepository(ctx, oid, repository any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByOIDAndRepository", reflect.TypeOf((*MockAccessPolicyRepository)(nil).FindByOIDAndRepository), ctx, oid, repository)
+}
+
+// FindByOIDsAndRepository mocks base method.
+func (m *MockAccessPolicyRepository) FindByOIDsAndRepository(ctx context.Context, oids []domain.OID, repository *domain.RepositoryIdentifier) (map[domain.OID]*domain.AccessPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByOIDsAndRepository", ctx, oids, repository)
+	ret0, _ := ret[0].(map[domain.OID]*domain.AccessPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByOIDsAndRepository indicates an expected call of FindByOIDsAndRepository.
+func (mr *MockAccessPolicyRepositoryMockRecorder) FindByOIDsAndRepository(ctx, oids, repository any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByOIDsAndRepository", reflect.TypeOf((*MockAccessPolicyRepository)(nil).FindByOIDsAndRepository), ctx, oids, repository)
+}
+
 // Save mocks base method.
 func (m *MockAccessPolicyRepository) Save(ctx context.Context, policy *domain.AccessPolicy) error {
 	m.ctrl.T.Helper()
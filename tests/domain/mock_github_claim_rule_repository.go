@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github_claim_rule_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=github_claim_rule_repository.go -destination=../../tests/domain/mock_github_claim_rule_repository.go -package=domain
+//
+
+// Package domain is a generated GoMock package.
+package domain
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/na2na-p/cargohold/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGitHubClaimRuleRepository is a mock of GitHubClaimRuleRepository interface.
+type MockGitHubClaimRuleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGitHubClaimRuleRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockGitHubClaimRuleRepositoryMockRecorder is the mock recorder for MockGitHubClaimRuleRepository.
+type MockGitHubClaimRuleRepositoryMockRecorder struct {
+	mock *MockGitHubClaimRuleRepository
+}
+
+// NewMockGitHubClaimRuleRepository creates a new mock instance.
+func NewMockGitHubClaimRuleRepository(ctrl *gomock.Controller) *MockGitHubClaimRuleRepository {
+	mock := &MockGitHubClaimRuleRepository{ctrl: ctrl}
+	mock.recorder = &MockGitHubClaimRuleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGitHubClaimRuleRepository) EXPECT() *MockGitHubClaimRuleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockGitHubClaimRuleRepository) Create(ctx context.Context, rule *domain.GitHubClaimRule) (*domain.GitHubClaimRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, rule)
+	ret0, _ := ret[0].(*domain.GitHubClaimRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockGitHubClaimRuleRepositoryMockRecorder) Create(ctx, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockGitHubClaimRuleRepository)(nil).Create), ctx, rule)
+}
+
+// Delete mocks base method.
+func (m *MockGitHubClaimRuleRepository) Delete(ctx context.Context, repository *domain.RepositoryIdentifier, id domain.GitHubClaimRuleID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, repository, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockGitHubClaimRuleRepositoryMockRecorder) Delete(ctx, repository, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockGitHubClaimRuleRepository)(nil).Delete), ctx, repository, id)
+}
+
+// FindByRepository mocks base method.
+func (m *MockGitHubClaimRuleRepository) FindByRepository(ctx context.Context, repository *domain.RepositoryIdentifier) ([]*domain.GitHubClaimRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByRepository", ctx, repository)
+	ret0, _ := ret[0].([]*domain.GitHubClaimRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByRepository indicates an expected call of FindByRepository.
+func (mr *MockGitHubClaimRuleRepositoryMockRecorder) FindByRepository(ctx, repository any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByRepository", reflect.TypeOf((*MockGitHubClaimRuleRepository)(nil).FindByRepository), ctx, repository)
+}
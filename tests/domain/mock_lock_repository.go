@@ -0,0 +1,117 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: lock_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=lock_repository.go -destination=../../tests/domain/mock_lock_repository.go -package=domain
+//
+
+// Package domain is a generated GoMock package.
+package domain
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/na2na-p/cargohold/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLockRepository is a mock of LockRepository interface.
+type MockLockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLockRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLockRepositoryMockRecorder is the mock recorder for MockLockRepository.
+type MockLockRepositoryMockRecorder struct {
+	mock *MockLockRepository
+}
+
+// NewMockLockRepository creates a new mock instance.
+func NewMockLockRepository(ctrl *gomock.Controller) *MockLockRepository {
+	mock := &MockLockRepository{ctrl: ctrl}
+	mock.recorder = &MockLockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLockRepository) EXPECT() *MockLockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockLockRepository) Create(ctx context.Context, lock *domain.Lock) (*domain.Lock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, lock)
+	ret0, _ := ret[0].(*domain.Lock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockLockRepositoryMockRecorder) Create(ctx, lock any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockLockRepository)(nil).Create), ctx, lock)
+}
+
+// Delete mocks base method.
+func (m *MockLockRepository) Delete(ctx context.Context, repository *domain.RepositoryIdentifier, id domain.LockID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, repository, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockLockRepositoryMockRecorder) Delete(ctx, repository, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockLockRepository)(nil).Delete), ctx, repository, id)
+}
+
+// FindByID mocks base method.
+func (m *MockLockRepository) FindByID(ctx context.Context, repository *domain.RepositoryIdentifier, id domain.LockID) (*domain.Lock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, repository, id)
+	ret0, _ := ret[0].(*domain.Lock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockLockRepositoryMockRecorder) FindByID(ctx, repository, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockLockRepository)(nil).FindByID), ctx, repository, id)
+}
+
+// FindByPath mocks base method.
+func (m *MockLockRepository) FindByPath(ctx context.Context, repository *domain.RepositoryIdentifier, path domain.LockPath) (*domain.Lock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByPath", ctx, repository, path)
+	ret0, _ := ret[0].(*domain.Lock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByPath indicates an expected call of FindByPath.
+func (mr *MockLockRepositoryMockRecorder) FindByPath(ctx, repository, path any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByPath", reflect.TypeOf((*MockLockRepository)(nil).FindByPath), ctx, repository, path)
+}
+
+// List mocks base method.
+func (m *MockLockRepository) List(ctx context.Context, repository *domain.RepositoryIdentifier, filter domain.LockListFilter) ([]*domain.Lock, domain.LockID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, repository, filter)
+	ret0, _ := ret[0].([]*domain.Lock)
+	ret1, _ := ret[1].(domain.LockID)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockLockRepositoryMockRecorder) List(ctx, repository, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockLockRepository)(nil).List), ctx, repository, filter)
+}
@@ -12,6 +12,7 @@ package domain
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	domain "github.com/na2na-p/cargohold/internal/domain"
 	gomock "go.uber.org/mock/gomock"
@@ -41,6 +42,35 @@ func (m *MockLFSObjectRepository) EXPECT() *MockLFSObjectRepositoryMockRecorder
 	return m.recorder
 }
 
+// Delete mocks base method.
+func (m *MockLFSObjectRepository) Delete(ctx context.Context, oid domain.OID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, oid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockLFSObjectRepositoryMockRecorder) Delete(ctx, oid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockLFSObjectRepository)(nil).Delete), ctx, oid)
+}
+
+// DeleteStaleNotUploaded mocks base method.
+func (m *MockLFSObjectRepository) DeleteStaleNotUploaded(ctx context.Context, oid domain.OID, olderThan time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStaleNotUploaded", ctx, oid, olderThan)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteStaleNotUploaded indicates an expected call of DeleteStaleNotUploaded.
+func (mr *MockLFSObjectRepositoryMockRecorder) DeleteStaleNotUploaded(ctx, oid, olderThan any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStaleNotUploaded", reflect.TypeOf((*MockLFSObjectRepository)(nil).DeleteStaleNotUploaded), ctx, oid, olderThan)
+}
+
 // ExistsByOID mocks base method.
 func (m *MockLFSObjectRepository) ExistsByOID(ctx context.Context, oid domain.OID) (bool, error) {
 	m.ctrl.T.Helper()
@@ -71,6 +101,66 @@ func (mr *MockLFSObjectRepositoryMockRecorder) FindByOID(ctx, oid any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByOID", reflect.TypeOf((*MockLFSObjectRepository)(nil).FindByOID), ctx, oid)
 }
 
+// FindByOIDs mocks base method.
+func (m *MockLFSObjectRepository) FindByOIDs(ctx context.Context, oids []domain.OID) (map[domain.OID]*domain.LFSObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByOIDs", ctx, oids)
+	ret0, _ := ret[0].(map[domain.OID]*domain.LFSObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByOIDs indicates an expected call of FindByOIDs.
+func (mr *MockLFSObjectRepositoryMockRecorder) FindByOIDs(ctx, oids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByOIDs", reflect.TypeOf((*MockLFSObjectRepository)(nil).FindByOIDs), ctx, oids)
+}
+
+// FindStaleNotUploaded mocks base method.
+func (m *MockLFSObjectRepository) FindStaleNotUploaded(ctx context.Context, olderThan time.Time, limit int) ([]*domain.LFSObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindStaleNotUploaded", ctx, olderThan, limit)
+	ret0, _ := ret[0].([]*domain.LFSObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindStaleNotUploaded indicates an expected call of FindStaleNotUploaded.
+func (mr *MockLFSObjectRepositoryMockRecorder) FindStaleNotUploaded(ctx, olderThan, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindStaleNotUploaded", reflect.TypeOf((*MockLFSObjectRepository)(nil).FindStaleNotUploaded), ctx, olderThan, limit)
+}
+
+// FindUnreferenced mocks base method.
+func (m *MockLFSObjectRepository) FindUnreferenced(ctx context.Context, olderThan time.Time, limit int) ([]*domain.LFSObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindUnreferenced", ctx, olderThan, limit)
+	ret0, _ := ret[0].([]*domain.LFSObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindUnreferenced indicates an expected call of FindUnreferenced.
+func (mr *MockLFSObjectRepositoryMockRecorder) FindUnreferenced(ctx, olderThan, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUnreferenced", reflect.TypeOf((*MockLFSObjectRepository)(nil).FindUnreferenced), ctx, olderThan, limit)
+}
+
+// ListAfter mocks base method.
+func (m *MockLFSObjectRepository) ListAfter(ctx context.Context, after domain.OID, limit int) ([]*domain.LFSObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAfter", ctx, after, limit)
+	ret0, _ := ret[0].([]*domain.LFSObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAfter indicates an expected call of ListAfter.
+func (mr *MockLFSObjectRepositoryMockRecorder) ListAfter(ctx, after, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAfter", reflect.TypeOf((*MockLFSObjectRepository)(nil).ListAfter), ctx, after, limit)
+}
+
 // Save mocks base method.
 func (m *MockLFSObjectRepository) Save(ctx context.Context, obj *domain.LFSObject) error {
 	m.ctrl.T.Helper()
@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	domain "github.com/na2na-p/cargohold/internal/domain"
+	usecase "github.com/na2na-p/cargohold/internal/usecase"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -42,9 +43,9 @@ func (m *MockGitHubOAuthUseCaseInterface) EXPECT() *MockGitHubOAuthUseCaseInterf
 }
 
 // HandleCallback mocks base method.
-func (m *MockGitHubOAuthUseCaseInterface) HandleCallback(ctx context.Context, code, state string) (string, domain.ShellType, error) {
+func (m *MockGitHubOAuthUseCaseInterface) HandleCallback(ctx context.Context, code, state, browserState string) (string, domain.ShellType, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "HandleCallback", ctx, code, state)
+	ret := m.ctrl.Call(m, "HandleCallback", ctx, code, state, browserState)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(domain.ShellType)
 	ret2, _ := ret[2].(error)
@@ -52,22 +53,52 @@ func (m *MockGitHubOAuthUseCaseInterface) HandleCallback(ctx context.Context, co
 }
 
 // HandleCallback indicates an expected call of HandleCallback.
-func (mr *MockGitHubOAuthUseCaseInterfaceMockRecorder) HandleCallback(ctx, code, state any) *gomock.Call {
+func (mr *MockGitHubOAuthUseCaseInterfaceMockRecorder) HandleCallback(ctx, code, state, browserState any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleCallback", reflect.TypeOf((*MockGitHubOAuthUseCaseInterface)(nil).HandleCallback), ctx, code, state)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleCallback", reflect.TypeOf((*MockGitHubOAuthUseCaseInterface)(nil).HandleCallback), ctx, code, state, browserState)
 }
 
-// StartAuthentication mocks base method.
-func (m *MockGitHubOAuthUseCaseInterface) StartAuthentication(ctx context.Context, repository *domain.RepositoryIdentifier, redirectURI string, shell domain.ShellType) (string, error) {
+// PollDeviceAuthorization mocks base method.
+func (m *MockGitHubOAuthUseCaseInterface) PollDeviceAuthorization(ctx context.Context, deviceCode string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StartAuthentication", ctx, repository, redirectURI, shell)
+	ret := m.ctrl.Call(m, "PollDeviceAuthorization", ctx, deviceCode)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
+// PollDeviceAuthorization indicates an expected call of PollDeviceAuthorization.
+func (mr *MockGitHubOAuthUseCaseInterfaceMockRecorder) PollDeviceAuthorization(ctx, deviceCode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PollDeviceAuthorization", reflect.TypeOf((*MockGitHubOAuthUseCaseInterface)(nil).PollDeviceAuthorization), ctx, deviceCode)
+}
+
+// StartAuthentication mocks base method.
+func (m *MockGitHubOAuthUseCaseInterface) StartAuthentication(ctx context.Context, scope *domain.RepositoryScope, redirectURI string, shell domain.ShellType) (*usecase.AuthorizationRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartAuthentication", ctx, scope, redirectURI, shell)
+	ret0, _ := ret[0].(*usecase.AuthorizationRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // StartAuthentication indicates an expected call of StartAuthentication.
-func (mr *MockGitHubOAuthUseCaseInterfaceMockRecorder) StartAuthentication(ctx, repository, redirectURI, shell any) *gomock.Call {
+func (mr *MockGitHubOAuthUseCaseInterfaceMockRecorder) StartAuthentication(ctx, scope, redirectURI, shell any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartAuthentication", reflect.TypeOf((*MockGitHubOAuthUseCaseInterface)(nil).StartAuthentication), ctx, scope, redirectURI, shell)
+}
+
+// StartDeviceAuthorization mocks base method.
+func (m *MockGitHubOAuthUseCaseInterface) StartDeviceAuthorization(ctx context.Context, scope *domain.RepositoryScope) (*usecase.DeviceCodeResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartDeviceAuthorization", ctx, scope)
+	ret0, _ := ret[0].(*usecase.DeviceCodeResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartDeviceAuthorization indicates an expected call of StartDeviceAuthorization.
+func (mr *MockGitHubOAuthUseCaseInterfaceMockRecorder) StartDeviceAuthorization(ctx, scope any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartAuthentication", reflect.TypeOf((*MockGitHubOAuthUseCaseInterface)(nil).StartAuthentication), ctx, repository, redirectURI, shell)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartDeviceAuthorization", reflect.TypeOf((*MockGitHubOAuthUseCaseInterface)(nil).StartDeviceAuthorization), ctx, scope)
 }
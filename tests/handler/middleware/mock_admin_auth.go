@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: admin_auth.go
+//
+// Generated by this command:
+//
+//	mockgen -source=admin_auth.go -destination=../../../tests/handler/middleware/mock_admin_auth.go -package=middleware
+//
+
+// Package middleware is a generated GoMock package.
+package middleware
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAdminAuthUseCaseInterface is a mock of AdminAuthUseCaseInterface interface.
+type MockAdminAuthUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminAuthUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockAdminAuthUseCaseInterfaceMockRecorder is the mock recorder for MockAdminAuthUseCaseInterface.
+type MockAdminAuthUseCaseInterfaceMockRecorder struct {
+	mock *MockAdminAuthUseCaseInterface
+}
+
+// NewMockAdminAuthUseCaseInterface creates a new mock instance.
+func NewMockAdminAuthUseCaseInterface(ctrl *gomock.Controller) *MockAdminAuthUseCaseInterface {
+	mock := &MockAdminAuthUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockAdminAuthUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminAuthUseCaseInterface) EXPECT() *MockAdminAuthUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Authenticate mocks base method.
+func (m *MockAdminAuthUseCaseInterface) Authenticate(ctx context.Context, token string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", ctx, token)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockAdminAuthUseCaseInterfaceMockRecorder) Authenticate(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockAdminAuthUseCaseInterface)(nil).Authenticate), ctx, token)
+}
@@ -0,0 +1,54 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: audit.go
+//
+// Generated by this command:
+//
+//	mockgen -source=audit.go -destination=../../../tests/handler/middleware/mock_audit.go -package=middleware
+//
+
+// Package middleware is a generated GoMock package.
+package middleware
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/na2na-p/cargohold/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAuditRecorderInterface is a mock of AuditRecorderInterface interface.
+type MockAuditRecorderInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditRecorderInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockAuditRecorderInterfaceMockRecorder is the mock recorder for MockAuditRecorderInterface.
+type MockAuditRecorderInterfaceMockRecorder struct {
+	mock *MockAuditRecorderInterface
+}
+
+// NewMockAuditRecorderInterface creates a new mock instance.
+func NewMockAuditRecorderInterface(ctrl *gomock.Controller) *MockAuditRecorderInterface {
+	mock := &MockAuditRecorderInterface{ctrl: ctrl}
+	mock.recorder = &MockAuditRecorderInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditRecorderInterface) EXPECT() *MockAuditRecorderInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Record mocks base method.
+func (m *MockAuditRecorderInterface) Record(ctx context.Context, events []*domain.AuditEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Record", ctx, events)
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockAuditRecorderInterfaceMockRecorder) Record(ctx, events any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockAuditRecorderInterface)(nil).Record), ctx, events)
+}
@@ -41,32 +41,47 @@ func (m *MockAuthUseCaseInterface) EXPECT() *MockAuthUseCaseInterfaceMockRecorde
 	return m.recorder
 }
 
-// AuthenticateGitHubOIDC mocks base method.
-func (m *MockAuthUseCaseInterface) AuthenticateGitHubOIDC(ctx context.Context, token string) (*domain.UserInfo, error) {
+// AuthenticateAccessToken mocks base method.
+func (m *MockAuthUseCaseInterface) AuthenticateAccessToken(ctx context.Context, secret string, repository *domain.RepositoryIdentifier) (*domain.UserInfo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AuthenticateGitHubOIDC", ctx, token)
+	ret := m.ctrl.Call(m, "AuthenticateAccessToken", ctx, secret, repository)
 	ret0, _ := ret[0].(*domain.UserInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// AuthenticateGitHubOIDC indicates an expected call of AuthenticateGitHubOIDC.
-func (mr *MockAuthUseCaseInterfaceMockRecorder) AuthenticateGitHubOIDC(ctx, token any) *gomock.Call {
+// AuthenticateAccessToken indicates an expected call of AuthenticateAccessToken.
+func (mr *MockAuthUseCaseInterfaceMockRecorder) AuthenticateAccessToken(ctx, secret, repository any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateGitHubOIDC", reflect.TypeOf((*MockAuthUseCaseInterface)(nil).AuthenticateGitHubOIDC), ctx, token)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateAccessToken", reflect.TypeOf((*MockAuthUseCaseInterface)(nil).AuthenticateAccessToken), ctx, secret, repository)
+}
+
+// AuthenticateOIDC mocks base method.
+func (m *MockAuthUseCaseInterface) AuthenticateOIDC(ctx context.Context, issuer, token string) (*domain.UserInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthenticateOIDC", ctx, issuer, token)
+	ret0, _ := ret[0].(*domain.UserInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthenticateOIDC indicates an expected call of AuthenticateOIDC.
+func (mr *MockAuthUseCaseInterfaceMockRecorder) AuthenticateOIDC(ctx, issuer, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateOIDC", reflect.TypeOf((*MockAuthUseCaseInterface)(nil).AuthenticateOIDC), ctx, issuer, token)
 }
 
 // AuthenticateSession mocks base method.
-func (m *MockAuthUseCaseInterface) AuthenticateSession(ctx context.Context, sessionID string) (*domain.UserInfo, error) {
+func (m *MockAuthUseCaseInterface) AuthenticateSession(ctx context.Context, sessionID string, repository *domain.RepositoryIdentifier) (*domain.UserInfo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AuthenticateSession", ctx, sessionID)
+	ret := m.ctrl.Call(m, "AuthenticateSession", ctx, sessionID, repository)
 	ret0, _ := ret[0].(*domain.UserInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AuthenticateSession indicates an expected call of AuthenticateSession.
-func (mr *MockAuthUseCaseInterfaceMockRecorder) AuthenticateSession(ctx, sessionID any) *gomock.Call {
+func (mr *MockAuthUseCaseInterfaceMockRecorder) AuthenticateSession(ctx, sessionID, repository any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateSession", reflect.TypeOf((*MockAuthUseCaseInterface)(nil).AuthenticateSession), ctx, sessionID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateSession", reflect.TypeOf((*MockAuthUseCaseInterface)(nil).AuthenticateSession), ctx, sessionID, repository)
 }
@@ -0,0 +1,89 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: metrics.go
+//
+// Generated by this command:
+//
+//	mockgen -source=metrics.go -destination=../../../tests/handler/middleware/mock_metrics.go -package=middleware
+//
+
+// Package middleware is a generated GoMock package.
+package middleware
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMetricsRecorderInterface is a mock of MetricsRecorderInterface interface.
+type MockMetricsRecorderInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockMetricsRecorderInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockMetricsRecorderInterfaceMockRecorder is the mock recorder for MockMetricsRecorderInterface.
+type MockMetricsRecorderInterfaceMockRecorder struct {
+	mock *MockMetricsRecorderInterface
+}
+
+// NewMockMetricsRecorderInterface creates a new mock instance.
+func NewMockMetricsRecorderInterface(ctrl *gomock.Controller) *MockMetricsRecorderInterface {
+	mock := &MockMetricsRecorderInterface{ctrl: ctrl}
+	mock.recorder = &MockMetricsRecorderInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMetricsRecorderInterface) EXPECT() *MockMetricsRecorderInterfaceMockRecorder {
+	return m.recorder
+}
+
+// AddProxiedBytes mocks base method.
+func (m *MockMetricsRecorderInterface) AddProxiedBytes(direction string, bytes int64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddProxiedBytes", direction, bytes)
+}
+
+// AddProxiedBytes indicates an expected call of AddProxiedBytes.
+func (mr *MockMetricsRecorderInterfaceMockRecorder) AddProxiedBytes(direction, bytes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProxiedBytes", reflect.TypeOf((*MockMetricsRecorderInterface)(nil).AddProxiedBytes), direction, bytes)
+}
+
+// ObserveBatchSize mocks base method.
+func (m *MockMetricsRecorderInterface) ObserveBatchSize(operation string, objects int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ObserveBatchSize", operation, objects)
+}
+
+// ObserveBatchSize indicates an expected call of ObserveBatchSize.
+func (mr *MockMetricsRecorderInterfaceMockRecorder) ObserveBatchSize(operation, objects any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObserveBatchSize", reflect.TypeOf((*MockMetricsRecorderInterface)(nil).ObserveBatchSize), operation, objects)
+}
+
+// ObserveHTTPRequest mocks base method.
+func (m *MockMetricsRecorderInterface) ObserveHTTPRequest(route, method string, statusCode int, elapsed time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ObserveHTTPRequest", route, method, statusCode, elapsed)
+}
+
+// ObserveHTTPRequest indicates an expected call of ObserveHTTPRequest.
+func (mr *MockMetricsRecorderInterfaceMockRecorder) ObserveHTTPRequest(route, method, statusCode, elapsed any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObserveHTTPRequest", reflect.TypeOf((*MockMetricsRecorderInterface)(nil).ObserveHTTPRequest), route, method, statusCode, elapsed)
+}
+
+// RecordAuthAttempt mocks base method.
+func (m *MockMetricsRecorderInterface) RecordAuthAttempt(method, outcome string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordAuthAttempt", method, outcome)
+}
+
+// RecordAuthAttempt indicates an expected call of RecordAuthAttempt.
+func (mr *MockMetricsRecorderInterfaceMockRecorder) RecordAuthAttempt(method, outcome any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordAuthAttempt", reflect.TypeOf((*MockMetricsRecorderInterface)(nil).RecordAuthAttempt), method, outcome)
+}
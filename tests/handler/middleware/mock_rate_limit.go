@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: rate_limit.go
+//
+// Generated by this command:
+//
+//	mockgen -source=rate_limit.go -destination=../../../tests/handler/middleware/mock_rate_limit.go -package=middleware
+//
+
+// Package middleware is a generated GoMock package.
+package middleware
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/na2na-p/cargohold/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRateLimiterInterface is a mock of RateLimiterInterface interface.
+type MockRateLimiterInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockRateLimiterInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockRateLimiterInterfaceMockRecorder is the mock recorder for MockRateLimiterInterface.
+type MockRateLimiterInterfaceMockRecorder struct {
+	mock *MockRateLimiterInterface
+}
+
+// NewMockRateLimiterInterface creates a new mock instance.
+func NewMockRateLimiterInterface(ctrl *gomock.Controller) *MockRateLimiterInterface {
+	mock := &MockRateLimiterInterface{ctrl: ctrl}
+	mock.recorder = &MockRateLimiterInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRateLimiterInterface) EXPECT() *MockRateLimiterInterfaceMockRecorder {
+	return m.recorder
+}
+
+// AllowBatch mocks base method.
+func (m *MockRateLimiterInterface) AllowBatch(ctx context.Context, subjects []domain.RateLimitSubject) (domain.RateLimitDecision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllowBatch", ctx, subjects)
+	ret0, _ := ret[0].(domain.RateLimitDecision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllowBatch indicates an expected call of AllowBatch.
+func (mr *MockRateLimiterInterfaceMockRecorder) AllowBatch(ctx, subjects any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllowBatch", reflect.TypeOf((*MockRateLimiterInterface)(nil).AllowBatch), ctx, subjects)
+}
+
+// AllowProxyTransfer mocks base method.
+func (m *MockRateLimiterInterface) AllowProxyTransfer(ctx context.Context, subjects []domain.RateLimitSubject, expectedBytes int64) (domain.RateLimitDecision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllowProxyTransfer", ctx, subjects, expectedBytes)
+	ret0, _ := ret[0].(domain.RateLimitDecision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllowProxyTransfer indicates an expected call of AllowProxyTransfer.
+func (mr *MockRateLimiterInterfaceMockRecorder) AllowProxyTransfer(ctx, subjects, expectedBytes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllowProxyTransfer", reflect.TypeOf((*MockRateLimiterInterface)(nil).AllowProxyTransfer), ctx, subjects, expectedBytes)
+}
+
+// RecordProxyTransfer mocks base method.
+func (m *MockRateLimiterInterface) RecordProxyTransfer(ctx context.Context, subjects []domain.RateLimitSubject, expectedBytes, transferredBytes int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordProxyTransfer", ctx, subjects, expectedBytes, transferredBytes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordProxyTransfer indicates an expected call of RecordProxyTransfer.
+func (mr *MockRateLimiterInterfaceMockRecorder) RecordProxyTransfer(ctx, subjects, expectedBytes, transferredBytes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordProxyTransfer", reflect.TypeOf((*MockRateLimiterInterface)(nil).RecordProxyTransfer), ctx, subjects, expectedBytes, transferredBytes)
+}
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: cache_metrics.go
+//
+// Generated by this command:
+//
+//	mockgen -source=cache_metrics.go -destination=../../tests/infrastructure/mock_cache_metrics.go -package=infrastructure
+//
+
+// Package infrastructure is a generated GoMock package.
+package infrastructure
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCacheMetricsRecorder is a mock of CacheMetricsRecorder interface.
+type MockCacheMetricsRecorder struct {
+	ctrl     *gomock.Controller
+	recorder *MockCacheMetricsRecorderMockRecorder
+	isgomock struct{}
+}
+
+// MockCacheMetricsRecorderMockRecorder is the mock recorder for MockCacheMetricsRecorder.
+type MockCacheMetricsRecorderMockRecorder struct {
+	mock *MockCacheMetricsRecorder
+}
+
+// NewMockCacheMetricsRecorder creates a new mock instance.
+func NewMockCacheMetricsRecorder(ctrl *gomock.Controller) *MockCacheMetricsRecorder {
+	mock := &MockCacheMetricsRecorder{ctrl: ctrl}
+	mock.recorder = &MockCacheMetricsRecorderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCacheMetricsRecorder) EXPECT() *MockCacheMetricsRecorderMockRecorder {
+	return m.recorder
+}
+
+// RecordCacheLookup mocks base method.
+func (m *MockCacheMetricsRecorder) RecordCacheLookup(cache string, hit bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordCacheLookup", cache, hit)
+}
+
+// RecordCacheLookup indicates an expected call of RecordCacheLookup.
+func (mr *MockCacheMetricsRecorderMockRecorder) RecordCacheLookup(cache, hit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordCacheLookup", reflect.TypeOf((*MockCacheMetricsRecorder)(nil).RecordCacheLookup), cache, hit)
+}
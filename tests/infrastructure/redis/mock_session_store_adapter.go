@@ -15,6 +15,7 @@ import (
 	time "time"
 
 	domain "github.com/na2na-p/cargohold/internal/domain"
+	redis "github.com/na2na-p/cargohold/internal/infrastructure/redis"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -56,6 +57,21 @@ func (mr *MockSessionClientMockRecorder) DeleteSession(ctx, sessionID any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSession", reflect.TypeOf((*MockSessionClient)(nil).DeleteSession), ctx, sessionID)
 }
 
+// DeleteUserSessions mocks base method.
+func (m *MockSessionClient) DeleteUserSessions(ctx context.Context, sub string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserSessions", ctx, sub)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUserSessions indicates an expected call of DeleteUserSessions.
+func (mr *MockSessionClientMockRecorder) DeleteUserSessions(ctx, sub any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserSessions", reflect.TypeOf((*MockSessionClient)(nil).DeleteUserSessions), ctx, sub)
+}
+
 // GetSession mocks base method.
 func (m *MockSessionClient) GetSession(ctx context.Context, sessionID string) (*domain.UserInfo, error) {
 	m.ctrl.T.Helper()
@@ -71,6 +87,36 @@ func (mr *MockSessionClientMockRecorder) GetSession(ctx, sessionID any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockSessionClient)(nil).GetSession), ctx, sessionID)
 }
 
+// GetSessionToken mocks base method.
+func (m *MockSessionClient) GetSessionToken(ctx context.Context, sessionID string) (*redis.SessionTokenRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionToken", ctx, sessionID)
+	ret0, _ := ret[0].(*redis.SessionTokenRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionToken indicates an expected call of GetSessionToken.
+func (mr *MockSessionClientMockRecorder) GetSessionToken(ctx, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionToken", reflect.TypeOf((*MockSessionClient)(nil).GetSessionToken), ctx, sessionID)
+}
+
+// ListUserSessions mocks base method.
+func (m *MockSessionClient) ListUserSessions(ctx context.Context, sub string) ([]*redis.SessionEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserSessions", ctx, sub)
+	ret0, _ := ret[0].([]*redis.SessionEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserSessions indicates an expected call of ListUserSessions.
+func (mr *MockSessionClientMockRecorder) ListUserSessions(ctx, sub any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserSessions", reflect.TypeOf((*MockSessionClient)(nil).ListUserSessions), ctx, sub)
+}
+
 // SetSession mocks base method.
 func (m *MockSessionClient) SetSession(ctx context.Context, sessionID string, userInfo *domain.UserInfo, ttl time.Duration) error {
 	m.ctrl.T.Helper()
@@ -85,6 +131,88 @@ func (mr *MockSessionClientMockRecorder) SetSession(ctx, sessionID, userInfo, tt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSession", reflect.TypeOf((*MockSessionClient)(nil).SetSession), ctx, sessionID, userInfo, ttl)
 }
 
+// SetSessionWithToken mocks base method.
+func (m *MockSessionClient) SetSessionWithToken(ctx context.Context, sessionID string, userInfo *domain.UserInfo, token *redis.SessionTokenRecord, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSessionWithToken", ctx, sessionID, userInfo, token, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSessionWithToken indicates an expected call of SetSessionWithToken.
+func (mr *MockSessionClientMockRecorder) SetSessionWithToken(ctx, sessionID, userInfo, token, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSessionWithToken", reflect.TypeOf((*MockSessionClient)(nil).SetSessionWithToken), ctx, sessionID, userInfo, token, ttl)
+}
+
+// UpdateSession mocks base method.
+func (m *MockSessionClient) UpdateSession(ctx context.Context, sessionID string, userInfo *domain.UserInfo, token *redis.SessionTokenRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSession", ctx, sessionID, userInfo, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSession indicates an expected call of UpdateSession.
+func (mr *MockSessionClientMockRecorder) UpdateSession(ctx, sessionID, userInfo, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSession", reflect.TypeOf((*MockSessionClient)(nil).UpdateSession), ctx, sessionID, userInfo, token)
+}
+
+// MockTokenCipher is a mock of TokenCipher interface.
+type MockTokenCipher struct {
+	ctrl     *gomock.Controller
+	recorder *MockTokenCipherMockRecorder
+	isgomock struct{}
+}
+
+// MockTokenCipherMockRecorder is the mock recorder for MockTokenCipher.
+type MockTokenCipherMockRecorder struct {
+	mock *MockTokenCipher
+}
+
+// NewMockTokenCipher creates a new mock instance.
+func NewMockTokenCipher(ctrl *gomock.Controller) *MockTokenCipher {
+	mock := &MockTokenCipher{ctrl: ctrl}
+	mock.recorder = &MockTokenCipherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTokenCipher) EXPECT() *MockTokenCipherMockRecorder {
+	return m.recorder
+}
+
+// Decrypt mocks base method.
+func (m *MockTokenCipher) Decrypt(ciphertext string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decrypt", ciphertext)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Decrypt indicates an expected call of Decrypt.
+func (mr *MockTokenCipherMockRecorder) Decrypt(ciphertext any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrypt", reflect.TypeOf((*MockTokenCipher)(nil).Decrypt), ciphertext)
+}
+
+// Encrypt mocks base method.
+func (m *MockTokenCipher) Encrypt(plaintext string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Encrypt", plaintext)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Encrypt indicates an expected call of Encrypt.
+func (mr *MockTokenCipherMockRecorder) Encrypt(plaintext any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Encrypt", reflect.TypeOf((*MockTokenCipher)(nil).Encrypt), plaintext)
+}
+
 // MockUUIDGenerator is a mock of UUIDGenerator interface.
 type MockUUIDGenerator struct {
 	ctrl     *gomock.Controller
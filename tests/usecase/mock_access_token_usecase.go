@@ -0,0 +1,185 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: access_token_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=access_token_usecase.go -destination=../../tests/usecase/mock_access_token_usecase.go -package=usecase
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/na2na-p/cargohold/internal/domain"
+	usecase "github.com/na2na-p/cargohold/internal/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSessionRepositoryAuthorizer is a mock of SessionRepositoryAuthorizer interface.
+type MockSessionRepositoryAuthorizer struct {
+	ctrl     *gomock.Controller
+	recorder *MockSessionRepositoryAuthorizerMockRecorder
+	isgomock struct{}
+}
+
+// MockSessionRepositoryAuthorizerMockRecorder is the mock recorder for MockSessionRepositoryAuthorizer.
+type MockSessionRepositoryAuthorizerMockRecorder struct {
+	mock *MockSessionRepositoryAuthorizer
+}
+
+// NewMockSessionRepositoryAuthorizer creates a new mock instance.
+func NewMockSessionRepositoryAuthorizer(ctrl *gomock.Controller) *MockSessionRepositoryAuthorizer {
+	mock := &MockSessionRepositoryAuthorizer{ctrl: ctrl}
+	mock.recorder = &MockSessionRepositoryAuthorizerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSessionRepositoryAuthorizer) EXPECT() *MockSessionRepositoryAuthorizerMockRecorder {
+	return m.recorder
+}
+
+// Authenticate mocks base method.
+func (m *MockSessionRepositoryAuthorizer) Authenticate(ctx context.Context, sessionID string, repository *domain.RepositoryIdentifier) (*domain.UserInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", ctx, sessionID, repository)
+	ret0, _ := ret[0].(*domain.UserInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockSessionRepositoryAuthorizerMockRecorder) Authenticate(ctx, sessionID, repository any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockSessionRepositoryAuthorizer)(nil).Authenticate), ctx, sessionID, repository)
+}
+
+// MockAccessTokenUseCase is a mock of AccessTokenUseCase interface.
+type MockAccessTokenUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccessTokenUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockAccessTokenUseCaseMockRecorder is the mock recorder for MockAccessTokenUseCase.
+type MockAccessTokenUseCaseMockRecorder struct {
+	mock *MockAccessTokenUseCase
+}
+
+// NewMockAccessTokenUseCase creates a new mock instance.
+func NewMockAccessTokenUseCase(ctrl *gomock.Controller) *MockAccessTokenUseCase {
+	mock := &MockAccessTokenUseCase{ctrl: ctrl}
+	mock.recorder = &MockAccessTokenUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccessTokenUseCase) EXPECT() *MockAccessTokenUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Authenticate mocks base method.
+func (m *MockAccessTokenUseCase) Authenticate(ctx context.Context, secret string, repository *domain.RepositoryIdentifier) (*domain.UserInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", ctx, secret, repository)
+	ret0, _ := ret[0].(*domain.UserInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockAccessTokenUseCaseMockRecorder) Authenticate(ctx, secret, repository any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockAccessTokenUseCase)(nil).Authenticate), ctx, secret, repository)
+}
+
+// Issue mocks base method.
+func (m *MockAccessTokenUseCase) Issue(ctx context.Context, createdBy string, input usecase.AccessTokenInput) (*usecase.IssuedAccessToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Issue", ctx, createdBy, input)
+	ret0, _ := ret[0].(*usecase.IssuedAccessToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Issue indicates an expected call of Issue.
+func (mr *MockAccessTokenUseCaseMockRecorder) Issue(ctx, createdBy, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Issue", reflect.TypeOf((*MockAccessTokenUseCase)(nil).Issue), ctx, createdBy, input)
+}
+
+// IssueForUser mocks base method.
+func (m *MockAccessTokenUseCase) IssueForUser(ctx context.Context, sessionID string, userInfo *domain.UserInfo, input usecase.AccessTokenInput) (*usecase.IssuedAccessToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueForUser", ctx, sessionID, userInfo, input)
+	ret0, _ := ret[0].(*usecase.IssuedAccessToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssueForUser indicates an expected call of IssueForUser.
+func (mr *MockAccessTokenUseCaseMockRecorder) IssueForUser(ctx, sessionID, userInfo, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueForUser", reflect.TypeOf((*MockAccessTokenUseCase)(nil).IssueForUser), ctx, sessionID, userInfo, input)
+}
+
+// List mocks base method.
+func (m *MockAccessTokenUseCase) List(ctx context.Context) ([]*domain.AccessToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]*domain.AccessToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockAccessTokenUseCaseMockRecorder) List(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAccessTokenUseCase)(nil).List), ctx)
+}
+
+// ListForUser mocks base method.
+func (m *MockAccessTokenUseCase) ListForUser(ctx context.Context, userInfo *domain.UserInfo) ([]*domain.AccessToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListForUser", ctx, userInfo)
+	ret0, _ := ret[0].([]*domain.AccessToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListForUser indicates an expected call of ListForUser.
+func (mr *MockAccessTokenUseCaseMockRecorder) ListForUser(ctx, userInfo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListForUser", reflect.TypeOf((*MockAccessTokenUseCase)(nil).ListForUser), ctx, userInfo)
+}
+
+// Revoke mocks base method.
+func (m *MockAccessTokenUseCase) Revoke(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockAccessTokenUseCaseMockRecorder) Revoke(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockAccessTokenUseCase)(nil).Revoke), ctx, id)
+}
+
+// RevokeForUser mocks base method.
+func (m *MockAccessTokenUseCase) RevokeForUser(ctx context.Context, userInfo *domain.UserInfo, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeForUser", ctx, userInfo, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeForUser indicates an expected call of RevokeForUser.
+func (mr *MockAccessTokenUseCaseMockRecorder) RevokeForUser(ctx, userInfo, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeForUser", reflect.TypeOf((*MockAccessTokenUseCase)(nil).RevokeForUser), ctx, userInfo, id)
+}
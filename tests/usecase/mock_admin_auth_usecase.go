@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: admin_auth_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=admin_auth_usecase.go -destination=../../tests/usecase/mock_admin_auth_usecase.go -package=usecase
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAdminAuthUseCase is a mock of AdminAuthUseCase interface.
+type MockAdminAuthUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminAuthUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockAdminAuthUseCaseMockRecorder is the mock recorder for MockAdminAuthUseCase.
+type MockAdminAuthUseCaseMockRecorder struct {
+	mock *MockAdminAuthUseCase
+}
+
+// NewMockAdminAuthUseCase creates a new mock instance.
+func NewMockAdminAuthUseCase(ctrl *gomock.Controller) *MockAdminAuthUseCase {
+	mock := &MockAdminAuthUseCase{ctrl: ctrl}
+	mock.recorder = &MockAdminAuthUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminAuthUseCase) EXPECT() *MockAdminAuthUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Authenticate mocks base method.
+func (m *MockAdminAuthUseCase) Authenticate(ctx context.Context, token string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", ctx, token)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockAdminAuthUseCaseMockRecorder) Authenticate(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockAdminAuthUseCase)(nil).Authenticate), ctx, token)
+}
@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: admin_object_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=admin_object_usecase.go -destination=../../tests/usecase/mock_admin_object_usecase.go -package=usecase
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	usecase "github.com/na2na-p/cargohold/internal/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAdminObjectUseCase is a mock of AdminObjectUseCase interface.
+type MockAdminObjectUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminObjectUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockAdminObjectUseCaseMockRecorder is the mock recorder for MockAdminObjectUseCase.
+type MockAdminObjectUseCaseMockRecorder struct {
+	mock *MockAdminObjectUseCase
+}
+
+// NewMockAdminObjectUseCase creates a new mock instance.
+func NewMockAdminObjectUseCase(ctrl *gomock.Controller) *MockAdminObjectUseCase {
+	mock := &MockAdminObjectUseCase{ctrl: ctrl}
+	mock.recorder = &MockAdminObjectUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminObjectUseCase) EXPECT() *MockAdminObjectUseCaseMockRecorder {
+	return m.recorder
+}
+
+// DeleteObject mocks base method.
+func (m *MockAdminObjectUseCase) DeleteObject(ctx context.Context, oid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteObject", ctx, oid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteObject indicates an expected call of DeleteObject.
+func (mr *MockAdminObjectUseCaseMockRecorder) DeleteObject(ctx, oid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObject", reflect.TypeOf((*MockAdminObjectUseCase)(nil).DeleteObject), ctx, oid)
+}
+
+// FlushObjectCache mocks base method.
+func (m *MockAdminObjectUseCase) FlushObjectCache(ctx context.Context, oid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FlushObjectCache", ctx, oid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FlushObjectCache indicates an expected call of FlushObjectCache.
+func (mr *MockAdminObjectUseCaseMockRecorder) FlushObjectCache(ctx, oid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FlushObjectCache", reflect.TypeOf((*MockAdminObjectUseCase)(nil).FlushObjectCache), ctx, oid)
+}
+
+// GetObject mocks base method.
+func (m *MockAdminObjectUseCase) GetObject(ctx context.Context, oid string) (*usecase.AdminObjectDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetObject", ctx, oid)
+	ret0, _ := ret[0].(*usecase.AdminObjectDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetObject indicates an expected call of GetObject.
+func (mr *MockAdminObjectUseCaseMockRecorder) GetObject(ctx, oid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObject", reflect.TypeOf((*MockAdminObjectUseCase)(nil).GetObject), ctx, oid)
+}
@@ -8,3 +8,89 @@
 
 // Package usecase is a generated GoMock package.
 package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/na2na-p/cargohold/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockOIDCAuthenticator is a mock of OIDCAuthenticator interface.
+type MockOIDCAuthenticator struct {
+	ctrl     *gomock.Controller
+	recorder *MockOIDCAuthenticatorMockRecorder
+	isgomock struct{}
+}
+
+// MockOIDCAuthenticatorMockRecorder is the mock recorder for MockOIDCAuthenticator.
+type MockOIDCAuthenticatorMockRecorder struct {
+	mock *MockOIDCAuthenticator
+}
+
+// NewMockOIDCAuthenticator creates a new mock instance.
+func NewMockOIDCAuthenticator(ctrl *gomock.Controller) *MockOIDCAuthenticator {
+	mock := &MockOIDCAuthenticator{ctrl: ctrl}
+	mock.recorder = &MockOIDCAuthenticatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOIDCAuthenticator) EXPECT() *MockOIDCAuthenticatorMockRecorder {
+	return m.recorder
+}
+
+// Authenticate mocks base method.
+func (m *MockOIDCAuthenticator) Authenticate(ctx context.Context, token string) (*domain.UserInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", ctx, token)
+	ret0, _ := ret[0].(*domain.UserInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockOIDCAuthenticatorMockRecorder) Authenticate(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockOIDCAuthenticator)(nil).Authenticate), ctx, token)
+}
+
+// MockAccessTokenAuthenticator is a mock of AccessTokenAuthenticator interface.
+type MockAccessTokenAuthenticator struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccessTokenAuthenticatorMockRecorder
+	isgomock struct{}
+}
+
+// MockAccessTokenAuthenticatorMockRecorder is the mock recorder for MockAccessTokenAuthenticator.
+type MockAccessTokenAuthenticatorMockRecorder struct {
+	mock *MockAccessTokenAuthenticator
+}
+
+// NewMockAccessTokenAuthenticator creates a new mock instance.
+func NewMockAccessTokenAuthenticator(ctrl *gomock.Controller) *MockAccessTokenAuthenticator {
+	mock := &MockAccessTokenAuthenticator{ctrl: ctrl}
+	mock.recorder = &MockAccessTokenAuthenticatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccessTokenAuthenticator) EXPECT() *MockAccessTokenAuthenticatorMockRecorder {
+	return m.recorder
+}
+
+// Authenticate mocks base method.
+func (m *MockAccessTokenAuthenticator) Authenticate(ctx context.Context, secret string, repository *domain.RepositoryIdentifier) (*domain.UserInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", ctx, secret, repository)
+	ret0, _ := ret[0].(*domain.UserInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockAccessTokenAuthenticatorMockRecorder) Authenticate(ctx, secret, repository any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockAccessTokenAuthenticator)(nil).Authenticate), ctx, secret, repository)
+}
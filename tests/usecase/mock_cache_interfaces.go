@@ -188,6 +188,21 @@ func (mr *MockCacheClientMockRecorder) GetJSON(ctx, key, dest any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJSON", reflect.TypeOf((*MockCacheClient)(nil).GetJSON), ctx, key, dest)
 }
 
+// MGet mocks base method.
+func (m *MockCacheClient) MGet(ctx context.Context, keys []string) ([][]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MGet", ctx, keys)
+	ret0, _ := ret[0].([][]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MGet indicates an expected call of MGet.
+func (mr *MockCacheClientMockRecorder) MGet(ctx, keys any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MGet", reflect.TypeOf((*MockCacheClient)(nil).MGet), ctx, keys)
+}
+
 // Set mocks base method.
 func (m *MockCacheClient) Set(ctx context.Context, key string, value any, ttl time.Duration) error {
 	m.ctrl.T.Helper()
@@ -15,6 +15,7 @@ import (
 	reflect "reflect"
 	time "time"
 
+	usecase "github.com/na2na-p/cargohold/internal/usecase"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -97,18 +98,19 @@ func (mr *MockS3ClientMockRecorder) GenerateGetURL(ctx, key, ttl any) *gomock.Ca
 }
 
 // GeneratePutURL mocks base method.
-func (m *MockS3Client) GeneratePutURL(ctx context.Context, key string, ttl time.Duration) (string, error) {
+func (m *MockS3Client) GeneratePutURL(ctx context.Context, key string, contentLength int64, checksumSHA256 string, ttl time.Duration) (string, map[string]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GeneratePutURL", ctx, key, ttl)
+	ret := m.ctrl.Call(m, "GeneratePutURL", ctx, key, contentLength, checksumSHA256, ttl)
 	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(map[string]string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // GeneratePutURL indicates an expected call of GeneratePutURL.
-func (mr *MockS3ClientMockRecorder) GeneratePutURL(ctx, key, ttl any) *gomock.Call {
+func (mr *MockS3ClientMockRecorder) GeneratePutURL(ctx, key, contentLength, checksumSHA256, ttl any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GeneratePutURL", reflect.TypeOf((*MockS3Client)(nil).GeneratePutURL), ctx, key, ttl)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GeneratePutURL", reflect.TypeOf((*MockS3Client)(nil).GeneratePutURL), ctx, key, contentLength, checksumSHA256, ttl)
 }
 
 // MockObjectStorage is a mock of ObjectStorage interface.
@@ -135,6 +137,34 @@ func (m *MockObjectStorage) EXPECT() *MockObjectStorageMockRecorder {
 	return m.recorder
 }
 
+// CopyObject mocks base method.
+func (m *MockObjectStorage) CopyObject(ctx context.Context, srcKey, dstKey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyObject", ctx, srcKey, dstKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CopyObject indicates an expected call of CopyObject.
+func (mr *MockObjectStorageMockRecorder) CopyObject(ctx, srcKey, dstKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyObject", reflect.TypeOf((*MockObjectStorage)(nil).CopyObject), ctx, srcKey, dstKey)
+}
+
+// DeleteObject mocks base method.
+func (m *MockObjectStorage) DeleteObject(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteObject", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteObject indicates an expected call of DeleteObject.
+func (mr *MockObjectStorageMockRecorder) DeleteObject(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObject", reflect.TypeOf((*MockObjectStorage)(nil).DeleteObject), ctx, key)
+}
+
 // GetObject mocks base method.
 func (m *MockObjectStorage) GetObject(ctx context.Context, key string) (io.ReadCloser, error) {
 	m.ctrl.T.Helper()
@@ -150,6 +180,37 @@ func (mr *MockObjectStorageMockRecorder) GetObject(ctx, key any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObject", reflect.TypeOf((*MockObjectStorage)(nil).GetObject), ctx, key)
 }
 
+// HeadObject mocks base method.
+func (m *MockObjectStorage) HeadObject(ctx context.Context, key string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HeadObject", ctx, key)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HeadObject indicates an expected call of HeadObject.
+func (mr *MockObjectStorageMockRecorder) HeadObject(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HeadObject", reflect.TypeOf((*MockObjectStorage)(nil).HeadObject), ctx, key)
+}
+
+// HeadObjectSize mocks base method.
+func (m *MockObjectStorage) HeadObjectSize(ctx context.Context, key string) (int64, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HeadObjectSize", ctx, key)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// HeadObjectSize indicates an expected call of HeadObjectSize.
+func (mr *MockObjectStorageMockRecorder) HeadObjectSize(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HeadObjectSize", reflect.TypeOf((*MockObjectStorage)(nil).HeadObjectSize), ctx, key)
+}
+
 // PutObject mocks base method.
 func (m *MockObjectStorage) PutObject(ctx context.Context, key string, body io.Reader, contentLength int64) error {
 	m.ctrl.T.Helper()
@@ -164,6 +225,44 @@ func (mr *MockObjectStorageMockRecorder) PutObject(ctx, key, body, contentLength
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutObject", reflect.TypeOf((*MockObjectStorage)(nil).PutObject), ctx, key, body, contentLength)
 }
 
+// MockObjectLister is a mock of ObjectLister interface.
+type MockObjectLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockObjectListerMockRecorder
+	isgomock struct{}
+}
+
+// MockObjectListerMockRecorder is the mock recorder for MockObjectLister.
+type MockObjectListerMockRecorder struct {
+	mock *MockObjectLister
+}
+
+// NewMockObjectLister creates a new mock instance.
+func NewMockObjectLister(ctrl *gomock.Controller) *MockObjectLister {
+	mock := &MockObjectLister{ctrl: ctrl}
+	mock.recorder = &MockObjectListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockObjectLister) EXPECT() *MockObjectListerMockRecorder {
+	return m.recorder
+}
+
+// ListObjects mocks base method.
+func (m *MockObjectLister) ListObjects(ctx context.Context, prefix string, fn func(usecase.StoredObject) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListObjects", ctx, prefix, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListObjects indicates an expected call of ListObjects.
+func (mr *MockObjectListerMockRecorder) ListObjects(ctx, prefix, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjects", reflect.TypeOf((*MockObjectLister)(nil).ListObjects), ctx, prefix, fn)
+}
+
 // MockActionURLGenerator is a mock of ActionURLGenerator interface.
 type MockActionURLGenerator struct {
 	ctrl     *gomock.Controller
@@ -216,6 +315,20 @@ func (mr *MockActionURLGeneratorMockRecorder) GenerateUploadURL(baseURL, owner,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateUploadURL", reflect.TypeOf((*MockActionURLGenerator)(nil).GenerateUploadURL), baseURL, owner, repo, oid)
 }
 
+// GenerateVerifyURL mocks base method.
+func (m *MockActionURLGenerator) GenerateVerifyURL(baseURL, owner, repo string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateVerifyURL", baseURL, owner, repo)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GenerateVerifyURL indicates an expected call of GenerateVerifyURL.
+func (mr *MockActionURLGeneratorMockRecorder) GenerateVerifyURL(baseURL, owner, repo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateVerifyURL", reflect.TypeOf((*MockActionURLGenerator)(nil).GenerateVerifyURL), baseURL, owner, repo)
+}
+
 // MockStorageErrorChecker is a mock of StorageErrorChecker interface.
 type MockStorageErrorChecker struct {
 	ctrl     *gomock.Controller
@@ -253,3 +366,42 @@ func (mr *MockStorageErrorCheckerMockRecorder) IsStorageError(err any) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsStorageError", reflect.TypeOf((*MockStorageErrorChecker)(nil).IsStorageError), err)
 }
+
+// MockAccessTokenSecretGenerator is a mock of AccessTokenSecretGenerator interface.
+type MockAccessTokenSecretGenerator struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccessTokenSecretGeneratorMockRecorder
+	isgomock struct{}
+}
+
+// MockAccessTokenSecretGeneratorMockRecorder is the mock recorder for MockAccessTokenSecretGenerator.
+type MockAccessTokenSecretGeneratorMockRecorder struct {
+	mock *MockAccessTokenSecretGenerator
+}
+
+// NewMockAccessTokenSecretGenerator creates a new mock instance.
+func NewMockAccessTokenSecretGenerator(ctrl *gomock.Controller) *MockAccessTokenSecretGenerator {
+	mock := &MockAccessTokenSecretGenerator{ctrl: ctrl}
+	mock.recorder = &MockAccessTokenSecretGeneratorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccessTokenSecretGenerator) EXPECT() *MockAccessTokenSecretGeneratorMockRecorder {
+	return m.recorder
+}
+
+// Generate mocks base method.
+func (m *MockAccessTokenSecretGenerator) Generate() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Generate")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Generate indicates an expected call of Generate.
+func (mr *MockAccessTokenSecretGeneratorMockRecorder) Generate() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generate", reflect.TypeOf((*MockAccessTokenSecretGenerator)(nil).Generate))
+}
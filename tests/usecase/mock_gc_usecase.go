@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: gc_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=gc_usecase.go -destination=../../tests/usecase/mock_gc_usecase.go -package=usecase
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	usecase "github.com/na2na-p/cargohold/internal/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGCUseCase is a mock of GCUseCase interface.
+type MockGCUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockGCUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockGCUseCaseMockRecorder is the mock recorder for MockGCUseCase.
+type MockGCUseCaseMockRecorder struct {
+	mock *MockGCUseCase
+}
+
+// NewMockGCUseCase creates a new mock instance.
+func NewMockGCUseCase(ctrl *gomock.Controller) *MockGCUseCase {
+	mock := &MockGCUseCase{ctrl: ctrl}
+	mock.recorder = &MockGCUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGCUseCase) EXPECT() *MockGCUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockGCUseCase) Run(ctx context.Context, query usecase.GCQuery) (*usecase.GCResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, query)
+	ret0, _ := ret[0].(*usecase.GCResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockGCUseCaseMockRecorder) Run(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockGCUseCase)(nil).Run), ctx, query)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: generic_oidc_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=generic_oidc_usecase.go -destination=../../tests/usecase/mock_generic_oidc_usecase.go -package=usecase
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/na2na-p/cargohold/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGenericOIDCProvider is a mock of GenericOIDCProvider interface.
+type MockGenericOIDCProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockGenericOIDCProviderMockRecorder
+	isgomock struct{}
+}
+
+// MockGenericOIDCProviderMockRecorder is the mock recorder for MockGenericOIDCProvider.
+type MockGenericOIDCProviderMockRecorder struct {
+	mock *MockGenericOIDCProvider
+}
+
+// NewMockGenericOIDCProvider creates a new mock instance.
+func NewMockGenericOIDCProvider(ctrl *gomock.Controller) *MockGenericOIDCProvider {
+	mock := &MockGenericOIDCProvider{ctrl: ctrl}
+	mock.recorder = &MockGenericOIDCProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGenericOIDCProvider) EXPECT() *MockGenericOIDCProviderMockRecorder {
+	return m.recorder
+}
+
+// VerifyIDToken mocks base method.
+func (m *MockGenericOIDCProvider) VerifyIDToken(ctx context.Context, token string) (*domain.OIDCIdentity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyIDToken", ctx, token)
+	ret0, _ := ret[0].(*domain.OIDCIdentity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyIDToken indicates an expected call of VerifyIDToken.
+func (mr *MockGenericOIDCProviderMockRecorder) VerifyIDToken(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyIDToken", reflect.TypeOf((*MockGenericOIDCProvider)(nil).VerifyIDToken), ctx, token)
+}
@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github_claim_rule_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=github_claim_rule_usecase.go -destination=../../tests/usecase/mock_github_claim_rule_usecase.go -package=usecase
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/na2na-p/cargohold/internal/domain"
+	usecase "github.com/na2na-p/cargohold/internal/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGitHubClaimRuleUseCase is a mock of GitHubClaimRuleUseCase interface.
+type MockGitHubClaimRuleUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockGitHubClaimRuleUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockGitHubClaimRuleUseCaseMockRecorder is the mock recorder for MockGitHubClaimRuleUseCase.
+type MockGitHubClaimRuleUseCaseMockRecorder struct {
+	mock *MockGitHubClaimRuleUseCase
+}
+
+// NewMockGitHubClaimRuleUseCase creates a new mock instance.
+func NewMockGitHubClaimRuleUseCase(ctrl *gomock.Controller) *MockGitHubClaimRuleUseCase {
+	mock := &MockGitHubClaimRuleUseCase{ctrl: ctrl}
+	mock.recorder = &MockGitHubClaimRuleUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGitHubClaimRuleUseCase) EXPECT() *MockGitHubClaimRuleUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockGitHubClaimRuleUseCase) Add(ctx context.Context, fullName string, input usecase.GitHubClaimRuleInput) (*domain.GitHubClaimRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, fullName, input)
+	ret0, _ := ret[0].(*domain.GitHubClaimRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockGitHubClaimRuleUseCaseMockRecorder) Add(ctx, fullName, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockGitHubClaimRuleUseCase)(nil).Add), ctx, fullName, input)
+}
+
+// List mocks base method.
+func (m *MockGitHubClaimRuleUseCase) List(ctx context.Context, fullName string) ([]*domain.GitHubClaimRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, fullName)
+	ret0, _ := ret[0].([]*domain.GitHubClaimRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockGitHubClaimRuleUseCaseMockRecorder) List(ctx, fullName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockGitHubClaimRuleUseCase)(nil).List), ctx, fullName)
+}
+
+// Remove mocks base method.
+func (m *MockGitHubClaimRuleUseCase) Remove(ctx context.Context, fullName, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, fullName, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockGitHubClaimRuleUseCaseMockRecorder) Remove(ctx, fullName, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockGitHubClaimRuleUseCase)(nil).Remove), ctx, fullName, id)
+}
@@ -59,32 +59,32 @@ func (mr *MockGitHubOAuthProviderInterfaceMockRecorder) CanAccessRepository(ctx,
 }
 
 // ExchangeCode mocks base method.
-func (m *MockGitHubOAuthProviderInterface) ExchangeCode(ctx context.Context, code string) (*usecase.OAuthTokenResult, error) {
+func (m *MockGitHubOAuthProviderInterface) ExchangeCode(ctx context.Context, code, redirectURI, codeVerifier string) (*usecase.OAuthTokenResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ExchangeCode", ctx, code)
+	ret := m.ctrl.Call(m, "ExchangeCode", ctx, code, redirectURI, codeVerifier)
 	ret0, _ := ret[0].(*usecase.OAuthTokenResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ExchangeCode indicates an expected call of ExchangeCode.
-func (mr *MockGitHubOAuthProviderInterfaceMockRecorder) ExchangeCode(ctx, code any) *gomock.Call {
+func (mr *MockGitHubOAuthProviderInterfaceMockRecorder) ExchangeCode(ctx, code, redirectURI, codeVerifier any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExchangeCode", reflect.TypeOf((*MockGitHubOAuthProviderInterface)(nil).ExchangeCode), ctx, code)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExchangeCode", reflect.TypeOf((*MockGitHubOAuthProviderInterface)(nil).ExchangeCode), ctx, code, redirectURI, codeVerifier)
 }
 
 // GetAuthorizationURL mocks base method.
-func (m *MockGitHubOAuthProviderInterface) GetAuthorizationURL(state string) string {
+func (m *MockGitHubOAuthProviderInterface) GetAuthorizationURL(state, redirectURI, codeChallenge string) string {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAuthorizationURL", state)
+	ret := m.ctrl.Call(m, "GetAuthorizationURL", state, redirectURI, codeChallenge)
 	ret0, _ := ret[0].(string)
 	return ret0
 }
 
 // GetAuthorizationURL indicates an expected call of GetAuthorizationURL.
-func (mr *MockGitHubOAuthProviderInterfaceMockRecorder) GetAuthorizationURL(state any) *gomock.Call {
+func (mr *MockGitHubOAuthProviderInterfaceMockRecorder) GetAuthorizationURL(state, redirectURI, codeChallenge any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthorizationURL", reflect.TypeOf((*MockGitHubOAuthProviderInterface)(nil).GetAuthorizationURL), state)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthorizationURL", reflect.TypeOf((*MockGitHubOAuthProviderInterface)(nil).GetAuthorizationURL), state, redirectURI, codeChallenge)
 }
 
 // GetRepositoryPermissions mocks base method.
@@ -117,16 +117,34 @@ func (mr *MockGitHubOAuthProviderInterfaceMockRecorder) GetUserInfo(ctx, token a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserInfo", reflect.TypeOf((*MockGitHubOAuthProviderInterface)(nil).GetUserInfo), ctx, token)
 }
 
-// SetRedirectURI mocks base method.
-func (m *MockGitHubOAuthProviderInterface) SetRedirectURI(redirectURI string) {
+// PollDeviceToken mocks base method.
+func (m *MockGitHubOAuthProviderInterface) PollDeviceToken(ctx context.Context, deviceCode string) (*usecase.OAuthTokenResult, error) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "SetRedirectURI", redirectURI)
+	ret := m.ctrl.Call(m, "PollDeviceToken", ctx, deviceCode)
+	ret0, _ := ret[0].(*usecase.OAuthTokenResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PollDeviceToken indicates an expected call of PollDeviceToken.
+func (mr *MockGitHubOAuthProviderInterfaceMockRecorder) PollDeviceToken(ctx, deviceCode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PollDeviceToken", reflect.TypeOf((*MockGitHubOAuthProviderInterface)(nil).PollDeviceToken), ctx, deviceCode)
 }
 
-// SetRedirectURI indicates an expected call of SetRedirectURI.
-func (mr *MockGitHubOAuthProviderInterfaceMockRecorder) SetRedirectURI(redirectURI any) *gomock.Call {
+// RequestDeviceCode mocks base method.
+func (m *MockGitHubOAuthProviderInterface) RequestDeviceCode(ctx context.Context) (*usecase.DeviceCodeResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestDeviceCode", ctx)
+	ret0, _ := ret[0].(*usecase.DeviceCodeResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestDeviceCode indicates an expected call of RequestDeviceCode.
+func (mr *MockGitHubOAuthProviderInterfaceMockRecorder) RequestDeviceCode(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRedirectURI", reflect.TypeOf((*MockGitHubOAuthProviderInterface)(nil).SetRedirectURI), redirectURI)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestDeviceCode", reflect.TypeOf((*MockGitHubOAuthProviderInterface)(nil).RequestDeviceCode), ctx)
 }
 
 // MockOAuthStateStoreInterface is a mock of OAuthStateStoreInterface interface.
@@ -182,6 +200,73 @@ func (mr *MockOAuthStateStoreInterfaceMockRecorder) SaveState(ctx, state, data,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveState", reflect.TypeOf((*MockOAuthStateStoreInterface)(nil).SaveState), ctx, state, data, ttl)
 }
 
+// MockDeviceAuthorizationStoreInterface is a mock of DeviceAuthorizationStoreInterface interface.
+type MockDeviceAuthorizationStoreInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeviceAuthorizationStoreInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockDeviceAuthorizationStoreInterfaceMockRecorder is the mock recorder for MockDeviceAuthorizationStoreInterface.
+type MockDeviceAuthorizationStoreInterfaceMockRecorder struct {
+	mock *MockDeviceAuthorizationStoreInterface
+}
+
+// NewMockDeviceAuthorizationStoreInterface creates a new mock instance.
+func NewMockDeviceAuthorizationStoreInterface(ctrl *gomock.Controller) *MockDeviceAuthorizationStoreInterface {
+	mock := &MockDeviceAuthorizationStoreInterface{ctrl: ctrl}
+	mock.recorder = &MockDeviceAuthorizationStoreInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeviceAuthorizationStoreInterface) EXPECT() *MockDeviceAuthorizationStoreInterfaceMockRecorder {
+	return m.recorder
+}
+
+// DeleteDeviceAuthorization mocks base method.
+func (m *MockDeviceAuthorizationStoreInterface) DeleteDeviceAuthorization(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDeviceAuthorization", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDeviceAuthorization indicates an expected call of DeleteDeviceAuthorization.
+func (mr *MockDeviceAuthorizationStoreInterfaceMockRecorder) DeleteDeviceAuthorization(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDeviceAuthorization", reflect.TypeOf((*MockDeviceAuthorizationStoreInterface)(nil).DeleteDeviceAuthorization), ctx, id)
+}
+
+// GetDeviceAuthorization mocks base method.
+func (m *MockDeviceAuthorizationStoreInterface) GetDeviceAuthorization(ctx context.Context, id string) (*domain.DeviceAuthorization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeviceAuthorization", ctx, id)
+	ret0, _ := ret[0].(*domain.DeviceAuthorization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeviceAuthorization indicates an expected call of GetDeviceAuthorization.
+func (mr *MockDeviceAuthorizationStoreInterfaceMockRecorder) GetDeviceAuthorization(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceAuthorization", reflect.TypeOf((*MockDeviceAuthorizationStoreInterface)(nil).GetDeviceAuthorization), ctx, id)
+}
+
+// SaveDeviceAuthorization mocks base method.
+func (m *MockDeviceAuthorizationStoreInterface) SaveDeviceAuthorization(ctx context.Context, id string, data *domain.DeviceAuthorization, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveDeviceAuthorization", ctx, id, data, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveDeviceAuthorization indicates an expected call of SaveDeviceAuthorization.
+func (mr *MockDeviceAuthorizationStoreInterfaceMockRecorder) SaveDeviceAuthorization(ctx, id, data, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveDeviceAuthorization", reflect.TypeOf((*MockDeviceAuthorizationStoreInterface)(nil).SaveDeviceAuthorization), ctx, id, data, ttl)
+}
+
 // MockSessionStoreInterface is a mock of SessionStoreInterface interface.
 type MockSessionStoreInterface struct {
 	ctrl     *gomock.Controller
@@ -207,18 +292,18 @@ func (m *MockSessionStoreInterface) EXPECT() *MockSessionStoreInterfaceMockRecor
 }
 
 // CreateSession mocks base method.
-func (m *MockSessionStoreInterface) CreateSession(ctx context.Context, userInfo *domain.UserInfo, ttl time.Duration) (string, error) {
+func (m *MockSessionStoreInterface) CreateSession(ctx context.Context, userInfo *domain.UserInfo, token *usecase.OAuthTokenResult, ttl time.Duration) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSession", ctx, userInfo, ttl)
+	ret := m.ctrl.Call(m, "CreateSession", ctx, userInfo, token, ttl)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateSession indicates an expected call of CreateSession.
-func (mr *MockSessionStoreInterfaceMockRecorder) CreateSession(ctx, userInfo, ttl any) *gomock.Call {
+func (mr *MockSessionStoreInterfaceMockRecorder) CreateSession(ctx, userInfo, token, ttl any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockSessionStoreInterface)(nil).CreateSession), ctx, userInfo, ttl)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockSessionStoreInterface)(nil).CreateSession), ctx, userInfo, token, ttl)
 }
 
 // DeleteSession mocks base method.
@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: lock_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=lock_usecase.go -destination=../../tests/usecase/mock_lock_usecase.go -package=usecase
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/na2na-p/cargohold/internal/domain"
+	usecase "github.com/na2na-p/cargohold/internal/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLockUseCase is a mock of LockUseCase interface.
+type MockLockUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockLockUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockLockUseCaseMockRecorder is the mock recorder for MockLockUseCase.
+type MockLockUseCaseMockRecorder struct {
+	mock *MockLockUseCase
+}
+
+// NewMockLockUseCase creates a new mock instance.
+func NewMockLockUseCase(ctrl *gomock.Controller) *MockLockUseCase {
+	mock := &MockLockUseCase{ctrl: ctrl}
+	mock.recorder = &MockLockUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLockUseCase) EXPECT() *MockLockUseCaseMockRecorder {
+	return m.recorder
+}
+
+// CreateLock mocks base method.
+func (m *MockLockUseCase) CreateLock(ctx context.Context, userInfo *domain.UserInfo, repository *domain.RepositoryIdentifier, path, refName string) (*domain.Lock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLock", ctx, userInfo, repository, path, refName)
+	ret0, _ := ret[0].(*domain.Lock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLock indicates an expected call of CreateLock.
+func (mr *MockLockUseCaseMockRecorder) CreateLock(ctx, userInfo, repository, path, refName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLock", reflect.TypeOf((*MockLockUseCase)(nil).CreateLock), ctx, userInfo, repository, path, refName)
+}
+
+// ListLocks mocks base method.
+func (m *MockLockUseCase) ListLocks(ctx context.Context, userInfo *domain.UserInfo, repository *domain.RepositoryIdentifier, query usecase.LockListQuery) (*usecase.LockListResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLocks", ctx, userInfo, repository, query)
+	ret0, _ := ret[0].(*usecase.LockListResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLocks indicates an expected call of ListLocks.
+func (mr *MockLockUseCaseMockRecorder) ListLocks(ctx, userInfo, repository, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLocks", reflect.TypeOf((*MockLockUseCase)(nil).ListLocks), ctx, userInfo, repository, query)
+}
+
+// Unlock mocks base method.
+func (m *MockLockUseCase) Unlock(ctx context.Context, userInfo *domain.UserInfo, repository *domain.RepositoryIdentifier, id string, force bool) (*domain.Lock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unlock", ctx, userInfo, repository, id, force)
+	ret0, _ := ret[0].(*domain.Lock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Unlock indicates an expected call of Unlock.
+func (mr *MockLockUseCaseMockRecorder) Unlock(ctx, userInfo, repository, id, force any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unlock", reflect.TypeOf((*MockLockUseCase)(nil).Unlock), ctx, userInfo, repository, id, force)
+}
+
+// VerifyLocks mocks base method.
+func (m *MockLockUseCase) VerifyLocks(ctx context.Context, userInfo *domain.UserInfo, repository *domain.RepositoryIdentifier, query usecase.LockVerifyQuery) (*usecase.LockVerifyResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyLocks", ctx, userInfo, repository, query)
+	ret0, _ := ret[0].(*usecase.LockVerifyResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyLocks indicates an expected call of VerifyLocks.
+func (mr *MockLockUseCaseMockRecorder) VerifyLocks(ctx, userInfo, repository, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyLocks", reflect.TypeOf((*MockLockUseCase)(nil).VerifyLocks), ctx, userInfo, repository, query)
+}
@@ -0,0 +1,140 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: rate_limit_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=rate_limit_usecase.go -destination=../../tests/usecase/mock_rate_limit_usecase.go -package=usecase
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/na2na-p/cargohold/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRateLimitStore is a mock of RateLimitStore interface.
+type MockRateLimitStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockRateLimitStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockRateLimitStoreMockRecorder is the mock recorder for MockRateLimitStore.
+type MockRateLimitStoreMockRecorder struct {
+	mock *MockRateLimitStore
+}
+
+// NewMockRateLimitStore creates a new mock instance.
+func NewMockRateLimitStore(ctrl *gomock.Controller) *MockRateLimitStore {
+	mock := &MockRateLimitStore{ctrl: ctrl}
+	mock.recorder = &MockRateLimitStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRateLimitStore) EXPECT() *MockRateLimitStoreMockRecorder {
+	return m.recorder
+}
+
+// Charge mocks base method.
+func (m *MockRateLimitStore) Charge(ctx context.Context, scope string, subject domain.RateLimitSubject, now time.Time, increment time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Charge", ctx, scope, subject, now, increment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Charge indicates an expected call of Charge.
+func (mr *MockRateLimitStoreMockRecorder) Charge(ctx, scope, subject, now, increment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Charge", reflect.TypeOf((*MockRateLimitStore)(nil).Charge), ctx, scope, subject, now, increment)
+}
+
+// Take mocks base method.
+func (m *MockRateLimitStore) Take(ctx context.Context, scope string, subject domain.RateLimitSubject, now time.Time, increment, tolerance time.Duration) (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Take", ctx, scope, subject, now, increment, tolerance)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Take indicates an expected call of Take.
+func (mr *MockRateLimitStoreMockRecorder) Take(ctx, scope, subject, now, increment, tolerance any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Take", reflect.TypeOf((*MockRateLimitStore)(nil).Take), ctx, scope, subject, now, increment, tolerance)
+}
+
+// MockRateLimitUseCase is a mock of RateLimitUseCase interface.
+type MockRateLimitUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockRateLimitUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockRateLimitUseCaseMockRecorder is the mock recorder for MockRateLimitUseCase.
+type MockRateLimitUseCaseMockRecorder struct {
+	mock *MockRateLimitUseCase
+}
+
+// NewMockRateLimitUseCase creates a new mock instance.
+func NewMockRateLimitUseCase(ctrl *gomock.Controller) *MockRateLimitUseCase {
+	mock := &MockRateLimitUseCase{ctrl: ctrl}
+	mock.recorder = &MockRateLimitUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRateLimitUseCase) EXPECT() *MockRateLimitUseCaseMockRecorder {
+	return m.recorder
+}
+
+// AllowBatch mocks base method.
+func (m *MockRateLimitUseCase) AllowBatch(ctx context.Context, subjects []domain.RateLimitSubject) (domain.RateLimitDecision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllowBatch", ctx, subjects)
+	ret0, _ := ret[0].(domain.RateLimitDecision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllowBatch indicates an expected call of AllowBatch.
+func (mr *MockRateLimitUseCaseMockRecorder) AllowBatch(ctx, subjects any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllowBatch", reflect.TypeOf((*MockRateLimitUseCase)(nil).AllowBatch), ctx, subjects)
+}
+
+// AllowProxyTransfer mocks base method.
+func (m *MockRateLimitUseCase) AllowProxyTransfer(ctx context.Context, subjects []domain.RateLimitSubject, expectedBytes int64) (domain.RateLimitDecision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllowProxyTransfer", ctx, subjects, expectedBytes)
+	ret0, _ := ret[0].(domain.RateLimitDecision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllowProxyTransfer indicates an expected call of AllowProxyTransfer.
+func (mr *MockRateLimitUseCaseMockRecorder) AllowProxyTransfer(ctx, subjects, expectedBytes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllowProxyTransfer", reflect.TypeOf((*MockRateLimitUseCase)(nil).AllowProxyTransfer), ctx, subjects, expectedBytes)
+}
+
+// RecordProxyTransfer mocks base method.
+func (m *MockRateLimitUseCase) RecordProxyTransfer(ctx context.Context, subjects []domain.RateLimitSubject, expectedBytes, transferredBytes int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordProxyTransfer", ctx, subjects, expectedBytes, transferredBytes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordProxyTransfer indicates an expected call of RecordProxyTransfer.
+func (mr *MockRateLimitUseCaseMockRecorder) RecordProxyTransfer(ctx, subjects, expectedBytes, transferredBytes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordProxyTransfer", reflect.TypeOf((*MockRateLimitUseCase)(nil).RecordProxyTransfer), ctx, subjects, expectedBytes, transferredBytes)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: reconcile_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=reconcile_usecase.go -destination=../../tests/usecase/mock_reconcile_usecase.go -package=usecase
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	usecase "github.com/na2na-p/cargohold/internal/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReconcileUseCase is a mock of ReconcileUseCase interface.
+type MockReconcileUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockReconcileUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockReconcileUseCaseMockRecorder is the mock recorder for MockReconcileUseCase.
+type MockReconcileUseCaseMockRecorder struct {
+	mock *MockReconcileUseCase
+}
+
+// NewMockReconcileUseCase creates a new mock instance.
+func NewMockReconcileUseCase(ctrl *gomock.Controller) *MockReconcileUseCase {
+	mock := &MockReconcileUseCase{ctrl: ctrl}
+	mock.recorder = &MockReconcileUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReconcileUseCase) EXPECT() *MockReconcileUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockReconcileUseCase) Run(ctx context.Context, query usecase.ReconcileQuery) (*usecase.ReconcileResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, query)
+	ret0, _ := ret[0].(*usecase.ReconcileResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockReconcileUseCaseMockRecorder) Run(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockReconcileUseCase)(nil).Run), ctx, query)
+}
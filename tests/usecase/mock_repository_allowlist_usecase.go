@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository_allowlist_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=repository_allowlist_usecase.go -destination=../../tests/usecase/mock_repository_allowlist_usecase.go -package=usecase
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/na2na-p/cargohold/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRepositoryAllowlistUseCase is a mock of RepositoryAllowlistUseCase interface.
+type MockRepositoryAllowlistUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryAllowlistUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockRepositoryAllowlistUseCaseMockRecorder is the mock recorder for MockRepositoryAllowlistUseCase.
+type MockRepositoryAllowlistUseCaseMockRecorder struct {
+	mock *MockRepositoryAllowlistUseCase
+}
+
+// NewMockRepositoryAllowlistUseCase creates a new mock instance.
+func NewMockRepositoryAllowlistUseCase(ctrl *gomock.Controller) *MockRepositoryAllowlistUseCase {
+	mock := &MockRepositoryAllowlistUseCase{ctrl: ctrl}
+	mock.recorder = &MockRepositoryAllowlistUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepositoryAllowlistUseCase) EXPECT() *MockRepositoryAllowlistUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockRepositoryAllowlistUseCase) Add(ctx context.Context, fullName string) (*domain.AllowedRepository, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, fullName)
+	ret0, _ := ret[0].(*domain.AllowedRepository)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockRepositoryAllowlistUseCaseMockRecorder) Add(ctx, fullName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockRepositoryAllowlistUseCase)(nil).Add), ctx, fullName)
+}
+
+// List mocks base method.
+func (m *MockRepositoryAllowlistUseCase) List(ctx context.Context) ([]*domain.AllowedRepository, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]*domain.AllowedRepository)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockRepositoryAllowlistUseCaseMockRecorder) List(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRepositoryAllowlistUseCase)(nil).List), ctx)
+}
+
+// Remove mocks base method.
+func (m *MockRepositoryAllowlistUseCase) Remove(ctx context.Context, fullName string) (*domain.AllowedRepository, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, fullName)
+	ret0, _ := ret[0].(*domain.AllowedRepository)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockRepositoryAllowlistUseCaseMockRecorder) Remove(ctx, fullName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockRepositoryAllowlistUseCase)(nil).Remove), ctx, fullName)
+}
@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	domain "github.com/na2na-p/cargohold/internal/domain"
+	usecase "github.com/na2na-p/cargohold/internal/usecase"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -55,3 +56,109 @@ func (mr *MockSessionClientMockRecorder) GetSession(ctx, sessionID any) *gomock.
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockSessionClient)(nil).GetSession), ctx, sessionID)
 }
+
+// MockSessionTokenStore is a mock of SessionTokenStore interface.
+type MockSessionTokenStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockSessionTokenStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockSessionTokenStoreMockRecorder is the mock recorder for MockSessionTokenStore.
+type MockSessionTokenStoreMockRecorder struct {
+	mock *MockSessionTokenStore
+}
+
+// NewMockSessionTokenStore creates a new mock instance.
+func NewMockSessionTokenStore(ctrl *gomock.Controller) *MockSessionTokenStore {
+	mock := &MockSessionTokenStore{ctrl: ctrl}
+	mock.recorder = &MockSessionTokenStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSessionTokenStore) EXPECT() *MockSessionTokenStoreMockRecorder {
+	return m.recorder
+}
+
+// DeleteSession mocks base method.
+func (m *MockSessionTokenStore) DeleteSession(ctx context.Context, sessionID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSession", ctx, sessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSession indicates an expected call of DeleteSession.
+func (mr *MockSessionTokenStoreMockRecorder) DeleteSession(ctx, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSession", reflect.TypeOf((*MockSessionTokenStore)(nil).DeleteSession), ctx, sessionID)
+}
+
+// GetSessionToken mocks base method.
+func (m *MockSessionTokenStore) GetSessionToken(ctx context.Context, sessionID string) (*usecase.SessionToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionToken", ctx, sessionID)
+	ret0, _ := ret[0].(*usecase.SessionToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionToken indicates an expected call of GetSessionToken.
+func (mr *MockSessionTokenStoreMockRecorder) GetSessionToken(ctx, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionToken", reflect.TypeOf((*MockSessionTokenStore)(nil).GetSessionToken), ctx, sessionID)
+}
+
+// UpdateSession mocks base method.
+func (m *MockSessionTokenStore) UpdateSession(ctx context.Context, sessionID string, userInfo *domain.UserInfo, token *usecase.SessionToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSession", ctx, sessionID, userInfo, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSession indicates an expected call of UpdateSession.
+func (mr *MockSessionTokenStoreMockRecorder) UpdateSession(ctx, sessionID, userInfo, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSession", reflect.TypeOf((*MockSessionTokenStore)(nil).UpdateSession), ctx, sessionID, userInfo, token)
+}
+
+// MockRepositoryPermissionsFetcher is a mock of RepositoryPermissionsFetcher interface.
+type MockRepositoryPermissionsFetcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryPermissionsFetcherMockRecorder
+	isgomock struct{}
+}
+
+// MockRepositoryPermissionsFetcherMockRecorder is the mock recorder for MockRepositoryPermissionsFetcher.
+type MockRepositoryPermissionsFetcherMockRecorder struct {
+	mock *MockRepositoryPermissionsFetcher
+}
+
+// NewMockRepositoryPermissionsFetcher creates a new mock instance.
+func NewMockRepositoryPermissionsFetcher(ctrl *gomock.Controller) *MockRepositoryPermissionsFetcher {
+	mock := &MockRepositoryPermissionsFetcher{ctrl: ctrl}
+	mock.recorder = &MockRepositoryPermissionsFetcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepositoryPermissionsFetcher) EXPECT() *MockRepositoryPermissionsFetcherMockRecorder {
+	return m.recorder
+}
+
+// GetRepositoryPermissions mocks base method.
+func (m *MockRepositoryPermissionsFetcher) GetRepositoryPermissions(ctx context.Context, token *usecase.OAuthTokenResult, repo *domain.RepositoryIdentifier) (domain.RepositoryPermissions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRepositoryPermissions", ctx, token, repo)
+	ret0, _ := ret[0].(domain.RepositoryPermissions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRepositoryPermissions indicates an expected call of GetRepositoryPermissions.
+func (mr *MockRepositoryPermissionsFetcherMockRecorder) GetRepositoryPermissions(ctx, token, repo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRepositoryPermissions", reflect.TypeOf((*MockRepositoryPermissionsFetcher)(nil).GetRepositoryPermissions), ctx, token, repo)
+}
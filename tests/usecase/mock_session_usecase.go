@@ -0,0 +1,155 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: session_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=session_usecase.go -destination=../../tests/usecase/mock_session_usecase.go -package=usecase
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/na2na-p/cargohold/internal/domain"
+	usecase "github.com/na2na-p/cargohold/internal/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserSessionStoreInterface is a mock of UserSessionStoreInterface interface.
+type MockUserSessionStoreInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserSessionStoreInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockUserSessionStoreInterfaceMockRecorder is the mock recorder for MockUserSessionStoreInterface.
+type MockUserSessionStoreInterfaceMockRecorder struct {
+	mock *MockUserSessionStoreInterface
+}
+
+// NewMockUserSessionStoreInterface creates a new mock instance.
+func NewMockUserSessionStoreInterface(ctrl *gomock.Controller) *MockUserSessionStoreInterface {
+	mock := &MockUserSessionStoreInterface{ctrl: ctrl}
+	mock.recorder = &MockUserSessionStoreInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserSessionStoreInterface) EXPECT() *MockUserSessionStoreInterfaceMockRecorder {
+	return m.recorder
+}
+
+// DeleteSession mocks base method.
+func (m *MockUserSessionStoreInterface) DeleteSession(ctx context.Context, sessionID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSession", ctx, sessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSession indicates an expected call of DeleteSession.
+func (mr *MockUserSessionStoreInterfaceMockRecorder) DeleteSession(ctx, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSession", reflect.TypeOf((*MockUserSessionStoreInterface)(nil).DeleteSession), ctx, sessionID)
+}
+
+// DeleteUserSessions mocks base method.
+func (m *MockUserSessionStoreInterface) DeleteUserSessions(ctx context.Context, sub string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserSessions", ctx, sub)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUserSessions indicates an expected call of DeleteUserSessions.
+func (mr *MockUserSessionStoreInterfaceMockRecorder) DeleteUserSessions(ctx, sub any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserSessions", reflect.TypeOf((*MockUserSessionStoreInterface)(nil).DeleteUserSessions), ctx, sub)
+}
+
+// ListUserSessions mocks base method.
+func (m *MockUserSessionStoreInterface) ListUserSessions(ctx context.Context, sub string) ([]*usecase.UserSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserSessions", ctx, sub)
+	ret0, _ := ret[0].([]*usecase.UserSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserSessions indicates an expected call of ListUserSessions.
+func (mr *MockUserSessionStoreInterfaceMockRecorder) ListUserSessions(ctx, sub any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserSessions", reflect.TypeOf((*MockUserSessionStoreInterface)(nil).ListUserSessions), ctx, sub)
+}
+
+// MockSessionUseCase is a mock of SessionUseCase interface.
+type MockSessionUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSessionUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockSessionUseCaseMockRecorder is the mock recorder for MockSessionUseCase.
+type MockSessionUseCaseMockRecorder struct {
+	mock *MockSessionUseCase
+}
+
+// NewMockSessionUseCase creates a new mock instance.
+func NewMockSessionUseCase(ctrl *gomock.Controller) *MockSessionUseCase {
+	mock := &MockSessionUseCase{ctrl: ctrl}
+	mock.recorder = &MockSessionUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSessionUseCase) EXPECT() *MockSessionUseCaseMockRecorder {
+	return m.recorder
+}
+
+// ListForUser mocks base method.
+func (m *MockSessionUseCase) ListForUser(ctx context.Context, userInfo *domain.UserInfo) ([]*usecase.UserSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListForUser", ctx, userInfo)
+	ret0, _ := ret[0].([]*usecase.UserSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListForUser indicates an expected call of ListForUser.
+func (mr *MockSessionUseCaseMockRecorder) ListForUser(ctx, userInfo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListForUser", reflect.TypeOf((*MockSessionUseCase)(nil).ListForUser), ctx, userInfo)
+}
+
+// Logout mocks base method.
+func (m *MockSessionUseCase) Logout(ctx context.Context, sessionID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logout", ctx, sessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Logout indicates an expected call of Logout.
+func (mr *MockSessionUseCaseMockRecorder) Logout(ctx, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockSessionUseCase)(nil).Logout), ctx, sessionID)
+}
+
+// RevokeAllForGitHubUser mocks base method.
+func (m *MockSessionUseCase) RevokeAllForGitHubUser(ctx context.Context, githubUserID string) (*usecase.RevokedUserCredentials, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAllForGitHubUser", ctx, githubUserID)
+	ret0, _ := ret[0].(*usecase.RevokedUserCredentials)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAllForGitHubUser indicates an expected call of RevokeAllForGitHubUser.
+func (mr *MockSessionUseCaseMockRecorder) RevokeAllForGitHubUser(ctx, githubUserID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAllForGitHubUser", reflect.TypeOf((*MockSessionUseCase)(nil).RevokeAllForGitHubUser), ctx, githubUserID)
+}
@@ -42,16 +42,30 @@ func (m *MockUploadUseCase) EXPECT() *MockUploadUseCaseMockRecorder {
 	return m.recorder
 }
 
+// HandleProofUpload mocks base method.
+func (m *MockUploadUseCase) HandleProofUpload(ctx context.Context, baseURL, owner, repo string, oid domain.OID, size domain.Size, obj *domain.LFSObject, authHeader string) usecase.ResponseObject {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandleProofUpload", ctx, baseURL, owner, repo, oid, size, obj, authHeader)
+	ret0, _ := ret[0].(usecase.ResponseObject)
+	return ret0
+}
+
+// HandleProofUpload indicates an expected call of HandleProofUpload.
+func (mr *MockUploadUseCaseMockRecorder) HandleProofUpload(ctx, baseURL, owner, repo, oid, size, obj, authHeader any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleProofUpload", reflect.TypeOf((*MockUploadUseCase)(nil).HandleProofUpload), ctx, baseURL, owner, repo, oid, size, obj, authHeader)
+}
+
 // HandleUploadObject mocks base method.
-func (m *MockUploadUseCase) HandleUploadObject(ctx context.Context, baseURL, owner, repo string, oid domain.OID, size domain.Size, hashAlgo, authHeader string) usecase.ResponseObject {
+func (m *MockUploadUseCase) HandleUploadObject(ctx context.Context, baseURL, owner, repo string, oid domain.OID, size domain.Size, obj *domain.LFSObject, hashAlgo, authHeader string) usecase.ResponseObject {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "HandleUploadObject", ctx, baseURL, owner, repo, oid, size, hashAlgo, authHeader)
+	ret := m.ctrl.Call(m, "HandleUploadObject", ctx, baseURL, owner, repo, oid, size, obj, hashAlgo, authHeader)
 	ret0, _ := ret[0].(usecase.ResponseObject)
 	return ret0
 }
 
 // HandleUploadObject indicates an expected call of HandleUploadObject.
-func (mr *MockUploadUseCaseMockRecorder) HandleUploadObject(ctx, baseURL, owner, repo, oid, size, hashAlgo, authHeader any) *gomock.Call {
+func (mr *MockUploadUseCaseMockRecorder) HandleUploadObject(ctx, baseURL, owner, repo, oid, size, obj, hashAlgo, authHeader any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleUploadObject", reflect.TypeOf((*MockUploadUseCase)(nil).HandleUploadObject), ctx, baseURL, owner, repo, oid, size, hashAlgo, authHeader)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleUploadObject", reflect.TypeOf((*MockUploadUseCase)(nil).HandleUploadObject), ctx, baseURL, owner, repo, oid, size, obj, hashAlgo, authHeader)
 }
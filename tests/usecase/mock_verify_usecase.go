@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	domain "github.com/na2na-p/cargohold/internal/domain"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -41,15 +42,15 @@ func (m *MockVerifyUseCaseInterface) EXPECT() *MockVerifyUseCaseInterfaceMockRec
 }
 
 // VerifyUpload mocks base method.
-func (m *MockVerifyUseCaseInterface) VerifyUpload(ctx context.Context, oid string, size int64) error {
+func (m *MockVerifyUseCaseInterface) VerifyUpload(ctx context.Context, repository *domain.RepositoryIdentifier, oid string, size int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "VerifyUpload", ctx, oid, size)
+	ret := m.ctrl.Call(m, "VerifyUpload", ctx, repository, oid, size)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // VerifyUpload indicates an expected call of VerifyUpload.
-func (mr *MockVerifyUseCaseInterfaceMockRecorder) VerifyUpload(ctx, oid, size any) *gomock.Call {
+func (mr *MockVerifyUseCaseInterfaceMockRecorder) VerifyUpload(ctx, repository, oid, size any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyUpload", reflect.TypeOf((*MockVerifyUseCaseInterface)(nil).VerifyUpload), ctx, oid, size)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyUpload", reflect.TypeOf((*MockVerifyUseCaseInterface)(nil).VerifyUpload), ctx, repository, oid, size)
 }